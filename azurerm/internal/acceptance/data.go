@@ -9,7 +9,9 @@ import (
 
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/mockarm"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 )
 
 func init() {
@@ -51,6 +53,9 @@ type TestData struct {
 
 	// resourceLabel is the local used for the resource - generally "test""
 	resourceLabel string
+
+	// recorder is used to record (or replay) the requests made during this test, when enabled
+	recorder *recording.Recorder
 }
 
 // BuildTestData generates some test data for the given resource
@@ -72,6 +77,11 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 
 		ResourceType:  resourceType,
 		resourceLabel: resourceLabel,
+		recorder:      recorderForTest(t),
+	}
+
+	if mockarm.Enabled() {
+		setMockEnvironment()
 	}
 
 	if features.UseDynamicTestLocations() {
@@ -84,6 +94,12 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		}
 	}
 
+	if testData.recorder != nil {
+		if err := testData.useRecordedValues(); err != nil {
+			t.Fatalf("Error retrieving the recorded values: %+v", err)
+		}
+	}
+
 	return testData
 }
 
@@ -122,5 +138,13 @@ func (td *TestData) RandomStringOfLength(len int) string {
 		panic("Invalid Test: RandomStringOfLength: length argument must be between 1 and 1024 characters")
 	}
 
+	if td.recorder != nil {
+		v, err := td.recordedValue(fmt.Sprintf("RandomStringOfLength(%d)", len), acctest.RandString(len))
+		if err != nil {
+			panic(fmt.Sprintf("Invalid Test: RandomStringOfLength: %+v", err))
+		}
+		return v
+	}
+
 	return acctest.RandString(len)
 }
@@ -0,0 +1,22 @@
+package acceptance
+
+import (
+	"os"
+)
+
+// setMockEnvironment sets the credentials and locations (when these aren't already set) when running
+// against the mock Resource Manager, since no requests are sent to Azure
+func setMockEnvironment() {
+	setReplayEnvironment()
+
+	locations := map[string]string{
+		"ARM_TEST_LOCATION":      "westeurope",
+		"ARM_TEST_LOCATION_ALT":  "eastus2",
+		"ARM_TEST_LOCATION_ALT2": "westus2",
+	}
+	for k, v := range locations {
+		if os.Getenv(k) == "" {
+			os.Setenv(k, v)
+		}
+	}
+}
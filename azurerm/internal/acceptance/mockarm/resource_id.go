@@ -0,0 +1,84 @@
+package mockarm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// resourceId is a Resource ID which has been parsed by the mock Resource Manager
+type resourceId struct {
+	// ID is the Resource ID, where the `subscriptions`, `resourceGroups` and `providers` segments use the
+	// casing returned by Azure (since some SDK's send these in lower-case)
+	ID string
+
+	// Name is the name of the Resource
+	Name string
+
+	// Type is the fully qualified type of the Resource, e.g. `Microsoft.ManagedIdentity/userAssignedIdentities`
+	Type string
+
+	// ResourceGroupId is the ID of the Resource Group containing this Resource, if any
+	ResourceGroupId string
+
+	// ParentId is the ID of the parent Resource, for nested Resources
+	ParentId string
+}
+
+// parseResourceId parses the path of a request to the Resource ID of a Resource Group, or a Resource
+// within a Subscription or Resource Group (including nested Resources)
+func parseResourceId(path string) (*resourceId, error) {
+	path = strings.TrimSuffix(path, "/")
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("the Resource ID %q contains an empty segment", path)
+		}
+	}
+
+	if len(segments) < 4 || !strings.EqualFold(segments[0], "subscriptions") {
+		return nil, fmt.Errorf("the Resource ID %q is not scoped to a Resource Group or a Resource Provider within a Subscription", path)
+	}
+
+	// the index of the `providers` segment, which follows the Resource Group when the Resource is within one
+	providers := 2
+	segments[0] = "subscriptions"
+	if strings.EqualFold(segments[2], "resourceGroups") {
+		segments[2] = "resourceGroups"
+		providers = 4
+	}
+	if len(segments) > providers && strings.EqualFold(segments[providers], "providers") {
+		segments[providers] = "providers"
+	}
+
+	id := resourceId{
+		ID:   "/" + strings.Join(segments, "/"),
+		Name: segments[len(segments)-1],
+	}
+
+	if providers == 4 {
+		if len(segments) == 4 {
+			id.Type = "Microsoft.Resources/resourceGroups"
+			return &id, nil
+		}
+
+		id.ResourceGroupId = "/" + strings.Join(segments[0:4], "/")
+	}
+
+	// the remaining segments should be `providers/{namespace}` followed by pairs of `{type}/{name}`
+	remaining := segments[providers:]
+	if len(remaining) < 4 || len(remaining)%2 != 0 || !strings.EqualFold(remaining[0], "providers") {
+		return nil, fmt.Errorf("the Resource ID %q is not a Resource ID for a Resource Provider", path)
+	}
+
+	types := []string{remaining[1]}
+	for i := 2; i < len(remaining); i += 2 {
+		types = append(types, remaining[i])
+	}
+	id.Type = strings.Join(types, "/")
+
+	if len(remaining) > 4 {
+		id.ParentId = "/" + strings.Join(segments[0:len(segments)-2], "/")
+	}
+
+	return &id, nil
+}
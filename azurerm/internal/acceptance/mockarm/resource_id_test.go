@@ -0,0 +1,103 @@
+package mockarm
+
+import (
+	"testing"
+)
+
+func TestParseResourceId(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *resourceId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// subscription
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Error: true,
+		},
+		{
+			// missing resource group name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Error: true,
+		},
+		{
+			// resource group
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			Expected: &resourceId{
+				ID:   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+				Name: "group1",
+				Type: "Microsoft.Resources/resourceGroups",
+			},
+		},
+		{
+			// lower-cased segments are returned in the casing used by Azure
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/resourcegroups/group1/PROVIDERS/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+			Expected: &resourceId{
+				ID:              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+				Name:            "identity1",
+				Type:            "Microsoft.ManagedIdentity/userAssignedIdentities",
+				ResourceGroupId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			},
+		},
+		{
+			// missing resource name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities",
+			Error: true,
+		},
+		{
+			// resource within a resource group
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+			Expected: &resourceId{
+				ID:              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+				Name:            "identity1",
+				Type:            "Microsoft.ManagedIdentity/userAssignedIdentities",
+				ResourceGroupId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			},
+		},
+		{
+			// nested resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: &resourceId{
+				ID:              "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+				Name:            "subnet1",
+				Type:            "Microsoft.Network/virtualNetworks/subnets",
+				ResourceGroupId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+				ParentId:        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			},
+		},
+		{
+			// resource within a subscription
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyDefinitions/definition1",
+			Expected: &resourceId{
+				ID:   "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyDefinitions/definition1",
+				Name: "definition1",
+				Type: "Microsoft.Authorization/policyDefinitions",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		actual, err := parseResourceId(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
@@ -0,0 +1,393 @@
+package mockarm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// ResourceType configures how the mock Resource Manager handles a type of Resource - Resource
+// Types which haven't been registered are created, updated and deleted synchronously
+type ResourceType struct {
+	// AsyncCreateUpdate specifies that creating or updating the Resource is a long-running operation
+	AsyncCreateUpdate bool
+
+	// AsyncDelete specifies that deleting the Resource is a long-running operation
+	AsyncDelete bool
+
+	// ComputedProperties returns the read-only properties set when the Resource is created, which are retained when it's updated
+	ComputedProperties func(s *Server) map[string]interface{}
+}
+
+// defaultResourceTypes are the Resource Types which are registered by default, keyed by the lower-cased type
+var defaultResourceTypes = map[string]ResourceType{
+	"microsoft.resources/resourcegroups": {
+		AsyncDelete: true,
+	},
+	"microsoft.managedidentity/userassignedidentities": {
+		ComputedProperties: func(s *Server) map[string]interface{} {
+			return map[string]interface{}{
+				"clientId":    newUUID(),
+				"principalId": newUUID(),
+				"tenantId":    s.TenantId,
+			}
+		},
+	},
+}
+
+// Server is an in-process mock of Azure Resource Manager, which stores Resources in-memory
+//
+// Resources are created (PUT), updated (PATCH), retrieved (GET) and deleted (DELETE) generically by their Resource ID,
+// with long-running operations polled using the `Azure-AsyncOperation` header - and 404's returned for Resources
+// which don't exist (or whose Resource Group doesn't exist)
+type Server struct {
+	// PollsUntilComplete is the number of times a long-running operation returns `InProgress` before it completes
+	PollsUntilComplete int
+
+	// TenantId is the Tenant ID returned for Resources which expose this
+	TenantId string
+
+	server *httptest.Server
+
+	lock       sync.Mutex
+	resources  map[string]*mockResource
+	operations map[string]*operation
+	types      map[string]ResourceType
+}
+
+type mockResource struct {
+	body     map[string]interface{}
+	computed map[string]interface{}
+}
+
+type operation struct {
+	pollsRemaining int
+	complete       func()
+	done           bool
+}
+
+// NewServer starts a mock Resource Manager, which should be closed once it's no longer needed
+func NewServer() *Server {
+	s := &Server{
+		PollsUntilComplete: 1,
+		TenantId:           newUUID(),
+		resources:          make(map[string]*mockResource),
+		operations:         make(map[string]*operation),
+		types:              make(map[string]ResourceType),
+	}
+	for k, v := range defaultResourceTypes {
+		s.types[k] = v
+	}
+
+	s.server = httptest.NewServer(s)
+	return s
+}
+
+// Endpoint returns the Resource Manager Endpoint which the clients should use
+func (s *Server) Endpoint() string {
+	return s.server.URL + "/"
+}
+
+// Close stops the mock Resource Manager
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// RegisterResourceType configures how the specified Resource Type (e.g. `Microsoft.Foo/bars`) is handled
+func (s *Server) RegisterResourceType(resourceType string, config ResourceType) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.types[strings.ToLower(resourceType)] = config
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log.Printf("[DEBUG] Mock Resource Manager: %s %s", r.Method, r.URL)
+
+	if r.URL.Query().Get("api-version") == "" {
+		writeError(w, http.StatusBadRequest, "MissingApiVersionParameter", "The api-version query parameter (?api-version=) is required for all requests.")
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	// the Resource Providers are listed when the Provider is configured, to determine which need to be registered
+	if r.Method == http.MethodGet && len(segments) == 3 && strings.EqualFold(segments[0], "subscriptions") && strings.EqualFold(segments[2], "providers") {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"value": []interface{}{},
+		})
+		return
+	}
+
+	if r.Method == http.MethodGet && len(segments) == 6 && strings.EqualFold(segments[4], "operationStatuses") {
+		s.getOperation(w, segments[5])
+		return
+	}
+
+	id, err := parseResourceId(r.URL.Path)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidResourceId", err.Error())
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.getResource(w, *id)
+	case http.MethodHead:
+		s.headResource(w, *id)
+	case http.MethodPut:
+		s.putResource(w, r, *id)
+	case http.MethodPatch:
+		s.patchResource(w, r, *id)
+	case http.MethodDelete:
+		s.deleteResource(w, r, *id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("The %s method is not supported by the mock Resource Manager", r.Method))
+	}
+}
+
+func (s *Server) getResource(w http.ResponseWriter, id resourceId) {
+	existing, ok := s.resources[strings.ToLower(id.ID)]
+	if !ok {
+		writeNotFound(w, id)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, existing.body)
+}
+
+func (s *Server) headResource(w http.ResponseWriter, id resourceId) {
+	if _, ok := s.resources[strings.ToLower(id.ID)]; !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) putResource(w http.ResponseWriter, r *http.Request, id resourceId) {
+	var body map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body == nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("The request content was invalid and could not be deserialized: %v", err))
+		return
+	}
+
+	if id.ResourceGroupId != "" {
+		if _, ok := s.resources[strings.ToLower(id.ResourceGroupId)]; !ok {
+			writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group %q could not be found.", id.ResourceGroupId))
+			return
+		}
+	}
+	if id.ParentId != "" {
+		if _, ok := s.resources[strings.ToLower(id.ParentId)]; !ok {
+			writeError(w, http.StatusNotFound, "ParentResourceNotFound", fmt.Sprintf("Can not perform requested operation on nested resource. Parent resource %q not found.", id.ParentId))
+			return
+		}
+	}
+
+	config := s.types[strings.ToLower(id.Type)]
+	existing, exists := s.resources[strings.ToLower(id.ID)]
+	if !exists {
+		existing = &mockResource{}
+		if config.ComputedProperties != nil {
+			existing.computed = config.ComputedProperties(s)
+		}
+	}
+
+	body["id"] = id.ID
+	body["name"] = id.Name
+	body["type"] = id.Type
+	existing.body = body
+	s.resources[strings.ToLower(id.ID)] = existing
+
+	status := http.StatusOK
+	provisioningState := "Updating"
+	if !exists {
+		status = http.StatusCreated
+		provisioningState = "Creating"
+	}
+
+	if !config.AsyncCreateUpdate {
+		s.setProperties(existing, "Succeeded")
+		writeJSON(w, status, existing.body)
+		return
+	}
+
+	s.setProperties(existing, provisioningState)
+	s.startOperation(w, r, func() {
+		s.setProperties(existing, "Succeeded")
+	})
+	writeJSON(w, status, existing.body)
+}
+
+func (s *Server) patchResource(w http.ResponseWriter, r *http.Request, id resourceId) {
+	existing, ok := s.resources[strings.ToLower(id.ID)]
+	if !ok {
+		writeNotFound(w, id)
+		return
+	}
+
+	var patch map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil || patch == nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("The request content was invalid and could not be deserialized: %v", err))
+		return
+	}
+
+	// the top-level fields (e.g. `tags`) are replaced, whereas the `properties` are merged
+	for k, v := range patch {
+		if k == "properties" {
+			continue
+		}
+		existing.body[k] = v
+	}
+	if properties, ok := patch["properties"].(map[string]interface{}); ok {
+		existingProperties, _ := existing.body["properties"].(map[string]interface{})
+		if existingProperties == nil {
+			existingProperties = make(map[string]interface{})
+		}
+		for k, v := range properties {
+			existingProperties[k] = v
+		}
+		existing.body["properties"] = existingProperties
+	}
+
+	s.setProperties(existing, "Succeeded")
+	writeJSON(w, http.StatusOK, existing.body)
+}
+
+func (s *Server) deleteResource(w http.ResponseWriter, r *http.Request, id resourceId) {
+	existing, ok := s.resources[strings.ToLower(id.ID)]
+	if !ok {
+		// deleting a Resource which doesn't exist succeeds, other than for Resource Groups
+		if strings.EqualFold(id.Type, "Microsoft.Resources/resourceGroups") {
+			writeNotFound(w, id)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	config := s.types[strings.ToLower(id.Type)]
+	if !config.AsyncDelete {
+		s.removeResource(id.ID)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	s.setProperties(existing, "Deleting")
+	s.startOperation(w, r, func() {
+		s.removeResource(id.ID)
+	})
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// removeResource removes the Resource along with any Resources within it (e.g. within a Resource Group)
+func (s *Server) removeResource(id string) {
+	prefix := strings.ToLower(id) + "/"
+	for k := range s.resources {
+		if k == strings.ToLower(id) || strings.HasPrefix(k, prefix) {
+			delete(s.resources, k)
+		}
+	}
+}
+
+// setProperties sets the Provisioning State and the Computed Properties of the Resource
+func (s *Server) setProperties(r *mockResource, provisioningState string) {
+	properties, _ := r.body["properties"].(map[string]interface{})
+	if properties == nil {
+		properties = make(map[string]interface{})
+	}
+
+	for k, v := range r.computed {
+		properties[k] = v
+	}
+	properties["provisioningState"] = provisioningState
+	r.body["properties"] = properties
+}
+
+// startOperation starts a long-running operation, which is polled via the `Azure-AsyncOperation` header
+// and which runs the specified function once it completes
+func (s *Server) startOperation(w http.ResponseWriter, r *http.Request, complete func()) {
+	operationId := newUUID()
+	s.operations[operationId] = &operation{
+		pollsRemaining: s.PollsUntilComplete,
+		complete:       complete,
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	uri := fmt.Sprintf("%s/subscriptions/%s/providers/Microsoft.Resources/operationStatuses/%s?api-version=%s", s.server.URL, segments[1], operationId, r.URL.Query().Get("api-version"))
+	w.Header().Set("Azure-AsyncOperation", uri)
+
+	// requests are polled without delay, since the operation completes after a number of polls rather than a duration
+	w.Header().Set("Retry-After", "0")
+}
+
+func (s *Server) getOperation(w http.ResponseWriter, operationId string) {
+	op, ok := s.operations[operationId]
+	if !ok {
+		writeError(w, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("The operation %q was not found.", operationId))
+		return
+	}
+
+	if !op.done && op.pollsRemaining > 0 {
+		op.pollsRemaining--
+	} else if !op.done {
+		op.complete()
+		op.done = true
+	}
+
+	status := "InProgress"
+	if op.done {
+		status = "Succeeded"
+	}
+
+	w.Header().Set("Retry-After", "0")
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"name":   operationId,
+		"status": status,
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, id resourceId) {
+	if strings.EqualFold(id.Type, "Microsoft.Resources/resourceGroups") {
+		writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group %q could not be found.", id.Name))
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource %q under resource group %q was not found.", id.Type+"/"+id.Name, id.ResourceGroupId))
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJSON(w, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("[DEBUG] Mock Resource Manager: writing response: %+v", err)
+	}
+}
+
+func newUUID() string {
+	v, err := uuid.GenerateUUID()
+	if err != nil {
+		panic(fmt.Sprintf("generating UUID: %+v", err))
+	}
+	return v
+}
@@ -0,0 +1,139 @@
+package mockarm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const testSubscriptionId = "12345678-1234-9876-4563-123456789012"
+
+func TestServerResourceLifecycle(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.PollsUntilComplete = 2
+
+	ctx := context.TODO()
+	groupsClient := resources.NewGroupsClientWithBaseURI(server.Endpoint(), testSubscriptionId)
+	groupsClient.Authorizer = autorest.NullAuthorizer{}
+	groupsClient.PollingDelay = 0
+	identitiesClient := msi.NewUserAssignedIdentitiesClientWithBaseURI(server.Endpoint(), testSubscriptionId)
+	identitiesClient.Authorizer = autorest.NullAuthorizer{}
+
+	// creating a Resource within a Resource Group which doesn't exist should fail
+	resp, err := identitiesClient.CreateOrUpdate(ctx, "group1", "identity1", msi.Identity{
+		Location: utils.String("westeurope"),
+	})
+	if err == nil {
+		t.Fatalf("expected an error creating an Identity within a Resource Group which doesn't exist but didn't get one")
+	}
+	if !utils.ResponseWasNotFound(resp.Response) {
+		t.Fatalf("expected a 404 creating an Identity within a Resource Group which doesn't exist but got %d", resp.StatusCode)
+	}
+
+	group, err := groupsClient.CreateOrUpdate(ctx, "group1", resources.Group{
+		Location: utils.String("westeurope"),
+	})
+	if err != nil {
+		t.Fatalf("creating Resource Group: %+v", err)
+	}
+	if group.StatusCode != http.StatusCreated {
+		t.Fatalf("expected a 201 creating the Resource Group but got %d", group.StatusCode)
+	}
+	expectedId := fmt.Sprintf("/subscriptions/%s/resourceGroups/group1", testSubscriptionId)
+	if group.ID == nil || *group.ID != expectedId {
+		t.Fatalf("expected the ID to be %q but got %v", expectedId, group.ID)
+	}
+	if group.Properties == nil || group.Properties.ProvisioningState == nil || *group.Properties.ProvisioningState != "Succeeded" {
+		t.Fatalf("expected the Resource Group to be provisioned but got %+v", group.Properties)
+	}
+
+	if _, err = groupsClient.Update(ctx, "group1", resources.GroupPatchable{
+		Tags: map[string]*string{
+			"environment": utils.String("test"),
+		},
+	}); err != nil {
+		t.Fatalf("updating Resource Group: %+v", err)
+	}
+	group, err = groupsClient.Get(ctx, "group1")
+	if err != nil {
+		t.Fatalf("retrieving Resource Group: %+v", err)
+	}
+	if v := group.Tags["environment"]; v == nil || *v != "test" {
+		t.Fatalf("expected the Resource Group to be tagged but got %+v", group.Tags)
+	}
+	if group.Location == nil || *group.Location != "westeurope" {
+		t.Fatalf("expected the location to be retained but got %v", group.Location)
+	}
+
+	identity, err := identitiesClient.CreateOrUpdate(ctx, "group1", "identity1", msi.Identity{
+		Location: utils.String("westeurope"),
+	})
+	if err != nil {
+		t.Fatalf("creating Identity: %+v", err)
+	}
+	if identity.UserAssignedIdentityProperties == nil || identity.PrincipalID == nil || identity.ClientID == nil {
+		t.Fatalf("expected the Client and Principal ID's to be computed but got %+v", identity.UserAssignedIdentityProperties)
+	}
+	if identity.TenantID == nil || identity.TenantID.String() != server.TenantId {
+		t.Fatalf("expected the Tenant ID to be %q but got %v", server.TenantId, identity.TenantID)
+	}
+
+	// the computed properties should be retained when the Resource is updated
+	updated, err := identitiesClient.CreateOrUpdate(ctx, "group1", "identity1", msi.Identity{
+		Location: utils.String("westeurope"),
+		Tags: map[string]*string{
+			"environment": utils.String("test"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("updating Identity: %+v", err)
+	}
+	if updated.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 updating the Identity but got %d", updated.StatusCode)
+	}
+	if updated.PrincipalID == nil || updated.PrincipalID.String() != identity.PrincipalID.String() {
+		t.Fatalf("expected the Principal ID to be %q but got %v", identity.PrincipalID.String(), updated.PrincipalID)
+	}
+
+	// deleting the Resource Group is a long-running operation, which deletes the Resources within it
+	future, err := groupsClient.Delete(ctx, "group1")
+	if err != nil {
+		t.Fatalf("deleting Resource Group: %+v", err)
+	}
+	if err = future.WaitForCompletionRef(ctx, groupsClient.Client); err != nil {
+		t.Fatalf("waiting for the deletion of the Resource Group: %+v", err)
+	}
+
+	if resp, err = identitiesClient.Get(ctx, "group1", "identity1"); err == nil || !utils.ResponseWasNotFound(resp.Response) {
+		t.Fatalf("expected the Identity to be deleted with the Resource Group but got %d: %+v", resp.StatusCode, err)
+	}
+	if group, err = groupsClient.Get(ctx, "group1"); err == nil || !utils.ResponseWasNotFound(group.Response) {
+		t.Fatalf("expected the Resource Group to be deleted but got %d: %+v", group.StatusCode, err)
+	}
+}
+
+func TestServerRequiresApiVersion(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, server.Endpoint()+"subscriptions/"+testSubscriptionId+"/resourceGroups/group1", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected a 400 but got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,29 @@
+package mockarm
+
+import (
+	"os"
+	"strconv"
+	"sync"
+)
+
+// EnvVar is the Environment Variable used to run the Acceptance Tests against the mock Resource Manager
+const EnvVar = "ARM_TEST_MOCK_RESOURCE_MANAGER"
+
+var (
+	sharedServer *Server
+	sharedOnce   sync.Once
+)
+
+// Enabled returns whether the Acceptance Tests should be run against the mock Resource Manager
+func Enabled() bool {
+	enabled, err := strconv.ParseBool(os.Getenv(EnvVar))
+	return err == nil && enabled
+}
+
+// Shared returns the mock Resource Manager used by the Acceptance Tests within this process, starting it if necessary
+func Shared() *Server {
+	sharedOnce.Do(func() {
+		sharedServer = NewServer()
+	})
+	return sharedServer
+}
@@ -0,0 +1,106 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
+)
+
+var recorders = struct {
+	lock   sync.Mutex
+	byTest map[string]*recording.Recorder
+}{
+	byTest: make(map[string]*recording.Recorder),
+}
+
+// recorderForTest returns the Recorder used to record (or replay) the requests made during this test,
+// which is shared between each TestData built for this test - or nil when recording is disabled
+func recorderForTest(t *testing.T) *recording.Recorder {
+	recorders.lock.Lock()
+	defer recorders.lock.Unlock()
+
+	if recorder, ok := recorders.byTest[t.Name()]; ok {
+		return recorder
+	}
+
+	recorder, err := recording.NewRecorder(t.Name())
+	if err != nil {
+		t.Fatalf("building Recorder: %+v", err)
+	}
+	if recorder == nil {
+		return nil
+	}
+
+	if recorder.Replaying() {
+		setReplayEnvironment()
+	}
+
+	recorders.byTest[t.Name()] = recorder
+	t.Cleanup(func() {
+		recorders.lock.Lock()
+		delete(recorders.byTest, t.Name())
+		recorders.lock.Unlock()
+
+		if t.Failed() && !recorder.Replaying() {
+			t.Logf("[DEBUG] Not saving the recording %q since the test failed", recorder.File())
+		}
+		if err := recorder.Stop(t.Failed()); err != nil {
+			t.Errorf("saving the recording: %+v", err)
+		}
+	})
+
+	return recorder
+}
+
+// setReplayEnvironment sets the credentials used to authenticate to placeholder values (when these
+// aren't already set) when replaying a recording or using the mock Resource Manager, since no requests are sent to Azure
+func setReplayEnvironment() {
+	values := map[string]string{
+		"ARM_CLIENT_ID":       recording.ReplayIdentity.ClientId,
+		"ARM_CLIENT_SECRET":   "replay",
+		"ARM_SUBSCRIPTION_ID": recording.ReplayIdentity.SubscriptionId,
+		"ARM_TENANT_ID":       recording.ReplayIdentity.TenantId,
+	}
+	for k, v := range values {
+		if os.Getenv(k) == "" {
+			os.Setenv(k, v)
+		}
+	}
+}
+
+// recordedValue returns the value of the named variable for this TestData - which is loaded from the
+// recording when replaying, and otherwise is the specified value (which is recorded)
+func (td TestData) recordedValue(name, value string) (string, error) {
+	return td.recorder.Variable(fmt.Sprintf("%s.%s", td.ResourceName, name), value)
+}
+
+// useRecordedValues replaces the random values for this TestData with those from the recording when
+// replaying, so that the requests made match those which were recorded
+func (td *TestData) useRecordedValues() error {
+	randomInteger, err := td.recordedValue("RandomInteger", strconv.Itoa(td.RandomInteger))
+	if err != nil {
+		return err
+	}
+	if td.RandomInteger, err = strconv.Atoi(randomInteger); err != nil {
+		return fmt.Errorf("parsing the recorded RandomInteger %q: %+v", randomInteger, err)
+	}
+
+	if td.RandomString, err = td.recordedValue("RandomString", td.RandomString); err != nil {
+		return err
+	}
+	if td.Locations.Primary, err = td.recordedValue("Locations.Primary", td.Locations.Primary); err != nil {
+		return err
+	}
+	if td.Locations.Secondary, err = td.recordedValue("Locations.Secondary", td.Locations.Secondary); err != nil {
+		return err
+	}
+	if td.Locations.Ternary, err = td.recordedValue("Locations.Ternary", td.Locations.Ternary); err != nil {
+		return err
+	}
+
+	return nil
+}
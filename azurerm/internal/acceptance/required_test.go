@@ -33,7 +33,7 @@ func TestAccEnsureRequiredResourceProvidersAreRegistered(t *testing.T) {
 		t.Fatalf("Error building ARM Client: %+v", err)
 	}
 
-	client := armClient.Resource.ProvidersClient
+	client := armClient.Resource().ProvidersClient
 	ctx := armClient.StopContext
 	providerList, err := client.List(ctx, nil, "")
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/helpers"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/mockarm"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/testclient"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/types"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
@@ -58,7 +59,15 @@ func (td TestData) runAcceptanceTest(t *testing.T, testCase resource.TestCase) {
 	if testclient.EnableBinaryTesting {
 		testCase.ProviderFactories = map[string]terraform.ResourceProviderFactory{
 			"azurerm": func() (terraform.ResourceProvider, error) {
-				azurerm := provider.TestAzureProvider()
+				overrides := provider.TestOverrides{}
+				if td.recorder != nil {
+					overrides.Recording = td.recorder
+				}
+				if mockarm.Enabled() {
+					overrides.MockResourceManagerEndpoint = mockarm.Shared().Endpoint()
+				}
+
+				azurerm := provider.TestAzureProviderWithOverrides(overrides)
 				return azurerm, nil
 			},
 		}
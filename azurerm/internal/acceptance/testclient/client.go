@@ -9,8 +9,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/mockarm"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 )
 
 var (
@@ -58,8 +61,22 @@ func Build() (*clients.Client, error) {
 				SkipProviderRegistration: true,
 				TerraformVersion:         os.Getenv("TERRAFORM_CORE_VERSION"),
 				Features:                 features.Default(),
+				Retry:                    common.DefaultRetryOptions(),
 				StorageUseAzureAD:        false,
 			}
+
+			// this client is shared between tests, so requests are recorded (or replayed) for the test which requested the same resource
+			mode, err := recording.CurrentMode()
+			if err != nil {
+				return nil, err
+			}
+			if mode != recording.ModeLive {
+				clientBuilder.Recording = recording.SharedTransport(mode)
+			}
+			if mockarm.Enabled() {
+				clientBuilder.DisableAuthentication = true
+				clientBuilder.Endpoints.ResourceManager = mockarm.Shared().Endpoint()
+			}
 			client, err := clients.Build(context.TODO(), clientBuilder)
 			if err != nil {
 				return nil, err
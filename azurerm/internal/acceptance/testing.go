@@ -10,6 +10,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 )
 
 func PreCheck(t *testing.T) {
@@ -18,9 +19,11 @@ func PreCheck(t *testing.T) {
 		"ARM_CLIENT_SECRET",
 		"ARM_SUBSCRIPTION_ID",
 		"ARM_TENANT_ID",
-		"ARM_TEST_LOCATION",
-		"ARM_TEST_LOCATION_ALT",
-		"ARM_TEST_LOCATION_ALT2",
+	}
+
+	// the locations are loaded from the recording when replaying
+	if mode, _ := recording.CurrentMode(); mode != recording.ModeReplay {
+		variables = append(variables, "ARM_TEST_LOCATION", "ARM_TEST_LOCATION_ALT", "ARM_TEST_LOCATION_ALT2")
 	}
 
 	for _, variable := range variables {
@@ -13,11 +13,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 )
 
 type ClientBuilder struct {
 	AuthConfig                  *authentication.Config
+	DisableAuthentication       bool
 	DisableCorrelationRequestID bool
 	DisableTerraformPartnerID   bool
 	PartnerId                   string
@@ -25,6 +28,12 @@ type ClientBuilder struct {
 	StorageUseAzureAD           bool
 	TerraformVersion            string
 	Features                    features.UserFeatures
+	Endpoints                   common.EndpointOptions
+	RateLimit                   common.RateLimitOptions
+	Recording                   recording.Transport
+	Retry                       common.RetryOptions
+	Tags                        tags.Settings
+	WireTraceFile               string
 }
 
 const azureStackEnvironmentError = `
@@ -55,14 +64,41 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, err
 	}
 
+	// the Graph Endpoint is also the audience of the tokens used to access Graph, which is
+	// unchanged when the endpoint is overridden (e.g. to use an API Gateway)
+	graphAudience := env.GraphEndpoint
+	if err := builder.Endpoints.Validate(); err != nil {
+		return nil, fmt.Errorf("validating the `endpoints` block: %+v", err)
+	}
+	builder.Endpoints.Apply(env)
+
+	// when replaying a recording (or authentication is disabled) no requests are sent to Azure Active Directory
+	offline := builder.DisableAuthentication || (builder.Recording != nil && builder.Recording.Replaying())
+	authConfig := *builder.AuthConfig
+	if offline {
+		authConfig.GetAuthenticatedObjectID = nil
+	}
+
 	// client declarations:
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, *env, builder.SkipProviderRegistration)
+	account, err := NewResourceManagerAccount(ctx, authConfig, *env, builder.SkipProviderRegistration)
 	if err != nil {
 		return nil, fmt.Errorf("Error building account: %+v", err)
 	}
+	if offline {
+		account.ObjectId = recording.ReplayIdentity.ObjectId
+	}
+	if builder.Recording != nil {
+		builder.Recording.SetIdentity(recording.Identity{
+			SubscriptionId: account.SubscriptionId,
+			TenantId:       account.TenantId,
+			ClientId:       account.ClientId,
+			ObjectId:       account.ObjectId,
+		})
+	}
 
 	client := Client{
 		Account: account,
+		Tags:    builder.Tags,
 	}
 
 	oauthConfig, err := builder.AuthConfig.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
@@ -86,7 +122,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	// Graph Endpoints
 	graphEndpoint := env.GraphEndpoint
-	graphAuth, err := builder.AuthConfig.GetAuthorizationToken(sender, oauthConfig, graphEndpoint)
+	graphAuth, err := builder.AuthConfig.GetAuthorizationToken(sender, oauthConfig, graphAudience)
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +145,30 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	// Key Vault Endpoints
-	keyVaultAuth := builder.AuthConfig.BearerAuthorizerCallback(sender, oauthConfig)
+	var keyVaultAuth autorest.Authorizer = builder.AuthConfig.BearerAuthorizerCallback(sender, oauthConfig)
+
+	// tokens are only obtained when a request is sent, so the Authorizers can be replaced before that happens
+	if offline {
+		auth = autorest.NullAuthorizer{}
+		graphAuth = autorest.NullAuthorizer{}
+		keyVaultAuth = autorest.NullAuthorizer{}
+		storageAuth = autorest.NullAuthorizer{}
+		if synapseAuth != nil {
+			synapseAuth = autorest.NullAuthorizer{}
+		}
+	}
+
+	// the Rate Limiter is shared between each of the clients, so that the budget applies to the Provider as a whole
+	rateLimiter, err := common.NewRateLimiter(builder.RateLimit, endpoint, builder.AuthConfig.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("building Rate Limiter: %+v", err)
+	}
+
+	// the Wire Tracer is shared between each of the clients, so that every request is written to the same file
+	wireTracer, err := common.NewWireTracer(builder.WireTraceFile, env.KeyVaultDNSSuffix)
+	if err != nil {
+		return nil, fmt.Errorf("building Wire Tracer: %+v", err)
+	}
 
 	o := &common.ClientOptions{
 		SubscriptionId:              builder.AuthConfig.SubscriptionID,
@@ -126,9 +185,14 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		SkipProviderReg:             builder.SkipProviderRegistration,
 		DisableCorrelationRequestID: builder.DisableCorrelationRequestID,
 		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
+		Endpoints:                   builder.Endpoints,
 		Environment:                 *env,
 		Features:                    builder.Features,
+		RateLimiter:                 rateLimiter,
+		Recording:                   builder.Recording,
+		Retry:                       builder.Retry,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
+		WireTracer:                  wireTracer,
 	}
 
 	if err := client.Build(ctx, o); err != nil {
@@ -137,7 +201,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	if features.EnhancedValidationEnabled() {
 		location.CacheSupportedLocations(ctx, env)
-		resourceproviders.CacheSupportedProviders(ctx, client.Resource.ProvidersClient)
+		resourceproviders.CacheSupportedProviders(ctx, client.Resource().ProvidersClient)
 	}
 
 	return &client, nil
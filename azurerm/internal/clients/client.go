@@ -2,8 +2,8 @@ package clients
 
 import (
 	"context"
+	"sync"
 
-	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
@@ -95,6 +95,7 @@ import (
 	trafficManager "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/trafficmanager/client"
 	vmware "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/vmware/client"
 	web "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/client"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 )
 
 type Client struct {
@@ -104,194 +105,287 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
-	Advisor               *advisor.Client
-	AnalysisServices      *analysisServices.Client
-	ApiManagement         *apiManagement.Client
-	AppConfiguration      *appConfiguration.Client
-	AppInsights           *applicationInsights.Client
-	AppPlatform           *appPlatform.Client
-	Attestation           *attestation.Client
-	Authorization         *authorization.Client
-	Automation            *automation.Client
-	AzureStackHCI         *azureStackHCI.Client
-	Batch                 *batch.Client
-	Blueprints            *blueprints.Client
-	Bot                   *bot.Client
-	Cdn                   *cdn.Client
-	Cognitive             *cognitiveServices.Client
-	Compute               *compute.Client
-	Containers            *containerServices.Client
-	Cosmos                *cosmosdb.Client
-	CostManagement        *costmanagement.Client
-	CustomProviders       *customproviders.Client
-	DatabaseMigration     *datamigration.Client
-	DataBricks            *databricks.Client
-	DataboxEdge           *databoxedge.Client
-	DataFactory           *datafactory.Client
-	Datalake              *datalake.Client
-	DataShare             *datashare.Client
-	DesktopVirtualization *desktopvirtualization.Client
-	DevSpace              *devspace.Client
-	DevTestLabs           *devtestlabs.Client
-	DigitalTwins          *digitaltwins.Client
-	Dns                   *dns.Client
-	EventGrid             *eventgrid.Client
-	Eventhub              *eventhub.Client
-	Firewall              *firewall.Client
-	Frontdoor             *frontdoor.Client
-	HPCCache              *hpccache.Client
-	HSM                   *hsm.Client
-	HDInsight             *hdinsight.Client
-	HealthCare            *healthcare.Client
-	IoTCentral            *iotcentral.Client
-	IoTHub                *iothub.Client
-	IoTTimeSeriesInsights *timeseriesinsights.Client
-	KeyVault              *keyvault.Client
-	Kusto                 *kusto.Client
-	Lighthouse            *lighthouse.Client
-	LoadBalancers         *loadbalancers.Client
-	LogAnalytics          *loganalytics.Client
-	Logic                 *logic.Client
-	MachineLearning       *machinelearning.Client
-	Maintenance           *maintenance.Client
-	ManagedApplication    *managedapplication.Client
-	ManagementGroups      *managementgroup.Client
-	Maps                  *maps.Client
-	MariaDB               *mariadb.Client
-	Media                 *media.Client
-	MixedReality          *mixedreality.Client
-	Monitor               *monitor.Client
-	MSI                   *msi.Client
-	MSSQL                 *mssql.Client
-	MySQL                 *mysql.Client
-	NetApp                *netapp.Client
-	Network               *network.Client
-	NotificationHubs      *notificationhub.Client
-	Policy                *policy.Client
-	Portal                *portal.Client
-	Postgres              *postgres.Client
-	PowerBI               *powerBI.Client
-	PrivateDns            *privatedns.Client
-	RecoveryServices      *recoveryServices.Client
-	Redis                 *redis.Client
-	RedisEnterprise       *redisenterprise.Client
-	Relay                 *relay.Client
-	Resource              *resource.Client
-	Search                *search.Client
-	SecurityCenter        *securityCenter.Client
-	Sentinel              *sentinel.Client
-	ServiceBus            *serviceBus.Client
-	ServiceFabric         *serviceFabric.Client
-	ServiceFabricMesh     *serviceFabricMesh.Client
-	SignalR               *signalr.Client
-	Storage               *storage.Client
-	StreamAnalytics       *streamAnalytics.Client
-	Subscription          *subscription.Client
-	Sql                   *sql.Client
-	Synapse               *synapse.Client
-	TrafficManager        *trafficManager.Client
-	Vmware                *vmware.Client
-	Web                   *web.Client
+	// Tags are the settings for tags from the Provider block, which are applied to every resource which supports tags
+	Tags tags.Settings
+
+	// options are used to build each of the Service Clients when these are first used, which
+	// avoids building the Service Clients for every Service when the Provider is configured
+	options *common.ClientOptions
+
+	advisorOnce sync.Once
+	advisor     *advisor.Client
+
+	analysisServicesOnce sync.Once
+	analysisServices     *analysisServices.Client
+
+	apiManagementOnce sync.Once
+	apiManagement     *apiManagement.Client
+
+	appConfigurationOnce sync.Once
+	appConfiguration     *appConfiguration.Client
+
+	appInsightsOnce sync.Once
+	appInsights     *applicationInsights.Client
+
+	appPlatformOnce sync.Once
+	appPlatform     *appPlatform.Client
+
+	attestationOnce sync.Once
+	attestation     *attestation.Client
+
+	authorizationOnce sync.Once
+	authorization     *authorization.Client
+
+	automationOnce sync.Once
+	automation     *automation.Client
+
+	azureStackHCIOnce sync.Once
+	azureStackHCI     *azureStackHCI.Client
+
+	batchOnce sync.Once
+	batch     *batch.Client
+
+	blueprintsOnce sync.Once
+	blueprints     *blueprints.Client
+
+	botOnce sync.Once
+	bot     *bot.Client
+
+	cdnOnce sync.Once
+	cdn     *cdn.Client
+
+	cognitiveOnce sync.Once
+	cognitive     *cognitiveServices.Client
+
+	computeOnce sync.Once
+	compute     *compute.Client
+
+	containersOnce sync.Once
+	containers     *containerServices.Client
+
+	cosmosOnce sync.Once
+	cosmos     *cosmosdb.Client
+
+	costManagementOnce sync.Once
+	costManagement     *costmanagement.Client
+
+	customProvidersOnce sync.Once
+	customProviders     *customproviders.Client
+
+	databaseMigrationOnce sync.Once
+	databaseMigration     *datamigration.Client
+
+	dataBricksOnce sync.Once
+	dataBricks     *databricks.Client
+
+	databoxEdgeOnce sync.Once
+	databoxEdge     *databoxedge.Client
+
+	dataFactoryOnce sync.Once
+	dataFactory     *datafactory.Client
+
+	datalakeOnce sync.Once
+	datalake     *datalake.Client
+
+	dataShareOnce sync.Once
+	dataShare     *datashare.Client
+
+	desktopVirtualizationOnce sync.Once
+	desktopVirtualization     *desktopvirtualization.Client
+
+	devSpaceOnce sync.Once
+	devSpace     *devspace.Client
+
+	devTestLabsOnce sync.Once
+	devTestLabs     *devtestlabs.Client
+
+	digitalTwinsOnce sync.Once
+	digitalTwins     *digitaltwins.Client
+
+	dnsOnce sync.Once
+	dns     *dns.Client
+
+	eventGridOnce sync.Once
+	eventGrid     *eventgrid.Client
+
+	eventhubOnce sync.Once
+	eventhub     *eventhub.Client
+
+	firewallOnce sync.Once
+	firewall     *firewall.Client
+
+	frontdoorOnce sync.Once
+	frontdoor     *frontdoor.Client
+
+	hpcCacheOnce sync.Once
+	hpcCache     *hpccache.Client
+
+	hsmOnce sync.Once
+	hsm     *hsm.Client
+
+	hdInsightOnce sync.Once
+	hdInsight     *hdinsight.Client
+
+	healthCareOnce sync.Once
+	healthCare     *healthcare.Client
+
+	ioTCentralOnce sync.Once
+	ioTCentral     *iotcentral.Client
+
+	ioTHubOnce sync.Once
+	ioTHub     *iothub.Client
+
+	ioTTimeSeriesInsightsOnce sync.Once
+	ioTTimeSeriesInsights     *timeseriesinsights.Client
+
+	keyVaultOnce sync.Once
+	keyVault     *keyvault.Client
+
+	kustoOnce sync.Once
+	kusto     *kusto.Client
+
+	lighthouseOnce sync.Once
+	lighthouse     *lighthouse.Client
+
+	loadBalancersOnce sync.Once
+	loadBalancers     *loadbalancers.Client
+
+	logAnalyticsOnce sync.Once
+	logAnalytics     *loganalytics.Client
+
+	logicOnce sync.Once
+	logic     *logic.Client
+
+	machineLearningOnce sync.Once
+	machineLearning     *machinelearning.Client
+
+	maintenanceOnce sync.Once
+	maintenance     *maintenance.Client
+
+	managedApplicationOnce sync.Once
+	managedApplication     *managedapplication.Client
+
+	managementGroupsOnce sync.Once
+	managementGroups     *managementgroup.Client
+
+	mapsOnce sync.Once
+	maps     *maps.Client
+
+	mariaDBOnce sync.Once
+	mariaDB     *mariadb.Client
+
+	mediaOnce sync.Once
+	media     *media.Client
+
+	mixedRealityOnce sync.Once
+	mixedReality     *mixedreality.Client
+
+	monitorOnce sync.Once
+	monitor     *monitor.Client
+
+	msiOnce sync.Once
+	msi     *msi.Client
+
+	mssqlOnce sync.Once
+	mssql     *mssql.Client
+
+	mySQLOnce sync.Once
+	mySQL     *mysql.Client
+
+	netAppOnce sync.Once
+	netApp     *netapp.Client
+
+	networkOnce sync.Once
+	network     *network.Client
+
+	notificationHubsOnce sync.Once
+	notificationHubs     *notificationhub.Client
+
+	policyOnce sync.Once
+	policy     *policy.Client
+
+	portalOnce sync.Once
+	portal     *portal.Client
+
+	postgresOnce sync.Once
+	postgres     *postgres.Client
+
+	powerBIOnce sync.Once
+	powerBI     *powerBI.Client
+
+	privateDnsOnce sync.Once
+	privateDns     *privatedns.Client
+
+	recoveryServicesOnce sync.Once
+	recoveryServices     *recoveryServices.Client
+
+	redisOnce sync.Once
+	redis     *redis.Client
+
+	redisEnterpriseOnce sync.Once
+	redisEnterprise     *redisenterprise.Client
+
+	relayOnce sync.Once
+	relay     *relay.Client
+
+	resourceOnce sync.Once
+	resource     *resource.Client
+
+	searchOnce sync.Once
+	search     *search.Client
+
+	securityCenterOnce sync.Once
+	securityCenter     *securityCenter.Client
+
+	sentinelOnce sync.Once
+	sentinel     *sentinel.Client
+
+	serviceBusOnce sync.Once
+	serviceBus     *serviceBus.Client
+
+	serviceFabricOnce sync.Once
+	serviceFabric     *serviceFabric.Client
+
+	serviceFabricMeshOnce sync.Once
+	serviceFabricMesh     *serviceFabricMesh.Client
+
+	signalROnce sync.Once
+	signalR     *signalr.Client
+
+	storageOnce sync.Once
+	storage     *storage.Client
+
+	streamAnalyticsOnce sync.Once
+	streamAnalytics     *streamAnalytics.Client
+
+	subscriptionOnce sync.Once
+	subscription     *subscription.Client
+
+	sqlOnce sync.Once
+	sql     *sql.Client
+
+	synapseOnce sync.Once
+	synapse     *synapse.Client
+
+	trafficManagerOnce sync.Once
+	trafficManager     *trafficManager.Client
+
+	vmwareOnce sync.Once
+	vmware     *vmware.Client
+
+	webOnce sync.Once
+	web     *web.Client
 }
 
 // NOTE: it should be possible for this method to become Private once the top level Client's removed
 
 func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error {
-	autorest.Count429AsRetry = false
 	// Disable the Azure SDK for Go's validation since it's unhelpful for our use-case
 	validation.Disabled = true
 
 	client.Features = o.Features
 	client.StopContext = ctx
-
-	client.Advisor = advisor.NewClient(o)
-	client.AnalysisServices = analysisServices.NewClient(o)
-	client.ApiManagement = apiManagement.NewClient(o)
-	client.AppConfiguration = appConfiguration.NewClient(o)
-	client.AppInsights = applicationInsights.NewClient(o)
-	client.AppPlatform = appPlatform.NewClient(o)
-	client.Attestation = attestation.NewClient(o)
-	client.Authorization = authorization.NewClient(o)
-	client.Automation = automation.NewClient(o)
-	client.AzureStackHCI = azureStackHCI.NewClient(o)
-	client.Batch = batch.NewClient(o)
-	client.Blueprints = blueprints.NewClient(o)
-	client.Bot = bot.NewClient(o)
-	client.Cdn = cdn.NewClient(o)
-	client.Cognitive = cognitiveServices.NewClient(o)
-	client.Compute = compute.NewClient(o)
-	client.Containers = containerServices.NewClient(o)
-	client.Cosmos = cosmosdb.NewClient(o)
-	client.CostManagement = costmanagement.NewClient(o)
-	client.CustomProviders = customproviders.NewClient(o)
-	client.DatabaseMigration = datamigration.NewClient(o)
-	client.DataBricks = databricks.NewClient(o)
-	client.DataboxEdge = databoxedge.NewClient(o)
-	client.DataFactory = datafactory.NewClient(o)
-	client.Datalake = datalake.NewClient(o)
-	client.DataShare = datashare.NewClient(o)
-	client.DesktopVirtualization = desktopvirtualization.NewClient(o)
-	client.DevSpace = devspace.NewClient(o)
-	client.DevTestLabs = devtestlabs.NewClient(o)
-	client.DigitalTwins = digitaltwins.NewClient(o)
-	client.Dns = dns.NewClient(o)
-	client.EventGrid = eventgrid.NewClient(o)
-	client.Eventhub = eventhub.NewClient(o)
-	client.Firewall = firewall.NewClient(o)
-	client.Frontdoor = frontdoor.NewClient(o)
-	client.HPCCache = hpccache.NewClient(o)
-	client.HSM = hsm.NewClient(o)
-	client.HDInsight = hdinsight.NewClient(o)
-	client.HealthCare = healthcare.NewClient(o)
-	client.IoTCentral = iotcentral.NewClient(o)
-	client.IoTHub = iothub.NewClient(o)
-	client.IoTTimeSeriesInsights = timeseriesinsights.NewClient(o)
-	client.KeyVault = keyvault.NewClient(o)
-	client.Kusto = kusto.NewClient(o)
-	client.Lighthouse = lighthouse.NewClient(o)
-	client.LogAnalytics = loganalytics.NewClient(o)
-	client.LoadBalancers = loadbalancers.NewClient(o)
-	client.Logic = logic.NewClient(o)
-	client.MachineLearning = machinelearning.NewClient(o)
-	client.Maintenance = maintenance.NewClient(o)
-	client.ManagedApplication = managedapplication.NewClient(o)
-	client.ManagementGroups = managementgroup.NewClient(o)
-	client.Maps = maps.NewClient(o)
-	client.MariaDB = mariadb.NewClient(o)
-	client.Media = media.NewClient(o)
-	client.MixedReality = mixedreality.NewClient(o)
-	client.Monitor = monitor.NewClient(o)
-	client.MSI = msi.NewClient(o)
-	client.MSSQL = mssql.NewClient(o)
-	client.MySQL = mysql.NewClient(o)
-	client.NetApp = netapp.NewClient(o)
-	client.Network = network.NewClient(o)
-	client.NotificationHubs = notificationhub.NewClient(o)
-	client.Policy = policy.NewClient(o)
-	client.Portal = portal.NewClient(o)
-	client.Postgres = postgres.NewClient(o)
-	client.PowerBI = powerBI.NewClient(o)
-	client.PrivateDns = privatedns.NewClient(o)
-	client.RecoveryServices = recoveryServices.NewClient(o)
-	client.Redis = redis.NewClient(o)
-	client.RedisEnterprise = redisenterprise.NewClient(o)
-	client.Relay = relay.NewClient(o)
-	client.Resource = resource.NewClient(o)
-	client.Search = search.NewClient(o)
-	client.SecurityCenter = securityCenter.NewClient(o)
-	client.Sentinel = sentinel.NewClient(o)
-	client.ServiceBus = serviceBus.NewClient(o)
-	client.ServiceFabric = serviceFabric.NewClient(o)
-	client.ServiceFabricMesh = serviceFabricMesh.NewClient(o)
-	client.SignalR = signalr.NewClient(o)
-	client.Sql = sql.NewClient(o)
-	client.Storage = storage.NewClient(o)
-	client.StreamAnalytics = streamAnalytics.NewClient(o)
-	client.Subscription = subscription.NewClient(o)
-	client.Synapse = synapse.NewClient(o)
-	client.TrafficManager = trafficManager.NewClient(o)
-	client.Vmware = vmware.NewClient(o)
-	client.Web = web.NewClient(o)
+	client.options = o
 
 	return nil
 }
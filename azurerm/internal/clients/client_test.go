@@ -0,0 +1,146 @@
+package clients
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func testClientOptions() *common.ClientOptions {
+	return &common.ClientOptions{
+		SubscriptionId:          "00000000-0000-0000-0000-000000000000",
+		TenantID:                "00000000-0000-0000-0000-000000000000",
+		TerraformVersion:        "0.15.0",
+		GraphEndpoint:           azure.PublicCloud.GraphEndpoint,
+		ResourceManagerEndpoint: azure.PublicCloud.ResourceManagerEndpoint,
+		Environment:             azure.PublicCloud,
+		Features:                features.Default(),
+		Retry:                   common.DefaultRetryOptions(),
+	}
+}
+
+func buildTestClient(t testing.TB) *Client {
+	client := Client{}
+	if err := client.Build(context.TODO(), testClientOptions()); err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	return &client
+}
+
+// serviceClients returns each of the Service Clients, building these if necessary
+func serviceClients(client *Client) map[string]interface{} {
+	out := make(map[string]interface{})
+
+	v := reflect.ValueOf(client)
+	for i := 0; i < v.NumMethod(); i++ {
+		method := v.Method(i)
+		if method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+			continue
+		}
+
+		out[v.Type().Method(i).Name] = method.Call(nil)[0].Interface()
+	}
+
+	return out
+}
+
+func TestServiceClientsAreBuiltOnFirstUse(t *testing.T) {
+	client := buildTestClient(t)
+	if client.network != nil || client.resource != nil {
+		t.Fatalf("expected the Service Clients not to be built until they're used")
+	}
+
+	network := client.Network()
+	if network == nil {
+		t.Fatalf("expected the Network Client to be built")
+	}
+	if client.Network() != network {
+		t.Fatalf("expected the Network Client to only be built once")
+	}
+	if client.resource != nil {
+		t.Fatalf("expected the Resource Client not to be built until it's used")
+	}
+}
+
+func TestServiceClientsCanBeBuilt(t *testing.T) {
+	client := buildTestClient(t)
+
+	services := serviceClients(client)
+	if len(services) == 0 {
+		t.Fatalf("expected some Service Clients but didn't get any")
+	}
+
+	for name, service := range services {
+		if reflect.ValueOf(service).IsNil() {
+			t.Fatalf("expected the Service Client for %q to be built", name)
+		}
+	}
+}
+
+func TestServiceClientsConcurrentFirstUse(t *testing.T) {
+	client := buildTestClient(t)
+
+	var wg sync.WaitGroup
+	results := make([]map[string]interface{}, 20)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = serviceClients(client)
+		}(i)
+	}
+	wg.Wait()
+
+	for name, expected := range results[0] {
+		for i, result := range results {
+			if result[name] != expected {
+				t.Fatalf("expected each caller to get the same Service Client for %q but caller %d got a different one", name, i)
+			}
+		}
+	}
+}
+
+// BenchmarkBuild benchmarks configuring the Client, where the Service Clients are built on first use
+func BenchmarkBuild(b *testing.B) {
+	o := testClientOptions()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		client := Client{}
+		if err := client.Build(context.TODO(), o); err != nil {
+			b.Fatalf("building client: %+v", err)
+		}
+	}
+}
+
+// BenchmarkBuildSingleService benchmarks configuring the Client and using a single Service, which is
+// representative of a configuration which only uses Resources from one Service (e.g. Resource Groups)
+func BenchmarkBuildSingleService(b *testing.B) {
+	o := testClientOptions()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		client := Client{}
+		if err := client.Build(context.TODO(), o); err != nil {
+			b.Fatalf("building client: %+v", err)
+		}
+		client.Resource()
+	}
+}
+
+// BenchmarkBuildAllServices benchmarks configuring the Client and building every Service Client, which
+// is equivalent to configuring the Client when each of the Service Clients were built up-front
+func BenchmarkBuildAllServices(b *testing.B) {
+	o := testClientOptions()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		client := Client{}
+		if err := client.Build(context.TODO(), o); err != nil {
+			b.Fatalf("building client: %+v", err)
+		}
+		serviceClients(&client)
+	}
+}
@@ -0,0 +1,708 @@
+package clients
+
+import (
+	advisor "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/advisor/client"
+	analysisServices "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/analysisservices/client"
+	apiManagement "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimanagement/client"
+	appConfiguration "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/appconfiguration/client"
+	applicationInsights "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/applicationinsights/client"
+	attestation "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/attestation/client"
+	authorization "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/authorization/client"
+	automation "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/automation/client"
+	azureStackHCI "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/azurestackhci/client"
+	batch "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/batch/client"
+	blueprints "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/blueprints/client"
+	bot "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/bot/client"
+	cdn "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cdn/client"
+	cognitiveServices "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cognitive/client"
+	compute "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/client"
+	containerServices "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers/client"
+	cosmosdb "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/client"
+	costmanagement "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/costmanagement/client"
+	customproviders "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/customproviders/client"
+	datamigration "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/databasemigration/client"
+	databoxedge "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/databoxedge/client"
+	databricks "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/databricks/client"
+	datafactory "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datafactory/client"
+	datalake "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datalake/client"
+	datashare "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datashare/client"
+	desktopvirtualization "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/desktopvirtualization/client"
+	devspace "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/devspace/client"
+	devtestlabs "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/devtestlabs/client"
+	digitaltwins "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/digitaltwins/client"
+	dns "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/dns/client"
+	eventgrid "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventgrid/client"
+	eventhub "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventhub/client"
+	firewall "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/firewall/client"
+	frontdoor "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/frontdoor/client"
+	hdinsight "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/hdinsight/client"
+	healthcare "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/healthcare/client"
+	hpccache "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/hpccache/client"
+	hsm "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/hsm/client"
+	iotcentral "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/iotcentral/client"
+	iothub "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/iothub/client"
+	timeseriesinsights "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/iottimeseriesinsights/client"
+	keyvault "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/client"
+	kusto "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/kusto/client"
+	lighthouse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/lighthouse/client"
+	loadbalancers "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loadbalancer/client"
+	loganalytics "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/client"
+	logic "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/logic/client"
+	machinelearning "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/machinelearning/client"
+	maintenance "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/maintenance/client"
+	managedapplication "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managedapplications/client"
+	managementgroup "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managementgroup/client"
+	maps "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/maps/client"
+	mariadb "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mariadb/client"
+	media "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/media/client"
+	mixedreality "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mixedreality/client"
+	monitor "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/client"
+	msi "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/client"
+	mssql "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/client"
+	mysql "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mysql/client"
+	netapp "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/netapp/client"
+	network "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/client"
+	notificationhub "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/notificationhub/client"
+	policy "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy/client"
+	portal "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/portal/client"
+	postgres "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/postgres/client"
+	powerBI "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/powerbi/client"
+	privatedns "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/privatedns/client"
+	recoveryServices "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/recoveryservices/client"
+	redis "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/redis/client"
+	redisenterprise "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/redisenterprise/client"
+	relay "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/relay/client"
+	resource "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/client"
+	search "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/search/client"
+	securityCenter "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/securitycenter/client"
+	sentinel "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/client"
+	serviceBus "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/client"
+	serviceFabric "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicefabric/client"
+	serviceFabricMesh "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicefabricmesh/client"
+	signalr "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/signalr/client"
+	appPlatform "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/springcloud/client"
+	sql "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sql/client"
+	storage "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/client"
+	streamAnalytics "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/streamanalytics/client"
+	subscription "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/subscription/client"
+	synapse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/synapse/client"
+	trafficManager "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/trafficmanager/client"
+	vmware "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/vmware/client"
+	web "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/client"
+)
+
+func (client *Client) Advisor() *advisor.Client {
+	client.advisorOnce.Do(func() {
+		client.advisor = advisor.NewClient(client.options)
+	})
+	return client.advisor
+}
+
+func (client *Client) AnalysisServices() *analysisServices.Client {
+	client.analysisServicesOnce.Do(func() {
+		client.analysisServices = analysisServices.NewClient(client.options)
+	})
+	return client.analysisServices
+}
+
+func (client *Client) ApiManagement() *apiManagement.Client {
+	client.apiManagementOnce.Do(func() {
+		client.apiManagement = apiManagement.NewClient(client.options)
+	})
+	return client.apiManagement
+}
+
+func (client *Client) AppConfiguration() *appConfiguration.Client {
+	client.appConfigurationOnce.Do(func() {
+		client.appConfiguration = appConfiguration.NewClient(client.options)
+	})
+	return client.appConfiguration
+}
+
+func (client *Client) AppInsights() *applicationInsights.Client {
+	client.appInsightsOnce.Do(func() {
+		client.appInsights = applicationInsights.NewClient(client.options)
+	})
+	return client.appInsights
+}
+
+func (client *Client) AppPlatform() *appPlatform.Client {
+	client.appPlatformOnce.Do(func() {
+		client.appPlatform = appPlatform.NewClient(client.options)
+	})
+	return client.appPlatform
+}
+
+func (client *Client) Attestation() *attestation.Client {
+	client.attestationOnce.Do(func() {
+		client.attestation = attestation.NewClient(client.options)
+	})
+	return client.attestation
+}
+
+func (client *Client) Authorization() *authorization.Client {
+	client.authorizationOnce.Do(func() {
+		client.authorization = authorization.NewClient(client.options)
+	})
+	return client.authorization
+}
+
+func (client *Client) Automation() *automation.Client {
+	client.automationOnce.Do(func() {
+		client.automation = automation.NewClient(client.options)
+	})
+	return client.automation
+}
+
+func (client *Client) AzureStackHCI() *azureStackHCI.Client {
+	client.azureStackHCIOnce.Do(func() {
+		client.azureStackHCI = azureStackHCI.NewClient(client.options)
+	})
+	return client.azureStackHCI
+}
+
+func (client *Client) Batch() *batch.Client {
+	client.batchOnce.Do(func() {
+		client.batch = batch.NewClient(client.options)
+	})
+	return client.batch
+}
+
+func (client *Client) Blueprints() *blueprints.Client {
+	client.blueprintsOnce.Do(func() {
+		client.blueprints = blueprints.NewClient(client.options)
+	})
+	return client.blueprints
+}
+
+func (client *Client) Bot() *bot.Client {
+	client.botOnce.Do(func() {
+		client.bot = bot.NewClient(client.options)
+	})
+	return client.bot
+}
+
+func (client *Client) Cdn() *cdn.Client {
+	client.cdnOnce.Do(func() {
+		client.cdn = cdn.NewClient(client.options)
+	})
+	return client.cdn
+}
+
+func (client *Client) Cognitive() *cognitiveServices.Client {
+	client.cognitiveOnce.Do(func() {
+		client.cognitive = cognitiveServices.NewClient(client.options)
+	})
+	return client.cognitive
+}
+
+func (client *Client) Compute() *compute.Client {
+	client.computeOnce.Do(func() {
+		client.compute = compute.NewClient(client.options)
+	})
+	return client.compute
+}
+
+func (client *Client) Containers() *containerServices.Client {
+	client.containersOnce.Do(func() {
+		client.containers = containerServices.NewClient(client.options)
+	})
+	return client.containers
+}
+
+func (client *Client) Cosmos() *cosmosdb.Client {
+	client.cosmosOnce.Do(func() {
+		client.cosmos = cosmosdb.NewClient(client.options)
+	})
+	return client.cosmos
+}
+
+func (client *Client) CostManagement() *costmanagement.Client {
+	client.costManagementOnce.Do(func() {
+		client.costManagement = costmanagement.NewClient(client.options)
+	})
+	return client.costManagement
+}
+
+func (client *Client) CustomProviders() *customproviders.Client {
+	client.customProvidersOnce.Do(func() {
+		client.customProviders = customproviders.NewClient(client.options)
+	})
+	return client.customProviders
+}
+
+func (client *Client) DatabaseMigration() *datamigration.Client {
+	client.databaseMigrationOnce.Do(func() {
+		client.databaseMigration = datamigration.NewClient(client.options)
+	})
+	return client.databaseMigration
+}
+
+func (client *Client) DataBricks() *databricks.Client {
+	client.dataBricksOnce.Do(func() {
+		client.dataBricks = databricks.NewClient(client.options)
+	})
+	return client.dataBricks
+}
+
+func (client *Client) DataboxEdge() *databoxedge.Client {
+	client.databoxEdgeOnce.Do(func() {
+		client.databoxEdge = databoxedge.NewClient(client.options)
+	})
+	return client.databoxEdge
+}
+
+func (client *Client) DataFactory() *datafactory.Client {
+	client.dataFactoryOnce.Do(func() {
+		client.dataFactory = datafactory.NewClient(client.options)
+	})
+	return client.dataFactory
+}
+
+func (client *Client) Datalake() *datalake.Client {
+	client.datalakeOnce.Do(func() {
+		client.datalake = datalake.NewClient(client.options)
+	})
+	return client.datalake
+}
+
+func (client *Client) DataShare() *datashare.Client {
+	client.dataShareOnce.Do(func() {
+		client.dataShare = datashare.NewClient(client.options)
+	})
+	return client.dataShare
+}
+
+func (client *Client) DesktopVirtualization() *desktopvirtualization.Client {
+	client.desktopVirtualizationOnce.Do(func() {
+		client.desktopVirtualization = desktopvirtualization.NewClient(client.options)
+	})
+	return client.desktopVirtualization
+}
+
+func (client *Client) DevSpace() *devspace.Client {
+	client.devSpaceOnce.Do(func() {
+		client.devSpace = devspace.NewClient(client.options)
+	})
+	return client.devSpace
+}
+
+func (client *Client) DevTestLabs() *devtestlabs.Client {
+	client.devTestLabsOnce.Do(func() {
+		client.devTestLabs = devtestlabs.NewClient(client.options)
+	})
+	return client.devTestLabs
+}
+
+func (client *Client) DigitalTwins() *digitaltwins.Client {
+	client.digitalTwinsOnce.Do(func() {
+		client.digitalTwins = digitaltwins.NewClient(client.options)
+	})
+	return client.digitalTwins
+}
+
+func (client *Client) Dns() *dns.Client {
+	client.dnsOnce.Do(func() {
+		client.dns = dns.NewClient(client.options)
+	})
+	return client.dns
+}
+
+func (client *Client) EventGrid() *eventgrid.Client {
+	client.eventGridOnce.Do(func() {
+		client.eventGrid = eventgrid.NewClient(client.options)
+	})
+	return client.eventGrid
+}
+
+func (client *Client) Eventhub() *eventhub.Client {
+	client.eventhubOnce.Do(func() {
+		client.eventhub = eventhub.NewClient(client.options)
+	})
+	return client.eventhub
+}
+
+func (client *Client) Firewall() *firewall.Client {
+	client.firewallOnce.Do(func() {
+		client.firewall = firewall.NewClient(client.options)
+	})
+	return client.firewall
+}
+
+func (client *Client) Frontdoor() *frontdoor.Client {
+	client.frontdoorOnce.Do(func() {
+		client.frontdoor = frontdoor.NewClient(client.options)
+	})
+	return client.frontdoor
+}
+
+func (client *Client) HPCCache() *hpccache.Client {
+	client.hpcCacheOnce.Do(func() {
+		client.hpcCache = hpccache.NewClient(client.options)
+	})
+	return client.hpcCache
+}
+
+func (client *Client) HSM() *hsm.Client {
+	client.hsmOnce.Do(func() {
+		client.hsm = hsm.NewClient(client.options)
+	})
+	return client.hsm
+}
+
+func (client *Client) HDInsight() *hdinsight.Client {
+	client.hdInsightOnce.Do(func() {
+		client.hdInsight = hdinsight.NewClient(client.options)
+	})
+	return client.hdInsight
+}
+
+func (client *Client) HealthCare() *healthcare.Client {
+	client.healthCareOnce.Do(func() {
+		client.healthCare = healthcare.NewClient(client.options)
+	})
+	return client.healthCare
+}
+
+func (client *Client) IoTCentral() *iotcentral.Client {
+	client.ioTCentralOnce.Do(func() {
+		client.ioTCentral = iotcentral.NewClient(client.options)
+	})
+	return client.ioTCentral
+}
+
+func (client *Client) IoTHub() *iothub.Client {
+	client.ioTHubOnce.Do(func() {
+		client.ioTHub = iothub.NewClient(client.options)
+	})
+	return client.ioTHub
+}
+
+func (client *Client) IoTTimeSeriesInsights() *timeseriesinsights.Client {
+	client.ioTTimeSeriesInsightsOnce.Do(func() {
+		client.ioTTimeSeriesInsights = timeseriesinsights.NewClient(client.options)
+	})
+	return client.ioTTimeSeriesInsights
+}
+
+func (client *Client) KeyVault() *keyvault.Client {
+	client.keyVaultOnce.Do(func() {
+		client.keyVault = keyvault.NewClient(client.options)
+	})
+	return client.keyVault
+}
+
+func (client *Client) Kusto() *kusto.Client {
+	client.kustoOnce.Do(func() {
+		client.kusto = kusto.NewClient(client.options)
+	})
+	return client.kusto
+}
+
+func (client *Client) Lighthouse() *lighthouse.Client {
+	client.lighthouseOnce.Do(func() {
+		client.lighthouse = lighthouse.NewClient(client.options)
+	})
+	return client.lighthouse
+}
+
+func (client *Client) LoadBalancers() *loadbalancers.Client {
+	client.loadBalancersOnce.Do(func() {
+		client.loadBalancers = loadbalancers.NewClient(client.options)
+	})
+	return client.loadBalancers
+}
+
+func (client *Client) LogAnalytics() *loganalytics.Client {
+	client.logAnalyticsOnce.Do(func() {
+		client.logAnalytics = loganalytics.NewClient(client.options)
+	})
+	return client.logAnalytics
+}
+
+func (client *Client) Logic() *logic.Client {
+	client.logicOnce.Do(func() {
+		client.logic = logic.NewClient(client.options)
+	})
+	return client.logic
+}
+
+func (client *Client) MachineLearning() *machinelearning.Client {
+	client.machineLearningOnce.Do(func() {
+		client.machineLearning = machinelearning.NewClient(client.options)
+	})
+	return client.machineLearning
+}
+
+func (client *Client) Maintenance() *maintenance.Client {
+	client.maintenanceOnce.Do(func() {
+		client.maintenance = maintenance.NewClient(client.options)
+	})
+	return client.maintenance
+}
+
+func (client *Client) ManagedApplication() *managedapplication.Client {
+	client.managedApplicationOnce.Do(func() {
+		client.managedApplication = managedapplication.NewClient(client.options)
+	})
+	return client.managedApplication
+}
+
+func (client *Client) ManagementGroups() *managementgroup.Client {
+	client.managementGroupsOnce.Do(func() {
+		client.managementGroups = managementgroup.NewClient(client.options)
+	})
+	return client.managementGroups
+}
+
+func (client *Client) Maps() *maps.Client {
+	client.mapsOnce.Do(func() {
+		client.maps = maps.NewClient(client.options)
+	})
+	return client.maps
+}
+
+func (client *Client) MariaDB() *mariadb.Client {
+	client.mariaDBOnce.Do(func() {
+		client.mariaDB = mariadb.NewClient(client.options)
+	})
+	return client.mariaDB
+}
+
+func (client *Client) Media() *media.Client {
+	client.mediaOnce.Do(func() {
+		client.media = media.NewClient(client.options)
+	})
+	return client.media
+}
+
+func (client *Client) MixedReality() *mixedreality.Client {
+	client.mixedRealityOnce.Do(func() {
+		client.mixedReality = mixedreality.NewClient(client.options)
+	})
+	return client.mixedReality
+}
+
+func (client *Client) Monitor() *monitor.Client {
+	client.monitorOnce.Do(func() {
+		client.monitor = monitor.NewClient(client.options)
+	})
+	return client.monitor
+}
+
+func (client *Client) MSI() *msi.Client {
+	client.msiOnce.Do(func() {
+		client.msi = msi.NewClient(client.options)
+	})
+	return client.msi
+}
+
+func (client *Client) MSSQL() *mssql.Client {
+	client.mssqlOnce.Do(func() {
+		client.mssql = mssql.NewClient(client.options)
+	})
+	return client.mssql
+}
+
+func (client *Client) MySQL() *mysql.Client {
+	client.mySQLOnce.Do(func() {
+		client.mySQL = mysql.NewClient(client.options)
+	})
+	return client.mySQL
+}
+
+func (client *Client) NetApp() *netapp.Client {
+	client.netAppOnce.Do(func() {
+		client.netApp = netapp.NewClient(client.options)
+	})
+	return client.netApp
+}
+
+func (client *Client) Network() *network.Client {
+	client.networkOnce.Do(func() {
+		client.network = network.NewClient(client.options)
+	})
+	return client.network
+}
+
+func (client *Client) NotificationHubs() *notificationhub.Client {
+	client.notificationHubsOnce.Do(func() {
+		client.notificationHubs = notificationhub.NewClient(client.options)
+	})
+	return client.notificationHubs
+}
+
+func (client *Client) Policy() *policy.Client {
+	client.policyOnce.Do(func() {
+		client.policy = policy.NewClient(client.options)
+	})
+	return client.policy
+}
+
+func (client *Client) Portal() *portal.Client {
+	client.portalOnce.Do(func() {
+		client.portal = portal.NewClient(client.options)
+	})
+	return client.portal
+}
+
+func (client *Client) Postgres() *postgres.Client {
+	client.postgresOnce.Do(func() {
+		client.postgres = postgres.NewClient(client.options)
+	})
+	return client.postgres
+}
+
+func (client *Client) PowerBI() *powerBI.Client {
+	client.powerBIOnce.Do(func() {
+		client.powerBI = powerBI.NewClient(client.options)
+	})
+	return client.powerBI
+}
+
+func (client *Client) PrivateDns() *privatedns.Client {
+	client.privateDnsOnce.Do(func() {
+		client.privateDns = privatedns.NewClient(client.options)
+	})
+	return client.privateDns
+}
+
+func (client *Client) RecoveryServices() *recoveryServices.Client {
+	client.recoveryServicesOnce.Do(func() {
+		client.recoveryServices = recoveryServices.NewClient(client.options)
+	})
+	return client.recoveryServices
+}
+
+func (client *Client) Redis() *redis.Client {
+	client.redisOnce.Do(func() {
+		client.redis = redis.NewClient(client.options)
+	})
+	return client.redis
+}
+
+func (client *Client) RedisEnterprise() *redisenterprise.Client {
+	client.redisEnterpriseOnce.Do(func() {
+		client.redisEnterprise = redisenterprise.NewClient(client.options)
+	})
+	return client.redisEnterprise
+}
+
+func (client *Client) Relay() *relay.Client {
+	client.relayOnce.Do(func() {
+		client.relay = relay.NewClient(client.options)
+	})
+	return client.relay
+}
+
+func (client *Client) Resource() *resource.Client {
+	client.resourceOnce.Do(func() {
+		client.resource = resource.NewClient(client.options)
+	})
+	return client.resource
+}
+
+func (client *Client) Search() *search.Client {
+	client.searchOnce.Do(func() {
+		client.search = search.NewClient(client.options)
+	})
+	return client.search
+}
+
+func (client *Client) SecurityCenter() *securityCenter.Client {
+	client.securityCenterOnce.Do(func() {
+		client.securityCenter = securityCenter.NewClient(client.options)
+	})
+	return client.securityCenter
+}
+
+func (client *Client) Sentinel() *sentinel.Client {
+	client.sentinelOnce.Do(func() {
+		client.sentinel = sentinel.NewClient(client.options)
+	})
+	return client.sentinel
+}
+
+func (client *Client) ServiceBus() *serviceBus.Client {
+	client.serviceBusOnce.Do(func() {
+		client.serviceBus = serviceBus.NewClient(client.options)
+	})
+	return client.serviceBus
+}
+
+func (client *Client) ServiceFabric() *serviceFabric.Client {
+	client.serviceFabricOnce.Do(func() {
+		client.serviceFabric = serviceFabric.NewClient(client.options)
+	})
+	return client.serviceFabric
+}
+
+func (client *Client) ServiceFabricMesh() *serviceFabricMesh.Client {
+	client.serviceFabricMeshOnce.Do(func() {
+		client.serviceFabricMesh = serviceFabricMesh.NewClient(client.options)
+	})
+	return client.serviceFabricMesh
+}
+
+func (client *Client) SignalR() *signalr.Client {
+	client.signalROnce.Do(func() {
+		client.signalR = signalr.NewClient(client.options)
+	})
+	return client.signalR
+}
+
+func (client *Client) Storage() *storage.Client {
+	client.storageOnce.Do(func() {
+		client.storage = storage.NewClient(client.options)
+	})
+	return client.storage
+}
+
+func (client *Client) StreamAnalytics() *streamAnalytics.Client {
+	client.streamAnalyticsOnce.Do(func() {
+		client.streamAnalytics = streamAnalytics.NewClient(client.options)
+	})
+	return client.streamAnalytics
+}
+
+func (client *Client) Subscription() *subscription.Client {
+	client.subscriptionOnce.Do(func() {
+		client.subscription = subscription.NewClient(client.options)
+	})
+	return client.subscription
+}
+
+func (client *Client) Sql() *sql.Client {
+	client.sqlOnce.Do(func() {
+		client.sql = sql.NewClient(client.options)
+	})
+	return client.sql
+}
+
+func (client *Client) Synapse() *synapse.Client {
+	client.synapseOnce.Do(func() {
+		client.synapse = synapse.NewClient(client.options)
+	})
+	return client.synapse
+}
+
+func (client *Client) TrafficManager() *trafficManager.Client {
+	client.trafficManagerOnce.Do(func() {
+		client.trafficManager = trafficManager.NewClient(client.options)
+	})
+	return client.trafficManager
+}
+
+func (client *Client) Vmware() *vmware.Client {
+	client.vmwareOnce.Do(func() {
+		client.vmware = vmware.NewClient(client.options)
+	})
+	return client.vmware
+}
+
+func (client *Client) Web() *web.Client {
+	client.webOnce.Do(func() {
+		client.web = web.NewClient(client.options)
+	})
+	return client.web
+}
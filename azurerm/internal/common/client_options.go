@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/terraform-plugin-sdk/meta"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/version"
 )
 
@@ -30,19 +31,33 @@ type ClientOptions struct {
 	SkipProviderReg             bool
 	DisableCorrelationRequestID bool
 	DisableTerraformPartnerID   bool
+	Endpoints                   EndpointOptions
 	Environment                 azure.Environment
 	Features                    features.UserFeatures
+	RateLimiter                 *RateLimiter
+	Recording                   recording.Transport
+	Retry                       RetryOptions
 	StorageUseAzureAD           bool
+	WireTracer                  *WireTracer
 }
 
 func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.Authorizer) {
 	setUserAgent(c, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	// each attempt made when retrying a request is subject to rate limiting, and is recorded (or replayed) and traced individually
+	c.Sender = autorest.DecorateSender(sender.BuildSender("AzureRM"), WithWireTracing(o.WireTracer), recording.WithRecording(o.Recording), WithRateLimiting(o.RateLimiter), WithRetries(o.Retry))
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
+
+	// requests are retried by WithRetries as configured in the `retry` block - so the SendDecorator used by the
+	// Azure SDK (which also retries requests) is replaced by one which only registers Resource Providers
+	c.SendDecorators = []autorest.SendDecorator{withResourceProviderRegistration(o.SkipProviderReg)}
+
+	// however some clients (e.g. for the Storage data plane) specify the SendDecorator when sending each request,
+	// so the retries performed by it are limited to the fewest possible
+	c.RetryAttempts = 1
 	if !o.DisableCorrelationRequestID {
-		c.RequestInspector = withCorrelationRequestID(correlationRequestID())
+		c.RequestInspector = withCorrelationRequestID(CorrelationRequestID())
 	}
 }
 
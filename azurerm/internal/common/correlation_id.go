@@ -24,8 +24,8 @@ func withCorrelationRequestID(uuid string) autorest.PrepareDecorator {
 	return autorest.WithHeader(HeaderCorrelationRequestID, uuid)
 }
 
-// correlationRequestID generates an UUID to pass through `x-ms-correlation-request-id` header.
-func correlationRequestID() string {
+// CorrelationRequestID generates an UUID to pass through `x-ms-correlation-request-id` header.
+func CorrelationRequestID() string {
 	msCorrelationRequestIDOnce.Do(func() {
 		var err error
 		msCorrelationRequestID, err = uuid.GenerateUUID()
@@ -8,20 +8,20 @@ import (
 )
 
 func TestCorrelationRequestID(t *testing.T) {
-	first := correlationRequestID()
+	first := CorrelationRequestID()
 
 	if first == "" {
 		t.Fatal("no correlation request ID generated")
 	}
 
-	second := correlationRequestID()
+	second := CorrelationRequestID()
 	if first != second {
 		t.Fatal("subsequent correlation request ID not the same as the first")
 	}
 }
 
 func TestWithCorrelationRequestID(t *testing.T) {
-	uuid := correlationRequestID()
+	uuid := CorrelationRequestID()
 	req, _ := autorest.Prepare(&http.Request{}, withCorrelationRequestID(uuid))
 
 	if req.Header.Get(HeaderCorrelationRequestID) != uuid {
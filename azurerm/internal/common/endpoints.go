@@ -0,0 +1,129 @@
+package common
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// EndpointOptions overrides the endpoints used for individual services, which are otherwise obtained from the
+// Azure Environment - for example to use a Private Link DNS name, an API Gateway or a local stand-in for a service
+type EndpointOptions struct {
+	// ResourceManager is the Resource Manager Endpoint, e.g. `https://management.azure.com/`
+	ResourceManager string
+
+	// Graph is the Microsoft Graph (Azure Active Directory) Endpoint, e.g. `https://graph.windows.net/`
+	Graph string
+
+	// KeyVaultDNSSuffix is the DNS Suffix used for the Key Vault Data Plane API, e.g. `vault.azure.net`
+	KeyVaultDNSSuffix string
+
+	// StorageDNSSuffix is the DNS Suffix used for the Storage Data Plane API's, e.g. `core.windows.net`
+	StorageDNSSuffix string
+
+	// SynapseDNSSuffix is the DNS Suffix used for the Synapse Data Plane API's, e.g. `dev.azuresynapse.net`
+	SynapseDNSSuffix string
+}
+
+// Validate returns an error if any of the overridden endpoints are invalid
+func (o EndpointOptions) Validate() error {
+	endpoints := map[string]string{
+		"resource_manager": o.ResourceManager,
+		"graph":            o.Graph,
+	}
+	for name, value := range endpoints {
+		if value == "" {
+			continue
+		}
+
+		if err := validateEndpoint(value); err != nil {
+			return fmt.Errorf("the `%s` endpoint %q is invalid: %+v", name, value, err)
+		}
+	}
+
+	suffixes := map[string]string{
+		"key_vault_dns_suffix": o.KeyVaultDNSSuffix,
+		"storage_dns_suffix":   o.StorageDNSSuffix,
+		"synapse_dns_suffix":   o.SynapseDNSSuffix,
+	}
+	for name, value := range suffixes {
+		if value == "" {
+			continue
+		}
+
+		if err := validateDNSSuffix(value); err != nil {
+			return fmt.Errorf("the `%s` %q is invalid: %+v", name, value, err)
+		}
+	}
+
+	return nil
+}
+
+// Apply overrides the endpoints within the Azure Environment with any which have been specified
+//
+// the audiences used to obtain tokens (e.g. `env.TokenAudience`) aren't changed, since these
+// identify the service rather than the endpoint which is used to access it
+func (o EndpointOptions) Apply(env *azure.Environment) {
+	if o.ResourceManager != "" {
+		env.ResourceManagerEndpoint = withTrailingSlash(o.ResourceManager)
+	}
+	if o.Graph != "" {
+		env.GraphEndpoint = withTrailingSlash(o.Graph)
+	}
+	if o.KeyVaultDNSSuffix != "" {
+		env.KeyVaultDNSSuffix = o.KeyVaultDNSSuffix
+	}
+	if o.StorageDNSSuffix != "" {
+		env.StorageEndpointSuffix = o.StorageDNSSuffix
+	}
+	if o.SynapseDNSSuffix != "" {
+		env.SynapseEndpointSuffix = o.SynapseDNSSuffix
+	}
+}
+
+func validateEndpoint(input string) error {
+	u, err := url.Parse(input)
+	if err != nil {
+		return err
+	}
+
+	// HTTP is supported for a local stand-in for a service, such as a mock
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("expected the scheme to be `https` or `http` but got %q", u.Scheme)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("expected a host to be specified")
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("expected no query string or fragment to be specified")
+	}
+
+	return nil
+}
+
+func validateDNSSuffix(input string) error {
+	if strings.Contains(input, "://") || strings.ContainsAny(input, "/:?# \t") {
+		return fmt.Errorf("expected a DNS Suffix (e.g. `vault.azure.net`) rather than a URI")
+	}
+	if strings.HasPrefix(input, ".") || strings.HasSuffix(input, ".") {
+		return fmt.Errorf("expected the DNS Suffix not to start or end with a `.`")
+	}
+
+	for _, label := range strings.Split(input, ".") {
+		if label == "" {
+			return fmt.Errorf("expected the DNS Suffix not to contain an empty label")
+		}
+	}
+
+	return nil
+}
+
+func withTrailingSlash(input string) string {
+	if strings.HasSuffix(input, "/") {
+		return input
+	}
+
+	return input + "/"
+}
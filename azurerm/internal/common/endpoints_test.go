@@ -0,0 +1,117 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestEndpointOptionsValidate(t *testing.T) {
+	testData := []struct {
+		Name  string
+		Input EndpointOptions
+		Error bool
+	}{
+		{
+			Name:  "Empty",
+			Input: EndpointOptions{},
+		},
+		{
+			Name: "Complete",
+			Input: EndpointOptions{
+				ResourceManager:   "https://gateway.example.com/arm",
+				Graph:             "https://graph.example.com/",
+				KeyVaultDNSSuffix: "privatelink.vaultcore.azure.net",
+				StorageDNSSuffix:  "privatelink.core.windows.net",
+				SynapseDNSSuffix:  "dev.azuresynapse.net",
+			},
+		},
+		{
+			Name: "Local Resource Manager",
+			Input: EndpointOptions{
+				ResourceManager: "http://127.0.0.1:8080",
+			},
+		},
+		{
+			Name: "Resource Manager without a Scheme",
+			Input: EndpointOptions{
+				ResourceManager: "management.azure.com",
+			},
+			Error: true,
+		},
+		{
+			Name: "Graph with a Query String",
+			Input: EndpointOptions{
+				Graph: "https://graph.example.com/?api-version=1.6",
+			},
+			Error: true,
+		},
+		{
+			Name: "Key Vault DNS Suffix as a URI",
+			Input: EndpointOptions{
+				KeyVaultDNSSuffix: "https://vault.azure.net",
+			},
+			Error: true,
+		},
+		{
+			Name: "Storage DNS Suffix with a leading dot",
+			Input: EndpointOptions{
+				StorageDNSSuffix: ".core.windows.net",
+			},
+			Error: true,
+		},
+		{
+			Name: "Synapse DNS Suffix with an empty label",
+			Input: EndpointOptions{
+				SynapseDNSSuffix: "dev..azuresynapse.net",
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := v.Input.Validate()
+		if v.Error && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestEndpointOptionsApply(t *testing.T) {
+	env := azure.PublicCloud
+	EndpointOptions{}.Apply(&env)
+	if env.ResourceManagerEndpoint != azure.PublicCloud.ResourceManagerEndpoint || env.KeyVaultDNSSuffix != azure.PublicCloud.KeyVaultDNSSuffix {
+		t.Fatalf("expected the Environment to be unchanged when no endpoints are overridden")
+	}
+
+	EndpointOptions{
+		ResourceManager:   "https://gateway.example.com/arm",
+		Graph:             "https://graph.example.com/",
+		KeyVaultDNSSuffix: "privatelink.vaultcore.azure.net",
+		StorageDNSSuffix:  "privatelink.core.windows.net",
+		SynapseDNSSuffix:  "privatelink.dev.azuresynapse.net",
+	}.Apply(&env)
+
+	testData := []struct {
+		Actual   string
+		Expected string
+	}{
+		{Actual: env.ResourceManagerEndpoint, Expected: "https://gateway.example.com/arm/"},
+		{Actual: env.GraphEndpoint, Expected: "https://graph.example.com/"},
+		{Actual: env.KeyVaultDNSSuffix, Expected: "privatelink.vaultcore.azure.net"},
+		{Actual: env.StorageEndpointSuffix, Expected: "privatelink.core.windows.net"},
+		{Actual: env.SynapseEndpointSuffix, Expected: "privatelink.dev.azuresynapse.net"},
+		// the audience of the tokens is unchanged
+		{Actual: env.TokenAudience, Expected: azure.PublicCloud.TokenAudience},
+	}
+	for _, v := range testData {
+		if v.Actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, v.Actual)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// RateLimitOptions configures the client-side rate limiting of requests to Azure Resource Manager
+//
+// Requests are limited using a token bucket for both reads and writes, per Subscription (or per Tenant
+// for requests which aren't scoped to a Subscription) - mirroring how these are throttled by ARM
+type RateLimitOptions struct {
+	// Enabled specifies whether requests should be rate limited
+	Enabled bool
+
+	// ReadsPerSecond is the rate at which the budget for read (GET/HEAD) requests is replenished
+	ReadsPerSecond float64
+
+	// ReadBurst is the maximum number of read requests which can be sent without being delayed
+	ReadBurst int
+
+	// WritesPerSecond is the rate at which the budget for write (PUT/PATCH/POST/DELETE) requests is replenished
+	WritesPerSecond float64
+
+	// WriteBurst is the maximum number of write requests which can be sent without being delayed
+	WriteBurst int
+}
+
+// DefaultRateLimitOptions returns the RateLimitOptions used when these aren't specified in the Provider block
+func DefaultRateLimitOptions() RateLimitOptions {
+	return RateLimitOptions{
+		Enabled:         false,
+		ReadsPerSecond:  25,
+		ReadBurst:       250,
+		WritesPerSecond: 10,
+		WriteBurst:      200,
+	}
+}
+
+// RateLimiter limits the rate of requests sent to Azure Resource Manager, and is shared between each of the clients
+type RateLimiter struct {
+	options                 RateLimitOptions
+	resourceManagerHostname string
+	tenantId                string
+
+	lock    sync.Mutex
+	buckets map[string]*tokenBucket
+
+	// now is overridden in tests
+	now func() time.Time
+}
+
+// NewRateLimiter returns a RateLimiter for requests sent to the specified Resource Manager Endpoint, or nil when disabled
+func NewRateLimiter(o RateLimitOptions, resourceManagerEndpoint, tenantId string) (*RateLimiter, error) {
+	if !o.Enabled {
+		return nil, nil
+	}
+
+	if o.ReadsPerSecond <= 0 || o.WritesPerSecond <= 0 {
+		return nil, fmt.Errorf("the number of reads and writes per second must be greater than 0")
+	}
+	if o.ReadBurst < 1 || o.WriteBurst < 1 {
+		return nil, fmt.Errorf("the read and write burst must be at least 1")
+	}
+
+	endpoint, err := url.Parse(resourceManagerEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the Resource Manager Endpoint %q: %+v", resourceManagerEndpoint, err)
+	}
+
+	return &RateLimiter{
+		options:                 o,
+		resourceManagerHostname: strings.ToLower(endpoint.Hostname()),
+		tenantId:                tenantId,
+		buckets:                 make(map[string]*tokenBucket),
+		now:                     time.Now,
+	}, nil
+}
+
+// WithRateLimiting returns a SendDecorator which delays requests to Azure Resource Manager until they're
+// within the budget of the RateLimiter - when the RateLimiter is nil requests are sent as-is
+func WithRateLimiting(l *RateLimiter) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		if l == nil {
+			return s
+		}
+
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if !strings.EqualFold(r.URL.Hostname(), l.resourceManagerHostname) {
+				return s.Do(r)
+			}
+
+			scope, isSubscription := l.scope(r.URL)
+			operation := "writes"
+			if r.Method == http.MethodGet || r.Method == http.MethodHead {
+				operation = "reads"
+			}
+
+			bucket := l.bucket(scope, operation)
+			if err := l.wait(r.Context(), bucket, fmt.Sprintf("%s for %s", operation, scope)); err != nil {
+				return nil, err
+			}
+
+			resp, err := s.Do(r)
+			if resp != nil {
+				// ARM returns the remaining budget for the Subscription/Tenant, which accounts for requests
+				// made from elsewhere (e.g. other Terraform runs) - so the local budget is reduced to match
+				header := fmt.Sprintf("x-ms-ratelimit-remaining-tenant-%s", operation)
+				if isSubscription {
+					header = fmt.Sprintf("x-ms-ratelimit-remaining-subscription-%s", operation)
+				}
+				if remaining, parseErr := strconv.Atoi(resp.Header.Get(header)); parseErr == nil {
+					bucket.observe(l.now(), remaining)
+				}
+			}
+
+			return resp, err
+		})
+	}
+}
+
+// scope returns the key for the Subscription which this request is scoped to - or for the Tenant when
+// the request isn't scoped to a Subscription - along with whether this is a Subscription
+func (l *RateLimiter) scope(u *url.URL) (string, bool) {
+	segments := strings.Split(strings.TrimPrefix(u.Path, "/"), "/")
+	if len(segments) >= 2 && strings.EqualFold(segments[0], "subscriptions") && segments[1] != "" {
+		return fmt.Sprintf("subscription %q", strings.ToLower(segments[1])), true
+	}
+
+	return fmt.Sprintf("tenant %q", l.tenantId), false
+}
+
+func (l *RateLimiter) bucket(scope, operation string) *tokenBucket {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	key := fmt.Sprintf("%s/%s", scope, operation)
+	if bucket, ok := l.buckets[key]; ok {
+		return bucket
+	}
+
+	bucket := newTokenBucket(l.now(), l.options.ReadBurst, l.options.ReadsPerSecond)
+	if operation == "writes" {
+		bucket = newTokenBucket(l.now(), l.options.WriteBurst, l.options.WritesPerSecond)
+	}
+	l.buckets[key] = bucket
+	return bucket
+}
+
+func (l *RateLimiter) wait(ctx context.Context, bucket *tokenBucket, description string) error {
+	delay := bucket.reserve(l.now())
+	if delay <= 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Rate Limiting: delaying request by %s to remain within the budget of %s", delay, description)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		bucket.release()
+		return ctx.Err()
+	}
+}
+
+// tokenBucket is a token bucket which allows tokens to be reserved in advance, such that the
+// number of tokens can become negative - which is the delay before the next request can be sent
+type tokenBucket struct {
+	lock       sync.Mutex
+	capacity   float64
+	tokens     float64
+	lastRefill time.Time
+
+	// refillPerSecond is the rate at which the bucket is currently replenished, which is reduced from
+	// maxRefillPerSecond (the configured rate) when ARM reports less of the budget remains
+	refillPerSecond    float64
+	maxRefillPerSecond float64
+}
+
+func newTokenBucket(now time.Time, capacity int, refillPerSecond float64) *tokenBucket {
+	return &tokenBucket{
+		capacity:           float64(capacity),
+		tokens:             float64(capacity),
+		lastRefill:         now,
+		refillPerSecond:    refillPerSecond,
+		maxRefillPerSecond: refillPerSecond,
+	}
+}
+
+// reserve takes a token from the bucket, returning how long the caller must wait before using it
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.refill(now)
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.refillPerSecond * float64(time.Second))
+}
+
+// release returns a reserved token which wasn't used to the bucket
+func (b *tokenBucket) release() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.tokens++
+}
+
+// observe adjusts the bucket to the remaining budget reported by ARM, which accounts for requests made from elsewhere
+//
+// The budget is replenished over a window, which is the time taken to replenish the full capacity of the bucket at the
+// configured rate - so the rate at which the bucket is replenished is derived from the budget remaining over that
+// window (capped at the configured rate), and the bucket never contains more tokens than the remaining budget.
+func (b *tokenBucket) observe(now time.Time, remaining int) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.refill(now)
+	if float64(remaining) < b.tokens {
+		b.tokens = float64(remaining)
+	}
+
+	// at least one request can be sent per window, so that requests continue once the budget is exhausted
+	budget := float64(remaining)
+	if budget < 1 {
+		budget = 1
+	}
+	window := b.capacity / b.maxRefillPerSecond
+	b.refillPerSecond = budget / window
+	if b.refillPerSecond > b.maxRefillPerSecond {
+		b.refillPerSecond = b.maxRefillPerSecond
+	}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(b.lastRefill)
+	if elapsed <= 0 {
+		return
+	}
+
+	b.tokens += elapsed.Seconds() * b.refillPerSecond
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	b.lastRefill = now
+}
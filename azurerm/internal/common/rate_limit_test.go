@@ -0,0 +1,305 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func newRateLimitTestServer(headers map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for k, v := range headers {
+			w.Header().Set(k, v)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func newTestRateLimiter(t *testing.T, endpoint string, o RateLimitOptions) *RateLimiter {
+	o.Enabled = true
+	limiter, err := NewRateLimiter(o, endpoint, "00000000-0000-0000-0000-000000000000")
+	if err != nil {
+		t.Fatalf("building Rate Limiter: %+v", err)
+	}
+
+	// the clock only moves when the test advances it, so that no tokens are replenished in the meantime
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time {
+		return now
+	}
+	return limiter
+}
+
+func sendRateLimitTestRequest(ctx context.Context, t *testing.T, limiter *RateLimiter, method, uri string) error {
+	req, err := http.NewRequestWithContext(ctx, method, uri, nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	resp, err := autorest.SendWithSender(http.DefaultClient, req, WithRateLimiting(limiter))
+	if resp != nil {
+		resp.Body.Close()
+	}
+	return err
+}
+
+func remainingTokens(limiter *RateLimiter, scope, operation string) float64 {
+	bucket := limiter.bucket(scope, operation)
+	bucket.lock.Lock()
+	defer bucket.lock.Unlock()
+	return bucket.tokens
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    RateLimitOptions
+		Expected bool
+		Error    bool
+	}{
+		{
+			Name:     "Disabled",
+			Input:    DefaultRateLimitOptions(),
+			Expected: false,
+		},
+		{
+			Name: "Enabled",
+			Input: func() RateLimitOptions {
+				o := DefaultRateLimitOptions()
+				o.Enabled = true
+				return o
+			}(),
+			Expected: true,
+		},
+		{
+			Name: "No Reads",
+			Input: func() RateLimitOptions {
+				o := DefaultRateLimitOptions()
+				o.Enabled = true
+				o.ReadsPerSecond = 0
+				return o
+			}(),
+			Error: true,
+		},
+		{
+			Name: "No Write Burst",
+			Input: func() RateLimitOptions {
+				o := DefaultRateLimitOptions()
+				o.Enabled = true
+				o.WriteBurst = 0
+				return o
+			}(),
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := NewRateLimiter(v.Input, "https://management.azure.com/", "00000000-0000-0000-0000-000000000000")
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("expected an error but didn't get one")
+		}
+
+		if (actual != nil) != v.Expected {
+			t.Fatalf("expected a Rate Limiter to be returned to be %t", v.Expected)
+		}
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	bucket := newTokenBucket(start, 2, 4)
+
+	// the initial burst should be available immediately
+	if delay := bucket.reserve(start); delay != 0 {
+		t.Fatalf("expected no delay for the first request but got %s", delay)
+	}
+	if delay := bucket.reserve(start); delay != 0 {
+		t.Fatalf("expected no delay for the second request but got %s", delay)
+	}
+
+	// once exhausted each request is delayed by the time taken to replenish the tokens reserved ahead of it
+	if delay := bucket.reserve(start); delay != 250*time.Millisecond {
+		t.Fatalf("expected a delay of 250ms for the third request but got %s", delay)
+	}
+	if delay := bucket.reserve(start); delay != 500*time.Millisecond {
+		t.Fatalf("expected a delay of 500ms for the fourth request but got %s", delay)
+	}
+
+	// the bucket shouldn't be replenished beyond its capacity
+	if delay := bucket.reserve(start.Add(time.Hour)); delay != 0 {
+		t.Fatalf("expected no delay after the bucket was replenished but got %s", delay)
+	}
+	if bucket.tokens != 1 {
+		t.Fatalf("expected 1 token to remain but got %f", bucket.tokens)
+	}
+
+	// the remaining budget reported by ARM should only ever reduce the number of tokens - and where this
+	// exceeds the capacity of the bucket, the bucket is replenished at the configured rate
+	bucket.observe(start.Add(time.Hour), 5)
+	if bucket.tokens != 1 {
+		t.Fatalf("expected 1 token to remain but got %f", bucket.tokens)
+	}
+	if bucket.refillPerSecond != 4 {
+		t.Fatalf("expected the bucket to be replenished at 4/s but got %f/s", bucket.refillPerSecond)
+	}
+
+	// otherwise the remaining budget is spread over the window in which the bucket is replenished (500ms)
+	bucket.observe(start.Add(time.Hour), 0)
+	if bucket.tokens != 0 {
+		t.Fatalf("expected no tokens to remain but got %f", bucket.tokens)
+	}
+	if bucket.refillPerSecond != 2 {
+		t.Fatalf("expected the bucket to be replenished at 2/s but got %f/s", bucket.refillPerSecond)
+	}
+	if delay := bucket.reserve(start.Add(time.Hour)); delay != 500*time.Millisecond {
+		t.Fatalf("expected a delay of 500ms once the budget was exhausted but got %s", delay)
+	}
+
+	// and the configured rate is restored once the budget recovers
+	bucket.observe(start.Add(2*time.Hour), 2)
+	if bucket.refillPerSecond != 4 {
+		t.Fatalf("expected the bucket to be replenished at 4/s but got %f/s", bucket.refillPerSecond)
+	}
+}
+
+func TestWithRateLimitingSeparateBudgets(t *testing.T) {
+	server := newRateLimitTestServer(nil)
+	defer server.Close()
+
+	limiter := newTestRateLimiter(t, server.URL, DefaultRateLimitOptions())
+	requests := []struct {
+		Method string
+		Path   string
+	}{
+		{Method: http.MethodGet, Path: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1"},
+		{Method: http.MethodGet, Path: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group2"},
+		{Method: http.MethodPut, Path: "/SUBSCRIPTIONS/11111111-1111-1111-1111-111111111111/resourceGroups/group1"},
+		{Method: http.MethodGet, Path: "/subscriptions/22222222-2222-2222-2222-222222222222/resourceGroups/group1"},
+		{Method: http.MethodGet, Path: "/providers/Microsoft.Management/managementGroups/group1"},
+		{Method: http.MethodDelete, Path: "/providers/Microsoft.Management/managementGroups/group1"},
+	}
+	for _, v := range requests {
+		if err := sendRateLimitTestRequest(context.TODO(), t, limiter, v.Method, server.URL+v.Path); err != nil {
+			t.Fatalf("sending %s request to %q: %+v", v.Method, v.Path, err)
+		}
+	}
+
+	expected := []struct {
+		Scope     string
+		Operation string
+		Tokens    float64
+	}{
+		{Scope: `subscription "11111111-1111-1111-1111-111111111111"`, Operation: "reads", Tokens: 248},
+		{Scope: `subscription "11111111-1111-1111-1111-111111111111"`, Operation: "writes", Tokens: 199},
+		{Scope: `subscription "22222222-2222-2222-2222-222222222222"`, Operation: "reads", Tokens: 249},
+		{Scope: `subscription "22222222-2222-2222-2222-222222222222"`, Operation: "writes", Tokens: 200},
+		{Scope: `tenant "00000000-0000-0000-0000-000000000000"`, Operation: "reads", Tokens: 249},
+		{Scope: `tenant "00000000-0000-0000-0000-000000000000"`, Operation: "writes", Tokens: 199},
+	}
+	for _, v := range expected {
+		t.Logf("[DEBUG] Testing %s for %s..", v.Operation, v.Scope)
+
+		if actual := remainingTokens(limiter, v.Scope, v.Operation); actual != v.Tokens {
+			t.Fatalf("expected %f tokens to remain but got %f", v.Tokens, actual)
+		}
+	}
+}
+
+func TestWithRateLimitingDelaysRequests(t *testing.T) {
+	server := newRateLimitTestServer(nil)
+	defer server.Close()
+
+	o := DefaultRateLimitOptions()
+	o.ReadBurst = 1
+	o.ReadsPerSecond = 5
+	limiter := newTestRateLimiter(t, server.URL, o)
+
+	uri := server.URL + "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1"
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := sendRateLimitTestRequest(context.TODO(), t, limiter, http.MethodGet, uri); err != nil {
+			t.Fatalf("sending request %d: %+v", i+1, err)
+		}
+	}
+
+	// the first request is within the burst, the second is delayed by 200ms and the third by 400ms
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Fatalf("expected the requests to be delayed by at least 400ms but they completed in %s", elapsed)
+	}
+}
+
+func TestWithRateLimitingObservesRemainingBudget(t *testing.T) {
+	server := newRateLimitTestServer(map[string]string{
+		"x-ms-ratelimit-remaining-subscription-reads": "3",
+		"x-ms-ratelimit-remaining-tenant-writes":      "7",
+	})
+	defer server.Close()
+
+	limiter := newTestRateLimiter(t, server.URL, DefaultRateLimitOptions())
+	if err := sendRateLimitTestRequest(context.TODO(), t, limiter, http.MethodGet, server.URL+"/subscriptions/11111111-1111-1111-1111-111111111111"); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if err := sendRateLimitTestRequest(context.TODO(), t, limiter, http.MethodPost, server.URL+"/providers/Microsoft.Resources/calculateTemplateHash"); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+
+	if actual := remainingTokens(limiter, `subscription "11111111-1111-1111-1111-111111111111"`, "reads"); actual != 3 {
+		t.Fatalf("expected 3 read tokens to remain but got %f", actual)
+	}
+	if actual := remainingTokens(limiter, `tenant "00000000-0000-0000-0000-000000000000"`, "writes"); actual != 7 {
+		t.Fatalf("expected 7 write tokens to remain but got %f", actual)
+	}
+}
+
+func TestWithRateLimitingOtherHostsAreNotLimited(t *testing.T) {
+	server := newRateLimitTestServer(nil)
+	defer server.Close()
+
+	limiter := newTestRateLimiter(t, "https://management.azure.com/", DefaultRateLimitOptions())
+	if err := sendRateLimitTestRequest(context.TODO(), t, limiter, http.MethodGet, server.URL+"/subscriptions/11111111-1111-1111-1111-111111111111"); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+
+	if len(limiter.buckets) != 0 {
+		t.Fatalf("expected no budgets to be used but got %d", len(limiter.buckets))
+	}
+}
+
+func TestWithRateLimitingCancelled(t *testing.T) {
+	server := newRateLimitTestServer(nil)
+	defer server.Close()
+
+	o := DefaultRateLimitOptions()
+	o.WriteBurst = 1
+	o.WritesPerSecond = 0.001
+	limiter := newTestRateLimiter(t, server.URL, o)
+
+	uri := server.URL + "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1"
+	if err := sendRateLimitTestRequest(context.TODO(), t, limiter, http.MethodPut, uri); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := sendRateLimitTestRequest(ctx, t, limiter, http.MethodPut, uri); err == nil {
+		t.Fatal("expected an error when the context was cancelled but didn't get one")
+	}
+
+	// the token reserved for the cancelled request should be returned
+	if actual := remainingTokens(limiter, `subscription "11111111-1111-1111-1111-111111111111"`, "writes"); actual != 0 {
+		t.Fatalf("expected no write tokens to remain but got %f", actual)
+	}
+}
@@ -0,0 +1,170 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const resourceProviderRegistrationApiVersion = "2016-09-01"
+
+// resourceProviderRegistrationPollingDelay is the delay between checking whether a Resource Provider has been registered
+var resourceProviderRegistrationPollingDelay = 10 * time.Second
+
+// withResourceProviderRegistration returns a SendDecorator which registers the Resource Provider used by a request
+// when the request fails because it isn't registered, and then sends the request again
+//
+// This replaces the SendDecorator used by the Azure SDK (which also retries requests, which WithRetries is
+// responsible for) - each request made here is sent using the Client, so is retried by WithRetries.
+func withResourceProviderRegistration(skipRegistration bool) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		if skipRegistration {
+			return s
+		}
+
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			if err := rr.Prepare(); err != nil {
+				return nil, err
+			}
+
+			resp, err := s.Do(rr.Request())
+			if err != nil || resp == nil || resp.StatusCode != http.StatusConflict {
+				return resp, err
+			}
+
+			namespace := unregisteredResourceProvider(resp)
+			subscriptionId := subscriptionIdFromPath(r.URL.Path)
+			if namespace == "" || subscriptionId == "" {
+				return resp, err
+			}
+
+			log.Printf("[DEBUG] Registering the Resource Provider %q in Subscription %q..", namespace, subscriptionId)
+			if err := registerResourceProvider(s, r, subscriptionId, namespace); err != nil {
+				return resp, fmt.Errorf("registering the Resource Provider %q in Subscription %q: %+v", namespace, subscriptionId, err)
+			}
+			autorest.DrainResponseBody(resp)
+
+			if err := rr.Prepare(); err != nil {
+				return nil, err
+			}
+
+			return s.Do(rr.Request())
+		})
+	}
+}
+
+// registerResourceProvider registers the Resource Provider `namespace` in the Subscription, and then waits
+// for this to be registered
+func registerResourceProvider(s autorest.Sender, original *http.Request, subscriptionId, namespace string) error {
+	baseUri := url.URL{
+		Scheme: original.URL.Scheme,
+		Host:   original.URL.Host,
+	}
+	pathParameters := map[string]interface{}{
+		"resourceProviderNamespace": autorest.Encode("path", namespace),
+		"subscriptionId":            autorest.Encode("path", subscriptionId),
+	}
+	queryParameters := map[string]interface{}{
+		"api-version": resourceProviderRegistrationApiVersion,
+	}
+
+	var send = func(method autorest.PrepareDecorator, path string) (string, error) {
+		req, err := autorest.CreatePreparer(
+			method,
+			autorest.WithBaseURL(baseUri.String()),
+			autorest.WithPathParameters(path, pathParameters),
+			autorest.WithQueryParameters(queryParameters),
+		).Prepare((&http.Request{}).WithContext(original.Context()))
+		if err != nil {
+			return "", err
+		}
+
+		resp, err := s.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("unexpected status %d", resp.StatusCode)
+		}
+
+		var provider struct {
+			RegistrationState string `json:"registrationState"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&provider); err != nil {
+			return "", fmt.Errorf("parsing the response: %+v", err)
+		}
+
+		return provider.RegistrationState, nil
+	}
+
+	state, err := send(autorest.AsPost(), "/subscriptions/{subscriptionId}/providers/{resourceProviderNamespace}/register")
+	for err == nil && !strings.EqualFold(state, "Registered") {
+		select {
+		case <-time.After(resourceProviderRegistrationPollingDelay):
+		case <-original.Context().Done():
+			return original.Context().Err()
+		}
+
+		state, err = send(autorest.AsGet(), "/subscriptions/{subscriptionId}/providers/{resourceProviderNamespace}")
+	}
+
+	return err
+}
+
+// unregisteredResourceProvider returns the namespace of the Resource Provider from an ARM Error Response
+// with the Error Code `MissingSubscriptionRegistration`, if present
+//
+// the body of the response is read and then restored, so that this remains available to the caller
+func unregisteredResourceProvider(resp *http.Response) string {
+	if resp.Body == nil {
+		return ""
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	var armError struct {
+		Error *struct {
+			Code    string `json:"code"`
+			Details []struct {
+				Target string `json:"target"`
+			} `json:"details"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &armError) != nil || armError.Error == nil {
+		return ""
+	}
+
+	if !strings.EqualFold(armError.Error.Code, "MissingSubscriptionRegistration") || len(armError.Error.Details) == 0 {
+		return ""
+	}
+
+	return armError.Error.Details[0].Target
+}
+
+// subscriptionIdFromPath returns the Subscription ID from the path of a request, if present
+func subscriptionIdFromPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, v := range segments {
+		if strings.EqualFold(v, "subscriptions") && i+1 < len(segments) {
+			return segments[i+1]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,83 @@
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWithResourceProviderRegistration(t *testing.T) {
+	resourceProviderRegistrationPollingDelay = time.Millisecond
+
+	var lock sync.Mutex
+	registered := false
+	requests := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		requests = append(requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/providers/Microsoft.Example/register"):
+			fmt.Fprint(w, `{"registrationState":"Registering"}`)
+
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/providers/Microsoft.Example"):
+			registered = true
+			fmt.Fprint(w, `{"registrationState":"Registered"}`)
+
+		case registered:
+			fmt.Fprint(w, `{}`)
+
+		default:
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"error":{"code":"MissingSubscriptionRegistration","message":"The subscription is not registered to use namespace 'Microsoft.Example'.","details":[{"code":"MissingSubscriptionRegistration","target":"Microsoft.Example"}]}}`)
+		}
+	}))
+	defer server.Close()
+
+	path := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/things/thing1"
+	req, _ := http.NewRequest(http.MethodPut, server.URL+path, strings.NewReader(`{}`))
+	resp, err := autorest.SendWithSender(server.Client(), req, withResourceProviderRegistration(false))
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the Status Code to be %d but got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	expected := []string{
+		"PUT " + path,
+		"POST /subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Example/register",
+		"GET /subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Example",
+		"PUT " + path,
+	}
+	if strings.Join(requests, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("expected the requests:\n%s\n\nbut got:\n%s", strings.Join(expected, "\n"), strings.Join(requests, "\n"))
+	}
+}
+
+func TestWithResourceProviderRegistrationSkipped(t *testing.T) {
+	server, requests := newRetryTestServer(t, testResponse{
+		statusCode: http.StatusConflict,
+		body:       `{"error":{"code":"MissingSubscriptionRegistration","details":[{"target":"Microsoft.Example"}]}}`,
+	})
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Example/things", nil)
+	resp, err := autorest.SendWithSender(server.Client(), req, withResourceProviderRegistration(true))
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected the Status Code to be %d but got %d", http.StatusConflict, resp.StatusCode)
+	}
+	if *requests != 1 {
+		t.Fatalf("expected 1 request but got %d", *requests)
+	}
+}
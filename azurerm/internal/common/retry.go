@@ -0,0 +1,221 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// RetryOptions configures how requests which fail due to throttling or a transient error are retried
+type RetryOptions struct {
+	// MaxAttempts is the maximum number of times a request is sent, including the first attempt
+	// a value of 1 (or less) disables retrying requests
+	MaxAttempts int
+
+	// BaseBackoff is the delay before the first retry, which is doubled for each subsequent retry
+	BaseBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between retries when this is calculated using BaseBackoff
+	MaxBackoff time.Duration
+
+	// RespectRetryAfter specifies whether the delay specified in the `Retry-After` header of a response is used
+	RespectRetryAfter bool
+
+	// RetryableStatusCodes are the HTTP Status Codes which should be retried
+	RetryableStatusCodes []int
+
+	// RetryableErrorCodes are the ARM Error Codes (e.g. `RetryableError`) which should be retried,
+	// regardless of the HTTP Status Code returned alongside them
+	RetryableErrorCodes []string
+}
+
+// DefaultRetryOptions returns the RetryOptions used when these aren't specified in the Provider block
+func DefaultRetryOptions() RetryOptions {
+	return RetryOptions{
+		MaxAttempts:       5,
+		BaseBackoff:       2 * time.Second,
+		MaxBackoff:        60 * time.Second,
+		RespectRetryAfter: true,
+		RetryableStatusCodes: []int{
+			http.StatusRequestTimeout,
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableErrorCodes: []string{
+			"RetryableError",
+			"ServerBusy",
+			"TooManyRequests",
+		},
+	}
+}
+
+// WithRetries returns a SendDecorator which retries requests which fail due to throttling or
+// a transient error, as configured by the RetryOptions
+func WithRetries(o RetryOptions) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		if o.MaxAttempts <= 1 {
+			return s
+		}
+
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			var resp *http.Response
+			var err error
+
+			rr := autorest.NewRetriableRequest(r)
+			for attempt := 1; ; attempt++ {
+				if err = rr.Prepare(); err != nil {
+					return resp, err
+				}
+
+				resp, err = s.Do(rr.Request())
+				if attempt >= o.MaxAttempts || !o.shouldRetry(r, resp, err) {
+					return resp, err
+				}
+
+				delay := o.delay(resp, attempt)
+				log.Printf("[DEBUG] Retrying %s request to %s in %s (attempt %d of %d)", r.Method, r.URL, delay, attempt+1, o.MaxAttempts)
+				autorest.DrainResponseBody(resp)
+
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return resp, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
+func (o RetryOptions) shouldRetry(r *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		// transient failures (e.g. a connection being reset) are retried - however failing to obtain
+		// a token won't succeed on a subsequent attempt, and there's no point retrying a cancelled request
+		//
+		// requests which aren't idempotent may have been processed before the failure, so aren't retried
+		return isIdempotent(r.Method) && !autorest.IsTokenRefreshError(err) && r.Context().Err() == nil
+	}
+
+	if resp == nil || resp.StatusCode < http.StatusBadRequest {
+		return false
+	}
+
+	for _, code := range o.RetryableStatusCodes {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+
+	if len(o.RetryableErrorCodes) == 0 {
+		return false
+	}
+
+	errorCode := armErrorCode(resp)
+	for _, code := range o.RetryableErrorCodes {
+		if strings.EqualFold(errorCode, code) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isIdempotent returns whether sending a request using the HTTP Method more than once has the same effect as
+// sending it once - in particular `POST` requests trigger an action (e.g. regenerating a key) and aren't
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPatch:
+		return false
+	}
+
+	return true
+}
+
+// delay returns the duration to wait before the next attempt, which is either the value of the `Retry-After`
+// header (when present and enabled) or an exponential backoff from BaseBackoff, capped at MaxBackoff
+func (o RetryOptions) delay(resp *http.Response, attempt int) time.Duration {
+	if o.RespectRetryAfter {
+		if v := retryAfter(resp); v > 0 {
+			return v
+		}
+	}
+
+	delay := o.BaseBackoff
+	for i := 1; i < attempt && delay < o.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	if o.MaxBackoff > 0 && delay > o.MaxBackoff {
+		delay = o.MaxBackoff
+	}
+
+	return delay
+}
+
+// retryAfter returns the delay specified by the `x-ms-retry-after-ms` or `Retry-After` headers, if present
+func retryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+
+	if v, err := strconv.Atoi(resp.Header.Get("x-ms-retry-after-ms")); err == nil && v > 0 {
+		return time.Duration(v) * time.Millisecond
+	}
+
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+
+	return 0
+}
+
+// armErrorCode returns the Error Code from the body of an ARM Error Response, if present
+//
+// the body of the response is read and then restored, so that this remains available to the caller
+func armErrorCode(resp *http.Response) string {
+	if resp.Body == nil {
+		return ""
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	// ARM Error Responses are usually nested within an `error` object, but some API's return these at the top level
+	var armError struct {
+		Code  string `json:"code"`
+		Error *struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &armError) != nil {
+		return ""
+	}
+
+	if armError.Error != nil {
+		return armError.Error.Code
+	}
+
+	return armError.Code
+}
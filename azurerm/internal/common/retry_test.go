@@ -0,0 +1,375 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type testResponse struct {
+	statusCode int
+	headers    map[string]string
+	body       string
+}
+
+// newRetryTestServer returns a server which returns each of the responses in turn, repeating the last response
+// once these have been exhausted - along with a counter for the number of requests received
+func newRetryTestServer(t *testing.T, responses ...testResponse) (*httptest.Server, *int32) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := int(atomic.AddInt32(&requests, 1)) - 1
+		if i >= len(responses) {
+			i = len(responses) - 1
+		}
+
+		// the body of the request should be re-sent for each attempt
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Method == http.MethodPut && string(body) != `{"hello":"world"}` {
+			t.Errorf("expected the request body to be sent on attempt %d but got %q", i+1, string(body))
+		}
+
+		response := responses[i]
+		for k, v := range response.headers {
+			w.Header().Set(k, v)
+		}
+		w.WriteHeader(response.statusCode)
+		fmt.Fprint(w, response.body)
+	}))
+
+	return server, &requests
+}
+
+func testRetryOptions() RetryOptions {
+	o := DefaultRetryOptions()
+	o.BaseBackoff = time.Millisecond
+	o.MaxBackoff = 5 * time.Millisecond
+	return o
+}
+
+func sendRetryTestRequest(t *testing.T, o RetryOptions, server *httptest.Server) *http.Response {
+	req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"hello":"world"}`))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	resp, err := autorest.SendWithSender(server.Client(), req, WithRetries(o))
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+
+	return resp
+}
+
+func TestWithRetries(t *testing.T) {
+	testData := []struct {
+		Name               string
+		Responses          []testResponse
+		ExpectedRequests   int32
+		ExpectedStatusCode int
+	}{
+		{
+			Name: "Success",
+			Responses: []testResponse{
+				{statusCode: http.StatusOK},
+			},
+			ExpectedRequests:   1,
+			ExpectedStatusCode: http.StatusOK,
+		},
+		{
+			Name: "Throttled then Success",
+			Responses: []testResponse{
+				{statusCode: http.StatusTooManyRequests},
+				{statusCode: http.StatusTooManyRequests},
+				{statusCode: http.StatusOK},
+			},
+			ExpectedRequests:   3,
+			ExpectedStatusCode: http.StatusOK,
+		},
+		{
+			Name: "Transient Server Error then Success",
+			Responses: []testResponse{
+				{statusCode: http.StatusServiceUnavailable},
+				{statusCode: http.StatusOK},
+			},
+			ExpectedRequests:   2,
+			ExpectedStatusCode: http.StatusOK,
+		},
+		{
+			Name: "Retryable ARM Error Code then Success",
+			Responses: []testResponse{
+				{statusCode: http.StatusConflict, body: `{"error":{"code":"RetryableError","message":"A retryable error occurred."}}`},
+				{statusCode: http.StatusOK},
+			},
+			ExpectedRequests:   2,
+			ExpectedStatusCode: http.StatusOK,
+		},
+		{
+			Name: "Retryable ARM Error Code at the top level",
+			Responses: []testResponse{
+				{statusCode: http.StatusBadRequest, body: `{"code":"retryableerror","message":"A retryable error occurred."}`},
+				{statusCode: http.StatusOK},
+			},
+			ExpectedRequests:   2,
+			ExpectedStatusCode: http.StatusOK,
+		},
+		{
+			Name: "Non-Retryable Status Code",
+			Responses: []testResponse{
+				{statusCode: http.StatusNotFound},
+				{statusCode: http.StatusOK},
+			},
+			ExpectedRequests:   1,
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		{
+			Name: "Non-Retryable ARM Error Code",
+			Responses: []testResponse{
+				{statusCode: http.StatusConflict, body: `{"error":{"code":"Conflict","message":"The resource already exists."}}`},
+				{statusCode: http.StatusOK},
+			},
+			ExpectedRequests:   1,
+			ExpectedStatusCode: http.StatusConflict,
+		},
+		{
+			Name: "Attempts Exhausted",
+			Responses: []testResponse{
+				{statusCode: http.StatusInternalServerError},
+			},
+			ExpectedRequests:   5,
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		server, requests := newRetryTestServer(t, v.Responses...)
+		resp := sendRetryTestRequest(t, testRetryOptions(), server)
+		server.Close()
+
+		if resp.StatusCode != v.ExpectedStatusCode {
+			t.Fatalf("expected the Status Code to be %d but got %d", v.ExpectedStatusCode, resp.StatusCode)
+		}
+		if actual := atomic.LoadInt32(requests); actual != v.ExpectedRequests {
+			t.Fatalf("expected %d requests but got %d", v.ExpectedRequests, actual)
+		}
+	}
+}
+
+func TestWithRetriesPreservesTheResponseBody(t *testing.T) {
+	body := `{"error":{"code":"Conflict","message":"The resource already exists."}}`
+	server, _ := newRetryTestServer(t, testResponse{statusCode: http.StatusConflict, body: body})
+	defer server.Close()
+
+	resp := sendRetryTestRequest(t, testRetryOptions(), server)
+	actual, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading response body: %+v", err)
+	}
+	if string(actual) != body {
+		t.Fatalf("expected the response body to be %q but got %q", body, string(actual))
+	}
+}
+
+func TestWithRetriesDisabled(t *testing.T) {
+	server, requests := newRetryTestServer(t, testResponse{statusCode: http.StatusTooManyRequests})
+	defer server.Close()
+
+	o := testRetryOptions()
+	o.MaxAttempts = 1
+	resp := sendRetryTestRequest(t, o, server)
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected the Status Code to be %d but got %d", http.StatusTooManyRequests, resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(requests); actual != 1 {
+		t.Fatalf("expected 1 request but got %d", actual)
+	}
+}
+
+func TestWithRetriesRespectsRetryAfter(t *testing.T) {
+	server, _ := newRetryTestServer(t,
+		testResponse{statusCode: http.StatusTooManyRequests, headers: map[string]string{"Retry-After": "1"}},
+		testResponse{statusCode: http.StatusOK},
+	)
+	defer server.Close()
+
+	start := time.Now()
+	resp := sendRetryTestRequest(t, testRetryOptions(), server)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the Status Code to be %d but got %d", http.StatusOK, resp.StatusCode)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("expected the `Retry-After` header to delay the retry by 1s but the request completed in %s", elapsed)
+	}
+}
+
+func TestWithRetriesCancelled(t *testing.T) {
+	server, requests := newRetryTestServer(t, testResponse{statusCode: http.StatusServiceUnavailable})
+	defer server.Close()
+
+	o := testRetryOptions()
+	o.BaseBackoff = time.Minute
+	o.MaxBackoff = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if _, err := autorest.SendWithSender(server.Client(), req, WithRetries(o)); err == nil {
+		t.Fatal("expected an error when the context was cancelled but didn't get one")
+	}
+	if actual := atomic.LoadInt32(requests); actual != 1 {
+		t.Fatalf("expected 1 request but got %d", actual)
+	}
+}
+
+func TestRetryOptionsDelay(t *testing.T) {
+	o := RetryOptions{
+		BaseBackoff:       2 * time.Second,
+		MaxBackoff:        10 * time.Second,
+		RespectRetryAfter: true,
+	}
+
+	testData := []struct {
+		Attempt  int
+		Headers  map[string]string
+		Expected time.Duration
+	}{
+		{
+			Attempt:  1,
+			Expected: 2 * time.Second,
+		},
+		{
+			Attempt:  2,
+			Expected: 4 * time.Second,
+		},
+		{
+			Attempt:  3,
+			Expected: 8 * time.Second,
+		},
+		{
+			Attempt:  4,
+			Expected: 10 * time.Second,
+		},
+		{
+			Attempt:  50,
+			Expected: 10 * time.Second,
+		},
+		{
+			Attempt:  1,
+			Headers:  map[string]string{"Retry-After": "30"},
+			Expected: 30 * time.Second,
+		},
+		{
+			Attempt:  1,
+			Headers:  map[string]string{"x-ms-retry-after-ms": "1500"},
+			Expected: 1500 * time.Millisecond,
+		},
+		{
+			Attempt:  2,
+			Headers:  map[string]string{"Retry-After": "not-a-number"},
+			Expected: 4 * time.Second,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing Attempt %d with Headers %+v..", v.Attempt, v.Headers)
+
+		resp := &http.Response{Header: http.Header{}}
+		for k, val := range v.Headers {
+			resp.Header.Set(k, val)
+		}
+
+		if actual := o.delay(resp, v.Attempt); actual != v.Expected {
+			t.Fatalf("expected a delay of %s but got %s", v.Expected, actual)
+		}
+	}
+
+	// when disabled the Retry-After header should be ignored
+	o.RespectRetryAfter = false
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"30"}}}
+	if actual := o.delay(resp, 1); actual != 2*time.Second {
+		t.Fatalf("expected a delay of 2s but got %s", actual)
+	}
+}
+
+func TestWithRetriesTransportErrors(t *testing.T) {
+	testData := []struct {
+		Method           string
+		ExpectedRequests int32
+	}{
+		{
+			Method:           http.MethodGet,
+			ExpectedRequests: 5,
+		},
+		{
+			Method:           http.MethodDelete,
+			ExpectedRequests: 5,
+		},
+		{
+			// the request may have been processed before the connection failed, so isn't retried
+			Method:           http.MethodPost,
+			ExpectedRequests: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Method)
+
+		var requests int32
+		failing := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&requests, 1)
+			return nil, fmt.Errorf("connection reset by peer")
+		})
+
+		req, _ := http.NewRequest(v.Method, "https://management.azure.com", nil)
+		if _, err := autorest.SendWithSender(failing, req, WithRetries(testRetryOptions())); err == nil {
+			t.Fatal("expected an error but didn't get one")
+		}
+		if actual := atomic.LoadInt32(&requests); actual != v.ExpectedRequests {
+			t.Fatalf("expected %d requests but got %d", v.ExpectedRequests, actual)
+		}
+	}
+}
+
+func TestConfigureClientOnlyRetriesUsingTheRetryOptions(t *testing.T) {
+	for _, maxAttempts := range []int{1, 3} {
+		t.Logf("[DEBUG] Testing %d Max Attempts..", maxAttempts)
+
+		server, requests := newRetryTestServer(t, testResponse{statusCode: http.StatusTooManyRequests})
+
+		o := ClientOptions{
+			Retry:           testRetryOptions(),
+			SkipProviderReg: true,
+		}
+		o.Retry.MaxAttempts = maxAttempts
+
+		client := autorest.NewClientWithUserAgent("")
+		o.ConfigureClient(&client, autorest.NullAuthorizer{})
+
+		// the Azure SDK sends requests using the Resource Provider registration SendDecorator, which also retries requests
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		resp, err := client.Send(req, azure.DoRetryWithRegistration(client))
+		server.Close()
+		if err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+
+		if resp.StatusCode != http.StatusTooManyRequests {
+			t.Fatalf("expected the Status Code to be %d but got %d", http.StatusTooManyRequests, resp.StatusCode)
+		}
+		if actual := atomic.LoadInt32(requests); actual != int32(maxAttempts) {
+			t.Fatalf("expected %d requests but got %d", maxAttempts, actual)
+		}
+	}
+}
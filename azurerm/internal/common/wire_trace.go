@@ -0,0 +1,193 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
+)
+
+// maxWireTraceBodySize is the maximum number of bytes of a request or response body which is written to the Wire Trace
+const maxWireTraceBodySize = 64 * 1024
+
+// WireTracer writes each request sent to Azure (and the response to it) to a file, with any secrets redacted
+//
+// This is shared between each of the clients, so that the requests sent to Resource Manager, Graph,
+// Key Vault and Storage are written to the same file
+type WireTracer struct {
+	// redactor redacts secrets in the same way as these are redacted from the recordings of the Acceptance Tests
+	redactor recording.Redactor
+
+	lock     sync.Mutex
+	file     io.Writer
+	sequence int64
+}
+
+// NewWireTracer returns a WireTracer which appends to the file at the specified path, or nil when no path is specified
+func NewWireTracer(path, keyVaultDNSSuffix string) (*WireTracer, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	// the file remains open until the Provider exits, since it's used by each of the clients
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("opening the Wire Trace file %q: %+v", path, err)
+	}
+
+	return &WireTracer{
+		redactor: recording.NewRedactor(keyVaultDNSSuffix),
+		file:     file,
+	}, nil
+}
+
+// WithWireTracing returns a SendDecorator which writes each request (and the response to it) to the Wire Trace
+func WithWireTracing(t *WireTracer) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		if t == nil {
+			return s
+		}
+
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			sequence := atomic.AddInt64(&t.sequence, 1)
+			requestBody := readWireTraceBody(&r.Body, r.Header)
+
+			start := time.Now()
+			resp, err := s.Do(r)
+			duration := time.Since(start)
+
+			out := &bytes.Buffer{}
+			fmt.Fprintf(out, "==> #%d %s %s %s\n", sequence, start.UTC().Format(time.RFC3339Nano), r.Method, t.redactor.URL(r.URL))
+			fmt.Fprintf(out, "Correlation ID: %s\n", r.Header.Get("X-Ms-Correlation-Request-Id"))
+			t.writeHeaders(out, r.Header)
+			t.writeBody(out, r, requestBody)
+
+			if err != nil {
+				fmt.Fprintf(out, "<== #%d failed after %s: %+v\n\n", sequence, duration, err)
+			}
+			if resp != nil {
+				responseBody := readWireTraceBody(&resp.Body, resp.Header)
+				if isListSecretsRequest(r) {
+					responseBody = []byte("REDACTED")
+				}
+
+				fmt.Fprintf(out, "<== #%d %s (took %s)\n", sequence, resp.Status, duration)
+				fmt.Fprintf(out, "Request ID: %s\n", resp.Header.Get("X-Ms-Request-Id"))
+				t.writeHeaders(out, resp.Header)
+				t.writeBody(out, r, responseBody)
+			}
+
+			t.lock.Lock()
+			defer t.lock.Unlock()
+			if _, writeErr := t.file.Write(out.Bytes()); writeErr != nil {
+				// the Wire Trace is best-effort, so failing to write to this shouldn't fail the request
+				fmt.Fprintf(os.Stderr, "[WARN] writing to the Wire Trace: %+v\n", writeErr)
+			}
+
+			return resp, err
+		})
+	}
+}
+
+// readWireTraceBody reads the body of a request or response when this is text (e.g. JSON or XML) rather than
+// binary content (e.g. a Storage Blob), replacing it so that it can be read again
+func readWireTraceBody(body *io.ReadCloser, headers http.Header) []byte {
+	if *body == nil || *body == http.NoBody || !isTextContent(headers.Get("Content-Type")) {
+		return nil
+	}
+
+	contents, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	*body = ioutil.NopCloser(bytes.NewReader(contents))
+	if err != nil {
+		return nil
+	}
+	return contents
+}
+
+func (t *WireTracer) writeBody(out *bytes.Buffer, r *http.Request, body []byte) {
+	if len(body) == 0 {
+		out.WriteString("\n")
+		return
+	}
+
+	body = t.redactor.Body(r.URL, body)
+
+	truncated := len(body) > maxWireTraceBodySize
+	if truncated {
+		body = body[:maxWireTraceBodySize]
+	}
+	if !utf8.Valid(body) && !truncated {
+		fmt.Fprintf(out, "\n(%d bytes of binary content)\n\n", len(body))
+		return
+	}
+
+	fmt.Fprintf(out, "\n%s\n", body)
+	if truncated {
+		fmt.Fprintf(out, "(truncated to %d bytes)\n", maxWireTraceBodySize)
+	}
+	out.WriteString("\n")
+}
+
+// isListSecretsRequest returns whether the request retrieves (or regenerates) the secrets for a Resource, for
+// example a `listKeys` request - the responses to which are redacted in their entirety
+func isListSecretsRequest(r *http.Request) bool {
+	segments := strings.Split(strings.ToLower(strings.Trim(r.URL.Path, "/")), "/")
+	action := segments[len(segments)-1]
+	if action == "list" && len(segments) > 1 {
+		// some API's list the secrets for a nested Resource, e.g. `config/publishingcredentials/list`
+		action += segments[len(segments)-2]
+	}
+	if !strings.HasPrefix(action, "list") && !strings.HasPrefix(action, "regenerate") {
+		return false
+	}
+
+	for _, v := range []string{"key", "secret", "credential", "connectionstring", "sas", "token"} {
+		if strings.Contains(action, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *WireTracer) writeHeaders(out *bytes.Buffer, headers http.Header) {
+	redacted := t.redactor.Headers(headers)
+
+	keys := make([]string, 0, len(redacted))
+	for k := range redacted {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		for _, v := range redacted[k] {
+			fmt.Fprintf(out, "%s: %s\n", k, v)
+		}
+	}
+}
+
+func isTextContent(contentType string) bool {
+	contentType = strings.ToLower(contentType)
+	if contentType == "" {
+		// requests sent by the SDK's without a Content-Type are generally empty or JSON
+		return true
+	}
+
+	for _, v := range []string{"json", "xml", "text/", "x-www-form-urlencoded"} {
+		if strings.Contains(contentType, v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const testWireTraceSecret = "c2VjcmV0LWFjY2Vzcy1rZXk="
+
+func newWireTraceTestServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the body of the request should still be sent once it's been traced
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Method == http.MethodPut && string(body) != `{"location":"westeurope"}` {
+			t.Errorf("expected the request body to be sent but got %q", string(body))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Ms-Request-Id", "request1")
+		if strings.HasSuffix(r.URL.Path, "/listKeys") {
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `{"someNewKey":"`+testWireTraceSecret+`"}`)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"group1","properties":{"endpoint":"Endpoint=sb://example;SharedAccessKey=`+testWireTraceSecret+`"}}`)
+	}))
+}
+
+func sendWireTraceTestRequest(t *testing.T, tracer *WireTracer, method, uri string) string {
+	req, err := http.NewRequestWithContext(context.TODO(), method, uri, strings.NewReader(`{"location":"westeurope"}`))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+testWireTraceSecret)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Ms-Correlation-Request-Id", "correlation1")
+
+	resp, err := autorest.SendWithSender(http.DefaultClient, req, WithWireTracing(tracer))
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	// the body of the response should still be returned once it's been traced
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading response: %+v", err)
+	}
+	return string(body)
+}
+
+func TestWireTracing(t *testing.T) {
+	server := newWireTraceTestServer(t)
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "trace.log")
+	tracer, err := NewWireTracer(path, "vault.azure.net")
+	if err != nil {
+		t.Fatalf("building Wire Tracer: %+v", err)
+	}
+
+	body := sendWireTraceTestRequest(t, tracer, http.MethodPut, server.URL+"/subscriptions/sub1/resourceGroups/group1?api-version=2020-06-01&sig=abc")
+	if !strings.Contains(body, testWireTraceSecret) {
+		t.Fatalf("expected the response to be unchanged but got %q", body)
+	}
+	body = sendWireTraceTestRequest(t, tracer, http.MethodPost, server.URL+"/subscriptions/sub1/resourceGroups/group1/listKeys?api-version=2020-06-01")
+	if !strings.Contains(body, testWireTraceSecret) {
+		t.Fatalf("expected the response to be unchanged but got %q", body)
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading Wire Trace: %+v", err)
+	}
+	trace := string(contents)
+
+	expected := []string{
+		"==> #1 ",
+		"PUT " + server.URL + "/subscriptions/sub1/resourceGroups/group1?api-version=2020-06-01&sig=REDACTED",
+		"Correlation ID: correlation1",
+		"Authorization: REDACTED",
+		`{"location":"westeurope"}`,
+		"<== #1 201 Created (took ",
+		"Request ID: request1",
+		"SharedAccessKey=REDACTED",
+		"==> #2 ",
+		"<== #2 200 OK (took ",
+	}
+	for _, v := range expected {
+		if !strings.Contains(trace, v) {
+			t.Fatalf("expected the Wire Trace to contain %q but got: %s", v, trace)
+		}
+	}
+	for _, v := range []string{testWireTraceSecret, "Bearer", "sig=abc"} {
+		if strings.Contains(trace, v) {
+			t.Fatalf("expected %q to be redacted from the Wire Trace but got: %s", v, trace)
+		}
+	}
+}
+
+func TestWireTracingDisabled(t *testing.T) {
+	tracer, err := NewWireTracer("", "vault.azure.net")
+	if err != nil {
+		t.Fatalf("building Wire Tracer: %+v", err)
+	}
+	if tracer != nil {
+		t.Fatalf("expected no Wire Tracer when no path is specified but got %+v", tracer)
+	}
+
+	server := newWireTraceTestServer(t)
+	defer server.Close()
+	sendWireTraceTestRequest(t, tracer, http.MethodPut, server.URL+"/subscriptions/sub1/resourceGroups/group1")
+}
+
+func TestWireTracingIsListSecretsRequest(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/listKeys",
+			Expected: true,
+		},
+		{
+			Input:    "/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/regenerateKey",
+			Expected: true,
+		},
+		{
+			Input:    "/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/ListAccountSas",
+			Expected: true,
+		},
+		{
+			Input:    "/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Web/sites/site1/config/publishingcredentials/list",
+			Expected: true,
+		},
+		{
+			Input:    "/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Web/sites/site1/functions/function1/listSecrets",
+			Expected: true,
+		},
+		{
+			Input:    "/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			Expected: false,
+		},
+		{
+			Input:    "/subscriptions/sub1/providers/Microsoft.Storage/skus",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		if actual := isListSecretsRequest(&http.Request{URL: &url.URL{Path: v.Input}}); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -1,5 +1,14 @@
 package locks
 
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = NewMutexKV()
 
@@ -7,18 +16,91 @@ func ByID(id string) {
 	armMutexKV.Lock(id)
 }
 
+// ByIDWithContext locks the specified ID, returning an error if the context is cancelled (or times out) before the
+// lock is acquired - in which case UnlockByID mustn't be called
+func ByIDWithContext(ctx context.Context, id string) error {
+	return lockWithContext(ctx, id, false)
+}
+
 // handle the case of using the same name for different kinds of resources
 func ByName(name string, resourceType string) {
 	updatedName := resourceType + "." + name
 	armMutexKV.Lock(updatedName)
 }
 
+// ByNameWithContext locks the specified name for this resource type, returning an error if the context is cancelled
+// (or times out) before the lock is acquired - in which case UnlockByName mustn't be called
+func ByNameWithContext(ctx context.Context, name string, resourceType string) error {
+	updatedName := resourceType + "." + name
+	return lockWithContext(ctx, updatedName, false)
+}
+
 func MultipleByName(names *[]string, resourceType string) {
-	newSlice := removeDuplicatesFromStringArray(*names)
+	// a lock can't fail to be acquired without a deadline
+	_ = MultipleByNameWithContext(context.Background(), names, resourceType)
+}
+
+// MultipleByNameWithContext locks each of the specified names for this resource type, returning an error if the context
+// is cancelled (or times out) before all of the locks are acquired - in which case any locks which were acquired are
+// released, and UnlockMultipleByName mustn't be called
+//
+// the locks are acquired in sorted order, so that two resources locking the same names can't deadlock
+func MultipleByNameWithContext(ctx context.Context, names *[]string, resourceType string) error {
+	newSlice := sortedNames(names)
 
-	for _, name := range newSlice {
-		ByName(name, resourceType)
+	for i, name := range newSlice {
+		if err := ByNameWithContext(ctx, name, resourceType); err != nil {
+			for j := i - 1; j >= 0; j-- {
+				UnlockByName(newSlice[j], resourceType)
+			}
+			return err
+		}
 	}
+
+	return nil
+}
+
+// SharedByNameWithContext locks the specified name for this resource type for reading - such that any number of
+// callers can hold this concurrently, but not whilst it's locked by ByName. This returns an error if the context is
+// cancelled (or times out) before the lock is acquired - in which case UnlockSharedByName mustn't be called
+func SharedByNameWithContext(ctx context.Context, name string, resourceType string) error {
+	updatedName := resourceType + "." + name
+	return lockWithContext(ctx, updatedName, true)
+}
+
+// Parent is the resource containing a child resource, for example the Virtual Network containing a Subnet
+type Parent struct {
+	Name         string
+	ResourceType string
+
+	// Shared specifies that the parent should be locked for reading, rather than exclusively - which allows
+	// the other children of this parent to be changed at the same time
+	Shared bool
+}
+
+// ChildByNameWithContext locks the parent resource (exclusively, unless it should be shared) followed by the child
+// resource exclusively - such that the child can't be changed at the same time as the parent, but that (when the
+// parent is shared) the other children of this parent can be changed concurrently.
+//
+// This returns an error if the context is cancelled (or times out) before both locks are acquired - in which case
+// neither lock is held and UnlockChildByName mustn't be called
+func ChildByNameWithContext(ctx context.Context, parent Parent, name string, resourceType string) error {
+	if parent.Shared {
+		if err := SharedByNameWithContext(ctx, parent.Name, parent.ResourceType); err != nil {
+			return err
+		}
+	} else {
+		if err := ByNameWithContext(ctx, parent.Name, parent.ResourceType); err != nil {
+			return err
+		}
+	}
+
+	if err := ByNameWithContext(ctx, ChildName(parent.Name, name), resourceType); err != nil {
+		unlockParent(parent)
+		return err
+	}
+
+	return nil
 }
 
 func UnlockByID(id string) {
@@ -30,10 +112,80 @@ func UnlockByName(name string, resourceType string) {
 	armMutexKV.Unlock(updatedName)
 }
 
+func UnlockSharedByName(name string, resourceType string) {
+	updatedName := resourceType + "." + name
+	armMutexKV.RUnlock(updatedName)
+}
+
+func UnlockChildByName(parent Parent, name string, resourceType string) {
+	UnlockByName(ChildName(parent.Name, name), resourceType)
+	unlockParent(parent)
+}
+
 func UnlockMultipleByName(names *[]string, resourceType string) {
-	newSlice := removeDuplicatesFromStringArray(*names)
+	newSlice := sortedNames(names)
+
+	// the locks are released in the reverse order they were acquired
+	for i := len(newSlice) - 1; i >= 0; i-- {
+		UnlockByName(newSlice[i], resourceType)
+	}
+}
+
+// Held returns the locks which are currently held, ordered by key
+func Held() []HeldLock {
+	return armMutexKV.Held()
+}
+
+// LogHeld writes the locks which are currently held (and who holds them) to the log, for debugging purposes
+func LogHeld() {
+	held := Held()
+	if len(held) == 0 {
+		log.Printf("[DEBUG] No locks are currently held")
+		return
+	}
+
+	lines := make([]string, 0, len(held))
+	for _, v := range held {
+		mode := "held"
+		if v.Shared {
+			mode = "shared"
+		}
+		lines = append(lines, fmt.Sprintf("%q %s by %s for %s", v.Key, mode, v.Holder, time.Since(v.Acquired).Round(time.Millisecond)))
+	}
+	log.Printf("[DEBUG] %d locks are currently held:\n%s", len(held), strings.Join(lines, "\n"))
+}
+
+func lockWithContext(ctx context.Context, key string, shared bool) error {
+	lock := armMutexKV.LockWithContext
+	if shared {
+		lock = armMutexKV.RLockWithContext
+	}
+
+	if err := lock(ctx, key); err != nil {
+		// the other locks which are held are useful to diagnose why this one couldn't be acquired
+		LogHeld()
+		return err
+	}
+
+	return nil
+}
+
+// ChildName returns the name used to lock a child resource (since this is only unique within the parent) - which
+// should be used when locking a child resource alongside other resources, rather than using ChildByNameWithContext
+func ChildName(parentName string, name string) string {
+	return parentName + "/" + name
+}
 
-	for _, name := range newSlice {
-		UnlockByName(name, resourceType)
+func unlockParent(parent Parent) {
+	if parent.Shared {
+		UnlockSharedByName(parent.Name, parent.ResourceType)
+	} else {
+		UnlockByName(parent.Name, parent.ResourceType)
 	}
 }
+
+func sortedNames(names *[]string) []string {
+	newSlice := removeDuplicatesFromStringArray(*names)
+	sort.Strings(newSlice)
+	return newSlice
+}
@@ -0,0 +1,384 @@
+package locks
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestByNameIsMutuallyExclusive(t *testing.T) {
+	counter := 0
+	wg := sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ByName("exclusive", "azurerm_test")
+			defer UnlockByName("exclusive", "azurerm_test")
+			counter++
+		}()
+	}
+	wg.Wait()
+
+	if counter != 50 {
+		t.Fatalf("expected the counter to be 50 but got %d", counter)
+	}
+}
+
+func TestByNameWithContextTimesOut(t *testing.T) {
+	ByName("timeout", "azurerm_test")
+	defer UnlockByName("timeout", "azurerm_test")
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+
+	err := ByNameWithContext(ctx, "timeout", "azurerm_test")
+	if err == nil {
+		t.Fatalf("expected an error acquiring a held lock but didn't get one")
+	}
+	if !strings.Contains(err.Error(), "context deadline exceeded") || !strings.Contains(err.Error(), "TestByNameWithContextTimesOut") {
+		t.Fatalf("expected the error to contain the reason and the holder but got: %+v", err)
+	}
+}
+
+func TestByIDWithContextAcquiresOnceReleased(t *testing.T) {
+	ByID("/subscriptions/sub1/resourceGroups/group1")
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		UnlockByID("/subscriptions/sub1/resourceGroups/group1")
+	}()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	if err := ByIDWithContext(ctx, "/subscriptions/sub1/resourceGroups/group1"); err != nil {
+		t.Fatalf("expected the lock to be acquired once released but got: %+v", err)
+	}
+	UnlockByID("/subscriptions/sub1/resourceGroups/group1")
+}
+
+func TestMultipleByNameInDifferentOrdersDoesNotDeadlock(t *testing.T) {
+	orders := [][]string{
+		{"network1", "network2", "network3"},
+		{"network3", "network2", "network1"},
+		{"network2", "network3", "network1", "network2"},
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+	defer cancel()
+
+	wg := sync.WaitGroup{}
+	errors := make(chan error, len(orders)*100)
+	for i := 0; i < 100; i++ {
+		for _, order := range orders {
+			names := order
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				if err := MultipleByNameWithContext(ctx, &names, "azurerm_test"); err != nil {
+					errors <- err
+					return
+				}
+				UnlockMultipleByName(&names, "azurerm_test")
+			}()
+		}
+	}
+	wg.Wait()
+	close(errors)
+
+	for err := range errors {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+}
+
+func TestMultipleByNameWithContextReleasesLocksOnTimeout(t *testing.T) {
+	ByName("partial2", "azurerm_test")
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+
+	names := []string{"partial3", "partial1", "partial2"}
+	if err := MultipleByNameWithContext(ctx, &names, "azurerm_test"); err == nil {
+		t.Fatalf("expected an error acquiring a held lock but didn't get one")
+	}
+	UnlockByName("partial2", "azurerm_test")
+
+	for _, v := range Held() {
+		if strings.HasPrefix(v.Key, "azurerm_test.partial") {
+			t.Fatalf("expected the locks acquired before timing out to be released but %q is held", v.Key)
+		}
+	}
+}
+
+func TestHeld(t *testing.T) {
+	names := []string{"held2", "held1"}
+	MultipleByName(&names, "azurerm_held")
+
+	held := make([]HeldLock, 0)
+	for _, v := range Held() {
+		if strings.HasPrefix(v.Key, "azurerm_held.") {
+			held = append(held, v)
+		}
+	}
+	if len(held) != 2 || held[0].Key != "azurerm_held.held1" || held[1].Key != "azurerm_held.held2" {
+		t.Fatalf("expected the two held locks in order but got %+v", held)
+	}
+	if !strings.Contains(held[0].Holder, "TestHeld") || held[0].Acquired.IsZero() {
+		t.Fatalf("expected the holder and time the lock was acquired to be tracked but got %+v", held[0])
+	}
+
+	UnlockMultipleByName(&names, "azurerm_held")
+	for _, v := range Held() {
+		if strings.HasPrefix(v.Key, "azurerm_held.") {
+			t.Fatalf("expected %q to be released", v.Key)
+		}
+	}
+}
+
+func TestSharedByNameIsHeldConcurrently(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+
+	for i := 0; i < 3; i++ {
+		if err := SharedByNameWithContext(ctx, "shared", "azurerm_test"); err != nil {
+			t.Fatalf("expected the shared lock to be acquired but got: %+v", err)
+		}
+	}
+
+	held := 0
+	for _, v := range Held() {
+		if v.Key == "azurerm_test.shared" && v.Shared {
+			held++
+		}
+	}
+	if held != 3 {
+		t.Fatalf("expected the shared lock to be held 3 times but got %d", held)
+	}
+
+	timeout, timeoutCancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer timeoutCancel()
+	if err := ByNameWithContext(timeout, "shared", "azurerm_test"); err == nil {
+		t.Fatalf("expected an error locking exclusively whilst shared but didn't get one")
+	}
+
+	for i := 0; i < 3; i++ {
+		UnlockSharedByName("shared", "azurerm_test")
+	}
+	if err := ByNameWithContext(ctx, "shared", "azurerm_test"); err != nil {
+		t.Fatalf("expected the exclusive lock to be acquired once released but got: %+v", err)
+	}
+	UnlockByName("shared", "azurerm_test")
+}
+
+func TestSharedByNameWaitsForWaitingWriters(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+
+	if err := SharedByNameWithContext(ctx, "writer-preference", "azurerm_test"); err != nil {
+		t.Fatalf("expected the shared lock to be acquired but got: %+v", err)
+	}
+
+	writerAcquired := make(chan struct{})
+	go func() {
+		if err := ByNameWithContext(ctx, "writer-preference", "azurerm_test"); err != nil {
+			t.Errorf("expected the exclusive lock to be acquired but got: %+v", err)
+		}
+		close(writerAcquired)
+	}()
+
+	// wait until the writer is waiting, after which new readers should wait for it
+	for {
+		armMutexKV.lock.Lock()
+		waiting := armMutexKV.get("azurerm_test.writer-preference").waitingWriters
+		armMutexKV.lock.Unlock()
+		if waiting == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	timeout, timeoutCancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer timeoutCancel()
+	if err := SharedByNameWithContext(timeout, "writer-preference", "azurerm_test"); err == nil {
+		t.Fatalf("expected an error acquiring the shared lock whilst a writer is waiting but didn't get one")
+	}
+
+	UnlockSharedByName("writer-preference", "azurerm_test")
+	<-writerAcquired
+	UnlockByName("writer-preference", "azurerm_test")
+}
+
+func TestChildByNameAllowsSiblingsConcurrently(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+
+	parent := Parent{
+		Name:         "network1",
+		ResourceType: "azurerm_parent",
+		Shared:       true,
+	}
+	if err := ChildByNameWithContext(ctx, parent, "subnet1", "azurerm_child"); err != nil {
+		t.Fatalf("expected the first child to be locked but got: %+v", err)
+	}
+	if err := ChildByNameWithContext(ctx, parent, "subnet2", "azurerm_child"); err != nil {
+		t.Fatalf("expected the sibling to be locked concurrently but got: %+v", err)
+	}
+
+	timeout, timeoutCancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer timeoutCancel()
+	if err := ChildByNameWithContext(timeout, parent, "subnet1", "azurerm_child"); err == nil {
+		t.Fatalf("expected an error locking the same child twice but didn't get one")
+	}
+	if err := ByNameWithContext(timeout, "network1", "azurerm_parent"); err == nil {
+		t.Fatalf("expected an error locking the parent whilst a child is locked but didn't get one")
+	}
+
+	// the same child within a different parent is a different resource
+	other := Parent{
+		Name:         "network2",
+		ResourceType: "azurerm_parent",
+	}
+	if err := ChildByNameWithContext(ctx, other, "subnet1", "azurerm_child"); err != nil {
+		t.Fatalf("expected the child of another parent to be locked but got: %+v", err)
+	}
+	UnlockChildByName(other, "subnet1", "azurerm_child")
+
+	UnlockChildByName(parent, "subnet1", "azurerm_child")
+	UnlockChildByName(parent, "subnet2", "azurerm_child")
+	for _, v := range Held() {
+		if strings.HasPrefix(v.Key, "azurerm_parent.") || strings.HasPrefix(v.Key, "azurerm_child.") {
+			t.Fatalf("expected %q to be released", v.Key)
+		}
+	}
+}
+
+func TestChildByNameWithExclusiveParent(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+
+	parent := Parent{
+		Name:         "exclusive",
+		ResourceType: "azurerm_parent",
+	}
+	if err := ChildByNameWithContext(ctx, parent, "child1", "azurerm_child"); err != nil {
+		t.Fatalf("expected the child to be locked but got: %+v", err)
+	}
+
+	timeout, timeoutCancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer timeoutCancel()
+	if err := ChildByNameWithContext(timeout, parent, "child2", "azurerm_child"); err == nil {
+		t.Fatalf("expected an error locking a sibling whilst the parent is locked exclusively but didn't get one")
+	}
+
+	UnlockChildByName(parent, "child1", "azurerm_child")
+	for _, v := range Held() {
+		if strings.HasPrefix(v.Key, "azurerm_parent.exclusive") || strings.HasPrefix(v.Key, "azurerm_child.exclusive/") {
+			t.Fatalf("expected %q to be released", v.Key)
+		}
+	}
+}
+
+func TestChildNameMatchesChildByName(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+
+	parent := Parent{
+		Name:         "referenced",
+		ResourceType: "azurerm_parent",
+	}
+	if err := ChildByNameWithContext(ctx, parent, "child1", "azurerm_child"); err != nil {
+		t.Fatalf("expected the child to be locked but got: %+v", err)
+	}
+
+	timeout, timeoutCancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer timeoutCancel()
+	if err := ByNameWithContext(timeout, ChildName("referenced", "child1"), "azurerm_child"); err == nil {
+		t.Fatalf("expected an error locking the child by its name whilst it's locked but didn't get one")
+	}
+
+	UnlockChildByName(parent, "child1", "azurerm_child")
+	if err := ByNameWithContext(ctx, ChildName("referenced", "child1"), "azurerm_child"); err != nil {
+		t.Fatalf("expected the child to be locked by its name once released but got: %+v", err)
+	}
+	UnlockByName(ChildName("referenced", "child1"), "azurerm_child")
+}
+
+func TestChildByNameAndParentAreMutuallyExclusive(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+	defer cancel()
+
+	parent := Parent{
+		Name:         "race",
+		ResourceType: "azurerm_parent",
+		Shared:       true,
+	}
+
+	// the parent and each child are changed concurrently - where the parent mustn't be changed at the
+	// same time as any child, and each child mustn't be changed by more than one caller at a time
+	lock := sync.Mutex{}
+	parentChanging := false
+	childrenChanging := map[string]int{}
+
+	wg := sync.WaitGroup{}
+	errors := make(chan error, 1000)
+	for i := 0; i < 200; i++ {
+		child := []string{"child1", "child2", "child3"}[i%3]
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+
+			if err := ChildByNameWithContext(ctx, parent, child, "azurerm_child"); err != nil {
+				errors <- err
+				return
+			}
+			defer UnlockChildByName(parent, child, "azurerm_child")
+
+			lock.Lock()
+			if parentChanging || childrenChanging[child] > 0 {
+				t.Errorf("expected %q not to be changed at the same time as its parent or itself", child)
+			}
+			childrenChanging[child]++
+			lock.Unlock()
+
+			time.Sleep(time.Microsecond)
+
+			lock.Lock()
+			childrenChanging[child]--
+			lock.Unlock()
+		}()
+		go func() {
+			defer wg.Done()
+
+			if err := ByNameWithContext(ctx, "race", "azurerm_parent"); err != nil {
+				errors <- err
+				return
+			}
+			defer UnlockByName("race", "azurerm_parent")
+
+			lock.Lock()
+			for k, v := range childrenChanging {
+				if v > 0 {
+					t.Errorf("expected the parent not to be changed at the same time as %q", k)
+				}
+			}
+			parentChanging = true
+			lock.Unlock()
+
+			time.Sleep(time.Microsecond)
+
+			lock.Lock()
+			parentChanging = false
+			lock.Unlock()
+		}()
+	}
+	wg.Wait()
+	close(errors)
+
+	for err := range errors {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+}
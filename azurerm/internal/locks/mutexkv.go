@@ -1,48 +1,299 @@
 package locks
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"runtime"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 )
 
+// waitLogInterval is how often a message is logged whilst waiting to acquire a lock
+var waitLogInterval = 30 * time.Second
+
 // mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
+//
+// Each mutex can be held exclusively (for writing) by a single holder, or shared (for reading)
+// by any number of holders.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*keyMutex
+}
+
+// keyMutex is a reader/writer mutex which can be acquired with a context - and which tracks who holds it.
+// All of the fields are guarded by the lock on the mutexKV
+type keyMutex struct {
+	// writer is the holder of the exclusive lock, if any
+	writer *lockHolder
+
+	// readers are the holders of the shared lock
+	readers []*lockHolder
+
+	// waitingWriters is the number of callers waiting to acquire the exclusive lock, which
+	// prevents the shared lock from being acquired so that writers aren't starved by readers
+	waitingWriters int
+
+	// changed is closed (and replaced) when the mutex is released, to wake any waiters
+	changed chan struct{}
+}
+
+type lockHolder struct {
+	function string
+	caller   string
+	acquired time.Time
+}
+
+// HeldLock describes a lock which is currently held
+type HeldLock struct {
+	Key      string
+	Holder   string
+	Acquired time.Time
+	Shared   bool
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
-	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	// a lock can't fail to be acquired without a deadline
+	_ = m.LockWithContext(context.Background(), key)
+}
+
+// LockWithContext locks the mutex for the given key, returning an error if the context is cancelled
+// (or reaches its deadline) before the lock is acquired. Caller is responsible for calling Unlock
+// for the same key when this returns no error
+func (m *mutexKV) LockWithContext(ctx context.Context, key string) error {
+	return m.lockWithContext(ctx, key, false)
+}
+
+// RLockWithContext locks the mutex for the given key for reading, returning an error if the context is
+// cancelled (or reaches its deadline) before the lock is acquired. Caller is responsible for calling
+// RUnlock for the same key when this returns no error
+func (m *mutexKV) RLockWithContext(ctx context.Context, key string) error {
+	return m.lockWithContext(ctx, key, true)
+}
+
+func (m *mutexKV) lockWithContext(ctx context.Context, key string, shared bool) error {
+	function, caller := callerOutsidePackage()
+	description := "Locking"
+	if shared {
+		description = "Locking (shared)"
+	}
+	log.Printf("[DEBUG] %s %q", description, key)
+	start := time.Now()
+
+	var ticker *time.Ticker
+	waiting := false
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+		if waiting && !shared {
+			m.lock.Lock()
+			mutex := m.get(key)
+			mutex.waitingWriters--
+			// readers which are waiting for this writer can now proceed, should this have given up
+			mutex.notify()
+			m.lock.Unlock()
+		}
+	}()
+
+	for {
+		m.lock.Lock()
+		mutex := m.get(key)
+		// try to acquire the lock before checking the context, so an uncontended lock is always acquired
+		if mutex.available(shared) {
+			holder := &lockHolder{
+				function: function,
+				caller:   caller,
+				acquired: time.Now(),
+			}
+			if shared {
+				mutex.readers = append(mutex.readers, holder)
+			} else {
+				mutex.writer = holder
+			}
+			m.lock.Unlock()
+
+			log.Printf("[DEBUG] Locked %q after waiting %s", key, time.Since(start).Round(time.Millisecond))
+			return nil
+		}
+
+		if !waiting {
+			waiting = true
+			if !shared {
+				mutex.waitingWriters++
+			}
+			ticker = time.NewTicker(waitLogInterval)
+		}
+		changed := mutex.changed
+		m.lock.Unlock()
+
+		select {
+		case <-changed:
+
+		case <-ticker.C:
+			log.Printf("[DEBUG] Still waiting to lock %q after %s (%s)", key, time.Since(start).Round(time.Second), m.describeHolders(key))
+
+		case <-ctx.Done():
+			return fmt.Errorf("waiting %s to lock %q (%s): %+v", time.Since(start).Round(time.Millisecond), key, m.describeHolders(key), ctx.Err())
+		}
+	}
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
-	log.Printf("[DEBUG] Unlocked %q", key)
+
+	m.lock.Lock()
+	mutex := m.get(key)
+	if mutex.writer == nil {
+		m.lock.Unlock()
+		panic(fmt.Sprintf("unlocking %q which isn't locked", key))
+	}
+	held := time.Since(mutex.writer.acquired)
+	mutex.writer = nil
+	mutex.notify()
+	m.lock.Unlock()
+
+	log.Printf("[DEBUG] Unlocked %q after holding it for %s", key, held.Round(time.Millisecond))
 }
 
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+// RUnlock releases the shared lock on the mutex for the given key. Caller must have called RLock for the same key first
+func (m *mutexKV) RUnlock(key string) {
+	log.Printf("[DEBUG] Unlocking (shared) %q", key)
+	function, _ := callerOutsidePackage()
+
+	m.lock.Lock()
+	mutex := m.get(key)
+	if len(mutex.readers) == 0 {
+		m.lock.Unlock()
+		panic(fmt.Sprintf("unlocking %q which isn't locked for reading", key))
+	}
+
+	// the holders are only tracked for diagnostic purposes - so where the caller can't be matched
+	// (for example when the lock is released by a different function) the oldest is removed
+	index := 0
+	for i, v := range mutex.readers {
+		if v.function == function {
+			index = i
+			break
+		}
+	}
+	held := time.Since(mutex.readers[index].acquired)
+	mutex.readers = append(mutex.readers[:index], mutex.readers[index+1:]...)
+	if len(mutex.readers) == 0 {
+		mutex.notify()
+	}
+	m.lock.Unlock()
+
+	log.Printf("[DEBUG] Unlocked (shared) %q after holding it for %s", key, held.Round(time.Millisecond))
+}
+
+// Held returns the locks which are currently held, ordered by key
+func (m *mutexKV) Held() []HeldLock {
 	m.lock.Lock()
 	defer m.lock.Unlock()
+
+	held := make([]HeldLock, 0)
+	for key, mutex := range m.store {
+		if mutex.writer != nil {
+			held = append(held, HeldLock{
+				Key:      key,
+				Holder:   mutex.writer.caller,
+				Acquired: mutex.writer.acquired,
+			})
+		}
+		for _, v := range mutex.readers {
+			held = append(held, HeldLock{
+				Key:      key,
+				Holder:   v.caller,
+				Acquired: v.acquired,
+				Shared:   true,
+			})
+		}
+	}
+
+	sort.SliceStable(held, func(i, j int) bool {
+		if held[i].Key != held[j].Key {
+			return held[i].Key < held[j].Key
+		}
+		return held[i].Acquired.Before(held[j].Acquired)
+	})
+	return held
+}
+
+func (m *mutexKV) describeHolders(key string) string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mutex := m.get(key)
+	if mutex.writer != nil {
+		return fmt.Sprintf("held by %s for %s", mutex.writer.caller, time.Since(mutex.writer.acquired).Round(time.Millisecond))
+	}
+	if len(mutex.readers) > 0 {
+		oldest := mutex.readers[0]
+		return fmt.Sprintf("shared by %d holders including %s for %s", len(mutex.readers), oldest.caller, time.Since(oldest.acquired).Round(time.Millisecond))
+	}
+	if mutex.waitingWriters > 0 {
+		return fmt.Sprintf("%d callers are waiting to lock this exclusively", mutex.waitingWriters)
+	}
+
+	return "not currently held"
+}
+
+// Returns a mutex for the given key, no guarantee of its lock status. Caller must hold the lock on the mutexKV
+func (m *mutexKV) get(key string) *keyMutex {
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = &keyMutex{
+			changed: make(chan struct{}),
+		}
 		m.store[key] = mutex
 	}
 	return mutex
 }
 
+// available returns whether the mutex can be acquired exclusively, or shared
+func (k *keyMutex) available(shared bool) bool {
+	if k.writer != nil {
+		return false
+	}
+	if shared {
+		return k.waitingWriters == 0
+	}
+	return len(k.readers) == 0
+}
+
+// notify wakes any callers waiting to acquire the mutex
+func (k *keyMutex) notify() {
+	close(k.changed)
+	k.changed = make(chan struct{})
+}
+
+// callerOutsidePackage returns the function (and line) outside of this package which is acquiring a lock
+func callerOutsidePackage() (string, string) {
+	pcs := make([]uintptr, 10)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.Contains(frame.Function, "/internal/locks.") || strings.HasSuffix(frame.File, "_test.go") {
+			return frame.Function, fmt.Sprintf("%s (%s:%d)", frame.Function, frame.File, frame.Line)
+		}
+		if !more {
+			return "", "unknown"
+		}
+	}
+}
+
 // Returns a properly initialized mutexKV
 func NewMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*keyMutex),
 	}
 }
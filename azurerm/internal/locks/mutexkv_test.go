@@ -0,0 +1,83 @@
+package locks
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// syncBuffer is a bytes.Buffer which can be written to by the logger and read by the test concurrently
+type syncBuffer struct {
+	lock   sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buffer.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buffer.String()
+}
+
+func TestMutexKVLogsWhilstWaiting(t *testing.T) {
+	writer := log.Writer()
+	output := &syncBuffer{}
+	log.SetOutput(output)
+	defer log.SetOutput(writer)
+
+	interval := waitLogInterval
+	waitLogInterval = 10 * time.Millisecond
+	defer func() {
+		waitLogInterval = interval
+	}()
+
+	kv := NewMutexKV()
+	kv.Lock("key1")
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		kv.Unlock("key1")
+	}()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	if err := kv.LockWithContext(ctx, "key1"); err != nil {
+		t.Fatalf("expected the lock to be acquired once released but got: %+v", err)
+	}
+	kv.Unlock("key1")
+
+	logs := output.String()
+	for _, v := range []string{`Still waiting to lock "key1"`, "held by", "TestMutexKVLogsWhilstWaiting", `Locked "key1" after waiting`} {
+		if !strings.Contains(logs, v) {
+			t.Fatalf("expected the logs to contain %q but got: %s", v, logs)
+		}
+	}
+}
+
+func TestMutexKVUnlockWhenNotLocked(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected unlocking a key which isn't locked to panic")
+		}
+	}()
+
+	NewMutexKV().Unlock("key1")
+}
+
+func TestMutexKVRUnlockWhenNotLocked(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected unlocking a key which isn't locked for reading to panic")
+		}
+	}()
+
+	NewMutexKV().RUnlock("key1")
+}
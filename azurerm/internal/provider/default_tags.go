@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+)
+
+func schemaDefaultTags() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:         schema.TypeMap,
+					Optional:     true,
+					ValidateFunc: tags.Validate,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "The tags which should be applied to every resource which supports tags, unless the resource specifies a tag with the same key.",
+				},
+			},
+		},
+	}
+}
+
+func expandDefaultTags(input []interface{}) map[string]string {
+	defaultTags := make(map[string]string)
+	if len(input) == 0 || input[0] == nil {
+		return defaultTags
+	}
+
+	raw := input[0].(map[string]interface{})
+	for k, v := range raw["tags"].(map[string]interface{}) {
+		// Validate should have ignored this error already
+		value, _ := tags.TagValueToString(v)
+		defaultTags[k] = value
+	}
+
+	return defaultTags
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandDefaultTags(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected map[string]string
+	}{
+		{
+			Name:     "Not Specified",
+			Input:    []interface{}{},
+			Expected: map[string]string{},
+		},
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{nil},
+			Expected: map[string]string{},
+		},
+		{
+			Name: "Tags",
+			Input: []interface{}{
+				map[string]interface{}{
+					"tags": map[string]interface{}{
+						"cost_center": "1234",
+						"owner":       "platform",
+					},
+				},
+			},
+			Expected: map[string]string{
+				"cost_center": "1234",
+				"owner":       "platform",
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandDefaultTags(testCase.Input)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
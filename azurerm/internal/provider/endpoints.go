@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+func schemaEndpoints() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resource_manager": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					Description:  "The Resource Manager Endpoint which should be used, rather than the one defined by the Azure Environment.",
+				},
+
+				"graph": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					Description:  "The Graph (Azure Active Directory) Endpoint which should be used, rather than the one defined by the Azure Environment.",
+				},
+
+				"key_vault_dns_suffix": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+					Description:  "The DNS Suffix used to access Key Vaults (for example `vault.azure.net`), rather than the one defined by the Azure Environment.",
+				},
+
+				"storage_dns_suffix": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+					Description:  "The DNS Suffix used to access Storage Accounts (for example `core.windows.net`), rather than the one defined by the Azure Environment.",
+				},
+
+				"synapse_dns_suffix": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+					Description:  "The DNS Suffix used to access Synapse Workspaces (for example `dev.azuresynapse.net`), rather than the one defined by the Azure Environment.",
+				},
+			},
+		},
+	}
+}
+
+func expandEndpoints(input []interface{}) common.EndpointOptions {
+	if len(input) == 0 || input[0] == nil {
+		return common.EndpointOptions{}
+	}
+
+	val := input[0].(map[string]interface{})
+	return common.EndpointOptions{
+		ResourceManager:   val["resource_manager"].(string),
+		Graph:             val["graph"].(string),
+		KeyVaultDNSSuffix: val["key_vault_dns_suffix"].(string),
+		StorageDNSSuffix:  val["storage_dns_suffix"].(string),
+		SynapseDNSSuffix:  val["synapse_dns_suffix"].(string),
+	}
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+func TestExpandEndpoints(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected common.EndpointOptions
+	}{
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{},
+			Expected: common.EndpointOptions{},
+		},
+		{
+			Name: "Default Values",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_manager":     "",
+					"graph":                "",
+					"key_vault_dns_suffix": "",
+					"storage_dns_suffix":   "",
+					"synapse_dns_suffix":   "",
+				},
+			},
+			Expected: common.EndpointOptions{},
+		},
+		{
+			Name: "Complete",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_manager":     "https://gateway.example.com/arm/",
+					"graph":                "https://gateway.example.com/graph/",
+					"key_vault_dns_suffix": "privatelink.vaultcore.azure.net",
+					"storage_dns_suffix":   "privatelink.core.windows.net",
+					"synapse_dns_suffix":   "privatelink.dev.azuresynapse.net",
+				},
+			},
+			Expected: common.EndpointOptions{
+				ResourceManager:   "https://gateway.example.com/arm/",
+				Graph:             "https://gateway.example.com/graph/",
+				KeyVaultDNSSuffix: "privatelink.vaultcore.azure.net",
+				StorageDNSSuffix:  "privatelink.core.windows.net",
+				SynapseDNSSuffix:  "privatelink.dev.azuresynapse.net",
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandEndpoints(testCase.Input)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func schemaIgnoreTags() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The keys of tags which should be ignored on every resource which supports tags.",
+				},
+
+				"key_prefixes": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The prefixes of the keys of tags which should be ignored on every resource which supports tags.",
+				},
+			},
+		},
+	}
+}
+
+func expandIgnoreTags(input []interface{}) tags.IgnoreTags {
+	ignoreTags := tags.IgnoreTags{
+		Keys:        make([]string, 0),
+		KeyPrefixes: make([]string, 0),
+	}
+	if len(input) == 0 || input[0] == nil {
+		return ignoreTags
+	}
+
+	raw := input[0].(map[string]interface{})
+	if v, ok := raw["keys"].(*schema.Set); ok {
+		ignoreTags.Keys = *utils.ExpandStringSlice(v.List())
+	}
+	if v, ok := raw["key_prefixes"].(*schema.Set); ok {
+		ignoreTags.KeyPrefixes = *utils.ExpandStringSlice(v.List())
+	}
+
+	return ignoreTags
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestExpandIgnoreTags(t *testing.T) {
+	testData := []struct {
+		Name                string
+		Input               []interface{}
+		ExpectedKeys        []string
+		ExpectedKeyPrefixes []string
+	}{
+		{
+			Name:                "Not Specified",
+			Input:               []interface{}{},
+			ExpectedKeys:        []string{},
+			ExpectedKeyPrefixes: []string{},
+		},
+		{
+			Name:                "Empty Block",
+			Input:               []interface{}{nil},
+			ExpectedKeys:        []string{},
+			ExpectedKeyPrefixes: []string{},
+		},
+		{
+			Name: "Keys and Prefixes",
+			Input: []interface{}{
+				map[string]interface{}{
+					"keys":         schema.NewSet(schema.HashString, []interface{}{"ms-resource-usage", "CreatedOnDate"}),
+					"key_prefixes": schema.NewSet(schema.HashString, []interface{}{"hidden-link:"}),
+				},
+			},
+			ExpectedKeys:        []string{"CreatedOnDate", "ms-resource-usage"},
+			ExpectedKeyPrefixes: []string{"hidden-link:"},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandIgnoreTags(testCase.Input)
+		keys, keyPrefixes := result.Keys, result.KeyPrefixes
+		sort.Strings(keys)
+		if !reflect.DeepEqual(keys, testCase.ExpectedKeys) {
+			t.Fatalf("Expected the keys %+v but got %+v", testCase.ExpectedKeys, keys)
+		}
+		if !reflect.DeepEqual(keyPrefixes, testCase.ExpectedKeyPrefixes) {
+			t.Fatalf("Expected the key prefixes %+v but got %+v", testCase.ExpectedKeyPrefixes, keyPrefixes)
+		}
+	}
+}
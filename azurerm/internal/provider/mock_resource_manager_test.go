@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/mockarm"
+)
+
+const mockResourceManagerSubscriptionId = "12345678-1234-9876-4563-123456789012"
+
+func TestMockResourceManagerResourceGroup(t *testing.T) {
+	server := mockarm.NewServer()
+	defer server.Close()
+
+	resourceName := "azurerm_resource_group.test"
+	updatedTags := `environment = "production"
+    cost_center = "finance"`
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"azurerm": TestAzureProviderWithOverrides(TestOverrides{
+				MockResourceManagerEndpoint: server.Endpoint(),
+			}),
+		},
+		CheckDestroy: testCheckMockResourceGroupDestroyed(server, "acctestRG-mock"),
+		Steps: []resource.TestStep{
+			{
+				Config: testMockResourceManagerResourceGroupConfig(`environment = "test"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-mock", mockResourceManagerSubscriptionId)),
+					resource.TestCheckResourceAttr(resourceName, "location", "westeurope"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "test"),
+				),
+			},
+			{
+				Config: testMockResourceManagerResourceGroupConfig(updatedTags),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "production"),
+					resource.TestCheckResourceAttr(resourceName, "tags.cost_center", "finance"),
+				),
+			},
+			{
+				Config:            testMockResourceManagerResourceGroupConfig(updatedTags),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestMockResourceManagerResourceGroupDefaultTags(t *testing.T) {
+	server := mockarm.NewServer()
+	defer server.Close()
+
+	resourceName := "azurerm_resource_group.test"
+	aliasedResourceName := "azurerm_resource_group.aliased"
+	resource.UnitTest(t, resource.TestCase{
+		// each Provider block is configured using a separate instance of the Provider, as it is by Terraform
+		ProviderFactories: map[string]terraform.ResourceProviderFactory{
+			"azurerm": func() (terraform.ResourceProvider, error) {
+				return TestAzureProviderWithOverrides(TestOverrides{
+					MockResourceManagerEndpoint: server.Endpoint(),
+				}), nil
+			},
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckMockResourceGroupDestroyed(server, "acctestRG-mock"),
+			testCheckMockResourceGroupDestroyed(server, "acctestRG-mock-aliased"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testMockResourceManagerResourceGroupDefaultTagsConfig("finance"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "test"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.cost_center", "finance"),
+					resource.TestCheckResourceAttr(aliasedResourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(aliasedResourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(aliasedResourceName, "tags_all.cost_center", "networking"),
+				),
+			},
+			{
+				Config: testMockResourceManagerResourceGroupDefaultTagsConfig("platform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.cost_center", "platform"),
+					resource.TestCheckResourceAttr(aliasedResourceName, "tags_all.cost_center", "networking"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckMockResourceGroupDestroyed(server *mockarm.Server, name string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		uri := fmt.Sprintf("%ssubscriptions/%s/resourceGroups/%s?api-version=2020-06-01", server.Endpoint(), mockResourceManagerSubscriptionId, name)
+		req, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, uri, nil)
+		if err != nil {
+			return fmt.Errorf("building request: %+v", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("retrieving Resource Group %q: %+v", name, err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("expected Resource Group %q to be deleted but got %d", name, resp.StatusCode)
+		}
+		return nil
+	}
+}
+
+func testMockResourceManagerResourceGroupConfig(tags string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+
+  subscription_id            = %q
+  tenant_id                  = "22222222-2222-2222-2222-222222222222"
+  client_id                  = "33333333-3333-3333-3333-333333333333"
+  client_secret              = "mock"
+  skip_provider_registration = true
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mock"
+  location = "West Europe"
+
+  tags = {
+    %s
+  }
+}
+`, mockResourceManagerSubscriptionId, tags)
+}
+
+func testMockResourceManagerResourceGroupDefaultTagsConfig(costCenter string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+
+  subscription_id            = %[1]q
+  tenant_id                  = "22222222-2222-2222-2222-222222222222"
+  client_id                  = "33333333-3333-3333-3333-333333333333"
+  client_secret              = "mock"
+  skip_provider_registration = true
+
+  default_tags {
+    tags = {
+      cost_center = %[2]q
+    }
+  }
+}
+
+provider "azurerm" {
+  features {}
+
+  alias                      = "aliased"
+  subscription_id            = %[1]q
+  tenant_id                  = "22222222-2222-2222-2222-222222222222"
+  client_id                  = "33333333-3333-3333-3333-333333333333"
+  client_secret              = "mock"
+  skip_provider_registration = true
+
+  default_tags {
+    tags = {
+      cost_center = "networking"
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mock"
+  location = "West Europe"
+
+  tags = {
+    environment = "test"
+  }
+}
+
+resource "azurerm_resource_group" "aliased" {
+  provider = azurerm.aliased
+  name     = "acctestRG-mock-aliased"
+  location = "West Europe"
+
+  tags = {
+    environment = "test"
+  }
+}
+`, mockResourceManagerSubscriptionId, costCenter)
+}
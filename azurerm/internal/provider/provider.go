@@ -13,8 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -26,6 +28,23 @@ func TestAzureProvider() terraform.ResourceProvider {
 	return azureProvider(true)
 }
 
+// TestOverrides overrides how the Provider used in the Acceptance Tests sends requests
+type TestOverrides struct {
+	// Recording records (or replays) the requests sent by the clients, when set
+	Recording recording.Transport
+
+	// MockResourceManagerEndpoint is the endpoint of a mock Resource Manager which requests are sent to
+	// (without authenticating), when set
+	MockResourceManagerEndpoint string
+}
+
+// TestAzureProviderWithOverrides returns the Provider used in the Acceptance Tests, configured using the specified overrides
+func TestAzureProviderWithOverrides(overrides TestOverrides) terraform.ResourceProvider {
+	p := azureProvider(true).(*schema.Provider)
+	p.ConfigureFunc = providerConfigure(p, overrides)
+	return p
+}
+
 func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 	// avoids this showing up in test output
 	debugLog := func(f string, v ...interface{}) {
@@ -98,6 +117,14 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 		}
 	}
 
+	// resources which support tags have the default tags, ignored tags and tag policy from the Provider block applied to them
+	for name, resource := range resources {
+		tags.ExtendResource(name, resource, tagSettings)
+	}
+	for _, dataSource := range dataSources {
+		tags.ExtendDataSource(dataSource, tagSettings)
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -212,8 +239,27 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 				Description: "This will disable the Terraform Partner ID which is used if a custom `partner_id` isn't specified.",
 			},
 
+			"default_tags": schemaDefaultTags(),
+
+			"endpoints": schemaEndpoints(),
+
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"ignore_tags": schemaIgnoreTags(),
+
+			"rate_limit": schemaRateLimit(),
+
+			"retry": schemaRetry(),
+
+			"tag_policy": schemaTagPolicy(),
+
+			"wire_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_WIRE_TRACE_FILE", ""),
+				Description: "The path to a file which each request sent to Azure (and the response to it) should be written to, with any secrets redacted.",
+			},
+
 			// Advanced feature flags
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
@@ -243,12 +289,12 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 		}
 	}
 
-	p.ConfigureFunc = providerConfigure(p)
+	p.ConfigureFunc = providerConfigure(p, TestOverrides{})
 
 	return p
 }
 
-func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
+func providerConfigure(p *schema.Provider, overrides TestOverrides) schema.ConfigureFunc {
 	return func(d *schema.ResourceData) (interface{}, error) {
 		var auxTenants []string
 		if v, ok := d.Get("auxiliary_tenant_ids").([]interface{}); ok && len(v) > 0 {
@@ -305,16 +351,35 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			terraformVersion = "0.11+compatible"
 		}
 
+		tagPolicy, err := expandTagPolicy(d.Get("tag_policy").([]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("expanding `tag_policy`: %+v", err)
+		}
+
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
 		clientBuilder := clients.ClientBuilder{
 			AuthConfig:                  config,
+			DisableAuthentication:       overrides.MockResourceManagerEndpoint != "",
 			SkipProviderRegistration:    skipProviderRegistration,
 			TerraformVersion:            terraformVersion,
 			PartnerId:                   d.Get("partner_id").(string),
 			DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 			DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
+			Endpoints:                   expandEndpoints(d.Get("endpoints").([]interface{})),
 			Features:                    expandFeatures(d.Get("features").([]interface{})),
+			RateLimit:                   expandRateLimit(d.Get("rate_limit").([]interface{})),
+			Recording:                   overrides.Recording,
+			Retry:                       expandRetry(d.Get("retry").([]interface{})),
 			StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
+			Tags: tags.Settings{
+				DefaultTags: expandDefaultTags(d.Get("default_tags").([]interface{})),
+				IgnoreTags:  expandIgnoreTags(d.Get("ignore_tags").([]interface{})),
+				Policy:      tagPolicy,
+			},
+			WireTraceFile: d.Get("wire_trace_file").(string),
+		}
+		if overrides.MockResourceManagerEndpoint != "" {
+			clientBuilder.Endpoints.ResourceManager = overrides.MockResourceManagerEndpoint
 		}
 		client, err := clients.Build(p.StopContext(), clientBuilder)
 		if err != nil {
@@ -327,7 +392,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			// List all the available providers and their registration state to avoid unnecessary
 			// requests. This also lets us check if the provider credentials are correct.
 			ctx := client.StopContext
-			providerList, err := client.Resource.ProvidersClient.List(ctx, nil, "")
+			providerList, err := client.Resource().ProvidersClient.List(ctx, nil, "")
 			if err != nil {
 				return nil, fmt.Errorf("Unable to list provider registration status, it is possible that this is due to invalid "+
 					"credentials or the service principal does not have permission to use the Resource Manager API, Azure "+
@@ -337,7 +402,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			availableResourceProviders := providerList.Values()
 			requiredResourceProviders := resourceproviders.Required()
 
-			if err := resourceproviders.EnsureRegistered(ctx, *client.Resource.ProvidersClient, availableResourceProviders, requiredResourceProviders); err != nil {
+			if err := resourceproviders.EnsureRegistered(ctx, *client.Resource().ProvidersClient, availableResourceProviders, requiredResourceProviders); err != nil {
 				return nil, fmt.Errorf(resourceProviderRegistrationErrorFmt, err)
 			}
 		}
@@ -346,6 +411,11 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 	}
 }
 
+// tagSettings returns the settings for tags from the Provider block, which are configured on the Client
+func tagSettings(meta interface{}) tags.Settings {
+	return meta.(*clients.Client).Tags
+}
+
 const resourceProviderRegistrationErrorFmt = `Error ensuring Resource Providers are registered.
 
 Terraform automatically attempts to register the Resource Providers it supports to
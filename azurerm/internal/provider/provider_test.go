@@ -2,10 +2,18 @@ package provider
 
 import (
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 func TestProvider(t *testing.T) {
@@ -86,6 +94,98 @@ func TestResourcesSupportCustomTimeouts(t *testing.T) {
 	}
 }
 
+func TestResourcesUpdateTagsWhenDefaultTagsChange(t *testing.T) {
+	// the default tags can change without the tags for a resource changing - in which case only `tags_all` changes,
+	// so a resource which only updates the tags when these change has to check for changes to both
+	fileSet := token.NewFileSet()
+	err := filepath.Walk("../services", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fileSet, path, nil, 0)
+		if err != nil {
+			return fmt.Errorf("parsing %q: %+v", path, err)
+		}
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			selector, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || (selector.Sel.Name != "HasChange" && selector.Sel.Name != "HasChanges") {
+				return true
+			}
+
+			keys := make(map[string]bool)
+			for _, arg := range call.Args {
+				if literal, ok := arg.(*ast.BasicLit); ok && literal.Kind == token.STRING {
+					if key, err := strconv.Unquote(literal.Value); err == nil {
+						keys[key] = true
+					}
+				}
+			}
+			if keys["tags"] && !keys["tags_all"] {
+				t.Errorf("%s: checks for changes to `tags` but not `tags_all` - use `d.HasChanges(\"tags\", \"tags_all\")` so the default tags are updated when they change", fileSet.Position(call.Pos()))
+			}
+
+			return true
+		})
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("checking the Resources: %+v", err)
+	}
+}
+
 func TestProvider_impl(t *testing.T) {
 	_ = AzureProvider()
 }
+
+func TestResourceIDRegistryMapsToResources(t *testing.T) {
+	// these Resource ID's are for items nested within another Resource (or are only used by Data Sources)
+	// and as such can't be imported
+	exempt := map[string]struct{}{
+		"frontdoor/BackendPool":                            {},
+		"frontdoor/HealthProbe":                            {},
+		"frontdoor/LoadBalancing":                          {},
+		"frontdoor/RoutingRule":                            {},
+		"loadbalancer/LoadBalancerFrontendIpConfiguration": {},
+		"mssql/RecoverableDatabase":                        {},
+		"network/PrivateDnsZoneConfig":                     {},
+		"network/PrivateDnsZoneGroup":                      {},
+		"network/VirtualNetworkGatewayIpConfiguration":     {},
+		"network/VpnSiteLink":                              {},
+		"sentinel/SentinelAlertRuleTemplate":               {},
+		"storage/StorageContainerResourceManager":          {},
+		"storage/StorageShareResourceManager":              {},
+	}
+
+	provider := TestAzureProvider().(*schema.Provider)
+	for _, id := range resourceid.RegisteredIDs() {
+		key := fmt.Sprintf("%s/%s", id.ServicePackageName, id.Name)
+		t.Logf("[DEBUG] Testing Resource ID %q..", key)
+
+		if _, ok := exempt[key]; ok {
+			if len(id.ResourceTypes) > 0 {
+				t.Fatalf("Resource ID %q is exempt but is used by %q - remove it from the exemptions", key, id.ResourceTypes)
+			}
+			continue
+		}
+
+		if len(id.ResourceTypes) == 0 {
+			t.Fatalf("Resource ID %q isn't used by any Resource - specify the `-resource-type` in the `go:generate` line", key)
+		}
+
+		for _, resourceType := range id.ResourceTypes {
+			if _, ok := provider.ResourcesMap[resourceType]; !ok {
+				t.Fatalf("Resource ID %q is used by %q which isn't a registered Resource", key, resourceType)
+			}
+		}
+	}
+}
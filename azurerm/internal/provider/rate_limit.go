@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+func schemaRateLimit() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"reads_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Default:      25,
+					ValidateFunc: validation.FloatAtLeast(0.001),
+					Description:  "The number of read requests per second which can be sent to Azure Resource Manager, per Subscription/Tenant.",
+				},
+
+				"read_burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      250,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of read requests which can be sent to Azure Resource Manager without being delayed, per Subscription/Tenant.",
+				},
+
+				"writes_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Default:      10,
+					ValidateFunc: validation.FloatAtLeast(0.001),
+					Description:  "The number of write requests per second which can be sent to Azure Resource Manager, per Subscription/Tenant.",
+				},
+
+				"write_burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      200,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of write requests which can be sent to Azure Resource Manager without being delayed, per Subscription/Tenant.",
+				},
+			},
+		},
+	}
+}
+
+func expandRateLimit(input []interface{}) common.RateLimitOptions {
+	// these are the defaults if omitted from the config - where rate limiting is disabled
+	rateLimit := common.DefaultRateLimitOptions()
+
+	if len(input) == 0 {
+		return rateLimit
+	}
+
+	// rate limiting is opt-in, so is enabled when the block is specified (even when empty)
+	rateLimit.Enabled = true
+	if input[0] == nil {
+		return rateLimit
+	}
+
+	val := input[0].(map[string]interface{})
+
+	if v, ok := val["reads_per_second"]; ok {
+		rateLimit.ReadsPerSecond = v.(float64)
+	}
+	if v, ok := val["read_burst"]; ok {
+		rateLimit.ReadBurst = v.(int)
+	}
+	if v, ok := val["writes_per_second"]; ok {
+		rateLimit.WritesPerSecond = v.(float64)
+	}
+	if v, ok := val["write_burst"]; ok {
+		rateLimit.WriteBurst = v.(int)
+	}
+
+	return rateLimit
+}
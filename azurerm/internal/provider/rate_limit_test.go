@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+func TestExpandRateLimit(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected common.RateLimitOptions
+	}{
+		{
+			Name:     "Not Specified",
+			Input:    []interface{}{},
+			Expected: common.DefaultRateLimitOptions(),
+		},
+		{
+			Name:  "Empty Block",
+			Input: []interface{}{nil},
+			Expected: common.RateLimitOptions{
+				Enabled:         true,
+				ReadsPerSecond:  25,
+				ReadBurst:       250,
+				WritesPerSecond: 10,
+				WriteBurst:      200,
+			},
+		},
+		{
+			Name: "Complete",
+			Input: []interface{}{
+				map[string]interface{}{
+					"reads_per_second":  3.5,
+					"read_burst":        100,
+					"writes_per_second": 0.5,
+					"write_burst":       20,
+				},
+			},
+			Expected: common.RateLimitOptions{
+				Enabled:         true,
+				ReadsPerSecond:  3.5,
+				ReadBurst:       100,
+				WritesPerSecond: 0.5,
+				WriteBurst:      20,
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandRateLimit(testCase.Input)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+func schemaRetry() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      5,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The maximum number of times a request should be sent, including the first attempt. Setting this to 1 disables retries.",
+				},
+
+				"base_backoff_in_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      2,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The number of seconds to wait before the first retry, which is doubled for each subsequent retry.",
+				},
+
+				"max_backoff_in_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      60,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The maximum number of seconds to wait between retries.",
+				},
+
+				"respect_retry_after": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Should the delay specified in the `Retry-After` header returned by Azure be used, rather than the backoff?",
+				},
+
+				"retryable_status_codes": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeInt,
+						ValidateFunc: validation.IntBetween(http.StatusBadRequest, 599),
+					},
+					Description: "A list of HTTP Status Codes which should be retried.",
+				},
+
+				"retryable_error_codes": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "A list of ARM Error Codes (for example `RetryableError`) which should be retried, regardless of the HTTP Status Code.",
+				},
+			},
+		},
+	}
+}
+
+func expandRetry(input []interface{}) common.RetryOptions {
+	// these are the defaults if omitted from the config
+	retry := common.DefaultRetryOptions()
+
+	if len(input) == 0 || input[0] == nil {
+		return retry
+	}
+
+	val := input[0].(map[string]interface{})
+
+	if v, ok := val["max_attempts"]; ok {
+		retry.MaxAttempts = v.(int)
+	}
+	if v, ok := val["base_backoff_in_seconds"]; ok {
+		retry.BaseBackoff = time.Duration(v.(int)) * time.Second
+	}
+	if v, ok := val["max_backoff_in_seconds"]; ok {
+		retry.MaxBackoff = time.Duration(v.(int)) * time.Second
+	}
+	if v, ok := val["respect_retry_after"]; ok {
+		retry.RespectRetryAfter = v.(bool)
+	}
+
+	if raw, ok := val["retryable_status_codes"].(*schema.Set); ok && raw.Len() > 0 {
+		statusCodes := make([]int, 0)
+		for _, v := range raw.List() {
+			statusCodes = append(statusCodes, v.(int))
+		}
+		retry.RetryableStatusCodes = statusCodes
+	}
+
+	if raw, ok := val["retryable_error_codes"].(*schema.Set); ok && raw.Len() > 0 {
+		errorCodes := make([]string, 0)
+		for _, v := range raw.List() {
+			errorCodes = append(errorCodes, v.(string))
+		}
+		retry.RetryableErrorCodes = errorCodes
+	}
+
+	return retry
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+func TestExpandRetry(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected common.RetryOptions
+	}{
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{},
+			Expected: common.DefaultRetryOptions(),
+		},
+		{
+			Name: "Default Values",
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_attempts":            5,
+					"base_backoff_in_seconds": 2,
+					"max_backoff_in_seconds":  60,
+					"respect_retry_after":     true,
+					"retryable_status_codes":  schema.NewSet(schema.HashInt, []interface{}{}),
+					"retryable_error_codes":   schema.NewSet(schema.HashString, []interface{}{}),
+				},
+			},
+			Expected: common.DefaultRetryOptions(),
+		},
+		{
+			Name: "Complete",
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_attempts":            10,
+					"base_backoff_in_seconds": 1,
+					"max_backoff_in_seconds":  30,
+					"respect_retry_after":     false,
+					"retryable_status_codes":  schema.NewSet(schema.HashInt, []interface{}{429}),
+					"retryable_error_codes":   schema.NewSet(schema.HashString, []interface{}{"AnotherOperationInProgress"}),
+				},
+			},
+			Expected: common.RetryOptions{
+				MaxAttempts:          10,
+				BaseBackoff:          1 * time.Second,
+				MaxBackoff:           30 * time.Second,
+				RespectRetryAfter:    false,
+				RetryableStatusCodes: []int{429},
+				RetryableErrorCodes:  []string{"AnotherOperationInProgress"},
+			},
+		},
+		{
+			Name: "Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_attempts":            1,
+					"base_backoff_in_seconds": 2,
+					"max_backoff_in_seconds":  60,
+					"respect_retry_after":     true,
+				},
+			},
+			Expected: func() common.RetryOptions {
+				o := common.DefaultRetryOptions()
+				o.MaxAttempts = 1
+				return o
+			}(),
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandRetry(testCase.Input)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
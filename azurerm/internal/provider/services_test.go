@@ -3,6 +3,7 @@ package provider
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
 )
 
@@ -31,3 +32,42 @@ func TestTypedResourcesContainValidModelObjects(t *testing.T) {
 		}
 	}
 }
+
+func TestTypedDataSourcesModelObjectsMatchSchema(t *testing.T) {
+	for _, service := range SupportedTypedServices() {
+		t.Logf("Service %q..", service.Name())
+		for _, resource := range service.DataSources() {
+			t.Logf("- DataSources %q..", resource.ResourceType())
+			obj := resource.ModelObject()
+			resourceSchema := combinedSchema(resource.Arguments(), resource.Attributes())
+			if err := sdk.ValidateModelObjectMatchesSchema(&obj, resourceSchema); err != nil {
+				t.Fatalf("validating model for %q: %+v", resource.ResourceType(), err)
+			}
+		}
+	}
+}
+
+func TestTypedResourcesModelObjectsMatchSchema(t *testing.T) {
+	for _, service := range SupportedTypedServices() {
+		t.Logf("Service %q..", service.Name())
+		for _, resource := range service.Resources() {
+			t.Logf("- Resource %q..", resource.ResourceType())
+			obj := resource.ModelObject()
+			resourceSchema := combinedSchema(resource.Arguments(), resource.Attributes())
+			if err := sdk.ValidateModelObjectMatchesSchema(&obj, resourceSchema); err != nil {
+				t.Fatalf("validating model for %q: %+v", resource.ResourceType(), err)
+			}
+		}
+	}
+}
+
+func combinedSchema(arguments map[string]*schema.Schema, attributes map[string]*schema.Schema) map[string]*schema.Schema {
+	out := make(map[string]*schema.Schema)
+	for k, v := range arguments {
+		out[k] = v
+	}
+	for k, v := range attributes {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func schemaTagPolicy() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"required_keys": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The keys of tags which must be specified on every resource which supports tags.",
+				},
+
+				"max_count": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 50),
+					Description:  "The maximum number of tags which can be specified on each resource.",
+				},
+
+				"key_case": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  string(tags.KeyCaseAny),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(tags.KeyCaseAny),
+						string(tags.KeyCaseLower),
+						string(tags.KeyCaseUpper),
+					}, false),
+					Description: "The case which the keys of tags must use. Possible values are `any`, `lower` and `upper`.",
+				},
+
+				"key": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The key of the tag which this policy applies to.",
+							},
+
+							"allowed_values": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+								Description: "The values which can be specified for this tag.",
+							},
+
+							"case_sensitive": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Should the `allowed_values` be compared case-sensitively?",
+							},
+
+							"pattern": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsValidRegExp,
+								Description:  "A regular expression which the value for this tag must match.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandTagPolicy(input []interface{}) (*tags.Policy, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	policy := tags.Policy{
+		RequiredKeys: make([]string, 0),
+		KeyCase:      tags.KeyCaseAny,
+		Keys:         make([]tags.KeyPolicy, 0),
+	}
+	if input[0] == nil {
+		return &policy, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	if v, ok := raw["required_keys"].(*schema.Set); ok {
+		policy.RequiredKeys = *utils.ExpandStringSlice(v.List())
+	}
+	if v, ok := raw["max_count"].(int); ok {
+		policy.MaxCount = v
+	}
+	if v, ok := raw["key_case"].(string); ok && v != "" {
+		policy.KeyCase = tags.KeyCase(v)
+	}
+
+	keys, _ := raw["key"].([]interface{})
+	for _, item := range keys {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		keyPolicy := tags.KeyPolicy{
+			Key:           v["name"].(string),
+			AllowedValues: make([]string, 0),
+			CaseSensitive: v["case_sensitive"].(bool),
+		}
+		if allowedValues, ok := v["allowed_values"].(*schema.Set); ok {
+			keyPolicy.AllowedValues = *utils.ExpandStringSlice(allowedValues.List())
+		}
+		if pattern := v["pattern"].(string); pattern != "" {
+			expression, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("compiling the `pattern` for the tag %q: %+v", keyPolicy.Key, err)
+			}
+			keyPolicy.Pattern = expression
+		}
+
+		policy.Keys = append(policy.Keys, keyPolicy)
+	}
+
+	return &policy, nil
+}
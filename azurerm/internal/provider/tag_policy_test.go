@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+)
+
+func TestExpandTagPolicy(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected *tags.Policy
+	}{
+		{
+			Name:     "Not Specified",
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Name:  "Empty Block",
+			Input: []interface{}{nil},
+			Expected: &tags.Policy{
+				RequiredKeys: []string{},
+				KeyCase:      tags.KeyCaseAny,
+				Keys:         []tags.KeyPolicy{},
+			},
+		},
+		{
+			Name: "Complete",
+			Input: []interface{}{
+				map[string]interface{}{
+					"required_keys": schema.NewSet(schema.HashString, []interface{}{"owner"}),
+					"max_count":     20,
+					"key_case":      "lower",
+					"key": []interface{}{
+						map[string]interface{}{
+							"name":           "environment",
+							"allowed_values": schema.NewSet(schema.HashString, []interface{}{"production"}),
+							"case_sensitive": true,
+							"pattern":        "",
+						},
+					},
+				},
+			},
+			Expected: &tags.Policy{
+				RequiredKeys: []string{"owner"},
+				MaxCount:     20,
+				KeyCase:      tags.KeyCaseLower,
+				Keys: []tags.KeyPolicy{
+					{
+						Key:           "environment",
+						AllowedValues: []string{"production"},
+						CaseSensitive: true,
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result, err := expandTagPolicy(testCase.Input)
+		if err != nil {
+			t.Fatalf("expanding: %+v", err)
+		}
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
+
+func TestExpandTagPolicyPattern(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"required_keys": schema.NewSet(schema.HashString, []interface{}{}),
+			"max_count":     0,
+			"key_case":      "any",
+			"key": []interface{}{
+				map[string]interface{}{
+					"name":           "cost_center",
+					"allowed_values": schema.NewSet(schema.HashString, []interface{}{}),
+					"case_sensitive": false,
+					"pattern":        "^[0-9]{4}$",
+				},
+			},
+		},
+	}
+
+	result, err := expandTagPolicy(input)
+	if err != nil {
+		t.Fatalf("expanding: %+v", err)
+	}
+	if len(result.Keys) != 1 || result.Keys[0].Pattern == nil || !result.Keys[0].Pattern.MatchString("1234") {
+		t.Fatalf("Expected the pattern to be compiled but got %+v", result.Keys)
+	}
+
+	input[0].(map[string]interface{})["key"].([]interface{})[0].(map[string]interface{})["pattern"] = "["
+	if _, err := expandTagPolicy(input); err == nil {
+		t.Fatalf("Expected an error for an invalid pattern but didn't get one")
+	}
+}
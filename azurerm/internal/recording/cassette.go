@@ -0,0 +1,93 @@
+package recording
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+)
+
+// cassette is the recording of the requests made during a single test, which is stored as JSON
+type cassette struct {
+	// Variables are the values generated for the test (e.g. random integers) which must match when replaying
+	Variables map[string]string `json:"variables"`
+
+	// Interactions are the requests made during the test, in the order these were sent
+	Interactions []interaction `json:"interactions"`
+}
+
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+
+	// BodyIsBase64 specifies whether the Body is Base64 encoded, which is the case when this isn't valid UTF-8
+	BodyIsBase64 bool `json:"bodyIsBase64,omitempty"`
+}
+
+// encodeBody returns the body in a form which can be stored as a JSON string, and whether this is Base64 encoded
+func encodeBody(body []byte) (string, bool) {
+	if utf8.Valid(body) {
+		return string(body), false
+	}
+
+	return base64.StdEncoding.EncodeToString(body), true
+}
+
+func (r recordedResponse) body() ([]byte, error) {
+	if r.BodyIsBase64 {
+		return base64.StdEncoding.DecodeString(r.Body)
+	}
+
+	return []byte(r.Body), nil
+}
+
+func loadCassette(path string) (*cassette, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading recording %q: %+v", path, err)
+	}
+
+	var out cassette
+	if err := json.Unmarshal(contents, &out); err != nil {
+		return nil, fmt.Errorf("deserializing recording %q: %+v", path, err)
+	}
+
+	if out.Variables == nil {
+		out.Variables = make(map[string]string)
+	}
+
+	return &out, nil
+}
+
+func (c cassette) save(path string) error {
+	contents, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing recording: %+v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating directory for recording %q: %+v", path, err)
+	}
+
+	if err := ioutil.WriteFile(path, append(contents, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing recording %q: %+v", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,288 @@
+package recording
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// Recorder records the requests made during a single test, or replays the responses to these from a recording
+type Recorder struct {
+	mode Mode
+	path string
+
+	lock      sync.Mutex
+	cassette  *cassette
+	sanitizer sanitizer
+
+	// used tracks which of the recorded interactions have been replayed
+	used []bool
+
+	// lastMatch is the index of the interaction last replayed for each request, which is replayed again once each
+	// of the matching interactions have been used - for example when polling a long-running operation
+	lastMatch map[string]int
+
+	// paths are the paths of the resources requested during this test, which are used to determine
+	// which Recorder should be used for requests sent by the shared clients
+	paths map[string]struct{}
+}
+
+// NewRecorder returns a Recorder for the test with the specified name, or nil when recording is disabled
+func NewRecorder(name string) (*Recorder, error) {
+	mode, err := CurrentMode()
+	if err != nil {
+		return nil, err
+	}
+	if mode == ModeLive {
+		return nil, nil
+	}
+
+	return newRecorder(mode, filepath.Join(Path(), filepath.FromSlash(name)+".json"))
+}
+
+func newRecorder(mode Mode, path string) (*Recorder, error) {
+	recorder := &Recorder{
+		mode: mode,
+		path: path,
+		cassette: &cassette{
+			Variables: make(map[string]string),
+		},
+		lastMatch: make(map[string]int),
+		paths:     make(map[string]struct{}),
+	}
+
+	if mode == ModeReplay {
+		c, err := loadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		recorder.cassette = c
+		recorder.used = make([]bool, len(c.Interactions))
+	}
+
+	register(recorder)
+	return recorder, nil
+}
+
+// File returns the path to the recording used by this Recorder
+func (r *Recorder) File() string {
+	return r.path
+}
+
+// Replaying returns whether responses are served from the recording, rather than by Azure
+func (r *Recorder) Replaying() bool {
+	return r.mode == ModeReplay
+}
+
+// SetIdentity specifies the identity used to authenticate, which is redacted from the recording
+func (r *Recorder) SetIdentity(identity Identity) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.sanitizer = newSanitizer(identity)
+}
+
+// Variable returns the value of the named Variable (for example a random integer) for this test - which
+// is loaded from the recording when replaying, and otherwise is the specified value (which is recorded)
+func (r *Recorder) Variable(name, value string) (string, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if v, ok := r.cassette.Variables[name]; ok {
+		return v, nil
+	}
+
+	if r.mode == ModeReplay {
+		return "", fmt.Errorf("the variable %q was not found in the recording %q - this test needs to be recorded again", name, r.path)
+	}
+
+	r.cassette.Variables[name] = value
+	return value, nil
+}
+
+// Stop completes the recording, which (when recording) is written to disk unless the test failed
+func (r *Recorder) Stop(failed bool) error {
+	unregister(r)
+
+	if r.mode != ModeRecord || failed {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.cassette.save(r.path)
+}
+
+// SendDecorator returns a SendDecorator which records or replays each request
+func (r *Recorder) SendDecorator() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+			if r.mode == ModeReplay {
+				return r.replay(req)
+			}
+
+			return r.record(s, req)
+		})
+	}
+}
+
+func (r *Recorder) record(s autorest.Sender, req *http.Request) (*http.Response, error) {
+	requestBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading the body of the %s request to %q: %+v", req.Method, req.URL, err)
+	}
+
+	resp, err := s.Do(req)
+	if err != nil {
+		// a request which failed to be sent has no response to record
+		return resp, err
+	}
+
+	responseBody, err := readBody(&resp.Body)
+	if err != nil {
+		return resp, fmt.Errorf("reading the body of the response to the %s request to %q: %+v", req.Method, req.URL, err)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	recorded := interaction{
+		Request: recordedRequest{
+			Method: req.Method,
+			URL:    r.sanitizer.url(req.URL),
+		},
+		Response: recordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    r.sanitizer.headers(resp.Header),
+		},
+	}
+	if body := r.sanitizer.body(req.URL, requestBody); utf8.Valid(body) {
+		recorded.Request.Body = string(body)
+	}
+	recorded.Response.Body, recorded.Response.BodyIsBase64 = encodeBody(r.sanitizer.body(req.URL, responseBody))
+
+	r.cassette.Interactions = append(r.cassette.Interactions, recorded)
+	r.trackPaths(req.URL, resp.Header)
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	uri := r.sanitizer.url(req.URL)
+	index, ok := r.match(req.Method, uri)
+	if !ok {
+		return nil, fmt.Errorf("no response was recorded for the %s request to %q in %q - this test needs to be recorded again", req.Method, uri, r.path)
+	}
+
+	recorded := r.cassette.Interactions[index].Response
+	body, err := recorded.body()
+	if err != nil {
+		return nil, fmt.Errorf("decoding the recorded response to the %s request to %q: %+v", req.Method, uri, err)
+	}
+	if !recorded.BodyIsBase64 {
+		body = []byte(r.sanitizer.restoreIdentity(string(body)))
+	}
+
+	headers := make(http.Header)
+	for k, values := range recorded.Headers {
+		for _, v := range values {
+			headers.Add(k, r.sanitizer.restoreIdentity(v))
+		}
+	}
+
+	// responses are replayed without delay, including when polling long-running operations
+	headers.Del("x-ms-retry-after-ms")
+	headers.Set("Retry-After", "0")
+
+	r.trackPaths(req.URL, headers)
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// match returns the index of the first unused interaction for this request - or once each of these has been
+// used, the index of the last one matched - which is how the polling of long-running operations is replayed
+func (r *Recorder) match(method, uri string) (int, bool) {
+	key := fmt.Sprintf("%s %s", strings.ToUpper(method), strings.ToLower(uri))
+
+	for i, v := range r.cassette.Interactions {
+		if r.used[i] || !strings.EqualFold(v.Request.Method, method) || !strings.EqualFold(v.Request.URL, uri) {
+			continue
+		}
+
+		r.used[i] = true
+		r.lastMatch[key] = i
+		return i, true
+	}
+
+	index, ok := r.lastMatch[key]
+	return index, ok
+}
+
+// owns returns whether this Recorder should be used for a request sent by the shared clients
+func (r *Recorder) owns(req *http.Request) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.paths[strings.ToLower(req.URL.Path)]; ok {
+		return true
+	}
+
+	if r.mode == ModeReplay {
+		uri := r.sanitizer.url(req.URL)
+		for _, v := range r.cassette.Interactions {
+			if strings.EqualFold(v.Request.Method, req.Method) && strings.EqualFold(v.Request.URL, uri) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// trackPaths tracks the path of the request, along with the paths used to poll any long-running operation
+func (r *Recorder) trackPaths(u *url.URL, headers http.Header) {
+	r.paths[strings.ToLower(u.Path)] = struct{}{}
+
+	for _, header := range []string{"Azure-AsyncOperation", "Location"} {
+		if v := headers.Get(header); v != "" {
+			if parsed, err := url.Parse(v); err == nil {
+				r.paths[strings.ToLower(parsed.Path)] = struct{}{}
+			}
+		}
+	}
+}
+
+// readBody reads the body of a request or response, replacing it so that it can be read again
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	contents, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	*body = ioutil.NopCloser(bytes.NewReader(contents))
+	return contents, err
+}
@@ -0,0 +1,320 @@
+package recording
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	testSubscriptionId = "12345678-1234-9876-4563-123456789012"
+	testSecret         = "c2VjcmV0LWFjY2Vzcy1rZXk="
+)
+
+// newRecordingTestServer returns a server which creates a resource using a long-running operation, which
+// completes on the second poll, and which returns the Access Keys for this resource
+func newRecordingTestServer(t *testing.T) *httptest.Server {
+	var lock sync.Mutex
+	polls := 0
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			t.Errorf("expected the %s request to %q to be authorized", r.Method, r.URL.Path)
+		}
+
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/subscriptions/"+testSubscriptionId+"/resourceGroups/group1":
+			w.Header().Set("Azure-AsyncOperation", server.URL+"/subscriptions/"+testSubscriptionId+"/providers/Microsoft.Resources/operations/op1?api-version=2020-06-01")
+			w.Header().Set("Retry-After", "10")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id":"/subscriptions/`+testSubscriptionId+`/resourceGroups/group1","properties":{"provisioningState":"Creating"}}`)
+
+		case r.Method == http.MethodGet && r.URL.Path == "/subscriptions/"+testSubscriptionId+"/providers/Microsoft.Resources/operations/op1":
+			lock.Lock()
+			polls++
+			status := "InProgress"
+			if polls > 1 {
+				status = "Succeeded"
+			}
+			lock.Unlock()
+
+			w.Header().Set("Retry-After", "10")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `{"status":"`+status+`"}`)
+
+		case r.Method == http.MethodPost && r.URL.Path == "/subscriptions/"+testSubscriptionId+"/resourceGroups/group1/listKeys":
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `{"keys":[{"keyName":"key1","value":"`+testSecret+`"}],"primaryConnectionString":"Endpoint=sb://example;SharedAccessKey=`+testSecret+`"}`)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return server
+}
+
+func sendRecordingTestRequest(t *testing.T, transport Transport, method, uri string) (int, string, http.Header, error) {
+	req, err := http.NewRequestWithContext(context.TODO(), method, uri, strings.NewReader(`{"location":"westeurope"}`))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+testSecret)
+
+	resp, err := autorest.SendWithSender(http.DefaultClient, req, WithRecording(transport))
+	if err != nil {
+		return 0, "", nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading response: %+v", err)
+	}
+	return resp.StatusCode, string(body), resp.Header, nil
+}
+
+func stopRecorder(t *testing.T, recorder *Recorder) {
+	if err := recorder.Stop(false); err != nil {
+		t.Fatalf("stopping Recorder: %+v", err)
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	server := newRecordingTestServer(t)
+	path := filepath.Join(t.TempDir(), "TestAccExample", "basic.json")
+	identity := Identity{
+		SubscriptionId: testSubscriptionId,
+	}
+
+	requests := []struct {
+		Method         string
+		Path           string
+		ExpectedBody   string
+		ExpectedStatus int
+	}{
+		{
+			Method:         http.MethodPut,
+			Path:           "/subscriptions/" + testSubscriptionId + "/resourceGroups/group1",
+			ExpectedBody:   `{"id":"/subscriptions/` + testSubscriptionId + `/resourceGroups/group1","properties":{"provisioningState":"Creating"}}`,
+			ExpectedStatus: http.StatusCreated,
+		},
+		{
+			Method:         http.MethodGet,
+			Path:           "/subscriptions/" + testSubscriptionId + "/providers/Microsoft.Resources/operations/op1?api-version=2020-06-01",
+			ExpectedBody:   `{"status":"InProgress"}`,
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Method:         http.MethodGet,
+			Path:           "/subscriptions/" + testSubscriptionId + "/providers/Microsoft.Resources/operations/op1?api-version=2020-06-01",
+			ExpectedBody:   `{"status":"Succeeded"}`,
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Method:         http.MethodGet,
+			Path:           "/subscriptions/" + testSubscriptionId + "/resourceGroups/group2",
+			ExpectedStatus: http.StatusNotFound,
+		},
+	}
+
+	recorder, err := newRecorder(ModeRecord, path)
+	if err != nil {
+		t.Fatalf("building Recorder: %+v", err)
+	}
+	recorder.SetIdentity(identity)
+	for _, v := range requests {
+		if _, _, _, err = sendRecordingTestRequest(t, recorder, v.Method, server.URL+v.Path); err != nil {
+			t.Fatalf("sending %s request to %q: %+v", v.Method, v.Path, err)
+		}
+	}
+	if err = recorder.Stop(false); err != nil {
+		t.Fatalf("saving recording: %+v", err)
+	}
+	server.Close()
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading recording: %+v", err)
+	}
+	for _, v := range []string{testSubscriptionId, testSecret, "Bearer"} {
+		if strings.Contains(string(contents), v) {
+			t.Fatalf("expected %q to be redacted from the recording but got: %s", v, string(contents))
+		}
+	}
+
+	replayer, err := newRecorder(ModeReplay, path)
+	if err != nil {
+		t.Fatalf("building Recorder: %+v", err)
+	}
+	replayer.SetIdentity(identity)
+	defer stopRecorder(t, replayer)
+
+	// the operation is polled once more than when it was recorded, which should replay the final poll again
+	requests = append(requests, requests[2])
+	for _, v := range requests {
+		t.Logf("[DEBUG] Testing %s %q..", v.Method, v.Path)
+
+		status, body, headers, sendErr := sendRecordingTestRequest(t, replayer, v.Method, server.URL+v.Path)
+		if sendErr != nil {
+			t.Fatalf("expected no error but got: %+v", sendErr)
+		}
+		if status != v.ExpectedStatus {
+			t.Fatalf("expected the status %d but got %d", v.ExpectedStatus, status)
+		}
+		if body != v.ExpectedBody {
+			t.Fatalf("expected the body %q but got %q", v.ExpectedBody, body)
+		}
+		if retryAfter := headers.Get("Retry-After"); retryAfter != "0" {
+			t.Fatalf("expected the Retry-After header to be replayed as 0 but got %q", retryAfter)
+		}
+	}
+
+	if _, _, _, err = sendRecordingTestRequest(t, replayer, http.MethodDelete, server.URL+requests[0].Path); err == nil {
+		t.Fatalf("expected an error for a request which wasn't recorded but didn't get one")
+	}
+}
+
+func TestRecordRedactsSecrets(t *testing.T) {
+	server := newRecordingTestServer(t)
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "TestAccExample.json")
+	recorder, err := newRecorder(ModeRecord, path)
+	if err != nil {
+		t.Fatalf("building Recorder: %+v", err)
+	}
+	recorder.SetIdentity(Identity{
+		SubscriptionId: testSubscriptionId,
+	})
+
+	uri := server.URL + "/subscriptions/" + testSubscriptionId + "/resourceGroups/group1/listKeys"
+	_, body, _, err := sendRecordingTestRequest(t, recorder, http.MethodPost, uri)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if err = recorder.Stop(false); err != nil {
+		t.Fatalf("saving recording: %+v", err)
+	}
+
+	// the response returned to the caller should be unchanged, with only the recording being redacted
+	if !strings.Contains(body, testSecret) {
+		t.Fatalf("expected the response to contain the Access Key but got %q", body)
+	}
+
+	c, err := loadCassette(path)
+	if err != nil {
+		t.Fatalf("loading recording: %+v", err)
+	}
+	if len(c.Interactions) != 1 {
+		t.Fatalf("expected 1 interaction to be recorded but got %d", len(c.Interactions))
+	}
+
+	expected := `{"keys":[{"keyName":"key1","value":"REDACTED"}],"primaryConnectionString":"REDACTED"}`
+	if actual := c.Interactions[0].Response.Body; actual != expected {
+		t.Fatalf("expected the recorded body to be %q but got %q", expected, actual)
+	}
+	expectedURL := server.URL + "/subscriptions/" + ReplayIdentity.SubscriptionId + "/resourceGroups/group1/listKeys"
+	if actual := c.Interactions[0].Request.URL; actual != expectedURL {
+		t.Fatalf("expected the recorded URL to be %q but got %q", expectedURL, actual)
+	}
+}
+
+func TestRecorderVariables(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "TestAccExample.json")
+	recorder, err := newRecorder(ModeRecord, path)
+	if err != nil {
+		t.Fatalf("building Recorder: %+v", err)
+	}
+
+	v, err := recorder.Variable("RandomInteger", "123")
+	if err != nil || v != "123" {
+		t.Fatalf("expected the value 123 to be recorded but got %q (error: %+v)", v, err)
+	}
+	if v, err = recorder.Variable("RandomInteger", "456"); err != nil || v != "123" {
+		t.Fatalf("expected the recorded value 123 to be used but got %q (error: %+v)", v, err)
+	}
+	if err = recorder.Stop(false); err != nil {
+		t.Fatalf("saving recording: %+v", err)
+	}
+
+	replayer, err := newRecorder(ModeReplay, path)
+	if err != nil {
+		t.Fatalf("building Recorder: %+v", err)
+	}
+	defer stopRecorder(t, replayer)
+
+	if v, err = replayer.Variable("RandomInteger", "789"); err != nil || v != "123" {
+		t.Fatalf("expected the recorded value 123 to be replayed but got %q (error: %+v)", v, err)
+	}
+	if _, err = replayer.Variable("RandomString", "abcde"); err == nil {
+		t.Fatalf("expected an error for a variable which wasn't recorded but didn't get one")
+	}
+}
+
+func TestRecorderFailedTestIsNotSaved(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "TestAccExample.json")
+	recorder, err := newRecorder(ModeRecord, path)
+	if err != nil {
+		t.Fatalf("building Recorder: %+v", err)
+	}
+
+	if err = recorder.Stop(true); err != nil {
+		t.Fatalf("stopping Recorder: %+v", err)
+	}
+	if _, err = loadCassette(path); err == nil {
+		t.Fatalf("expected the recording for a failed test not to be saved")
+	}
+}
+
+func TestSharedTransport(t *testing.T) {
+	server := newRecordingTestServer(t)
+	defer server.Close()
+
+	first, err := newRecorder(ModeRecord, filepath.Join(t.TempDir(), "first.json"))
+	if err != nil {
+		t.Fatalf("building Recorder: %+v", err)
+	}
+	second, err := newRecorder(ModeRecord, filepath.Join(t.TempDir(), "second.json"))
+	if err != nil {
+		t.Fatalf("building Recorder: %+v", err)
+	}
+
+	uri := server.URL + "/subscriptions/" + testSubscriptionId + "/resourceGroups/group1"
+	if _, _, _, err = sendRecordingTestRequest(t, second, http.MethodPut, uri); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+
+	// the shared client should record requests for the same resource (and the long-running operation) using the same Recorder
+	shared := SharedTransport(ModeRecord)
+	if _, _, _, err = sendRecordingTestRequest(t, shared, http.MethodGet, uri); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if _, _, _, err = sendRecordingTestRequest(t, shared, http.MethodGet, server.URL+"/subscriptions/"+testSubscriptionId+"/providers/Microsoft.Resources/operations/op1?api-version=2020-06-01"); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if _, _, _, err = sendRecordingTestRequest(t, shared, http.MethodGet, server.URL+"/subscriptions/"+testSubscriptionId+"/resourceGroups/group2"); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+
+	if len(first.cassette.Interactions) != 0 {
+		t.Fatalf("expected no interactions to be recorded for the first test but got %d", len(first.cassette.Interactions))
+	}
+	if len(second.cassette.Interactions) != 3 {
+		t.Fatalf("expected 3 interactions to be recorded for the second test but got %d", len(second.cassette.Interactions))
+	}
+
+	stopRecorder(t, second)
+	stopRecorder(t, first)
+	if _, _, _, err = sendRecordingTestRequest(t, SharedTransport(ModeReplay), http.MethodGet, uri); err == nil {
+		t.Fatalf("expected an error when replaying a request which no test has recorded but didn't get one")
+	}
+}
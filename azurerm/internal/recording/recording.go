@@ -0,0 +1,89 @@
+package recording
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// ModeEnvVar is the Environment Variable used to enable recording (`record`) or replaying (`replay`)
+	// the requests made during the Acceptance Tests - when unset requests are sent to Azure as-is
+	ModeEnvVar = "ARM_TEST_RECORDING_MODE"
+
+	// PathEnvVar is the Environment Variable used to override the directory containing the recordings
+	PathEnvVar = "ARM_TEST_RECORDINGS_PATH"
+
+	// DefaultPath is the directory containing the recordings, relative to the package being tested
+	DefaultPath = "testdata/recordings"
+)
+
+type Mode string
+
+const (
+	ModeLive   Mode = ""
+	ModeRecord Mode = "record"
+	ModeReplay Mode = "replay"
+)
+
+// CurrentMode returns the Mode specified in the `ARM_TEST_RECORDING_MODE` Environment Variable
+func CurrentMode() (Mode, error) {
+	switch mode := Mode(strings.ToLower(os.Getenv(ModeEnvVar))); mode {
+	case ModeLive, ModeRecord, ModeReplay:
+		return mode, nil
+	default:
+		return ModeLive, fmt.Errorf("unsupported value %q for %q - supported values are %q and %q", mode, ModeEnvVar, ModeRecord, ModeReplay)
+	}
+}
+
+// Path returns the directory containing the recordings
+func Path() string {
+	if v := os.Getenv(PathEnvVar); v != "" {
+		return v
+	}
+
+	return DefaultPath
+}
+
+// Identity is the identity used to authenticate to Azure, which is redacted from recordings
+type Identity struct {
+	SubscriptionId string
+	TenantId       string
+	ClientId       string
+	ObjectId       string
+}
+
+// ReplayIdentity is the identity which replaces the real identity within recordings, and which
+// is used to authenticate (without making any requests) when replaying these
+var ReplayIdentity = Identity{
+	SubscriptionId: "11111111-1111-1111-1111-111111111111",
+	TenantId:       "22222222-2222-2222-2222-222222222222",
+	ClientId:       "33333333-3333-3333-3333-333333333333",
+	ObjectId:       "44444444-4444-4444-4444-444444444444",
+}
+
+// Transport records the requests sent by the clients, or replays the responses to these
+type Transport interface {
+	// Replaying returns whether responses are served from recordings, rather than by Azure
+	Replaying() bool
+
+	// SetIdentity specifies the identity used to authenticate, which is redacted from recordings
+	SetIdentity(identity Identity)
+
+	// SendDecorator returns a SendDecorator which records or replays each request
+	SendDecorator() autorest.SendDecorator
+}
+
+// WithRecording returns a SendDecorator which records or replays requests using the Transport
+// - when the Transport is nil requests are sent as-is
+func WithRecording(t Transport) autorest.SendDecorator {
+	if t == nil {
+		return func(s autorest.Sender) autorest.Sender {
+			return s
+		}
+	}
+
+	return t.SendDecorator()
+}
@@ -0,0 +1,316 @@
+package recording
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const redacted = "REDACTED"
+
+// secretProperties are the names of JSON properties (compared case-insensitively) whose values are redacted
+var secretProperties = map[string]struct{}{
+	"accesskey":                  {},
+	"accesstoken":                {},
+	"clientsecret":               {},
+	"connectionstring":           {},
+	"key1":                       {},
+	"key2":                       {},
+	"password":                   {},
+	"primaryaccesskey":           {},
+	"primaryconnectionstring":    {},
+	"primarykey":                 {},
+	"primarymasterkey":           {},
+	"primaryreadonlymasterkey":   {},
+	"refreshtoken":               {},
+	"sastoken":                   {},
+	"secondaryaccesskey":         {},
+	"secondaryconnectionstring":  {},
+	"secondarykey":               {},
+	"secondarymasterkey":         {},
+	"secondaryreadonlymasterkey": {},
+	"sharedkey":                  {},
+}
+
+// keyVaultSecretProperties are the properties of a Key Vault request or response which contain a secret - such as the
+// value of a Secret, the password of a Certificate being imported and the private components of a JSON Web Key
+var keyVaultSecretProperties = map[string]struct{}{
+	"d":     {},
+	"dp":    {},
+	"dq":    {},
+	"k":     {},
+	"p":     {},
+	"pwd":   {},
+	"q":     {},
+	"qi":    {},
+	"value": {},
+}
+
+// keyVaultDNSSuffixes are the DNS Suffixes of the Key Vault Data Plane API in each of the Azure Environments
+var keyVaultDNSSuffixes = []string{
+	"vault.azure.net",
+	"vault.azure.cn",
+	"vault.microsoftazure.de",
+	"vault.usgovcloudapi.net",
+}
+
+// secretHeaders are the names of Headers (compared case-insensitively) whose values are redacted
+var secretHeaders = map[string]struct{}{
+	"authorization":       {},
+	"proxy-authorization": {},
+}
+
+// secretQueryParameters are the Query String parameters whose values are redacted, such as the signature of a SAS Token
+var secretQueryParameters = []string{"code", "sig"}
+
+// secretPatterns match secrets embedded within other values, such as Connection Strings and SAS URI's
+var secretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)((?:AccountKey|SharedAccessKey|AccessKey|Password)=)[^;"&<\s]+`),
+	regexp.MustCompile(`(?i)([?&](?:sig|code)=)[^&"<\s]+`),
+}
+
+// sanitizer redacts secrets and the identity used to authenticate from requests and responses
+type sanitizer struct {
+	// identity maps each value of the real identity to the value from ReplayIdentity which replaces it
+	identity []replacement
+
+	// keyVaultDNSSuffixes are the DNS Suffixes used to identify requests sent to the Key Vault Data Plane API
+	keyVaultDNSSuffixes []string
+}
+
+type replacement struct {
+	pattern *regexp.Regexp
+	value   string
+	with    string
+}
+
+func newSanitizer(actual Identity) sanitizer {
+	pairs := [][2]string{
+		{actual.SubscriptionId, ReplayIdentity.SubscriptionId},
+		{actual.TenantId, ReplayIdentity.TenantId},
+		{actual.ClientId, ReplayIdentity.ClientId},
+		{actual.ObjectId, ReplayIdentity.ObjectId},
+	}
+
+	out := sanitizer{
+		keyVaultDNSSuffixes: keyVaultDNSSuffixes,
+	}
+	for _, pair := range pairs {
+		if pair[0] == "" || strings.EqualFold(pair[0], pair[1]) {
+			continue
+		}
+
+		out.identity = append(out.identity, replacement{
+			pattern: regexp.MustCompile("(?i)" + regexp.QuoteMeta(pair[0])),
+			value:   pair[0],
+			with:    pair[1],
+		})
+	}
+	return out
+}
+
+// redactIdentity replaces the real identity with the ReplayIdentity
+func (s sanitizer) redactIdentity(input string) string {
+	for _, v := range s.identity {
+		input = v.pattern.ReplaceAllLiteralString(input, v.with)
+	}
+	return input
+}
+
+// restoreIdentity replaces the ReplayIdentity with the real identity
+func (s sanitizer) restoreIdentity(input string) string {
+	for _, v := range s.identity {
+		input = strings.ReplaceAll(input, v.with, v.value)
+	}
+	return input
+}
+
+// url returns the sanitized form of a URL, with the Query String sorted so that these can be compared
+func (s sanitizer) url(input *url.URL) string {
+	u := *input
+	u.User = nil
+
+	query := u.Query()
+	for _, parameter := range secretQueryParameters {
+		if query.Get(parameter) != "" {
+			query.Set(parameter, redacted)
+		}
+	}
+	u.RawQuery = query.Encode()
+
+	return s.redactIdentity(u.String())
+}
+
+// text returns the sanitized form of a value, such as a Header
+func (s sanitizer) text(input string) string {
+	for _, pattern := range secretPatterns {
+		input = pattern.ReplaceAllString(input, "${1}"+redacted)
+	}
+
+	return s.redactIdentity(input)
+}
+
+// body returns the sanitized form of the body of a request (or the response to it) sent to the specified URL - when
+// this is JSON the values of any properties containing secrets are also redacted
+func (s sanitizer) body(u *url.URL, input []byte) []byte {
+	if len(input) == 0 {
+		return input
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+	var v interface{}
+	if decoder.Decode(&v) == nil {
+		changed := redactSecretProperties(v)
+		if s.isKeyVaultRequest(u) && redactKeyVaultSecretProperties(v) {
+			changed = true
+		}
+
+		if changed {
+			if out, err := json.Marshal(v); err == nil {
+				input = out
+			}
+		}
+	}
+
+	return []byte(s.text(string(input)))
+}
+
+// isKeyVaultRequest returns whether the URL is for the Key Vault Data Plane API
+func (s sanitizer) isKeyVaultRequest(u *url.URL) bool {
+	host := strings.ToLower(u.Hostname())
+	for _, suffix := range s.keyVaultDNSSuffixes {
+		if suffix != "" && strings.HasSuffix(host, "."+suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// headers returns the sanitized form of the request or response headers
+func (s sanitizer) headers(input http.Header) http.Header {
+	out := make(http.Header)
+	for k, values := range input {
+		if strings.EqualFold(k, "Set-Cookie") {
+			continue
+		}
+
+		for _, v := range values {
+			if _, isSecret := secretHeaders[strings.ToLower(k)]; isSecret {
+				out.Add(k, redacted)
+				continue
+			}
+
+			out.Add(k, s.text(v))
+		}
+	}
+	return out
+}
+
+// redactSecretProperties redacts the values of any properties containing secrets, returning whether anything was redacted
+func redactSecretProperties(input interface{}) bool {
+	changed := false
+
+	switch v := input.(type) {
+	case map[string]interface{}:
+		// Access Keys are commonly returned as a list of `{"keyName": "key1", "value": "..."}`
+		_, isAccessKey := v["keyName"]
+
+		for key, value := range v {
+			_, isSecret := secretProperties[strings.ToLower(key)]
+			if isAccessKey && strings.EqualFold(key, "value") {
+				isSecret = true
+			}
+
+			if s, ok := value.(string); ok && isSecret && s != "" && s != redacted {
+				v[key] = redacted
+				changed = true
+				continue
+			}
+
+			if redactSecretProperties(value) {
+				changed = true
+			}
+		}
+
+	case []interface{}:
+		for _, item := range v {
+			if redactSecretProperties(item) {
+				changed = true
+			}
+		}
+	}
+
+	return changed
+}
+
+// redactKeyVaultSecretProperties redacts the secret values from the body of a Key Vault request or response,
+// returning whether anything was redacted
+func redactKeyVaultSecretProperties(input interface{}) bool {
+	changed := false
+
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			_, isSecret := keyVaultSecretProperties[key]
+			if s, ok := value.(string); ok && isSecret && s != "" && s != redacted {
+				v[key] = redacted
+				changed = true
+				continue
+			}
+
+			if redactKeyVaultSecretProperties(value) {
+				changed = true
+			}
+		}
+
+	case []interface{}:
+		for _, item := range v {
+			if redactKeyVaultSecretProperties(item) {
+				changed = true
+			}
+		}
+	}
+
+	return changed
+}
+
+// Redactor redacts secrets from requests and responses, so that these can be logged - using the same rules
+// as are used to redact secrets from recordings
+type Redactor struct {
+	sanitizer sanitizer
+}
+
+// NewRedactor returns a Redactor, which also treats requests sent to the specified Key Vault DNS Suffix
+// (for example in a custom Environment) as requests to the Key Vault Data Plane API
+func NewRedactor(keyVaultDNSSuffix string) Redactor {
+	suffixes := keyVaultDNSSuffixes
+	if keyVaultDNSSuffix = strings.TrimPrefix(strings.ToLower(keyVaultDNSSuffix), "."); keyVaultDNSSuffix != "" {
+		suffixes = append([]string{keyVaultDNSSuffix}, suffixes...)
+	}
+
+	return Redactor{
+		sanitizer: sanitizer{
+			keyVaultDNSSuffixes: suffixes,
+		},
+	}
+}
+
+// URL returns the URL with any secrets (such as the signature of a SAS Token) redacted
+func (r Redactor) URL(input *url.URL) string {
+	return r.sanitizer.url(input)
+}
+
+// Headers returns the Headers with any secrets (such as the Authorization header) redacted
+func (r Redactor) Headers(input http.Header) http.Header {
+	return r.sanitizer.headers(input)
+}
+
+// Body returns the body of a request (or the response to it) sent to the specified URL with any
+// secrets (such as Access Keys, or the value of a Key Vault Secret) redacted
+func (r Redactor) Body(u *url.URL, input []byte) []byte {
+	return r.sanitizer.body(u, input)
+}
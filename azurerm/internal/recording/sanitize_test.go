@@ -0,0 +1,237 @@
+package recording
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestSanitizeURL(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1?api-version=2020-06-01",
+			Expected: "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1?api-version=2020-06-01",
+		},
+		{
+			// the Query String is sorted so that requests can be compared
+			Input:    "https://management.azure.com/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012?b=2&a=1",
+			Expected: "https://management.azure.com/SUBSCRIPTIONS/11111111-1111-1111-1111-111111111111?a=1&b=2",
+		},
+		{
+			Input:    "https://account1.blob.core.windows.net/container1/blob1?sv=2019-12-12&sig=abc%2Fdef%3D&sp=r",
+			Expected: "https://account1.blob.core.windows.net/container1/blob1?sig=REDACTED&sp=r&sv=2019-12-12",
+		},
+		{
+			Input:    "https://function1.azurewebsites.net/api/trigger?code=secret",
+			Expected: "https://function1.azurewebsites.net/api/trigger?code=REDACTED",
+		},
+	}
+
+	s := newSanitizer(Identity{
+		SubscriptionId: "12345678-1234-9876-4563-123456789012",
+	})
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		input, err := url.Parse(v.Input)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", v.Input, err)
+		}
+
+		if actual := s.url(input); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestSanitizeBody(t *testing.T) {
+	testData := []struct {
+		Name     string
+		URL      string
+		Input    string
+		Expected string
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Name:     "No Secrets",
+			Input:    `{ "name": "group1", "location": "westeurope" }`,
+			Expected: `{ "name": "group1", "location": "westeurope" }`,
+		},
+		{
+			Name:     "Identity",
+			Input:    `{"tenantId":"AAAAAAAA-1234-9876-4563-123456789012","principalId":"bbbbbbbb-1234-9876-4563-123456789012"}`,
+			Expected: `{"tenantId":"22222222-2222-2222-2222-222222222222","principalId":"44444444-4444-4444-4444-444444444444"}`,
+		},
+		{
+			Name:     "Secret Properties",
+			Input:    `{"properties":{"primaryKey":"abc","secondaryKey":"def","PrimaryConnectionString":"ghi","other":"jkl"}}`,
+			Expected: `{"properties":{"PrimaryConnectionString":"REDACTED","other":"jkl","primaryKey":"REDACTED","secondaryKey":"REDACTED"}}`,
+		},
+		{
+			Name:     "Access Keys",
+			Input:    `{"keys":[{"keyName":"key1","value":"abc","permissions":"FULL"},{"keyName":"key2","value":"def","permissions":"FULL"}]}`,
+			Expected: `{"keys":[{"keyName":"key1","permissions":"FULL","value":"REDACTED"},{"keyName":"key2","permissions":"FULL","value":"REDACTED"}]}`,
+		},
+		{
+			Name:     "Values which aren't Access Keys",
+			Input:    `{"value":[{"name":"group1"}]}`,
+			Expected: `{"value":[{"name":"group1"}]}`,
+		},
+		{
+			Name:     "Embedded Connection String",
+			Input:    `{"properties":{"AzureWebJobsStorage":"DefaultEndpointsProtocol=https;AccountName=account1;AccountKey=abc==;EndpointSuffix=core.windows.net"}}`,
+			Expected: `{"properties":{"AzureWebJobsStorage":"DefaultEndpointsProtocol=https;AccountName=account1;AccountKey=REDACTED;EndpointSuffix=core.windows.net"}}`,
+		},
+		{
+			Name:     "Embedded SAS URI",
+			Input:    `{"uri":"https://account1.blob.core.windows.net/container1?sv=2019-12-12&sig=abc%2F&sp=r"}`,
+			Expected: `{"uri":"https://account1.blob.core.windows.net/container1?sv=2019-12-12&sig=REDACTED&sp=r"}`,
+		},
+		{
+			Name:     "Large Numbers",
+			Input:    `{"password":"abc","size":1234567890123456789}`,
+			Expected: `{"password":"REDACTED","size":1234567890123456789}`,
+		},
+		{
+			Name:     "Not JSON",
+			Input:    `<Error><Message>Server failed to authenticate the request. AccountKey=abc</Message></Error>`,
+			Expected: `<Error><Message>Server failed to authenticate the request. AccountKey=REDACTED</Message></Error>`,
+		},
+		{
+			Name:     "Values outside of Key Vault",
+			Input:    `{"name":"setting1","value":"abc"}`,
+			Expected: `{"name":"setting1","value":"abc"}`,
+		},
+		{
+			Name:     "Key Vault Secret",
+			URL:      "https://vault1.vault.azure.net/secrets/secret1/abc?api-version=7.1",
+			Input:    `{"value":"secret","id":"https://vault1.vault.azure.net/secrets/secret1/abc","attributes":{"enabled":true}}`,
+			Expected: `{"attributes":{"enabled":true},"id":"https://vault1.vault.azure.net/secrets/secret1/abc","value":"REDACTED"}`,
+		},
+		{
+			// listing the secrets returns the metadata within the `value` list
+			Name:     "Key Vault Secrets List",
+			URL:      "https://vault1.vault.azure.net/secrets?api-version=7.1",
+			Input:    `{"value":[{"id":"https://vault1.vault.azure.net/secrets/secret1"}],"nextLink":null}`,
+			Expected: `{"value":[{"id":"https://vault1.vault.azure.net/secrets/secret1"}],"nextLink":null}`,
+		},
+		{
+			Name:     "Key Vault Key",
+			URL:      "https://VAULT1.VAULT.AZURE.CN/keys/key1?api-version=7.1",
+			Input:    `{"key":{"kid":"https://vault1.vault.azure.cn/keys/key1","kty":"RSA","n":"abc","e":"AQAB","d":"private","p":"private","q":"private"}}`,
+			Expected: `{"key":{"d":"REDACTED","e":"AQAB","kid":"https://vault1.vault.azure.cn/keys/key1","kty":"RSA","n":"abc","p":"REDACTED","q":"REDACTED"}}`,
+		},
+		{
+			Name:     "Key Vault Certificate Import",
+			URL:      "https://vault1.vault.azure.net/certificates/certificate1/import?api-version=7.1",
+			Input:    `{"value":"cGZ4","pwd":"password"}`,
+			Expected: `{"pwd":"REDACTED","value":"REDACTED"}`,
+		},
+		{
+			Name:     "Not Key Vault",
+			URL:      "https://vault.azure.net.example.com/secrets/secret1",
+			Input:    `{"value":"secret"}`,
+			Expected: `{"value":"secret"}`,
+		},
+	}
+
+	s := newSanitizer(Identity{
+		SubscriptionId: "12345678-1234-9876-4563-123456789012",
+		TenantId:       "aaaaaaaa-1234-9876-4563-123456789012",
+		ObjectId:       "bbbbbbbb-1234-9876-4563-123456789012",
+	})
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		uri := v.URL
+		if uri == "" {
+			uri = "https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012"
+		}
+		u, err := url.Parse(uri)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", uri, err)
+		}
+
+		if actual := string(s.body(u, []byte(v.Input))); actual != v.Expected {
+			t.Fatalf("expected %s but got %s", v.Expected, actual)
+		}
+	}
+}
+
+func TestRedactorKeyVaultDNSSuffix(t *testing.T) {
+	redactor := NewRedactor(".vault.azurestack.example.com")
+
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "https://vault1.vault.azurestack.example.com/secrets/secret1",
+			Expected: `{"value":"REDACTED"}`,
+		},
+		{
+			Input:    "https://vault1.vault.azure.net/secrets/secret1",
+			Expected: `{"value":"REDACTED"}`,
+		},
+		{
+			Input:    "https://management.azurestack.example.com/subscriptions/sub1",
+			Expected: `{"value":"secret"}`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		u, err := url.Parse(v.Input)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", v.Input, err)
+		}
+
+		if actual := string(redactor.Body(u, []byte(`{"value":"secret"}`))); actual != v.Expected {
+			t.Fatalf("expected %s but got %s", v.Expected, actual)
+		}
+	}
+}
+
+func TestSanitizeHeaders(t *testing.T) {
+	s := newSanitizer(Identity{
+		SubscriptionId: "12345678-1234-9876-4563-123456789012",
+	})
+
+	input := http.Header{
+		"Authorization":        []string{"Bearer abc"},
+		"Azure-Asyncoperation": []string{"https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Resources/operations/op1"},
+		"Set-Cookie":           []string{"session=abc"},
+		"X-Ms-Request-Id":      []string{"request1"},
+	}
+	expected := http.Header{
+		"Authorization":        []string{"REDACTED"},
+		"Azure-Asyncoperation": []string{"https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/providers/Microsoft.Resources/operations/op1"},
+		"X-Ms-Request-Id":      []string{"request1"},
+	}
+
+	if actual := s.headers(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestRestoreIdentity(t *testing.T) {
+	s := newSanitizer(Identity{
+		SubscriptionId: "12345678-1234-9876-4563-123456789012",
+		ClientId:       ReplayIdentity.ClientId,
+	})
+
+	input := "/subscriptions/11111111-1111-1111-1111-111111111111/clients/33333333-3333-3333-3333-333333333333"
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/clients/33333333-3333-3333-3333-333333333333"
+	if actual := s.restoreIdentity(input); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
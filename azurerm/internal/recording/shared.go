@@ -0,0 +1,88 @@
+package recording
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// active are the Recorders for the tests which are currently running
+var active = struct {
+	lock      sync.Mutex
+	recorders []*Recorder
+}{}
+
+func register(r *Recorder) {
+	active.lock.Lock()
+	defer active.lock.Unlock()
+
+	active.recorders = append(active.recorders, r)
+}
+
+func unregister(r *Recorder) {
+	active.lock.Lock()
+	defer active.lock.Unlock()
+
+	for i, v := range active.recorders {
+		if v == r {
+			active.recorders = append(active.recorders[:i], active.recorders[i+1:]...)
+			return
+		}
+	}
+}
+
+// owner returns the Recorder for the test which previously requested the same resource as this request
+func owner(req *http.Request) *Recorder {
+	active.lock.Lock()
+	defer active.lock.Unlock()
+
+	for _, v := range active.recorders {
+		if v.owns(req) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+type sharedTransport struct {
+	mode Mode
+}
+
+// SharedTransport returns a Transport for the clients which are shared between tests (for example those used
+// to check whether a resource exists) - which records (or replays) each request using the Recorder for the test
+// which previously requested the same resource
+func SharedTransport(mode Mode) Transport {
+	return sharedTransport{
+		mode: mode,
+	}
+}
+
+func (t sharedTransport) Replaying() bool {
+	return t.mode == ModeReplay
+}
+
+// SetIdentity is a no-op, since the identity used for each test is specified on the Recorder for that test
+func (t sharedTransport) SetIdentity(_ Identity) {
+}
+
+func (t sharedTransport) SendDecorator() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+			recorder := owner(req)
+			if recorder != nil {
+				return recorder.SendDecorator()(s).Do(req)
+			}
+
+			if t.mode == ModeReplay {
+				return nil, fmt.Errorf("no test has recorded a response for the %s request to %q", req.Method, req.URL)
+			}
+
+			log.Printf("[DEBUG] Not recording the %s request to %q since no test has requested this resource", req.Method, req.URL)
+			return s.Do(req)
+		})
+	}
+}
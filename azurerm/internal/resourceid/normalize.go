@@ -0,0 +1,49 @@
+package resourceid
+
+import (
+	"strings"
+)
+
+// NormalizeSegmentKeys returns the Resource ID `input` with any trailing slash removed, and the casing of each
+// constant segment within `format` (that is, the segment keys and the Resource Provider) updated to match `format`
+//
+// The `format` is the format string used to build this Resource ID, e.g. `/subscriptions/%s/resourceGroups/%s` -
+// where this starts with `%s` this is a user-specified Scope, which can contain any number of segments and is
+// normalized using NormalizeScope.
+//
+// Resource ID's which don't match the format are returned with only the trailing slash removed, so that these
+// fail when parsed.
+func NormalizeSegmentKeys(input, format string) string {
+	input = strings.TrimSuffix(input, "/")
+	inputSegments := strings.Split(input, "/")
+	formatSegments := strings.Split(format, "/")
+
+	offset := 0
+	if strings.HasPrefix(format, "%s") {
+		// the user-specified Scope can span any number of segments, so the remaining segments are matched from the end
+		formatSegments = formatSegments[1:]
+		offset = len(inputSegments) - len(formatSegments)
+		if offset < 1 {
+			return input
+		}
+
+		scopeSegments := strings.Split(NormalizeScope(strings.Join(inputSegments[0:offset], "/")), "/")
+		if len(scopeSegments) == offset {
+			copy(inputSegments, scopeSegments)
+		}
+	} else if len(inputSegments) != len(formatSegments) {
+		return input
+	}
+
+	for i, formatSegment := range formatSegments {
+		if formatSegment == "%s" {
+			continue
+		}
+
+		if strings.EqualFold(inputSegments[offset+i], formatSegment) {
+			inputSegments[offset+i] = formatSegment
+		}
+	}
+
+	return strings.Join(inputSegments, "/")
+}
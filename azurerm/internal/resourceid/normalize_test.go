@@ -0,0 +1,66 @@
+package resourceid
+
+import (
+	"testing"
+)
+
+func TestNormalizeSegmentKeys(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Format   string
+		Expected string
+	}{
+		{
+			Name:     "empty",
+			Input:    "",
+			Format:   "/subscriptions/%s/resourceGroups/%s",
+			Expected: "",
+		},
+		{
+			Name:     "already normalized",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			Format:   "/subscriptions/%s/resourceGroups/%s",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+		},
+		{
+			Name:     "lower-cased keys with a trailing slash",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/Group1/providers/microsoft.web/SITES/site1/",
+			Format:   "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1/providers/Microsoft.Web/sites/site1",
+		},
+		{
+			Name:     "values aren't normalized",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroups",
+			Format:   "/subscriptions/%s/resourceGroups/%s",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroups",
+		},
+		{
+			Name:     "different number of segments",
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/",
+			Format:   "/subscriptions/%s/resourceGroups/%s",
+			Expected: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012",
+		},
+		{
+			Name:     "scoped",
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/Microsoft.authorization/RoleAssignments/assignment1",
+			Format:   "%s/providers/Microsoft.Authorization/roleAssignments/%s",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/assignment1",
+		},
+		{
+			Name:     "scoped without a scope",
+			Input:    "providers/microsoft.authorization/roleAssignments/assignment1",
+			Format:   "%s/providers/Microsoft.Authorization/roleAssignments/%s",
+			Expected: "providers/microsoft.authorization/roleAssignments/assignment1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := NormalizeSegmentKeys(v.Input, v.Format)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
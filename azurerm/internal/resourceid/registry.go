@@ -0,0 +1,106 @@
+package resourceid
+
+import (
+	"fmt"
+	"strings"
+)
+
+//go:generate go run ../tools/generator-resource-id/main.go -path=../services -registry=./registry_gen.go
+
+// RegisteredID is a Resource ID generated using the Resource ID generator, along with
+// the Terraform Resource Types which can import a Resource ID of this type
+type RegisteredID struct {
+	// Name is the name of this Resource ID within the Service Package, e.g. `ResourceGroup`
+	Name string
+
+	// ServicePackageName is the name of the Service Package containing this Resource ID, e.g. `resource`
+	ServicePackageName string
+
+	// Template is the format of this Resource ID, where each user-specified segment is a placeholder
+	// e.g. `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}`
+	Template string
+
+	// ResourceTypes is a list of the Terraform Resource Types which use this Resource ID
+	// e.g. `azurerm_resource_group` - this is empty for Resource ID's which can't be imported, such as
+	// those for items nested within another Resource
+	ResourceTypes []string
+}
+
+// RegisteredIDs returns each of the Resource ID's within the Registry
+func RegisteredIDs() []RegisteredID {
+	return registeredIDs
+}
+
+// Lookup returns the Registered Resource ID's matching the specified Azure Resource ID
+//
+// This allows determining which Terraform Resource(s) can be used to import an existing resource
+func Lookup(input string) []RegisteredID {
+	return lookup(registeredIDs, input)
+}
+
+func lookup(registered []RegisteredID, input string) []RegisteredID {
+	out := make([]RegisteredID, 0)
+	for _, v := range registered {
+		if v.Matches(input) {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+// Matches returns whether the Azure Resource ID matches the Template for this Resource ID
+//
+// The keys for each segment (and the Resource Provider) are compared case-insensitively, since
+// the casing of these can differ in Resource ID's returned from the Azure API's
+func (id RegisteredID) Matches(input string) bool {
+	templateSegments := strings.Split(strings.TrimPrefix(id.Template, "/"), "/")
+	inputSegments := strings.Split(strings.TrimSuffix(strings.TrimPrefix(input, "/"), "/"), "/")
+
+	offset := 0
+	if !strings.HasPrefix(id.Template, "/") {
+		// the Template starts with a user-specified Scope (e.g. `{scope}/providers/..`) which
+		// can span any (even) number of segments - including none, for the Tenant Scope - so the remaining
+		// segments are matched from the end
+		templateSegments = templateSegments[1:]
+		offset = len(inputSegments) - len(templateSegments)
+		if offset < 0 || offset%2 != 0 {
+			return false
+		}
+
+		for _, v := range inputSegments[0:offset] {
+			if v == "" {
+				return false
+			}
+		}
+	} else if len(templateSegments) != len(inputSegments) {
+		return false
+	}
+
+	for i, templateSegment := range templateSegments {
+		inputSegment := inputSegments[offset+i]
+		if inputSegment == "" {
+			return false
+		}
+
+		if strings.HasPrefix(templateSegment, "{") && strings.HasSuffix(templateSegment, "}") {
+			continue
+		}
+
+		if !strings.EqualFold(templateSegment, inputSegment) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ImportCommands returns the `terraform import` command for each of the Resource Types using this Resource ID
+func (id RegisteredID) ImportCommands(input string) []string {
+	out := make([]string, 0)
+	for _, resourceType := range id.ResourceTypes {
+		out = append(out, fmt.Sprintf("terraform import %s.example %s", resourceType, input))
+	}
+
+	return out
+}
@@ -0,0 +1,2010 @@
+package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+var registeredIDs = []RegisteredID{
+	{
+		Name:               "Server",
+		ServicePackageName: "analysisservices",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.AnalysisServices/servers/{name}",
+		ResourceTypes:      []string{"azurerm_analysis_services_server"},
+	},
+	{
+		Name:               "Api",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/apis/{name}",
+		ResourceTypes:      []string{"azurerm_api_management_api"},
+	},
+	{
+		Name:               "ApiDiagnostic",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/apis/{apiName}/diagnostics/{diagnosticName}",
+		ResourceTypes:      []string{"azurerm_api_management_api_diagnostic"},
+	},
+	{
+		Name:               "ApiManagement",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}",
+		ResourceTypes:      []string{"azurerm_api_management"},
+	},
+	{
+		Name:               "ApiOperation",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/apis/{apiName}/operations/{operationName}",
+		ResourceTypes:      []string{"azurerm_api_management_api_operation"},
+	},
+	{
+		Name:               "ApiOperationPolicy",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/apis/{apiName}/operations/{operationName}/policies/{policyName}",
+		ResourceTypes:      []string{"azurerm_api_management_api_operation_policy"},
+	},
+	{
+		Name:               "ApiPolicy",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/apis/{apiName}/policies/{policyName}",
+		ResourceTypes:      []string{"azurerm_api_management_api_policy"},
+	},
+	{
+		Name:               "ApiSchema",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/apis/{apiName}/schemas/{schemaName}",
+		ResourceTypes:      []string{"azurerm_api_management_api_schema"},
+	},
+	{
+		Name:               "ApiVersionSet",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/apiVersionSets/{name}",
+		ResourceTypes:      []string{"azurerm_api_management_api_version_set"},
+	},
+	{
+		Name:               "AuthorizationServer",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/authorizationServers/{name}",
+		ResourceTypes:      []string{"azurerm_api_management_authorization_server"},
+	},
+	{
+		Name:               "Backend",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/backends/{name}",
+		ResourceTypes:      []string{"azurerm_api_management_backend"},
+	},
+	{
+		Name:               "Certificate",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/certificates/{name}",
+		ResourceTypes:      []string{"azurerm_api_management_certificate"},
+	},
+	{
+		Name:               "CustomDomain",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/customDomains/{name}",
+		ResourceTypes:      []string{"azurerm_api_management_custom_domain"},
+	},
+	{
+		Name:               "Diagnostic",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/diagnostics/{name}",
+		ResourceTypes:      []string{"azurerm_api_management_diagnostic"},
+	},
+	{
+		Name:               "Group",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/groups/{name}",
+		ResourceTypes:      []string{"azurerm_api_management_group"},
+	},
+	{
+		Name:               "GroupUser",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/groups/{groupName}/users/{userName}",
+		ResourceTypes:      []string{"azurerm_api_management_group_user"},
+	},
+	{
+		Name:               "IdentityProvider",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/identityProviders/{name}",
+		ResourceTypes:      []string{"azurerm_api_management_identity_provider_aad", "azurerm_api_management_identity_provider_aadb2c", "azurerm_api_management_identity_provider_facebook", "azurerm_api_management_identity_provider_google", "azurerm_api_management_identity_provider_microsoft", "azurerm_api_management_identity_provider_twitter"},
+	},
+	{
+		Name:               "Logger",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/loggers/{name}",
+		ResourceTypes:      []string{"azurerm_api_management_logger"},
+	},
+	{
+		Name:               "NamedValue",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/namedValues/{name}",
+		ResourceTypes:      []string{"azurerm_api_management_named_value"},
+	},
+	{
+		Name:               "OpenIDConnectProvider",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/openidConnectProviders/{name}",
+		ResourceTypes:      []string{"azurerm_api_management_openid_connect_provider"},
+	},
+	{
+		Name:               "Policy",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/policies/{name}",
+		ResourceTypes:      []string{"azurerm_api_management_policy"},
+	},
+	{
+		Name:               "Product",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/products/{name}",
+		ResourceTypes:      []string{"azurerm_api_management_product"},
+	},
+	{
+		Name:               "ProductApi",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/products/{productName}/apis/{apiName}",
+		ResourceTypes:      []string{"azurerm_api_management_product_api"},
+	},
+	{
+		Name:               "ProductGroup",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/products/{productName}/groups/{groupName}",
+		ResourceTypes:      []string{"azurerm_api_management_product_group"},
+	},
+	{
+		Name:               "ProductPolicy",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/products/{productName}/policies/{policyName}",
+		ResourceTypes:      []string{"azurerm_api_management_product_policy"},
+	},
+	{
+		Name:               "Property",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/namedValues/{namedValueName}",
+		ResourceTypes:      []string{"azurerm_api_management_property"},
+	},
+	{
+		Name:               "Subscription",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/subscriptions/{name}",
+		ResourceTypes:      []string{"azurerm_api_management_subscription"},
+	},
+	{
+		Name:               "User",
+		ServicePackageName: "apimanagement",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/users/{name}",
+		ResourceTypes:      []string{"azurerm_api_management_user"},
+	},
+	{
+		Name:               "ConfigurationStore",
+		ServicePackageName: "appconfiguration",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.AppConfiguration/configurationStores/{name}",
+		ResourceTypes:      []string{"azurerm_app_configuration"},
+	},
+	{
+		Name:               "Component",
+		ServicePackageName: "applicationinsights",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/microsoft.insights/components/{name}",
+		ResourceTypes:      []string{"azurerm_application_insights"},
+	},
+	{
+		Name:               "SmartDetectionRule",
+		ServicePackageName: "applicationinsights",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/microsoft.insights/components/{componentName}/SmartDetectionRule/{smartDetectionRuleName}",
+		ResourceTypes:      []string{"azurerm_application_insights_smart_detection_rule"},
+	},
+	{
+		Name:               "WebTest",
+		ServicePackageName: "applicationinsights",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/microsoft.insights/webtests/{name}",
+		ResourceTypes:      []string{"azurerm_application_insights_web_test"},
+	},
+	{
+		Name:               "Provider",
+		ServicePackageName: "attestation",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Attestation/attestationProviders/{attestationProviderName}",
+		ResourceTypes:      []string{"azurerm_attestation_provider"},
+	},
+	{
+		Name:               "RoleAssignment",
+		ServicePackageName: "authorization",
+		Template:           "{scope}/providers/Microsoft.Authorization/roleAssignments/{name}",
+		ResourceTypes:      []string{"azurerm_role_assignment"},
+	},
+	{
+		Name:               "Connection",
+		ServicePackageName: "automation",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccountName}/connections/{name}",
+		ResourceTypes:      []string{"azurerm_automation_connection", "azurerm_automation_connection_certificate", "azurerm_automation_connection_classic_certificate", "azurerm_automation_connection_service_principal"},
+	},
+	{
+		Name:               "AutomationAccount",
+		ServicePackageName: "automation",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{name}",
+		ResourceTypes:      []string{"azurerm_automation_account"},
+	},
+	{
+		Name:               "Cluster",
+		ServicePackageName: "azurestackhci",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.AzureStackHCI/clusters/{name}",
+		ResourceTypes:      []string{"azurerm_stack_hci_cluster"},
+	},
+	{
+		Name:               "Account",
+		ServicePackageName: "batch",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Batch/batchAccounts/{batchAccountName}",
+		ResourceTypes:      []string{"azurerm_batch_account"},
+	},
+	{
+		Name:               "Application",
+		ServicePackageName: "batch",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Batch/batchAccounts/{batchAccountName}/applications/{name}",
+		ResourceTypes:      []string{"azurerm_batch_application"},
+	},
+	{
+		Name:               "Certificate",
+		ServicePackageName: "batch",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Batch/batchAccounts/{batchAccountName}/certificates/{name}",
+		ResourceTypes:      []string{"azurerm_batch_certificate"},
+	},
+	{
+		Name:               "Pool",
+		ServicePackageName: "batch",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Batch/batchAccounts/{batchAccountName}/pools/{name}",
+		ResourceTypes:      []string{"azurerm_batch_pool"},
+	},
+	{
+		Name:               "BotChannel",
+		ServicePackageName: "bot",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.BotService/botServices/{botServiceName}/channels/{channelName}",
+		ResourceTypes:      []string{"azurerm_bot_channel_directline", "azurerm_bot_channel_email", "azurerm_bot_channel_ms_teams", "azurerm_bot_channel_slack"},
+	},
+	{
+		Name:               "BotConnection",
+		ServicePackageName: "bot",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.BotService/botServices/{botServiceName}/connections/{connectionName}",
+		ResourceTypes:      []string{"azurerm_bot_connection"},
+	},
+	{
+		Name:               "BotService",
+		ServicePackageName: "bot",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.BotService/botServices/{name}",
+		ResourceTypes:      []string{"azurerm_bot_channels_registration", "azurerm_bot_web_app"},
+	},
+	{
+		Name:               "Endpoint",
+		ServicePackageName: "cdn",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cdn/profiles/{profileName}/endpoints/{name}",
+		ResourceTypes:      []string{"azurerm_cdn_endpoint"},
+	},
+	{
+		Name:               "Profile",
+		ServicePackageName: "cdn",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cdn/profiles/{name}",
+		ResourceTypes:      []string{"azurerm_cdn_profile"},
+	},
+	{
+		Name:               "Account",
+		ServicePackageName: "cognitive",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.CognitiveServices/accounts/{name}",
+		ResourceTypes:      []string{"azurerm_cognitive_account"},
+	},
+	{
+		Name:               "AvailabilitySet",
+		ServicePackageName: "compute",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/availabilitySets/{name}",
+		ResourceTypes:      []string{"azurerm_availability_set"},
+	},
+	{
+		Name:               "DedicatedHostGroup",
+		ServicePackageName: "compute",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/hostGroups/{hostGroupName}",
+		ResourceTypes:      []string{"azurerm_dedicated_host_group"},
+	},
+	{
+		Name:               "DedicatedHost",
+		ServicePackageName: "compute",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/hostGroups/{hostGroupName}/hosts/{hostName}",
+		ResourceTypes:      []string{"azurerm_dedicated_host"},
+	},
+	{
+		Name:               "DiskEncryptionSet",
+		ServicePackageName: "compute",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/diskEncryptionSets/{name}",
+		ResourceTypes:      []string{"azurerm_disk_encryption_set"},
+	},
+	{
+		Name:               "Image",
+		ServicePackageName: "compute",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/images/{name}",
+		ResourceTypes:      []string{"azurerm_image"},
+	},
+	{
+		Name:               "ManagedDisk",
+		ServicePackageName: "compute",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/disks/{diskName}",
+		ResourceTypes:      []string{"azurerm_managed_disk"},
+	},
+	{
+		Name:               "ProximityPlacementGroup",
+		ServicePackageName: "compute",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/proximityPlacementGroups/{name}",
+		ResourceTypes:      []string{"azurerm_proximity_placement_group"},
+	},
+	{
+		Name:               "SharedImage",
+		ServicePackageName: "compute",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{galleryName}/images/{imageName}",
+		ResourceTypes:      []string{"azurerm_shared_image"},
+	},
+	{
+		Name:               "SharedImageGallery",
+		ServicePackageName: "compute",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{galleryName}",
+		ResourceTypes:      []string{"azurerm_shared_image_gallery"},
+	},
+	{
+		Name:               "SharedImageVersion",
+		ServicePackageName: "compute",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{galleryName}/images/{imageName}/versions/{versionName}",
+		ResourceTypes:      []string{"azurerm_shared_image_version"},
+	},
+	{
+		Name:               "VirtualMachine",
+		ServicePackageName: "compute",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{name}",
+		ResourceTypes:      []string{"azurerm_linux_virtual_machine", "azurerm_windows_virtual_machine"},
+	},
+	{
+		Name:               "VirtualMachineExtension",
+		ServicePackageName: "compute",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{virtualMachineName}/extensions/{extensionName}",
+		ResourceTypes:      []string{"azurerm_virtual_machine_extension"},
+	},
+	{
+		Name:               "VirtualMachineScaleSet",
+		ServicePackageName: "compute",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{name}",
+		ResourceTypes:      []string{"azurerm_linux_virtual_machine_scale_set", "azurerm_orchestrated_virtual_machine_scale_set", "azurerm_windows_virtual_machine_scale_set"},
+	},
+	{
+		Name:               "VirtualMachineScaleSetExtension",
+		ServicePackageName: "compute",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{virtualMachineScaleSetName}/extensions/{extensionName}",
+		ResourceTypes:      []string{"azurerm_virtual_machine_scale_set_extension"},
+	},
+	{
+		Name:               "SSHPublicKey",
+		ServicePackageName: "compute",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/sshPublicKeys/{name}",
+		ResourceTypes:      []string{"azurerm_ssh_public_key"},
+	},
+	{
+		Name:               "DiskAccess",
+		ServicePackageName: "compute",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/diskAccesses/{name}",
+		ResourceTypes:      []string{"azurerm_disk_access"},
+	},
+	{
+		Name:               "Cluster",
+		ServicePackageName: "containers",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerService/managedClusters/{managedClusterName}",
+		ResourceTypes:      []string{"azurerm_kubernetes_cluster"},
+	},
+	{
+		Name:               "NodePool",
+		ServicePackageName: "containers",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerService/managedClusters/{managedClusterName}/agentPools/{agentPoolName}",
+		ResourceTypes:      []string{"azurerm_kubernetes_cluster_node_pool"},
+	},
+	{
+		Name:               "ContainerGroup",
+		ServicePackageName: "containers",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerInstance/containerGroups/{name}",
+		ResourceTypes:      []string{"azurerm_container_group"},
+	},
+	{
+		Name:               "CassandraKeyspace",
+		ServicePackageName: "cosmos",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{databaseAccountName}/cassandraKeyspaces/{name}",
+		ResourceTypes:      []string{"azurerm_cosmosdb_cassandra_keyspace"},
+	},
+	{
+		Name:               "CassandraTable",
+		ServicePackageName: "cosmos",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{databaseAccountName}/cassandraKeyspaces/{cassandraKeyspaceName}/tables/{tableName}",
+		ResourceTypes:      []string{"azurerm_cosmosdb_cassandra_table"},
+	},
+	{
+		Name:               "DatabaseAccount",
+		ServicePackageName: "cosmos",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{name}",
+		ResourceTypes:      []string{"azurerm_cosmosdb_account"},
+	},
+	{
+		Name:               "GremlinDatabase",
+		ServicePackageName: "cosmos",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{databaseAccountName}/gremlinDatabases/{name}",
+		ResourceTypes:      []string{"azurerm_cosmosdb_gremlin_database"},
+	},
+	{
+		Name:               "GremlinGraph",
+		ServicePackageName: "cosmos",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{databaseAccountName}/gremlinDatabases/{gremlinDatabaseName}/graphs/{graphName}",
+		ResourceTypes:      []string{"azurerm_cosmosdb_gremlin_graph"},
+	},
+	{
+		Name:               "MongodbCollection",
+		ServicePackageName: "cosmos",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{databaseAccountName}/mongodbDatabases/{mongodbDatabaseName}/collections/{collectionName}",
+		ResourceTypes:      []string{"azurerm_cosmosdb_mongo_collection"},
+	},
+	{
+		Name:               "MongodbDatabase",
+		ServicePackageName: "cosmos",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{databaseAccountName}/mongodbDatabases/{name}",
+		ResourceTypes:      []string{"azurerm_cosmosdb_mongo_database"},
+	},
+	{
+		Name:               "SqlContainer",
+		ServicePackageName: "cosmos",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{databaseAccountName}/sqlDatabases/{sqlDatabaseName}/containers/{containerName}",
+		ResourceTypes:      []string{"azurerm_cosmosdb_sql_container"},
+	},
+	{
+		Name:               "SqlDatabase",
+		ServicePackageName: "cosmos",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{databaseAccountName}/sqlDatabases/{name}",
+		ResourceTypes:      []string{"azurerm_cosmosdb_sql_database"},
+	},
+	{
+		Name:               "SqlStoredProcedure",
+		ServicePackageName: "cosmos",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{databaseAccountName}/sqlDatabases/{sqlDatabaseName}/containers/{containerName}/storedProcedures/{storedProcedureName}",
+		ResourceTypes:      []string{"azurerm_cosmosdb_sql_stored_procedure"},
+	},
+	{
+		Name:               "Table",
+		ServicePackageName: "cosmos",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{databaseAccountName}/tables/{name}",
+		ResourceTypes:      []string{"azurerm_cosmosdb_table"},
+	},
+	{
+		Name:               "ResourceProvider",
+		ServicePackageName: "customproviders",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.CustomProviders/resourceproviders/{name}",
+		ResourceTypes:      []string{"azurerm_custom_provider"},
+	},
+	{
+		Name:               "Project",
+		ServicePackageName: "databasemigration",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataMigration/services/{serviceName}/projects/{name}",
+		ResourceTypes:      []string{"azurerm_database_migration_project"},
+	},
+	{
+		Name:               "Service",
+		ServicePackageName: "databasemigration",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataMigration/services/{name}",
+		ResourceTypes:      []string{"azurerm_database_migration_service"},
+	},
+	{
+		Name:               "Workspace",
+		ServicePackageName: "databricks",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Databricks/workspaces/{name}",
+		ResourceTypes:      []string{"azurerm_databricks_workspace"},
+	},
+	{
+		Name:               "IntegrationRuntime",
+		ServicePackageName: "datafactory",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataFactory/factories/{factoryName}/integrationruntimes/{name}",
+		ResourceTypes:      []string{"azurerm_data_factory_integration_runtime_self_hosted"},
+	},
+	{
+		Name:               "LinkedService",
+		ServicePackageName: "datafactory",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataFactory/factories/{factoryName}/linkedservices/{name}",
+		ResourceTypes:      []string{"azurerm_data_factory_linked_service_azure_blob_storage", "azurerm_data_factory_linked_service_azure_file_storage", "azurerm_data_factory_linked_service_azure_function", "azurerm_data_factory_linked_service_azure_sql_database", "azurerm_data_factory_linked_service_azure_table_storage", "azurerm_data_factory_linked_service_cosmosdb", "azurerm_data_factory_linked_service_data_lake_storage_gen2", "azurerm_data_factory_linked_service_key_vault", "azurerm_data_factory_linked_service_mysql", "azurerm_data_factory_linked_service_postgresql", "azurerm_data_factory_linked_service_sftp", "azurerm_data_factory_linked_service_snowflake", "azurerm_data_factory_linked_service_sql_server", "azurerm_data_factory_linked_service_synapse", "azurerm_data_factory_linked_service_web"},
+	},
+	{
+		Name:               "DataSet",
+		ServicePackageName: "datafactory",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataFactory/factories/{factoryName}/datasets/{name}",
+		ResourceTypes:      []string{"azurerm_data_factory_dataset_azure_blob", "azurerm_data_factory_dataset_cosmosdb_sqlapi", "azurerm_data_factory_dataset_delimited_text", "azurerm_data_factory_dataset_http", "azurerm_data_factory_dataset_json", "azurerm_data_factory_dataset_mysql", "azurerm_data_factory_dataset_postgresql", "azurerm_data_factory_dataset_sql_server_table"},
+	},
+	{
+		Name:               "Account",
+		ServicePackageName: "datalake",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeStore/accounts/{name}",
+		ResourceTypes:      []string{"azurerm_data_lake_store"},
+	},
+	{
+		Name:               "Account",
+		ServicePackageName: "datashare",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataShare/accounts/{name}",
+		ResourceTypes:      []string{"azurerm_data_share_account"},
+	},
+	{
+		Name:               "DataSet",
+		ServicePackageName: "datashare",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataShare/accounts/{accountName}/shares/{shareName}/dataSets/{name}",
+		ResourceTypes:      []string{"azurerm_data_share_dataset_blob_storage", "azurerm_data_share_dataset_data_lake_gen1", "azurerm_data_share_dataset_data_lake_gen2", "azurerm_data_share_dataset_kusto_cluster", "azurerm_data_share_dataset_kusto_database"},
+	},
+	{
+		Name:               "Share",
+		ServicePackageName: "datashare",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataShare/accounts/{accountName}/shares/{name}",
+		ResourceTypes:      []string{"azurerm_data_share"},
+	},
+	{
+		Name:               "ApplicationGroup",
+		ServicePackageName: "desktopvirtualization",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DesktopVirtualization/applicationGroups/{name}",
+		ResourceTypes:      []string{"azurerm_virtual_desktop_application_group"},
+	},
+	{
+		Name:               "HostPool",
+		ServicePackageName: "desktopvirtualization",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DesktopVirtualization/hostPools/{name}",
+		ResourceTypes:      []string{"azurerm_virtual_desktop_host_pool"},
+	},
+	{
+		Name:               "Workspace",
+		ServicePackageName: "desktopvirtualization",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DesktopVirtualization/workspaces/{name}",
+		ResourceTypes:      []string{"azurerm_virtual_desktop_workspace"},
+	},
+	{
+		Name:               "Controller",
+		ServicePackageName: "devspace",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevSpaces/controllers/{name}",
+		ResourceTypes:      []string{"azurerm_devspace_controller"},
+	},
+	{
+		Name:               "Schedule",
+		ServicePackageName: "devtestlabs",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/schedules/{name}",
+		ResourceTypes:      []string{"azurerm_dev_test_global_vm_shutdown_schedule"},
+	},
+	{
+		Name:               "DigitalTwinsInstance",
+		ServicePackageName: "digitaltwins",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DigitalTwins/digitalTwinsInstances/{name}",
+		ResourceTypes:      []string{"azurerm_digital_twins_instance"},
+	},
+	{
+		Name:               "DigitalTwinsEndpoint",
+		ServicePackageName: "digitaltwins",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DigitalTwins/digitalTwinsInstances/{digitalTwinsInstanceName}/endpoints/{endpointName}",
+		ResourceTypes:      []string{"azurerm_digital_twins_endpoint_eventgrid", "azurerm_digital_twins_endpoint_eventhub", "azurerm_digital_twins_endpoint_servicebus"},
+	},
+	{
+		Name:               "DnsZone",
+		ServicePackageName: "dns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{name}",
+		ResourceTypes:      []string{"azurerm_dns_zone"},
+	},
+	{
+		Name:               "ARecord",
+		ServicePackageName: "dns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnszoneName}/A/{aName}",
+		ResourceTypes:      []string{"azurerm_dns_a_record"},
+	},
+	{
+		Name:               "AaaaRecord",
+		ServicePackageName: "dns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnszoneName}/AAAA/{aAAAName}",
+		ResourceTypes:      []string{"azurerm_dns_aaaa_record"},
+	},
+	{
+		Name:               "CaaRecord",
+		ServicePackageName: "dns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnszoneName}/CAA/{cAAName}",
+		ResourceTypes:      []string{"azurerm_dns_caa_record"},
+	},
+	{
+		Name:               "CnameRecord",
+		ServicePackageName: "dns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnszoneName}/CNAME/{cNAMEName}",
+		ResourceTypes:      []string{"azurerm_dns_cname_record"},
+	},
+	{
+		Name:               "MxRecord",
+		ServicePackageName: "dns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnszoneName}/MX/{mXName}",
+		ResourceTypes:      []string{"azurerm_dns_mx_record"},
+	},
+	{
+		Name:               "NsRecord",
+		ServicePackageName: "dns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnszoneName}/NS/{nSName}",
+		ResourceTypes:      []string{"azurerm_dns_ns_record"},
+	},
+	{
+		Name:               "PtrRecord",
+		ServicePackageName: "dns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnszoneName}/PTR/{pTRName}",
+		ResourceTypes:      []string{"azurerm_dns_ptr_record"},
+	},
+	{
+		Name:               "SrvRecord",
+		ServicePackageName: "dns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnszoneName}/SRV/{sRVName}",
+		ResourceTypes:      []string{"azurerm_dns_srv_record"},
+	},
+	{
+		Name:               "TxtRecord",
+		ServicePackageName: "dns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnszoneName}/TXT/{tXTName}",
+		ResourceTypes:      []string{"azurerm_dns_txt_record"},
+	},
+	{
+		Name:               "Domain",
+		ServicePackageName: "eventgrid",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventGrid/domains/{name}",
+		ResourceTypes:      []string{"azurerm_eventgrid_domain"},
+	},
+	{
+		Name:               "DomainTopic",
+		ServicePackageName: "eventgrid",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventGrid/domains/{domainName}/topics/{topicName}",
+		ResourceTypes:      []string{"azurerm_eventgrid_domain_topic"},
+	},
+	{
+		Name:               "SystemTopic",
+		ServicePackageName: "eventgrid",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventGrid/systemTopics/{name}",
+		ResourceTypes:      []string{"azurerm_eventgrid_system_topic"},
+	},
+	{
+		Name:               "Topic",
+		ServicePackageName: "eventgrid",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventGrid/topics/{name}",
+		ResourceTypes:      []string{"azurerm_eventgrid_topic"},
+	},
+	{
+		Name:               "Cluster",
+		ServicePackageName: "eventhub",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/clusters/{name}",
+		ResourceTypes:      []string{"azurerm_eventhub_cluster"},
+	},
+	{
+		Name:               "EventHub",
+		ServicePackageName: "eventhub",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/eventhubs/{name}",
+		ResourceTypes:      []string{"azurerm_eventhub"},
+	},
+	{
+		Name:               "EventHubConsumerGroup",
+		ServicePackageName: "eventhub",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/eventhubs/{eventhubName}/consumergroups/{consumergroupName}",
+		ResourceTypes:      []string{"azurerm_eventhub_consumer_group"},
+	},
+	{
+		Name:               "Namespace",
+		ServicePackageName: "eventhub",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{name}",
+		ResourceTypes:      []string{"azurerm_eventhub_namespace"},
+	},
+	{
+		Name:               "NamespaceAuthorizationRule",
+		ServicePackageName: "eventhub",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/authorizationRules/{authorizationRuleName}",
+		ResourceTypes:      []string{"azurerm_eventhub_namespace_authorization_rule"},
+	},
+	{
+		Name:               "Firewall",
+		ServicePackageName: "firewall",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{azureFirewallName}",
+		ResourceTypes:      []string{"azurerm_firewall"},
+	},
+	{
+		Name:               "FirewallApplicationRuleCollection",
+		ServicePackageName: "firewall",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{azureFirewallName}/applicationRuleCollections/{applicationRuleCollectionName}",
+		ResourceTypes:      []string{"azurerm_firewall_application_rule_collection"},
+	},
+	{
+		Name:               "FirewallNatRuleCollection",
+		ServicePackageName: "firewall",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{azureFirewallName}/natRuleCollections/{natRuleCollectionName}",
+		ResourceTypes:      []string{"azurerm_firewall_nat_rule_collection"},
+	},
+	{
+		Name:               "FirewallNetworkRuleCollection",
+		ServicePackageName: "firewall",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{azureFirewallName}/networkRuleCollections/{networkRuleCollectionName}",
+		ResourceTypes:      []string{"azurerm_firewall_network_rule_collection"},
+	},
+	{
+		Name:               "FirewallPolicy",
+		ServicePackageName: "firewall",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/firewallPolicies/{name}",
+		ResourceTypes:      []string{"azurerm_firewall_policy"},
+	},
+	{
+		Name:               "FirewallPolicyRuleCollectionGroup",
+		ServicePackageName: "firewall",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/firewallPolicies/{firewallPolicyName}/ruleCollectionGroups/{ruleCollectionGroupName}",
+		ResourceTypes:      []string{"azurerm_firewall_policy_rule_collection_group"},
+	},
+	{
+		Name:               "BackendPool",
+		ServicePackageName: "frontdoor",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/frontDoors/{frontDoorName}/backendPools/{name}",
+		ResourceTypes:      []string{},
+	},
+	{
+		Name:               "FrontDoor",
+		ServicePackageName: "frontdoor",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/frontDoors/{name}",
+		ResourceTypes:      []string{"azurerm_frontdoor"},
+	},
+	{
+		Name:               "FrontendEndpoint",
+		ServicePackageName: "frontdoor",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/frontDoors/{frontDoorName}/frontendEndpoints/{name}",
+		ResourceTypes:      []string{"azurerm_frontdoor_custom_https_configuration"},
+	},
+	{
+		Name:               "HealthProbe",
+		ServicePackageName: "frontdoor",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/frontDoors/{frontDoorName}/healthProbeSettings/{healthProbeSettingName}",
+		ResourceTypes:      []string{},
+	},
+	{
+		Name:               "LoadBalancing",
+		ServicePackageName: "frontdoor",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/frontDoors/{frontDoorName}/loadBalancingSettings/{loadBalancingSettingName}",
+		ResourceTypes:      []string{},
+	},
+	{
+		Name:               "RoutingRule",
+		ServicePackageName: "frontdoor",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/frontDoors/{frontDoorName}/routingRules/{name}",
+		ResourceTypes:      []string{},
+	},
+	{
+		Name:               "WebApplicationFirewallPolicy",
+		ServicePackageName: "frontdoor",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/frontDoorWebApplicationFirewallPolicies/{frontDoorWebApplicationFirewallPolicyName}",
+		ResourceTypes:      []string{"azurerm_frontdoor_firewall_policy"},
+	},
+	{
+		Name:               "Cluster",
+		ServicePackageName: "hdinsight",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.HDInsight/clusters/{name}",
+		ResourceTypes:      []string{"azurerm_hdinsight_hadoop_cluster", "azurerm_hdinsight_hbase_cluster", "azurerm_hdinsight_interactive_query_cluster", "azurerm_hdinsight_kafka_cluster", "azurerm_hdinsight_ml_services_cluster", "azurerm_hdinsight_rserver_cluster", "azurerm_hdinsight_spark_cluster", "azurerm_hdinsight_storm_cluster"},
+	},
+	{
+		Name:               "Service",
+		ServicePackageName: "healthcare",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.HealthcareApis/services/{name}",
+		ResourceTypes:      []string{"azurerm_healthcare_service"},
+	},
+	{
+		Name:               "Cache",
+		ServicePackageName: "hpccache",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.StorageCache/caches/{name}",
+		ResourceTypes:      []string{"azurerm_hpc_cache"},
+	},
+	{
+		Name:               "StorageTarget",
+		ServicePackageName: "hpccache",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.StorageCache/caches/{cacheName}/storageTargets/{name}",
+		ResourceTypes:      []string{"azurerm_hpc_cache_blob_target", "azurerm_hpc_cache_nfs_target"},
+	},
+	{
+		Name:               "DedicatedHardwareSecurityModule",
+		ServicePackageName: "hsm",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/{dedicatedHSMName}",
+		ResourceTypes:      []string{"azurerm_dedicated_hardware_security_module"},
+	},
+	{
+		Name:               "Application",
+		ServicePackageName: "iotcentral",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.IoTCentral/IoTApps/{ioTAppName}",
+		ResourceTypes:      []string{"azurerm_iotcentral_application"},
+	},
+	{
+		Name:               "Enrichment",
+		ServicePackageName: "iothub",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Devices/IotHubs/{iotHubName}/Enrichments/{name}",
+		ResourceTypes:      []string{"azurerm_iothub_enrichment"},
+	},
+	{
+		Name:               "IotHub",
+		ServicePackageName: "iothub",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Devices/IotHubs/{name}",
+		ResourceTypes:      []string{"azurerm_iothub"},
+	},
+	{
+		Name:               "AccessPolicy",
+		ServicePackageName: "iottimeseriesinsights",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.TimeSeriesInsights/environments/{environmentName}/accessPolicies/{name}",
+		ResourceTypes:      []string{"azurerm_iot_time_series_insights_access_policy"},
+	},
+	{
+		Name:               "Environment",
+		ServicePackageName: "iottimeseriesinsights",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.TimeSeriesInsights/environments/{name}",
+		ResourceTypes:      []string{"azurerm_iot_time_series_insights_gen2_environment", "azurerm_iot_time_series_insights_standard_environment"},
+	},
+	{
+		Name:               "ReferenceDataSet",
+		ServicePackageName: "iottimeseriesinsights",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.TimeSeriesInsights/environments/{environmentName}/referenceDataSets/{name}",
+		ResourceTypes:      []string{"azurerm_iot_time_series_insights_reference_data_set"},
+	},
+	{
+		Name:               "Vault",
+		ServicePackageName: "keyvault",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.KeyVault/vaults/{name}",
+		ResourceTypes:      []string{"azurerm_key_vault"},
+	},
+	{
+		Name:               "AttachedDatabaseConfiguration",
+		ServicePackageName: "kusto",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Kusto/Clusters/{clusterName}/AttachedDatabaseConfigurations/{name}",
+		ResourceTypes:      []string{"azurerm_kusto_attached_database_configuration"},
+	},
+	{
+		Name:               "Cluster",
+		ServicePackageName: "kusto",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Kusto/Clusters/{name}",
+		ResourceTypes:      []string{"azurerm_kusto_cluster"},
+	},
+	{
+		Name:               "ClusterPrincipalAssignment",
+		ServicePackageName: "kusto",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Kusto/Clusters/{clusterName}/PrincipalAssignments/{principalAssignmentName}",
+		ResourceTypes:      []string{"azurerm_kusto_cluster_principal_assignment"},
+	},
+	{
+		Name:               "Database",
+		ServicePackageName: "kusto",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Kusto/Clusters/{clusterName}/Databases/{name}",
+		ResourceTypes:      []string{"azurerm_kusto_database"},
+	},
+	{
+		Name:               "DatabasePrincipal",
+		ServicePackageName: "kusto",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Kusto/Clusters/{clusterName}/Databases/{databaseName}/Role/{roleName}/FQN/{fQNName}",
+		ResourceTypes:      []string{"azurerm_kusto_database_principal"},
+	},
+	{
+		Name:               "DatabasePrincipalAssignment",
+		ServicePackageName: "kusto",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Kusto/Clusters/{clusterName}/Databases/{databaseName}/PrincipalAssignments/{principalAssignmentName}",
+		ResourceTypes:      []string{"azurerm_kusto_database_principal_assignment"},
+	},
+	{
+		Name:               "DataConnection",
+		ServicePackageName: "kusto",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Kusto/Clusters/{clusterName}/Databases/{databaseName}/DataConnections/{name}",
+		ResourceTypes:      []string{"azurerm_kusto_eventgrid_data_connection", "azurerm_kusto_eventhub_data_connection", "azurerm_kusto_iothub_data_connection"},
+	},
+	{
+		Name:               "LoadBalancer",
+		ServicePackageName: "loadbalancer",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{name}",
+		ResourceTypes:      []string{"azurerm_lb"},
+	},
+	{
+		Name:               "BackendAddressPoolAddress",
+		ServicePackageName: "loadbalancer",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/backendAddressPools/{backendAddressPoolName}/addresses/{addressName}",
+		ResourceTypes:      []string{"azurerm_lb_backend_address_pool_address"},
+	},
+	{
+		Name:               "LoadBalancerBackendAddressPool",
+		ServicePackageName: "loadbalancer",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/backendAddressPools/{backendAddressPoolName}",
+		ResourceTypes:      []string{"azurerm_lb_backend_address_pool"},
+	},
+	{
+		Name:               "LoadBalancerFrontendIpConfiguration",
+		ServicePackageName: "loadbalancer",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/frontendIPConfigurations/{frontendIPConfigurationName}",
+		ResourceTypes:      []string{},
+	},
+	{
+		Name:               "LoadBalancerInboundNatPool",
+		ServicePackageName: "loadbalancer",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/inboundNatPools/{inboundNatPoolName}",
+		ResourceTypes:      []string{"azurerm_lb_nat_pool"},
+	},
+	{
+		Name:               "LoadBalancerInboundNatRule",
+		ServicePackageName: "loadbalancer",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/inboundNatRules/{inboundNatRuleName}",
+		ResourceTypes:      []string{"azurerm_lb_nat_rule"},
+	},
+	{
+		Name:               "LoadBalancerOutboundRule",
+		ServicePackageName: "loadbalancer",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/outboundRules/{outboundRuleName}",
+		ResourceTypes:      []string{"azurerm_lb_outbound_rule"},
+	},
+	{
+		Name:               "LoadBalancerProbe",
+		ServicePackageName: "loadbalancer",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/probes/{probeName}",
+		ResourceTypes:      []string{"azurerm_lb_probe"},
+	},
+	{
+		Name:               "LoadBalancingRule",
+		ServicePackageName: "loadbalancer",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/loadBalancingRules/{name}",
+		ResourceTypes:      []string{"azurerm_lb_rule"},
+	},
+	{
+		Name:               "LogAnalyticsCluster",
+		ServicePackageName: "loganalytics",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/clusters/{clusterName}",
+		ResourceTypes:      []string{"azurerm_log_analytics_cluster"},
+	},
+	{
+		Name:               "LogAnalyticsDataExport",
+		ServicePackageName: "loganalytics",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}/dataexports/{dataexportName}",
+		ResourceTypes:      []string{"azurerm_log_analytics_data_export_rule"},
+	},
+	{
+		Name:               "LogAnalyticsDataSourceWindowsEvent",
+		ServicePackageName: "loganalytics",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}/dataSources/{dataSourceName}",
+		ResourceTypes:      []string{"azurerm_log_analytics_datasource_windows_event"},
+	},
+	{
+		Name:               "LogAnalyticsLinkedService",
+		ServicePackageName: "loganalytics",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}/linkedServices/{linkedServiceName}",
+		ResourceTypes:      []string{"azurerm_log_analytics_linked_service"},
+	},
+	{
+		Name:               "LogAnalyticsLinkedStorageAccount",
+		ServicePackageName: "loganalytics",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}/linkedStorageAccounts/{linkedStorageAccountName}",
+		ResourceTypes:      []string{"azurerm_log_analytics_linked_storage_account"},
+	},
+	{
+		Name:               "LogAnalyticsSavedSearch",
+		ServicePackageName: "loganalytics",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}/savedSearches/{savedSearcheName}",
+		ResourceTypes:      []string{"azurerm_log_analytics_saved_search"},
+	},
+	{
+		Name:               "LogAnalyticsSolution",
+		ServicePackageName: "loganalytics",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationsManagement/solutions/{solutionName}",
+		ResourceTypes:      []string{"azurerm_log_analytics_solution"},
+	},
+	{
+		Name:               "LogAnalyticsStorageInsights",
+		ServicePackageName: "loganalytics",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}/storageInsightConfigs/{storageInsightConfigName}",
+		ResourceTypes:      []string{"azurerm_log_analytics_storage_insights"},
+	},
+	{
+		Name:               "LogAnalyticsWorkspace",
+		ServicePackageName: "loganalytics",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}",
+		ResourceTypes:      []string{"azurerm_log_analytics_workspace"},
+	},
+	{
+		Name:               "IntegrationAccount",
+		ServicePackageName: "logic",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/integrationAccounts/{name}",
+		ResourceTypes:      []string{"azurerm_logic_app_integration_account"},
+	},
+	{
+		Name:               "IntegrationServiceEnvironment",
+		ServicePackageName: "logic",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/integrationServiceEnvironments/{name}",
+		ResourceTypes:      []string{"azurerm_integration_service_environment"},
+	},
+	{
+		Name:               "Application",
+		ServicePackageName: "managedapplications",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Solutions/applications/{name}",
+		ResourceTypes:      []string{"azurerm_managed_application"},
+	},
+	{
+		Name:               "ApplicationDefinition",
+		ServicePackageName: "managedapplications",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Solutions/applicationDefinitions/{name}",
+		ResourceTypes:      []string{"azurerm_managed_application_definition"},
+	},
+	{
+		Name:               "Account",
+		ServicePackageName: "maps",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Maps/accounts/{name}",
+		ResourceTypes:      []string{"azurerm_maps_account"},
+	},
+	{
+		Name:               "Server",
+		ServicePackageName: "mariadb",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMariaDB/servers/{name}",
+		ResourceTypes:      []string{"azurerm_mariadb_server"},
+	},
+	{
+		Name:               "MediaService",
+		ServicePackageName: "media",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Media/mediaservices/{name}",
+		ResourceTypes:      []string{"azurerm_media_services_account"},
+	},
+	{
+		Name:               "Transform",
+		ServicePackageName: "media",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Media/mediaservices/{mediaserviceName}/transforms/{name}",
+		ResourceTypes:      []string{"azurerm_media_transform"},
+	},
+	{
+		Name:               "Asset",
+		ServicePackageName: "media",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Media/mediaservices/{mediaserviceName}/assets/{name}",
+		ResourceTypes:      []string{"azurerm_media_asset"},
+	},
+	{
+		Name:               "StreamingEndpoint",
+		ServicePackageName: "media",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Media/mediaservices/{mediaserviceName}/streamingendpoints/{name}",
+		ResourceTypes:      []string{"azurerm_media_streaming_endpoint"},
+	},
+	{
+		Name:               "Job",
+		ServicePackageName: "media",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Media/mediaservices/{mediaserviceName}/transforms/{transformName}/jobs/{name}",
+		ResourceTypes:      []string{"azurerm_media_job"},
+	},
+	{
+		Name:               "StreamingLocator",
+		ServicePackageName: "media",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Media/mediaservices/{mediaserviceName}/streaminglocators/{name}",
+		ResourceTypes:      []string{"azurerm_media_streaming_locator"},
+	},
+	{
+		Name:               "ContentKeyPolicy",
+		ServicePackageName: "media",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Media/mediaservices/{mediaserviceName}/contentkeypolicies/{name}",
+		ResourceTypes:      []string{"azurerm_media_content_key_policy"},
+	},
+	{
+		Name:               "StreamingPolicy",
+		ServicePackageName: "media",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Media/mediaservices/{mediaserviceName}/streamingpolicies/{name}",
+		ResourceTypes:      []string{"azurerm_media_streaming_policy"},
+	},
+	{
+		Name:               "SpatialAnchorsAccount",
+		ServicePackageName: "mixedreality",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.MixedReality/spatialAnchorsAccounts/{name}",
+		ResourceTypes:      []string{"azurerm_spatial_anchors_account"},
+	},
+	{
+		Name:               "ActionGroup",
+		ServicePackageName: "monitor",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/microsoft.insights/actionGroups/{name}",
+		ResourceTypes:      []string{"azurerm_monitor_action_group"},
+	},
+	{
+		Name:               "ActionRule",
+		ServicePackageName: "monitor",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.AlertsManagement/actionRules/{name}",
+		ResourceTypes:      []string{"azurerm_monitor_action_rule_action_group", "azurerm_monitor_action_rule_suppression"},
+	},
+	{
+		Name:               "SmartDetectorAlertRule",
+		ServicePackageName: "monitor",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.AlertsManagement/smartdetectoralertrules/{name}",
+		ResourceTypes:      []string{"azurerm_monitor_smart_detector_alert_rule"},
+	},
+	{
+		Name:               "UserAssignedIdentity",
+		ServicePackageName: "msi",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{name}",
+		ResourceTypes:      []string{"azurerm_user_assigned_identity"},
+	},
+	{
+		Name:               "Database",
+		ServicePackageName: "mssql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/databases/{name}",
+		ResourceTypes:      []string{"azurerm_mssql_database"},
+	},
+	{
+		Name:               "DatabaseExtendedAuditingPolicy",
+		ServicePackageName: "mssql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/databases/{databaseName}/extendedAuditingSettings/{extendedAuditingSettingName}",
+		ResourceTypes:      []string{"azurerm_mssql_database_extended_auditing_policy"},
+	},
+	{
+		Name:               "DatabaseVulnerabilityAssessmentRuleBaseline",
+		ServicePackageName: "mssql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/databases/{databaseName}/vulnerabilityAssessments/{vulnerabilityAssessmentName}/rules/{ruleName}/baselines/{baselineName}",
+		ResourceTypes:      []string{"azurerm_mssql_database_vulnerability_assessment_rule_baseline"},
+	},
+	{
+		Name:               "ElasticPool",
+		ServicePackageName: "mssql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/elasticPools/{name}",
+		ResourceTypes:      []string{"azurerm_mssql_elasticpool"},
+	},
+	{
+		Name:               "RecoverableDatabase",
+		ServicePackageName: "mssql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/recoverabledatabases/{name}",
+		ResourceTypes:      []string{},
+	},
+	{
+		Name:               "Server",
+		ServicePackageName: "mssql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{name}",
+		ResourceTypes:      []string{"azurerm_mssql_server"},
+	},
+	{
+		Name:               "ServerExtendedAuditingPolicy",
+		ServicePackageName: "mssql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/extendedAuditingSettings/{extendedAuditingSettingName}",
+		ResourceTypes:      []string{"azurerm_mssql_server_extended_auditing_policy"},
+	},
+	{
+		Name:               "ServerSecurityAlertPolicy",
+		ServicePackageName: "mssql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/securityAlertPolicies/{securityAlertPolicyName}",
+		ResourceTypes:      []string{"azurerm_mssql_server_security_alert_policy"},
+	},
+	{
+		Name:               "ServerVulnerabilityAssessment",
+		ServicePackageName: "mssql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/vulnerabilityAssessments/{vulnerabilityAssessmentName}",
+		ResourceTypes:      []string{"azurerm_mssql_server_vulnerability_assessment"},
+	},
+	{
+		Name:               "SqlVirtualMachine",
+		ServicePackageName: "mssql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.SqlVirtualMachine/sqlVirtualMachines/{name}",
+		ResourceTypes:      []string{"azurerm_mssql_virtual_machine"},
+	},
+	{
+		Name:               "Configuration",
+		ServicePackageName: "mysql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/configurations/{name}",
+		ResourceTypes:      []string{"azurerm_mysql_configuration"},
+	},
+	{
+		Name:               "Key",
+		ServicePackageName: "mysql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/keys/{name}",
+		ResourceTypes:      []string{"azurerm_mysql_server_key"},
+	},
+	{
+		Name:               "Server",
+		ServicePackageName: "mysql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{name}",
+		ResourceTypes:      []string{"azurerm_mysql_server"},
+	},
+	{
+		Name:               "VirtualNetworkRule",
+		ServicePackageName: "mysql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/virtualNetworkRules/{name}",
+		ResourceTypes:      []string{"azurerm_mysql_virtual_network_rule"},
+	},
+	{
+		Name:               "Account",
+		ServicePackageName: "netapp",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.NetApp/netAppAccounts/{netAppAccountName}",
+		ResourceTypes:      []string{"azurerm_netapp_account"},
+	},
+	{
+		Name:               "CapacityPool",
+		ServicePackageName: "netapp",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.NetApp/netAppAccounts/{netAppAccountName}/capacityPools/{name}",
+		ResourceTypes:      []string{"azurerm_netapp_pool"},
+	},
+	{
+		Name:               "Snapshot",
+		ServicePackageName: "netapp",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.NetApp/netAppAccounts/{netAppAccountName}/capacityPools/{capacityPoolName}/volumes/{volumeName}/snapshots/{name}",
+		ResourceTypes:      []string{"azurerm_netapp_snapshot"},
+	},
+	{
+		Name:               "Volume",
+		ServicePackageName: "netapp",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.NetApp/netAppAccounts/{netAppAccountName}/capacityPools/{capacityPoolName}/volumes/{name}",
+		ResourceTypes:      []string{"azurerm_netapp_volume"},
+	},
+	{
+		Name:               "ApplicationGateway",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationGateways/{name}",
+		ResourceTypes:      []string{"azurerm_application_gateway"},
+	},
+	{
+		Name:               "IpGroup",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/ipGroups/{name}",
+		ResourceTypes:      []string{"azurerm_ip_group"},
+	},
+	{
+		Name:               "NetworkInterface",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkInterfaces/{name}",
+		ResourceTypes:      []string{"azurerm_network_interface"},
+	},
+	{
+		Name:               "NetworkSecurityGroup",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkSecurityGroups/{name}",
+		ResourceTypes:      []string{"azurerm_network_security_group"},
+	},
+	{
+		Name:               "PublicIpAddress",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/publicIPAddresses/{name}",
+		ResourceTypes:      []string{"azurerm_public_ip"},
+	},
+	{
+		Name:               "Route",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{routeTableName}/routes/{name}",
+		ResourceTypes:      []string{"azurerm_route"},
+	},
+	{
+		Name:               "RouteTable",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{name}",
+		ResourceTypes:      []string{"azurerm_route_table"},
+	},
+	{
+		Name:               "Subnet",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}/subnets/{name}",
+		ResourceTypes:      []string{"azurerm_subnet"},
+	},
+	{
+		Name:               "VirtualNetwork",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{name}",
+		ResourceTypes:      []string{"azurerm_virtual_network"},
+	},
+	{
+		Name:               "BastionHost",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/bastionHosts/{name}",
+		ResourceTypes:      []string{"azurerm_bastion_host"},
+	},
+	{
+		Name:               "NatGateway",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/natGateways/{name}",
+		ResourceTypes:      []string{"azurerm_nat_gateway"},
+	},
+	{
+		Name:               "ConnectionMonitor",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{networkWatcherName}/connectionMonitors/{name}",
+		ResourceTypes:      []string{"azurerm_network_connection_monitor"},
+	},
+	{
+		Name:               "NetworkWatcher",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{name}",
+		ResourceTypes:      []string{"azurerm_network_watcher"},
+	},
+	{
+		Name:               "PacketCapture",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{networkWatcherName}/packetCaptures/{name}",
+		ResourceTypes:      []string{"azurerm_network_packet_capture"},
+	},
+	{
+		Name:               "PrivateEndpoint",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateEndpoints/{name}",
+		ResourceTypes:      []string{"azurerm_private_endpoint"},
+	},
+	{
+		Name:               "PrivateDnsZoneConfig",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateEndpoints/{privateEndpointName}/privateDnsZoneGroups/{privateDnsZoneGroupName}/privateDnsZoneConfigs/{name}",
+		ResourceTypes:      []string{},
+	},
+	{
+		Name:               "PrivateDnsZoneGroup",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateEndpoints/{privateEndpointName}/privateDnsZoneGroups/{name}",
+		ResourceTypes:      []string{},
+	},
+	{
+		Name:               "RouteFilter",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeFilters/{name}",
+		ResourceTypes:      []string{"azurerm_route_filter"},
+	},
+	{
+		Name:               "BgpConnection",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualHubs/{virtualHubName}/bgpConnections/{name}",
+		ResourceTypes:      []string{"azurerm_virtual_hub_bgp_connection"},
+	},
+	{
+		Name:               "HubRouteTable",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualHubs/{virtualHubName}/hubRouteTables/{name}",
+		ResourceTypes:      []string{"azurerm_virtual_hub_route_table"},
+	},
+	{
+		Name:               "HubVirtualNetworkConnection",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualHubs/{virtualHubName}/hubVirtualNetworkConnections/{name}",
+		ResourceTypes:      []string{"azurerm_virtual_hub_connection"},
+	},
+	{
+		Name:               "SecurityPartnerProvider",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/securityPartnerProviders/{name}",
+		ResourceTypes:      []string{"azurerm_virtual_hub_security_partner_provider"},
+	},
+	{
+		Name:               "VirtualHub",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualHubs/{name}",
+		ResourceTypes:      []string{"azurerm_virtual_hub"},
+	},
+	{
+		Name:               "VirtualHubIpConfiguration",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualHubs/{virtualHubName}/ipConfigurations/{ipConfigurationName}",
+		ResourceTypes:      []string{"azurerm_virtual_hub_ip"},
+	},
+	{
+		Name:               "VirtualWan",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualWans/{name}",
+		ResourceTypes:      []string{"azurerm_virtual_wan"},
+	},
+	{
+		Name:               "VpnGateway",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnGateways/{name}",
+		ResourceTypes:      []string{"azurerm_vpn_gateway"},
+	},
+	{
+		Name:               "PointToSiteVpnGateway",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/p2sVpnGateways/{p2sVpnGatewayName}",
+		ResourceTypes:      []string{"azurerm_point_to_site_vpn_gateway"},
+	},
+	{
+		Name:               "VpnConnection",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnGateways/{vpnGatewayName}/vpnConnections/{name}",
+		ResourceTypes:      []string{"azurerm_vpn_gateway_connection"},
+	},
+	{
+		Name:               "VpnServerConfiguration",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnServerConfigurations/{name}",
+		ResourceTypes:      []string{"azurerm_vpn_server_configuration"},
+	},
+	{
+		Name:               "VpnSite",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnSites/{name}",
+		ResourceTypes:      []string{"azurerm_vpn_site"},
+	},
+	{
+		Name:               "VpnSiteLink",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnSites/{vpnSiteName}/vpnSiteLinks/{name}",
+		ResourceTypes:      []string{},
+	},
+	{
+		Name:               "SubnetServiceEndpointStoragePolicy",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/serviceEndpointPolicies/{serviceEndpointPolicyName}",
+		ResourceTypes:      []string{"azurerm_subnet_service_endpoint_storage_policy"},
+	},
+	{
+		Name:               "VirtualNetworkGateway",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworkGateways/{name}",
+		ResourceTypes:      []string{"azurerm_virtual_network_gateway"},
+	},
+	{
+		Name:               "VirtualNetworkGatewayIpConfiguration",
+		ServicePackageName: "network",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworkGateways/{virtualNetworkGatewayName}/ipConfigurations/{ipConfigurationName}",
+		ResourceTypes:      []string{},
+	},
+	{
+		Name:               "Namespace",
+		ServicePackageName: "notificationhub",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{name}",
+		ResourceTypes:      []string{"azurerm_notification_hub_namespace"},
+	},
+	{
+		Name:               "NotificationHub",
+		ServicePackageName: "notificationhub",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{namespaceName}/notificationHubs/{name}",
+		ResourceTypes:      []string{"azurerm_notification_hub"},
+	},
+	{
+		Name:               "NotificationHubAuthorizationRule",
+		ServicePackageName: "notificationhub",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{namespaceName}/notificationHubs/{notificationHubName}/AuthorizationRules/{authorizationRuleName}",
+		ResourceTypes:      []string{"azurerm_notification_hub_authorization_rule"},
+	},
+	{
+		Name:               "Dashboard",
+		ServicePackageName: "portal",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Portal/dashboards/{name}",
+		ResourceTypes:      []string{"azurerm_dashboard"},
+	},
+	{
+		Name:               "AzureActiveDirectoryAdministrator",
+		ServicePackageName: "postgres",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/administrators/{administratorName}",
+		ResourceTypes:      []string{"azurerm_postgresql_active_directory_administrator"},
+	},
+	{
+		Name:               "Configuration",
+		ServicePackageName: "postgres",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/configurations/{name}",
+		ResourceTypes:      []string{"azurerm_postgresql_configuration"},
+	},
+	{
+		Name:               "Database",
+		ServicePackageName: "postgres",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/databases/{name}",
+		ResourceTypes:      []string{"azurerm_postgresql_database"},
+	},
+	{
+		Name:               "FirewallRule",
+		ServicePackageName: "postgres",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/firewallRules/{name}",
+		ResourceTypes:      []string{"azurerm_postgresql_firewall_rule"},
+	},
+	{
+		Name:               "Server",
+		ServicePackageName: "postgres",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{name}",
+		ResourceTypes:      []string{"azurerm_postgresql_server"},
+	},
+	{
+		Name:               "ServerKey",
+		ServicePackageName: "postgres",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/keys/{keyName}",
+		ResourceTypes:      []string{"azurerm_postgresql_server_key"},
+	},
+	{
+		Name:               "VirtualNetworkRule",
+		ServicePackageName: "postgres",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/virtualNetworkRules/{name}",
+		ResourceTypes:      []string{"azurerm_postgresql_virtual_network_rule"},
+	},
+	{
+		Name:               "Embedded",
+		ServicePackageName: "powerbi",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.PowerBIDedicated/capacities/{capacityName}",
+		ResourceTypes:      []string{"azurerm_powerbi_embedded"},
+	},
+	{
+		Name:               "PrivateDnsZone",
+		ServicePackageName: "privatedns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{name}",
+		ResourceTypes:      []string{"azurerm_private_dns_zone"},
+	},
+	{
+		Name:               "VirtualNetworkLink",
+		ServicePackageName: "privatedns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{privateDnsZoneName}/virtualNetworkLinks/{name}",
+		ResourceTypes:      []string{"azurerm_private_dns_zone_virtual_network_link"},
+	},
+	{
+		Name:               "ARecord",
+		ServicePackageName: "privatedns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{privateDnsZoneName}/A/{aName}",
+		ResourceTypes:      []string{"azurerm_private_dns_a_record"},
+	},
+	{
+		Name:               "AaaaRecord",
+		ServicePackageName: "privatedns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{privateDnsZoneName}/AAAA/{aAAAName}",
+		ResourceTypes:      []string{"azurerm_private_dns_aaaa_record"},
+	},
+	{
+		Name:               "CnameRecord",
+		ServicePackageName: "privatedns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{privateDnsZoneName}/CNAME/{cNAMEName}",
+		ResourceTypes:      []string{"azurerm_private_dns_cname_record"},
+	},
+	{
+		Name:               "MxRecord",
+		ServicePackageName: "privatedns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{privateDnsZoneName}/MX/{mXName}",
+		ResourceTypes:      []string{"azurerm_private_dns_mx_record"},
+	},
+	{
+		Name:               "PtrRecord",
+		ServicePackageName: "privatedns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{privateDnsZoneName}/PTR/{pTRName}",
+		ResourceTypes:      []string{"azurerm_private_dns_ptr_record"},
+	},
+	{
+		Name:               "SrvRecord",
+		ServicePackageName: "privatedns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{privateDnsZoneName}/SRV/{sRVName}",
+		ResourceTypes:      []string{"azurerm_private_dns_srv_record"},
+	},
+	{
+		Name:               "TxtRecord",
+		ServicePackageName: "privatedns",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{privateDnsZoneName}/TXT/{tXTName}",
+		ResourceTypes:      []string{"azurerm_private_dns_txt_record"},
+	},
+	{
+		Name:               "Cache",
+		ServicePackageName: "redis",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{rediName}",
+		ResourceTypes:      []string{"azurerm_redis_cache"},
+	},
+	{
+		Name:               "FirewallRule",
+		ServicePackageName: "redis",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{rediName}/firewallRules/{name}",
+		ResourceTypes:      []string{"azurerm_redis_firewall_rule"},
+	},
+	{
+		Name:               "LinkedServer",
+		ServicePackageName: "redis",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{rediName}/linkedServers/{name}",
+		ResourceTypes:      []string{"azurerm_redis_linked_server"},
+	},
+	{
+		Name:               "RedisEnterpriseCluster",
+		ServicePackageName: "redisenterprise",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/redisEnterprise/{redisEnterpriseName}",
+		ResourceTypes:      []string{"azurerm_redis_enterprise_cluster"},
+	},
+	{
+		Name:               "RedisEnterpriseDatabase",
+		ServicePackageName: "redisenterprise",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/redisEnterprise/{redisEnterpriseName}/databases/{databaseName}",
+		ResourceTypes:      []string{"azurerm_redis_enterprise_database"},
+	},
+	{
+		Name:               "HybridConnection",
+		ServicePackageName: "relay",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Relay/namespaces/{namespaceName}/hybridConnections/{name}",
+		ResourceTypes:      []string{"azurerm_relay_hybrid_connection"},
+	},
+	{
+		Name:               "Namespace",
+		ServicePackageName: "relay",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Relay/namespaces/{name}",
+		ResourceTypes:      []string{"azurerm_relay_namespace"},
+	},
+	{
+		Name:               "ResourceGroup",
+		ServicePackageName: "resource",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}",
+		ResourceTypes:      []string{"azurerm_resource_group"},
+	},
+	{
+		Name:               "ResourceGroupTemplateDeployment",
+		ServicePackageName: "resource",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Resources/deployments/{deploymentName}",
+		ResourceTypes:      []string{"azurerm_resource_group_template_deployment"},
+	},
+	{
+		Name:               "SubscriptionTemplateDeployment",
+		ServicePackageName: "resource",
+		Template:           "/subscriptions/{subscriptionId}/providers/Microsoft.Resources/deployments/{deploymentName}",
+		ResourceTypes:      []string{"azurerm_subscription_template_deployment"},
+	},
+	{
+		Name:               "ManagementLock",
+		ServicePackageName: "resource",
+		Template:           "{scope}/providers/Microsoft.Authorization/locks/{lockName}",
+		ResourceTypes:      []string{"azurerm_management_lock"},
+	},
+	{
+		Name:               "SearchService",
+		ServicePackageName: "search",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Search/searchServices/{name}",
+		ResourceTypes:      []string{"azurerm_search_service"},
+	},
+	{
+		Name:               "AssessmentMetadata",
+		ServicePackageName: "securitycenter",
+		Template:           "/subscriptions/{subscriptionId}/providers/Microsoft.Security/assessmentMetadata/{assessmentMetadataName}",
+		ResourceTypes:      []string{"azurerm_security_center_assessment_metadata"},
+	},
+	{
+		Name:               "IotSecuritySolution",
+		ServicePackageName: "securitycenter",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Security/IoTSecuritySolutions/{name}",
+		ResourceTypes:      []string{"azurerm_iot_security_solution"},
+	},
+	{
+		Name:               "AlertRule",
+		ServicePackageName: "sentinel",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}/providers/Microsoft.SecurityInsights/alertRules/{name}",
+		ResourceTypes:      []string{"azurerm_sentinel_alert_rule_fusion", "azurerm_sentinel_alert_rule_ms_security_incident", "azurerm_sentinel_alert_rule_scheduled"},
+	},
+	{
+		Name:               "SentinelAlertRuleTemplate",
+		ServicePackageName: "sentinel",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}/providers/Microsoft.SecurityInsights/AlertRuleTemplates/{alertRuleTemplateName}",
+		ResourceTypes:      []string{},
+	},
+	{
+		Name:               "DataConnector",
+		ServicePackageName: "sentinel",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}/providers/Microsoft.SecurityInsights/dataConnectors/{name}",
+		ResourceTypes:      []string{"azurerm_sentinel_data_connector_azure_active_directory", "azurerm_sentinel_data_connector_office_365", "azurerm_sentinel_data_connector_threat_intelligence"},
+	},
+	{
+		Name:               "Queue",
+		ServicePackageName: "servicebus",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/queues/{name}",
+		ResourceTypes:      []string{"azurerm_servicebus_queue"},
+	},
+	{
+		Name:               "QueueAuthorizationRule",
+		ServicePackageName: "servicebus",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/queues/{queueName}/authorizationRules/{authorizationRuleName}",
+		ResourceTypes:      []string{"azurerm_servicebus_queue_authorization_rule"},
+	},
+	{
+		Name:               "Namespace",
+		ServicePackageName: "servicebus",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{name}",
+		ResourceTypes:      []string{"azurerm_servicebus_namespace"},
+	},
+	{
+		Name:               "NamespaceAuthorizationRule",
+		ServicePackageName: "servicebus",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/AuthorizationRules/{authorizationRuleName}",
+		ResourceTypes:      []string{"azurerm_servicebus_namespace_authorization_rule"},
+	},
+	{
+		Name:               "NamespaceNetworkRuleSet",
+		ServicePackageName: "servicebus",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/networkrulesets/{networkrulesetName}",
+		ResourceTypes:      []string{"azurerm_servicebus_namespace_network_rule_set"},
+	},
+	{
+		Name:               "Subscription",
+		ServicePackageName: "servicebus",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}/subscriptions/{name}",
+		ResourceTypes:      []string{"azurerm_servicebus_subscription"},
+	},
+	{
+		Name:               "SubscriptionRule",
+		ServicePackageName: "servicebus",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}/subscriptions/{subscriptionName}/rules/{ruleName}",
+		ResourceTypes:      []string{"azurerm_servicebus_subscription_rule"},
+	},
+	{
+		Name:               "Topic",
+		ServicePackageName: "servicebus",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{name}",
+		ResourceTypes:      []string{"azurerm_servicebus_topic"},
+	},
+	{
+		Name:               "TopicAuthorizationRule",
+		ServicePackageName: "servicebus",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}/authorizationRules/{authorizationRuleName}",
+		ResourceTypes:      []string{"azurerm_servicebus_topic_authorization_rule"},
+	},
+	{
+		Name:               "Cluster",
+		ServicePackageName: "servicefabric",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceFabric/clusters/{name}",
+		ResourceTypes:      []string{"azurerm_service_fabric_cluster"},
+	},
+	{
+		Name:               "Application",
+		ServicePackageName: "servicefabricmesh",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceFabricMesh/applications/{name}",
+		ResourceTypes:      []string{"azurerm_service_fabric_mesh_application"},
+	},
+	{
+		Name:               "Network",
+		ServicePackageName: "servicefabricmesh",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceFabricMesh/networks/{name}",
+		ResourceTypes:      []string{"azurerm_service_fabric_mesh_local_network"},
+	},
+	{
+		Name:               "Secret",
+		ServicePackageName: "servicefabricmesh",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceFabricMesh/secrets/{name}",
+		ResourceTypes:      []string{"azurerm_service_fabric_mesh_secret"},
+	},
+	{
+		Name:               "SecretValue",
+		ServicePackageName: "servicefabricmesh",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceFabricMesh/secrets/{secretName}/values/{valueName}",
+		ResourceTypes:      []string{"azurerm_service_fabric_mesh_secret_value"},
+	},
+	{
+		Name:               "Service",
+		ServicePackageName: "signalr",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.SignalRService/SignalR/{signalRName}",
+		ResourceTypes:      []string{"azurerm_signalr_service"},
+	},
+	{
+		Name:               "SpringCloudApp",
+		ServicePackageName: "springcloud",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.AppPlatform/Spring/{springName}/apps/{appName}",
+		ResourceTypes:      []string{"azurerm_spring_cloud_active_deployment", "azurerm_spring_cloud_app"},
+	},
+	{
+		Name:               "SpringCloudDeployment",
+		ServicePackageName: "springcloud",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.AppPlatform/Spring/{springName}/apps/{appName}/deployments/{deploymentName}",
+		ResourceTypes:      []string{"azurerm_spring_cloud_java_deployment"},
+	},
+	{
+		Name:               "SpringCloudCertificate",
+		ServicePackageName: "springcloud",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.AppPlatform/Spring/{springName}/certificates/{certificateName}",
+		ResourceTypes:      []string{"azurerm_spring_cloud_certificate"},
+	},
+	{
+		Name:               "SpringCloudCustomDomain",
+		ServicePackageName: "springcloud",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.AppPlatform/Spring/{springName}/apps/{appName}/domains/{domainName}",
+		ResourceTypes:      []string{"azurerm_spring_cloud_custom_domain"},
+	},
+	{
+		Name:               "SpringCloudService",
+		ServicePackageName: "springcloud",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.AppPlatform/Spring/{springName}",
+		ResourceTypes:      []string{"azurerm_spring_cloud_service"},
+	},
+	{
+		Name:               "AzureActiveDirectoryAdministrator",
+		ServicePackageName: "sql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.SQL/servers/{serverName}/administrators/{administratorName}",
+		ResourceTypes:      []string{"azurerm_sql_active_directory_administrator"},
+	},
+	{
+		Name:               "Database",
+		ServicePackageName: "sql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/databases/{name}",
+		ResourceTypes:      []string{"azurerm_sql_database"},
+	},
+	{
+		Name:               "ElasticPool",
+		ServicePackageName: "sql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/elasticPools/{name}",
+		ResourceTypes:      []string{"azurerm_sql_elasticpool"},
+	},
+	{
+		Name:               "FailoverGroup",
+		ServicePackageName: "sql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/failoverGroups/{name}",
+		ResourceTypes:      []string{"azurerm_sql_failover_group"},
+	},
+	{
+		Name:               "FirewallRule",
+		ServicePackageName: "sql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/firewallRules/{name}",
+		ResourceTypes:      []string{"azurerm_sql_firewall_rule"},
+	},
+	{
+		Name:               "Server",
+		ServicePackageName: "sql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{name}",
+		ResourceTypes:      []string{"azurerm_sql_server"},
+	},
+	{
+		Name:               "VirtualNetworkRule",
+		ServicePackageName: "sql",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/virtualNetworkRules/{name}",
+		ResourceTypes:      []string{"azurerm_sql_virtual_network_rule"},
+	},
+	{
+		Name:               "EncryptionScope",
+		ServicePackageName: "storage",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Storage/storageAccounts/{storageAccountName}/encryptionScopes/{name}",
+		ResourceTypes:      []string{"azurerm_storage_encryption_scope"},
+	},
+	{
+		Name:               "StorageAccount",
+		ServicePackageName: "storage",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Storage/storageAccounts/{name}",
+		ResourceTypes:      []string{"azurerm_storage_account"},
+	},
+	{
+		Name:               "StorageContainerResourceManager",
+		ServicePackageName: "storage",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Storage/storageAccounts/{storageAccountName}/blobServices/{blobServiceName}/containers/{containerName}",
+		ResourceTypes:      []string{},
+	},
+	{
+		Name:               "StorageShareResourceManager",
+		ServicePackageName: "storage",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Storage/storageAccounts/{storageAccountName}/fileServices/{fileServiceName}/shares/{shareName}",
+		ResourceTypes:      []string{},
+	},
+	{
+		Name:               "StorageSyncGroup",
+		ServicePackageName: "storage",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.StorageSync/storageSyncServices/{storageSyncServiceName}/syncGroups/{syncGroupName}",
+		ResourceTypes:      []string{"azurerm_storage_sync_group"},
+	},
+	{
+		Name:               "StorageSyncService",
+		ServicePackageName: "storage",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.StorageSync/storageSyncServices/{name}",
+		ResourceTypes:      []string{"azurerm_storage_sync"},
+	},
+	{
+		Name:               "StorageSyncCloudEndpoint",
+		ServicePackageName: "storage",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.StorageSync/storageSyncServices/{storageSyncServiceName}/syncGroups/{syncGroupName}/cloudEndpoints/{cloudEndpointName}",
+		ResourceTypes:      []string{"azurerm_storage_sync_cloud_endpoint"},
+	},
+	{
+		Name:               "Function",
+		ServicePackageName: "streamanalytics",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.StreamAnalytics/streamingjobs/{streamingjobName}/functions/{name}",
+		ResourceTypes:      []string{"azurerm_stream_analytics_function_javascript_udf"},
+	},
+	{
+		Name:               "StreamingJob",
+		ServicePackageName: "streamanalytics",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.StreamAnalytics/streamingjobs/{name}",
+		ResourceTypes:      []string{"azurerm_stream_analytics_job"},
+	},
+	{
+		Name:               "StreamInput",
+		ServicePackageName: "streamanalytics",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.StreamAnalytics/streamingjobs/{streamingjobName}/inputs/{inputName}",
+		ResourceTypes:      []string{"azurerm_stream_analytics_reference_input_blob", "azurerm_stream_analytics_stream_input_blob", "azurerm_stream_analytics_stream_input_eventhub", "azurerm_stream_analytics_stream_input_iothub"},
+	},
+	{
+		Name:               "Output",
+		ServicePackageName: "streamanalytics",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.StreamAnalytics/streamingjobs/{streamingjobName}/outputs/{name}",
+		ResourceTypes:      []string{"azurerm_stream_analytics_output_blob", "azurerm_stream_analytics_output_eventhub", "azurerm_stream_analytics_output_mssql", "azurerm_stream_analytics_output_servicebus_queue", "azurerm_stream_analytics_output_servicebus_topic"},
+	},
+	{
+		Name:               "FirewallRule",
+		ServicePackageName: "synapse",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Synapse/workspaces/{workspaceName}/firewallRules/{name}",
+		ResourceTypes:      []string{"azurerm_synapse_firewall_rule"},
+	},
+	{
+		Name:               "SparkPool",
+		ServicePackageName: "synapse",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Synapse/workspaces/{workspaceName}/bigDataPools/{bigDataPoolName}",
+		ResourceTypes:      []string{"azurerm_synapse_spark_pool"},
+	},
+	{
+		Name:               "SqlPool",
+		ServicePackageName: "synapse",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Synapse/workspaces/{workspaceName}/sqlPools/{name}",
+		ResourceTypes:      []string{"azurerm_synapse_sql_pool"},
+	},
+	{
+		Name:               "Workspace",
+		ServicePackageName: "synapse",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Synapse/workspaces/{name}",
+		ResourceTypes:      []string{"azurerm_synapse_workspace"},
+	},
+	{
+		Name:               "ManagedPrivateEndpoint",
+		ServicePackageName: "synapse",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Synapse/workspaces/{workspaceName}/managedVirtualNetworks/{managedVirtualNetworkName}/managedPrivateEndpoints/{name}",
+		ResourceTypes:      []string{"azurerm_synapse_managed_private_endpoint"},
+	},
+	{
+		Name:               "AzureEndpoint",
+		ServicePackageName: "trafficmanager",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/trafficManagerProfiles/{trafficManagerProfileName}/azureEndpoints/{name}",
+		ResourceTypes:      []string{"azurerm_traffic_manager_endpoint"},
+	},
+	{
+		Name:               "ExternalEndpoint",
+		ServicePackageName: "trafficmanager",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/trafficManagerProfiles/{trafficManagerProfileName}/externalEndpoints/{name}",
+		ResourceTypes:      []string{"azurerm_traffic_manager_endpoint"},
+	},
+	{
+		Name:               "NestedEndpoint",
+		ServicePackageName: "trafficmanager",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/trafficManagerProfiles/{trafficManagerProfileName}/nestedEndpoints/{name}",
+		ResourceTypes:      []string{"azurerm_traffic_manager_endpoint"},
+	},
+	{
+		Name:               "TrafficManagerProfile",
+		ServicePackageName: "trafficmanager",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/trafficManagerProfiles/{name}",
+		ResourceTypes:      []string{"azurerm_traffic_manager_profile"},
+	},
+	{
+		Name:               "PrivateCloud",
+		ServicePackageName: "vmware",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.AVS/privateClouds/{name}",
+		ResourceTypes:      []string{"azurerm_vmware_private_cloud"},
+	},
+	{
+		Name:               "AppService",
+		ServicePackageName: "web",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{siteName}",
+		ResourceTypes:      []string{"azurerm_app_service", "azurerm_app_service_active_slot"},
+	},
+	{
+		Name:               "AppServiceEnvironment",
+		ServicePackageName: "web",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/hostingEnvironments/{hostingEnvironmentName}",
+		ResourceTypes:      []string{"azurerm_app_service_environment"},
+	},
+	{
+		Name:               "AppServicePlan",
+		ServicePackageName: "web",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/serverfarms/{serverfarmName}",
+		ResourceTypes:      []string{"azurerm_app_service_plan"},
+	},
+	{
+		Name:               "AppServiceSlot",
+		ServicePackageName: "web",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{siteName}/slots/{slotName}",
+		ResourceTypes:      []string{"azurerm_app_service_slot"},
+	},
+	{
+		Name:               "Certificate",
+		ServicePackageName: "web",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/certificates/{name}",
+		ResourceTypes:      []string{"azurerm_app_service_certificate"},
+	},
+	{
+		Name:               "CertificateOrder",
+		ServicePackageName: "web",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/certificateOrders/{name}",
+		ResourceTypes:      []string{"azurerm_app_service_certificate_order"},
+	},
+	{
+		Name:               "FunctionApp",
+		ServicePackageName: "web",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{siteName}",
+		ResourceTypes:      []string{"azurerm_function_app"},
+	},
+	{
+		Name:               "FunctionAppSlot",
+		ServicePackageName: "web",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{siteName}/slots/{slotName}",
+		ResourceTypes:      []string{"azurerm_function_app_slot"},
+	},
+	{
+		Name:               "HostnameBinding",
+		ServicePackageName: "web",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{siteName}/hostNameBindings/{name}",
+		ResourceTypes:      []string{"azurerm_app_service_custom_hostname_binding"},
+	},
+	{
+		Name:               "HybridConnection",
+		ServicePackageName: "web",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{siteName}/hybridConnectionNamespaces/{hybridConnectionNamespaceName}/relays/{relayName}",
+		ResourceTypes:      []string{"azurerm_app_service_hybrid_connection"},
+	},
+	{
+		Name:               "ManagedCertificate",
+		ServicePackageName: "web",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/certificates/{certificateName}",
+		ResourceTypes:      []string{"azurerm_app_service_managed_certificate"},
+	},
+	{
+		Name:               "SlotVirtualNetworkSwiftConnection",
+		ServicePackageName: "web",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{siteName}/slots/{slotName}/config/{configName}",
+		ResourceTypes:      []string{"azurerm_app_service_slot_virtual_network_swift_connection"},
+	},
+	{
+		Name:               "VirtualNetworkSwiftConnection",
+		ServicePackageName: "web",
+		Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{siteName}/config/{configName}",
+		ResourceTypes:      []string{"azurerm_app_service_virtual_network_swift_connection"},
+	},
+}
@@ -0,0 +1,137 @@
+package resourceid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRegisteredIDMatches(t *testing.T) {
+	registered := []RegisteredID{
+		{
+			Name:          "ResourceGroup",
+			Template:      "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}",
+			ResourceTypes: []string{"azurerm_resource_group"},
+		},
+		{
+			Name:          "Server",
+			Template:      "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{name}",
+			ResourceTypes: []string{"azurerm_sql_server", "azurerm_mssql_server"},
+		},
+		{
+			Name:     "Database",
+			Template: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/databases/{name}",
+		},
+		{
+			Name:     "RoleAssignment",
+			Template: "{scope}/providers/Microsoft.Authorization/roleAssignments/{name}",
+		},
+	}
+
+	testData := []struct {
+		Input    string
+		Expected []string
+	}{
+		{
+			Input:    "",
+			Expected: []string{},
+		},
+		{
+			Input:    "/subscriptions/1234",
+			Expected: []string{},
+		},
+		{
+			Input:    "/subscriptions/1234/resourceGroups/group1",
+			Expected: []string{"ResourceGroup"},
+		},
+		{
+			// trailing slashes and the casing of the keys should be ignored
+			Input:    "/subscriptions/1234/resourcegroups/group1/",
+			Expected: []string{"ResourceGroup"},
+		},
+		{
+			Input:    "/subscriptions/1234/resourceGroups/",
+			Expected: []string{},
+		},
+		{
+			Input:    "/subscriptions/1234/resourceGroups/group1/providers/microsoft.sql/Servers/server1",
+			Expected: []string{"Server"},
+		},
+		{
+			Input:    "/subscriptions/1234/resourceGroups/group1/providers/Microsoft.Web/sites/site1",
+			Expected: []string{},
+		},
+		{
+			Input:    "/subscriptions/1234/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/db1",
+			Expected: []string{"Database"},
+		},
+		{
+			Input:    "/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			Expected: []string{"RoleAssignment"},
+		},
+		{
+			Input:    "/subscriptions/1234/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			Expected: []string{"RoleAssignment"},
+		},
+		{
+			Input:    "/subscriptions/1234/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/providers/microsoft.authorization/roleAssignments/assignment1",
+			Expected: []string{"RoleAssignment"},
+		},
+		{
+			Input:    "/subscriptions/1234/resourceGroups/group1/extra/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			Expected: []string{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		actual := lookup(registered, v.Input)
+		if len(actual) != len(v.Expected) {
+			t.Fatalf("expected %d matches but got %d: %+v", len(v.Expected), len(actual), actual)
+		}
+		for i, expected := range v.Expected {
+			if actual[i].Name != expected {
+				t.Fatalf("expected match %d to be %q but got %q", i, expected, actual[i].Name)
+			}
+		}
+	}
+}
+
+func TestRegisteredIDImportCommands(t *testing.T) {
+	id := RegisteredID{
+		Template:      "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{name}",
+		ResourceTypes: []string{"azurerm_mssql_server", "azurerm_sql_server"},
+	}
+	input := "/subscriptions/1234/resourceGroups/group1/providers/Microsoft.Sql/servers/server1"
+
+	actual := id.ImportCommands(input)
+	expected := []string{
+		"terraform import azurerm_mssql_server.example " + input,
+		"terraform import azurerm_sql_server.example " + input,
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d commands but got %d", len(expected), len(actual))
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("expected %q but got %q", expected[i], actual[i])
+		}
+	}
+}
+
+func TestRegistryIsValid(t *testing.T) {
+	seen := make(map[string]struct{})
+	for _, v := range RegisteredIDs() {
+		key := v.ServicePackageName + "/" + v.Name
+		if _, exists := seen[key]; exists {
+			t.Fatalf("%q is registered more than once", key)
+		}
+		seen[key] = struct{}{}
+
+		// a user-specified Scope spans multiple segments, so is substituted for an example Scope
+		template := strings.Replace(v.Template, "{scope}", "/subscriptions/{subscriptionId}", 1)
+		if !v.Matches(template) {
+			t.Fatalf("expected the Template for %q to match itself", key)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package resourceid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Scope is the Resource ID of the Scope beneath which an extension resource (for example a Role Assignment
+// or a Management Lock) is nested - which is one of a TenantScope, ManagementGroupScope, ProviderScope,
+// SubscriptionScope, ResourceGroupScope or ResourceScope
+type Scope interface {
+	// ID returns the Resource ID of this Scope
+	ID() string
+
+	// String returns a human-readable description of this Scope
+	String() string
+}
+
+// TenantScope is the Scope for the Tenant (also known as the root Scope, `/`)
+//
+// The Resource ID for this Scope is empty, such that the Resource ID of an extension resource
+// nested beneath it starts with `/providers`, e.g. `/providers/Microsoft.Authorization/roleAssignments/{name}`
+type TenantScope struct{}
+
+func (id TenantScope) ID() string {
+	return ""
+}
+
+func (id TenantScope) String() string {
+	return "Tenant"
+}
+
+// ManagementGroupScope is a Scope for a Management Group
+// e.g. `/providers/Microsoft.Management/managementGroups/{name}`
+type ManagementGroupScope struct {
+	Name string
+}
+
+func (id ManagementGroupScope) ID() string {
+	return fmt.Sprintf("/providers/Microsoft.Management/managementGroups/%s", id.Name)
+}
+
+func (id ManagementGroupScope) String() string {
+	return fmt.Sprintf("Management Group %q", id.Name)
+}
+
+// ProviderScope is a Scope for a Resource which isn't nested beneath a Subscription, that is a tenant-level
+// Resource or a Resource within a Management Group
+// e.g. `/providers/Microsoft.Capacity/reservationOrders/{name}`
+type ProviderScope struct {
+	// Resource is the Resource ID without the leading slash, e.g. `providers/Microsoft.Capacity/reservationOrders/{name}`
+	Resource string
+}
+
+func (id ProviderScope) ID() string {
+	return fmt.Sprintf("/%s", id.Resource)
+}
+
+func (id ProviderScope) String() string {
+	return fmt.Sprintf("Resource %q", id.ID())
+}
+
+// SubscriptionScope is a Scope for a Subscription
+// e.g. `/subscriptions/{subscriptionId}`
+type SubscriptionScope struct {
+	SubscriptionId string
+}
+
+func (id SubscriptionScope) ID() string {
+	return fmt.Sprintf("/subscriptions/%s", id.SubscriptionId)
+}
+
+func (id SubscriptionScope) String() string {
+	return fmt.Sprintf("Subscription %q", id.SubscriptionId)
+}
+
+// ResourceGroupScope is a Scope for a Resource Group
+// e.g. `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}`
+type ResourceGroupScope struct {
+	SubscriptionId string
+	ResourceGroup  string
+}
+
+func (id ResourceGroupScope) ID() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", id.SubscriptionId, id.ResourceGroup)
+}
+
+func (id ResourceGroupScope) String() string {
+	return fmt.Sprintf("Resource Group %q", id.ResourceGroup)
+}
+
+// ResourceScope is a Scope for a Resource within a Resource Group (or a Subscription)
+// e.g. `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Storage/storageAccounts/{name}`
+type ResourceScope struct {
+	SubscriptionId string
+
+	// ResourceGroup is the name of the Resource Group containing this Resource, which is
+	// empty for Resources which are nested directly beneath a Subscription
+	ResourceGroup string
+
+	// Resource is the remainder of the Resource ID, e.g. `providers/Microsoft.Storage/storageAccounts/{name}`
+	Resource string
+}
+
+func (id ResourceScope) ID() string {
+	if id.ResourceGroup == "" {
+		return fmt.Sprintf("/subscriptions/%s/%s", id.SubscriptionId, id.Resource)
+	}
+
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/%s", id.SubscriptionId, id.ResourceGroup, id.Resource)
+}
+
+func (id ResourceScope) String() string {
+	return fmt.Sprintf("Resource %q", id.ID())
+}
+
+// ParseScope parses the Resource ID `input` into a TenantScope (where `input` is empty or `/`), ManagementGroupScope,
+// ProviderScope, SubscriptionScope, ResourceGroupScope or ResourceScope - the segment keys are parsed case-sensitively,
+// NormalizeScope can be used to fix the casing of these first where required.
+func ParseScope(input string) (Scope, error) {
+	if input == "" || input == "/" {
+		return TenantScope{}, nil
+	}
+
+	if !strings.HasPrefix(input, "/") {
+		return nil, fmt.Errorf("expected the Scope %q to start with a `/`", input)
+	}
+
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(segments)%2 != 0 {
+		return nil, fmt.Errorf("the number of segments in the Scope is not divisible by 2 in %q", input)
+	}
+	for _, v := range segments {
+		if v == "" {
+			return nil, fmt.Errorf("the Scope contains an empty segment in %q", input)
+		}
+	}
+
+	switch segments[0] {
+	case "providers":
+		if len(segments) < 4 {
+			return nil, fmt.Errorf("expected the Scope %q to contain a Resource Provider, Resource Type and Name", input)
+		}
+
+		if len(segments) == 4 && segments[1] == "Microsoft.Management" && segments[2] == "managementGroups" {
+			return ManagementGroupScope{
+				Name: segments[3],
+			}, nil
+		}
+
+		return ProviderScope{
+			Resource: strings.Join(segments, "/"),
+		}, nil
+
+	case "subscriptions":
+		subscriptionId := segments[1]
+		remaining := segments[2:]
+		if len(remaining) == 0 {
+			return SubscriptionScope{
+				SubscriptionId: subscriptionId,
+			}, nil
+		}
+
+		resourceGroup := ""
+		if remaining[0] == "resourceGroups" {
+			resourceGroup = remaining[1]
+			remaining = remaining[2:]
+			if len(remaining) == 0 {
+				return ResourceGroupScope{
+					SubscriptionId: subscriptionId,
+					ResourceGroup:  resourceGroup,
+				}, nil
+			}
+		}
+
+		if remaining[0] != "providers" {
+			return nil, fmt.Errorf("ID was missing the 'providers' element in the Scope %q", input)
+		}
+
+		return ResourceScope{
+			SubscriptionId: subscriptionId,
+			ResourceGroup:  resourceGroup,
+			Resource:       strings.Join(remaining, "/"),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("expected the Scope %q to be the Tenant, a Management Group, Subscription, Resource Group or Resource ID", input)
+}
+
+// NormalizeScope returns the Resource ID of the Scope `input` with any trailing slash removed, and the casing of
+// the `subscriptions`, `resourceGroups` and `managementGroups` segments (and the `providers` segment which
+// follows these) fixed - the casing of the Resource ID of a Resource used as a Scope is otherwise unchanged.
+func NormalizeScope(input string) string {
+	segments := strings.Split(strings.TrimSuffix(input, "/"), "/")
+
+	var normalize = func(index int, expected string) bool {
+		if index >= len(segments) || !strings.EqualFold(segments[index], expected) {
+			return false
+		}
+
+		segments[index] = expected
+		return true
+	}
+
+	// the first segment is empty, since the Scope starts with a `/`
+	if normalize(1, "providers") {
+		if normalize(2, "Microsoft.Management") {
+			normalize(3, "managementGroups")
+		}
+	} else if normalize(1, "subscriptions") {
+		next := 3
+		if normalize(next, "resourceGroups") {
+			next += 2
+		}
+		normalize(next, "providers")
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// ParseScopedID parses the Resource ID `input` for an extension resource - that is a Resource ID (described by `format`,
+// e.g. `/providers/Microsoft.Authorization/roleAssignments/%s`) which is nested beneath a Scope (see ParseScope) - where
+// the Resource ID doesn't contain a Scope, this is nested beneath the TenantScope.
+//
+// The Scope is returned along with the value for each `%s` within the format, in order.
+func ParseScopedID(input, format string) (Scope, []string, error) {
+	if !strings.HasPrefix(input, "/") {
+		return nil, nil, fmt.Errorf("expected the ID %q to start with a `/`", input)
+	}
+
+	inputSegments := strings.Split(strings.TrimPrefix(strings.TrimSuffix(input, "/"), "/"), "/")
+	formatSegments := strings.Split(strings.TrimPrefix(format, "/"), "/")
+
+	scopeLength := len(inputSegments) - len(formatSegments)
+	if scopeLength < 0 {
+		return nil, nil, fmt.Errorf("expected the ID %q to be in the format %q", input, "{scope}"+format)
+	}
+
+	values := make([]string, 0)
+	for i, formatSegment := range formatSegments {
+		inputSegment := inputSegments[scopeLength+i]
+		if formatSegment == "%s" {
+			if inputSegment == "" {
+				return nil, nil, fmt.Errorf("ID was missing the value for the '%s' element", formatSegments[i-1])
+			}
+
+			values = append(values, inputSegment)
+			continue
+		}
+
+		if inputSegment != formatSegment {
+			return nil, nil, fmt.Errorf("ID was missing the '%s' element", formatSegment)
+		}
+	}
+
+	scope, err := ParseScope("/" + strings.Join(inputSegments[0:scopeLength], "/"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return scope, values, nil
+}
@@ -0,0 +1,280 @@
+package resourceid
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseScope(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected Scope
+	}{
+		{
+			Name:     "empty",
+			Input:    "",
+			Expected: TenantScope{},
+		},
+		{
+			Name:     "root",
+			Input:    "/",
+			Expected: TenantScope{},
+		},
+		{
+			Name:  "missing the leading slash",
+			Input: "subscriptions/12345678-1234-9876-4563-123456789012",
+		},
+		{
+			Name:  "trailing slash",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+		},
+		{
+			Name:  "missing the subscription id",
+			Input: "/subscriptions",
+		},
+		{
+			Name:     "management group",
+			Input:    "/providers/Microsoft.Management/managementGroups/group1",
+			Expected: ManagementGroupScope{Name: "group1"},
+		},
+		{
+			Name:     "resource within a management group",
+			Input:    "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyDefinitions/definition1",
+			Expected: ProviderScope{Resource: "providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyDefinitions/definition1"},
+		},
+		{
+			Name:     "tenant-level resource",
+			Input:    "/providers/Microsoft.Capacity/reservationOrders/order1",
+			Expected: ProviderScope{Resource: "providers/Microsoft.Capacity/reservationOrders/order1"},
+		},
+		{
+			Name:  "missing the resource name",
+			Input: "/providers/Microsoft.Capacity",
+		},
+		{
+			Name:     "subscription",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Expected: SubscriptionScope{SubscriptionId: "12345678-1234-9876-4563-123456789012"},
+		},
+		{
+			Name:  "lower-cased subscription",
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012",
+		},
+		{
+			Name:  "resource group",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			Expected: ResourceGroupScope{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+			},
+		},
+		{
+			Name:  "lower-cased resource group",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1",
+		},
+		{
+			Name:  "resource",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			Expected: ResourceScope{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+				Resource:       "providers/Microsoft.Storage/storageAccounts/account1",
+			},
+		},
+		{
+			Name:  "resource within a subscription",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/pricings/VirtualMachines",
+			Expected: ResourceScope{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				Resource:       "providers/Microsoft.Security/pricings/VirtualMachines",
+			},
+		},
+		{
+			Name:  "nested resource",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1",
+			Expected: ResourceScope{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+				Resource:       "providers/Microsoft.Sql/servers/server1/databases/database1",
+			},
+		},
+		{
+			Name:  "missing the providers segment",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/servers/server1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := ParseScope(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got %+v", actual)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+
+		// the Scope should be formatted into the original Resource ID (where the ID for the Tenant Scope is empty)
+		if expected := strings.TrimSuffix(v.Input, "/"); actual.ID() != expected {
+			t.Fatalf("Expected the ID %q but got %q", expected, actual.ID())
+		}
+	}
+}
+
+func TestNormalizeScope(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Input:    "/",
+			Expected: "",
+		},
+		{
+			Input:    "/PROVIDERS/Microsoft.Capacity/reservationOrders/order1",
+			Expected: "/providers/Microsoft.Capacity/reservationOrders/order1",
+		},
+		{
+			Input:    "/PROVIDERS/microsoft.management/MANAGEMENTGROUPS/Group1/",
+			Expected: "/providers/Microsoft.Management/managementGroups/Group1",
+		},
+		{
+			Input:    "/Subscriptions/12345678-1234-9876-4563-123456789012",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012",
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/Group1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1",
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/Group1/Providers/microsoft.storage/storageaccounts/account1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1/providers/microsoft.storage/storageaccounts/account1",
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/PROVIDERS/Microsoft.Security/pricings/VirtualMachines",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/pricings/VirtualMachines",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		if actual := NormalizeScope(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestParseScopedID(t *testing.T) {
+	format := "/providers/Microsoft.Authorization/roleAssignments/%s"
+	testData := []struct {
+		Name          string
+		Input         string
+		Error         bool
+		ExpectedScope Scope
+		ExpectedValue string
+	}{
+		{
+			Name:  "empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:          "tenant",
+			Input:         "/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			ExpectedScope: TenantScope{},
+			ExpectedValue: "assignment1",
+		},
+		{
+			Name:  "missing the name",
+			Input: "/providers/Microsoft.Authorization/roleAssignments",
+			Error: true,
+		},
+		{
+			Name:  "missing the value",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/",
+			Error: true,
+		},
+		{
+			Name:  "missing the leading slash",
+			Input: "subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			Error: true,
+		},
+		{
+			Name:  "empty segment within the scope",
+			Input: "/subscriptions//resourceGroups/group1/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			Error: true,
+		},
+		{
+			Name:  "wrong casing",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleassignments/assignment1",
+			Error: true,
+		},
+		{
+			Name:          "subscription",
+			Input:         "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			ExpectedScope: SubscriptionScope{SubscriptionId: "12345678-1234-9876-4563-123456789012"},
+			ExpectedValue: "assignment1",
+		},
+		{
+			Name:          "management group",
+			Input:         "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/roleAssignments/assignment1/",
+			ExpectedScope: ManagementGroupScope{Name: "group1"},
+			ExpectedValue: "assignment1",
+		},
+		{
+			Name:          "tenant-level resource",
+			Input:         "/providers/Microsoft.Capacity/reservationOrders/order1/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			ExpectedScope: ProviderScope{Resource: "providers/Microsoft.Capacity/reservationOrders/order1"},
+			ExpectedValue: "assignment1",
+		},
+		{
+			Name:  "resource",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			ExpectedScope: ResourceScope{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+				Resource:       "providers/Microsoft.Storage/storageAccounts/account1",
+			},
+			ExpectedValue: "assignment1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		scope, values, err := ParseScopedID(v.Input, format)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if scope != v.ExpectedScope {
+			t.Fatalf("Expected the Scope %+v but got %+v", v.ExpectedScope, scope)
+		}
+		if !reflect.DeepEqual(values, []string{v.ExpectedValue}) {
+			t.Fatalf("Expected the values %+v but got %+v", []string{v.ExpectedValue}, values)
+		}
+	}
+}
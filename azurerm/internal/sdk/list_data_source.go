@@ -0,0 +1,370 @@
+package sdk
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// A ListDataSource is a Data Source which lists the resources of a given type, filtered
+// using the values specified in the Terraform Configuration - for example `azurerm_public_ips`
+//
+// The ListDataSource returns an iterator for the (paged) List API, which is iterated through
+// until all pages have been retrieved - and each item is filtered (see the `listfilter` tag
+// on the FilterModel) and then encoded into the list of items returned from the Data Source.
+//
+// This is converted into a DataSource (and in-turn registered) using NewListDataSource.
+type ListDataSource interface {
+	// Arguments is a list of user-configurable arguments used to filter the list of items
+	Arguments() map[string]*schema.Schema
+
+	// FilterModel is an instance of the object the Arguments are decoded into
+	//
+	// The `listfilter` struct tag can be used to filter the items on a field within the ItemModel,
+	// in the format `listfilter:"{tfschema tag within the ItemModel},{comparison}"` where the
+	// comparison is either `equals` (the default) or `prefix`. These filters are only evaluated
+	// when a value has been specified (e.g. a non-empty string or a non-nil pointer) - fields
+	// without a `listfilter` tag (e.g. the Resource Group Name) are passed through to List.
+	FilterModel() interface{}
+
+	// ItemAttributes is the Schema for each item returned from this Data Source
+	ItemAttributes() map[string]*schema.Schema
+
+	// ItemModel is an instance of the object each item is encoded from
+	ItemModel() interface{}
+
+	// ItemsKey is the name of the attribute containing the list of items (e.g. `public_ips`)
+	ItemsKey() string
+
+	// ResourceType is the exposed name of this data source (e.g. `azurerm_public_ips`)
+	ResourceType() string
+
+	// List is a ListFunc which returns the iterator for the items matching the FilterModel
+	List() ListFunc
+
+	// FlattenItem converts the value returned from the iterator (e.g. a `network.PublicIPAddress`)
+	// into the ItemModel - returning nil (without an error) means this item is excluded
+	FlattenItem(input interface{}) (interface{}, error)
+}
+
+// ListDataSourceWithCustomFilter is an optional interface
+//
+// List Data Sources implementing this interface can filter the items in ways which
+// aren't possible using the `listfilter` struct tags, for example where this
+// requires comparing against multiple fields within the ItemModel.
+type ListDataSourceWithCustomFilter interface {
+	ListDataSource
+
+	// IncludeItem returns whether the item (an instance of the ItemModel) should be included
+	// for the specified filter (an instance of the FilterModel) - this is run after the
+	// filters specified using the `listfilter` struct tags have been evaluated
+	IncludeItem(filter interface{}, item interface{}) (bool, error)
+}
+
+// ListIterator is the subset of the `*ListResultIterator` types returned from the `*Complete`
+// functions within the Azure SDK for Go, which is required to iterate through each item
+//
+// NOTE: the iterator must also expose a `Value()` method returning the current item, this
+// isn't part of this interface since the type differs for each iterator
+type ListIterator interface {
+	// NotDone returns whether there are further items to iterate through
+	NotDone() bool
+
+	// NextWithContext advances to the next item, retrieving the next page where required
+	NextWithContext(ctx context.Context) error
+}
+
+// ListRunFunc returns the iterator for the items matching the filter - which is an instance of the FilterModel
+type ListRunFunc func(ctx context.Context, metadata ResourceMetaData, filter interface{}) (ListIterator, error)
+
+type ListFunc struct {
+	// Func is the function which should be called to list the items
+	Func ListRunFunc
+
+	// Timeout is the default timeout, which can be overridden by users
+	// for this method - in-turn used for the Azure API
+	Timeout time.Duration
+}
+
+// listItemsFieldName is the name of the field containing the items within the generated Model Object
+const listItemsFieldName = "ListDataSourceItems"
+
+// NewListDataSource returns a DataSource for the specified ListDataSource
+func NewListDataSource(dataSource ListDataSource) DataSource {
+	return listDataSourceAdapter{
+		dataSource: dataSource,
+	}
+}
+
+var _ DataSource = listDataSourceAdapter{}
+
+// listDataSourceAdapter converts a ListDataSource into a DataSource, with a Model Object
+// containing the fields within the FilterModel and a list of the ItemModel
+type listDataSourceAdapter struct {
+	dataSource ListDataSource
+}
+
+func (a listDataSourceAdapter) Arguments() map[string]*schema.Schema {
+	return a.dataSource.Arguments()
+}
+
+func (a listDataSourceAdapter) Attributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		a.dataSource.ItemsKey(): {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: a.dataSource.ItemAttributes(),
+			},
+		},
+	}
+}
+
+func (a listDataSourceAdapter) ModelObject() interface{} {
+	return reflect.New(a.modelType()).Elem().Interface()
+}
+
+func (a listDataSourceAdapter) ResourceType() string {
+	return a.dataSource.ResourceType()
+}
+
+// modelType returns a struct type containing each field within the FilterModel and the list of items
+func (a listDataSourceAdapter) modelType() reflect.Type {
+	filterType := reflect.TypeOf(a.dataSource.FilterModel())
+	fields := make([]reflect.StructField, 0)
+	for i := 0; i < filterType.NumField(); i++ {
+		field := filterType.Field(i)
+		fields = append(fields, reflect.StructField{
+			Name: field.Name,
+			Type: field.Type,
+			Tag:  field.Tag,
+		})
+	}
+
+	fields = append(fields, reflect.StructField{
+		Name: listItemsFieldName,
+		Type: reflect.SliceOf(reflect.TypeOf(a.dataSource.ItemModel())),
+		Tag:  reflect.StructTag(fmt.Sprintf(`tfschema:%q`, a.dataSource.ItemsKey())),
+	})
+
+	return reflect.StructOf(fields)
+}
+
+func (a listDataSourceAdapter) Read() ResourceFunc {
+	return ResourceFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			model := reflect.New(a.modelType())
+			if err := metadata.Decode(model.Interface()); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the fields within the FilterModel are the first fields within the Model Object
+			filter := reflect.New(reflect.TypeOf(a.dataSource.FilterModel())).Elem()
+			for i := 0; i < filter.NumField(); i++ {
+				filter.Field(i).Set(model.Elem().Field(i))
+			}
+
+			filters, err := parseListFilters(filter.Type(), reflect.TypeOf(a.dataSource.ItemModel()))
+			if err != nil {
+				return fmt.Errorf("parsing the `listfilter` tags for %q: %+v", a.dataSource.ResourceType(), err)
+			}
+
+			metadata.Logger.Info("listing items..")
+			iterator, err := a.dataSource.List().Func(ctx, metadata, filter.Interface())
+			if err != nil {
+				return fmt.Errorf("listing items: %+v", err)
+			}
+
+			items, err := a.iterate(ctx, iterator, filter, filters)
+			if err != nil {
+				return err
+			}
+			metadata.Logger.Infof("found %d items matching the filter", items.Len())
+			model.Elem().FieldByName(listItemsFieldName).Set(items)
+
+			id, err := listDataSourceID(a.dataSource.ResourceType(), metadata, filter.Interface())
+			if err != nil {
+				return fmt.Errorf("building ID: %+v", err)
+			}
+			metadata.ResourceData.SetId(id)
+
+			return metadata.Encode(model.Interface())
+		},
+		Timeout: a.dataSource.List().Timeout,
+	}
+}
+
+// iterate iterates through each page of results, returning a slice of the ItemModel's matching the filters
+func (a listDataSourceAdapter) iterate(ctx context.Context, iterator ListIterator, filter reflect.Value, filters []listFilter) (reflect.Value, error) {
+	itemType := reflect.TypeOf(a.dataSource.ItemModel())
+	items := reflect.MakeSlice(reflect.SliceOf(itemType), 0, 0)
+	if iterator == nil {
+		return items, nil
+	}
+
+	valueFunc := reflect.ValueOf(iterator).MethodByName("Value")
+	if !valueFunc.IsValid() || valueFunc.Type().NumIn() != 0 || valueFunc.Type().NumOut() != 1 {
+		return items, fmt.Errorf("the iterator %T must expose a `Value()` method returning the current item", iterator)
+	}
+
+	customFilter, hasCustomFilter := a.dataSource.(ListDataSourceWithCustomFilter)
+	for iterator.NotDone() {
+		flattened, err := a.dataSource.FlattenItem(valueFunc.Call(nil)[0].Interface())
+		if err != nil {
+			return items, fmt.Errorf("flattening item: %+v", err)
+		}
+
+		if flattened != nil {
+			item := reflect.Indirect(reflect.ValueOf(flattened))
+			if item.Type() != itemType {
+				return items, fmt.Errorf("expected FlattenItem to return a %s but got %T", itemType, flattened)
+			}
+
+			include := true
+			for _, f := range filters {
+				if !f.matches(filter, item) {
+					include = false
+					break
+				}
+			}
+			if include && hasCustomFilter {
+				if include, err = customFilter.IncludeItem(filter.Interface(), item.Interface()); err != nil {
+					return items, fmt.Errorf("filtering item: %+v", err)
+				}
+			}
+
+			if include {
+				items = reflect.Append(items, item)
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return items, fmt.Errorf("retrieving the next page of items: %+v", err)
+		}
+	}
+
+	return items, nil
+}
+
+// listDataSourceID returns a stable ID for this Data Source, based on the Subscription and the filter
+func listDataSourceID(resourceType string, metadata ResourceMetaData, filter interface{}) (string, error) {
+	// json.Marshal outputs the fields in a consistent order, meaning this is stable
+	serialized, err := json.Marshal(filter)
+	if err != nil {
+		return "", err
+	}
+
+	subscriptionId := ""
+	if metadata.Client != nil && metadata.Client.Account != nil {
+		subscriptionId = metadata.Client.Account.SubscriptionId
+	}
+
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s", subscriptionId, resourceType, string(serialized))))
+	return fmt.Sprintf("%s-%x", resourceType, hash), nil
+}
+
+type listFilterComparison string
+
+const (
+	listFilterEquals listFilterComparison = "equals"
+	listFilterPrefix listFilterComparison = "prefix"
+)
+
+// listFilter is a filter defined using the `listfilter` struct tag on a field within the FilterModel
+type listFilter struct {
+	filterIndex int
+	itemIndex   int
+	comparison  listFilterComparison
+}
+
+// parseListFilters parses the `listfilter` struct tags within the FilterModel, validating that each
+// references a field within the ItemModel of a compatible type
+func parseListFilters(filterType reflect.Type, itemType reflect.Type) ([]listFilter, error) {
+	itemFields := make(map[string]int)
+	for i := 0; i < itemType.NumField(); i++ {
+		if tag, ok := itemType.Field(i).Tag.Lookup("tfschema"); ok {
+			itemFields[tag] = i
+		}
+	}
+
+	filters := make([]listFilter, 0)
+	for i := 0; i < filterType.NumField(); i++ {
+		field := filterType.Field(i)
+		tag, ok := field.Tag.Lookup("listfilter")
+		if !ok {
+			continue
+		}
+
+		split := strings.Split(tag, ",")
+		itemIndex, ok := itemFields[split[0]]
+		if !ok {
+			return nil, fmt.Errorf("field %q filters on %q which doesn't exist within the ItemModel", field.Name, split[0])
+		}
+
+		comparison := listFilterEquals
+		if len(split) > 1 {
+			comparison = listFilterComparison(split[1])
+		}
+
+		filterValueType := field.Type
+		if filterValueType.Kind() == reflect.Ptr {
+			filterValueType = filterValueType.Elem()
+		}
+		itemValueType := itemType.Field(itemIndex).Type
+		if itemValueType.Kind() == reflect.Ptr {
+			itemValueType = itemValueType.Elem()
+		}
+
+		switch comparison {
+		case listFilterEquals:
+			if filterValueType != itemValueType || !filterValueType.Comparable() {
+				return nil, fmt.Errorf("field %q is a %s but the field %q within the ItemModel is a %s", field.Name, field.Type, split[0], itemType.Field(itemIndex).Type)
+			}
+
+		case listFilterPrefix:
+			if filterValueType.Kind() != reflect.String || itemValueType.Kind() != reflect.String {
+				return nil, fmt.Errorf("field %q uses a `prefix` filter which requires both fields are strings", field.Name)
+			}
+
+		default:
+			return nil, fmt.Errorf("field %q uses an unsupported comparison %q - supported values are `equals` and `prefix`", field.Name, comparison)
+		}
+
+		filters = append(filters, listFilter{
+			filterIndex: i,
+			itemIndex:   itemIndex,
+			comparison:  comparison,
+		})
+	}
+
+	return filters, nil
+}
+
+// matches returns whether the item matches this filter - which is always the case when the filter is unset
+func (f listFilter) matches(filter reflect.Value, item reflect.Value) bool {
+	filterValue := filter.Field(f.filterIndex)
+	if filterValue.IsZero() {
+		return true
+	}
+	filterValue = reflect.Indirect(filterValue)
+
+	itemValue := item.Field(f.itemIndex)
+	if itemValue.Kind() == reflect.Ptr {
+		if itemValue.IsNil() {
+			return false
+		}
+		itemValue = itemValue.Elem()
+	}
+
+	if f.comparison == listFilterPrefix {
+		return strings.HasPrefix(itemValue.String(), filterValue.String())
+	}
+
+	return filterValue.Interface() == itemValue.Interface()
+}
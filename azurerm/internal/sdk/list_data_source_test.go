@@ -0,0 +1,286 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// listTestApiModel is the type returned from the (fake) Azure SDK
+type listTestApiModel struct {
+	Name     *string
+	Sku      *string
+	Attached *bool
+}
+
+// listTestIterator is a fake of the `*ListResultIterator` types within the Azure SDK
+type listTestIterator struct {
+	pages [][]listTestApiModel
+	page  int
+	index int
+}
+
+func (i *listTestIterator) NextWithContext(_ context.Context) error {
+	i.index++
+	if i.index < len(i.pages[i.page]) {
+		return nil
+	}
+
+	i.page++
+	i.index = 0
+	return nil
+}
+
+func (i listTestIterator) NotDone() bool {
+	return i.page < len(i.pages) && i.index < len(i.pages[i.page])
+}
+
+func (i listTestIterator) Value() listTestApiModel {
+	return i.pages[i.page][i.index]
+}
+
+type listTestFilter struct {
+	ResourceGroup string `tfschema:"resource_group_name"`
+	NamePrefix    string `tfschema:"name_prefix" listfilter:"name,prefix"`
+	Sku           string `tfschema:"sku" listfilter:"sku"`
+	Attached      *bool  `tfschema:"attached" listfilter:"attached"`
+}
+
+type listTestItem struct {
+	Name     string `tfschema:"name"`
+	Sku      string `tfschema:"sku"`
+	Attached bool   `tfschema:"attached"`
+}
+
+type listTestDataSource struct {
+	pages [][]listTestApiModel
+}
+
+var _ ListDataSourceWithCustomFilter = listTestDataSource{}
+
+func (listTestDataSource) Arguments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"resource_group_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"name_prefix": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"sku": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"attached": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+	}
+}
+
+func (listTestDataSource) FilterModel() interface{} {
+	return listTestFilter{}
+}
+
+func (listTestDataSource) ItemAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sku": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"attached": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func (listTestDataSource) ItemModel() interface{} {
+	return listTestItem{}
+}
+
+func (listTestDataSource) ItemsKey() string {
+	return "things"
+}
+
+func (listTestDataSource) ResourceType() string {
+	return "validator_things"
+}
+
+func (ds listTestDataSource) List() ListFunc {
+	return ListFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData, filter interface{}) (ListIterator, error) {
+			if filter.(listTestFilter).ResourceGroup == "" {
+				return nil, fmt.Errorf("expected the resource group to be decoded")
+			}
+
+			return &listTestIterator{
+				pages: ds.pages,
+			}, nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (listTestDataSource) FlattenItem(input interface{}) (interface{}, error) {
+	v := input.(listTestApiModel)
+	if v.Name == nil {
+		return nil, nil
+	}
+
+	item := listTestItem{
+		Name: *v.Name,
+	}
+	if v.Sku != nil {
+		item.Sku = *v.Sku
+	}
+	if v.Attached != nil {
+		item.Attached = *v.Attached
+	}
+	return &item, nil
+}
+
+func (listTestDataSource) IncludeItem(_ interface{}, item interface{}) (bool, error) {
+	return !strings.HasSuffix(item.(listTestItem).Name, "-excluded"), nil
+}
+
+func TestListDataSource(t *testing.T) {
+	pages := [][]listTestApiModel{
+		{
+			{Name: utils.String("first"), Sku: utils.String("Basic"), Attached: utils.Bool(true)},
+			{Name: utils.String("second"), Sku: utils.String("Standard"), Attached: utils.Bool(false)},
+			{Name: nil},
+		},
+		{
+			{Name: utils.String("first-excluded"), Sku: utils.String("Basic")},
+			{Name: utils.String("third"), Sku: utils.String("Basic")},
+		},
+	}
+
+	testData := []struct {
+		Name     string
+		Config   map[string]interface{}
+		Expected []string
+	}{
+		{
+			Name: "No Filters",
+			Config: map[string]interface{}{
+				"resource_group_name": "example",
+			},
+			Expected: []string{"first", "second", "third"},
+		},
+		{
+			Name: "Prefix",
+			Config: map[string]interface{}{
+				"resource_group_name": "example",
+				"name_prefix":         "fi",
+			},
+			Expected: []string{"first"},
+		},
+		{
+			Name: "Equals",
+			Config: map[string]interface{}{
+				"resource_group_name": "example",
+				"sku":                 "Basic",
+			},
+			Expected: []string{"first", "third"},
+		},
+		{
+			Name: "Pointer Equals",
+			Config: map[string]interface{}{
+				"resource_group_name": "example",
+				"attached":            false,
+			},
+			Expected: []string{"second", "third"},
+		},
+	}
+
+	wrapper := NewDataSourceWrapper(NewListDataSource(listTestDataSource{pages: pages}))
+	resource, err := wrapper.DataSource()
+	if err != nil {
+		t.Fatalf("building data source: %+v", err)
+	}
+	if err := ValidateModelObjectMatchesSchema(&listTestItem{}, listTestDataSource{}.ItemAttributes()); err != nil {
+		t.Fatalf("validating item model: %+v", err)
+	}
+
+	ids := make(map[string]struct{})
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		d := schema.TestResourceDataRaw(t, resource.Schema, v.Config)
+		metadata := ResourceMetaData{
+			Client:                   &clients.Client{},
+			Logger:                   NullLogger{},
+			ResourceData:             d,
+			serializationDebugLogger: NullLogger{},
+		}
+		ctx, cancel := context.WithTimeout(context.TODO(), time.Minute)
+		err := NewListDataSource(listTestDataSource{pages: pages}).Read().Func(ctx, metadata)
+		cancel()
+		if err != nil {
+			t.Fatalf("reading: %+v", err)
+		}
+
+		actual := make([]string, 0)
+		for _, raw := range d.Get("things").([]interface{}) {
+			actual = append(actual, raw.(map[string]interface{})["name"].(string))
+		}
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+
+		if _, exists := ids[d.Id()]; exists || d.Id() == "" {
+			t.Fatalf("expected a unique ID for each filter but got %q", d.Id())
+		}
+		ids[d.Id()] = struct{}{}
+
+		// the ID should be stable for the same filter
+		again := schema.TestResourceDataRaw(t, resource.Schema, v.Config)
+		metadata.ResourceData = again
+		if err := NewListDataSource(listTestDataSource{}).Read().Func(context.TODO(), metadata); err != nil {
+			t.Fatalf("reading: %+v", err)
+		}
+		if again.Id() != d.Id() {
+			t.Fatalf("expected the ID to be stable but got %q and %q", d.Id(), again.Id())
+		}
+	}
+}
+
+func TestParseListFilters(t *testing.T) {
+	itemType := reflect.TypeOf(listTestItem{})
+	testData := map[string]interface{}{
+		"unknown field": struct {
+			Value string `tfschema:"value" listfilter:"unknown"`
+		}{},
+		"mismatched type": struct {
+			Value int `tfschema:"value" listfilter:"name"`
+		}{},
+		"prefix on a bool": struct {
+			Value bool `tfschema:"value" listfilter:"attached,prefix"`
+		}{},
+		"unsupported comparison": struct {
+			Value string `tfschema:"value" listfilter:"name,suffix"`
+		}{},
+	}
+
+	for name, filter := range testData {
+		t.Logf("[DEBUG] Testing %q..", name)
+
+		if _, err := parseListFilters(reflect.TypeOf(filter), itemType); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
@@ -2,6 +2,13 @@ package sdk
 
 // Logger is an interface for switching out the Logger implementation
 type Logger interface {
+	// Debug prints out a message prefixed with `[DEBUG]` verbatim
+	Debug(message string)
+
+	// Debugf prints out a message prefixed with `[DEBUG]` formatted
+	// with the specified arguments
+	Debugf(format string, args ...interface{})
+
 	// Info prints out a message prefixed with `[INFO]` verbatim
 	Info(message string)
 
@@ -15,4 +22,47 @@ type Logger interface {
 	// Warnf prints out a message prefixed with `[WARN]` formatted
 	// with the specified arguments
 	Warnf(format string, args ...interface{})
+
+	// Error prints out a message prefixed with `[ERROR]` verbatim
+	Error(message string)
+
+	// Errorf prints out a message prefixed with `[ERROR]` formatted
+	// with the specified arguments
+	Errorf(format string, args ...interface{})
+
+	// WithFields returns a copy of this Logger which includes the specified
+	// key/value pairs (in addition to any existing fields) in each message
+	WithFields(fields LogFields) Logger
+}
+
+// LogFields are key/value pairs which are included in each message
+//
+// Values can either be static or a `func() string` which is evaluated each
+// time a message is logged, for example to log the current Resource ID
+type LogFields map[string]interface{}
+
+const (
+	// LogFieldCorrelationRequestID is the Correlation Request ID sent to Azure in each request
+	LogFieldCorrelationRequestID = "correlation_request_id"
+
+	// LogFieldOperation is the operation being performed, e.g. `create` or `read`
+	LogFieldOperation = "operation"
+
+	// LogFieldResourceID is the ID of the Resource being managed, once known
+	LogFieldResourceID = "resource_id"
+
+	// LogFieldResourceType is the Terraform Resource Type, e.g. `azurerm_resource_group`
+	LogFieldResourceType = "resource_type"
+)
+
+// merge returns a new LogFields containing the existing fields and the specified fields
+func (f LogFields) merge(other LogFields) LogFields {
+	out := make(LogFields, len(f)+len(other))
+	for k, v := range f {
+		out[k] = v
+	}
+	for k, v := range other {
+		out[k] = v
+	}
+	return out
 }
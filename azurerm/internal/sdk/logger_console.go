@@ -1,18 +1,51 @@
 package sdk
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"os"
+	"sort"
+	"strings"
 )
 
 // ConsoleLogger provides a Logger implementation which writes the log messages
 // to StdOut - in Terraform's perspective that's proxied via the Plugin SDK
+//
+// Each message is prefixed with the level (e.g. `[INFO]`) so that these can be
+// filtered using `TF_LOG` - when JSON is enabled the message and any fields are
+// output as a JSON object following this prefix, for example:
+//
+//	[INFO] {"@level":"info","@message":"creating..","operation":"create","resource_type":"azurerm_example"}
 type ConsoleLogger struct {
+	// JSON specifies whether the message and fields should be output as a JSON object
+	JSON bool
+
+	fields LogFields
+}
+
+// NewConsoleLogger returns a ConsoleLogger, which outputs JSON when the
+// Environment Variable `ARM_LOG_FORMAT` is set to `json`
+func NewConsoleLogger() ConsoleLogger {
+	return ConsoleLogger{
+		JSON: strings.EqualFold(os.Getenv("ARM_LOG_FORMAT"), "json"),
+	}
+}
+
+// Debug prints out a message prefixed with `[DEBUG]` verbatim
+func (l ConsoleLogger) Debug(message string) {
+	log.Print(l.format("DEBUG", message))
+}
+
+// Debugf prints out a message prefixed with `[DEBUG]` formatted
+// with the specified arguments
+func (l ConsoleLogger) Debugf(format string, args ...interface{}) {
+	l.Debug(fmt.Sprintf(format, args...))
 }
 
 // Info prints out a message prefixed with `[INFO]` verbatim
-func (ConsoleLogger) Info(message string) {
-	log.Print(fmt.Sprintf("[INFO] %s", message))
+func (l ConsoleLogger) Info(message string) {
+	log.Print(l.format("INFO", message))
 }
 
 // Infof prints out a message prefixed with `[INFO]` formatted
@@ -23,7 +56,7 @@ func (l ConsoleLogger) Infof(format string, args ...interface{}) {
 
 // Warn prints out a message prefixed with `[WARN]` formatted verbatim
 func (l ConsoleLogger) Warn(message string) {
-	log.Print(fmt.Sprintf("[WARN] %s", message))
+	log.Print(l.format("WARN", message))
 }
 
 // Warnf prints out a message prefixed with `[WARN]` formatted
@@ -31,3 +64,82 @@ func (l ConsoleLogger) Warn(message string) {
 func (l ConsoleLogger) Warnf(format string, args ...interface{}) {
 	l.Warn(fmt.Sprintf(format, args...))
 }
+
+// Error prints out a message prefixed with `[ERROR]` verbatim
+func (l ConsoleLogger) Error(message string) {
+	log.Print(l.format("ERROR", message))
+}
+
+// Errorf prints out a message prefixed with `[ERROR]` formatted
+// with the specified arguments
+func (l ConsoleLogger) Errorf(format string, args ...interface{}) {
+	l.Error(fmt.Sprintf(format, args...))
+}
+
+// WithFields returns a copy of this Logger which includes the specified fields in each message
+func (l ConsoleLogger) WithFields(fields LogFields) Logger {
+	return ConsoleLogger{
+		JSON:   l.JSON,
+		fields: l.fields.merge(fields),
+	}
+}
+
+// format returns the log line for this message, including any fields which have a value
+func (l ConsoleLogger) format(level string, message string) string {
+	fields := l.resolvedFields()
+
+	if l.JSON {
+		out := make(map[string]interface{}, len(fields)+2)
+		for k, v := range fields {
+			out[k] = v
+		}
+		out["@level"] = strings.ToLower(level)
+		out["@message"] = message
+
+		// json.Marshal sorts the keys, so the output is consistent
+		body, err := json.Marshal(out)
+		if err == nil {
+			return fmt.Sprintf("[%s] %s", level, string(body))
+		}
+
+		// this shouldn't happen since each value is a string, but if it does we still want the message
+		log.Printf("[WARN] serializing log message to JSON: %+v", err)
+	}
+
+	if len(fields) == 0 {
+		return fmt.Sprintf("[%s] %s", level, message)
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", k, fields[k]))
+	}
+	return fmt.Sprintf("[%s] %s (%s)", level, message, strings.Join(pairs, " "))
+}
+
+// resolvedFields returns the value for each field, omitting those without a value
+func (l ConsoleLogger) resolvedFields() map[string]string {
+	out := make(map[string]string, len(l.fields))
+	for k, v := range l.fields {
+		var value string
+		switch t := v.(type) {
+		case func() string:
+			value = t()
+		case string:
+			value = t
+		default:
+			value = fmt.Sprintf("%v", t)
+		}
+
+		if value != "" {
+			out[k] = value
+		}
+	}
+	return out
+}
@@ -0,0 +1,85 @@
+package sdk
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestConsoleLoggerFormat(t *testing.T) {
+	resourceId := ""
+	logger := ConsoleLogger{}.WithFields(LogFields{
+		LogFieldResourceType: "azurerm_example",
+		LogFieldOperation:    "create",
+		LogFieldResourceID: func() string {
+			return resourceId
+		},
+	}).(ConsoleLogger)
+
+	testData := []struct {
+		Name       string
+		Logger     ConsoleLogger
+		ResourceId string
+		Expected   string
+	}{
+		{
+			Name:     "no fields",
+			Logger:   ConsoleLogger{},
+			Expected: `[INFO] hello`,
+		},
+		{
+			Name:     "with fields",
+			Logger:   logger,
+			Expected: `[INFO] hello (operation="create" resource_type="azurerm_example")`,
+		},
+		{
+			Name:       "with fields evaluated when logging",
+			Logger:     logger,
+			ResourceId: "/subscriptions/1234",
+			Expected:   `[INFO] hello (operation="create" resource_id="/subscriptions/1234" resource_type="azurerm_example")`,
+		},
+		{
+			Name: "json",
+			Logger: ConsoleLogger{
+				JSON:   true,
+				fields: logger.fields,
+			},
+			ResourceId: "/subscriptions/1234",
+			Expected:   `[INFO] {"@level":"info","@message":"hello","operation":"create","resource_id":"/subscriptions/1234","resource_type":"azurerm_example"}`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		resourceId = v.ResourceId
+		actual := v.Logger.format("INFO", "hello")
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestConsoleLoggerWithFieldsDoesNotModifyParent(t *testing.T) {
+	parent := ConsoleLogger{JSON: true}.WithFields(LogFields{
+		LogFieldResourceType: "azurerm_example",
+	}).(ConsoleLogger)
+	child := parent.WithFields(LogFields{
+		LogFieldOperation: "read",
+	}).(ConsoleLogger)
+
+	if _, ok := parent.fields[LogFieldOperation]; ok {
+		t.Fatalf("expected the parent logger not to contain the child's fields")
+	}
+	if !child.JSON {
+		t.Fatalf("expected the child logger to retain the output format")
+	}
+
+	var out map[string]interface{}
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(child.format("ERROR", "boom"), "[ERROR] ")), &out); err != nil {
+		t.Fatalf("parsing JSON: %+v", err)
+	}
+	if out["@level"] != "error" || out[LogFieldResourceType] != "azurerm_example" || out[LogFieldOperation] != "read" {
+		t.Fatalf("unexpected output: %+v", out)
+	}
+}
@@ -62,9 +62,29 @@ type Resource interface {
 	IDValidationFunc() schema.SchemaValidateFunc
 }
 
-// TODO: ResourceWithCustomizeDiff
-// TODO: ResourceWithStateMigration
-// TODO: a generic state migration for updating ID's
+// ResourceWithCustomizeDiff is an optional interface
+//
+// Resources implementing this interface can validate combinations of fields
+// and make changes to the Plan (for example marking a field as ForceNew or
+// Computed) during `terraform plan` - rather than during `terraform apply`.
+type ResourceWithCustomizeDiff interface {
+	Resource
+
+	// CustomizeDiff returns a ResourceDiffFunc which is run during the Plan
+	CustomizeDiff() ResourceDiffFunc
+}
+
+// ResourceWithStateMigration is an optional interface
+//
+// Resources implementing this interface have a versioned Schema and
+// provide a StateUpgrade for each previous version of the Schema, which
+// Terraform runs (in order) to upgrade the existing State.
+type ResourceWithStateMigration interface {
+	Resource
+
+	// StateUpgraders returns the current Schema Version and the StateUpgrade's for this Resource
+	StateUpgraders() StateUpgradeData
+}
 
 type ResourceWithCustomImporter interface {
 	Resource
@@ -114,6 +134,20 @@ type ResourceFunc struct {
 	Timeout time.Duration
 }
 
+// ResourceDiffRunFunc is the function which is run during a CustomizeDiff
+// ctx provides a Context instance with the timeout specified in the ResourceDiffFunc
+// metadata is a reference to an object containing the Client, ResourceDiff and a Logger
+type ResourceDiffRunFunc func(ctx context.Context, metadata ResourceDiffMetaData) error
+
+type ResourceDiffFunc struct {
+	// Func is the function which should be called during the CustomizeDiff
+	Func ResourceDiffRunFunc
+
+	// Timeout is the timeout for this function - notably this cannot
+	// be overridden by users since this runs during the Plan
+	Timeout time.Duration
+}
+
 type ResourceMetaData struct {
 	// Client is a reference to the Azure Providers Client - providing a typed reference to this object
 	Client *clients.Client
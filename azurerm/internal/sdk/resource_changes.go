@@ -0,0 +1,164 @@
+package sdk
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// ModelChanges provides a typed view of the changes between the existing values (from
+// the Terraform State) and the planned values (from the Terraform Configuration/Plan)
+// for a Model Object, which allows Update functions to only send the changed fields
+// without having to duplicate the `tfschema` tags when checking for changes.
+type ModelChanges struct {
+	old reflect.Value
+	new reflect.Value
+
+	// changedFields is a map of the `tfschema` tag to whether that field has changed
+	changedFields map[string]bool
+}
+
+// DecodeChanges decodes the existing values from the Terraform State into `old` and the planned
+// values into `new` - returning the changes between the two. Both of these must be pointers to
+// the same Model Object type, which must contain `tfschema` struct tags for all fields.
+//
+// Example Usage:
+//
+//	var old, config ResourceGroup
+//	changes, err := metadata.DecodeChanges(&old, &config)
+//	if err != nil {
+//		return err
+//	}
+//
+//	patch := resources.GroupPatchable{}
+//	changed, err := changes.HasChangeFor(&config.Tags)
+//	if err != nil {
+//		return err
+//	}
+//	if changed {
+//		patch.Tags = tags.FromTypedObject(config.Tags)
+//	}
+func (rmd ResourceMetaData) DecodeChanges(old interface{}, new interface{}) (*ModelChanges, error) {
+	return decodeChanges(old, new, rmd.ResourceData, rmd.ResourceData, rmd.ResourceData, rmd.serializationDebugLogger)
+}
+
+// DecodeChanges decodes the existing values from the Terraform State into `old` and the planned
+// values into `new` - returning the changes between the two, see ResourceMetaData.DecodeChanges
+func (rdmd ResourceDiffMetaData) DecodeChanges(old interface{}, new interface{}) (*ModelChanges, error) {
+	return decodeChanges(old, new, rdmd.ResourceDiff, rdmd.ResourceDiff, rdmd.ResourceDiff, rdmd.serializationDebugLogger)
+}
+
+// changeDetector is implemented by both the ResourceData and ResourceDiff from the Plugin SDK
+type changeDetector interface {
+	HasChange(key string) bool
+}
+
+func decodeChanges(old interface{}, new interface{}, state changeRetriever, newState stateRetriever, detector changeDetector, debugLogger Logger) (*ModelChanges, error) {
+	if reflect.TypeOf(old) != reflect.TypeOf(new) {
+		return nil, fmt.Errorf("`old` and `new` must be the same type but got %T and %T", old, new)
+	}
+	if reflect.TypeOf(new).Kind() != reflect.Ptr || reflect.TypeOf(new).Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("need a pointer to a struct")
+	}
+
+	if err := decodeReflectedType(old, oldValueRetriever{diff: state}, debugLogger); err != nil {
+		return nil, fmt.Errorf("decoding the existing values: %+v", err)
+	}
+	if err := decodeReflectedType(new, newState, debugLogger); err != nil {
+		return nil, fmt.Errorf("decoding the planned values: %+v", err)
+	}
+
+	changes := ModelChanges{
+		old:           reflect.ValueOf(old).Elem(),
+		new:           reflect.ValueOf(new).Elem(),
+		changedFields: make(map[string]bool),
+	}
+	objType := changes.new.Type()
+	for i := 0; i < objType.NumField(); i++ {
+		if tag, ok := objType.Field(i).Tag.Lookup("tfschema"); ok {
+			changes.changedFields[tag] = detector.HasChange(tag)
+		}
+	}
+
+	return &changes, nil
+}
+
+// ChangedFields returns the `tfschema` tags of the top-level fields which have changed, sorted alphabetically
+func (c ModelChanges) ChangedFields() []string {
+	out := make([]string, 0)
+	for tag, changed := range c.changedFields {
+		if changed {
+			out = append(out, tag)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// HasChanges returns whether any of the fields within the Model Object have changed
+func (c ModelChanges) HasChanges() bool {
+	return len(c.ChangedFields()) > 0
+}
+
+// HasChangeFor returns whether the field referenced by `field` has changed, where `field` is
+// a pointer to a top-level field within either of the Model Objects passed to DecodeChanges,
+// for example `changes.HasChangeFor(&config.Tags)`
+//
+// An error is returned if `field` isn't a pointer to a field within either Model Object with a `tfschema` tag
+func (c ModelChanges) HasChangeFor(field interface{}) (bool, error) {
+	tag, err := c.tagForField(field)
+	if err != nil {
+		return false, err
+	}
+
+	return c.changedFields[tag], nil
+}
+
+// HasChangesFor returns whether any of the fields referenced by `fields` have changed
+//
+// An error is returned if any of the `fields` aren't a pointer to a field within either Model Object
+// with a `tfschema` tag
+func (c ModelChanges) HasChangesFor(fields ...interface{}) (bool, error) {
+	changed := false
+	for _, field := range fields {
+		// each field is checked so that invalid references are caught regardless of the order
+		fieldChanged, err := c.HasChangeFor(field)
+		if err != nil {
+			return false, err
+		}
+		if fieldChanged {
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+// tagForField returns the `tfschema` tag for the field within either Model Object which `field` points to
+func (c ModelChanges) tagForField(field interface{}) (string, error) {
+	fieldPtr := reflect.ValueOf(field)
+	if fieldPtr.Kind() != reflect.Ptr || fieldPtr.IsNil() {
+		return "", fmt.Errorf("expected a pointer to a field within the Model Object but got %T", field)
+	}
+
+	address := fieldPtr.Pointer()
+	fieldType := fieldPtr.Type().Elem()
+	objType := c.new.Type()
+	for _, model := range []reflect.Value{c.new, c.old} {
+		for i := 0; i < objType.NumField(); i++ {
+			// the type is compared too, since the first field shares the address of the Model Object itself
+			value := model.Field(i)
+			if value.Addr().Pointer() != address || value.Type() != fieldType {
+				continue
+			}
+
+			tag, ok := objType.Field(i).Tag.Lookup("tfschema")
+			if !ok {
+				return "", fmt.Errorf("the field %q doesn't have a `tfschema` tag", objType.Field(i).Name)
+			}
+
+			return tag, nil
+		}
+	}
+
+	return "", fmt.Errorf("the %T passed to HasChangeFor doesn't point to a top-level field within the Model Object (%s)", field, objType)
+}
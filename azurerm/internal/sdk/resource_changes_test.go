@@ -0,0 +1,156 @@
+package sdk
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+)
+
+func TestDecodeChanges(t *testing.T) {
+	testData := []struct {
+		Name            string
+		State           map[string]string
+		Config          map[string]interface{}
+		ExpectedChanges []string
+	}{
+		{
+			Name: "No Changes",
+			State: map[string]string{
+				"id":    "some-id",
+				"name":  "example",
+				"count": "2",
+			},
+			Config: map[string]interface{}{
+				"name":  "example",
+				"count": 2,
+			},
+			ExpectedChanges: []string{},
+		},
+		{
+			Name: "Single Change",
+			State: map[string]string{
+				"id":    "some-id",
+				"name":  "example",
+				"count": "2",
+			},
+			Config: map[string]interface{}{
+				"name":  "example",
+				"count": 3,
+			},
+			ExpectedChanges: []string{"count"},
+		},
+		{
+			Name: "Multiple Changes",
+			State: map[string]string{
+				"id":    "some-id",
+				"name":  "example",
+				"count": "2",
+			},
+			Config: map[string]interface{}{
+				"name":  "example",
+				"count": 3,
+				"sku":   "Premium",
+			},
+			ExpectedChanges: []string{"count", "sku"},
+		},
+	}
+
+	resource := schema.Resource{
+		Schema: diffTestResource{}.Arguments(),
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		state := &terraform.InstanceState{
+			ID:         v.State["id"],
+			Attributes: v.State,
+		}
+		diff, err := resource.Diff(state, terraform.NewResourceConfigRaw(v.Config), &clients.Client{StopContext: context.TODO()})
+		if err != nil {
+			t.Fatalf("building diff: %+v", err)
+		}
+		d, err := schema.InternalMap(resource.Schema).Data(state, diff)
+		if err != nil {
+			t.Fatalf("building ResourceData: %+v", err)
+		}
+
+		metadata := ResourceMetaData{
+			ResourceData:             d,
+			serializationDebugLogger: NullLogger{},
+		}
+		var old, config diffTestModel
+		changes, err := metadata.DecodeChanges(&old, &config)
+		if err != nil {
+			t.Fatalf("decoding changes: %+v", err)
+		}
+
+		if old.Count != 2 {
+			t.Fatalf("expected the old count to be 2 but got %d", old.Count)
+		}
+		if config.Count != v.Config["count"].(int) {
+			t.Fatalf("expected the new count to be %d but got %d", v.Config["count"].(int), config.Count)
+		}
+
+		if actual := changes.ChangedFields(); !reflect.DeepEqual(actual, v.ExpectedChanges) {
+			t.Fatalf("expected the changed fields to be %+v but got %+v", v.ExpectedChanges, actual)
+		}
+		if changes.HasChanges() != (len(v.ExpectedChanges) > 0) {
+			t.Fatalf("expected HasChanges to be %t", len(v.ExpectedChanges) > 0)
+		}
+
+		expectCountChanged := old.Count != config.Count
+		for _, field := range []interface{}{&config.Count, &old.Count} {
+			changed, err := changes.HasChangeFor(field)
+			if err != nil {
+				t.Fatalf("checking for changes: %+v", err)
+			}
+			if changed != expectCountChanged {
+				t.Fatalf("expected HasChangeFor(count) to be %t", expectCountChanged)
+			}
+		}
+		changed, err := changes.HasChangeFor(&config.Name)
+		if err != nil {
+			t.Fatalf("checking for changes: %+v", err)
+		}
+		if changed {
+			t.Fatalf("expected `name` not to have changed")
+		}
+		changed, err = changes.HasChangesFor(&config.Name, &config.Sku)
+		if err != nil {
+			t.Fatalf("checking for changes: %+v", err)
+		}
+		if changed != (config.Sku != old.Sku) {
+			t.Fatalf("expected HasChangesFor(name, sku) to be %t", config.Sku != old.Sku)
+		}
+	}
+}
+
+func TestDecodeChangesInvalidField(t *testing.T) {
+	changes := ModelChanges{
+		old:           reflect.ValueOf(&diffTestModel{}).Elem(),
+		new:           reflect.ValueOf(&diffTestModel{}).Elem(),
+		changedFields: map[string]bool{},
+	}
+
+	var other diffTestModel
+	testData := map[string]interface{}{
+		"not a pointer":          "name",
+		"nil pointer":            (*string)(nil),
+		"field in another model": &other.Name,
+		"the model itself":       changes.new.Addr().Interface(),
+	}
+	for name, field := range testData {
+		t.Logf("[DEBUG] Testing %q..", name)
+
+		if _, err := changes.HasChangeFor(field); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if _, err := changes.HasChangesFor(&changes.new.Addr().Interface().(*diffTestModel).Name, field); err == nil {
+			t.Fatalf("expected an error from HasChangesFor but didn't get one")
+		}
+	}
+}
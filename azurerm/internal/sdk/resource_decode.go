@@ -71,35 +71,53 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 		}
 	}()
 
+	field := reflect.ValueOf(input).Elem().Field(index)
+	return setFieldValue(field, tfschemaValue, fieldName, debugLogger)
+}
+
+func setFieldValue(field reflect.Value, tfschemaValue interface{}, fieldName string, debugLogger Logger) error {
+	switch field.Kind() {
+	case reflect.Ptr:
+		return setPointerValue(field, tfschemaValue, fieldName, debugLogger)
+
+	case reflect.Struct:
+		// a nested block (e.g. a List or Set with `MaxItems: 1`) decoded into a single object
+		v, ok := listValues(tfschemaValue)
+		if !ok || len(v) == 0 {
+			return nil
+		}
+
+		return setNestedObjectValue(field, v[0], fieldName, debugLogger)
+	}
+
 	if v, ok := tfschemaValue.(string); ok {
 		debugLogger.Infof("[String] Decode %+v", v)
-		debugLogger.Infof("Input %+v", reflect.ValueOf(input))
-		debugLogger.Infof("Input Elem %+v", reflect.ValueOf(input).Elem())
-		reflect.ValueOf(input).Elem().Field(index).SetString(v)
+		debugLogger.Infof("Field %+v", field)
+		field.SetString(v)
 		return nil
 	}
 
 	if v, ok := tfschemaValue.(int); ok {
 		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(int64(v))
+		field.SetInt(int64(v))
 		return nil
 	}
 
 	if v, ok := tfschemaValue.(int32); ok {
 		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(int64(v))
+		field.SetInt(int64(v))
 		return nil
 	}
 
 	if v, ok := tfschemaValue.(int64); ok {
 		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(v)
+		field.SetInt(v)
 		return nil
 	}
 
 	if v, ok := tfschemaValue.(float64); ok {
 		debugLogger.Infof("[Float] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetFloat(v)
+		field.SetFloat(v)
 		return nil
 	}
 
@@ -107,98 +125,157 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 	if v, ok := tfschemaValue.(bool); ok {
 		debugLogger.Infof("[BOOL] Decode %+v", v)
 
-		reflect.ValueOf(input).Elem().Field(index).SetBool(v)
+		field.SetBool(v)
 		return nil
 	}
 
 	if v, ok := tfschemaValue.(*schema.Set); ok {
-		return setListValue(input, index, fieldName, v.List(), debugLogger)
+		return setListValue(field, fieldName, v.List(), debugLogger)
 	}
 
 	if mapConfig, ok := tfschemaValue.(map[string]interface{}); ok {
-		mapOutput := reflect.MakeMap(reflect.ValueOf(input).Elem().Field(index).Type())
+		mapOutput := reflect.MakeMap(field.Type())
 		for key, val := range mapConfig {
 			mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val))
 		}
 
-		reflect.ValueOf(input).Elem().Field(index).Set(mapOutput)
+		field.Set(mapOutput)
 		return nil
 	}
 
 	if v, ok := tfschemaValue.([]interface{}); ok {
-		return setListValue(input, index, fieldName, v, debugLogger)
+		return setListValue(field, fieldName, v, debugLogger)
+	}
+
+	return nil
+}
+
+// setPointerValue decodes the value into a newly allocated object for pointer fields - meaning
+// that the field remains nil when no value is available, allowing this to be distinguished from
+// the zero value
+func setPointerValue(field reflect.Value, tfschemaValue interface{}, fieldName string, debugLogger Logger) error {
+	if tfschemaValue == nil {
+		return nil
+	}
+
+	elemType := field.Type().Elem()
+	if elemType.Kind() == reflect.Struct {
+		v, ok := listValues(tfschemaValue)
+		if !ok || len(v) == 0 {
+			return nil
+		}
+
+		elem := reflect.New(elemType)
+		if err := setNestedObjectValue(elem.Elem(), v[0], fieldName, debugLogger); err != nil {
+			return err
+		}
+
+		field.Set(elem)
+		return nil
+	}
+
+	debugLogger.Infof("[POINTER] Decode %+v", tfschemaValue)
+	elem := reflect.New(elemType)
+	if err := setFieldValue(elem.Elem(), tfschemaValue, fieldName, debugLogger); err != nil {
+		return err
 	}
 
+	field.Set(elem)
 	return nil
 }
 
-func setListValue(input interface{}, index int, fieldName string, v []interface{}, debugLogger Logger) error {
-	switch fieldType := reflect.ValueOf(input).Elem().Field(index).Type(); fieldType {
+// setNestedObjectValue decodes the map of values for a nested block into the struct `elem`
+func setNestedObjectValue(elem reflect.Value, input interface{}, fieldName string, debugLogger Logger) error {
+	values, ok := input.(map[string]interface{})
+	if !ok || values == nil {
+		return nil
+	}
+
+	for j := 0; j < elem.NumField(); j++ {
+		nestedField := elem.Type().Field(j)
+		debugLogger.Infof("nestedField ", nestedField)
+
+		if val, exists := nestedField.Tag.Lookup("tfschema"); exists {
+			nestedTFSchemaValue := values[val]
+			if err := setFieldValue(elem.Field(j), nestedTFSchemaValue, fieldName, debugLogger); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// listValues returns the items within a List or Set
+func listValues(input interface{}) ([]interface{}, bool) {
+	if v, ok := input.(*schema.Set); ok {
+		return v.List(), true
+	}
+
+	v, ok := input.([]interface{})
+	return v, ok
+}
+
+func setListValue(field reflect.Value, fieldName string, v []interface{}, debugLogger Logger) error {
+	switch fieldType := field.Type(); fieldType {
 	case reflect.TypeOf([]string{}):
 		stringSlice := reflect.MakeSlice(reflect.TypeOf([]string{}), len(v), len(v))
 		for i, stringVal := range v {
 			stringSlice.Index(i).SetString(stringVal.(string))
 		}
-		reflect.ValueOf(input).Elem().Field(index).Set(stringSlice)
+		field.Set(stringSlice)
 
 	case reflect.TypeOf([]int{}):
 		iSlice := reflect.MakeSlice(reflect.TypeOf([]int{}), len(v), len(v))
 		for i, iVal := range v {
 			iSlice.Index(i).SetInt(int64(iVal.(int)))
 		}
-		reflect.ValueOf(input).Elem().Field(index).Set(iSlice)
+		field.Set(iSlice)
 
 	case reflect.TypeOf([]float64{}):
 		fSlice := reflect.MakeSlice(reflect.TypeOf([]float64{}), len(v), len(v))
 		for i, fVal := range v {
 			fSlice.Index(i).SetFloat(fVal.(float64))
 		}
-		reflect.ValueOf(input).Elem().Field(index).Set(fSlice)
+		field.Set(fSlice)
 
 	case reflect.TypeOf([]bool{}):
 		bSlice := reflect.MakeSlice(reflect.TypeOf([]bool{}), len(v), len(v))
 		for i, bVal := range v {
 			bSlice.Index(i).SetBool(bVal.(bool))
 		}
-		reflect.ValueOf(input).Elem().Field(index).Set(bSlice)
+		field.Set(bSlice)
 
 	default:
-		valueToSet := reflect.MakeSlice(reflect.ValueOf(input).Elem().Field(index).Type(), 0, 0)
+		valueToSet := reflect.MakeSlice(fieldType, 0, 0)
 		debugLogger.Infof("List Type", valueToSet.Type())
 
+		// the items within the slice can either be objects or pointers to objects
+		itemType := fieldType.Elem()
+		isPointer := itemType.Kind() == reflect.Ptr
+		if isPointer {
+			itemType = itemType.Elem()
+		}
+
 		for _, mapVal := range v {
 			if test, ok := mapVal.(map[string]interface{}); ok && test != nil {
-				elem := reflect.New(fieldType.Elem())
+				elem := reflect.New(itemType)
 				debugLogger.Infof("element ", elem)
-				for j := 0; j < elem.Type().Elem().NumField(); j++ {
-					nestedField := elem.Type().Elem().Field(j)
-					debugLogger.Infof("nestedField ", nestedField)
-
-					if val, exists := nestedField.Tag.Lookup("tfschema"); exists {
-						nestedTFSchemaValue := test[val]
-						if err := setValue(elem.Interface(), nestedTFSchemaValue, j, fieldName, debugLogger); err != nil {
-							return err
-						}
-					}
-				}
-
-				if !elem.CanSet() {
-					elem = elem.Elem()
+				if err := setNestedObjectValue(elem.Elem(), test, fieldName, debugLogger); err != nil {
+					return err
 				}
 
-				if valueToSet.Kind() == reflect.Ptr {
-					valueToSet.Elem().Set(reflect.Append(valueToSet.Elem(), elem))
-				} else {
+				if isPointer {
 					valueToSet = reflect.Append(valueToSet, elem)
+				} else {
+					valueToSet = reflect.Append(valueToSet, elem.Elem())
 				}
 
 				debugLogger.Infof("value to set type after changes", valueToSet.Type())
 			}
 		}
 
-		valueToSet = reflect.Indirect(valueToSet)
-		fieldToSet := reflect.ValueOf(input).Elem().Field(index)
-		fieldToSet.Set(reflect.Indirect(valueToSet))
+		field.Set(valueToSet)
 	}
 
 	return nil
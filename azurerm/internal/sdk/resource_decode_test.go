@@ -3,6 +3,9 @@ package sdk
 import (
 	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 type decodeTestData struct {
@@ -696,6 +699,171 @@ func TestResourceDecode_NestedThreeLevelsDeepMultipleItems(t *testing.T) {
 	}.test(t)
 }
 
+func TestResourceDecode_NestedBlocks(t *testing.T) {
+	type Inner struct {
+		Value        string            `tfschema:"value"`
+		MapOfStrings map[string]string `tfschema:"map_of_strings"`
+	}
+	type BlockType struct {
+		Block Inner `tfschema:"block"`
+	}
+	type PointerBlockType struct {
+		Block *Inner `tfschema:"block"`
+	}
+	type SliceOfPointersType struct {
+		Blocks []*Inner `tfschema:"blocks"`
+	}
+	type PointerType struct {
+		String  *string  `tfschema:"string"`
+		Number  *int     `tfschema:"number"`
+		Price   *float64 `tfschema:"price"`
+		Enabled *bool    `tfschema:"enabled"`
+	}
+	hashInner := func(input interface{}) int {
+		return schema.HashString(input.(map[string]interface{})["value"])
+	}
+
+	testData := map[string]decodeTestData{
+		"Block Into Struct": {
+			State: map[string]interface{}{
+				"block": []interface{}{
+					map[string]interface{}{
+						"value": "hello",
+						"map_of_strings": map[string]interface{}{
+							"hello": "world",
+						},
+					},
+				},
+			},
+			Input: &BlockType{},
+			Expected: &BlockType{
+				Block: Inner{
+					Value: "hello",
+					MapOfStrings: map[string]string{
+						"hello": "world",
+					},
+				},
+			},
+		},
+		"Empty Block Into Struct": {
+			State: map[string]interface{}{
+				"block": []interface{}{},
+			},
+			Input:    &BlockType{},
+			Expected: &BlockType{},
+		},
+		"Set Block Into Struct": {
+			State: map[string]interface{}{
+				"block": schema.NewSet(hashInner, []interface{}{
+					map[string]interface{}{
+						"value": "hello",
+					},
+				}),
+			},
+			Input: &BlockType{},
+			Expected: &BlockType{
+				Block: Inner{
+					Value: "hello",
+				},
+			},
+		},
+		"Block Into Pointer": {
+			State: map[string]interface{}{
+				"block": []interface{}{
+					map[string]interface{}{
+						"value": "hello",
+					},
+				},
+			},
+			Input: &PointerBlockType{},
+			Expected: &PointerBlockType{
+				Block: &Inner{
+					Value: "hello",
+				},
+			},
+		},
+		"Empty Block Into Pointer": {
+			State: map[string]interface{}{
+				"block": []interface{}{},
+			},
+			Input:    &PointerBlockType{},
+			Expected: &PointerBlockType{},
+		},
+		"Omitted Block Into Pointer": {
+			State:    map[string]interface{}{},
+			Input:    &PointerBlockType{},
+			Expected: &PointerBlockType{},
+		},
+		"Blocks Into Slice Of Pointers": {
+			State: map[string]interface{}{
+				"blocks": []interface{}{
+					map[string]interface{}{
+						"value": "first",
+					},
+					map[string]interface{}{
+						"value": "second",
+					},
+				},
+			},
+			Input: &SliceOfPointersType{},
+			Expected: &SliceOfPointersType{
+				Blocks: []*Inner{
+					{
+						Value: "first",
+					},
+					{
+						Value: "second",
+					},
+				},
+			},
+		},
+		"Set Of Blocks Into Slice Of Pointers": {
+			State: map[string]interface{}{
+				"blocks": schema.NewSet(hashInner, []interface{}{
+					map[string]interface{}{
+						"value": "first",
+					},
+				}),
+			},
+			Input: &SliceOfPointersType{},
+			Expected: &SliceOfPointersType{
+				Blocks: []*Inner{
+					{
+						Value: "first",
+					},
+				},
+			},
+		},
+		"Pointers With Values": {
+			State: map[string]interface{}{
+				"string":  "hello",
+				"number":  42,
+				"price":   float64(129.99),
+				"enabled": false,
+			},
+			Input: &PointerType{},
+			Expected: &PointerType{
+				String:  utils.String("hello"),
+				Number:  utils.Int(42),
+				Price:   utils.Float(129.99),
+				Enabled: utils.Bool(false),
+			},
+		},
+		"Pointers Without Values": {
+			State: map[string]interface{}{
+				"string": nil,
+			},
+			Input:    &PointerType{},
+			Expected: &PointerType{},
+		},
+	}
+
+	for name, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", name)
+		v.test(t)
+	}
+}
+
 func (testData decodeTestData) test(t *testing.T) {
 	debugLogger := ConsoleLogger{}
 	state := testData.stateWrapper()
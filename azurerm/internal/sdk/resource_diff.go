@@ -0,0 +1,104 @@
+package sdk
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+)
+
+type ResourceDiffMetaData struct {
+	// Client is a reference to the Azure Providers Client - providing a typed reference to this object
+	Client *clients.Client
+
+	// Logger provides a logger for debug purposes
+	Logger Logger
+
+	// ResourceDiff is a reference to the ResourceDiff object from Terraform's Plugin SDK
+	// This is used to be able to call operations directly should the helpers be insufficient
+	ResourceDiff *schema.ResourceDiff
+
+	// serializationDebugLogger is used for testing purposes
+	serializationDebugLogger Logger
+}
+
+// DecodeOld will decode the existing values from the Terraform State into the specified object
+// NOTE: this object must be passed by value - and must contain `tfschema` struct tags for all fields
+//
+// When the resource is being created there are no existing values, as such the object is left untouched.
+func (rdmd ResourceDiffMetaData) DecodeOld(input interface{}) error {
+	return decodeReflectedType(input, oldValueRetriever{diff: rdmd.ResourceDiff}, rdmd.serializationDebugLogger)
+}
+
+// DecodeNew will decode the planned values for this resource into the specified object
+// NOTE: this object must be passed by value - and must contain `tfschema` struct tags for all fields
+func (rdmd ResourceDiffMetaData) DecodeNew(input interface{}) error {
+	return decodeReflectedType(input, rdmd.ResourceDiff, rdmd.serializationDebugLogger)
+}
+
+// DecodeDiff decodes both the existing values into `old` and the planned values into `new`
+// which allows the two objects to be compared, for example to determine if a value has shrunk
+func (rdmd ResourceDiffMetaData) DecodeDiff(old interface{}, new interface{}) error {
+	if err := rdmd.DecodeOld(old); err != nil {
+		return err
+	}
+
+	return rdmd.DecodeNew(new)
+}
+
+// ForceNew marks the specified key as requiring the recreation of this resource
+func (rdmd ResourceDiffMetaData) ForceNew(key string) error {
+	return rdmd.ResourceDiff.ForceNew(key)
+}
+
+// HasChange returns whether the specified key has changed between the State and the Plan
+func (rdmd ResourceDiffMetaData) HasChange(key string) bool {
+	return rdmd.ResourceDiff.HasChange(key)
+}
+
+// IsNewResource returns whether this resource is being created, rather than updated
+func (rdmd ResourceDiffMetaData) IsNewResource() bool {
+	return rdmd.ResourceDiff.Id() == ""
+}
+
+// SetNewComputed marks the specified key as being Computed, meaning that the
+// value is unknown until after the apply
+func (rdmd ResourceDiffMetaData) SetNewComputed(key string) error {
+	return rdmd.ResourceDiff.SetNewComputed(key)
+}
+
+// changeRetriever is implemented by both the ResourceData and ResourceDiff from the Plugin SDK
+type changeRetriever interface {
+	GetChange(key string) (interface{}, interface{})
+	Id() string
+}
+
+// oldValueRetriever is a stateRetriever which returns the existing (rather than the planned)
+// values for a ResourceData/ResourceDiff, so that these can be decoded using the same logic as Decode
+type oldValueRetriever struct {
+	diff changeRetriever
+}
+
+func (r oldValueRetriever) Get(key string) interface{} {
+	old, _ := r.diff.GetChange(key)
+	return old
+}
+
+func (r oldValueRetriever) GetOk(key string) (interface{}, bool) {
+	v := r.Get(key)
+	if v == nil {
+		return nil, false
+	}
+
+	return v, !reflect.ValueOf(v).IsZero()
+}
+
+func (r oldValueRetriever) GetOkExists(key string) (interface{}, bool) {
+	// there's no prior state when the resource is being created
+	if r.diff.Id() == "" {
+		return nil, false
+	}
+
+	v := r.Get(key)
+	return v, v != nil
+}
@@ -0,0 +1,219 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+)
+
+type diffTestModel struct {
+	Name  string `tfschema:"name"`
+	Count int    `tfschema:"count"`
+	Sku   string `tfschema:"sku"`
+}
+
+type diffTestResource struct{}
+
+var _ ResourceWithCustomizeDiff = diffTestResource{}
+
+func (diffTestResource) Arguments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"count": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"sku": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func (diffTestResource) Attributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{}
+}
+
+func (diffTestResource) ModelObject() interface{} {
+	return diffTestModel{}
+}
+
+func (diffTestResource) ResourceType() string {
+	return "validator_diff"
+}
+
+func (diffTestResource) Create() ResourceFunc {
+	return ResourceFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (diffTestResource) Read() ResourceFunc {
+	return ResourceFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (diffTestResource) Delete() ResourceFunc {
+	return ResourceFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (diffTestResource) IDValidationFunc() schema.SchemaValidateFunc {
+	return nil
+}
+
+func (diffTestResource) CustomizeDiff() ResourceDiffFunc {
+	return ResourceDiffFunc{
+		Func: func(ctx context.Context, metadata ResourceDiffMetaData) error {
+			var old, new diffTestModel
+			if err := metadata.DecodeDiff(&old, &new); err != nil {
+				return err
+			}
+
+			if new.Sku == "Premium" && new.Count == 0 {
+				return fmt.Errorf("`count` must be specified when `sku` is `Premium`")
+			}
+
+			if !metadata.IsNewResource() && new.Count < old.Count {
+				return metadata.ForceNew("count")
+			}
+
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func TestCustomizeDiff(t *testing.T) {
+	testData := []struct {
+		Name            string
+		State           map[string]string
+		Config          map[string]interface{}
+		ExpectError     bool
+		ExpectForceNew  bool
+		ExpectNoChanges bool
+	}{
+		{
+			Name: "Create",
+			Config: map[string]interface{}{
+				"name":  "example",
+				"count": 2,
+			},
+		},
+		{
+			Name: "Create Invalid Combination",
+			Config: map[string]interface{}{
+				"name": "example",
+				"sku":  "Premium",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "No Changes",
+			State: map[string]string{
+				"id":    "some-id",
+				"name":  "example",
+				"count": "2",
+			},
+			Config: map[string]interface{}{
+				"name":  "example",
+				"count": 2,
+			},
+			ExpectNoChanges: true,
+		},
+		{
+			Name: "Grow",
+			State: map[string]string{
+				"id":    "some-id",
+				"name":  "example",
+				"count": "2",
+			},
+			Config: map[string]interface{}{
+				"name":  "example",
+				"count": 3,
+			},
+		},
+		{
+			Name: "Shrink",
+			State: map[string]string{
+				"id":    "some-id",
+				"name":  "example",
+				"count": "2",
+			},
+			Config: map[string]interface{}{
+				"name":  "example",
+				"count": 1,
+			},
+			ExpectForceNew: true,
+		},
+	}
+
+	wrapper := NewResourceWrapper(diffTestResource{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building resource: %+v", err)
+	}
+	if resource.CustomizeDiff == nil {
+		t.Fatalf("expected CustomizeDiff to be set but it wasn't")
+	}
+	meta := &clients.Client{
+		StopContext: context.TODO(),
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		var state *terraform.InstanceState
+		if v.State != nil {
+			state = &terraform.InstanceState{
+				ID:         v.State["id"],
+				Attributes: v.State,
+			}
+		}
+
+		diff, err := resource.Diff(state, terraform.NewResourceConfigRaw(v.Config), meta)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if v.ExpectNoChanges {
+			if diff != nil && !diff.Empty() {
+				t.Fatalf("expected no changes but got %+v", diff)
+			}
+			continue
+		}
+
+		if diff == nil {
+			t.Fatalf("expected a diff but didn't get one")
+		}
+		if diff.RequiresNew() != v.ExpectForceNew {
+			t.Fatalf("expected RequiresNew to be %t but got %t", v.ExpectForceNew, diff.RequiresNew())
+		}
+	}
+}
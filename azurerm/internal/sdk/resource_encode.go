@@ -49,93 +49,125 @@ func recurse(objType reflect.Type, objVal reflect.Value, fieldName string, debug
 		field := objType.Field(i)
 		fieldVal := objVal.Field(i)
 		if tfschemaTag, exists := field.Tag.Lookup("tfschema"); exists {
-			switch field.Type.Kind() {
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				iv := fieldVal.Int()
-				debugLogger.Infof("Setting %q to %d", tfschemaTag, iv)
-				output[tfschemaTag] = iv
-
-			case reflect.Float32, reflect.Float64:
-				fv := fieldVal.Float()
-				debugLogger.Infof("Setting %q to %f", tfschemaTag, fv)
-				output[tfschemaTag] = fv
-
-			case reflect.String:
-				sv := fieldVal.String()
-				debugLogger.Infof("Setting %q to %q", tfschemaTag, sv)
-				output[tfschemaTag] = sv
-
-			case reflect.Bool:
-				bv := fieldVal.Bool()
-				debugLogger.Infof("Setting %q to %t", tfschemaTag, bv)
-				output[tfschemaTag] = bv
-
-			case reflect.Map:
-				iter := fieldVal.MapRange()
-				attr := make(map[string]interface{})
-				for iter.Next() {
-					attr[iter.Key().String()] = iter.Value().Interface()
+			serialized, err := encodeValue(tfschemaTag, field.Name, fieldVal, debugLogger)
+			if err != nil {
+				return output, err
+			}
+
+			output[tfschemaTag] = serialized
+		}
+	}
+
+	return output, nil
+}
+
+func encodeValue(tfschemaTag string, fieldName string, fieldVal reflect.Value, debugLogger Logger) (interface{}, error) {
+	switch fieldVal.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		iv := fieldVal.Int()
+		debugLogger.Infof("Setting %q to %d", tfschemaTag, iv)
+		return iv, nil
+
+	case reflect.Float32, reflect.Float64:
+		fv := fieldVal.Float()
+		debugLogger.Infof("Setting %q to %f", tfschemaTag, fv)
+		return fv, nil
+
+	case reflect.String:
+		sv := fieldVal.String()
+		debugLogger.Infof("Setting %q to %q", tfschemaTag, sv)
+		return sv, nil
+
+	case reflect.Bool:
+		bv := fieldVal.Bool()
+		debugLogger.Infof("Setting %q to %t", tfschemaTag, bv)
+		return bv, nil
+
+	case reflect.Map:
+		iter := fieldVal.MapRange()
+		attr := make(map[string]interface{})
+		for iter.Next() {
+			attr[iter.Key().String()] = iter.Value().Interface()
+		}
+		return attr, nil
+
+	case reflect.Ptr:
+		if fieldVal.IsNil() {
+			// an unset nested block is an empty list, whereas an unset value is null
+			if fieldVal.Type().Elem().Kind() == reflect.Struct {
+				debugLogger.Infof("Setting %q to an empty list", tfschemaTag)
+				return make([]interface{}, 0), nil
+			}
+
+			debugLogger.Infof("Setting %q to nil", tfschemaTag)
+			return nil, nil
+		}
+
+		return encodeValue(tfschemaTag, fieldName, fieldVal.Elem(), debugLogger)
+
+	case reflect.Struct:
+		// a single nested object is exposed as a List/Set containing a single item
+		debugLogger.Infof("[STRUCT] Setting %q to a list containing a single item", tfschemaTag)
+		serialized, err := recurse(fieldVal.Type(), fieldVal, fieldName, debugLogger)
+		if err != nil {
+			return nil, fmt.Errorf("serializing nested object %q: %+v", fieldVal.Type(), err)
+		}
+		return []interface{}{serialized}, nil
+
+	case reflect.Slice:
+		sv := fieldVal.Slice(0, fieldVal.Len())
+		attr := make([]interface{}, sv.Len())
+		switch sv.Type() {
+		case reflect.TypeOf([]string{}):
+			debugLogger.Infof("Setting %q to []string", tfschemaTag)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]string, 0), nil
+
+		case reflect.TypeOf([]int{}):
+			debugLogger.Infof("Setting %q to []int", tfschemaTag)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]int, 0), nil
+
+		case reflect.TypeOf([]float64{}):
+			debugLogger.Infof("Setting %q to []float64", tfschemaTag)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]float64, 0), nil
+
+		case reflect.TypeOf([]bool{}):
+			debugLogger.Infof("Setting %q to []bool", tfschemaTag)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]bool, 0), nil
+
+		default:
+			for i := 0; i < sv.Len(); i++ {
+				debugLogger.Infof("[SLICE] Index %d is %q", i, sv.Index(i).Interface())
+				debugLogger.Infof("[SLICE] Type %+v", sv.Type())
+
+				// the items within the slice can either be objects or pointers to objects
+				nestedValue := reflect.Indirect(sv.Index(i))
+				if !nestedValue.IsValid() {
+					return nil, fmt.Errorf("serializing nested object %q: item %d was nil", sv.Type(), i)
 				}
-				output[tfschemaTag] = attr
-
-			case reflect.Slice:
-				sv := fieldVal.Slice(0, fieldVal.Len())
-				attr := make([]interface{}, sv.Len())
-				switch sv.Type() {
-				case reflect.TypeOf([]string{}):
-					debugLogger.Infof("Setting %q to []string", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]string, 0)
-					}
-
-				case reflect.TypeOf([]int{}):
-					debugLogger.Infof("Setting %q to []int", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]int, 0)
-					}
-
-				case reflect.TypeOf([]float64{}):
-					debugLogger.Infof("Setting %q to []float64", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]float64, 0)
-					}
-
-				case reflect.TypeOf([]bool{}):
-					debugLogger.Infof("Setting %q to []bool", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]bool, 0)
-					}
-
-				default:
-					for i := 0; i < sv.Len(); i++ {
-						debugLogger.Infof("[SLICE] Index %d is %q", i, sv.Index(i).Interface())
-						debugLogger.Infof("[SLICE] Type %+v", sv.Type())
-						nestedType := sv.Index(i).Type()
-						nestedValue := sv.Index(i)
-
-						fieldName := field.Name
-						serialized, err := recurse(nestedType, nestedValue, fieldName, debugLogger)
-						if err != nil {
-							return nil, fmt.Errorf("serializing nested object %q: %+v", sv.Type(), exists)
-						}
-						attr[i] = serialized
-					}
-					debugLogger.Infof("[SLICE] Setting %q to %+v", tfschemaTag, attr)
-					output[tfschemaTag] = attr
+				nestedType := nestedValue.Type()
+
+				serialized, err := recurse(nestedType, nestedValue, fieldName, debugLogger)
+				if err != nil {
+					return nil, fmt.Errorf("serializing nested object %q: %+v", sv.Type(), err)
 				}
-			default:
-				return output, fmt.Errorf("unknown type %+v for key %q", field.Type.Kind(), tfschemaTag)
+				attr[i] = serialized
 			}
+			debugLogger.Infof("[SLICE] Setting %q to %+v", tfschemaTag, attr)
+			return attr, nil
 		}
 	}
 
-	return output, nil
+	return nil, fmt.Errorf("unknown type %+v for key %q", fieldVal.Kind(), tfschemaTag)
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 type encodeTestData struct {
@@ -645,6 +646,132 @@ func TestResourceEncode_NestedThreeLevelsDeepMultipleItems(t *testing.T) {
 	}.test(t)
 }
 
+func TestResourceEncode_NestedBlocks(t *testing.T) {
+	type Inner struct {
+		Value        string            `tfschema:"value"`
+		MapOfStrings map[string]string `tfschema:"map_of_strings"`
+	}
+	type BlockType struct {
+		Block Inner `tfschema:"block"`
+	}
+	type PointerBlockType struct {
+		Block *Inner `tfschema:"block"`
+	}
+	type SliceOfPointersType struct {
+		Blocks []*Inner `tfschema:"blocks"`
+	}
+	type PointerType struct {
+		String  *string  `tfschema:"string"`
+		Number  *int     `tfschema:"number"`
+		Price   *float64 `tfschema:"price"`
+		Enabled *bool    `tfschema:"enabled"`
+	}
+
+	testData := map[string]encodeTestData{
+		"Struct Into Block": {
+			Input: &BlockType{
+				Block: Inner{
+					Value: "hello",
+					MapOfStrings: map[string]string{
+						"hello": "world",
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"block": []interface{}{
+					map[string]interface{}{
+						"value": "hello",
+						"map_of_strings": map[string]interface{}{
+							"hello": "world",
+						},
+					},
+				},
+			},
+		},
+		"Pointer Into Block": {
+			Input: &PointerBlockType{
+				Block: &Inner{
+					Value: "hello",
+				},
+			},
+			Expected: map[string]interface{}{
+				"block": []interface{}{
+					map[string]interface{}{
+						"value":          "hello",
+						"map_of_strings": map[string]interface{}{},
+					},
+				},
+			},
+		},
+		"Nil Pointer Into Block": {
+			Input: &PointerBlockType{},
+			Expected: map[string]interface{}{
+				"block": []interface{}{},
+			},
+		},
+		"Slice Of Pointers Into Blocks": {
+			Input: &SliceOfPointersType{
+				Blocks: []*Inner{
+					{
+						Value: "first",
+					},
+					{
+						Value: "second",
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"blocks": []interface{}{
+					map[string]interface{}{
+						"value":          "first",
+						"map_of_strings": map[string]interface{}{},
+					},
+					map[string]interface{}{
+						"value":          "second",
+						"map_of_strings": map[string]interface{}{},
+					},
+				},
+			},
+		},
+		"Slice Containing A Nil Pointer": {
+			Input: &SliceOfPointersType{
+				Blocks: []*Inner{
+					nil,
+				},
+			},
+			ExpectError: true,
+		},
+		"Pointers With Values": {
+			Input: &PointerType{
+				String:  utils.String("hello"),
+				Number:  utils.Int(42),
+				Price:   utils.Float(129.99),
+				Enabled: utils.Bool(false),
+			},
+			Expected: map[string]interface{}{
+				"string":  "hello",
+				"number":  int64(42),
+				"price":   float64(129.99),
+				"enabled": false,
+			},
+		},
+		"Pointers Without Values": {
+			Input: &PointerType{},
+			Expected: map[string]interface{}{
+				"string":  nil,
+				"number":  nil,
+				"price":   nil,
+				"enabled": nil,
+			},
+		},
+	}
+
+	for name, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", name)
+		v.test(t)
+	}
+}
+
 func (testData encodeTestData) test(t *testing.T) {
 	objType := reflect.TypeOf(testData.Input).Elem()
 	objVal := reflect.ValueOf(testData.Input).Elem()
@@ -0,0 +1,241 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// maxPollingRetryDelay is the maximum delay before polling the status of a long-running operation again
+// after polling the status failed
+const maxPollingRetryDelay = 60 * time.Second
+
+// PollableFuture is the subset of the Azure SDK for Go's Future (`azure.FutureAPI`)
+// which is required to poll a long-running operation until it completes
+//
+// Each of the Futures returned from the Azure SDK for Go implement this - however
+// this can also be implemented by a fake Future for testing purposes
+type PollableFuture interface {
+	// DoneWithContext queries the API to determine if the long-running operation has completed
+	DoneWithContext(ctx context.Context, sender autorest.Sender) (bool, error)
+
+	// GetPollingDelay returns the delay from the `Retry-After` header, if one was returned
+	GetPollingDelay() (time.Duration, bool)
+
+	// Status returns the last status of the long-running operation
+	Status() string
+}
+
+// LongRunningOperationError is returned when a long-running operation fails
+// and exposes the error details returned from the Azure API, where available
+type LongRunningOperationError struct {
+	// Status is the last status of the long-running operation, e.g. `Failed`
+	Status string
+
+	// Code is the error code returned from the Azure API (if any)
+	Code string
+
+	// Message is the error message returned from the Azure API (if any)
+	Message string
+
+	// Body is the raw response body returned from the Azure API (if any)
+	Body string
+
+	// Err is the original error
+	Err error
+}
+
+func (e LongRunningOperationError) Error() string {
+	message := "the long-running operation failed"
+	if e.Status != "" {
+		message = fmt.Sprintf("%s with status %q", message, e.Status)
+	}
+
+	if e.Code != "" || e.Message != "" {
+		return fmt.Sprintf("%s: Code=%q Message=%q", message, e.Code, e.Message)
+	}
+
+	if e.Body != "" {
+		return fmt.Sprintf("%s: %+v\n\nResponse Body: %s", message, e.Err, e.Body)
+	}
+
+	return fmt.Sprintf("%s: %+v", message, e.Err)
+}
+
+func (e LongRunningOperationError) Unwrap() error {
+	return e.Err
+}
+
+// WaitForCompletion polls the long-running operation represented by `future` until it completes,
+// or until the context (which contains the timeout from the ResourceFunc) is cancelled.
+//
+// The `Retry-After` header is honoured where returned from the API, otherwise the PollingDelay
+// from `client` is used - and polling requests which fail are retried (with a back-off) up to
+// the RetryAttempts defined in the `client`.
+//
+// Example Usage:
+//
+//	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, input)
+//	if err != nil {
+//		return fmt.Errorf("creating %s: %+v", id, err)
+//	}
+//	if err := metadata.WaitForCompletion(ctx, &future, client.Client); err != nil {
+//		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+//	}
+func (rmd ResourceMetaData) WaitForCompletion(ctx context.Context, future PollableFuture, client autorest.Client) error {
+	return waitForCompletion(ctx, future, client, rmd.Logger)
+}
+
+// WaitForStateChange polls the Refresh function within the StateChangeConf until one of the Target
+// states is reached, or until the context (which contains the timeout from the ResourceFunc) is cancelled.
+//
+// This is intended for API's which don't return a Future, and sets the Timeout for the StateChangeConf
+// from the context, meaning that this doesn't need to be specified.
+func (rmd ResourceMetaData) WaitForStateChange(ctx context.Context, stateConf *resource.StateChangeConf) (interface{}, error) {
+	return waitForStateChange(ctx, stateConf, rmd.Logger)
+}
+
+func waitForCompletion(ctx context.Context, future PollableFuture, client autorest.Client, logger Logger) error {
+	if _, ok := ctx.Deadline(); !ok {
+		return fmt.Errorf("internal-error: the context used for polling is missing a deadline")
+	}
+
+	// if the initial response contains a Retry-After header, we need to wait that long before polling
+	if delay, ok := future.GetPollingDelay(); ok {
+		logger.Infof("waiting %s before polling the long-running operation..", delay)
+		if !sleepWithContext(ctx, delay) {
+			return fmt.Errorf("waiting for the long-running operation: %+v", ctx.Err())
+		}
+	}
+
+	attempts := 0
+	for {
+		done, err := future.DoneWithContext(ctx, client)
+		if done {
+			if err != nil {
+				logger.Warnf("the long-running operation completed with status %q: %+v", future.Status(), err)
+				return longRunningOperationError(future.Status(), err)
+			}
+
+			logger.Infof("the long-running operation completed with status %q", future.Status())
+			return nil
+		}
+
+		var delay time.Duration
+		if err == nil {
+			// the retries are for consecutive failures to poll the status, rather than across the whole operation
+			attempts = 0
+			logger.Infof("the long-running operation has the status %q..", future.Status())
+
+			var ok bool
+			if delay, ok = future.GetPollingDelay(); !ok {
+				delay = client.PollingDelay
+			}
+		} else {
+			if attempts >= client.RetryAttempts {
+				return longRunningOperationError(future.Status(), fmt.Errorf("the number of retries has been exceeded: %+v", err))
+			}
+
+			// polling the status failed, so we back-off before trying again
+			logger.Warnf("polling the status of the long-running operation failed (attempt %d of %d): %+v", attempts+1, client.RetryAttempts, err)
+			delay = pollingRetryDelay(client.RetryDuration, attempts)
+			attempts++
+		}
+
+		if !sleepWithContext(ctx, delay) {
+			return fmt.Errorf("waiting for the long-running operation (last status %q): %+v", future.Status(), ctx.Err())
+		}
+	}
+}
+
+// pollingRetryDelay returns the exponential back-off from the base delay for the specified (zero-based) attempt,
+// capped at maxPollingRetryDelay
+func pollingRetryDelay(base time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 0; i < attempt && delay < maxPollingRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > maxPollingRetryDelay {
+		delay = maxPollingRetryDelay
+	}
+	return delay
+}
+
+func waitForStateChange(ctx context.Context, stateConf *resource.StateChangeConf, logger Logger) (interface{}, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil, fmt.Errorf("internal-error: the context used for polling is missing a deadline")
+	}
+
+	if stateConf.Timeout == 0 {
+		stateConf.Timeout = time.Until(deadline)
+	}
+
+	refresh := stateConf.Refresh
+	stateConf.Refresh = func() (interface{}, string, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, "", err
+		}
+
+		result, state, err := refresh()
+		if err != nil {
+			logger.Warnf("refreshing the state failed: %+v", err)
+			return result, state, longRunningOperationError(state, err)
+		}
+
+		logger.Infof("the current state is %q (waiting for %s)..", state, strings.Join(stateConf.Target, " / "))
+		return result, state, nil
+	}
+
+	return stateConf.WaitForState()
+}
+
+// sleepWithContext waits for the specified duration, returning false if the context is cancelled first
+//
+// NOTE: autorest.DelayForBackoff isn't used here since it truncates the delay to whole seconds
+func sleepWithContext(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// longRunningOperationError extracts the error details from the Azure API from the specified error
+func longRunningOperationError(status string, err error) error {
+	out := LongRunningOperationError{
+		Status: status,
+		Err:    err,
+	}
+
+	var serviceError *azure.ServiceError
+	var requestError *azure.RequestError
+	var detailedError autorest.DetailedError
+	switch {
+	case errors.As(err, &serviceError) && serviceError != nil:
+		out.Code = serviceError.Code
+		out.Message = serviceError.Message
+
+	case errors.As(err, &requestError) && requestError.ServiceError != nil:
+		out.Code = requestError.ServiceError.Code
+		out.Message = requestError.ServiceError.Message
+
+	case errors.As(err, &detailedError):
+		if len(detailedError.ServiceError) > 0 {
+			out.Body = string(detailedError.ServiceError)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,274 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// fakeFuture completes after the specified number of polls, returning the
+// specified error for each poll (where set) or the result
+type fakeFuture struct {
+	pollsUntilDone int
+	pollErrors     []error
+	result         error
+	status         string
+
+	polls int
+}
+
+func (f *fakeFuture) DoneWithContext(_ context.Context, _ autorest.Sender) (bool, error) {
+	f.polls++
+	if f.polls <= len(f.pollErrors) {
+		if err := f.pollErrors[f.polls-1]; err != nil {
+			return false, err
+		}
+	}
+
+	if f.polls < f.pollsUntilDone {
+		f.status = "InProgress"
+		return false, nil
+	}
+
+	if f.result != nil {
+		f.status = "Failed"
+		return true, f.result
+	}
+
+	f.status = "Succeeded"
+	return true, nil
+}
+
+func (f *fakeFuture) GetPollingDelay() (time.Duration, bool) {
+	return 0, false
+}
+
+func (f *fakeFuture) Status() string {
+	return f.status
+}
+
+func TestWaitForCompletion(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Future        *fakeFuture
+		ExpectedPolls int
+		ExpectedError string
+	}{
+		{
+			Name:          "completes immediately",
+			Future:        &fakeFuture{pollsUntilDone: 1},
+			ExpectedPolls: 1,
+		},
+		{
+			Name:          "completes after polling",
+			Future:        &fakeFuture{pollsUntilDone: 3},
+			ExpectedPolls: 3,
+		},
+		{
+			Name: "completes after a transient error",
+			Future: &fakeFuture{
+				pollsUntilDone: 2,
+				pollErrors:     []error{fmt.Errorf("connection reset")},
+			},
+			ExpectedPolls: 2,
+		},
+		{
+			Name: "completes after separate transient errors",
+			Future: &fakeFuture{
+				pollsUntilDone: 6,
+				pollErrors:     []error{fmt.Errorf("connection reset"), nil, fmt.Errorf("connection reset"), nil, fmt.Errorf("connection reset")},
+			},
+			ExpectedPolls: 6,
+		},
+		{
+			Name: "retries exceeded",
+			Future: &fakeFuture{
+				pollsUntilDone: 5,
+				pollErrors:     []error{fmt.Errorf("boom"), fmt.Errorf("boom"), fmt.Errorf("boom")},
+			},
+			ExpectedPolls: 3,
+			ExpectedError: "the number of retries has been exceeded",
+		},
+		{
+			Name: "failed with a service error",
+			Future: &fakeFuture{
+				pollsUntilDone: 2,
+				result: autorest.NewErrorWithError(&azure.ServiceError{
+					Code:    "Conflict",
+					Message: "Another operation is in progress",
+				}, "Future", "WaitForCompletion", nil, "polling failed"),
+			},
+			ExpectedPolls: 2,
+			ExpectedError: `with status "Failed": Code="Conflict" Message="Another operation is in progress"`,
+		},
+		{
+			Name: "failed with a response body",
+			Future: &fakeFuture{
+				pollsUntilDone: 1,
+				result: autorest.DetailedError{
+					Original:     fmt.Errorf("unexpected status"),
+					ServiceError: []byte(`{"error":"bad"}`),
+				},
+			},
+			ExpectedPolls: 1,
+			ExpectedError: `Response Body: {"error":"bad"}`,
+		},
+	}
+
+	client := autorest.Client{
+		PollingDelay:  time.Millisecond,
+		RetryAttempts: 2,
+		RetryDuration: time.Millisecond,
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		ctx, cancel := context.WithTimeout(context.TODO(), time.Minute)
+		err := waitForCompletion(ctx, v.Future, client, NullLogger{})
+		cancel()
+
+		if v.ExpectedError == "" && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.ExpectedError != "" {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if !strings.Contains(err.Error(), v.ExpectedError) {
+				t.Fatalf("expected the error to contain %q but got %q", v.ExpectedError, err.Error())
+			}
+		}
+
+		if v.Future.polls != v.ExpectedPolls {
+			t.Fatalf("expected %d polls but got %d", v.ExpectedPolls, v.Future.polls)
+		}
+	}
+}
+
+func TestPollingRetryDelay(t *testing.T) {
+	testData := []struct {
+		Attempt  int
+		Expected time.Duration
+	}{
+		{
+			Attempt:  0,
+			Expected: 10 * time.Second,
+		},
+		{
+			Attempt:  2,
+			Expected: 40 * time.Second,
+		},
+		{
+			Attempt:  3,
+			Expected: maxPollingRetryDelay,
+		},
+		{
+			Attempt:  100,
+			Expected: maxPollingRetryDelay,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing attempt %d..", v.Attempt)
+
+		if actual := pollingRetryDelay(10*time.Second, v.Attempt); actual != v.Expected {
+			t.Fatalf("expected %s but got %s", v.Expected, actual)
+		}
+	}
+}
+
+func TestWaitForCompletionServiceErrorIsExposed(t *testing.T) {
+	future := &fakeFuture{
+		pollsUntilDone: 1,
+		result: &azure.ServiceError{
+			Code:    "InternalServerError",
+			Message: "Something went wrong",
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Minute)
+	defer cancel()
+
+	err := waitForCompletion(ctx, future, autorest.Client{}, NullLogger{})
+	var lroError LongRunningOperationError
+	if !errors.As(err, &lroError) {
+		t.Fatalf("expected a LongRunningOperationError but got %T", err)
+	}
+	if lroError.Status != "Failed" {
+		t.Fatalf("expected the Status to be %q but got %q", "Failed", lroError.Status)
+	}
+	if lroError.Code != "InternalServerError" {
+		t.Fatalf("expected the Code to be %q but got %q", "InternalServerError", lroError.Code)
+	}
+
+	var serviceError *azure.ServiceError
+	if !errors.As(err, &serviceError) {
+		t.Fatalf("expected the original error to be unwrappable")
+	}
+}
+
+func TestWaitForCompletionTimeout(t *testing.T) {
+	future := &fakeFuture{pollsUntilDone: 1000}
+	client := autorest.Client{
+		PollingDelay: 10 * time.Millisecond,
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+
+	err := waitForCompletion(ctx, future, client, NullLogger{})
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if !strings.Contains(err.Error(), context.DeadlineExceeded.Error()) {
+		t.Fatalf("expected the error to contain %q but got %q", context.DeadlineExceeded.Error(), err.Error())
+	}
+}
+
+func TestWaitForCompletionRequiresDeadline(t *testing.T) {
+	future := &fakeFuture{pollsUntilDone: 1}
+	if err := waitForCompletion(context.TODO(), future, autorest.Client{}, NullLogger{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if future.polls != 0 {
+		t.Fatalf("expected no polls but got %d", future.polls)
+	}
+}
+
+func TestWaitForStateChange(t *testing.T) {
+	polls := 0
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Provisioning"},
+		Target:  []string{"Succeeded"},
+		Refresh: func() (interface{}, string, error) {
+			polls++
+			if polls < 3 {
+				return polls, "Provisioning", nil
+			}
+			return polls, "Succeeded", nil
+		},
+		MinTimeout:   time.Millisecond,
+		PollInterval: time.Millisecond,
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Minute)
+	defer cancel()
+
+	result, err := waitForStateChange(ctx, stateConf, NullLogger{})
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if result.(int) != 3 {
+		t.Fatalf("expected the result to be 3 but got %+v", result)
+	}
+	if stateConf.Timeout == 0 {
+		t.Fatalf("expected the Timeout to be set from the context")
+	}
+}
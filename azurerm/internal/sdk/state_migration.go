@@ -0,0 +1,145 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+type StateUpgradeData struct {
+	// SchemaVersion is the current version of the Schema for this Resource
+	SchemaVersion int
+
+	// Upgraders is a map of the Schema Version to the StateUpgrade which upgrades
+	// the State from that version to the next (e.g. 0 -> 1)
+	Upgraders map[int]StateUpgrade
+}
+
+// StateUpgrade upgrades the State from one version of the Schema to the next
+type StateUpgrade interface {
+	// Schema returns the Schema for the version of the Resource being upgraded
+	//
+	// If this returns nil the current Schema for this Resource is used instead,
+	// which is sufficient where only values (for example the Resource ID) are changed
+	Schema() map[string]*schema.Schema
+
+	// UpgradeFunc returns the StateUpgraderFunc used to upgrade the State
+	UpgradeFunc() StateUpgraderFunc
+}
+
+// StateUpgraderFunc takes the State from the previous version of the Schema
+// and returns the State which should be used for the next version
+//
+// NOTE: this matches the signature of the Plugin SDK's StateUpgradeFunc so
+// that these can also be used by untyped resources
+type StateUpgraderFunc func(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error)
+
+// ResourceIDParseFunc parses the specified Resource ID into a Formatter
+type ResourceIDParseFunc func(input string) (resourceid.Formatter, error)
+
+// ResourceIDStateUpgrade is a generic StateUpgrade which parses the Resource ID
+// (and any other ID fields) using the specified ParseFunc and then formats the
+// result, for example to update the casing of the Resource ID.
+//
+// Example Usage:
+//
+//	sdk.ResourceIDStateUpgrade{
+//		 ParseFunc: func(input string) (resourceid.Formatter, error) {
+//			 return parse.ProfileIDInsensitively(input)
+//		 },
+//	}
+type ResourceIDStateUpgrade struct {
+	// OldSchema is the Schema for the version of the Resource being upgraded
+	// this can be omitted when the Schema itself is unchanged
+	OldSchema map[string]*schema.Schema
+
+	// ParseFunc is used to parse the existing Resource ID - typically this'll be
+	// the case-insensitive Parser for this Resource ID
+	ParseFunc ResourceIDParseFunc
+
+	// AdditionalFields is an optional list of other top-level fields containing
+	// a Resource ID of the same type which should also be updated
+	AdditionalFields []string
+}
+
+var _ StateUpgrade = ResourceIDStateUpgrade{}
+
+func (u ResourceIDStateUpgrade) Schema() map[string]*schema.Schema {
+	return u.OldSchema
+}
+
+func (u ResourceIDStateUpgrade) UpgradeFunc() StateUpgraderFunc {
+	return func(rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+		fields := append([]string{"id"}, u.AdditionalFields...)
+		for _, field := range fields {
+			raw, ok := rawState[field]
+			if !ok || raw == nil {
+				continue
+			}
+
+			oldId, ok := raw.(string)
+			if !ok {
+				return rawState, fmt.Errorf("expected %q to be a string but got %T", field, raw)
+			}
+			if oldId == "" {
+				continue
+			}
+
+			parsed, err := u.ParseFunc(oldId)
+			if err != nil {
+				return rawState, fmt.Errorf("parsing %q: %+v", field, err)
+			}
+
+			newId := parsed.ID()
+			log.Printf("[DEBUG] Updating %q from %q to %q", field, oldId, newId)
+			rawState[field] = newId
+		}
+
+		return rawState, nil
+	}
+}
+
+// buildStateUpgraders converts the StateUpgradeData into the Plugin SDK's type,
+// validating that a StateUpgrade exists for each previous version of the Schema
+func buildStateUpgraders(data StateUpgradeData, currentSchema map[string]*schema.Schema) ([]schema.StateUpgrader, error) {
+	if data.SchemaVersion <= 0 {
+		return nil, fmt.Errorf("`SchemaVersion` must be greater than 0 when implementing ResourceWithStateMigration")
+	}
+
+	versions := make([]int, 0)
+	for version := range data.Upgraders {
+		if version < 0 || version >= data.SchemaVersion {
+			return nil, fmt.Errorf("a StateUpgrade was defined for version %d but the SchemaVersion is %d", version, data.SchemaVersion)
+		}
+		versions = append(versions, version)
+	}
+	sort.Ints(versions)
+
+	if len(versions) != data.SchemaVersion {
+		return nil, fmt.Errorf("expected %d StateUpgrade's (one for each version prior to %d) but got %d", data.SchemaVersion, data.SchemaVersion, len(versions))
+	}
+
+	upgraders := make([]schema.StateUpgrader, 0)
+	for _, version := range versions {
+		upgrade := data.Upgraders[version]
+		if upgrade == nil {
+			return nil, fmt.Errorf("the StateUpgrade for version %d was nil", version)
+		}
+
+		upgradeSchema := upgrade.Schema()
+		if upgradeSchema == nil {
+			upgradeSchema = currentSchema
+		}
+
+		upgraders = append(upgraders, schema.StateUpgrader{
+			Version: version,
+			Type:    (&schema.Resource{Schema: upgradeSchema}).CoreConfigSchema().ImpliedType(),
+			Upgrade: schema.StateUpgradeFunc(upgrade.UpgradeFunc()),
+		})
+	}
+
+	return upgraders, nil
+}
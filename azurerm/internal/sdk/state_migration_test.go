@@ -0,0 +1,200 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+type migrationTestId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func (id migrationTestId) ID() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Example/things/%s", id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+func parseMigrationTestIdInsensitively(input string) (resourceid.Formatter, error) {
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(segments) != 8 {
+		return nil, fmt.Errorf("expected 8 segments but got %d", len(segments))
+	}
+
+	return migrationTestId{
+		SubscriptionId: segments[1],
+		ResourceGroup:  segments[3],
+		Name:           segments[7],
+	}, nil
+}
+
+func TestResourceIDStateUpgrade(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Input       map[string]interface{}
+		Expected    map[string]interface{}
+		ExpectError bool
+	}{
+		{
+			Name: "empty id",
+			Input: map[string]interface{}{
+				"id": "",
+			},
+			Expected: map[string]interface{}{
+				"id": "",
+			},
+		},
+		{
+			Name: "invalid id",
+			Input: map[string]interface{}{
+				"id": "/subscriptions/1234",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "old id",
+			Input: map[string]interface{}{
+				"id": "/subscriptions/1234/resourcegroups/group1/providers/Microsoft.Example/things/thing1",
+			},
+			Expected: map[string]interface{}{
+				"id": "/subscriptions/1234/resourceGroups/group1/providers/Microsoft.Example/things/thing1",
+			},
+		},
+		{
+			Name: "new id",
+			Input: map[string]interface{}{
+				"id": "/subscriptions/1234/resourceGroups/group1/providers/Microsoft.Example/things/thing1",
+			},
+			Expected: map[string]interface{}{
+				"id": "/subscriptions/1234/resourceGroups/group1/providers/Microsoft.Example/things/thing1",
+			},
+		},
+		{
+			Name: "additional fields",
+			Input: map[string]interface{}{
+				"id":        "/subscriptions/1234/resourcegroups/group1/providers/Microsoft.Example/things/thing1",
+				"parent_id": "/subscriptions/1234/resourcegroups/group1/providers/Microsoft.Example/things/thing2",
+				"name":      "thing1",
+			},
+			Expected: map[string]interface{}{
+				"id":        "/subscriptions/1234/resourceGroups/group1/providers/Microsoft.Example/things/thing1",
+				"parent_id": "/subscriptions/1234/resourceGroups/group1/providers/Microsoft.Example/things/thing2",
+				"name":      "thing1",
+			},
+		},
+	}
+
+	upgrade := ResourceIDStateUpgrade{
+		ParseFunc:        parseMigrationTestIdInsensitively,
+		AdditionalFields: []string{"parent_id"},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := upgrade.UpgradeFunc()(v.Input, nil)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		for key, expected := range v.Expected {
+			if actual[key] != expected {
+				t.Fatalf("expected %q to be %q but got %q", key, expected, actual[key])
+			}
+		}
+	}
+}
+
+func TestBuildStateUpgraders(t *testing.T) {
+	currentSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+	upgrade := ResourceIDStateUpgrade{
+		ParseFunc: parseMigrationTestIdInsensitively,
+	}
+
+	testData := []struct {
+		Name          string
+		Input         StateUpgradeData
+		ExpectedCount int
+		ExpectError   bool
+	}{
+		{
+			Name: "no schema version",
+			Input: StateUpgradeData{
+				Upgraders: map[int]StateUpgrade{},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "missing upgrader",
+			Input: StateUpgradeData{
+				SchemaVersion: 2,
+				Upgraders: map[int]StateUpgrade{
+					0: upgrade,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "upgrader for a future version",
+			Input: StateUpgradeData{
+				SchemaVersion: 1,
+				Upgraders: map[int]StateUpgrade{
+					0: upgrade,
+					1: upgrade,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "valid",
+			Input: StateUpgradeData{
+				SchemaVersion: 2,
+				Upgraders: map[int]StateUpgrade{
+					1: upgrade,
+					0: upgrade,
+				},
+			},
+			ExpectedCount: 2,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := buildStateUpgraders(v.Input, currentSchema)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if len(actual) != v.ExpectedCount {
+			t.Fatalf("expected %d upgraders but got %d", v.ExpectedCount, len(actual))
+		}
+		for i, upgrader := range actual {
+			if upgrader.Version != i {
+				t.Fatalf("expected upgrader %d to be for version %d but got %d", i, i, upgrader.Version)
+			}
+		}
+	}
+}
@@ -19,7 +19,7 @@ type DataSourceWrapper struct {
 func NewDataSourceWrapper(dataSource DataSource) DataSourceWrapper {
 	return DataSourceWrapper{
 		dataSource: dataSource,
-		logger:     ConsoleLogger{},
+		logger:     NewConsoleLogger(),
 	}
 }
 
@@ -42,7 +42,7 @@ func (rw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 	resource := schema.Resource{
 		Schema: *resourceSchema,
 		Read: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, operationLogger(rw.logger, rw.dataSource.ResourceType(), "read"))
 			wrappedCtx, cancel := timeouts.ForRead(ctx, d)
 			defer cancel()
 			return rw.dataSource.Read().Func(wrappedCtx, metaData)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 )
 
 // combineSchema combines the arguments (user-configurable) and attributes (read-only) schema fields
@@ -51,10 +52,42 @@ func runArgs(d *schema.ResourceData, meta interface{}, logger Logger) (context.C
 	client := meta.(*clients.Client)
 	metaData := ResourceMetaData{
 		Client:                   client,
-		Logger:                   logger,
+		Logger:                   requestLogger(logger, d.Id),
 		ResourceData:             d,
 		serializationDebugLogger: NullLogger{},
 	}
 
 	return stopContext, metaData
 }
+
+func diffArgs(d *schema.ResourceDiff, meta interface{}, logger Logger) (context.Context, ResourceDiffMetaData) {
+	// NOTE: this is wrapped as a result of this function, so this is "fine" being unwrapped
+	stopContext := meta.(*clients.Client).StopContext
+	client := meta.(*clients.Client)
+	metaData := ResourceDiffMetaData{
+		Client:                   client,
+		Logger:                   requestLogger(logger, d.Id),
+		ResourceDiff:             d,
+		serializationDebugLogger: NullLogger{},
+	}
+
+	return stopContext, metaData
+}
+
+// operationLogger returns a Logger which includes the Resource Type and Operation in each message
+func operationLogger(logger Logger, resourceType string, operation string) Logger {
+	return logger.WithFields(LogFields{
+		LogFieldResourceType: resourceType,
+		LogFieldOperation:    operation,
+	})
+}
+
+// requestLogger returns a Logger which includes the Resource ID (once it's known) and the
+// Correlation Request ID sent to Azure in each message, so that the log messages for a
+// given Resource can be matched up with the requests made to Azure
+func requestLogger(logger Logger, resourceId func() string) Logger {
+	return logger.WithFields(LogFields{
+		LogFieldCorrelationRequestID: common.CorrelationRequestID(),
+		LogFieldResourceID:           resourceId,
+	})
+}
@@ -1,6 +1,7 @@
 package sdk
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -19,7 +20,7 @@ type ResourceWrapper struct {
 // NewResourceWrapper returns a ResourceWrapper for this Resource implementation
 func NewResourceWrapper(resource Resource) ResourceWrapper {
 	return ResourceWrapper{
-		logger:   ConsoleLogger{},
+		logger:   NewConsoleLogger(),
 		resource: resource,
 	}
 }
@@ -44,7 +45,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		Schema: *resourceSchema,
 
 		Create: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, operationLogger(rw.logger, rw.resource.ResourceType(), "create"))
 			wrappedCtx, cancel := timeouts.ForCreate(ctx, d)
 			defer cancel()
 			err := rw.resource.Create().Func(wrappedCtx, metaData)
@@ -59,13 +60,13 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 
 		// looks like these could be reused, easiest if they're not
 		Read: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, operationLogger(rw.logger, rw.resource.ResourceType(), "read"))
 			wrappedCtx, cancel := timeouts.ForRead(ctx, d)
 			defer cancel()
 			return rw.resource.Read().Func(wrappedCtx, metaData)
 		},
 		Delete: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, operationLogger(rw.logger, rw.resource.ResourceType(), "delete"))
 			wrappedCtx, cancel := timeouts.ForDelete(ctx, d)
 			defer cancel()
 			return rw.resource.Delete().Func(wrappedCtx, metaData)
@@ -95,7 +96,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			return nil
 		}, func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 			if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
-				ctx, metaData := runArgs(d, meta, rw.logger)
+				ctx, metaData := runArgs(d, meta, operationLogger(rw.logger, rw.resource.ResourceType(), "import"))
 				wrappedCtx, cancel := timeouts.ForRead(ctx, d)
 				defer cancel()
 
@@ -115,7 +116,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	// implementations can opt to interface
 	if v, ok := rw.resource.(ResourceWithUpdate); ok {
 		resource.Update = func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, operationLogger(rw.logger, rw.resource.ResourceType(), "update"))
 			wrappedCtx, cancel := timeouts.ForUpdate(ctx, d)
 			defer cancel()
 
@@ -140,8 +141,25 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		resource.DeprecationMessage = message
 	}
 
-	// TODO: CustomizeDiff
-	// TODO: State Migrations
+	if v, ok := rw.resource.(ResourceWithCustomizeDiff); ok {
+		resource.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+			ctx, metaData := diffArgs(d, meta, operationLogger(rw.logger, rw.resource.ResourceType(), "customize_diff"))
+			wrappedCtx, cancel := context.WithTimeout(ctx, v.CustomizeDiff().Timeout)
+			defer cancel()
+			return v.CustomizeDiff().Func(wrappedCtx, metaData)
+		}
+	}
+
+	if v, ok := rw.resource.(ResourceWithStateMigration); ok {
+		data := v.StateUpgraders()
+		upgraders, err := buildStateUpgraders(data, *resourceSchema)
+		if err != nil {
+			return nil, fmt.Errorf("building State Upgraders for %q: %+v", rw.resource.ResourceType(), err)
+		}
+
+		resource.SchemaVersion = data.SchemaVersion
+		resource.StateUpgraders = upgraders
+	}
 
 	return &resource, nil
 }
@@ -3,7 +3,11 @@ package sdk
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 // ValidateModelObject validates that the object contains the specified `tfschema` tags
@@ -13,11 +17,28 @@ func ValidateModelObject(input interface{}) error {
 		return fmt.Errorf("need a pointer")
 	}
 
-	// TODO: could we also validate that each `tfschema` tag exists in the schema?
+	objVal, err := modelObjectValue(input)
+	if err != nil {
+		return err
+	}
 
-	objType := reflect.TypeOf(input).Elem()
+	return validateModelObjectRecursively("", objVal.Type(), objVal)
+}
+
+// modelObjectValue returns the struct referenced by input, which is either a pointer to the
+// struct or (as returned from ModelObject) a pointer to an interface containing the struct
+func modelObjectValue(input interface{}) (reflect.Value, error) {
 	objVal := reflect.ValueOf(input).Elem()
-	return validateModelObjectRecursively("", objType, objVal)
+	if objVal.Kind() == reflect.Interface {
+		objVal = objVal.Elem()
+	}
+	objVal = reflect.Indirect(objVal)
+
+	if objVal.Kind() != reflect.Struct {
+		return objVal, fmt.Errorf("expected the model to be a struct but got %s", objVal.Kind())
+	}
+
+	return objVal, nil
 }
 
 func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal reflect.Value) (errOut error) {
@@ -34,23 +55,186 @@ func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal
 
 	for i := 0; i < objType.NumField(); i++ {
 		field := objType.Field(i)
-		fieldVal := objVal.Field(i)
+		fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
 
-		if field.Type.Kind() == reflect.Slice {
-			sv := fieldVal.Slice(0, fieldVal.Len())
-			innerType := sv.Type().Elem()
+		// nested objects can be specified as an object, a pointer to an object, or a slice of either
+		if innerType := nestedObjectType(field.Type); innerType != nil {
 			innerVal := reflect.Indirect(reflect.New(innerType))
-			fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
 			if err := validateModelObjectRecursively(fieldName, innerType, innerVal); err != nil {
 				return err
 			}
 		}
 
 		if _, exists := field.Tag.Lookup("tfschema"); !exists {
-			fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
 			return fmt.Errorf("field %q is missing an `tfschema` label", fieldName)
 		}
 	}
 
 	return nil
 }
+
+// nestedObjectType returns the type of the nested object for this field, if this is a nested object
+func nestedObjectType(fieldType reflect.Type) reflect.Type {
+	if fieldType.Kind() == reflect.Slice {
+		fieldType = fieldType.Elem()
+	}
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	if fieldType.Kind() != reflect.Struct {
+		return nil
+	}
+
+	return fieldType
+}
+
+// ValidateModelObjectMatchesSchema validates that the `tfschema` tags within the object match the
+// specified Schema, that is:
+//
+//   - each `tfschema` tag refers to a field within the Schema
+//   - each field within the Schema has a corresponding `tfschema` tag - since otherwise the field
+//     can't be decoded and is never set during the Read
+//   - the Go type of each field is compatible with the ValueType of the Schema field
+//
+// This is intended to be run from unit tests for each Typed Data Source/Resource, so
+// that these mismatches are caught at test-time rather than apply-time.
+func ValidateModelObjectMatchesSchema(input interface{}, resourceSchema map[string]*schema.Schema) error {
+	if err := ValidateModelObject(input); err != nil {
+		return err
+	}
+
+	objVal, err := modelObjectValue(input)
+	if err != nil {
+		return err
+	}
+
+	var result *multierror.Error
+	for _, err := range validateModelTypeMatchesSchema("", objVal.Type(), resourceSchema) {
+		result = multierror.Append(result, err)
+	}
+
+	return result.ErrorOrNil()
+}
+
+func validateModelTypeMatchesSchema(prefix string, objType reflect.Type, resourceSchema map[string]*schema.Schema) []error {
+	errors := make([]error, 0)
+	tagsInModel := make(map[string]struct{})
+
+	for i := 0; i < objType.NumField(); i++ {
+		field := objType.Field(i)
+		tag, exists := field.Tag.Lookup("tfschema")
+		if !exists {
+			// this is caught by ValidateModelObject
+			continue
+		}
+
+		key := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, tag), ".")
+		if _, duplicate := tagsInModel[tag]; duplicate {
+			errors = append(errors, fmt.Errorf("the `tfschema` tag %q is used for more than one field", key))
+			continue
+		}
+		tagsInModel[tag] = struct{}{}
+
+		fieldSchema, ok := resourceSchema[tag]
+		if !ok {
+			errors = append(errors, fmt.Errorf("field %q has the `tfschema` tag %q which doesn't exist in the Schema", field.Name, key))
+			continue
+		}
+
+		errors = append(errors, validateFieldTypeMatchesSchema(key, field.Type, fieldSchema)...)
+	}
+
+	keys := make([]string, 0)
+	for k := range resourceSchema {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if _, ok := tagsInModel[k]; !ok {
+			key := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, k), ".")
+			errors = append(errors, fmt.Errorf("the Schema field %q has no corresponding `tfschema` tag in the model, so it's never set", key))
+		}
+	}
+
+	return errors
+}
+
+func validateFieldTypeMatchesSchema(key string, fieldType reflect.Type, fieldSchema *schema.Schema) []error {
+	switch fieldSchema.Type {
+	case schema.TypeBool, schema.TypeInt, schema.TypeFloat, schema.TypeString:
+		// pointers are used to distinguish between a value being unset and the zero value
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if !goTypeMatchesValueType(fieldType, fieldSchema.Type) {
+			return []error{fmt.Errorf("%q is a %s in the Schema but a %s in the model", key, fieldSchema.Type, fieldType)}
+		}
+
+	case schema.TypeMap:
+		if fieldType.Kind() != reflect.Map || fieldType.Key().Kind() != reflect.String {
+			return []error{fmt.Errorf("%q is a %s in the Schema but a %s in the model", key, fieldSchema.Type, fieldType)}
+		}
+
+		// the Plugin SDK defaults the Elem of a Map to a String when unspecified
+		elemType := schema.TypeString
+		if v, ok := fieldSchema.Elem.(*schema.Schema); ok {
+			elemType = v.Type
+		}
+		if !goTypeMatchesValueType(fieldType.Elem(), elemType) {
+			return []error{fmt.Errorf("%q is a map of %s in the Schema but a %s in the model", key, elemType, fieldType)}
+		}
+
+	case schema.TypeList, schema.TypeSet:
+		switch elem := fieldSchema.Elem.(type) {
+		case *schema.Schema:
+			if fieldType.Kind() != reflect.Slice || !goTypeMatchesValueType(fieldType.Elem(), elem.Type) {
+				return []error{fmt.Errorf("%q is a %s of %s in the Schema but a %s in the model", key, fieldSchema.Type, elem.Type, fieldType)}
+			}
+
+		case *schema.Resource:
+			nestedType := nestedObjectType(fieldType)
+			if nestedType == nil {
+				return []error{fmt.Errorf("%q is a %s of objects in the Schema but a %s in the model", key, fieldSchema.Type, fieldType)}
+			}
+
+			// a single object can only be used when at most one item can be specified
+			if fieldType.Kind() != reflect.Slice && fieldSchema.MaxItems != 1 {
+				return []error{fmt.Errorf("%q can contain multiple items in the Schema but is a single object (%s) in the model - either set `MaxItems` to 1 or use a slice", key, fieldType)}
+			}
+
+			return validateModelTypeMatchesSchema(key, nestedType, elem.Schema)
+
+		default:
+			return []error{fmt.Errorf("%q is a %s in the Schema with an unsupported Elem %T", key, fieldSchema.Type, fieldSchema.Elem)}
+		}
+
+	default:
+		return []error{fmt.Errorf("%q has an unsupported type %s in the Schema", key, fieldSchema.Type)}
+	}
+
+	return nil
+}
+
+func goTypeMatchesValueType(fieldType reflect.Type, valueType schema.ValueType) bool {
+	switch valueType {
+	case schema.TypeBool:
+		return fieldType.Kind() == reflect.Bool
+
+	case schema.TypeInt:
+		switch fieldType.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return true
+		}
+
+	case schema.TypeFloat:
+		switch fieldType.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return true
+		}
+
+	case schema.TypeString:
+		return fieldType.Kind() == reflect.String
+	}
+
+	return false
+}
@@ -1,6 +1,10 @@
 package sdk
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
 
 func TestValidateTopLevelObjectValid(t *testing.T) {
 	type Person struct {
@@ -56,3 +60,239 @@ func TestValidateNestedObjectInvalid(t *testing.T) {
 		t.Fatalf("expected an error but didn't get one")
 	}
 }
+
+func TestValidateNestedPointerObjectValid(t *testing.T) {
+	type Pet struct {
+		Name string `tfschema:"name"`
+	}
+	type Person struct {
+		Name      string   `tfschema:"name"`
+		Nicknames []string `tfschema:"nicknames"`
+		Pet       *Pet     `tfschema:"pet"`
+		Pets      []*Pet   `tfschema:"pets"`
+		Favourite Pet      `tfschema:"favourite"`
+	}
+	if err := ValidateModelObject(&Person{}); err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+}
+
+func TestValidateNestedPointerObjectInvalid(t *testing.T) {
+	type Pet struct {
+		Name string `tfschema:"name"`
+		Age  int
+	}
+
+	t.Log("Pointer")
+	type Person1 struct {
+		Pet *Pet `tfschema:"pet"`
+	}
+	if err := ValidateModelObject(&Person1{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	t.Log("Slice of Pointers")
+	type Person2 struct {
+		Pets []*Pet `tfschema:"pets"`
+	}
+	if err := ValidateModelObject(&Person2{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	t.Log("Object")
+	type Person3 struct {
+		Pet Pet `tfschema:"pet"`
+	}
+	if err := ValidateModelObject(&Person3{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	t.Log("Field after a Slice of Strings")
+	type Person4 struct {
+		Nicknames []string `tfschema:"nicknames"`
+		Age       int
+	}
+	if err := ValidateModelObject(&Person4{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestValidateModelObjectFromInterface(t *testing.T) {
+	type Person struct {
+		Name string `tfschema:"name"`
+		Age  int
+	}
+	var obj interface{} = Person{}
+	if err := ValidateModelObject(&obj); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestValidateModelObjectMatchesSchema(t *testing.T) {
+	type Pet struct {
+		Name string `tfschema:"name"`
+	}
+	type Person struct {
+		Name      string            `tfschema:"name"`
+		Age       *int              `tfschema:"age"`
+		Height    float64           `tfschema:"height"`
+		Enabled   bool              `tfschema:"enabled"`
+		Nicknames []string          `tfschema:"nicknames"`
+		Tags      map[string]string `tfschema:"tags"`
+		Favourite *Pet              `tfschema:"favourite"`
+		Pets      []Pet             `tfschema:"pets"`
+	}
+	petSchema := func(maxItems int) *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: maxItems,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+	validSchema := func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"age": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"height": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"nicknames": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"favourite": petSchema(1),
+			"pets":      petSchema(0),
+		}
+	}
+
+	testData := []struct {
+		Name        string
+		Schema      func() map[string]*schema.Schema
+		ExpectError bool
+	}{
+		{
+			Name:   "Valid",
+			Schema: validSchema,
+		},
+		{
+			Name: "Tag Missing From Schema",
+			Schema: func() map[string]*schema.Schema {
+				s := validSchema()
+				delete(s, "height")
+				return s
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Schema Field Missing From Model",
+			Schema: func() map[string]*schema.Schema {
+				s := validSchema()
+				s["weight"] = &schema.Schema{
+					Type:     schema.TypeInt,
+					Computed: true,
+				}
+				return s
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Nested Schema Field Missing From Model",
+			Schema: func() map[string]*schema.Schema {
+				s := validSchema()
+				s["pets"].Elem.(*schema.Resource).Schema["age"] = &schema.Schema{
+					Type:     schema.TypeInt,
+					Optional: true,
+				}
+				return s
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Mismatched Type",
+			Schema: func() map[string]*schema.Schema {
+				s := validSchema()
+				s["enabled"].Type = schema.TypeString
+				return s
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Mismatched List Type",
+			Schema: func() map[string]*schema.Schema {
+				s := validSchema()
+				s["nicknames"].Elem = &schema.Schema{
+					Type: schema.TypeInt,
+				}
+				return s
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Mismatched Map Type",
+			Schema: func() map[string]*schema.Schema {
+				s := validSchema()
+				s["tags"].Elem = &schema.Schema{
+					Type: schema.TypeBool,
+				}
+				return s
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Single Object Without MaxItems",
+			Schema: func() map[string]*schema.Schema {
+				s := validSchema()
+				s["favourite"] = petSchema(0)
+				return s
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		var obj interface{} = Person{}
+		err := ValidateModelObjectMatchesSchema(&obj, v.Schema())
+		if err != nil {
+			if v.ExpectError {
+				t.Logf("[DEBUG] Got the expected error: %+v", err)
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
@@ -100,7 +100,7 @@ func dataSourceAdvisorRecommendations() *schema.Resource {
 }
 
 func dataSourceAdvisorRecommendationsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Advisor.RecommendationsClient
+	client := meta.(*clients.Client).Advisor().RecommendationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -131,7 +131,7 @@ func resourceAnalysisServicesServer() *schema.Resource {
 }
 
 func resourceAnalysisServicesServerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AnalysisServices.ServerClient
+	client := meta.(*clients.Client).AnalysisServices().ServerClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -192,7 +192,7 @@ func resourceAnalysisServicesServerCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAnalysisServicesServerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AnalysisServices.ServerClient
+	client := meta.(*clients.Client).AnalysisServices().ServerClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -246,7 +246,7 @@ func resourceAnalysisServicesServerRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAnalysisServicesServerUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AnalysisServices.ServerClient
+	client := meta.(*clients.Client).AnalysisServices().ServerClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -313,7 +313,7 @@ func resourceAnalysisServicesServerUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAnalysisServicesServerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AnalysisServices.ServerClient
+	client := meta.(*clients.Client).AnalysisServices().ServerClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
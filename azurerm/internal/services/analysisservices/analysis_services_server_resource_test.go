@@ -505,7 +505,7 @@ func (t AnalysisServicesServerResource) Exists(ctx context.Context, clients *cli
 		return nil, err
 	}
 
-	resp, err := clients.AnalysisServices.ServerClient.GetDetails(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.AnalysisServices().ServerClient.GetDetails(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Analysis Services Server %q (resource group: %q): %+v", id.Name, id.ResourceGroup, err)
 	}
@@ -514,7 +514,7 @@ func (t AnalysisServicesServerResource) Exists(ctx context.Context, clients *cli
 }
 
 func (t AnalysisServicesServerResource) suspend(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) error {
-	client := clients.AnalysisServices.ServerClient
+	client := clients.AnalysisServices().ServerClient
 
 	id, err := parse.ServerID(state.ID)
 	if err != nil {
@@ -536,7 +536,7 @@ func (t AnalysisServicesServerResource) suspend(ctx context.Context, clients *cl
 
 func (t AnalysisServicesServerResource) checkState(serverState analysisservices.State) acceptance.ClientCheckFunc {
 	return func(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) error {
-		client := clients.AnalysisServices.ServerClient
+		client := clients.AnalysisServices().ServerClient
 
 		id, err := parse.ServerID(state.ID)
 		if err != nil {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ServerId struct {
@@ -67,3 +68,12 @@ func ServerID(input string) (*ServerId, error) {
 
 	return &resourceId, nil
 }
+
+// ServerIDNormalized parses a Server ID into an ServerId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ServerID method should be used instead for validation etc.
+func ServerIDNormalized(input string) (*ServerId, error) {
+	return ServerID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AnalysisServices/servers/%s"))
+}
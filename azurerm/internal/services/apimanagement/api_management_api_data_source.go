@@ -119,7 +119,7 @@ func dataSourceApiManagementApi() *schema.Resource {
 }
 
 func dataSourceApiManagementApiRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiClient
+	client := meta.(*clients.Client).ApiManagement().ApiClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
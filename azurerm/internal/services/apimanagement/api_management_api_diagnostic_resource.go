@@ -141,7 +141,7 @@ func resourceApiManagementApiDiagnosticAdditionalContentSchema() *schema.Schema
 }
 
 func resourceApiManagementApiDiagnosticCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiDiagnosticClient
+	client := meta.(*clients.Client).ApiManagement().ApiDiagnosticClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -235,7 +235,7 @@ func resourceApiManagementApiDiagnosticCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceApiManagementApiDiagnosticRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiDiagnosticClient
+	client := meta.(*clients.Client).ApiManagement().ApiDiagnosticClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -288,7 +288,7 @@ func resourceApiManagementApiDiagnosticRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceApiManagementApiDiagnosticDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiDiagnosticClient
+	client := meta.(*clients.Client).ApiManagement().ApiDiagnosticClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -90,7 +90,7 @@ func (ApiManagementApiDiagnosticResource) Exists(ctx context.Context, clients *c
 		return nil, err
 	}
 
-	resp, err := clients.ApiManagement.ApiDiagnosticClient.Get(ctx, id.ResourceGroup, id.ServiceName, id.ApiName, id.DiagnosticName)
+	resp, err := clients.ApiManagement().ApiDiagnosticClient.Get(ctx, id.ResourceGroup, id.ServiceName, id.ApiName, id.DiagnosticName)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagementApiDiagnostic (%s): %+v", id.String(), err)
 	}
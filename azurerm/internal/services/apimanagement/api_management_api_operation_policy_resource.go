@@ -62,7 +62,7 @@ func resourceApiManagementApiOperationPolicy() *schema.Resource {
 }
 
 func resourceApiManagementAPIOperationPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiOperationPoliciesClient
+	client := meta.(*clients.Client).ApiManagement().ApiOperationPoliciesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -124,7 +124,7 @@ func resourceApiManagementAPIOperationPolicyCreateUpdate(d *schema.ResourceData,
 }
 
 func resourceApiManagementAPIOperationPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiOperationPoliciesClient
+	client := meta.(*clients.Client).ApiManagement().ApiOperationPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -163,7 +163,7 @@ func resourceApiManagementAPIOperationPolicyRead(d *schema.ResourceData, meta in
 }
 
 func resourceApiManagementAPIOperationPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiOperationPoliciesClient
+	client := meta.(*clients.Client).ApiManagement().ApiOperationPoliciesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -104,7 +104,7 @@ func (ApiManagementApiOperationPolicyResource) Exists(ctx context.Context, clien
 	apiName := id.Path["apis"]
 	operationID := id.Path["operations"]
 
-	resp, err := clients.ApiManagement.ApiOperationPoliciesClient.Get(ctx, resourceGroup, serviceName, apiName, operationID, apimanagement.PolicyExportFormatXML)
+	resp, err := clients.ApiManagement().ApiOperationPoliciesClient.Get(ctx, resourceGroup, serviceName, apiName, operationID, apimanagement.PolicyExportFormatXML)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagementApi Operation Policy (%s): %+v", id, err)
 	}
@@ -112,7 +112,7 @@ func resourceApiManagementApiOperation() *schema.Resource {
 }
 
 func resourceApiManagementApiOperationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiOperationsClient
+	client := meta.(*clients.Client).ApiManagement().ApiOperationsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -181,7 +181,7 @@ func resourceApiManagementApiOperationCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceApiManagementApiOperationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiOperationsClient
+	client := meta.(*clients.Client).ApiManagement().ApiOperationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -237,7 +237,7 @@ func resourceApiManagementApiOperationRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceApiManagementApiOperationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiOperationsClient
+	client := meta.(*clients.Client).ApiManagement().ApiOperationsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
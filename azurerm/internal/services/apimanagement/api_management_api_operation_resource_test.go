@@ -134,7 +134,7 @@ func (ApiManagementApiOperationResource) Exists(ctx context.Context, clients *cl
 	apiId := id.Path["apis"]
 	operationId := id.Path["operations"]
 
-	resp, err := clients.ApiManagement.ApiOperationsClient.Get(ctx, resourceGroup, serviceName, apiId, operationId)
+	resp, err := clients.ApiManagement().ApiOperationsClient.Get(ctx, resourceGroup, serviceName, apiId, operationId)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagementApi Operation (%s): %+v", id, err)
 	}
@@ -60,7 +60,7 @@ func resourceApiManagementApiPolicy() *schema.Resource {
 }
 
 func resourceApiManagementAPIPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiPoliciesClient
+	client := meta.(*clients.Client).ApiManagement().ApiPoliciesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -126,7 +126,7 @@ func resourceApiManagementAPIPolicyCreateUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceApiManagementAPIPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiPoliciesClient
+	client := meta.(*clients.Client).ApiManagement().ApiPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -168,7 +168,7 @@ func resourceApiManagementAPIPolicyRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceApiManagementAPIPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiPoliciesClient
+	client := meta.(*clients.Client).ApiManagement().ApiPoliciesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -108,7 +108,7 @@ func (ApiManagementApiPolicyResource) Exists(ctx context.Context, clients *clien
 	serviceName := id.Path["service"]
 	apiName := id.Path["apis"]
 
-	resp, err := clients.ApiManagement.ApiPoliciesClient.Get(ctx, resourceGroup, serviceName, apiName, apimanagement.PolicyExportFormatXML)
+	resp, err := clients.ApiManagement().ApiPoliciesClient.Get(ctx, resourceGroup, serviceName, apiName, apimanagement.PolicyExportFormatXML)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagementApi Policy (%s): %+v", id, err)
 	}
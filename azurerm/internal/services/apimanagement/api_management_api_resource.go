@@ -246,7 +246,7 @@ func resourceApiManagementApi() *schema.Resource {
 }
 
 func resourceApiManagementApiCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiClient
+	client := meta.(*clients.Client).ApiManagement().ApiClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -403,7 +403,7 @@ func resourceApiManagementApiCreateUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceApiManagementApiRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiClient
+	client := meta.(*clients.Client).ApiManagement().ApiClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -472,7 +472,7 @@ func resourceApiManagementApiRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceApiManagementApiDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiClient
+	client := meta.(*clients.Client).ApiManagement().ApiClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -272,7 +272,7 @@ func (ApiManagementApiResource) Exists(ctx context.Context, clients *clients.Cli
 	serviceName := id.Path["service"]
 	apiid := id.Path["apis"]
 
-	resp, err := clients.ApiManagement.ApiClient.Get(ctx, resourceGroup, serviceName, apiid)
+	resp, err := clients.ApiManagement().ApiClient.Get(ctx, resourceGroup, serviceName, apiid)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagementApi (%s): %+v", id, err)
 	}
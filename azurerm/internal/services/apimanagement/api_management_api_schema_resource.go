@@ -60,7 +60,7 @@ func resourceApiManagementApiSchema() *schema.Resource {
 }
 
 func resourceApiManagementApiSchemaCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiSchemasClient
+	client := meta.(*clients.Client).ApiManagement().ApiSchemasClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -110,7 +110,7 @@ func resourceApiManagementApiSchemaCreateUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceApiManagementApiSchemaRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiSchemasClient
+	client := meta.(*clients.Client).ApiManagement().ApiSchemasClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -150,7 +150,7 @@ func resourceApiManagementApiSchemaRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceApiManagementApiSchemaDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiSchemasClient
+	client := meta.(*clients.Client).ApiManagement().ApiSchemasClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
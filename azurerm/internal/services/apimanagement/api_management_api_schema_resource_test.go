@@ -57,7 +57,7 @@ func (ApiManagementApiSchemaResource) Exists(ctx context.Context, clients *clien
 	apiName := id.Path["apis"]
 	schemaID := id.Path["schemas"]
 
-	resp, err := clients.ApiManagement.ApiSchemasClient.Get(ctx, resourceGroup, serviceName, apiName, schemaID)
+	resp, err := clients.ApiManagement().ApiSchemasClient.Get(ctx, resourceGroup, serviceName, apiName, schemaID)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagementApi Schema (%s): %+v", id, err)
 	}
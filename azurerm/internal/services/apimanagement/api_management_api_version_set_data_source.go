@@ -58,7 +58,7 @@ func dataSourceApiManagementApiVersionSet() *schema.Resource {
 }
 
 func dataSourceApiManagementApiVersionSetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiVersionSetClient
+	client := meta.(*clients.Client).ApiManagement().ApiVersionSetClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -91,7 +91,7 @@ func resourceApiManagementApiVersionSet() *schema.Resource {
 }
 
 func resourceApiManagementApiVersionSetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiVersionSetClient
+	client := meta.(*clients.Client).ApiManagement().ApiVersionSetClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -174,7 +174,7 @@ func resourceApiManagementApiVersionSetCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceApiManagementApiVersionSetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiVersionSetClient
+	client := meta.(*clients.Client).ApiManagement().ApiVersionSetClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -210,7 +210,7 @@ func resourceApiManagementApiVersionSetRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceApiManagementApiVersionSetDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiVersionSetClient
+	client := meta.(*clients.Client).ApiManagement().ApiVersionSetClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -108,7 +108,7 @@ func (ApiManagementApiVersionSetResource) Exists(ctx context.Context, clients *c
 		return nil, err
 	}
 
-	resp, err := clients.ApiManagement.ApiVersionSetClient.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	resp, err := clients.ApiManagement().ApiVersionSetClient.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagementApi Version Set (%s): %+v", id, err)
 	}
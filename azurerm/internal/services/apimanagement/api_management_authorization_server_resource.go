@@ -187,7 +187,7 @@ func resourceApiManagementAuthorizationServer() *schema.Resource {
 }
 
 func resourceApiManagementAuthorizationServerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.AuthorizationServersClient
+	client := meta.(*clients.Client).ApiManagement().AuthorizationServersClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -281,7 +281,7 @@ func resourceApiManagementAuthorizationServerCreateUpdate(d *schema.ResourceData
 }
 
 func resourceApiManagementAuthorizationServerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.AuthorizationServersClient
+	client := meta.(*clients.Client).ApiManagement().AuthorizationServersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -346,7 +346,7 @@ func resourceApiManagementAuthorizationServerRead(d *schema.ResourceData, meta i
 }
 
 func resourceApiManagementAuthorizationServerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.AuthorizationServersClient
+	client := meta.(*clients.Client).ApiManagement().AuthorizationServersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
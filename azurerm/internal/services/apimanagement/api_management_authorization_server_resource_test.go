@@ -72,7 +72,7 @@ func (ApiManagementAuthorizationServerResource) Exists(ctx context.Context, clie
 	serviceName := id.Path["service"]
 	name := id.Path["authorizationServers"]
 
-	resp, err := clients.ApiManagement.AuthorizationServersClient.Get(ctx, resourceGroup, serviceName, name)
+	resp, err := clients.ApiManagement().AuthorizationServersClient.Get(ctx, resourceGroup, serviceName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement Authorization Server (%s): %+v", id, err)
 	}
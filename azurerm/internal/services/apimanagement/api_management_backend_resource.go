@@ -238,7 +238,7 @@ func resourceApiManagementBackend() *schema.Resource {
 }
 
 func resourceApiManagementBackendCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.BackendClient
+	client := meta.(*clients.Client).ApiManagement().BackendClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -315,7 +315,7 @@ func resourceApiManagementBackendCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceApiManagementBackendRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.BackendClient
+	client := meta.(*clients.Client).ApiManagement().BackendClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	id, err := parse.BackendID(d.Id())
@@ -368,7 +368,7 @@ func resourceApiManagementBackendRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceApiManagementBackendDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.BackendClient
+	client := meta.(*clients.Client).ApiManagement().BackendClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
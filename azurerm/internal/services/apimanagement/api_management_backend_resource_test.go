@@ -183,7 +183,7 @@ func (ApiManagementAuthorizationBackendResource) Exists(ctx context.Context, cli
 	serviceName := id.Path["service"]
 	name := id.Path["backends"]
 
-	resp, err := clients.ApiManagement.BackendClient.Get(ctx, resourceGroup, serviceName, name)
+	resp, err := clients.ApiManagement().BackendClient.Get(ctx, resourceGroup, serviceName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement Authorization Backend (%s): %+v", id, err)
 	}
@@ -200,7 +200,7 @@ func (r ApiManagementAuthorizationBackendResource) Destroy(ctx context.Context,
 	serviceName := id.Path["service"]
 	name := id.Path["backends"]
 
-	resp, err := client.ApiManagement.BackendClient.Delete(ctx, resourceGroup, serviceName, name, "")
+	resp, err := client.ApiManagement().BackendClient.Delete(ctx, resourceGroup, serviceName, name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(resp) {
 			return utils.Bool(true), nil
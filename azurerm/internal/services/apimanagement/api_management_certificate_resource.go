@@ -74,7 +74,7 @@ func resourceApiManagementCertificate() *schema.Resource {
 }
 
 func resourceApiManagementCertificateCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.CertificatesClient
+	client := meta.(*clients.Client).ApiManagement().CertificatesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -121,7 +121,7 @@ func resourceApiManagementCertificateCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceApiManagementCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.CertificatesClient
+	client := meta.(*clients.Client).ApiManagement().CertificatesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -162,7 +162,7 @@ func resourceApiManagementCertificateRead(d *schema.ResourceData, meta interface
 }
 
 func resourceApiManagementCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.CertificatesClient
+	client := meta.(*clients.Client).ApiManagement().CertificatesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
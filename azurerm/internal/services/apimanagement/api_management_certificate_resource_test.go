@@ -69,7 +69,7 @@ func (ApiManagementCertificateResource) Exists(ctx context.Context, clients *cli
 	serviceName := id.Path["service"]
 	name := id.Path["certificates"]
 
-	resp, err := clients.ApiManagement.CertificatesClient.Get(ctx, resourceGroup, serviceName, name)
+	resp, err := clients.ApiManagement().CertificatesClient.Get(ctx, resourceGroup, serviceName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement Certificate (%s): %+v", id, err)
 	}
@@ -91,7 +91,7 @@ func resourceApiManagementCustomDomain() *schema.Resource {
 }
 
 func apiManagementCustomDomainCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ServiceClient
+	client := meta.(*clients.Client).ApiManagement().ServiceClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -162,7 +162,7 @@ func apiManagementCustomDomainCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func apiManagementCustomDomainRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ServiceClient
+	client := meta.(*clients.Client).ApiManagement().ServiceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -202,7 +202,7 @@ func apiManagementCustomDomainRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func apiManagementCustomDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ServiceClient
+	client := meta.(*clients.Client).ApiManagement().ServiceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -91,7 +91,7 @@ func (ApiManagementCustomDomainResource) Exists(ctx context.Context, clients *cl
 	resourceGroup := id.ResourceGroup
 	serviceName := id.ServiceName
 
-	resp, err := clients.ApiManagement.ServiceClient.Get(ctx, resourceGroup, serviceName)
+	resp, err := clients.ApiManagement().ServiceClient.Get(ctx, resourceGroup, serviceName)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement Custom Domain (%s): %+v", id, err)
 	}
@@ -118,7 +118,7 @@ func resourceApiManagementDiagnostic() *schema.Resource {
 }
 
 func resourceApiManagementDiagnosticCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.DiagnosticClient
+	client := meta.(*clients.Client).ApiManagement().DiagnosticClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -211,7 +211,7 @@ func resourceApiManagementDiagnosticCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceApiManagementDiagnosticRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.DiagnosticClient
+	client := meta.(*clients.Client).ApiManagement().DiagnosticClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -263,7 +263,7 @@ func resourceApiManagementDiagnosticRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceApiManagementDiagnosticDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.DiagnosticClient
+	client := meta.(*clients.Client).ApiManagement().DiagnosticClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -74,7 +74,7 @@ func resourceApiManagementGroup() *schema.Resource {
 }
 
 func resourceApiManagementGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.GroupClient
+	client := meta.(*clients.Client).ApiManagement().GroupClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -126,7 +126,7 @@ func resourceApiManagementGroupCreateUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceApiManagementGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.GroupClient
+	client := meta.(*clients.Client).ApiManagement().GroupClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -164,7 +164,7 @@ func resourceApiManagementGroupRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceApiManagementGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.GroupClient
+	client := meta.(*clients.Client).ApiManagement().GroupClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -113,7 +113,7 @@ func (ApiManagementGroupResource) Exists(ctx context.Context, clients *clients.C
 	serviceName := id.Path["service"]
 	name := id.Path["groups"]
 
-	resp, err := clients.ApiManagement.GroupClient.Get(ctx, resourceGroup, serviceName, name)
+	resp, err := clients.ApiManagement().GroupClient.Get(ctx, resourceGroup, serviceName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement Group (%s): %+v", id, err)
 	}
@@ -45,7 +45,7 @@ func resourceApiManagementGroupUser() *schema.Resource {
 }
 
 func resourceApiManagementGroupUserCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.GroupUsersClient
+	client := meta.(*clients.Client).ApiManagement().GroupUsersClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -80,7 +80,7 @@ func resourceApiManagementGroupUserCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceApiManagementGroupUserRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.GroupUsersClient
+	client := meta.(*clients.Client).ApiManagement().GroupUsersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -113,7 +113,7 @@ func resourceApiManagementGroupUserRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceApiManagementGroupUserDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.GroupUsersClient
+	client := meta.(*clients.Client).ApiManagement().GroupUsersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
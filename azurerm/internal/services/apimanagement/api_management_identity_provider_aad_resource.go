@@ -71,7 +71,7 @@ func resourceApiManagementIdentityProviderAAD() *schema.Resource {
 }
 
 func resourceApiManagementIdentityProviderAADCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -122,7 +122,7 @@ func resourceApiManagementIdentityProviderAADCreateUpdate(d *schema.ResourceData
 }
 
 func resourceApiManagementIdentityProviderAADRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -158,7 +158,7 @@ func resourceApiManagementIdentityProviderAADRead(d *schema.ResourceData, meta i
 }
 
 func resourceApiManagementIdentityProviderAADDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -85,7 +85,7 @@ func (ApiManagementIdentityProviderAADResource) Exists(ctx context.Context, clie
 		return nil, err
 	}
 
-	resp, err := clients.ApiManagement.IdentityProviderClient.Get(ctx, id.ResourceGroup, id.ServiceName, apimanagement.IdentityProviderType(id.Name))
+	resp, err := clients.ApiManagement().IdentityProviderClient.Get(ctx, id.ResourceGroup, id.ServiceName, apimanagement.IdentityProviderType(id.Name))
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement Identity Provider AAD (%s): %+v", id, err)
 	}
@@ -103,7 +103,7 @@ func resourceArmApiManagementIdentityProviderAADB2C() *schema.Resource {
 }
 
 func resourceArmApiManagementIdentityProviderAADB2CCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -159,7 +159,7 @@ func resourceArmApiManagementIdentityProviderAADB2CCreateUpdate(d *schema.Resour
 }
 
 func resourceArmApiManagementIdentityProviderAADB2CRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -203,7 +203,7 @@ func resourceArmApiManagementIdentityProviderAADB2CRead(d *schema.ResourceData,
 }
 
 func resourceArmApiManagementIdentityProviderAADB2CDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
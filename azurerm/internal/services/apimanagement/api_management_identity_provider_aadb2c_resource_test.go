@@ -96,7 +96,7 @@ func (ApiManagementIdentityProviderAADB2CResource) Exists(ctx context.Context, c
 		return nil, err
 	}
 
-	resp, err := clients.ApiManagement.IdentityProviderClient.Get(ctx, id.ResourceGroup, id.ServiceName, apimanagement.IdentityProviderType(id.Name))
+	resp, err := clients.ApiManagement().IdentityProviderClient.Get(ctx, id.ResourceGroup, id.ServiceName, apimanagement.IdentityProviderType(id.Name))
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement Identity Provider AADB2C (%s): %+v", id, err)
 	}
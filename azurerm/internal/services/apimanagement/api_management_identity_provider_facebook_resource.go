@@ -57,7 +57,7 @@ func resourceApiManagementIdentityProviderFacebook() *schema.Resource {
 }
 
 func resourceApiManagementIdentityProviderFacebookCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -104,7 +104,7 @@ func resourceApiManagementIdentityProviderFacebookCreateUpdate(d *schema.Resourc
 }
 
 func resourceApiManagementIdentityProviderFacebookRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -138,7 +138,7 @@ func resourceApiManagementIdentityProviderFacebookRead(d *schema.ResourceData, m
 }
 
 func resourceApiManagementIdentityProviderFacebookDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -79,7 +79,7 @@ func (ApiManagementIdentityProviderFacebookResource) Exists(ctx context.Context,
 		return nil, err
 	}
 
-	resp, err := clients.ApiManagement.IdentityProviderClient.Get(ctx, id.ResourceGroup, id.ServiceName, apimanagement.IdentityProviderType(id.Name))
+	resp, err := clients.ApiManagement().IdentityProviderClient.Get(ctx, id.ResourceGroup, id.ServiceName, apimanagement.IdentityProviderType(id.Name))
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement Identity Provider Facebook (%s): %+v", id, err)
 	}
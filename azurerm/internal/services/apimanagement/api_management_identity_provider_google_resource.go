@@ -58,7 +58,7 @@ func resourceApiManagementIdentityProviderGoogle() *schema.Resource {
 }
 
 func resourceApiManagementIdentityProviderGoogleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -105,7 +105,7 @@ func resourceApiManagementIdentityProviderGoogleCreateUpdate(d *schema.ResourceD
 }
 
 func resourceApiManagementIdentityProviderGoogleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -139,7 +139,7 @@ func resourceApiManagementIdentityProviderGoogleRead(d *schema.ResourceData, met
 }
 
 func resourceApiManagementIdentityProviderGoogleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -57,7 +57,7 @@ func resourceApiManagementIdentityProviderMicrosoft() *schema.Resource {
 }
 
 func resourceApiManagementIdentityProviderMicrosoftCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -104,7 +104,7 @@ func resourceApiManagementIdentityProviderMicrosoftCreateUpdate(d *schema.Resour
 }
 
 func resourceApiManagementIdentityProviderMicrosoftRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -138,7 +138,7 @@ func resourceApiManagementIdentityProviderMicrosoftRead(d *schema.ResourceData,
 }
 
 func resourceApiManagementIdentityProviderMicrosoftDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -57,7 +57,7 @@ func resourceApiManagementIdentityProviderTwitter() *schema.Resource {
 }
 
 func resourceApiManagementIdentityProviderTwitterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -104,7 +104,7 @@ func resourceApiManagementIdentityProviderTwitterCreateUpdate(d *schema.Resource
 }
 
 func resourceApiManagementIdentityProviderTwitterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -138,7 +138,7 @@ func resourceApiManagementIdentityProviderTwitterRead(d *schema.ResourceData, me
 }
 
 func resourceApiManagementIdentityProviderTwitterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.IdentityProviderClient
+	client := meta.(*clients.Client).ApiManagement().IdentityProviderClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -105,7 +105,7 @@ func resourceApiManagementLogger() *schema.Resource {
 }
 
 func resourceApiManagementLoggerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.LoggerClient
+	client := meta.(*clients.Client).ApiManagement().LoggerClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -169,7 +169,7 @@ func resourceApiManagementLoggerCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceApiManagementLoggerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.LoggerClient
+	client := meta.(*clients.Client).ApiManagement().LoggerClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -208,7 +208,7 @@ func resourceApiManagementLoggerRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceApiManagementLoggerUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.LoggerClient
+	client := meta.(*clients.Client).ApiManagement().LoggerClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -242,7 +242,7 @@ func resourceApiManagementLoggerUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceApiManagementLoggerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.LoggerClient
+	client := meta.(*clients.Client).ApiManagement().LoggerClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -193,7 +193,7 @@ func (ApiManagementLoggerResource) Exists(ctx context.Context, clients *clients.
 	serviceName := id.Path["service"]
 	name := id.Path["loggers"]
 
-	resp, err := clients.ApiManagement.LoggerClient.Get(ctx, resourceGroup, serviceName, name)
+	resp, err := clients.ApiManagement().LoggerClient.Get(ctx, resourceGroup, serviceName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement Logger (%s): %+v", id, err)
 	}
@@ -73,7 +73,7 @@ func resourceApiManagementNamedValue() *schema.Resource {
 }
 
 func resourceApiManagementNamedValueCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.NamedValueClient
+	client := meta.(*clients.Client).ApiManagement().NamedValueClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -128,7 +128,7 @@ func resourceApiManagementNamedValueCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceApiManagementNamedValueRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.NamedValueClient
+	client := meta.(*clients.Client).ApiManagement().NamedValueClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -169,7 +169,7 @@ func resourceApiManagementNamedValueRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceApiManagementNamedValueDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.NamedValueClient
+	client := meta.(*clients.Client).ApiManagement().NamedValueClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
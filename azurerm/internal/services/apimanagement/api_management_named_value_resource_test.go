@@ -63,7 +63,7 @@ func (ApiManagementNamedValueResource) Exists(ctx context.Context, clients *clie
 	serviceName := id.Path["service"]
 	name := id.Path["namedValues"]
 
-	resp, err := clients.ApiManagement.NamedValueClient.Get(ctx, resourceGroup, serviceName, name)
+	resp, err := clients.ApiManagement().NamedValueClient.Get(ctx, resourceGroup, serviceName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement Named Value (%s): %+v", id, err)
 	}
@@ -78,7 +78,7 @@ func resourceApiManagementOpenIDConnectProvider() *schema.Resource {
 }
 
 func resourceApiManagementOpenIDConnectProviderCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.OpenIdConnectClient
+	client := meta.(*clients.Client).ApiManagement().OpenIdConnectClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -126,7 +126,7 @@ func resourceApiManagementOpenIDConnectProviderCreateUpdate(d *schema.ResourceDa
 }
 
 func resourceApiManagementOpenIDConnectProviderRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.OpenIdConnectClient
+	client := meta.(*clients.Client).ApiManagement().OpenIdConnectClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -163,7 +163,7 @@ func resourceApiManagementOpenIDConnectProviderRead(d *schema.ResourceData, meta
 }
 
 func resourceApiManagementOpenIDConnectProviderDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.OpenIdConnectClient
+	client := meta.(*clients.Client).ApiManagement().OpenIdConnectClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -78,7 +78,7 @@ func (ApiManagementOpenIDConnectProviderResource) Exists(ctx context.Context, cl
 	serviceName := id.Path["service"]
 	name := id.Path["openidConnectProviders"]
 
-	resp, err := clients.ApiManagement.OpenIdConnectClient.Get(ctx, resourceGroup, serviceName, name)
+	resp, err := clients.ApiManagement().OpenIdConnectClient.Get(ctx, resourceGroup, serviceName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement Open ID Connect (%s): %+v", id, err)
 	}
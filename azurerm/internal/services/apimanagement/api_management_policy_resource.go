@@ -60,7 +60,7 @@ func resourceApiManagementPolicy() *schema.Resource {
 }
 
 func resourceApiManagementPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.PolicyClient
+	client := meta.(*clients.Client).ApiManagement().PolicyClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -123,8 +123,8 @@ func resourceApiManagementPolicyCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceApiManagementPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	serviceClient := meta.(*clients.Client).ApiManagement.ServiceClient
-	client := meta.(*clients.Client).ApiManagement.PolicyClient
+	serviceClient := meta.(*clients.Client).ApiManagement().ServiceClient
+	client := meta.(*clients.Client).ApiManagement().PolicyClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -174,7 +174,7 @@ func resourceApiManagementPolicyRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceApiManagementPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.PolicyClient
+	client := meta.(*clients.Client).ApiManagement().PolicyClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
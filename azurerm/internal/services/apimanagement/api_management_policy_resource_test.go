@@ -77,7 +77,7 @@ func (ApiManagementPolicyResource) Exists(ctx context.Context, clients *clients.
 	resourceGroup := id.ResourceGroup
 	serviceName := id.ServiceName
 
-	resp, err := clients.ApiManagement.ServiceClient.Get(ctx, resourceGroup, serviceName)
+	resp, err := clients.ApiManagement().ServiceClient.Get(ctx, resourceGroup, serviceName)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement Policy (%s): %+v", id, err)
 	}
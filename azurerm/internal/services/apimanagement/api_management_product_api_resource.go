@@ -45,7 +45,7 @@ func resourceApiManagementProductApi() *schema.Resource {
 }
 
 func resourceApiManagementProductApiCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ProductApisClient
+	client := meta.(*clients.Client).ApiManagement().ProductApisClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -79,7 +79,7 @@ func resourceApiManagementProductApiCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceApiManagementProductApiRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ProductApisClient
+	client := meta.(*clients.Client).ApiManagement().ProductApisClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -120,7 +120,7 @@ func resourceApiManagementProductApiRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceApiManagementProductApiDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ProductApisClient
+	client := meta.(*clients.Client).ApiManagement().ProductApisClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
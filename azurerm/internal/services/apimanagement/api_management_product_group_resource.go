@@ -45,7 +45,7 @@ func resourceApiManagementProductGroup() *schema.Resource {
 }
 
 func resourceApiManagementProductGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ProductGroupsClient
+	client := meta.(*clients.Client).ApiManagement().ProductGroupsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -79,7 +79,7 @@ func resourceApiManagementProductGroupCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceApiManagementProductGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ProductGroupsClient
+	client := meta.(*clients.Client).ApiManagement().ProductGroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -112,7 +112,7 @@ func resourceApiManagementProductGroupRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceApiManagementProductGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ProductGroupsClient
+	client := meta.(*clients.Client).ApiManagement().ProductGroupsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -60,7 +60,7 @@ func resourceApiManagementProductPolicy() *schema.Resource {
 }
 
 func resourceApiManagementProductPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ProductPoliciesClient
+	client := meta.(*clients.Client).ApiManagement().ProductPoliciesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -121,7 +121,7 @@ func resourceApiManagementProductPolicyCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceApiManagementProductPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ProductPoliciesClient
+	client := meta.(*clients.Client).ApiManagement().ProductPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -158,7 +158,7 @@ func resourceApiManagementProductPolicyRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceApiManagementProductPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ProductPoliciesClient
+	client := meta.(*clients.Client).ApiManagement().ProductPoliciesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
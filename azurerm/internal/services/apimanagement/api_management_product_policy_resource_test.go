@@ -88,7 +88,7 @@ func (ApiManagementProductPolicyResource) Exists(ctx context.Context, clients *c
 	serviceName := id.Path["service"]
 	productID := id.Path["products"]
 
-	resp, err := clients.ApiManagement.ProductPoliciesClient.Get(ctx, resourceGroup, serviceName, productID, apimanagement.PolicyExportFormatXML)
+	resp, err := clients.ApiManagement().ProductPoliciesClient.Get(ctx, resourceGroup, serviceName, productID, apimanagement.PolicyExportFormatXML)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement Product Policy (%s): %+v", id, err)
 	}
@@ -82,7 +82,7 @@ func resourceApiManagementProduct() *schema.Resource {
 }
 
 func resourceApiManagementProductCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ProductsClient
+	client := meta.(*clients.Client).ApiManagement().ProductsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -155,7 +155,7 @@ func resourceApiManagementProductCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceApiManagementProductRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ProductsClient
+	client := meta.(*clients.Client).ApiManagement().ProductsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -197,7 +197,7 @@ func resourceApiManagementProductRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceApiManagementProductDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ProductsClient
+	client := meta.(*clients.Client).ApiManagement().ProductsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
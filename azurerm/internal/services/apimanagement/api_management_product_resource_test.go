@@ -165,7 +165,7 @@ func (ApiManagementProductResource) Exists(ctx context.Context, clients *clients
 	serviceName := id.Path["service"]
 	productId := id.Path["products"]
 
-	resp, err := clients.ApiManagement.ProductsClient.Get(ctx, resourceGroup, serviceName, productId)
+	resp, err := clients.ApiManagement().ProductsClient.Get(ctx, resourceGroup, serviceName, productId)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement Product (%s): %+v", id, err)
 	}
@@ -85,7 +85,7 @@ func resourceApiManagementProperty() *schema.Resource {
 }
 
 func resourceApiManagementPropertyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.NamedValueClient
+	client := meta.(*clients.Client).ApiManagement().NamedValueClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -142,7 +142,7 @@ func resourceApiManagementPropertyCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceApiManagementPropertyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.NamedValueClient
+	client := meta.(*clients.Client).ApiManagement().NamedValueClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -182,7 +182,7 @@ func resourceApiManagementPropertyRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceApiManagementPropertyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.NamedValueClient
+	client := meta.(*clients.Client).ApiManagement().NamedValueClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -76,7 +76,7 @@ func (ApiManagementPropertyResource) Exists(ctx context.Context, clients *client
 	serviceName := id.Path["service"]
 	name := id.Path["namedValues"]
 
-	resp, err := clients.ApiManagement.NamedValueClient.Get(ctx, resourceGroup, serviceName, name)
+	resp, err := clients.ApiManagement().NamedValueClient.Get(ctx, resourceGroup, serviceName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement Property (%s): %+v", id, err)
 	}
@@ -577,7 +577,7 @@ func resourceApiManagementService() *schema.Resource {
 }
 
 func resourceApiManagementServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ServiceClient
+	client := meta.(*clients.Client).ApiManagement().ServiceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -689,7 +689,7 @@ func resourceApiManagementServiceCreateUpdate(d *schema.ResourceData, meta inter
 	}
 	if sku.Name != apimanagement.SkuTypeConsumption {
 		signInSettings := expandApiManagementSignInSettings(signInSettingsRaw)
-		signInClient := meta.(*clients.Client).ApiManagement.SignInClient
+		signInClient := meta.(*clients.Client).ApiManagement().SignInClient
 		if _, err := signInClient.CreateOrUpdate(ctx, resourceGroup, name, signInSettings, ""); err != nil {
 			return fmt.Errorf(" setting Sign In settings for API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
@@ -701,13 +701,13 @@ func resourceApiManagementServiceCreateUpdate(d *schema.ResourceData, meta inter
 	}
 	if sku.Name != apimanagement.SkuTypeConsumption {
 		signUpSettings := expandApiManagementSignUpSettings(signUpSettingsRaw)
-		signUpClient := meta.(*clients.Client).ApiManagement.SignUpClient
+		signUpClient := meta.(*clients.Client).ApiManagement().SignUpClient
 		if _, err := signUpClient.CreateOrUpdate(ctx, resourceGroup, name, signUpSettings, ""); err != nil {
 			return fmt.Errorf(" setting Sign Up settings for API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
 	}
 
-	policyClient := meta.(*clients.Client).ApiManagement.PolicyClient
+	policyClient := meta.(*clients.Client).ApiManagement().PolicyClient
 	policiesRaw := d.Get("policy").([]interface{})
 	policy, err := expandApiManagementPolicies(policiesRaw)
 	if err != nil {
@@ -733,7 +733,7 @@ func resourceApiManagementServiceCreateUpdate(d *schema.ResourceData, meta inter
 	if d.HasChange("tenant_access") {
 		tenantAccessInformationParametersRaw := d.Get("tenant_access").([]interface{})
 		tenantAccessInformationParameters := expandApiManagementTenantAccessSettings(tenantAccessInformationParametersRaw)
-		tenantAccessClient := meta.(*clients.Client).ApiManagement.TenantAccessClient
+		tenantAccessClient := meta.(*clients.Client).ApiManagement().TenantAccessClient
 		if _, err := tenantAccessClient.Update(ctx, resourceGroup, name, tenantAccessInformationParameters, ""); err != nil {
 			return fmt.Errorf(" updating tenant access settings for API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
@@ -743,10 +743,10 @@ func resourceApiManagementServiceCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceApiManagementServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ServiceClient
-	signInClient := meta.(*clients.Client).ApiManagement.SignInClient
-	signUpClient := meta.(*clients.Client).ApiManagement.SignUpClient
-	tenantAccessClient := meta.(*clients.Client).ApiManagement.TenantAccessClient
+	client := meta.(*clients.Client).ApiManagement().ServiceClient
+	signInClient := meta.(*clients.Client).ApiManagement().SignInClient
+	signUpClient := meta.(*clients.Client).ApiManagement().SignUpClient
+	tenantAccessClient := meta.(*clients.Client).ApiManagement().TenantAccessClient
 	environment := meta.(*clients.Client).Account.Environment
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -770,7 +770,7 @@ func resourceApiManagementServiceRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("making Read request on API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	policyClient := meta.(*clients.Client).ApiManagement.PolicyClient
+	policyClient := meta.(*clients.Client).ApiManagement().PolicyClient
 	policy, err := policyClient.Get(ctx, resourceGroup, name, apimanagement.PolicyExportFormatXML)
 	if err != nil {
 		if !utils.ResponseWasNotFound(policy.Response) {
@@ -874,7 +874,7 @@ func resourceApiManagementServiceRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceApiManagementServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ServiceClient
+	client := meta.(*clients.Client).ApiManagement().ServiceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
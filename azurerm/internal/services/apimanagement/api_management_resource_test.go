@@ -301,7 +301,7 @@ func (ApiManagementResource) Exists(ctx context.Context, clients *clients.Client
 	resourceGroup := id.ResourceGroup
 	name := id.ServiceName
 
-	resp, err := clients.ApiManagement.ServiceClient.Get(ctx, resourceGroup, name)
+	resp, err := clients.ApiManagement().ServiceClient.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement (%s): %+v", id, err)
 	}
@@ -106,7 +106,7 @@ func resourceApiManagementSubscription() *schema.Resource {
 }
 
 func resourceApiManagementSubscriptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.SubscriptionsClient
+	client := meta.(*clients.Client).ApiManagement().SubscriptionsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -171,7 +171,7 @@ func resourceApiManagementSubscriptionCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceApiManagementSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.SubscriptionsClient
+	client := meta.(*clients.Client).ApiManagement().SubscriptionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -223,7 +223,7 @@ func resourceApiManagementSubscriptionRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceApiManagementSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.SubscriptionsClient
+	client := meta.(*clients.Client).ApiManagement().SubscriptionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -128,7 +128,7 @@ func (ApiManagementSubscriptionResource) Exists(ctx context.Context, clients *cl
 		return nil, err
 	}
 
-	resp, err := clients.ApiManagement.SubscriptionsClient.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	resp, err := clients.ApiManagement().SubscriptionsClient.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement Subscription (%s): %+v", id, err)
 	}
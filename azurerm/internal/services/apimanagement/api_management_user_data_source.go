@@ -56,7 +56,7 @@ func dataSourceApiManagementUser() *schema.Resource {
 }
 
 func dataSourceApiManagementUserRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.UsersClient
+	client := meta.(*clients.Client).ApiManagement().UsersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
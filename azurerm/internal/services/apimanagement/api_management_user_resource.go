@@ -96,7 +96,7 @@ func resourceApiManagementUser() *schema.Resource {
 }
 
 func resourceApiManagementUserCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.UsersClient
+	client := meta.(*clients.Client).ApiManagement().UsersClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -168,7 +168,7 @@ func resourceApiManagementUserCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceApiManagementUserRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.UsersClient
+	client := meta.(*clients.Client).ApiManagement().UsersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -208,7 +208,7 @@ func resourceApiManagementUserRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceApiManagementUserDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.UsersClient
+	client := meta.(*clients.Client).ApiManagement().UsersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
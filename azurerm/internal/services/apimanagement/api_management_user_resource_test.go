@@ -187,7 +187,7 @@ func (ApiManagementUserResource) Exists(ctx context.Context, clients *clients.Cl
 	serviceName := id.Path["service"]
 	userId := id.Path["users"]
 
-	resp, err := clients.ApiManagement.UsersClient.Get(ctx, resourceGroup, serviceName, userId)
+	resp, err := clients.ApiManagement().UsersClient.Get(ctx, resourceGroup, serviceName, userId)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement User (%s): %+v", id, err)
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiId struct {
@@ -73,3 +74,12 @@ func ApiID(input string) (*ApiId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiIDNormalized parses a Api ID into an ApiId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ApiID method should be used instead for validation etc.
+func ApiIDNormalized(input string) (*ApiId, error) {
+	return ApiID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apis/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiDiagnosticId struct {
@@ -79,3 +80,12 @@ func ApiDiagnosticID(input string) (*ApiDiagnosticId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiDiagnosticIDNormalized parses a ApiDiagnostic ID into an ApiDiagnosticId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ApiDiagnosticID method should be used instead for validation etc.
+func ApiDiagnosticIDNormalized(input string) (*ApiDiagnosticId, error) {
+	return ApiDiagnosticID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apis/%s/diagnostics/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiManagementId struct {
@@ -67,3 +68,12 @@ func ApiManagementID(input string) (*ApiManagementId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiManagementIDNormalized parses a ApiManagement ID into an ApiManagementId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ApiManagementID method should be used instead for validation etc.
+func ApiManagementIDNormalized(input string) (*ApiManagementId, error) {
+	return ApiManagementID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiOperationId struct {
@@ -79,3 +80,12 @@ func ApiOperationID(input string) (*ApiOperationId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiOperationIDNormalized parses a ApiOperation ID into an ApiOperationId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ApiOperationID method should be used instead for validation etc.
+func ApiOperationIDNormalized(input string) (*ApiOperationId, error) {
+	return ApiOperationID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apis/%s/operations/%s"))
+}
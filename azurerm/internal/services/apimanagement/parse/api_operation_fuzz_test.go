@@ -0,0 +1,41 @@
+//go:build go1.18
+// +build go1.18
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import "testing"
+
+func FuzzApiOperationID(f *testing.F) {
+	f.Add("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1")
+	f.Add("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1/")
+	f.Add("/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/service1/APIS/api1/OPERATIONS/operation1")
+	f.Add("/")
+	f.Add("/subscriptions/")
+	f.Add("/subscriptions/12345678-1234-9876-4563-123456789012/")
+	f.Add("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/")
+	f.Add("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/")
+	f.Add("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/")
+	f.Add("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/")
+	f.Add("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/")
+	f.Add("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/")
+	f.Add("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/")
+
+	f.Fuzz(func(t *testing.T, input string) {
+		id, err := ApiOperationIDNormalized(input)
+		if err != nil {
+			return
+		}
+
+		// any ID which can be parsed should be formatted into an ID which the strict parser accepts,
+		// and which is unchanged when it's parsed again
+		actual, err := ApiOperationID(id.ID())
+		if err != nil {
+			t.Fatalf("parsing the formatted ID %q: %+v", id.ID(), err)
+		}
+		if *actual != *id {
+			t.Fatalf("Expected %+v but got %+v", *id, *actual)
+		}
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiOperationPolicyId struct {
@@ -85,3 +86,12 @@ func ApiOperationPolicyID(input string) (*ApiOperationPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiOperationPolicyIDNormalized parses a ApiOperationPolicy ID into an ApiOperationPolicyId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ApiOperationPolicyID method should be used instead for validation etc.
+func ApiOperationPolicyIDNormalized(input string) (*ApiOperationPolicyId, error) {
+	return ApiOperationPolicyID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apis/%s/operations/%s/policies/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiPolicyId struct {
@@ -79,3 +80,12 @@ func ApiPolicyID(input string) (*ApiPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiPolicyIDNormalized parses a ApiPolicy ID into an ApiPolicyId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ApiPolicyID method should be used instead for validation etc.
+func ApiPolicyIDNormalized(input string) (*ApiPolicyId, error) {
+	return ApiPolicyID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apis/%s/policies/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiSchemaId struct {
@@ -79,3 +80,12 @@ func ApiSchemaID(input string) (*ApiSchemaId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiSchemaIDNormalized parses a ApiSchema ID into an ApiSchemaId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ApiSchemaID method should be used instead for validation etc.
+func ApiSchemaIDNormalized(input string) (*ApiSchemaId, error) {
+	return ApiSchemaID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apis/%s/schemas/%s"))
+}
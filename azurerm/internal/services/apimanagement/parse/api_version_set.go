@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiVersionSetId struct {
@@ -73,3 +74,12 @@ func ApiVersionSetID(input string) (*ApiVersionSetId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiVersionSetIDNormalized parses a ApiVersionSet ID into an ApiVersionSetId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ApiVersionSetID method should be used instead for validation etc.
+func ApiVersionSetIDNormalized(input string) (*ApiVersionSetId, error) {
+	return ApiVersionSetID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apiVersionSets/%s"))
+}
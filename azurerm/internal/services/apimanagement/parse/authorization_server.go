@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AuthorizationServerId struct {
@@ -73,3 +74,12 @@ func AuthorizationServerID(input string) (*AuthorizationServerId, error) {
 
 	return &resourceId, nil
 }
+
+// AuthorizationServerIDNormalized parses a AuthorizationServer ID into an AuthorizationServerId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the AuthorizationServerID method should be used instead for validation etc.
+func AuthorizationServerIDNormalized(input string) (*AuthorizationServerId, error) {
+	return AuthorizationServerID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/authorizationServers/%s"))
+}
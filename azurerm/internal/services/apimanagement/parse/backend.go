@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BackendId struct {
@@ -73,3 +74,12 @@ func BackendID(input string) (*BackendId, error) {
 
 	return &resourceId, nil
 }
+
+// BackendIDNormalized parses a Backend ID into an BackendId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the BackendID method should be used instead for validation etc.
+func BackendIDNormalized(input string) (*BackendId, error) {
+	return BackendID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/backends/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DiagnosticId struct {
@@ -73,3 +74,12 @@ func DiagnosticID(input string) (*DiagnosticId, error) {
 
 	return &resourceId, nil
 }
+
+// DiagnosticIDNormalized parses a Diagnostic ID into an DiagnosticId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DiagnosticID method should be used instead for validation etc.
+func DiagnosticIDNormalized(input string) (*DiagnosticId, error) {
+	return DiagnosticID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/diagnostics/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type GroupId struct {
@@ -73,3 +74,12 @@ func GroupID(input string) (*GroupId, error) {
 
 	return &resourceId, nil
 }
+
+// GroupIDNormalized parses a Group ID into an GroupId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the GroupID method should be used instead for validation etc.
+func GroupIDNormalized(input string) (*GroupId, error) {
+	return GroupID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/groups/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type GroupUserId struct {
@@ -79,3 +80,12 @@ func GroupUserID(input string) (*GroupUserId, error) {
 
 	return &resourceId, nil
 }
+
+// GroupUserIDNormalized parses a GroupUser ID into an GroupUserId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the GroupUserID method should be used instead for validation etc.
+func GroupUserIDNormalized(input string) (*GroupUserId, error) {
+	return GroupUserID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/groups/%s/users/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type IdentityProviderId struct {
@@ -73,3 +74,12 @@ func IdentityProviderID(input string) (*IdentityProviderId, error) {
 
 	return &resourceId, nil
 }
+
+// IdentityProviderIDNormalized parses a IdentityProvider ID into an IdentityProviderId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the IdentityProviderID method should be used instead for validation etc.
+func IdentityProviderIDNormalized(input string) (*IdentityProviderId, error) {
+	return IdentityProviderID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/identityProviders/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoggerId struct {
@@ -73,3 +74,12 @@ func LoggerID(input string) (*LoggerId, error) {
 
 	return &resourceId, nil
 }
+
+// LoggerIDNormalized parses a Logger ID into an LoggerId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LoggerID method should be used instead for validation etc.
+func LoggerIDNormalized(input string) (*LoggerId, error) {
+	return LoggerID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/loggers/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NamedValueId struct {
@@ -73,3 +74,12 @@ func NamedValueID(input string) (*NamedValueId, error) {
 
 	return &resourceId, nil
 }
+
+// NamedValueIDNormalized parses a NamedValue ID into an NamedValueId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the NamedValueID method should be used instead for validation etc.
+func NamedValueIDNormalized(input string) (*NamedValueId, error) {
+	return NamedValueID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/namedValues/%s"))
+}
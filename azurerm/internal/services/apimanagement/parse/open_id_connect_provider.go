@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type OpenIDConnectProviderId struct {
@@ -73,3 +74,12 @@ func OpenIDConnectProviderID(input string) (*OpenIDConnectProviderId, error) {
 
 	return &resourceId, nil
 }
+
+// OpenIDConnectProviderIDNormalized parses a OpenIDConnectProvider ID into an OpenIDConnectProviderId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the OpenIDConnectProviderID method should be used instead for validation etc.
+func OpenIDConnectProviderIDNormalized(input string) (*OpenIDConnectProviderId, error) {
+	return OpenIDConnectProviderID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/openidConnectProviders/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PolicyId struct {
@@ -73,3 +74,12 @@ func PolicyID(input string) (*PolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// PolicyIDNormalized parses a Policy ID into an PolicyId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the PolicyID method should be used instead for validation etc.
+func PolicyIDNormalized(input string) (*PolicyId, error) {
+	return PolicyID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/policies/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProductId struct {
@@ -73,3 +74,12 @@ func ProductID(input string) (*ProductId, error) {
 
 	return &resourceId, nil
 }
+
+// ProductIDNormalized parses a Product ID into an ProductId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ProductID method should be used instead for validation etc.
+func ProductIDNormalized(input string) (*ProductId, error) {
+	return ProductID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/products/%s"))
+}
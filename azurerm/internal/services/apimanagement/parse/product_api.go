@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProductApiId struct {
@@ -79,3 +80,12 @@ func ProductApiID(input string) (*ProductApiId, error) {
 
 	return &resourceId, nil
 }
+
+// ProductApiIDNormalized parses a ProductApi ID into an ProductApiId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ProductApiID method should be used instead for validation etc.
+func ProductApiIDNormalized(input string) (*ProductApiId, error) {
+	return ProductApiID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/products/%s/apis/%s"))
+}
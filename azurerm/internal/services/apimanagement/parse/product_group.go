@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProductGroupId struct {
@@ -79,3 +80,12 @@ func ProductGroupID(input string) (*ProductGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// ProductGroupIDNormalized parses a ProductGroup ID into an ProductGroupId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ProductGroupID method should be used instead for validation etc.
+func ProductGroupIDNormalized(input string) (*ProductGroupId, error) {
+	return ProductGroupID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/products/%s/groups/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProductPolicyId struct {
@@ -79,3 +80,12 @@ func ProductPolicyID(input string) (*ProductPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// ProductPolicyIDNormalized parses a ProductPolicy ID into an ProductPolicyId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ProductPolicyID method should be used instead for validation etc.
+func ProductPolicyIDNormalized(input string) (*ProductPolicyId, error) {
+	return ProductPolicyID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/products/%s/policies/%s"))
+}
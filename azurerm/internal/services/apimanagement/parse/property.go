@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PropertyId struct {
@@ -73,3 +74,12 @@ func PropertyID(input string) (*PropertyId, error) {
 
 	return &resourceId, nil
 }
+
+// PropertyIDNormalized parses a Property ID into an PropertyId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the PropertyID method should be used instead for validation etc.
+func PropertyIDNormalized(input string) (*PropertyId, error) {
+	return PropertyID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/namedValues/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type UserId struct {
@@ -73,3 +74,12 @@ func UserID(input string) (*UserId, error) {
 
 	return &resourceId, nil
 }
+
+// UserIDNormalized parses a User ID into an UserId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the UserID method should be used instead for validation etc.
+func UserIDNormalized(input string) (*UserId, error) {
+	return UserID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/users/%s"))
+}
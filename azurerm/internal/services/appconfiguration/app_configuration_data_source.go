@@ -145,7 +145,7 @@ func dataSourceAppConfiguration() *schema.Resource {
 }
 
 func dataSourceAppConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppConfiguration.AppConfigurationsClient
+	client := meta.(*clients.Client).AppConfiguration().AppConfigurationsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -196,7 +196,7 @@ func resourceAppConfiguration() *schema.Resource {
 }
 
 func resourceAppConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppConfiguration.AppConfigurationsClient
+	client := meta.(*clients.Client).AppConfiguration().AppConfigurationsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -240,7 +240,7 @@ func resourceAppConfigurationCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAppConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppConfiguration.AppConfigurationsClient
+	client := meta.(*clients.Client).AppConfiguration().AppConfigurationsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -284,7 +284,7 @@ func resourceAppConfigurationUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAppConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppConfiguration.AppConfigurationsClient
+	client := meta.(*clients.Client).AppConfiguration().AppConfigurationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -338,7 +338,7 @@ func resourceAppConfigurationRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAppConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppConfiguration.AppConfigurationsClient
+	client := meta.(*clients.Client).AppConfiguration().AppConfigurationsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ConfigurationStoreId struct {
@@ -67,3 +68,12 @@ func ConfigurationStoreID(input string) (*ConfigurationStoreId, error) {
 
 	return &resourceId, nil
 }
+
+// ConfigurationStoreIDNormalized parses a ConfigurationStore ID into an ConfigurationStoreId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ConfigurationStoreID method should be used instead for validation etc.
+func ConfigurationStoreIDNormalized(input string) (*ConfigurationStoreId, error) {
+	return ConfigurationStoreID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AppConfiguration/configurationStores/%s"))
+}
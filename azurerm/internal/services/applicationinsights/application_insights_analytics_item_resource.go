@@ -103,7 +103,7 @@ func resourceApplicationInsightsAnalyticsItemUpdate(d *schema.ResourceData, meta
 }
 
 func resourceApplicationInsightsAnalyticsItemCreateUpdate(d *schema.ResourceData, meta interface{}, overwrite bool) error {
-	client := meta.(*clients.Client).AppInsights.AnalyticsItemsClient
+	client := meta.(*clients.Client).AppInsights().AnalyticsItemsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -165,7 +165,7 @@ func resourceApplicationInsightsAnalyticsItemCreateUpdate(d *schema.ResourceData
 }
 
 func resourceApplicationInsightsAnalyticsItemRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppInsights.AnalyticsItemsClient
+	client := meta.(*clients.Client).AppInsights().AnalyticsItemsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -199,7 +199,7 @@ func resourceApplicationInsightsAnalyticsItemRead(d *schema.ResourceData, meta i
 }
 
 func resourceApplicationInsightsAnalyticsItemDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppInsights.AnalyticsItemsClient
+	client := meta.(*clients.Client).AppInsights().AnalyticsItemsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
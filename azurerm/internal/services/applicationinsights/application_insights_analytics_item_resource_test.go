@@ -107,7 +107,7 @@ func (t AppInsightsAnalyticsItemResource) Exists(ctx context.Context, clients *c
 		return nil, fmt.Errorf("Failed to parse ID (id: %s): %+v", state.ID, err)
 	}
 
-	resp, err := clients.AppInsights.AnalyticsItemsClient.Get(ctx, resGroup, appInsightsName, itemScopePath, itemID, "")
+	resp, err := clients.AppInsights().AnalyticsItemsClient.Get(ctx, resGroup, appInsightsName, itemScopePath, itemID, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Application Insights AnalyticsItem %q (resource group: %q, app insight: %s, item scope: %s): %+v", resGroup, appInsightsName, itemScopePath, itemID, err)
 	}
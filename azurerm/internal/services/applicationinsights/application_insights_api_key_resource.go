@@ -78,7 +78,7 @@ func resourceApplicationInsightsAPIKey() *schema.Resource {
 }
 
 func resourceApplicationInsightsAPIKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppInsights.APIKeysClient
+	client := meta.(*clients.Client).AppInsights().APIKeysClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -153,7 +153,7 @@ func resourceApplicationInsightsAPIKeyCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceApplicationInsightsAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppInsights.APIKeysClient
+	client := meta.(*clients.Client).AppInsights().APIKeysClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -194,7 +194,7 @@ func resourceApplicationInsightsAPIKeyRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceApplicationInsightsAPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppInsights.APIKeysClient
+	client := meta.(*clients.Client).AppInsights().APIKeysClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
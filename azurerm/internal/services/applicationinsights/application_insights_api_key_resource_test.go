@@ -128,7 +128,7 @@ func (t AppInsightsAPIKey) Exists(ctx context.Context, clients *clients.Client,
 	resGroup := id.ResourceGroup
 	appInsightsName := id.Path["components"]
 
-	resp, err := clients.AppInsights.APIKeysClient.Get(ctx, resGroup, appInsightsName, keyID)
+	resp, err := clients.AppInsights().APIKeysClient.Get(ctx, resGroup, appInsightsName, keyID)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Application Insights API Key '%q' (resource group: '%q') does not exist", keyID, resGroup)
 	}
@@ -75,7 +75,7 @@ func dataSourceApplicationInsights() *schema.Resource {
 }
 
 func dataSourceArmApplicationInsightsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppInsights.ComponentsClient
+	client := meta.(*clients.Client).AppInsights().ComponentsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
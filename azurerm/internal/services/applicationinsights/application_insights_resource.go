@@ -130,8 +130,8 @@ func resourceApplicationInsights() *schema.Resource {
 }
 
 func resourceApplicationInsightsCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppInsights.ComponentsClient
-	billingClient := meta.(*clients.Client).AppInsights.BillingClient
+	client := meta.(*clients.Client).AppInsights().ComponentsClient
+	billingClient := meta.(*clients.Client).AppInsights().BillingClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -221,8 +221,8 @@ func resourceApplicationInsightsCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceApplicationInsightsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppInsights.ComponentsClient
-	billingClient := meta.(*clients.Client).AppInsights.BillingClient
+	client := meta.(*clients.Client).AppInsights().ComponentsClient
+	billingClient := meta.(*clients.Client).AppInsights().BillingClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -274,7 +274,7 @@ func resourceApplicationInsightsRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceApplicationInsightsDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppInsights.ComponentsClient
+	client := meta.(*clients.Client).AppInsights().ComponentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -73,7 +73,7 @@ func resourceApplicationInsightsSmartDetectionRule() *schema.Resource {
 }
 
 func resourceApplicationInsightsSmartDetectionRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppInsights.SmartDetectionRuleClient
+	client := meta.(*clients.Client).AppInsights().SmartDetectionRuleClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -107,7 +107,7 @@ func resourceApplicationInsightsSmartDetectionRuleUpdate(d *schema.ResourceData,
 }
 
 func resourceApplicationInsightsSmartDetectionRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppInsights.SmartDetectionRuleClient
+	client := meta.(*clients.Client).AppInsights().SmartDetectionRuleClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -137,7 +137,7 @@ func resourceApplicationInsightsSmartDetectionRuleRead(d *schema.ResourceData, m
 }
 
 func resourceApplicationInsightsSmartDetectionRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppInsights.SmartDetectionRuleClient
+	client := meta.(*clients.Client).AppInsights().SmartDetectionRuleClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -94,7 +94,7 @@ func (t AppInsightsSmartDetectionRule) Exists(ctx context.Context, clients *clie
 		return nil, err
 	}
 
-	resp, err := clients.AppInsights.SmartDetectionRuleClient.Get(ctx, id.ResourceGroup, id.ComponentName, id.SmartDetectionRuleName)
+	resp, err := clients.AppInsights().SmartDetectionRuleClient.Get(ctx, id.ResourceGroup, id.ComponentName, id.SmartDetectionRuleName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Application Insights Smart Detection Rule '%q' does not exist", id.String())
 	}
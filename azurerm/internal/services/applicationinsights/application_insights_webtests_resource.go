@@ -130,7 +130,7 @@ func resourceApplicationInsightsWebTests() *schema.Resource {
 }
 
 func resourceApplicationInsightsWebTestsCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppInsights.WebTestsClient
+	client := meta.(*clients.Client).AppInsights().WebTestsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -203,7 +203,7 @@ func resourceApplicationInsightsWebTestsCreateUpdate(d *schema.ResourceData, met
 }
 
 func resourceApplicationInsightsWebTestsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppInsights.WebTestsClient
+	client := meta.(*clients.Client).AppInsights().WebTestsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -264,7 +264,7 @@ func resourceApplicationInsightsWebTestsRead(d *schema.ResourceData, meta interf
 }
 
 func resourceApplicationInsightsWebTestsDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppInsights.WebTestsClient
+	client := meta.(*clients.Client).AppInsights().WebTestsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
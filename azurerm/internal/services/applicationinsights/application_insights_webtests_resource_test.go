@@ -103,7 +103,7 @@ func (t AppInsightsWebTestsResource) Exists(ctx context.Context, clients *client
 		return nil, err
 	}
 
-	resp, err := clients.AppInsights.WebTestsClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.AppInsights().WebTestsClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Application Insights '%q' (resource group: '%q') does not exist", id.ResourceGroup, id.Name)
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ComponentId struct {
@@ -67,3 +68,12 @@ func ComponentID(input string) (*ComponentId, error) {
 
 	return &resourceId, nil
 }
+
+// ComponentIDNormalized parses a Component ID into an ComponentId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ComponentID method should be used instead for validation etc.
+func ComponentIDNormalized(input string) (*ComponentId, error) {
+	return ComponentID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/microsoft.insights/components/%s"))
+}
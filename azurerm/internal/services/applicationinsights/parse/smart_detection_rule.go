@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SmartDetectionRuleId struct {
@@ -73,3 +74,12 @@ func SmartDetectionRuleID(input string) (*SmartDetectionRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// SmartDetectionRuleIDNormalized parses a SmartDetectionRule ID into an SmartDetectionRuleId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SmartDetectionRuleID method should be used instead for validation etc.
+func SmartDetectionRuleIDNormalized(input string) (*SmartDetectionRuleId, error) {
+	return SmartDetectionRuleID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/microsoft.insights/components/%s/SmartDetectionRule/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type WebTestId struct {
@@ -67,3 +68,12 @@ func WebTestID(input string) (*WebTestId, error) {
 
 	return &resourceId, nil
 }
+
+// WebTestIDNormalized parses a WebTest ID into an WebTestId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the WebTestID method should be used instead for validation etc.
+func WebTestIDNormalized(input string) (*WebTestId, error) {
+	return WebTestID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/microsoft.insights/webtests/%s"))
+}
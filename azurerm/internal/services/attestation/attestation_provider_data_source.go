@@ -48,7 +48,7 @@ func dataSourceAttestationProvider() *schema.Resource {
 }
 
 func dataSourceArmAttestationProviderRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Attestation.ProviderClient
+	client := meta.(*clients.Client).Attestation().ProviderClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -75,7 +75,7 @@ func resourceAttestationProvider() *schema.Resource {
 }
 
 func resourceAttestationProviderCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Attestation.ProviderClient
+	client := meta.(*clients.Client).Attestation().ProviderClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -126,7 +126,7 @@ func resourceAttestationProviderCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAttestationProviderRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Attestation.ProviderClient
+	client := meta.(*clients.Client).Attestation().ProviderClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -158,7 +158,7 @@ func resourceAttestationProviderRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAttestationProviderUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Attestation.ProviderClient
+	client := meta.(*clients.Client).Attestation().ProviderClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -168,7 +168,7 @@ func resourceAttestationProviderUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	updateParams := attestation.ServicePatchParams{}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateParams.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -179,7 +179,7 @@ func resourceAttestationProviderUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAttestationProviderDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Attestation.ProviderClient
+	client := meta.(*clients.Client).Attestation().ProviderClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -134,7 +134,7 @@ func (t AttestationProviderResource) Exists(ctx context.Context, clients *client
 		return nil, err
 	}
 
-	resp, err := clients.Attestation.ProviderClient.Get(ctx, id.ResourceGroup, id.AttestationProviderName)
+	resp, err := clients.Attestation().ProviderClient.Get(ctx, id.ResourceGroup, id.AttestationProviderName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Attestation Provider %q (resource group: %q): %+v", id.AttestationProviderName, id.ResourceGroup, err)
 	}
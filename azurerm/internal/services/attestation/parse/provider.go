@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProviderId struct {
@@ -67,3 +68,12 @@ func ProviderID(input string) (*ProviderId, error) {
 
 	return &resourceId, nil
 }
+
+// ProviderIDNormalized parses a Provider ID into an ProviderId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ProviderID method should be used instead for validation etc.
+func ProviderIDNormalized(input string) (*ProviderId, error) {
+	return ProviderID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Attestation/attestationProviders/%s"))
+}
@@ -47,7 +47,7 @@ func dataSourceArmClientConfigRead(d *schema.ResourceData, meta interface{}) err
 	defer cancel()
 
 	if client.Account.AuthenticatedAsAServicePrincipal {
-		spClient := client.Authorization.ServicePrincipalsClient
+		spClient := client.Authorization().ServicePrincipalsClient
 		// Application & Service Principal is 1:1 per tenant. Since we know the appId (client_id)
 		// here, we can query for the Service Principal whose appId matches.
 		filter := fmt.Sprintf("appId eq '%s'", client.Account.ClientId)
@@ -0,0 +1,39 @@
+//go:build go1.18
+// +build go1.18
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import "testing"
+
+func FuzzRoleAssignmentID(f *testing.F) {
+	f.Add("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121")
+	f.Add("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121/")
+	f.Add("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/PROVIDERS/MICROSOFT.AUTHORIZATION/ROLEASSIGNMENTS/23456781-2349-8764-5631-234567890121")
+	f.Add("/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121")
+	f.Add("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121")
+	f.Add("/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121")
+	f.Add("/providers/Microsoft.Capacity/reservationOrders/order1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121")
+	f.Add("/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121")
+	f.Add("/subscriptions/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121")
+	f.Add("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/")
+	f.Add("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/roleAssignments/")
+
+	f.Fuzz(func(t *testing.T, input string) {
+		id, err := RoleAssignmentIDNormalized(input)
+		if err != nil {
+			return
+		}
+
+		// any ID which can be parsed should be formatted into an ID which the strict parser accepts,
+		// and which is unchanged when it's parsed again
+		actual, err := RoleAssignmentID(id.ID())
+		if err != nil {
+			t.Fatalf("parsing the formatted ID %q: %+v", id.ID(), err)
+		}
+		if *actual != *id {
+			t.Fatalf("Expected %+v but got %+v", *id, *actual)
+		}
+	})
+}
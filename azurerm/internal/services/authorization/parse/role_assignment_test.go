@@ -1,5 +1,7 @@
 package parse
 
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
 import (
 	"testing"
 
@@ -9,74 +11,91 @@ import (
 var _ resourceid.Formatter = RoleAssignmentId{}
 
 func TestRoleAssignmentIDFormatter(t *testing.T) {
+	actual := NewRoleAssignmentID(resourceid.ResourceGroupScope{SubscriptionId: "12345678-1234-9876-4563-123456789012", ResourceGroup: "resGroup1"}, "23456781-2349-8764-5631-234567890121").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestRoleAssignmentID(t *testing.T) {
 	testData := []struct {
-		SubscriptionId  string
-		ResourceGroup   string
-		ManagementGroup string
-		Name            string
-		Expected        string
+		Input    string
+		Error    bool
+		Expected *RoleAssignmentId
 	}{
+
 		{
-			SubscriptionId:  "",
-			ResourceGroup:   "",
-			ManagementGroup: "",
-			Name:            "23456781-2349-8764-5631-234567890121",
+			// empty
+			Input: "",
+			Error: true,
 		},
+
 		{
-			SubscriptionId:  "12345678-1234-9876-4563-123456789012",
-			ResourceGroup:   "group1",
-			ManagementGroup: "managementGroup1",
-			Name:            "23456781-2349-8764-5631-234567890121",
+			// missing invalid value for Scope
+			Input: "/subscriptions/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+			Error: true,
 		},
+
 		{
-			SubscriptionId:  "12345678-1234-9876-4563-123456789012",
-			ResourceGroup:   "",
-			ManagementGroup: "managementGroup1",
-			Name:            "23456781-2349-8764-5631-234567890121",
+			// missing Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/",
+			Error: true,
 		},
+
 		{
-			SubscriptionId:  "12345678-1234-9876-4563-123456789012",
-			ResourceGroup:   "",
-			ManagementGroup: "",
-			Name:            "23456781-2349-8764-5631-234567890121",
-			Expected:        "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+			// missing value for Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/roleAssignments/",
+			Error: true,
 		},
+
 		{
-			SubscriptionId:  "12345678-1234-9876-4563-123456789012",
-			ResourceGroup:   "group1",
-			ManagementGroup: "",
-			Name:            "23456781-2349-8764-5631-234567890121",
-			Expected:        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+			Expected: &RoleAssignmentId{
+				Scope: resourceid.ResourceGroupScope{SubscriptionId: "12345678-1234-9876-4563-123456789012", ResourceGroup: "resGroup1"},
+				Name:  "23456781-2349-8764-5631-234567890121",
+			},
 		},
+
 		{
-			SubscriptionId:  "",
-			ResourceGroup:   "",
-			ManagementGroup: "12345678-1234-9876-4563-123456789012",
-			Name:            "23456781-2349-8764-5631-234567890121",
-			Expected:        "/providers/Microsoft.Management/managementGroups/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.AUTHORIZATION/ROLEASSIGNMENTS/23456781-2349-8764-5631-234567890121",
+			Error: true,
 		},
 	}
+
 	for _, v := range testData {
-		t.Logf("testing %+v", v)
-		actual, err := NewRoleAssignmentID(v.SubscriptionId, v.ResourceGroup, v.ManagementGroup, v.Name)
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := RoleAssignmentID(v.Input)
 		if err != nil {
-			if v.Expected == "" {
+			if v.Error {
 				continue
 			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
 		}
-		actualId := actual.ID()
-		if actualId != v.Expected {
-			t.Fatalf("expected %q, got %q", v.Expected, actualId)
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
 		}
 	}
 }
 
-func TestRoleAssignmentID(t *testing.T) {
+func TestRoleAssignmentIDNormalized(t *testing.T) {
 	testData := []struct {
 		Input    string
 		Error    bool
 		Expected *RoleAssignmentId
 	}{
+
 		{
 			// empty
 			Input: "",
@@ -84,71 +103,56 @@ func TestRoleAssignmentID(t *testing.T) {
 		},
 
 		{
-			// missing SubscriptionId
-			Input: "/",
-			Error: true,
-		},
-
-		{
-			// just subscription
-			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
-			Error: true,
-		},
-
-		{
-			// missing ResourceGroup value
-			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			// missing invalid value for Scope
+			Input: "/subscriptions/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
 			Error: true,
 		},
 
 		{
-			// missing Management Group value
-			Input: "/providers/Microsoft.Management/managementGroups/",
+			// missing Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/",
 			Error: true,
 		},
 
 		{
-			// missing Role Assignment value at Subscription Scope
-			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/",
+			// missing value for Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/roleAssignments/",
 			Error: true,
 		},
 
 		{
-			// missing Role Assignment value at Management Group scope
-			Input: "/providers/Microsoft.Management/managementGroups/managementGroup1/providers/Microsoft.Authorization/roleAssignments/",
-			Error: true,
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+			Expected: &RoleAssignmentId{
+				Scope: resourceid.ResourceGroupScope{SubscriptionId: "12345678-1234-9876-4563-123456789012", ResourceGroup: "resGroup1"},
+				Name:  "23456781-2349-8764-5631-234567890121",
+			},
 		},
 
 		{
-			// valid at subscription scope
-			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+			// trailing slash
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121/",
 			Expected: &RoleAssignmentId{
-				SubscriptionID:  "12345678-1234-9876-4563-123456789012",
-				ResourceGroup:   "",
-				ManagementGroup: "",
-				Name:            "23456781-2349-8764-5631-234567890121",
+				Scope: resourceid.ResourceGroupScope{SubscriptionId: "12345678-1234-9876-4563-123456789012", ResourceGroup: "resGroup1"},
+				Name:  "23456781-2349-8764-5631-234567890121",
 			},
 		},
 
 		{
-			// valid at resource group scope
-			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+			// lower-cased segment names
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/microsoft.authorization/roleassignments/23456781-2349-8764-5631-234567890121",
 			Expected: &RoleAssignmentId{
-				SubscriptionID:  "12345678-1234-9876-4563-123456789012",
-				ResourceGroup:   "group1",
-				ManagementGroup: "",
-				Name:            "23456781-2349-8764-5631-234567890121",
+				Scope: resourceid.ResourceGroupScope{SubscriptionId: "12345678-1234-9876-4563-123456789012", ResourceGroup: "resGroup1"},
+				Name:  "23456781-2349-8764-5631-234567890121",
 			},
 		},
 
 		{
-			// valid at management group scope
-			Input: "/providers/Microsoft.Management/managementGroups/managementGroup1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+			// upper-cased segment names
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/PROVIDERS/MICROSOFT.AUTHORIZATION/ROLEASSIGNMENTS/23456781-2349-8764-5631-234567890121",
 			Expected: &RoleAssignmentId{
-				SubscriptionID:  "",
-				ResourceGroup:   "",
-				ManagementGroup: "managementGroup1",
-				Name:            "23456781-2349-8764-5631-234567890121",
+				Scope: resourceid.ResourceGroupScope{SubscriptionId: "12345678-1234-9876-4563-123456789012", ResourceGroup: "resGroup1"},
+				Name:  "23456781-2349-8764-5631-234567890121",
 			},
 		},
 	}
@@ -156,33 +160,53 @@ func TestRoleAssignmentID(t *testing.T) {
 	for _, v := range testData {
 		t.Logf("[DEBUG] Testing %q", v.Input)
 
-		actual, err := RoleAssignmentID(v.Input)
+		actual, err := RoleAssignmentIDNormalized(v.Input)
 		if err != nil {
 			if v.Error {
 				continue
 			}
 
-			t.Fatalf("expected a value but got an error: %+v", err)
+			t.Fatalf("Expect a value but got an error: %s", err)
 		}
-
 		if v.Error {
 			t.Fatal("Expect an error but didn't get one")
 		}
 
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
 		if actual.Name != v.Expected.Name {
-			t.Fatalf("Expected %q but got %q for Role Assignment Name", v.Expected.Name, actual.Name)
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
 		}
+	}
+}
 
-		if actual.SubscriptionID != v.Expected.SubscriptionID {
-			t.Fatalf("Expected %q but got %q for Role Assignment Name", v.Expected.SubscriptionID, actual.SubscriptionID)
+func TestRoleAssignmentIDRoundTrip(t *testing.T) {
+	testData := []string{
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+		"/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+		"/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+		"/providers/Microsoft.Capacity/reservationOrders/order1/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+		"/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121",
+	}
+
+	for _, input := range testData {
+		t.Logf("[DEBUG] Testing %q", input)
+
+		id, err := RoleAssignmentID(input)
+		if err != nil {
+			t.Fatalf("Expect a value but got an error: %s", err)
 		}
 
-		if actual.ResourceGroup != v.Expected.ResourceGroup {
-			t.Fatalf("Expected %q but got %q for Role Assignment Name", v.Expected.ResourceGroup, actual.ResourceGroup)
+		// formatting the parsed ID should return the original ID
+		if actual := id.ID(); actual != input {
+			t.Fatalf("Expected %q but got %q", input, actual)
 		}
 
-		if actual.ManagementGroup != v.Expected.ManagementGroup {
-			t.Fatalf("Expected %q but got %q for Role Assignment Name", v.Expected.ManagementGroup, actual.ManagementGroup)
+		// and building the ID from each of it's segments should return the same ID
+		if actual := NewRoleAssignmentID(id.Scope, id.Name); actual != *id {
+			t.Fatalf("Expected %+v but got %+v", *id, actual)
 		}
 	}
 }
@@ -0,0 +1,5 @@
+package authorization
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=RoleAssignment -id={scope}/providers/Microsoft.Authorization/roleAssignments/23456781-2349-8764-5631-234567890121 -resource-type=azurerm_role_assignment
+
+// RoleDefinition is manually maintained since this is a pseudo ID containing the Scope, which the generator doesn't support
@@ -15,9 +15,11 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/authorization/parse"
 	managementGroupValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managementgroup/validate"
 	resourceValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/validate"
 	subscriptionValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/subscription/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -28,9 +30,10 @@ func resourceArmRoleAssignment() *schema.Resource {
 		Create: resourceArmRoleAssignmentCreate,
 		Read:   resourceArmRoleAssignmentRead,
 		Delete: resourceArmRoleAssignmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.RoleAssignmentID(id)
+			return err
+		}),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -100,8 +103,8 @@ func resourceArmRoleAssignment() *schema.Resource {
 }
 
 func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
-	roleAssignmentsClient := meta.(*clients.Client).Authorization.RoleAssignmentsClient
-	roleDefinitionsClient := meta.(*clients.Client).Authorization.RoleDefinitionsClient
+	roleAssignmentsClient := meta.(*clients.Client).Authorization().RoleAssignmentsClient
+	roleDefinitionsClient := meta.(*clients.Client).Authorization().RoleDefinitionsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -177,20 +180,26 @@ func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Authorization.RoleAssignmentsClient
-	roleDefinitionsClient := meta.(*clients.Client).Authorization.RoleDefinitionsClient
+	client := meta.(*clients.Client).Authorization().RoleAssignmentsClient
+	roleDefinitionsClient := meta.(*clients.Client).Authorization().RoleDefinitionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	resp, err := client.GetByID(ctx, d.Id())
+	id, err := parse.RoleAssignmentIDNormalized(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the Scope is a part of the URI, so shouldn't include the leading slash
+	resp, err := client.Get(ctx, strings.TrimPrefix(id.Scope.ID(), "/"), id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
-			log.Printf("[DEBUG] Role Assignment ID %q was not found - removing from state", d.Id())
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
 			d.SetId("")
 			return nil
 		}
 
-		return fmt.Errorf("Error loading Role Assignment %q: %+v", d.Id(), err)
+		return fmt.Errorf("Error loading %s: %+v", *id, err)
 	}
 
 	d.Set("name", resp.Name)
@@ -218,16 +227,17 @@ func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmRoleAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Authorization.RoleAssignmentsClient
+	client := meta.(*clients.Client).Authorization().RoleAssignmentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parseRoleAssignmentId(d.Id())
+	id, err := parse.RoleAssignmentIDNormalized(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.scope, id.name)
+	// the Scope is a part of the URI, so shouldn't include the leading slash
+	resp, err := client.Delete(ctx, strings.TrimPrefix(id.Scope.ID(), "/"), id.Name)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp.Response) {
 			return err
@@ -239,7 +249,7 @@ func resourceArmRoleAssignmentDelete(d *schema.ResourceData, meta interface{}) e
 
 func retryRoleAssignmentsClient(d *schema.ResourceData, scope string, name string, properties authorization.RoleAssignmentCreateParameters, meta interface{}) func() *resource.RetryError {
 	return func() *resource.RetryError {
-		roleAssignmentsClient := meta.(*clients.Client).Authorization.RoleAssignmentsClient
+		roleAssignmentsClient := meta.(*clients.Client).Authorization().RoleAssignmentsClient
 		ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 		defer cancel()
 
@@ -280,25 +290,6 @@ func retryRoleAssignmentsClient(d *schema.ResourceData, scope string, name strin
 	}
 }
 
-type roleAssignmentId struct {
-	scope string
-	name  string
-}
-
-func parseRoleAssignmentId(input string) (*roleAssignmentId, error) {
-	segments := strings.Split(input, "/providers/Microsoft.Authorization/roleAssignments/")
-	if len(segments) != 2 {
-		return nil, fmt.Errorf("Expected Role Assignment ID to be in the format `{scope}/providers/Microsoft.Authorization/roleAssignments/{name}` but got %q", input)
-	}
-
-	// /{scope}/providers/Microsoft.Authorization/roleAssignments/{roleAssignmentName}
-	id := roleAssignmentId{
-		scope: strings.TrimPrefix(segments[0], "/"),
-		name:  segments[1],
-	}
-	return &id, nil
-}
-
 func roleAssignmentCreateStateRefreshFunc(ctx context.Context, client *authorization.RoleAssignmentsClient, roleID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		resp, err := client.GetByID(ctx, roleID)
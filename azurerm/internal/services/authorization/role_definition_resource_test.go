@@ -176,7 +176,7 @@ func (RoleDefinitionResource) Exists(ctx context.Context, client *clients.Client
 	scope := state.Attributes["scope"]
 	roleDefinitionId := state.Attributes["role_definition_id"]
 
-	resp, err := client.Authorization.RoleDefinitionsClient.Get(ctx, scope, roleDefinitionId)
+	resp, err := client.Authorization().RoleDefinitionsClient.Get(ctx, scope, roleDefinitionId)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
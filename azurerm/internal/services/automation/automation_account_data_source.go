@@ -44,8 +44,8 @@ func dataSourceAutomationAccount() *schema.Resource {
 }
 
 func dataSourceAutomationAccountRead(d *schema.ResourceData, meta interface{}) error {
-	iclient := meta.(*clients.Client).Automation.AgentRegistrationInfoClient
-	client := meta.(*clients.Client).Automation.AccountClient
+	iclient := meta.(*clients.Client).Automation().AgentRegistrationInfoClient
+	client := meta.(*clients.Client).Automation().AccountClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -74,7 +74,7 @@ func resourceAutomationAccount() *schema.Resource {
 }
 
 func resourceAutomationAccountCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.AccountClient
+	client := meta.(*clients.Client).Automation().AccountClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -130,8 +130,8 @@ func resourceAutomationAccountCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAutomationAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.AccountClient
-	registrationClient := meta.(*clients.Client).Automation.AgentRegistrationInfoClient
+	client := meta.(*clients.Client).Automation().AccountClient
+	registrationClient := meta.(*clients.Client).Automation().AgentRegistrationInfoClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -192,7 +192,7 @@ func resourceAutomationAccountRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAutomationAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.AccountClient
+	client := meta.(*clients.Client).Automation().AccountClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
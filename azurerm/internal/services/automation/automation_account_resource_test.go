@@ -78,7 +78,7 @@ func (t AutomationAccountResource) Exists(ctx context.Context, clients *clients.
 	}
 	name := id.Path["automationAccounts"]
 
-	resp, err := clients.Automation.AccountClient.Get(ctx, id.ResourceGroup, name)
+	resp, err := clients.Automation().AccountClient.Get(ctx, id.ResourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Automation Account %q (resource group: %q): %+v", name, id.ResourceGroup, err)
 	}
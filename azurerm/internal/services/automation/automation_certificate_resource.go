@@ -77,7 +77,7 @@ func resourceAutomationCertificate() *schema.Resource {
 }
 
 func resourceAutomationCertificateCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.CertificateClient
+	client := meta.(*clients.Client).Automation().CertificateClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -133,7 +133,7 @@ func resourceAutomationCertificateCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceAutomationCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.CertificateClient
+	client := meta.(*clients.Client).Automation().CertificateClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -169,7 +169,7 @@ func resourceAutomationCertificateRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAutomationCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.CertificateClient
+	client := meta.(*clients.Client).Automation().CertificateClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
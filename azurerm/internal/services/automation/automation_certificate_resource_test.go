@@ -108,7 +108,7 @@ func (t AutomationCertificateResource) Exists(ctx context.Context, clients *clie
 	accountName := id.Path["automationAccounts"]
 	name := id.Path["certificates"]
 
-	resp, err := clients.Automation.CertificateClient.Get(ctx, id.ResourceGroup, accountName, name)
+	resp, err := clients.Automation().CertificateClient.Get(ctx, id.ResourceGroup, accountName, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Automation Certificate %q (resource group: %q): %+v", name, id.ResourceGroup, err)
 	}
@@ -75,7 +75,7 @@ func resourceAutomationConnectionCertificate() *schema.Resource {
 }
 
 func resourceAutomationConnectionCertificateCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ConnectionClient
+	client := meta.(*clients.Client).Automation().ConnectionClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -131,7 +131,7 @@ func resourceAutomationConnectionCertificateCreateUpdate(d *schema.ResourceData,
 }
 
 func resourceAutomationConnectionCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ConnectionClient
+	client := meta.(*clients.Client).Automation().ConnectionClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -168,7 +168,7 @@ func resourceAutomationConnectionCertificateRead(d *schema.ResourceData, meta in
 }
 
 func resourceAutomationConnectionCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ConnectionClient
+	client := meta.(*clients.Client).Automation().ConnectionClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
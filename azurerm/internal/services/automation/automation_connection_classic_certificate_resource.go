@@ -81,7 +81,7 @@ func resourceAutomationConnectionClassicCertificate() *schema.Resource {
 }
 
 func resourceAutomationConnectionClassicCertificateCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ConnectionClient
+	client := meta.(*clients.Client).Automation().ConnectionClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -138,7 +138,7 @@ func resourceAutomationConnectionClassicCertificateCreateUpdate(d *schema.Resour
 }
 
 func resourceAutomationConnectionClassicCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ConnectionClient
+	client := meta.(*clients.Client).Automation().ConnectionClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -178,7 +178,7 @@ func resourceAutomationConnectionClassicCertificateRead(d *schema.ResourceData,
 }
 
 func resourceAutomationConnectionClassicCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ConnectionClient
+	client := meta.(*clients.Client).Automation().ConnectionClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
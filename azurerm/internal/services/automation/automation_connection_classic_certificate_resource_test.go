@@ -97,7 +97,7 @@ func (t AutomationConnectionClassicCertificateResource) Exists(ctx context.Conte
 		return nil, err
 	}
 
-	resp, err := clients.Automation.ConnectionClient.Get(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name)
+	resp, err := clients.Automation().ConnectionClient.Get(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Automation Connection (Classic Certificate) %q (resource group: %q): %+v", id.Name, id.ResourceGroup, err)
 	}
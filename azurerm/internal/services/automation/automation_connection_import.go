@@ -16,7 +16,7 @@ func importAutomationConnection(connectionType string) func(d *schema.ResourceDa
 			return []*schema.ResourceData{}, err
 		}
 
-		client := meta.(*clients.Client).Automation.ConnectionClient
+		client := meta.(*clients.Client).Automation().ConnectionClient
 		ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 		defer cancel()
 
@@ -79,8 +79,8 @@ func resourceAutomationConnection() *schema.Resource {
 }
 
 func resourceAutomationConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ConnectionClient
-	connectionTypeClient := meta.(*clients.Client).Automation.ConnectionTypeClient
+	client := meta.(*clients.Client).Automation().ConnectionClient
+	connectionTypeClient := meta.(*clients.Client).Automation().ConnectionTypeClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -153,7 +153,7 @@ func resourceAutomationConnectionCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceAutomationConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ConnectionClient
+	client := meta.(*clients.Client).Automation().ConnectionClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -192,7 +192,7 @@ func resourceAutomationConnectionRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAutomationConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ConnectionClient
+	client := meta.(*clients.Client).Automation().ConnectionClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
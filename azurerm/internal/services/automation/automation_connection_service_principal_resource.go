@@ -87,7 +87,7 @@ func resourceAutomationConnectionServicePrincipal() *schema.Resource {
 }
 
 func resourceAutomationConnectionServicePrincipalCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ConnectionClient
+	client := meta.(*clients.Client).Automation().ConnectionClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -145,7 +145,7 @@ func resourceAutomationConnectionServicePrincipalCreateUpdate(d *schema.Resource
 }
 
 func resourceAutomationConnectionServicePrincipalRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ConnectionClient
+	client := meta.(*clients.Client).Automation().ConnectionClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -188,7 +188,7 @@ func resourceAutomationConnectionServicePrincipalRead(d *schema.ResourceData, me
 }
 
 func resourceAutomationConnectionServicePrincipalDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ConnectionClient
+	client := meta.(*clients.Client).Automation().ConnectionClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
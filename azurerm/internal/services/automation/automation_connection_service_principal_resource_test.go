@@ -97,7 +97,7 @@ func (t AutomationConnectionServicePrincipalResource) Exists(ctx context.Context
 		return nil, err
 	}
 
-	resp, err := clients.Automation.ConnectionClient.Get(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name)
+	resp, err := clients.Automation().ConnectionClient.Get(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Automation Connection (Service Principal) %q (resource group: %q): %+v", id.Name, id.ResourceGroup, err)
 	}
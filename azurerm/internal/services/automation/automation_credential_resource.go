@@ -69,7 +69,7 @@ func resourceAutomationCredential() *schema.Resource {
 }
 
 func resourceAutomationCredentialCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.CredentialClient
+	client := meta.(*clients.Client).Automation().CredentialClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -124,7 +124,7 @@ func resourceAutomationCredentialCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceAutomationCredentialRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.CredentialClient
+	client := meta.(*clients.Client).Automation().CredentialClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -158,7 +158,7 @@ func resourceAutomationCredentialRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAutomationCredentialDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.CredentialClient
+	client := meta.(*clients.Client).Automation().CredentialClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
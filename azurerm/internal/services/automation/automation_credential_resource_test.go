@@ -74,7 +74,7 @@ func (t AutomationCredentialResource) Exists(ctx context.Context, clients *clien
 	accountName := id.Path["automationAccounts"]
 	name := id.Path["credentials"]
 
-	resp, err := clients.Automation.CredentialClient.Get(ctx, resGroup, accountName, name)
+	resp, err := clients.Automation().CredentialClient.Get(ctx, resGroup, accountName, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Automation Credential %q (resource group: %q): %+v", name, id.ResourceGroup, err)
 	}
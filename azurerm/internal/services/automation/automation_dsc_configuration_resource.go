@@ -87,7 +87,7 @@ func resourceAutomationDscConfiguration() *schema.Resource {
 }
 
 func resourceAutomationDscConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.DscConfigurationClient
+	client := meta.(*clients.Client).Automation().DscConfigurationClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -148,7 +148,7 @@ func resourceAutomationDscConfigurationCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAutomationDscConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.DscConfigurationClient
+	client := meta.(*clients.Client).Automation().DscConfigurationClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -201,7 +201,7 @@ func resourceAutomationDscConfigurationRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAutomationDscConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.DscConfigurationClient
+	client := meta.(*clients.Client).Automation().DscConfigurationClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
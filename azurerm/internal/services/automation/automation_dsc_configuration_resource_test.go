@@ -62,7 +62,7 @@ func (t AutomationDscConfigurationResource) Exists(ctx context.Context, clients
 	accName := id.Path["automationAccounts"]
 	name := id.Path["configurations"]
 
-	resp, err := clients.Automation.DscConfigurationClient.Get(ctx, resGroup, accName, name)
+	resp, err := clients.Automation().DscConfigurationClient.Get(ctx, resGroup, accName, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Automation Dsc Configuration %q (resource group: %q): %+v", name, id.ResourceGroup, err)
 	}
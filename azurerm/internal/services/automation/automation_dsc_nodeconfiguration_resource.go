@@ -67,7 +67,7 @@ func resourceAutomationDscNodeConfiguration() *schema.Resource {
 }
 
 func resourceAutomationDscNodeConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.DscNodeConfigurationClient
+	client := meta.(*clients.Client).Automation().DscNodeConfigurationClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -129,7 +129,7 @@ func resourceAutomationDscNodeConfigurationCreateUpdate(d *schema.ResourceData,
 }
 
 func resourceAutomationDscNodeConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.DscNodeConfigurationClient
+	client := meta.(*clients.Client).Automation().DscNodeConfigurationClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -162,7 +162,7 @@ func resourceAutomationDscNodeConfigurationRead(d *schema.ResourceData, meta int
 }
 
 func resourceAutomationDscNodeConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.DscNodeConfigurationClient
+	client := meta.(*clients.Client).Automation().DscNodeConfigurationClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
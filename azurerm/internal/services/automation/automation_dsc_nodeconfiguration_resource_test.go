@@ -57,7 +57,7 @@ func (t AutomationDscNodeConfigurationResource) Exists(ctx context.Context, clie
 	accName := id.Path["automationAccounts"]
 	name := id.Path["nodeConfigurations"]
 
-	resp, err := clients.Automation.DscNodeConfigurationClient.Get(ctx, resGroup, accName, name)
+	resp, err := clients.Automation().DscNodeConfigurationClient.Get(ctx, resGroup, accName, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Automation Dsc Node Configuration %q (resource group: %q): %+v", name, id.ResourceGroup, err)
 	}
@@ -97,7 +97,7 @@ func resourceAutomationJobSchedule() *schema.Resource {
 }
 
 func resourceAutomationJobScheduleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.JobScheduleClient
+	client := meta.(*clients.Client).Automation().JobScheduleClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -196,7 +196,7 @@ func resourceAutomationJobScheduleCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAutomationJobScheduleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.JobScheduleClient
+	client := meta.(*clients.Client).Automation().JobScheduleClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -242,7 +242,7 @@ func resourceAutomationJobScheduleRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAutomationJobScheduleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.JobScheduleClient
+	client := meta.(*clients.Client).Automation().JobScheduleClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -104,7 +104,7 @@ func (t AutomationJobScheduleResource) Exists(ctx context.Context, clients *clie
 	resourceGroup := id.ResourceGroup
 	accountName := id.Path["automationAccounts"]
 
-	resp, err := clients.Automation.JobScheduleClient.Get(ctx, resourceGroup, accountName, jobScheduleUUID)
+	resp, err := clients.Automation().JobScheduleClient.Get(ctx, resourceGroup, accountName, jobScheduleUUID)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Automation Job Schedule '%s' (Account %q / Resource Group %q) does not exist", jobScheduleUUID, accountName, resourceGroup)
 	}
@@ -88,7 +88,7 @@ func resourceAutomationModule() *schema.Resource {
 }
 
 func resourceAutomationModuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ModuleClient
+	client := meta.(*clients.Client).Automation().ModuleClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -185,7 +185,7 @@ func resourceAutomationModuleCreateUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAutomationModuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ModuleClient
+	client := meta.(*clients.Client).Automation().ModuleClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -215,7 +215,7 @@ func resourceAutomationModuleRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAutomationModuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ModuleClient
+	client := meta.(*clients.Client).Automation().ModuleClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -71,7 +71,7 @@ func (t AutomationModuleResource) Exists(ctx context.Context, clients *clients.C
 	accName := id.Path["automationAccounts"]
 	name := id.Path["modules"]
 
-	resp, err := clients.Automation.ModuleClient.Get(ctx, resGroup, accName, name)
+	resp, err := clients.Automation().ModuleClient.Get(ctx, resGroup, accName, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Automation Module '%s' (resource group: '%s') does not exist", name, id.ResourceGroup)
 	}
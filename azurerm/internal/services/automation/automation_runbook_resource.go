@@ -143,8 +143,8 @@ func resourceAutomationRunbook() *schema.Resource {
 }
 
 func resourceAutomationRunbookCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.RunbookClient
-	jsClient := meta.(*clients.Client).Automation.JobScheduleClient
+	client := meta.(*clients.Client).Automation().RunbookClient
+	jsClient := meta.(*clients.Client).Automation().JobScheduleClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -201,7 +201,7 @@ func resourceAutomationRunbookCreateUpdate(d *schema.ResourceData, meta interfac
 	if v, ok := d.GetOk("content"); ok {
 		content := v.(string)
 		reader := ioutil.NopCloser(bytes.NewBufferString(content))
-		draftClient := meta.(*clients.Client).Automation.RunbookDraftClient
+		draftClient := meta.(*clients.Client).Automation().RunbookDraftClient
 
 		if _, err := draftClient.ReplaceContent(ctx, resGroup, accName, name, reader); err != nil {
 			return fmt.Errorf("Error setting the draft Automation Runbook %q (Account %q / Resource Group %q): %+v", name, accName, resGroup, err)
@@ -256,8 +256,8 @@ func resourceAutomationRunbookCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAutomationRunbookRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.RunbookClient
-	jsClient := meta.(*clients.Client).Automation.JobScheduleClient
+	client := meta.(*clients.Client).Automation().RunbookClient
+	jsClient := meta.(*clients.Client).Automation().JobScheduleClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -345,7 +345,7 @@ func resourceAutomationRunbookRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAutomationRunbookDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.RunbookClient
+	client := meta.(*clients.Client).Automation().RunbookClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -140,7 +140,7 @@ func (t AutomationRunbookResource) Exists(ctx context.Context, clients *clients.
 	accName := id.Path["automationAccounts"]
 	name := id.Path["runbooks"]
 
-	resp, err := clients.Automation.RunbookClient.Get(ctx, resGroup, accName, name)
+	resp, err := clients.Automation().RunbookClient.Get(ctx, resGroup, accName, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Automation Runbook '%s' (resource group: '%s') does not exist", name, id.ResourceGroup)
 	}
@@ -200,7 +200,7 @@ func resourceAutomationSchedule() *schema.Resource {
 }
 
 func resourceAutomationScheduleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ScheduleClient
+	client := meta.(*clients.Client).Automation().ScheduleClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -287,7 +287,7 @@ func resourceAutomationScheduleCreateUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAutomationScheduleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ScheduleClient
+	client := meta.(*clients.Client).Automation().ScheduleClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -346,7 +346,7 @@ func resourceAutomationScheduleRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAutomationScheduleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Automation.ScheduleClient
+	client := meta.(*clients.Client).Automation().ScheduleClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -210,7 +210,7 @@ func (t AutomationScheduleResource) Exists(ctx context.Context, clients *clients
 	resGroup := id.ResourceGroup
 	accountName := id.Path["automationAccounts"]
 
-	resp, err := clients.Automation.ScheduleClient.Get(ctx, resGroup, accountName, name)
+	resp, err := clients.Automation().ScheduleClient.Get(ctx, resGroup, accountName, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Automation Schedule '%s' (resource group: '%s') does not exist", name, id.ResourceGroup)
 	}
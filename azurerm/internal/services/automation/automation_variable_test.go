@@ -91,7 +91,7 @@ func testCheckAzureRMAutomationVariableExists(ctx context.Context, clients *clie
 	accountName := id.Path["automationAccounts"]
 	name := id.Path["variables"]
 
-	resp, err := clients.Automation.VariableClient.Get(ctx, resourceGroup, accountName, name)
+	resp, err := clients.Automation().VariableClient.Get(ctx, resourceGroup, accountName, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Automation %s Variable %q (Automation Account Name %q / Resource Group %q) does not exist", varType, name, accountName, resourceGroup)
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AutomationAccountId struct {
@@ -67,3 +68,12 @@ func AutomationAccountID(input string) (*AutomationAccountId, error) {
 
 	return &resourceId, nil
 }
+
+// AutomationAccountIDNormalized parses a AutomationAccount ID into an AutomationAccountId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the AutomationAccountID method should be used instead for validation etc.
+func AutomationAccountIDNormalized(input string) (*AutomationAccountId, error) {
+	return AutomationAccountID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s"))
+}
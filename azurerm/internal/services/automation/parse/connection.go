@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ConnectionId struct {
@@ -73,3 +74,12 @@ func ConnectionID(input string) (*ConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+// ConnectionIDNormalized parses a Connection ID into an ConnectionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ConnectionID method should be used instead for validation etc.
+func ConnectionIDNormalized(input string) (*ConnectionId, error) {
+	return ConnectionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Automation/automationAccounts/%s/connections/%s"))
+}
@@ -72,7 +72,7 @@ func resourceArmStackHCICluster() *schema.Resource {
 }
 
 func resourceArmStackHCIClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AzureStackHCI.ClusterClient
+	client := meta.(*clients.Client).AzureStackHCI().ClusterClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	tenantId := meta.(*clients.Client).Account.TenantId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -118,7 +118,7 @@ func resourceArmStackHCIClusterCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmStackHCIClusterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AzureStackHCI.ClusterClient
+	client := meta.(*clients.Client).AzureStackHCI().ClusterClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -151,7 +151,7 @@ func resourceArmStackHCIClusterRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceArmStackHCIClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AzureStackHCI.ClusterClient
+	client := meta.(*clients.Client).AzureStackHCI().ClusterClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -162,7 +162,7 @@ func resourceArmStackHCIClusterUpdate(d *schema.ResourceData, meta interface{})
 
 	cluster := azurestackhci.ClusterUpdate{}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		cluster.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -174,7 +174,7 @@ func resourceArmStackHCIClusterUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmStackHCIClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AzureStackHCI.ClusterClient
+	client := meta.(*clients.Client).AzureStackHCI().ClusterClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -84,7 +84,7 @@ func TestAccStackHCICluster_update(t *testing.T) {
 }
 
 func (r StackHCIClusterResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	clusterClient := client.AzureStackHCI.ClusterClient
+	clusterClient := client.AzureStackHCI().ClusterClient
 	id, err := parse.ClusterID(state.ID)
 	if err != nil {
 		return nil, err
@@ -73,7 +73,7 @@ func dataSourceBatchAccount() *schema.Resource {
 }
 
 func dataSourceBatchAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.AccountClient
+	client := meta.(*clients.Client).Batch().AccountClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -106,7 +106,7 @@ func resourceBatchAccount() *schema.Resource {
 }
 
 func resourceBatchAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.AccountClient
+	client := meta.(*clients.Client).Batch().AccountClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -185,7 +185,7 @@ func resourceBatchAccountCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceBatchAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.AccountClient
+	client := meta.(*clients.Client).Batch().AccountClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -233,7 +233,7 @@ func resourceBatchAccountRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBatchAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.AccountClient
+	client := meta.(*clients.Client).Batch().AccountClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -275,7 +275,7 @@ func resourceBatchAccountUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceBatchAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.AccountClient
+	client := meta.(*clients.Client).Batch().AccountClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -128,7 +128,7 @@ func (t BatchAccountResource) Exists(ctx context.Context, clients *clients.Clien
 		return nil, err
 	}
 
-	resp, err := clients.Batch.AccountClient.Get(ctx, id.ResourceGroup, id.BatchAccountName)
+	resp, err := clients.Batch().AccountClient.Get(ctx, id.ResourceGroup, id.BatchAccountName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Batch Application %q (Resource Group %q) does not exist", id.BatchAccountName, id.ResourceGroup)
 	}
@@ -75,7 +75,7 @@ func resourceBatchApplication() *schema.Resource {
 }
 
 func resourceBatchApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.ApplicationClient
+	client := meta.(*clients.Client).Batch().ApplicationClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -122,7 +122,7 @@ func resourceBatchApplicationCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceBatchApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.ApplicationClient
+	client := meta.(*clients.Client).Batch().ApplicationClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -154,7 +154,7 @@ func resourceBatchApplicationRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceBatchApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.ApplicationClient
+	client := meta.(*clients.Client).Batch().ApplicationClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -183,7 +183,7 @@ func resourceBatchApplicationUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceBatchApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.ApplicationClient
+	client := meta.(*clients.Client).Batch().ApplicationClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -56,7 +56,7 @@ func dataSourceBatchCertificate() *schema.Resource {
 }
 
 func dataSourceBatchCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.CertificateClient
+	client := meta.(*clients.Client).Batch().CertificateClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -101,7 +101,7 @@ func resourceBatchCertificate() *schema.Resource {
 }
 
 func resourceBatchCertificateCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.CertificateClient
+	client := meta.(*clients.Client).Batch().CertificateClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -164,7 +164,7 @@ func resourceBatchCertificateCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceBatchCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.CertificateClient
+	client := meta.(*clients.Client).Batch().CertificateClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -198,7 +198,7 @@ func resourceBatchCertificateRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceBatchCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.CertificateClient
+	client := meta.(*clients.Client).Batch().CertificateClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -247,7 +247,7 @@ func resourceBatchCertificateUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceBatchCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.CertificateClient
+	client := meta.(*clients.Client).Batch().CertificateClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
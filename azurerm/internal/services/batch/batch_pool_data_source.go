@@ -360,7 +360,7 @@ func dataSourceBatchPool() *schema.Resource {
 }
 
 func dataSourceBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.PoolClient
+	client := meta.(*clients.Client).Batch().PoolClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -499,7 +499,7 @@ func resourceBatchPool() *schema.Resource {
 }
 
 func resourceBatchPoolCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.PoolClient
+	client := meta.(*clients.Client).Batch().PoolClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -640,7 +640,7 @@ func resourceBatchPoolCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.PoolClient
+	client := meta.(*clients.Client).Batch().PoolClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -733,7 +733,7 @@ func resourceBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.PoolClient
+	client := meta.(*clients.Client).Batch().PoolClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -800,7 +800,7 @@ func resourceBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBatchPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.PoolClient
+	client := meta.(*clients.Client).Batch().PoolClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PoolId struct {
@@ -73,3 +74,12 @@ func PoolID(input string) (*PoolId, error) {
 
 	return &resourceId, nil
 }
+
+// PoolIDNormalized parses a Pool ID into an PoolId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the PoolID method should be used instead for validation etc.
+func PoolIDNormalized(input string) (*PoolId, error) {
+	return PoolID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Batch/batchAccounts/%s/pools/%s"))
+}
@@ -127,7 +127,7 @@ func resourceBlueprintAssignment() *schema.Resource {
 }
 
 func resourceBlueprintAssignmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Blueprints.AssignmentsClient
+	client := meta.(*clients.Client).Blueprints().AssignmentsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -218,7 +218,7 @@ func resourceBlueprintAssignmentCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceBlueprintAssignmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Blueprints.AssignmentsClient
+	client := meta.(*clients.Client).Blueprints().AssignmentsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -300,7 +300,7 @@ func resourceBlueprintAssignmentRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceBlueprintAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Blueprints.AssignmentsClient
+	client := meta.(*clients.Client).Blueprints().AssignmentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -111,7 +111,7 @@ func (t BlueprintAssignmentResource) Exists(ctx context.Context, clients *client
 		return nil, err
 	}
 
-	resp, err := clients.Blueprints.AssignmentsClient.Get(ctx, id.Scope, id.Name)
+	resp, err := clients.Blueprints().AssignmentsClient.Get(ctx, id.Scope, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Blueprint Assignment %q (scope %q) was not found", id.Name, id.Scope)
 	}
@@ -76,7 +76,7 @@ func dataSourceBlueprintDefinition() *schema.Resource {
 }
 
 func dataSourceBlueprintDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Blueprints.BlueprintsClient
+	client := meta.(*clients.Client).Blueprints().BlueprintsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
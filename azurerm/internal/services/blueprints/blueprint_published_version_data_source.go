@@ -78,7 +78,7 @@ func dataSourceBlueprintPublishedVersion() *schema.Resource {
 }
 
 func dataSourceBlueprintPublishedVersionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Blueprints.PublishedBlueprintsClient
+	client := meta.(*clients.Client).Blueprints().PublishedBlueprintsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -118,7 +118,7 @@ func resourceBotChannelDirectline() *schema.Resource {
 }
 
 func resourceBotChannelDirectlineCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot().ChannelClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -168,7 +168,7 @@ func resourceBotChannelDirectlineCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceBotChannelDirectlineRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot().ChannelClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -209,7 +209,7 @@ func resourceBotChannelDirectlineRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceBotChannelDirectlineUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot().ChannelClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -237,7 +237,7 @@ func resourceBotChannelDirectlineUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceBotChannelDirectlineDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot().ChannelClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -67,7 +67,7 @@ func resourceBotChannelEmail() *schema.Resource {
 }
 
 func resourceBotChannelEmailCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot().ChannelClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -107,7 +107,7 @@ func resourceBotChannelEmailCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceBotChannelEmailRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot().ChannelClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -143,7 +143,7 @@ func resourceBotChannelEmailRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceBotChannelEmailUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot().ChannelClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -173,7 +173,7 @@ func resourceBotChannelEmailUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceBotChannelEmailDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot().ChannelClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
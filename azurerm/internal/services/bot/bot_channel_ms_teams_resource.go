@@ -70,7 +70,7 @@ func resourceBotChannelMsTeams() *schema.Resource {
 }
 
 func resourceBotChannelMsTeamsCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot().ChannelClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -114,7 +114,7 @@ func resourceBotChannelMsTeamsCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceBotChannelMsTeamsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot().ChannelClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -151,7 +151,7 @@ func resourceBotChannelMsTeamsRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceBotChannelMsTeamsUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot().ChannelClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -181,7 +181,7 @@ func resourceBotChannelMsTeamsUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceBotChannelMsTeamsDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot().ChannelClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
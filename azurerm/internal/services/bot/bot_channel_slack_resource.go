@@ -80,7 +80,7 @@ func resourceBotChannelSlack() *schema.Resource {
 }
 
 func resourceBotChannelSlackCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot().ChannelClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -123,7 +123,7 @@ func resourceBotChannelSlackCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceBotChannelSlackRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot().ChannelClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -159,7 +159,7 @@ func resourceBotChannelSlackRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceBotChannelSlackUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot().ChannelClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -192,7 +192,7 @@ func resourceBotChannelSlackUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceBotChannelSlackDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot().ChannelClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -38,7 +38,7 @@ func resourceBotChannelsRegistration() *schema.Resource {
 			_, err := parse.BotServiceID(id)
 			return err
 		}, func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-			client := meta.(*clients.Client).Bot.BotClient
+			client := meta.(*clients.Client).Bot().BotClient
 			ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 			defer cancel()
 
@@ -132,7 +132,7 @@ func resourceBotChannelsRegistration() *schema.Resource {
 }
 
 func resourceBotChannelsRegistrationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.BotClient
+	client := meta.(*clients.Client).Bot().BotClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -181,7 +181,7 @@ func resourceBotChannelsRegistrationCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceBotChannelsRegistrationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.BotClient
+	client := meta.(*clients.Client).Bot().BotClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -221,7 +221,7 @@ func resourceBotChannelsRegistrationRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceBotChannelsRegistrationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.BotClient
+	client := meta.(*clients.Client).Bot().BotClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -261,7 +261,7 @@ func resourceBotChannelsRegistrationUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceBotChannelsRegistrationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.BotClient
+	client := meta.(*clients.Client).Bot().BotClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -30,7 +30,7 @@ func resourceBotWebApp() *schema.Resource {
 			_, err := parse.BotServiceID(id)
 			return err
 		}, func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-			client := meta.(*clients.Client).Bot.BotClient
+			client := meta.(*clients.Client).Bot().BotClient
 			ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 			defer cancel()
 
@@ -147,7 +147,7 @@ func resourceBotWebApp() *schema.Resource {
 }
 
 func resourceBotWebAppCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.BotClient
+	client := meta.(*clients.Client).Bot().BotClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -200,7 +200,7 @@ func resourceBotWebAppCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBotWebAppRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.BotClient
+	client := meta.(*clients.Client).Bot().BotClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -241,7 +241,7 @@ func resourceBotWebAppRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBotWebAppUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.BotClient
+	client := meta.(*clients.Client).Bot().BotClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -282,7 +282,7 @@ func resourceBotWebAppUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBotWebAppDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.BotClient
+	client := meta.(*clients.Client).Bot().BotClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BotChannelId struct {
@@ -73,3 +74,12 @@ func BotChannelID(input string) (*BotChannelId, error) {
 
 	return &resourceId, nil
 }
+
+// BotChannelIDNormalized parses a BotChannel ID into an BotChannelId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the BotChannelID method should be used instead for validation etc.
+func BotChannelIDNormalized(input string) (*BotChannelId, error) {
+	return BotChannelID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.BotService/botServices/%s/channels/%s"))
+}
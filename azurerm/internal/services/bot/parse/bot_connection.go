@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BotConnectionId struct {
@@ -73,3 +74,12 @@ func BotConnectionID(input string) (*BotConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+// BotConnectionIDNormalized parses a BotConnection ID into an BotConnectionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the BotConnectionID method should be used instead for validation etc.
+func BotConnectionIDNormalized(input string) (*BotConnectionId, error) {
+	return BotConnectionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.BotService/botServices/%s/connections/%s"))
+}
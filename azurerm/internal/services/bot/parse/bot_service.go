@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BotServiceId struct {
@@ -67,3 +68,12 @@ func BotServiceID(input string) (*BotServiceId, error) {
 
 	return &resourceId, nil
 }
+
+// BotServiceIDNormalized parses a BotService ID into an BotServiceId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the BotServiceID method should be used instead for validation etc.
+func BotServiceIDNormalized(input string) (*BotServiceId, error) {
+	return BotServiceID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.BotService/botServices/%s"))
+}
@@ -1,5 +1,5 @@
 package bot
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=BotChannel -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1/channels/Discovery1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=BotConnection -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1/connections/connection1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=BotService -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=BotChannel -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1/channels/Discovery1 -resource-type=azurerm_bot_channel_directline,azurerm_bot_channel_email,azurerm_bot_channel_ms_teams,azurerm_bot_channel_slack
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=BotConnection -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1/connections/connection1 -resource-type=azurerm_bot_connection
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=BotService -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1 -resource-type=azurerm_bot_channels_registration,azurerm_bot_web_app
@@ -215,8 +215,8 @@ func resourceCdnEndpoint() *schema.Resource {
 }
 
 func resourceCdnEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	endpointsClient := meta.(*clients.Client).Cdn.EndpointsClient
-	profilesClient := meta.(*clients.Client).Cdn.ProfilesClient
+	endpointsClient := meta.(*clients.Client).Cdn().EndpointsClient
+	profilesClient := meta.(*clients.Client).Cdn().ProfilesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -334,7 +334,7 @@ func resourceCdnEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
-	endpointsClient := meta.(*clients.Client).Cdn.EndpointsClient
+	endpointsClient := meta.(*clients.Client).Cdn().EndpointsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -385,7 +385,7 @@ func resourceCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
 		endpoint.EndpointPropertiesUpdateParameters.ProbePath = utils.String(probePath)
 	}
 
-	profilesClient := meta.(*clients.Client).Cdn.ProfilesClient
+	profilesClient := meta.(*clients.Client).Cdn().ProfilesClient
 	profileGetCtx, profileGetCancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer profileGetCancel()
 
@@ -424,7 +424,7 @@ func resourceCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCdnEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cdn.EndpointsClient
+	client := meta.(*clients.Client).Cdn().EndpointsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -500,7 +500,7 @@ func resourceCdnEndpointRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCdnEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cdn.EndpointsClient
+	client := meta.(*clients.Client).Cdn().EndpointsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -318,7 +318,7 @@ func (r CdnEndpointResource) Exists(ctx context.Context, client *clients.Client,
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Cdn.EndpointsClient.Get(ctx, id.ResourceGroup, id.ProfileName, id.Name)
+	resp, err := client.Cdn().EndpointsClient.Get(ctx, id.ResourceGroup, id.ProfileName, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -334,7 +334,7 @@ func (r CdnEndpointResource) Destroy(ctx context.Context, client *clients.Client
 		return nil, err
 	}
 
-	endpointsClient := client.Cdn.EndpointsClient
+	endpointsClient := client.Cdn().EndpointsClient
 	future, err := endpointsClient.Delete(ctx, id.ResourceGroup, id.ProfileName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting CDN Endpoint %q (Resource Group %q / Profile %q): %+v", id.Name, id.ResourceGroup, id.ProfileName, err)
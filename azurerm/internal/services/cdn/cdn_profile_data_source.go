@@ -43,7 +43,7 @@ func dataSourceCdnProfile() *schema.Resource {
 }
 
 func dataSourceCdnProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cdn.ProfilesClient
+	client := meta.(*clients.Client).Cdn().ProfilesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -81,7 +81,7 @@ func resourceCdnProfile() *schema.Resource {
 }
 
 func resourceCdnProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cdn.ProfilesClient
+	client := meta.(*clients.Client).Cdn().ProfilesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -143,11 +143,11 @@ func resourceCdnProfileCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCdnProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cdn.ProfilesClient
+	client := meta.(*clients.Client).Cdn().ProfilesClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	if !d.HasChange("tags") {
+	if !d.HasChanges("tags", "tags_all") {
 		return nil
 	}
 
@@ -177,7 +177,7 @@ func resourceCdnProfileUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCdnProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cdn.ProfilesClient
+	client := meta.(*clients.Client).Cdn().ProfilesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -209,7 +209,7 @@ func resourceCdnProfileRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCdnProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cdn.ProfilesClient
+	client := meta.(*clients.Client).Cdn().ProfilesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
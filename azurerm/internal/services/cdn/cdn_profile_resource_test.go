@@ -147,7 +147,7 @@ func (r CdnProfileResource) Exists(ctx context.Context, client *clients.Client,
 		return nil, err
 	}
 
-	resp, err := client.Cdn.ProfilesClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Cdn().ProfilesClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
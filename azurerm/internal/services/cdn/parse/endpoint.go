@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EndpointId struct {
@@ -73,3 +74,12 @@ func EndpointID(input string) (*EndpointId, error) {
 
 	return &resourceId, nil
 }
+
+// EndpointIDNormalized parses a Endpoint ID into an EndpointId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the EndpointID method should be used instead for validation etc.
+func EndpointIDNormalized(input string) (*EndpointId, error) {
+	return EndpointID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cdn/profiles/%s/endpoints/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProfileId struct {
@@ -67,3 +68,12 @@ func ProfileID(input string) (*ProfileId, error) {
 
 	return &resourceId, nil
 }
+
+// ProfileIDNormalized parses a Profile ID into an ProfileId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ProfileID method should be used instead for validation etc.
+func ProfileIDNormalized(input string) (*ProfileId, error) {
+	return ProfileID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cdn/profiles/%s"))
+}
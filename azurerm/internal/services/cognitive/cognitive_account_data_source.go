@@ -68,7 +68,7 @@ func dataSourceCognitiveAccount() *schema.Resource {
 }
 
 func dataSourceCognitiveAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cognitive.AccountsClient
+	client := meta.(*clients.Client).Cognitive().AccountsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
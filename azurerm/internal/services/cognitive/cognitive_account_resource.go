@@ -130,7 +130,7 @@ func resourceCognitiveAccount() *schema.Resource {
 }
 
 func resourceCognitiveAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cognitive.AccountsClient
+	client := meta.(*clients.Client).Cognitive().AccountsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -201,7 +201,7 @@ func resourceCognitiveAccountCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceCognitiveAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cognitive.AccountsClient
+	client := meta.(*clients.Client).Cognitive().AccountsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -239,7 +239,7 @@ func resourceCognitiveAccountUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceCognitiveAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cognitive.AccountsClient
+	client := meta.(*clients.Client).Cognitive().AccountsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -294,7 +294,7 @@ func resourceCognitiveAccountRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceCognitiveAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cognitive.AccountsClient
+	client := meta.(*clients.Client).Cognitive().AccountsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
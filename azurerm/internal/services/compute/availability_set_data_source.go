@@ -58,7 +58,7 @@ func dataSourceAvailabilitySet() *schema.Resource {
 }
 
 func dataSourceAvailabilitySetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.AvailabilitySetsClient
+	client := meta.(*clients.Client).Compute().AvailabilitySetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -93,7 +93,7 @@ func resourceAvailabilitySet() *schema.Resource {
 }
 
 func resourceAvailabilitySetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.AvailabilitySetsClient
+	client := meta.(*clients.Client).Compute().AvailabilitySetsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -155,7 +155,7 @@ func resourceAvailabilitySetCreateUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAvailabilitySetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.AvailabilitySetsClient
+	client := meta.(*clients.Client).Compute().AvailabilitySetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -195,7 +195,7 @@ func resourceAvailabilitySetRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAvailabilitySetDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.AvailabilitySetsClient
+	client := meta.(*clients.Client).Compute().AvailabilitySetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -148,7 +148,7 @@ func (AvailabilitySetResource) Exists(ctx context.Context, clients *clients.Clie
 		return nil, err
 	}
 
-	resp, err := clients.Compute.AvailabilitySetsClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Compute().AvailabilitySetsClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Availability Set %q", id.String())
 	}
@@ -162,7 +162,7 @@ func (AvailabilitySetResource) Destroy(ctx context.Context, client *clients.Clie
 		return nil, err
 	}
 
-	resp, err := client.Compute.AvailabilitySetsClient.Delete(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Compute().AvailabilitySetsClient.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		if !response.WasNotFound(resp.Response) {
 			return nil, fmt.Errorf("deleting on availSetClient: %+v", err)
@@ -44,7 +44,7 @@ func dataSourceDedicatedHost() *schema.Resource {
 }
 
 func dataSourceDedicatedHostRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DedicatedHostsClient
+	client := meta.(*clients.Client).Compute().DedicatedHostsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
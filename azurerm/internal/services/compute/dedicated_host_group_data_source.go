@@ -54,7 +54,7 @@ func dataSourceDedicatedHostGroup() *schema.Resource {
 }
 
 func dataSourceDedicatedHostGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DedicatedHostGroupsClient
+	client := meta.(*clients.Client).Compute().DedicatedHostGroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
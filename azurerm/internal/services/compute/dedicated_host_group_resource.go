@@ -67,7 +67,7 @@ func resourceDedicatedHostGroup() *schema.Resource {
 }
 
 func resourceDedicatedHostGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DedicatedHostGroupsClient
+	client := meta.(*clients.Client).Compute().DedicatedHostGroupsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -118,7 +118,7 @@ func resourceDedicatedHostGroupCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceDedicatedHostGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DedicatedHostGroupsClient
+	client := meta.(*clients.Client).Compute().DedicatedHostGroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -157,7 +157,7 @@ func resourceDedicatedHostGroupRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceDedicatedHostGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DedicatedHostGroupsClient
+	client := meta.(*clients.Client).Compute().DedicatedHostGroupsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -177,7 +177,7 @@ func resourceDedicatedHostGroupUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceDedicatedHostGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DedicatedHostGroupsClient
+	client := meta.(*clients.Client).Compute().DedicatedHostGroupsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
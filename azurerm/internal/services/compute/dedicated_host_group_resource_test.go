@@ -74,7 +74,7 @@ func (t DedicatedHostGroupResource) Exists(ctx context.Context, clients *clients
 	resourceGroupName := id.ResourceGroup
 	name := id.Path["hostGroups"]
 
-	resp, err := clients.Compute.DedicatedHostGroupsClient.Get(ctx, resourceGroupName, name, "")
+	resp, err := clients.Compute().DedicatedHostGroupsClient.Get(ctx, resourceGroupName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Dedicated Host Group %q", id)
 	}
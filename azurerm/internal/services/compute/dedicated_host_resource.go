@@ -126,7 +126,7 @@ func resourceDedicatedHost() *schema.Resource {
 }
 
 func resourceDedicatedHostCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DedicatedHostsClient
+	client := meta.(*clients.Client).Compute().DedicatedHostsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -185,8 +185,8 @@ func resourceDedicatedHostCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceDedicatedHostRead(d *schema.ResourceData, meta interface{}) error {
-	groupsClient := meta.(*clients.Client).Compute.DedicatedHostGroupsClient
-	hostsClient := meta.(*clients.Client).Compute.DedicatedHostsClient
+	groupsClient := meta.(*clients.Client).Compute().DedicatedHostGroupsClient
+	hostsClient := meta.(*clients.Client).Compute().DedicatedHostsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -239,7 +239,7 @@ func resourceDedicatedHostRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDedicatedHostUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DedicatedHostsClient
+	client := meta.(*clients.Client).Compute().DedicatedHostsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -268,7 +268,7 @@ func resourceDedicatedHostUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceDedicatedHostDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DedicatedHostsClient
+	client := meta.(*clients.Client).Compute().DedicatedHostsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
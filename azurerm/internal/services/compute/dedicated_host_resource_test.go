@@ -173,7 +173,7 @@ func (t DedicatedHostResource) Exists(ctx context.Context, clients *clients.Clie
 		return nil, err
 	}
 
-	resp, err := clients.Compute.DedicatedHostsClient.Get(ctx, id.ResourceGroup, id.HostGroupName, id.HostName, "")
+	resp, err := clients.Compute().DedicatedHostsClient.Get(ctx, id.ResourceGroup, id.HostGroupName, id.HostName, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Dedicated Host %q", id.String())
 	}
@@ -34,7 +34,7 @@ func dataSourceDiskAccess() *schema.Resource {
 }
 
 func dataSourceDiskAccessRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DiskAccessClient
+	client := meta.(*clients.Client).Compute().DiskAccessClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -53,7 +53,7 @@ func resourceDiskAccess() *schema.Resource {
 }
 
 func resourceDiskAccessCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DiskAccessClient
+	client := meta.(*clients.Client).Compute().DiskAccessClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -106,7 +106,7 @@ func resourceDiskAccessCreateUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceDiskAccessRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DiskAccessClient
+	client := meta.(*clients.Client).Compute().DiskAccessClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -136,7 +136,7 @@ func resourceDiskAccessRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDiskAccessDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DiskAccessClient
+	client := meta.(*clients.Client).Compute().DiskAccessClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -70,7 +70,7 @@ func (t DiskAccessResource) Exists(ctx context.Context, clients *clients.Client,
 		return nil, err
 	}
 
-	resp, err := clients.Compute.DiskAccessClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Compute().DiskAccessClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Disk Access %q", id.String())
 	}
@@ -38,7 +38,7 @@ func dataSourceDiskEncryptionSet() *schema.Resource {
 }
 
 func dataSourceDiskEncryptionSetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DiskEncryptionSetsClient
+	client := meta.(*clients.Client).Compute().DiskEncryptionSetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
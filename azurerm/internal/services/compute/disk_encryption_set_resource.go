@@ -94,9 +94,9 @@ func resourceDiskEncryptionSet() *schema.Resource {
 }
 
 func resourceDiskEncryptionSetCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DiskEncryptionSetsClient
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	resourcesClient := meta.(*clients.Client).Resource
+	client := meta.(*clients.Client).Compute().DiskEncryptionSetsClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -164,7 +164,7 @@ func resourceDiskEncryptionSetCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceDiskEncryptionSetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DiskEncryptionSetsClient
+	client := meta.(*clients.Client).Compute().DiskEncryptionSetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -205,9 +205,9 @@ func resourceDiskEncryptionSetRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceDiskEncryptionSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DiskEncryptionSetsClient
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	resourcesClient := meta.(*clients.Client).Resource
+	client := meta.(*clients.Client).Compute().DiskEncryptionSetsClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -217,7 +217,7 @@ func resourceDiskEncryptionSetUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	update := compute.DiskEncryptionSetUpdate{}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -255,7 +255,7 @@ func resourceDiskEncryptionSetUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceDiskEncryptionSetDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DiskEncryptionSetsClient
+	client := meta.(*clients.Client).Compute().DiskEncryptionSetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
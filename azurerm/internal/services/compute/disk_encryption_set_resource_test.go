@@ -121,7 +121,7 @@ func (DiskEncryptionSetResource) Exists(ctx context.Context, clients *clients.Cl
 		return nil, err
 	}
 
-	resp, err := clients.Compute.DiskEncryptionSetsClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Compute().DiskEncryptionSetsClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Disk Encryption Set %q", id.String())
 	}
@@ -122,7 +122,7 @@ func dataSourceImage() *schema.Resource {
 }
 
 func dataSourceImageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.ImagesClient
+	client := meta.(*clients.Client).Compute().ImagesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
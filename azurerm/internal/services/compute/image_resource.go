@@ -187,7 +187,7 @@ func resourceImage() *schema.Resource {
 }
 
 func resourceImageCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.ImagesClient
+	client := meta.(*clients.Client).Compute().ImagesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -275,7 +275,7 @@ func resourceImageCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceImageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.ImagesClient
+	client := meta.(*clients.Client).Compute().ImagesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -324,7 +324,7 @@ func resourceImageRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceImageDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.ImagesClient
+	client := meta.(*clients.Client).Compute().ImagesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
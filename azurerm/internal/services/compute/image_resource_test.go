@@ -190,7 +190,7 @@ func (ImageResource) Exists(ctx context.Context, clients *clients.Client, state
 	resGroup := id.ResourceGroup
 	name := id.Path["images"]
 
-	resp, err := clients.Compute.ImagesClient.Get(ctx, resGroup, name, "")
+	resp, err := clients.Compute().ImagesClient.Get(ctx, resGroup, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Image %q", id)
 	}
@@ -217,7 +217,7 @@ func (ImageResource) generalizeVirtualMachine(data acceptance.TestData) func(con
 		}
 
 		log.Printf("[DEBUG] Retrieving Network Interface..")
-		nic, err := client.Network.InterfacesClient.Get(ctx, nicId.ResourceGroup, nicId.Name, "")
+		nic, err := client.Network().InterfacesClient.Get(ctx, nicId.ResourceGroup, nicId.Name, "")
 		if err != nil {
 			return fmt.Errorf("retrieving %s: %+v", *nicId, err)
 		}
@@ -253,7 +253,7 @@ func (ImageResource) generalizeVirtualMachine(data acceptance.TestData) func(con
 			return err
 		}
 
-		publicIpAddress, err := client.Network.PublicIPsClient.Get(ctx, publicIpId.ResourceGroup, publicIpId.Name, "")
+		publicIpAddress, err := client.Network().PublicIPsClient.Get(ctx, publicIpId.ResourceGroup, publicIpId.Name, "")
 		if err != nil {
 			return fmt.Errorf("retrieving %s: %+v", *publicIpId, err)
 		}
@@ -284,17 +284,17 @@ func (ImageResource) generalizeVirtualMachine(data acceptance.TestData) func(con
 		}
 
 		log.Printf("[DEBUG] Deallocating VM..")
-		future, err := client.Compute.VMClient.Deallocate(ctx, id.ResourceGroup, id.Name)
+		future, err := client.Compute().VMClient.Deallocate(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
 			return fmt.Errorf("Bad: deallocating vm: %+v", err)
 		}
 		log.Printf("[DEBUG] Waiting for Deallocation..")
-		if err = future.WaitForCompletionRef(ctx, client.Compute.VMClient.Client); err != nil {
+		if err = future.WaitForCompletionRef(ctx, client.Compute().VMClient.Client); err != nil {
 			return fmt.Errorf("Bad: waiting for deallocation: %+v", err)
 		}
 
 		log.Printf("[DEBUG] Generalizing VM..")
-		if _, err = client.Compute.VMClient.Generalize(ctx, id.ResourceGroup, id.Name); err != nil {
+		if _, err = client.Compute().VMClient.Generalize(ctx, id.ResourceGroup, id.Name); err != nil {
 			return fmt.Errorf("Bad: Generalizing error %+v", err)
 		}
 
@@ -308,7 +308,7 @@ func (ImageResource) virtualMachineExists(ctx context.Context, client *clients.C
 		return err
 	}
 
-	resp, err := client.Compute.VMClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := client.Compute().VMClient.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return fmt.Errorf("%s does not exist", *id)
@@ -326,7 +326,7 @@ func (ImageResource) virtualMachineScaleSetExists(ctx context.Context, client *c
 		return err
 	}
 
-	resp, err := client.Compute.VMScaleSetClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Compute().VMScaleSetClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return fmt.Errorf("%s does not exist", *id)
@@ -116,7 +116,7 @@ func dataSourceImages() *schema.Resource {
 }
 
 func dataSourceImagesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.ImagesClient
+	client := meta.(*clients.Client).Compute().ImagesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -285,14 +285,16 @@ func resourceLinuxVirtualMachine() *schema.Resource {
 }
 
 func resourceLinuxVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMClient
+	client := meta.(*clients.Client).Compute().VMClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(name, virtualMachineResourceName)
+	if err := locks.ByNameWithContext(ctx, name, virtualMachineResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, virtualMachineResourceName)
 
 	resp, err := client.Get(ctx, resourceGroup, name, "")
@@ -500,10 +502,10 @@ func resourceLinuxVirtualMachineCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLinuxVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMClient
-	disksClient := meta.(*clients.Client).Compute.DisksClient
-	networkInterfacesClient := meta.(*clients.Client).Network.InterfacesClient
-	publicIPAddressesClient := meta.(*clients.Client).Network.PublicIPsClient
+	client := meta.(*clients.Client).Compute().VMClient
+	disksClient := meta.(*clients.Client).Compute().DisksClient
+	networkInterfacesClient := meta.(*clients.Client).Network().InterfacesClient
+	publicIPAddressesClient := meta.(*clients.Client).Network().PublicIPsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -680,7 +682,7 @@ func resourceLinuxVirtualMachineRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceLinuxVirtualMachineUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMClient
+	client := meta.(*clients.Client).Compute().VMClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -689,7 +691,9 @@ func resourceLinuxVirtualMachineUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	locks.ByName(id.Name, virtualMachineResourceName)
+	if err := locks.ByNameWithContext(ctx, id.Name, virtualMachineResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.Name, virtualMachineResourceName)
 
 	log.Printf("[DEBUG] Retrieving Linux Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
@@ -883,7 +887,7 @@ func resourceLinuxVirtualMachineUpdate(d *schema.ResourceData, meta interface{})
 		update.OsProfile.AllowExtensionOperations = utils.Bool(allowExtensionOperations)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		shouldUpdate = true
 
 		tagsRaw := d.Get("tags").(map[string]interface{})
@@ -983,7 +987,7 @@ func resourceLinuxVirtualMachineUpdate(d *schema.ResourceData, meta interface{})
 		newSize := d.Get("os_disk.0.disk_size_gb").(int)
 		log.Printf("[DEBUG] Resizing OS Disk %q for Linux Virtual Machine %q (Resource Group %q) to %dGB..", diskName, id.Name, id.ResourceGroup, newSize)
 
-		disksClient := meta.(*clients.Client).Compute.DisksClient
+		disksClient := meta.(*clients.Client).Compute().DisksClient
 
 		update := compute.DiskUpdate{
 			DiskUpdateProperties: &compute.DiskUpdateProperties{
@@ -1008,7 +1012,7 @@ func resourceLinuxVirtualMachineUpdate(d *schema.ResourceData, meta interface{})
 			diskName := d.Get("os_disk.0.name").(string)
 			log.Printf("[DEBUG] Updating encryption settings of OS Disk %q for Linux Virtual Machine %q (Resource Group %q) to %q..", diskName, id.Name, id.ResourceGroup, diskEncryptionSetId)
 
-			disksClient := meta.(*clients.Client).Compute.DisksClient
+			disksClient := meta.(*clients.Client).Compute().DisksClient
 
 			update := compute.DiskUpdate{
 				DiskUpdateProperties: &compute.DiskUpdateProperties{
@@ -1067,7 +1071,7 @@ func resourceLinuxVirtualMachineUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLinuxVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMClient
+	client := meta.(*clients.Client).Compute().VMClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -1076,7 +1080,9 @@ func resourceLinuxVirtualMachineDelete(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	locks.ByName(id.Name, virtualMachineResourceName)
+	if err := locks.ByNameWithContext(ctx, id.Name, virtualMachineResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.Name, virtualMachineResourceName)
 
 	log.Printf("[DEBUG] Retrieving Linux Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
@@ -1126,7 +1132,7 @@ func resourceLinuxVirtualMachineDelete(d *schema.ResourceData, meta interface{})
 	deleteOSDisk := meta.(*clients.Client).Features.VirtualMachine.DeleteOSDiskOnDeletion
 	if deleteOSDisk {
 		log.Printf("[DEBUG] Deleting OS Disk from Linux Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
-		disksClient := meta.(*clients.Client).Compute.DisksClient
+		disksClient := meta.(*clients.Client).Compute().DisksClient
 		managedDiskId := ""
 		if props := existing.VirtualMachineProperties; props != nil && props.StorageProfile != nil && props.StorageProfile.OsDisk != nil {
 			if disk := props.StorageProfile.OsDisk.ManagedDisk; disk != nil && disk.ID != nil {
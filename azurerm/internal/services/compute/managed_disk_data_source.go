@@ -92,7 +92,7 @@ func dataSourceManagedDisk() *schema.Resource {
 }
 
 func dataSourceManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DisksClient
+	client := meta.(*clients.Client).Compute().DisksClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
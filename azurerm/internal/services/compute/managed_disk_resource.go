@@ -149,7 +149,7 @@ func resourceManagedDisk() *schema.Resource {
 }
 
 func resourceManagedDiskCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DisksClient
+	client := meta.(*clients.Client).Compute().DisksClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -290,7 +290,7 @@ func resourceManagedDiskCreateUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceManagedDiskUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DisksClient
+	client := meta.(*clients.Client).Compute().DisksClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -314,7 +314,7 @@ func resourceManagedDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		DiskUpdateProperties: &compute.DiskUpdateProperties{},
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
 		diskUpdate.Tags = tags.Expand(t)
 	}
@@ -385,9 +385,11 @@ func resourceManagedDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("Error parsing VMID %q for disk attachment: %+v", *disk.ManagedBy, err)
 		}
 		// check instanceView State
-		vmClient := meta.(*clients.Client).Compute.VMClient
+		vmClient := meta.(*clients.Client).Compute().VMClient
 
-		locks.ByName(name, virtualMachineResourceName)
+		if err := locks.ByNameWithContext(ctx, name, virtualMachineResourceName); err != nil {
+			return fmt.Errorf("acquiring lock for Managed Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
 		defer locks.UnlockByName(name, virtualMachineResourceName)
 
 		instanceView, err := vmClient.InstanceView(ctx, virtualMachine.ResourceGroup, virtualMachine.Name)
@@ -494,7 +496,7 @@ func resourceManagedDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DisksClient
+	client := meta.(*clients.Client).Compute().DisksClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -560,7 +562,7 @@ func resourceManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceManagedDiskDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DisksClient
+	client := meta.(*clients.Client).Compute().DisksClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
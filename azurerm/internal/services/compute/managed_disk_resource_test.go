@@ -328,7 +328,7 @@ func (ManagedDiskResource) Exists(ctx context.Context, clients *clients.Client,
 		return nil, err
 	}
 
-	resp, err := clients.Compute.DisksClient.Get(ctx, id.ResourceGroup, id.DiskName)
+	resp, err := clients.Compute().DisksClient.Get(ctx, id.ResourceGroup, id.DiskName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Managed Disk %q", id.String())
 	}
@@ -342,12 +342,12 @@ func (ManagedDiskResource) destroyVirtualMachine(ctx context.Context, client *cl
 
 	// this is a preview feature we don't want to use right now
 	var forceDelete *bool = nil
-	future, err := client.Compute.VMClient.Delete(ctx, resourceGroup, vmName, forceDelete)
+	future, err := client.Compute().VMClient.Delete(ctx, resourceGroup, vmName, forceDelete)
 	if err != nil {
 		return fmt.Errorf("Bad: Delete on vmClient: %+v", err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Compute.VMClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Compute().VMClient.Client); err != nil {
 		return fmt.Errorf("Bad: Delete on vmClient: %+v", err)
 	}
 
@@ -66,7 +66,7 @@ func resourceMarketplaceAgreement() *schema.Resource {
 }
 
 func resourceMarketplaceAgreementCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.MarketplaceAgreementsClient
+	client := meta.(*clients.Client).Compute().MarketplaceAgreementsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -127,7 +127,7 @@ func resourceMarketplaceAgreementCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceMarketplaceAgreementRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.MarketplaceAgreementsClient
+	client := meta.(*clients.Client).Compute().MarketplaceAgreementsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -168,7 +168,7 @@ func resourceMarketplaceAgreementRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceMarketplaceAgreementDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.MarketplaceAgreementsClient
+	client := meta.(*clients.Client).Compute().MarketplaceAgreementsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
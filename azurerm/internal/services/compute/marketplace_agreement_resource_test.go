@@ -95,7 +95,7 @@ func (t MarketplaceAgreementResource) Exists(ctx context.Context, clients *clien
 	offer := id.Path["offers"]
 	plan := id.Path["plans"]
 
-	resp, err := clients.Compute.MarketplaceAgreementsClient.Get(ctx, publisher, offer, plan)
+	resp, err := clients.Compute().MarketplaceAgreementsClient.Get(ctx, publisher, offer, plan)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Marketplace Agreement %q", id)
 	}
@@ -112,7 +112,7 @@ func (MarketplaceAgreementResource) Destroy(ctx context.Context, client *clients
 	offer := id.Path["offers"]
 	plan := id.Path["plans"]
 
-	resp, err := client.Compute.MarketplaceAgreementsClient.Cancel(ctx, publisher, offer, plan)
+	resp, err := client.Compute().MarketplaceAgreementsClient.Cancel(ctx, publisher, offer, plan)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return nil, fmt.Errorf("marketplace agreement %q does not exist", id)
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AvailabilitySetId struct {
@@ -67,3 +68,12 @@ func AvailabilitySetID(input string) (*AvailabilitySetId, error) {
 
 	return &resourceId, nil
 }
+
+// AvailabilitySetIDNormalized parses a AvailabilitySet ID into an AvailabilitySetId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the AvailabilitySetID method should be used instead for validation etc.
+func AvailabilitySetIDNormalized(input string) (*AvailabilitySetId, error) {
+	return AvailabilitySetID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/availabilitySets/%s"))
+}
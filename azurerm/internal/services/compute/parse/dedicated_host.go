@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DedicatedHostId struct {
@@ -73,3 +74,12 @@ func DedicatedHostID(input string) (*DedicatedHostId, error) {
 
 	return &resourceId, nil
 }
+
+// DedicatedHostIDNormalized parses a DedicatedHost ID into an DedicatedHostId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DedicatedHostID method should be used instead for validation etc.
+func DedicatedHostIDNormalized(input string) (*DedicatedHostId, error) {
+	return DedicatedHostID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/hostGroups/%s/hosts/%s"))
+}
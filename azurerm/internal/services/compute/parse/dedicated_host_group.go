@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DedicatedHostGroupId struct {
@@ -67,3 +68,12 @@ func DedicatedHostGroupID(input string) (*DedicatedHostGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// DedicatedHostGroupIDNormalized parses a DedicatedHostGroup ID into an DedicatedHostGroupId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DedicatedHostGroupID method should be used instead for validation etc.
+func DedicatedHostGroupIDNormalized(input string) (*DedicatedHostGroupId, error) {
+	return DedicatedHostGroupID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/hostGroups/%s"))
+}
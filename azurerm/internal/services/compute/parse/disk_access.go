@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DiskAccessId struct {
@@ -67,3 +68,12 @@ func DiskAccessID(input string) (*DiskAccessId, error) {
 
 	return &resourceId, nil
 }
+
+// DiskAccessIDNormalized parses a DiskAccess ID into an DiskAccessId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DiskAccessID method should be used instead for validation etc.
+func DiskAccessIDNormalized(input string) (*DiskAccessId, error) {
+	return DiskAccessID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/diskAccesses/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DiskEncryptionSetId struct {
@@ -67,3 +68,12 @@ func DiskEncryptionSetID(input string) (*DiskEncryptionSetId, error) {
 
 	return &resourceId, nil
 }
+
+// DiskEncryptionSetIDNormalized parses a DiskEncryptionSet ID into an DiskEncryptionSetId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DiskEncryptionSetID method should be used instead for validation etc.
+func DiskEncryptionSetIDNormalized(input string) (*DiskEncryptionSetId, error) {
+	return DiskEncryptionSetID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/diskEncryptionSets/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ImageId struct {
@@ -67,3 +68,12 @@ func ImageID(input string) (*ImageId, error) {
 
 	return &resourceId, nil
 }
+
+// ImageIDNormalized parses a Image ID into an ImageId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ImageID method should be used instead for validation etc.
+func ImageIDNormalized(input string) (*ImageId, error) {
+	return ImageID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/images/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ManagedDiskId struct {
@@ -67,3 +68,12 @@ func ManagedDiskID(input string) (*ManagedDiskId, error) {
 
 	return &resourceId, nil
 }
+
+// ManagedDiskIDNormalized parses a ManagedDisk ID into an ManagedDiskId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ManagedDiskID method should be used instead for validation etc.
+func ManagedDiskIDNormalized(input string) (*ManagedDiskId, error) {
+	return ManagedDiskID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/disks/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProximityPlacementGroupId struct {
@@ -67,3 +68,12 @@ func ProximityPlacementGroupID(input string) (*ProximityPlacementGroupId, error)
 
 	return &resourceId, nil
 }
+
+// ProximityPlacementGroupIDNormalized parses a ProximityPlacementGroup ID into an ProximityPlacementGroupId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ProximityPlacementGroupID method should be used instead for validation etc.
+func ProximityPlacementGroupIDNormalized(input string) (*ProximityPlacementGroupId, error) {
+	return ProximityPlacementGroupID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/proximityPlacementGroups/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SharedImageId struct {
@@ -73,3 +74,12 @@ func SharedImageID(input string) (*SharedImageId, error) {
 
 	return &resourceId, nil
 }
+
+// SharedImageIDNormalized parses a SharedImage ID into an SharedImageId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SharedImageID method should be used instead for validation etc.
+func SharedImageIDNormalized(input string) (*SharedImageId, error) {
+	return SharedImageID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s/images/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SharedImageGalleryId struct {
@@ -67,3 +68,12 @@ func SharedImageGalleryID(input string) (*SharedImageGalleryId, error) {
 
 	return &resourceId, nil
 }
+
+// SharedImageGalleryIDNormalized parses a SharedImageGallery ID into an SharedImageGalleryId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SharedImageGalleryID method should be used instead for validation etc.
+func SharedImageGalleryIDNormalized(input string) (*SharedImageGalleryId, error) {
+	return SharedImageGalleryID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s"))
+}
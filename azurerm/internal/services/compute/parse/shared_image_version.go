@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SharedImageVersionId struct {
@@ -79,3 +80,12 @@ func SharedImageVersionID(input string) (*SharedImageVersionId, error) {
 
 	return &resourceId, nil
 }
+
+// SharedImageVersionIDNormalized parses a SharedImageVersion ID into an SharedImageVersionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SharedImageVersionID method should be used instead for validation etc.
+func SharedImageVersionIDNormalized(input string) (*SharedImageVersionId, error) {
+	return SharedImageVersionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s/images/%s/versions/%s"))
+}
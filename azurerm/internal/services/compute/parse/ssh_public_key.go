@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SSHPublicKeyId struct {
@@ -67,3 +68,12 @@ func SSHPublicKeyID(input string) (*SSHPublicKeyId, error) {
 
 	return &resourceId, nil
 }
+
+// SSHPublicKeyIDNormalized parses a SSHPublicKey ID into an SSHPublicKeyId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SSHPublicKeyID method should be used instead for validation etc.
+func SSHPublicKeyIDNormalized(input string) (*SSHPublicKeyId, error) {
+	return SSHPublicKeyID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/sshPublicKeys/%s"))
+}
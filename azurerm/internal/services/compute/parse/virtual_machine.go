@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualMachineId struct {
@@ -67,3 +68,12 @@ func VirtualMachineID(input string) (*VirtualMachineId, error) {
 
 	return &resourceId, nil
 }
+
+// VirtualMachineIDNormalized parses a VirtualMachine ID into an VirtualMachineId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VirtualMachineID method should be used instead for validation etc.
+func VirtualMachineIDNormalized(input string) (*VirtualMachineId, error) {
+	return VirtualMachineID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s"))
+}
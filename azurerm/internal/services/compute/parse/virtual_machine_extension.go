@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualMachineExtensionId struct {
@@ -73,3 +74,12 @@ func VirtualMachineExtensionID(input string) (*VirtualMachineExtensionId, error)
 
 	return &resourceId, nil
 }
+
+// VirtualMachineExtensionIDNormalized parses a VirtualMachineExtension ID into an VirtualMachineExtensionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VirtualMachineExtensionID method should be used instead for validation etc.
+func VirtualMachineExtensionIDNormalized(input string) (*VirtualMachineExtensionId, error) {
+	return VirtualMachineExtensionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s/extensions/%s"))
+}
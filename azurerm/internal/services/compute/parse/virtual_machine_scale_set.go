@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualMachineScaleSetId struct {
@@ -67,3 +68,12 @@ func VirtualMachineScaleSetID(input string) (*VirtualMachineScaleSetId, error) {
 
 	return &resourceId, nil
 }
+
+// VirtualMachineScaleSetIDNormalized parses a VirtualMachineScaleSet ID into an VirtualMachineScaleSetId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VirtualMachineScaleSetID method should be used instead for validation etc.
+func VirtualMachineScaleSetIDNormalized(input string) (*VirtualMachineScaleSetId, error) {
+	return VirtualMachineScaleSetID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachineScaleSets/%s"))
+}
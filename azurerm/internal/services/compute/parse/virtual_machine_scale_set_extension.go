@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualMachineScaleSetExtensionId struct {
@@ -73,3 +74,12 @@ func VirtualMachineScaleSetExtensionID(input string) (*VirtualMachineScaleSetExt
 
 	return &resourceId, nil
 }
+
+// VirtualMachineScaleSetExtensionIDNormalized parses a VirtualMachineScaleSetExtension ID into an VirtualMachineScaleSetExtensionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VirtualMachineScaleSetExtensionID method should be used instead for validation etc.
+func VirtualMachineScaleSetExtensionIDNormalized(input string) (*VirtualMachineScaleSetExtensionId, error) {
+	return VirtualMachineScaleSetExtensionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachineScaleSets/%s/extensions/%s"))
+}
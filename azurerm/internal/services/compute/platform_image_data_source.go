@@ -48,7 +48,7 @@ func dataSourcePlatformImage() *schema.Resource {
 }
 
 func dataSourcePlatformImageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMImageClient
+	client := meta.(*clients.Client).Compute().VMImageClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
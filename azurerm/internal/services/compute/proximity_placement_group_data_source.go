@@ -38,7 +38,7 @@ func dataSourceProximityPlacementGroup() *schema.Resource {
 }
 
 func dataSourceProximityPlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.ProximityPlacementGroupsClient
+	client := meta.(*clients.Client).Compute().ProximityPlacementGroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
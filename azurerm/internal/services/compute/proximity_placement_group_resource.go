@@ -52,7 +52,7 @@ func resourceProximityPlacementGroup() *schema.Resource {
 }
 
 func resourceProximityPlacementGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.ProximityPlacementGroupsClient
+	client := meta.(*clients.Client).Compute().ProximityPlacementGroupsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -91,7 +91,7 @@ func resourceProximityPlacementGroupCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceProximityPlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.ProximityPlacementGroupsClient
+	client := meta.(*clients.Client).Compute().ProximityPlacementGroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -121,7 +121,7 @@ func resourceProximityPlacementGroupRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceProximityPlacementGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.ProximityPlacementGroupsClient
+	client := meta.(*clients.Client).Compute().ProximityPlacementGroupsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
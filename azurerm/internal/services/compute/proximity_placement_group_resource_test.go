@@ -96,7 +96,7 @@ func (t ProximityPlacementGroupResource) Exists(ctx context.Context, clients *cl
 		return nil, err
 	}
 
-	resp, err := clients.Compute.ProximityPlacementGroupsClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := clients.Compute().ProximityPlacementGroupsClient.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Proximity Placement Group %q", id)
 	}
@@ -110,7 +110,7 @@ func (ProximityPlacementGroupResource) Destroy(ctx context.Context, client *clie
 		return nil, err
 	}
 
-	resp, err := client.Compute.ProximityPlacementGroupsClient.Delete(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Compute().ProximityPlacementGroupsClient.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		if !response.WasNotFound(resp.Response) {
 			return nil, fmt.Errorf("deleting Proximity Placement Group %q: %+v", id, err)
@@ -101,7 +101,7 @@ func dataSourceSharedImage() *schema.Resource {
 }
 
 func dataSourceSharedImageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleryImagesClient
+	client := meta.(*clients.Client).Compute().GalleryImagesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -48,7 +48,7 @@ func dataSourceSharedImageGallery() *schema.Resource {
 }
 
 func dataSourceSharedImageGalleryRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleriesClient
+	client := meta.(*clients.Client).Compute().GalleriesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
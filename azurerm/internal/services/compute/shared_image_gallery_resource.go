@@ -65,7 +65,7 @@ func resourceSharedImageGallery() *schema.Resource {
 }
 
 func resourceSharedImageGalleryCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleriesClient
+	client := meta.(*clients.Client).Compute().GalleriesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -122,7 +122,7 @@ func resourceSharedImageGalleryCreateUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceSharedImageGalleryRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleriesClient
+	client := meta.(*clients.Client).Compute().GalleriesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -159,7 +159,7 @@ func resourceSharedImageGalleryRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceSharedImageGalleryDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleriesClient
+	client := meta.(*clients.Client).Compute().GalleriesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -77,7 +77,7 @@ func (t SharedImageGalleryResource) Exists(ctx context.Context, clients *clients
 		return nil, err
 	}
 
-	resp, err := clients.Compute.GalleriesClient.Get(ctx, id.ResourceGroup, id.GalleryName)
+	resp, err := clients.Compute().GalleriesClient.Get(ctx, id.ResourceGroup, id.GalleryName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Shared Image Gallery %q", id.String())
 	}
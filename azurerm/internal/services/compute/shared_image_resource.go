@@ -162,7 +162,7 @@ func resourceSharedImage() *schema.Resource {
 }
 
 func resourceSharedImageCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleryImagesClient
+	client := meta.(*clients.Client).Compute().GalleryImagesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -230,7 +230,7 @@ func resourceSharedImageCreateUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceSharedImageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleryImagesClient
+	client := meta.(*clients.Client).Compute().GalleryImagesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -279,7 +279,7 @@ func resourceSharedImageRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSharedImageDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleryImagesClient
+	client := meta.(*clients.Client).Compute().GalleryImagesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
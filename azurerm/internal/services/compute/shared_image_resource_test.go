@@ -104,7 +104,7 @@ func (t SharedImageResource) Exists(ctx context.Context, clients *clients.Client
 		return nil, err
 	}
 
-	resp, err := clients.Compute.GalleryImagesClient.Get(ctx, id.ResourceGroup, id.GalleryName, id.ImageName)
+	resp, err := clients.Compute().GalleryImagesClient.Get(ctx, id.ResourceGroup, id.GalleryName, id.ImageName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Shared Image %q", id.String())
 	}
@@ -95,7 +95,7 @@ func dataSourceSharedImageVersion() *schema.Resource {
 }
 
 func dataSourceSharedImageVersionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleryImageVersionsClient
+	client := meta.(*clients.Client).Compute().GalleryImageVersionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -132,7 +132,7 @@ func resourceSharedImageVersion() *schema.Resource {
 }
 
 func resourceSharedImageVersionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleryImageVersionsClient
+	client := meta.(*clients.Client).Compute().GalleryImageVersionsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -200,7 +200,7 @@ func resourceSharedImageVersionCreateUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceSharedImageVersionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleryImageVersionsClient
+	client := meta.(*clients.Client).Compute().GalleryImageVersionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -254,7 +254,7 @@ func resourceSharedImageVersionRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceSharedImageVersionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleryImageVersionsClient
+	client := meta.(*clients.Client).Compute().GalleryImageVersionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
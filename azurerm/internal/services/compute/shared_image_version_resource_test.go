@@ -172,7 +172,7 @@ func (r SharedImageVersionResource) Exists(ctx context.Context, clients *clients
 		return nil, err
 	}
 
-	resp, err := clients.Compute.GalleryImageVersionsClient.Get(ctx, id.ResourceGroup, id.GalleryName, id.ImageName, id.VersionName, compute.ReplicationStatusTypesReplicationStatus)
+	resp, err := clients.Compute().GalleryImageVersionsClient.Get(ctx, id.ResourceGroup, id.GalleryName, id.ImageName, id.VersionName, compute.ReplicationStatusTypesReplicationStatus)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Shared Image Gallery %q", id.String())
 	}
@@ -184,11 +184,11 @@ func (SharedImageVersionResource) revokeSnapshot(ctx context.Context, client *cl
 	snapShotName := state.Attributes["name"]
 	resourceGroup := state.Attributes["resource_group_name"]
 
-	future, err := client.Compute.SnapshotsClient.RevokeAccess(ctx, resourceGroup, snapShotName)
+	future, err := client.Compute().SnapshotsClient.RevokeAccess(ctx, resourceGroup, snapShotName)
 	if err != nil {
 		return fmt.Errorf("bad: cannot revoke SAS on the snapshot: %+v", err)
 	}
-	if err := future.WaitForCompletionRef(ctx, client.Compute.SnapshotsClient.Client); err != nil {
+	if err := future.WaitForCompletionRef(ctx, client.Compute().SnapshotsClient.Client); err != nil {
 		return fmt.Errorf("bad: waiting the revoke of SAS on the snapshot: %+v", err)
 	}
 
@@ -93,7 +93,7 @@ func dataSourceSharedImageVersions() *schema.Resource {
 }
 
 func dataSourceSharedImageVersionsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleryImageVersionsClient
+	client := meta.(*clients.Client).Compute().GalleryImageVersionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -89,7 +89,7 @@ func resourceSnapshot() *schema.Resource {
 }
 
 func resourceSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.SnapshotsClient
+	client := meta.(*clients.Client).Compute().SnapshotsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -165,7 +165,7 @@ func resourceSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceSnapshotRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.SnapshotsClient
+	client := meta.(*clients.Client).Compute().SnapshotsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -216,7 +216,7 @@ func resourceSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.SnapshotsClient
+	client := meta.(*clients.Client).Compute().SnapshotsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
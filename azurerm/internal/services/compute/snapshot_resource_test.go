@@ -187,7 +187,7 @@ func (t SnapshotResource) Exists(ctx context.Context, clients *clients.Client, s
 	resourceGroup := id.ResourceGroup
 	name := id.Path["snapshots"]
 
-	resp, err := clients.Compute.SnapshotsClient.Get(ctx, resourceGroup, name)
+	resp, err := clients.Compute().SnapshotsClient.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Shared Image Gallery %q", id)
 	}
@@ -46,7 +46,7 @@ func dataSourceSshPublicKey() *schema.Resource {
 }
 
 func dataSourceSshPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.SSHPublicKeysClient
+	client := meta.(*clients.Client).Compute().SSHPublicKeysClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
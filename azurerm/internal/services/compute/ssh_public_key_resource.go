@@ -67,7 +67,7 @@ func resourceSshPublicKey() *schema.Resource {
 }
 
 func resourceSshPublicKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.SSHPublicKeysClient
+	client := meta.(*clients.Client).Compute().SSHPublicKeysClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -117,7 +117,7 @@ func resourceSshPublicKeyCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceSshPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.SSHPublicKeysClient
+	client := meta.(*clients.Client).Compute().SSHPublicKeysClient
 
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -152,7 +152,7 @@ func resourceSshPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSshPublicKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.SSHPublicKeysClient
+	client := meta.(*clients.Client).Compute().SSHPublicKeysClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -181,7 +181,7 @@ func resourceSshPublicKeyUpdate(d *schema.ResourceData, meta interface{}) error
 		SSHPublicKeyResourceProperties: &props,
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagsRaw := d.Get("tags").(map[string]interface{})
 		update.Tags = tags.Expand(tagsRaw)
 	}
@@ -198,7 +198,7 @@ func resourceSshPublicKeyUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceSshPublicKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.SSHPublicKeysClient
+	client := meta.(*clients.Client).Compute().SSHPublicKeysClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
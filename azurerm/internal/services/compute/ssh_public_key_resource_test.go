@@ -50,7 +50,7 @@ func (t SSHPublicKeyResource) Exists(ctx context.Context, clients *clients.Clien
 		return nil, err
 	}
 
-	resp, err := clients.Compute.SSHPublicKeysClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Compute().SSHPublicKeysClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute SSH Public Key %q", id.String())
 	}
@@ -90,7 +90,7 @@ func resourceVirtualMachineDataDiskAttachment() *schema.Resource {
 }
 
 func resourceVirtualMachineDataDiskAttachmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMClient
+	client := meta.(*clients.Client).Compute().VMClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -103,7 +103,9 @@ func resourceVirtualMachineDataDiskAttachmentCreateUpdate(d *schema.ResourceData
 	resourceGroup := parsedVirtualMachineId.ResourceGroup
 	virtualMachineName := parsedVirtualMachineId.Path["virtualMachines"]
 
-	locks.ByName(virtualMachineName, virtualMachineResourceName)
+	if err := locks.ByNameWithContext(ctx, virtualMachineName, virtualMachineResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Data Disk Attachment (Virtual Machine %q / Resource Group %q): %+v", virtualMachineName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(virtualMachineName, virtualMachineResourceName)
 
 	virtualMachine, err := client.Get(ctx, resourceGroup, virtualMachineName, "")
@@ -192,7 +194,7 @@ func resourceVirtualMachineDataDiskAttachmentCreateUpdate(d *schema.ResourceData
 }
 
 func resourceVirtualMachineDataDiskAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMClient
+	client := meta.(*clients.Client).Compute().VMClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -252,7 +254,7 @@ func resourceVirtualMachineDataDiskAttachmentRead(d *schema.ResourceData, meta i
 }
 
 func resourceVirtualMachineDataDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMClient
+	client := meta.(*clients.Client).Compute().VMClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -265,7 +267,9 @@ func resourceVirtualMachineDataDiskAttachmentDelete(d *schema.ResourceData, meta
 	virtualMachineName := id.Path["virtualMachines"]
 	name := id.Path["dataDisks"]
 
-	locks.ByName(virtualMachineName, virtualMachineResourceName)
+	if err := locks.ByNameWithContext(ctx, virtualMachineName, virtualMachineResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Data Disk Attachment %q (Virtual Machine %q / Resource Group %q): %+v", name, virtualMachineName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(virtualMachineName, virtualMachineResourceName)
 
 	virtualMachine, err := client.Get(ctx, resourceGroup, virtualMachineName, "")
@@ -305,7 +309,7 @@ func resourceVirtualMachineDataDiskAttachmentDelete(d *schema.ResourceData, meta
 }
 
 func retrieveDataDiskAttachmentManagedDisk(d *schema.ResourceData, meta interface{}, id string) (*compute.Disk, error) {
-	client := meta.(*clients.Client).Compute.DisksClient
+	client := meta.(*clients.Client).Compute().DisksClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -199,7 +199,7 @@ func (t VirtualMachineDataDiskAttachmentResource) Exists(ctx context.Context, cl
 	virtualMachineName := id.Path["virtualMachines"]
 	name := id.Path["dataDisks"]
 
-	resp, err := clients.Compute.VMClient.Get(ctx, resourceGroup, virtualMachineName, "")
+	resp, err := clients.Compute().VMClient.Get(ctx, resourceGroup, virtualMachineName, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Virtual Machine Data Disk Attachment %q", id)
 	}
@@ -229,7 +229,7 @@ func (VirtualMachineDataDiskAttachmentResource) Destroy(ctx context.Context, cli
 	virtualMachineName := id.Path["virtualMachines"]
 	name := id.Path["dataDisks"]
 
-	resp, err := client.Compute.VMClient.Get(ctx, resourceGroup, virtualMachineName, "")
+	resp, err := client.Compute().VMClient.Get(ctx, resourceGroup, virtualMachineName, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Virtual Machine Data Disk Attachment %q", id)
 	}
@@ -250,12 +250,12 @@ func (VirtualMachineDataDiskAttachmentResource) Destroy(ctx context.Context, cli
 	// fixes #1600
 	resp.Resources = nil
 
-	future, err := client.Compute.VMClient.CreateOrUpdate(ctx, resourceGroup, virtualMachineName, resp)
+	future, err := client.Compute().VMClient.CreateOrUpdate(ctx, resourceGroup, virtualMachineName, resp)
 	if err != nil {
 		return nil, fmt.Errorf("updating Virtual Machine %q: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Compute.VMClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Compute().VMClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for Virtual Machine %q: %+v", id, err)
 	}
 
@@ -66,7 +66,7 @@ func dataSourceVirtualMachine() *schema.Resource {
 }
 
 func dataSourceVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMClient
+	client := meta.(*clients.Client).Compute().VMClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
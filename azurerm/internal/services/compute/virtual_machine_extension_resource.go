@@ -93,8 +93,8 @@ func resourceVirtualMachineExtension() *schema.Resource {
 }
 
 func resourceVirtualMachineExtensionsCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	vmExtensionClient := meta.(*clients.Client).Compute.VMExtensionClient
-	vmClient := meta.(*clients.Client).Compute.VMClient
+	vmExtensionClient := meta.(*clients.Client).Compute().VMExtensionClient
+	vmClient := meta.(*clients.Client).Compute().VMClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -186,8 +186,8 @@ func resourceVirtualMachineExtensionsCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceVirtualMachineExtensionsRead(d *schema.ResourceData, meta interface{}) error {
-	vmExtensionClient := meta.(*clients.Client).Compute.VMExtensionClient
-	vmClient := meta.(*clients.Client).Compute.VMClient
+	vmExtensionClient := meta.(*clients.Client).Compute().VMExtensionClient
+	vmClient := meta.(*clients.Client).Compute().VMClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -238,7 +238,7 @@ func resourceVirtualMachineExtensionsRead(d *schema.ResourceData, meta interface
 }
 
 func resourceVirtualMachineExtensionsDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMExtensionClient
+	client := meta.(*clients.Client).Compute().VMExtensionClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
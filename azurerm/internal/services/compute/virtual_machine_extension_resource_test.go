@@ -97,7 +97,7 @@ func (t VirtualMachineExtensionResource) Exists(ctx context.Context, clients *cl
 		return nil, err
 	}
 
-	resp, err := clients.Compute.VMExtensionClient.Get(ctx, id.ResourceGroup, id.VirtualMachineName, id.ExtensionName, "")
+	resp, err := clients.Compute().VMExtensionClient.Get(ctx, id.ResourceGroup, id.VirtualMachineName, id.ExtensionName, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Virtual Machine Extension %q", id.String())
 	}
@@ -17,7 +17,7 @@ func importVirtualMachine(osType compute.OperatingSystemTypes, resourceType stri
 			return []*schema.ResourceData{}, err
 		}
 
-		client := meta.(*clients.Client).Compute.VMClient
+		client := meta.(*clients.Client).Compute().VMClient
 		ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 		defer cancel()
 
@@ -627,7 +627,7 @@ func resourceVirtualMachine() *schema.Resource {
 }
 
 func resourceVirtualMachineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMClient
+	client := meta.(*clients.Client).Compute().VMClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -742,7 +742,9 @@ func resourceVirtualMachineCreateUpdate(d *schema.ResourceData, meta interface{}
 		vm.Plan = expandAzureRmVirtualMachinePlan(d)
 	}
 
-	locks.ByName(name, virtualMachineResourceName)
+	if err := locks.ByNameWithContext(ctx, name, virtualMachineResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+	}
 	defer locks.UnlockByName(name, virtualMachineResourceName)
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, vm)
@@ -786,7 +788,7 @@ func resourceVirtualMachineCreateUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
-	vmclient := meta.(*clients.Client).Compute.VMClient
+	vmclient := meta.(*clients.Client).Compute().VMClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -920,7 +922,7 @@ func resourceVirtualMachineRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMClient
+	client := meta.(*clients.Client).Compute().VMClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -931,7 +933,9 @@ func resourceVirtualMachineDelete(d *schema.ResourceData, meta interface{}) erro
 	resGroup := id.ResourceGroup
 	name := id.Path["virtualMachines"]
 
-	locks.ByName(name, virtualMachineResourceName)
+	if err := locks.ByNameWithContext(ctx, name, virtualMachineResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+	}
 	defer locks.UnlockByName(name, virtualMachineResourceName)
 
 	virtualMachine, err := client.Get(ctx, resGroup, name, "")
@@ -956,7 +960,7 @@ func resourceVirtualMachineDelete(d *schema.ResourceData, meta interface{}) erro
 	deleteDataDisks := d.Get("delete_data_disks_on_termination").(bool)
 
 	if deleteOsDisk || deleteDataDisks {
-		storageClient := meta.(*clients.Client).Storage
+		storageClient := meta.(*clients.Client).Storage()
 
 		props := virtualMachine.VirtualMachineProperties
 		if props == nil {
@@ -1068,7 +1072,7 @@ func resourceVirtualMachineDeleteManagedDisk(d *schema.ResourceData, disk *compu
 	}
 	managedDiskID := *disk.ID
 
-	client := meta.(*clients.Client).Compute.DisksClient
+	client := meta.(*clients.Client).Compute().DisksClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -1953,7 +1957,7 @@ func resourceVirtualMachineStorageImageReferenceHash(v interface{}) int {
 }
 
 func resourceVirtualMachineGetManagedDiskInfo(d *schema.ResourceData, disk *compute.ManagedDiskParameters, meta interface{}) (*compute.Disk, error) {
-	client := meta.(*clients.Client).Compute.DisksClient
+	client := meta.(*clients.Client).Compute().DisksClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -1978,8 +1982,8 @@ func resourceVirtualMachineGetManagedDiskInfo(d *schema.ResourceData, disk *comp
 }
 
 func determineVirtualMachineIPAddress(ctx context.Context, meta interface{}, props *compute.VirtualMachineProperties) (string, error) {
-	nicClient := meta.(*clients.Client).Network.InterfacesClient
-	pipClient := meta.(*clients.Client).Network.PublicIPsClient
+	nicClient := meta.(*clients.Client).Network().InterfacesClient
+	pipClient := meta.(*clients.Client).Network().PublicIPsClient
 
 	if props == nil {
 		return "", nil
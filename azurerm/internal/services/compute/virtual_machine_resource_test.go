@@ -109,7 +109,7 @@ func (VirtualMachineResource) Exists(ctx context.Context, clients *clients.Clien
 	resGroup := id.ResourceGroup
 	name := id.Path["virtualMachines"]
 
-	resp, err := clients.Compute.VMClient.Get(ctx, resGroup, name, "")
+	resp, err := clients.Compute().VMClient.Get(ctx, resGroup, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Virtual Machine %q", id)
 	}
@@ -124,7 +124,7 @@ func (VirtualMachineResource) managedDiskExists(diskId string, shouldExist bool)
 			return err
 		}
 
-		disk, err := clients.Compute.DisksClient.Get(ctx, id.ResourceGroup, id.DiskName)
+		disk, err := clients.Compute().DisksClient.Get(ctx, id.ResourceGroup, id.DiskName)
 		if err != nil {
 			if utils.ResponseWasNotFound(disk.Response) {
 				if !shouldExist {
@@ -151,7 +151,7 @@ func (VirtualMachineResource) findManagedDiskID(field string, managedDiskID *str
 			return err
 		}
 
-		virtualMachine, err := clients.Compute.VMClient.Get(ctx, id.ResourceGroup, id.Name, "")
+		virtualMachine, err := clients.Compute().VMClient.Get(ctx, id.ResourceGroup, id.Name, "")
 		if err != nil {
 			return err
 		}
@@ -199,12 +199,12 @@ func (VirtualMachineResource) deallocate(ctx context.Context, client *clients.Cl
 	name := vmID.Name
 	resourceGroup := vmID.ResourceGroup
 
-	future, err := client.Compute.VMClient.Deallocate(ctx, resourceGroup, name)
+	future, err := client.Compute().VMClient.Deallocate(ctx, resourceGroup, name)
 	if err != nil {
 		return fmt.Errorf("Failed stopping virtual machine %q: %+v", resourceGroup, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Compute.VMClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Compute().VMClient.Client); err != nil {
 		return fmt.Errorf("Failed long polling for the stop of virtual machine %q: %+v", resourceGroup, err)
 	}
 
@@ -216,7 +216,7 @@ func (VirtualMachineResource) unmanagedDiskExistsInContainer(blobName string, sh
 		accountName := state.Attributes["storage_account_name"]
 		containerName := state.Attributes["name"]
 
-		account, err := clients.Storage.FindAccount(ctx, accountName)
+		account, err := clients.Storage().FindAccount(ctx, accountName)
 		if err != nil {
 			return fmt.Errorf("Error retrieving Account %q for Blob %q (Container %q): %s", accountName, blobName, containerName, err)
 		}
@@ -224,7 +224,7 @@ func (VirtualMachineResource) unmanagedDiskExistsInContainer(blobName string, sh
 			return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
 		}
 
-		client, err := clients.Storage.BlobsClient(ctx, *account)
+		client, err := clients.Storage().BlobsClient(ctx, *account)
 		if err != nil {
 			return fmt.Errorf("Error building Blobs Client: %s", err)
 		}
@@ -257,12 +257,12 @@ func (VirtualMachineResource) Destroy(ctx context.Context, client *clients.Clien
 
 	// this is a preview feature we don't want to use right now
 	var forceDelete *bool = nil
-	future, err := client.Compute.VMClient.Delete(ctx, resourceGroup, vmName, forceDelete)
+	future, err := client.Compute().VMClient.Delete(ctx, resourceGroup, vmName, forceDelete)
 	if err != nil {
 		return nil, fmt.Errorf("Bad: Delete on vmClient: %+v", err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Compute.VMClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Compute().VMClient.Client); err != nil {
 		return nil, fmt.Errorf("Bad: Delete on vmClient: %+v", err)
 	}
 
@@ -113,7 +113,7 @@ func resourceVirtualMachineScaleSetExtension() *schema.Resource {
 }
 
 func resourceVirtualMachineScaleSetExtensionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMScaleSetExtensionsClient
+	client := meta.(*clients.Client).Compute().VMScaleSetExtensionsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -192,7 +192,7 @@ func resourceVirtualMachineScaleSetExtensionCreate(d *schema.ResourceData, meta
 }
 
 func resourceVirtualMachineScaleSetExtensionUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMScaleSetExtensionsClient
+	client := meta.(*clients.Client).Compute().VMScaleSetExtensionsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -271,8 +271,8 @@ func resourceVirtualMachineScaleSetExtensionUpdate(d *schema.ResourceData, meta
 }
 
 func resourceVirtualMachineScaleSetExtensionRead(d *schema.ResourceData, meta interface{}) error {
-	vmssClient := meta.(*clients.Client).Compute.VMScaleSetClient
-	client := meta.(*clients.Client).Compute.VMScaleSetExtensionsClient
+	vmssClient := meta.(*clients.Client).Compute().VMScaleSetClient
+	client := meta.(*clients.Client).Compute().VMScaleSetExtensionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -332,7 +332,7 @@ func resourceVirtualMachineScaleSetExtensionRead(d *schema.ResourceData, meta in
 }
 
 func resourceVirtualMachineScaleSetExtensionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMScaleSetExtensionsClient
+	client := meta.(*clients.Client).Compute().VMScaleSetExtensionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -171,7 +171,7 @@ func (t VirtualMachineScaleSetExtensionResource) Exists(ctx context.Context, cli
 		return nil, err
 	}
 
-	resp, err := clients.Compute.VMScaleSetExtensionsClient.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.ExtensionName, "")
+	resp, err := clients.Compute().VMScaleSetExtensionsClient.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.ExtensionName, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Virtual Machine Scale Set Extension %q", id.String())
 	}
@@ -16,7 +16,7 @@ func importOrchestratedVirtualMachineScaleSet(d *schema.ResourceData, meta inter
 		return []*schema.ResourceData{}, err
 	}
 
-	client := meta.(*clients.Client).Compute.VMScaleSetClient
+	client := meta.(*clients.Client).Compute().VMScaleSetClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -51,7 +51,7 @@ func importVirtualMachineScaleSet(osType compute.OperatingSystemTypes, resourceT
 			return []*schema.ResourceData{}, err
 		}
 
-		client := meta.(*clients.Client).Compute.VMScaleSetClient
+		client := meta.(*clients.Client).Compute().VMScaleSetClient
 		ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 		defer cancel()
 
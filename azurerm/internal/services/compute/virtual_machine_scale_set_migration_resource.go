@@ -28,7 +28,7 @@ func resourceVirtualMachineScaleSetStateV0toV1(is *terraform.InstanceState, meta
 
 	log.Printf("[DEBUG] ARM Virtual Machine Scale Set Attributes before Migration: %#v", is.Attributes)
 
-	client := meta.(*clients.Client).Compute.VMScaleSetClient
+	client := meta.(*clients.Client).Compute().VMScaleSetClient
 	ctx, cancel := context.WithTimeout(meta.(*clients.Client).StopContext, 5*time.Minute)
 	defer cancel()
 
@@ -806,12 +806,12 @@ func (VirtualMachineScaleSetResource) Destroy(ctx context.Context, client *clien
 
 	// this is a preview feature we don't want to use right now
 	var forceDelete *bool = nil
-	future, err := client.Compute.VMScaleSetClient.Delete(ctx, id.ResourceGroup, id.Name, forceDelete)
+	future, err := client.Compute().VMScaleSetClient.Delete(ctx, id.ResourceGroup, id.Name, forceDelete)
 	if err != nil {
 		return nil, fmt.Errorf("Bad: deleting %s: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Compute.VMScaleSetClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Compute().VMScaleSetClient.Client); err != nil {
 		return nil, fmt.Errorf("Bad: waiting for deletion of %s: %+v", *id, err)
 	}
 
@@ -824,7 +824,7 @@ func (VirtualMachineScaleSetResource) hasLoadBalancer(ctx context.Context, clien
 		return err
 	}
 
-	read, err := client.Compute.VMScaleSetClient.Get(ctx, id.ResourceGroup, id.Name)
+	read, err := client.Compute().VMScaleSetClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return err
 	}
@@ -862,7 +862,7 @@ func (VirtualMachineScaleSetResource) hasApplicationGateway(ctx context.Context,
 		return err
 	}
 
-	read, err := client.Compute.VMScaleSetClient.Get(ctx, id.ResourceGroup, id.Name)
+	read, err := client.Compute().VMScaleSetClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return err
 	}
@@ -902,7 +902,7 @@ func (t VirtualMachineScaleSetResource) Exists(ctx context.Context, clients *cli
 	resGroup := id.ResourceGroup
 	name := id.Path["virtualMachineScaleSets"]
 
-	resp, err := clients.Compute.VMScaleSetClient.Get(ctx, resGroup, name)
+	resp, err := clients.Compute().VMScaleSetClient.Get(ctx, resGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Compute Virtual Machine Scale Set %q", id)
 	}
@@ -323,14 +323,16 @@ func resourceWindowsVirtualMachine() *schema.Resource {
 }
 
 func resourceWindowsVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMClient
+	client := meta.(*clients.Client).Compute().VMClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(name, virtualMachineResourceName)
+	if err := locks.ByNameWithContext(ctx, name, virtualMachineResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, virtualMachineResourceName)
 
 	resp, err := client.Get(ctx, resourceGroup, name, "")
@@ -548,10 +550,10 @@ func resourceWindowsVirtualMachineCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceWindowsVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMClient
-	disksClient := meta.(*clients.Client).Compute.DisksClient
-	networkInterfacesClient := meta.(*clients.Client).Network.InterfacesClient
-	publicIPAddressesClient := meta.(*clients.Client).Network.PublicIPsClient
+	client := meta.(*clients.Client).Compute().VMClient
+	disksClient := meta.(*clients.Client).Compute().DisksClient
+	networkInterfacesClient := meta.(*clients.Client).Network().InterfacesClient
+	publicIPAddressesClient := meta.(*clients.Client).Network().PublicIPsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -736,7 +738,7 @@ func resourceWindowsVirtualMachineRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceWindowsVirtualMachineUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMClient
+	client := meta.(*clients.Client).Compute().VMClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -745,7 +747,9 @@ func resourceWindowsVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	locks.ByName(id.Name, virtualMachineResourceName)
+	if err := locks.ByNameWithContext(ctx, id.Name, virtualMachineResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.Name, virtualMachineResourceName)
 
 	log.Printf("[DEBUG] Retrieving Windows Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
@@ -955,7 +959,7 @@ func resourceWindowsVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		shouldUpdate = true
 
 		tagsRaw := d.Get("tags").(map[string]interface{})
@@ -1068,7 +1072,7 @@ func resourceWindowsVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 		newSize := d.Get("os_disk.0.disk_size_gb").(int)
 		log.Printf("[DEBUG] Resizing OS Disk %q for Windows Virtual Machine %q (Resource Group %q) to %dGB..", diskName, id.Name, id.ResourceGroup, newSize)
 
-		disksClient := meta.(*clients.Client).Compute.DisksClient
+		disksClient := meta.(*clients.Client).Compute().DisksClient
 
 		update := compute.DiskUpdate{
 			DiskUpdateProperties: &compute.DiskUpdateProperties{
@@ -1093,7 +1097,7 @@ func resourceWindowsVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 			diskName := d.Get("os_disk.0.name").(string)
 			log.Printf("[DEBUG] Updating encryption settings of OS Disk %q for Windows Virtual Machine %q (Resource Group %q) to %q..", diskName, id.Name, id.ResourceGroup, diskEncryptionSetId)
 
-			disksClient := meta.(*clients.Client).Compute.DisksClient
+			disksClient := meta.(*clients.Client).Compute().DisksClient
 
 			update := compute.DiskUpdate{
 				DiskUpdateProperties: &compute.DiskUpdateProperties{
@@ -1152,7 +1156,7 @@ func resourceWindowsVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceWindowsVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMClient
+	client := meta.(*clients.Client).Compute().VMClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -1161,7 +1165,9 @@ func resourceWindowsVirtualMachineDelete(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	locks.ByName(id.Name, virtualMachineResourceName)
+	if err := locks.ByNameWithContext(ctx, id.Name, virtualMachineResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.Name, virtualMachineResourceName)
 
 	log.Printf("[DEBUG] Retrieving Windows Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
@@ -1211,7 +1217,7 @@ func resourceWindowsVirtualMachineDelete(d *schema.ResourceData, meta interface{
 	deleteOSDisk := meta.(*clients.Client).Features.VirtualMachine.DeleteOSDiskOnDeletion
 	if deleteOSDisk {
 		log.Printf("[DEBUG] Deleting OS Disk from Windows Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
-		disksClient := meta.(*clients.Client).Compute.DisksClient
+		disksClient := meta.(*clients.Client).Compute().DisksClient
 		managedDiskId := ""
 		if props := existing.VirtualMachineProperties; props != nil && props.StorageProfile != nil && props.StorageProfile.OsDisk != nil {
 			if disk := props.StorageProfile.OsDisk.ManagedDisk; disk != nil && disk.ID != nil {
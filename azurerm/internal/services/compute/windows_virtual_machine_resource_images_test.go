@@ -376,25 +376,25 @@ func (WindowsVirtualMachineResource) generalizeVirtualMachine(ctx context.Contex
 		Script:    &command,
 	}
 
-	future, err := client.Compute.VMClient.RunCommand(ctx, id.ResourceGroup, id.Name, runCommand)
+	future, err := client.Compute().VMClient.RunCommand(ctx, id.ResourceGroup, id.Name, runCommand)
 	if err != nil {
 		return fmt.Errorf("Bad: Error in running sysprep: %+v", err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Compute.VMClient.Client); err != nil {
+	if err := future.WaitForCompletionRef(ctx, client.Compute().VMClient.Client); err != nil {
 		return fmt.Errorf("Bad: Error waiting for Windows VM to sysprep: %+v", err)
 	}
 
-	daFuture, err := client.Compute.VMClient.Deallocate(ctx, id.ResourceGroup, id.Name)
+	daFuture, err := client.Compute().VMClient.Deallocate(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("Bad: Deallocation error: %+v", err)
 	}
 
-	if err := daFuture.WaitForCompletionRef(ctx, client.Compute.VMClient.Client); err != nil {
+	if err := daFuture.WaitForCompletionRef(ctx, client.Compute().VMClient.Client); err != nil {
 		return fmt.Errorf("Bad: Deallocation error: %+v", err)
 	}
 
-	if _, err = client.Compute.VMClient.Generalize(ctx, id.ResourceGroup, id.Name); err != nil {
+	if _, err = client.Compute().VMClient.Generalize(ctx, id.ResourceGroup, id.Name); err != nil {
 		return fmt.Errorf("Bad: Generalizing error: %+v", err)
 	}
 
@@ -508,7 +508,7 @@ func resourceContainerGroup() *schema.Resource {
 }
 
 func resourceContainerGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.GroupsClient
+	client := meta.(*clients.Client).Containers().GroupsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -599,7 +599,7 @@ func resourceContainerGroupCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceContainerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.GroupsClient
+	client := meta.(*clients.Client).Containers().GroupsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -622,7 +622,7 @@ func resourceContainerGroupUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceContainerGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.GroupsClient
+	client := meta.(*clients.Client).Containers().GroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -688,7 +688,7 @@ func resourceContainerGroupRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceContainerGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.GroupsClient
+	client := meta.(*clients.Client).Containers().GroupsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -733,7 +733,7 @@ func resourceContainerGroupDelete(d *schema.ResourceData, meta interface{}) erro
 			return err
 		}
 
-		networkProfileClient := meta.(*clients.Client).Network.ProfileClient
+		networkProfileClient := meta.(*clients.Client).Network().ProfileClient
 		networkProfileResourceGroup := parsedProfileId.ResourceGroup
 		networkProfileName := parsedProfileId.Path["networkProfiles"]
 
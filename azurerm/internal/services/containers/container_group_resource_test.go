@@ -1469,7 +1469,7 @@ func (t ContainerGroupResource) Exists(ctx context.Context, clients *clients.Cli
 	resourceGroup := id.ResourceGroup
 	name := id.Path["containerGroups"]
 
-	resp, err := clients.Containers.GroupsClient.Get(ctx, resourceGroup, name)
+	resp, err := clients.Containers().GroupsClient.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Container Group (%s): %+v", id, err)
 	}
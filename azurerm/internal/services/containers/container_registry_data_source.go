@@ -67,7 +67,7 @@ func dataSourceContainerRegistry() *schema.Resource {
 }
 
 func dataSourceContainerRegistryRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.RegistriesClient
+	client := meta.(*clients.Client).Containers().RegistriesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
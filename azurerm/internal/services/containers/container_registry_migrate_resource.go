@@ -97,7 +97,7 @@ func findAzureStorageAccountIdFromName(name string, meta interface{}) (string, e
 	ctx, cancel := context.WithTimeout(meta.(*clients.Client).StopContext, time.Minute*5)
 	defer cancel()
 
-	client := meta.(*clients.Client).Storage.AccountsClient
+	client := meta.(*clients.Client).Storage().AccountsClient
 	accounts, err := client.List(ctx)
 	if err != nil {
 		return "", err
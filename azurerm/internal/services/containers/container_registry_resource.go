@@ -237,7 +237,7 @@ func resourceContainerRegistry() *schema.Resource {
 }
 
 func resourceContainerRegistryCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.RegistriesClient
+	client := meta.(*clients.Client).Containers().RegistriesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for  Container Registry creation.")
@@ -352,7 +352,7 @@ func resourceContainerRegistryCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceContainerRegistryUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.RegistriesClient
+	client := meta.(*clients.Client).Containers().RegistriesClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for  Container Registry update.")
@@ -451,7 +451,7 @@ func resourceContainerRegistryUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func applyContainerRegistrySku(d *schema.ResourceData, meta interface{}, sku string, resourceGroup string, name string) error {
-	client := meta.(*clients.Client).Containers.RegistriesClient
+	client := meta.(*clients.Client).Containers().RegistriesClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -475,7 +475,7 @@ func applyContainerRegistrySku(d *schema.ResourceData, meta interface{}, sku str
 }
 
 func applyGeoReplicationLocations(d *schema.ResourceData, meta interface{}, resourceGroup string, name string, oldGeoReplicationLocations []interface{}, newGeoReplicationLocations []interface{}) error {
-	replicationClient := meta.(*clients.Client).Containers.ReplicationsClient
+	replicationClient := meta.(*clients.Client).Containers().ReplicationsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing to apply geo-replications for  Container Registry.")
@@ -545,8 +545,8 @@ func applyGeoReplicationLocations(d *schema.ResourceData, meta interface{}, reso
 }
 
 func resourceContainerRegistryRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.RegistriesClient
-	replicationClient := meta.(*clients.Client).Containers.ReplicationsClient
+	client := meta.(*clients.Client).Containers().RegistriesClient
+	replicationClient := meta.(*clients.Client).Containers().ReplicationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -643,7 +643,7 @@ func resourceContainerRegistryRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceContainerRegistryDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.RegistriesClient
+	client := meta.(*clients.Client).Containers().RegistriesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
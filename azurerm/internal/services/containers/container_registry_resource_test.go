@@ -392,7 +392,7 @@ func (t ContainerRegistryResource) Exists(ctx context.Context, clients *clients.
 	resourceGroup := id.ResourceGroup
 	name := id.Path["registries"]
 
-	resp, err := clients.Containers.RegistriesClient.Get(ctx, resourceGroup, name)
+	resp, err := clients.Containers().RegistriesClient.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Container Registry (%s): %+v", id, err)
 	}
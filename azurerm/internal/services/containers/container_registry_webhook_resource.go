@@ -107,7 +107,7 @@ func resourceContainerRegistryWebhook() *schema.Resource {
 }
 
 func resourceContainerRegistryWebhookCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.WebhooksClient
+	client := meta.(*clients.Client).Containers().WebhooksClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for  Container Registry Webhook creation.")
@@ -162,7 +162,7 @@ func resourceContainerRegistryWebhookCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceContainerRegistryWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.WebhooksClient
+	client := meta.(*clients.Client).Containers().WebhooksClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -197,7 +197,7 @@ func resourceContainerRegistryWebhookUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceContainerRegistryWebhookRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.WebhooksClient
+	client := meta.(*clients.Client).Containers().WebhooksClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -263,7 +263,7 @@ func resourceContainerRegistryWebhookRead(d *schema.ResourceData, meta interface
 }
 
 func resourceContainerRegistryWebhookDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.WebhooksClient
+	client := meta.(*clients.Client).Containers().WebhooksClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -631,7 +631,7 @@ func (t ContainerRegistryWebhookResource) Exists(ctx context.Context, clients *c
 	registryName := id.Path["registries"]
 	name := id.Path["webhooks"]
 
-	resp, err := clients.Containers.WebhooksClient.Get(ctx, resourceGroup, registryName, name)
+	resp, err := clients.Containers().WebhooksClient.Get(ctx, resourceGroup, registryName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Container Registry Webhook (%s): %+v", id, err)
 	}
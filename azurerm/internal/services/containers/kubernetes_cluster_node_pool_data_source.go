@@ -156,8 +156,8 @@ func dataSourceKubernetesClusterNodePool() *schema.Resource {
 }
 
 func dataSourceKubernetesClusterNodePoolRead(d *schema.ResourceData, meta interface{}) error {
-	clustersClient := meta.(*clients.Client).Containers.KubernetesClustersClient
-	poolsClient := meta.(*clients.Client).Containers.AgentPoolsClient
+	clustersClient := meta.(*clients.Client).Containers().KubernetesClustersClient
+	poolsClient := meta.(*clients.Client).Containers().AgentPoolsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
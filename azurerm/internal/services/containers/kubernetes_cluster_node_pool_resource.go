@@ -231,7 +231,7 @@ func resourceKubernetesClusterNodePool() *schema.Resource {
 }
 
 func resourceKubernetesClusterNodePoolCreate(d *schema.ResourceData, meta interface{}) error {
-	containersClient := meta.(*clients.Client).Containers
+	containersClient := meta.(*clients.Client).Containers()
 	clustersClient := containersClient.KubernetesClustersClient
 	poolsClient := containersClient.AgentPoolsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -425,7 +425,7 @@ func resourceKubernetesClusterNodePoolCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceKubernetesClusterNodePoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	containersClient := meta.(*clients.Client).Containers
+	containersClient := meta.(*clients.Client).Containers()
 	client := containersClient.AgentPoolsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -515,7 +515,7 @@ func resourceKubernetesClusterNodePoolUpdate(d *schema.ResourceData, meta interf
 		props.OrchestratorVersion = utils.String(orchestratorVersion)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
 		props.Tags = tags.Expand(t)
 	}
@@ -569,8 +569,8 @@ func resourceKubernetesClusterNodePoolUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceKubernetesClusterNodePoolRead(d *schema.ResourceData, meta interface{}) error {
-	clustersClient := meta.(*clients.Client).Containers.KubernetesClustersClient
-	poolsClient := meta.(*clients.Client).Containers.AgentPoolsClient
+	clustersClient := meta.(*clients.Client).Containers().KubernetesClustersClient
+	poolsClient := meta.(*clients.Client).Containers().AgentPoolsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -699,7 +699,7 @@ func resourceKubernetesClusterNodePoolRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceKubernetesClusterNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.AgentPoolsClient
+	client := meta.(*clients.Client).Containers().AgentPoolsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -711,8 +711,8 @@ func resourceKubernetesCluster() *schema.Resource {
 }
 
 func resourceKubernetesClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.KubernetesClustersClient
-	env := meta.(*clients.Client).Containers.Environment
+	client := meta.(*clients.Client).Containers().KubernetesClustersClient
+	env := meta.(*clients.Client).Containers().Environment
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	tenantId := meta.(*clients.Client).Account.TenantId
@@ -888,7 +888,7 @@ func resourceKubernetesClusterCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	containersClient := meta.(*clients.Client).Containers
+	containersClient := meta.(*clients.Client).Containers()
 	nodePoolsClient := containersClient.AgentPoolsClient
 	clusterClient := containersClient.KubernetesClustersClient
 	env := containersClient.Environment
@@ -1106,7 +1106,7 @@ func resourceKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}) e
 		existing.ManagedClusterProperties.NetworkProfile.LoadBalancerProfile = &loadBalancerProfile
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateCluster = true
 		t := d.Get("tags").(map[string]interface{})
 		existing.Tags = tags.Expand(t)
@@ -1219,7 +1219,7 @@ func resourceKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.KubernetesClustersClient
+	client := meta.(*clients.Client).Containers().KubernetesClustersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -1371,7 +1371,7 @@ func resourceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceKubernetesClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.KubernetesClustersClient
+	client := meta.(*clients.Client).Containers().KubernetesClustersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
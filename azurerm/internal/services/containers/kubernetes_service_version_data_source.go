@@ -51,7 +51,7 @@ func dataSourceKubernetesServiceVersions() *schema.Resource {
 }
 
 func dataSourceKubernetesServiceVersionsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Containers.ServicesClient
+	client := meta.(*clients.Client).Containers().ServicesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
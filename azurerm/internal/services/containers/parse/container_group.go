@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ContainerGroupId struct {
@@ -67,3 +68,12 @@ func ContainerGroupID(input string) (*ContainerGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// ContainerGroupIDNormalized parses a ContainerGroup ID into an ContainerGroupId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ContainerGroupID method should be used instead for validation etc.
+func ContainerGroupIDNormalized(input string) (*ContainerGroupId, error) {
+	return ContainerGroupID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerInstance/containerGroups/%s"))
+}
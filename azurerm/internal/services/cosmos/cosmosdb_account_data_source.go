@@ -225,7 +225,7 @@ func dataSourceCosmosDbAccount() *schema.Resource {
 }
 
 func dataSourceCosmosDbAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.DatabaseClient
+	client := meta.(*clients.Client).Cosmos().DatabaseClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
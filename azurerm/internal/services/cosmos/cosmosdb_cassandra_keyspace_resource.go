@@ -76,7 +76,7 @@ func resourceCosmosDbCassandraKeyspace() *schema.Resource {
 }
 
 func resourceCosmosDbCassandraKeyspaceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.CassandraClient
+	client := meta.(*clients.Client).Cosmos().CassandraClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -140,7 +140,7 @@ func resourceCosmosDbCassandraKeyspaceCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceCosmosDbCassandraKeyspaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.CassandraClient
+	client := meta.(*clients.Client).Cosmos().CassandraClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -191,7 +191,7 @@ func resourceCosmosDbCassandraKeyspaceUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceCosmosDbCassandraKeyspaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.CassandraClient
+	client := meta.(*clients.Client).Cosmos().CassandraClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -235,7 +235,7 @@ func resourceCosmosDbCassandraKeyspaceRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceCosmosDbCassandraKeyspaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.CassandraClient
+	client := meta.(*clients.Client).Cosmos().CassandraClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -111,7 +111,7 @@ func (t CosmosDbCassandraKeyspaceResource) Exists(ctx context.Context, clients *
 		return nil, err
 	}
 
-	resp, err := clients.Cosmos.CassandraClient.GetCassandraKeyspace(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+	resp, err := clients.Cosmos().CassandraClient.GetCassandraKeyspace(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Cosmos Cassandra Keyspace (%s): %+v", id.String(), err)
 	}
@@ -74,7 +74,7 @@ func resourceCosmosDbCassandraTable() *schema.Resource {
 }
 
 func resourceCosmosDbCassandraTableCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.CassandraClient
+	client := meta.(*clients.Client).Cosmos().CassandraClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -140,7 +140,7 @@ func resourceCosmosDbCassandraTableCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceCosmosDbCassandraTableUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.CassandraClient
+	client := meta.(*clients.Client).Cosmos().CassandraClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -197,7 +197,7 @@ func resourceCosmosDbCassandraTableUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceCosmosDbCassandraTableRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.CassandraClient
+	client := meta.(*clients.Client).Cosmos().CassandraClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	defer cancel()
@@ -252,7 +252,7 @@ func resourceCosmosDbCassandraTableRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCosmosDbCassandraTableDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.CassandraClient
+	client := meta.(*clients.Client).Cosmos().CassandraClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
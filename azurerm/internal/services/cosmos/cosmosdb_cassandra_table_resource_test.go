@@ -23,7 +23,7 @@ func (t CosmosDBCassandraTableResource) Exists(ctx context.Context, clients *cli
 		return nil, err
 	}
 
-	resp, err := clients.Cosmos.CassandraClient.GetCassandraTable(ctx, id.ResourceGroup, id.DatabaseAccountName, id.CassandraKeyspaceName, id.TableName)
+	resp, err := clients.Cosmos().CassandraClient.GetCassandraTable(ctx, id.ResourceGroup, id.DatabaseAccountName, id.CassandraKeyspaceName, id.TableName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Cosmos Cassandra Table (%s): %+v", id.String(), err)
 	}
@@ -76,7 +76,7 @@ func resourceCosmosGremlinDatabase() *schema.Resource {
 }
 
 func resourceCosmosGremlinDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.GremlinClient
+	client := meta.(*clients.Client).Cosmos().GremlinClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -140,7 +140,7 @@ func resourceCosmosGremlinDatabaseCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceCosmosGremlinDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.GremlinClient
+	client := meta.(*clients.Client).Cosmos().GremlinClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -195,7 +195,7 @@ func resourceCosmosGremlinDatabaseUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceCosmosGremlinDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.GremlinClient
+	client := meta.(*clients.Client).Cosmos().GremlinClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -239,7 +239,7 @@ func resourceCosmosGremlinDatabaseRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCosmosGremlinDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.GremlinClient
+	client := meta.(*clients.Client).Cosmos().GremlinClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
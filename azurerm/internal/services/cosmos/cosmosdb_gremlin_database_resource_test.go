@@ -113,7 +113,7 @@ func (t CosmosGremlinDatabaseResource) Exists(ctx context.Context, clients *clie
 		return nil, err
 	}
 
-	resp, err := clients.Cosmos.GremlinClient.GetGremlinDatabase(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+	resp, err := clients.Cosmos().GremlinClient.GetGremlinDatabase(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Cosmos Gremlin Database (%s): %+v", id.String(), err)
 	}
@@ -193,7 +193,7 @@ func resourceCosmosDbGremlinGraph() *schema.Resource {
 }
 
 func resourceCosmosDbGremlinGraphCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.GremlinClient
+	client := meta.(*clients.Client).Cosmos().GremlinClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -279,7 +279,7 @@ func resourceCosmosDbGremlinGraphCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCosmosDbGremlinGraphUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.GremlinClient
+	client := meta.(*clients.Client).Cosmos().GremlinClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -350,7 +350,7 @@ func resourceCosmosDbGremlinGraphUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCosmosDbGremlinGraphRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.GremlinClient
+	client := meta.(*clients.Client).Cosmos().GremlinClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -427,7 +427,7 @@ func resourceCosmosDbGremlinGraphRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCosmosDbGremlinGraphDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.GremlinClient
+	client := meta.(*clients.Client).Cosmos().GremlinClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -138,7 +138,7 @@ func resourceCosmosDbMongoCollection() *schema.Resource {
 }
 
 func resourceCosmosDbMongoCollectionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.MongoDbClient
+	client := meta.(*clients.Client).Cosmos().MongoDbClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -215,7 +215,7 @@ func resourceCosmosDbMongoCollectionCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceCosmosDbMongoCollectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.MongoDbClient
+	client := meta.(*clients.Client).Cosmos().MongoDbClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -278,8 +278,8 @@ func resourceCosmosDbMongoCollectionUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceCosmosDbMongoCollectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.MongoDbClient
-	accClient := meta.(*clients.Client).Cosmos.DatabaseClient
+	client := meta.(*clients.Client).Cosmos().MongoDbClient
+	accClient := meta.(*clients.Client).Cosmos().DatabaseClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -359,7 +359,7 @@ func resourceCosmosDbMongoCollectionRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceCosmosDbMongoCollectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.MongoDbClient
+	client := meta.(*clients.Client).Cosmos().MongoDbClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -182,7 +182,7 @@ func (t CosmosMongoCollectionResource) Exists(ctx context.Context, clients *clie
 		return nil, err
 	}
 
-	resp, err := clients.Cosmos.MongoDbClient.GetMongoDBCollection(ctx, id.ResourceGroup, id.DatabaseAccountName, id.MongodbDatabaseName, id.CollectionName)
+	resp, err := clients.Cosmos().MongoDbClient.GetMongoDBCollection(ctx, id.ResourceGroup, id.DatabaseAccountName, id.MongodbDatabaseName, id.CollectionName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Cosmos Mongo Collection (%s): %+v", id.String(), err)
 	}
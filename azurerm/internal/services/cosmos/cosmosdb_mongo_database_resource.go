@@ -76,7 +76,7 @@ func resourceCosmosDbMongoDatabase() *schema.Resource {
 }
 
 func resourceCosmosDbMongoDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.MongoDbClient
+	client := meta.(*clients.Client).Cosmos().MongoDbClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -140,7 +140,7 @@ func resourceCosmosDbMongoDatabaseCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceCosmosDbMongoDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.MongoDbClient
+	client := meta.(*clients.Client).Cosmos().MongoDbClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -195,8 +195,8 @@ func resourceCosmosDbMongoDatabaseUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceCosmosDbMongoDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.MongoDbClient
-	accountClient := meta.(*clients.Client).Cosmos.DatabaseClient
+	client := meta.(*clients.Client).Cosmos().MongoDbClient
+	accountClient := meta.(*clients.Client).Cosmos().DatabaseClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -261,7 +261,7 @@ func resourceCosmosDbMongoDatabaseRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCosmosDbMongoDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.MongoDbClient
+	client := meta.(*clients.Client).Cosmos().MongoDbClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
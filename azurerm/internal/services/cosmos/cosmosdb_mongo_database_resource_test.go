@@ -101,7 +101,7 @@ func (t CosmosMongoDatabaseResource) Exists(ctx context.Context, clients *client
 		return nil, err
 	}
 
-	resp, err := clients.Cosmos.MongoDbClient.GetMongoDBDatabase(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+	resp, err := clients.Cosmos().MongoDbClient.GetMongoDBDatabase(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Cosmos Mongo Database (%s): %+v", id.String(), err)
 	}
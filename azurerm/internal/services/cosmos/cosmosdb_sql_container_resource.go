@@ -125,7 +125,7 @@ func resourceCosmosDbSQLContainer() *schema.Resource {
 }
 
 func resourceCosmosDbSQLContainerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.SqlClient
+	client := meta.(*clients.Client).Cosmos().SqlClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -219,7 +219,7 @@ func resourceCosmosDbSQLContainerCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCosmosDbSQLContainerUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.SqlClient
+	client := meta.(*clients.Client).Cosmos().SqlClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -300,8 +300,8 @@ func resourceCosmosDbSQLContainerUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCosmosDbSQLContainerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.SqlClient
-	accountClient := meta.(*clients.Client).Cosmos.DatabaseClient
+	client := meta.(*clients.Client).Cosmos().SqlClient
+	accountClient := meta.(*clients.Client).Cosmos().DatabaseClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -393,7 +393,7 @@ func resourceCosmosDbSQLContainerRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCosmosDbSQLContainerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.SqlClient
+	client := meta.(*clients.Client).Cosmos().SqlClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -76,7 +76,7 @@ func resourceCosmosDbSQLDatabase() *schema.Resource {
 }
 
 func resourceCosmosDbSQLDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.SqlClient
+	client := meta.(*clients.Client).Cosmos().SqlClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -140,7 +140,7 @@ func resourceCosmosDbSQLDatabaseCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCosmosDbSQLDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.SqlClient
+	client := meta.(*clients.Client).Cosmos().SqlClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -191,8 +191,8 @@ func resourceCosmosDbSQLDatabaseUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCosmosDbSQLDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.SqlClient
-	accountClient := meta.(*clients.Client).Cosmos.DatabaseClient
+	client := meta.(*clients.Client).Cosmos().SqlClient
+	accountClient := meta.(*clients.Client).Cosmos().DatabaseClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -256,7 +256,7 @@ func resourceCosmosDbSQLDatabaseRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceCosmosDbSQLDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.SqlClient
+	client := meta.(*clients.Client).Cosmos().SqlClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -114,7 +114,7 @@ func (t CosmosSqlDatabaseResource) Exists(ctx context.Context, clients *clients.
 		return nil, err
 	}
 
-	resp, err := clients.Cosmos.SqlClient.GetSQLDatabase(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
+	resp, err := clients.Cosmos().SqlClient.GetSQLDatabase(ctx, id.ResourceGroup, id.DatabaseAccountName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Cosmos SQL Database (%s): %+v", id.String(), err)
 	}
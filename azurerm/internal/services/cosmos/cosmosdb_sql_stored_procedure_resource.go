@@ -80,7 +80,7 @@ func resourceCosmosDbSQLStoredProcedure() *schema.Resource {
 }
 
 func resourceCosmosDbSQLStoredProcedureCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.SqlClient
+	client := meta.(*clients.Client).Cosmos().SqlClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -134,7 +134,7 @@ func resourceCosmosDbSQLStoredProcedureCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceCosmosDbSQLStoredProcedureUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.SqlClient
+	client := meta.(*clients.Client).Cosmos().SqlClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -171,7 +171,7 @@ func resourceCosmosDbSQLStoredProcedureUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceCosmosDbSQLStoredProcedureRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.SqlClient
+	client := meta.(*clients.Client).Cosmos().SqlClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -207,7 +207,7 @@ func resourceCosmosDbSQLStoredProcedureRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceCosmosDbSQLStoredProcedureDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.SqlClient
+	client := meta.(*clients.Client).Cosmos().SqlClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
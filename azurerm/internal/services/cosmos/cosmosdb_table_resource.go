@@ -76,7 +76,7 @@ func resourceCosmosDbTable() *schema.Resource {
 }
 
 func resourceCosmosDbTableCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.TableClient
+	client := meta.(*clients.Client).Cosmos().TableClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -140,7 +140,7 @@ func resourceCosmosDbTableCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceCosmosDbTableUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.TableClient
+	client := meta.(*clients.Client).Cosmos().TableClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -191,8 +191,8 @@ func resourceCosmosDbTableUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceCosmosDbTableRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.TableClient
-	accountClient := meta.(*clients.Client).Cosmos.DatabaseClient
+	client := meta.(*clients.Client).Cosmos().TableClient
+	accountClient := meta.(*clients.Client).Cosmos().DatabaseClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -256,7 +256,7 @@ func resourceCosmosDbTableRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCosmosDbTableDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.TableClient
+	client := meta.(*clients.Client).Cosmos().TableClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CassandraKeyspaceId struct {
@@ -73,3 +74,12 @@ func CassandraKeyspaceID(input string) (*CassandraKeyspaceId, error) {
 
 	return &resourceId, nil
 }
+
+// CassandraKeyspaceIDNormalized parses a CassandraKeyspace ID into an CassandraKeyspaceId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the CassandraKeyspaceID method should be used instead for validation etc.
+func CassandraKeyspaceIDNormalized(input string) (*CassandraKeyspaceId, error) {
+	return CassandraKeyspaceID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s/cassandraKeyspaces/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CassandraTableId struct {
@@ -79,3 +80,12 @@ func CassandraTableID(input string) (*CassandraTableId, error) {
 
 	return &resourceId, nil
 }
+
+// CassandraTableIDNormalized parses a CassandraTable ID into an CassandraTableId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the CassandraTableID method should be used instead for validation etc.
+func CassandraTableIDNormalized(input string) (*CassandraTableId, error) {
+	return CassandraTableID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s/cassandraKeyspaces/%s/tables/%s"))
+}
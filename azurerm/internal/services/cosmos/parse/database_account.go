@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabaseAccountId struct {
@@ -67,3 +68,12 @@ func DatabaseAccountID(input string) (*DatabaseAccountId, error) {
 
 	return &resourceId, nil
 }
+
+// DatabaseAccountIDNormalized parses a DatabaseAccount ID into an DatabaseAccountId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DatabaseAccountID method should be used instead for validation etc.
+func DatabaseAccountIDNormalized(input string) (*DatabaseAccountId, error) {
+	return DatabaseAccountID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s"))
+}
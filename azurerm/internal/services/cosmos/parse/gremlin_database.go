@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type GremlinDatabaseId struct {
@@ -73,3 +74,12 @@ func GremlinDatabaseID(input string) (*GremlinDatabaseId, error) {
 
 	return &resourceId, nil
 }
+
+// GremlinDatabaseIDNormalized parses a GremlinDatabase ID into an GremlinDatabaseId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the GremlinDatabaseID method should be used instead for validation etc.
+func GremlinDatabaseIDNormalized(input string) (*GremlinDatabaseId, error) {
+	return GremlinDatabaseID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s/gremlinDatabases/%s"))
+}
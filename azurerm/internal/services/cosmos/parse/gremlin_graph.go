@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type GremlinGraphId struct {
@@ -79,3 +80,12 @@ func GremlinGraphID(input string) (*GremlinGraphId, error) {
 
 	return &resourceId, nil
 }
+
+// GremlinGraphIDNormalized parses a GremlinGraph ID into an GremlinGraphId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the GremlinGraphID method should be used instead for validation etc.
+func GremlinGraphIDNormalized(input string) (*GremlinGraphId, error) {
+	return GremlinGraphID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s/gremlinDatabases/%s/graphs/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type MongodbCollectionId struct {
@@ -79,3 +80,12 @@ func MongodbCollectionID(input string) (*MongodbCollectionId, error) {
 
 	return &resourceId, nil
 }
+
+// MongodbCollectionIDNormalized parses a MongodbCollection ID into an MongodbCollectionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the MongodbCollectionID method should be used instead for validation etc.
+func MongodbCollectionIDNormalized(input string) (*MongodbCollectionId, error) {
+	return MongodbCollectionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s/mongodbDatabases/%s/collections/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type MongodbDatabaseId struct {
@@ -73,3 +74,12 @@ func MongodbDatabaseID(input string) (*MongodbDatabaseId, error) {
 
 	return &resourceId, nil
 }
+
+// MongodbDatabaseIDNormalized parses a MongodbDatabase ID into an MongodbDatabaseId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the MongodbDatabaseID method should be used instead for validation etc.
+func MongodbDatabaseIDNormalized(input string) (*MongodbDatabaseId, error) {
+	return MongodbDatabaseID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s/mongodbDatabases/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SqlContainerId struct {
@@ -79,3 +80,12 @@ func SqlContainerID(input string) (*SqlContainerId, error) {
 
 	return &resourceId, nil
 }
+
+// SqlContainerIDNormalized parses a SqlContainer ID into an SqlContainerId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SqlContainerID method should be used instead for validation etc.
+func SqlContainerIDNormalized(input string) (*SqlContainerId, error) {
+	return SqlContainerID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s/sqlDatabases/%s/containers/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SqlDatabaseId struct {
@@ -73,3 +74,12 @@ func SqlDatabaseID(input string) (*SqlDatabaseId, error) {
 
 	return &resourceId, nil
 }
+
+// SqlDatabaseIDNormalized parses a SqlDatabase ID into an SqlDatabaseId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SqlDatabaseID method should be used instead for validation etc.
+func SqlDatabaseIDNormalized(input string) (*SqlDatabaseId, error) {
+	return SqlDatabaseID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s/sqlDatabases/%s"))
+}
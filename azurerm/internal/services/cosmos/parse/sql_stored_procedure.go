@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SqlStoredProcedureId struct {
@@ -85,3 +86,12 @@ func SqlStoredProcedureID(input string) (*SqlStoredProcedureId, error) {
 
 	return &resourceId, nil
 }
+
+// SqlStoredProcedureIDNormalized parses a SqlStoredProcedure ID into an SqlStoredProcedureId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SqlStoredProcedureID method should be used instead for validation etc.
+func SqlStoredProcedureIDNormalized(input string) (*SqlStoredProcedureId, error) {
+	return SqlStoredProcedureID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s/sqlDatabases/%s/containers/%s/storedProcedures/%s"))
+}
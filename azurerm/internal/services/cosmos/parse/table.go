@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type TableId struct {
@@ -73,3 +74,12 @@ func TableID(input string) (*TableId, error) {
 
 	return &resourceId, nil
 }
+
+// TableIDNormalized parses a Table ID into an TableId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the TableID method should be used instead for validation etc.
+func TableIDNormalized(input string) (*TableId, error) {
+	return TableID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s/tables/%s"))
+}
@@ -141,7 +141,7 @@ func resourceCostManagementExportResourceGroup() *schema.Resource {
 }
 
 func resourceCostManagementExportResourceGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).CostManagement.ExportClient
+	client := meta.(*clients.Client).CostManagement().ExportClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -212,7 +212,7 @@ func resourceCostManagementExportResourceGroupCreateUpdate(d *schema.ResourceDat
 }
 
 func resourceCostManagementExportResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).CostManagement.ExportClient
+	client := meta.(*clients.Client).CostManagement().ExportClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -258,7 +258,7 @@ func resourceCostManagementExportResourceGroupRead(d *schema.ResourceData, meta
 }
 
 func resourceCostManagementExportResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).CostManagement.ExportClient
+	client := meta.(*clients.Client).CostManagement().ExportClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
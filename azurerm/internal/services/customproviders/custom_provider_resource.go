@@ -117,7 +117,7 @@ func resourceCustomProvider() *schema.Resource {
 }
 
 func resourceCustomProviderCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).CustomProviders.CustomProviderClient
+	client := meta.(*clients.Client).CustomProviders().CustomProviderClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -171,7 +171,7 @@ func resourceCustomProviderCreateUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCustomProviderRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).CustomProviders.CustomProviderClient
+	client := meta.(*clients.Client).CustomProviders().CustomProviderClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -212,7 +212,7 @@ func resourceCustomProviderRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceCustomProviderDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).CustomProviders.CustomProviderClient
+	client := meta.(*clients.Client).CustomProviders().CustomProviderClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
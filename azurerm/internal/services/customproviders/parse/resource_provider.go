@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ResourceProviderId struct {
@@ -67,3 +68,12 @@ func ResourceProviderID(input string) (*ResourceProviderId, error) {
 
 	return &resourceId, nil
 }
+
+// ResourceProviderIDNormalized parses a ResourceProvider ID into an ResourceProviderId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ResourceProviderID method should be used instead for validation etc.
+func ResourceProviderIDNormalized(input string) (*ResourceProviderId, error) {
+	return ResourceProviderID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.CustomProviders/resourceproviders/%s"))
+}
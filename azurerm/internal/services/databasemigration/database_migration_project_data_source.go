@@ -53,7 +53,7 @@ func dataSourceDatabaseMigrationProject() *schema.Resource {
 }
 
 func dataSourceDatabaseMigrationProjectRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DatabaseMigration.ProjectsClient
+	client := meta.(*clients.Client).DatabaseMigration().ProjectsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -82,7 +82,7 @@ func resourceDatabaseMigrationProject() *schema.Resource {
 }
 
 func resourceDatabaseMigrationProjectCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DatabaseMigration.ProjectsClient
+	client := meta.(*clients.Client).DatabaseMigration().ProjectsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -133,7 +133,7 @@ func resourceDatabaseMigrationProjectCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceDatabaseMigrationProjectRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DatabaseMigration.ProjectsClient
+	client := meta.(*clients.Client).DatabaseMigration().ProjectsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -171,7 +171,7 @@ func resourceDatabaseMigrationProjectRead(d *schema.ResourceData, meta interface
 }
 
 func resourceDatabaseMigrationProjectDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DatabaseMigration.ProjectsClient
+	client := meta.(*clients.Client).DatabaseMigration().ProjectsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
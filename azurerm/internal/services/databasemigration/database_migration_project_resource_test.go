@@ -101,7 +101,7 @@ func (t DatabaseMigrationProjectResource) Exists(ctx context.Context, clients *c
 		return nil, err
 	}
 
-	resp, err := clients.DatabaseMigration.ProjectsClient.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	resp, err := clients.DatabaseMigration().ProjectsClient.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Database Migration Project (Project Name %q / Service Name %q / Group Name %q) does not exist", id.Name, id.ServiceName, id.ResourceGroup)
 	}
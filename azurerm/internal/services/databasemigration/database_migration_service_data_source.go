@@ -48,7 +48,7 @@ func dataSourceDatabaseMigrationService() *schema.Resource {
 }
 
 func dataSourceDatabaseMigrationServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DatabaseMigration.ServicesClient
+	client := meta.(*clients.Client).DatabaseMigration().ServicesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
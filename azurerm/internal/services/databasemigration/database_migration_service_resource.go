@@ -78,7 +78,7 @@ func resourceDatabaseMigrationService() *schema.Resource {
 }
 
 func resourceDatabaseMigrationServiceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DatabaseMigration.ServicesClient
+	client := meta.(*clients.Client).DatabaseMigration().ServicesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -136,7 +136,7 @@ func resourceDatabaseMigrationServiceCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceDatabaseMigrationServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DatabaseMigration.ServicesClient
+	client := meta.(*clients.Client).DatabaseMigration().ServicesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -176,7 +176,7 @@ func resourceDatabaseMigrationServiceRead(d *schema.ResourceData, meta interface
 }
 
 func resourceDatabaseMigrationServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DatabaseMigration.ServicesClient
+	client := meta.(*clients.Client).DatabaseMigration().ServicesClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -201,7 +201,7 @@ func resourceDatabaseMigrationServiceUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceDatabaseMigrationServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DatabaseMigration.ServicesClient
+	client := meta.(*clients.Client).DatabaseMigration().ServicesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
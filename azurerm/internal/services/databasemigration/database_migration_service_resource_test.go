@@ -102,7 +102,7 @@ func (t DatabaseMigrationServiceResource) Exists(ctx context.Context, clients *c
 		return nil, err
 	}
 
-	resp, err := clients.DatabaseMigration.ServicesClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.DatabaseMigration().ServicesClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Database Migration Service (Service Name %q / Group Name %q) does not exist", id.Name, id.ResourceGroup)
 	}
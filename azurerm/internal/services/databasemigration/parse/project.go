@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProjectId struct {
@@ -73,3 +74,12 @@ func ProjectID(input string) (*ProjectId, error) {
 
 	return &resourceId, nil
 }
+
+// ProjectIDNormalized parses a Project ID into an ProjectId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ProjectID method should be used instead for validation etc.
+func ProjectIDNormalized(input string) (*ProjectId, error) {
+	return ProjectID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataMigration/services/%s/projects/%s"))
+}
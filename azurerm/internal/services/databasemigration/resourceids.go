@@ -1,4 +1,4 @@
 package databasemigration
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Project -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataMigration/services/service1/projects/project1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Service -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataMigration/services/service1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Project -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataMigration/services/service1/projects/project1 -resource-type=azurerm_database_migration_project
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Service -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataMigration/services/service1 -resource-type=azurerm_database_migration_service
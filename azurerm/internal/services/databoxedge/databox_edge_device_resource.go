@@ -130,7 +130,7 @@ func resourceDataboxEdgeDevice() *schema.Resource {
 
 func resourceDataboxEdgeDeviceCreate(d *schema.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).DataboxEdge.DeviceClient
+	client := meta.(*clients.Client).DataboxEdge().DeviceClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -181,7 +181,7 @@ func resourceDataboxEdgeDeviceCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceDataboxEdgeDeviceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataboxEdge.DeviceClient
+	client := meta.(*clients.Client).DataboxEdge().DeviceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -218,7 +218,7 @@ func resourceDataboxEdgeDeviceRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceDataboxEdgeDeviceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataboxEdge.DeviceClient
+	client := meta.(*clients.Client).DataboxEdge().DeviceClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -228,7 +228,7 @@ func resourceDataboxEdgeDeviceUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	parameters := databoxedge.DevicePatch{}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -240,7 +240,7 @@ func resourceDataboxEdgeDeviceUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceDataboxEdgeDeviceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataboxEdge.DeviceClient
+	client := meta.(*clients.Client).DataboxEdge().DeviceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -104,7 +104,7 @@ func (DataboxEdgeDeviceResource) Exists(ctx context.Context, clients *clients.Cl
 		return nil, err
 	}
 
-	resp, err := clients.DataboxEdge.DeviceClient.Get(ctx, id.Name, id.ResourceGroup)
+	resp, err := clients.DataboxEdge().DeviceClient.Get(ctx, id.Name, id.ResourceGroup)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Databox Edge Device Name %q (resource group: %q): %+v", id.Name, id.ResourceGroup, err)
 	}
@@ -260,7 +260,7 @@ func resourceDataboxEdgeOrder() *schema.Resource {
 }
 func resourceDataboxEdgeOrderCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).DataboxEdge.OrderClient
+	client := meta.(*clients.Client).DataboxEdge().OrderClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -311,7 +311,7 @@ func resourceDataboxEdgeOrderCreateUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceDataboxEdgeOrderRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataboxEdge.OrderClient
+	client := meta.(*clients.Client).DataboxEdge().OrderClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -360,7 +360,7 @@ func resourceDataboxEdgeOrderRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceDataboxEdgeOrderDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataboxEdge.OrderClient
+	client := meta.(*clients.Client).DataboxEdge().OrderClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
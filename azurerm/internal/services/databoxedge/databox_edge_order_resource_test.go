@@ -73,7 +73,7 @@ func (DataboxEdgeOrderResource) Exists(ctx context.Context, clients *clients.Cli
 		return nil, err
 	}
 
-	resp, err := clients.DataboxEdge.OrderClient.Get(ctx, id.DeviceName, id.ResourceGroup)
+	resp, err := clients.DataboxEdge().OrderClient.Get(ctx, id.DeviceName, id.ResourceGroup)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Databox Edge Order Device Name %q (resource group: %q): %+v", id.DeviceName, id.ResourceGroup, err)
 	}
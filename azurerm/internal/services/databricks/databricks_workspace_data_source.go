@@ -49,7 +49,7 @@ func dataSourceDatabricksWorkspace() *schema.Resource {
 }
 
 func dataSourceDatabricksWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataBricks.WorkspacesClient
+	client := meta.(*clients.Client).DataBricks().WorkspacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
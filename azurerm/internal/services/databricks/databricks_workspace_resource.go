@@ -141,7 +141,7 @@ func resourceDatabricksWorkspace() *schema.Resource {
 }
 
 func resourceDatabricksWorkspaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataBricks.WorkspacesClient
+	client := meta.(*clients.Client).DataBricks().WorkspacesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	subscriptionID := meta.(*clients.Client).Account.SubscriptionId
@@ -219,7 +219,7 @@ func resourceDatabricksWorkspaceCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceDatabricksWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataBricks.WorkspacesClient
+	client := meta.(*clients.Client).DataBricks().WorkspacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -270,7 +270,7 @@ func resourceDatabricksWorkspaceRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceDatabricksWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataBricks.WorkspacesClient
+	client := meta.(*clients.Client).DataBricks().WorkspacesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -126,7 +126,7 @@ func dataSourceDataFactory() *schema.Resource {
 }
 
 func dataSourceDataFactoryRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.FactoriesClient
+	client := meta.(*clients.Client).DataFactory().FactoriesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -153,7 +153,7 @@ func resourceDataFactoryDatasetAzureBlob() *schema.Resource {
 }
 
 func resourceDataFactoryDatasetAzureBlobCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -241,7 +241,7 @@ func resourceDataFactoryDatasetAzureBlobCreateUpdate(d *schema.ResourceData, met
 }
 
 func resourceDataFactoryDatasetAzureBlobRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -324,7 +324,7 @@ func resourceDataFactoryDatasetAzureBlobRead(d *schema.ResourceData, meta interf
 }
 
 func resourceDataFactoryDatasetAzureBlobDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -146,7 +146,7 @@ func resourceDataFactoryDatasetCosmosDbSQLAPI() *schema.Resource {
 }
 
 func resourceDataFactoryDatasetCosmosDbSQLAPICreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -235,7 +235,7 @@ func resourceDataFactoryDatasetCosmosDbSQLAPICreateUpdate(d *schema.ResourceData
 }
 
 func resourceDataFactoryDatasetCosmosDbSQLAPIRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -312,7 +312,7 @@ func resourceDataFactoryDatasetCosmosDbSQLAPIRead(d *schema.ResourceData, meta i
 }
 
 func resourceDataFactoryDatasetCosmosDbSQLAPIDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
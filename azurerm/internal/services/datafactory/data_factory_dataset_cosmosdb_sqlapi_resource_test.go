@@ -73,7 +73,7 @@ func (t DatasetCosmosDbSQLAPIResource) Exists(ctx context.Context, clients *clie
 	dataFactoryName := id.Path["factories"]
 	name := id.Path["datasets"]
 
-	resp, err := clients.DataFactory.DatasetClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	resp, err := clients.DataFactory().DatasetClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory Dataset CosomsDB SQL (%s): %+v", id, err)
 	}
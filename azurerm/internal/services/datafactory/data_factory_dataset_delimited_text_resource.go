@@ -268,7 +268,7 @@ func resourceDataFactoryDatasetDelimitedText() *schema.Resource {
 }
 
 func resourceDataFactoryDatasetDelimitedTextCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -371,7 +371,7 @@ func resourceDataFactoryDatasetDelimitedTextCreateUpdate(d *schema.ResourceData,
 }
 
 func resourceDataFactoryDatasetDelimitedTextRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -508,7 +508,7 @@ func resourceDataFactoryDatasetDelimitedTextRead(d *schema.ResourceData, meta in
 }
 
 func resourceDataFactoryDatasetDelimitedTextDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -92,7 +92,7 @@ func (t DatasetDelimitedTextResource) Exists(ctx context.Context, clients *clien
 	dataFactoryName := id.Path["factories"]
 	name := id.Path["datasets"]
 
-	resp, err := clients.DataFactory.DatasetClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	resp, err := clients.DataFactory().DatasetClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory Dataset Delimited Text (%s): %+v", id, err)
 	}
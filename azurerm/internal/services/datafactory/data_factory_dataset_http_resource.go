@@ -160,7 +160,7 @@ func resourceDataFactoryDatasetHTTP() *schema.Resource {
 }
 
 func resourceDataFactoryDatasetHTTPCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -251,7 +251,7 @@ func resourceDataFactoryDatasetHTTPCreateUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceDataFactoryDatasetHTTPRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -340,7 +340,7 @@ func resourceDataFactoryDatasetHTTPRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceDataFactoryDatasetHTTPDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -202,7 +202,7 @@ func resourceDataFactoryDatasetJSON() *schema.Resource {
 }
 
 func resourceDataFactoryDatasetJSONCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -297,7 +297,7 @@ func resourceDataFactoryDatasetJSONCreateUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceDataFactoryDatasetJSONRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -385,7 +385,7 @@ func resourceDataFactoryDatasetJSONRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceDataFactoryDatasetJSONDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -73,7 +73,7 @@ func (t DatasetJSONResource) Exists(ctx context.Context, clients *clients.Client
 	dataFactoryName := id.Path["factories"]
 	name := id.Path["datasets"]
 
-	resp, err := clients.DataFactory.DatasetClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	resp, err := clients.DataFactory().DatasetClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory Dataset JSON (%s): %+v", id, err)
 	}
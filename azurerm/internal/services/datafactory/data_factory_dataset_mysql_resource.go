@@ -145,7 +145,7 @@ func resourceDataFactoryDatasetMySQL() *schema.Resource {
 }
 
 func resourceDataFactoryDatasetMySQLCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -233,7 +233,7 @@ func resourceDataFactoryDatasetMySQLCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceDataFactoryDatasetMySQLRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -310,7 +310,7 @@ func resourceDataFactoryDatasetMySQLRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceDataFactoryDatasetMySQLDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.DatasetClient
+	client := meta.(*clients.Client).DataFactory().DatasetClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
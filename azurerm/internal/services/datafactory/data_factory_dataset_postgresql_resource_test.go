@@ -72,7 +72,7 @@ func (t DatasetPostgreSQLResource) Exists(ctx context.Context, clients *clients.
 	dataFactoryName := id.Path["factories"]
 	name := id.Path["datasets"]
 
-	resp, err := clients.DataFactory.DatasetClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	resp, err := clients.DataFactory().DatasetClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory Dataset PostgreSQL (%s): %+v", id, err)
 	}
@@ -90,7 +90,7 @@ func resourceDataFactoryIntegrationRuntimeAzure() *schema.Resource {
 }
 
 func resourceDataFactoryIntegrationRuntimeAzureCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	client := meta.(*clients.Client).DataFactory().IntegrationRuntimesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -148,7 +148,7 @@ func resourceDataFactoryIntegrationRuntimeAzureCreateUpdate(d *schema.ResourceDa
 }
 
 func resourceDataFactoryIntegrationRuntimeAzureRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	client := meta.(*clients.Client).DataFactory().IntegrationRuntimesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -208,7 +208,7 @@ func resourceDataFactoryIntegrationRuntimeAzureRead(d *schema.ResourceData, meta
 }
 
 func resourceDataFactoryIntegrationRuntimeAzureDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	client := meta.(*clients.Client).DataFactory().IntegrationRuntimesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -199,7 +199,7 @@ func (t IntegrationRuntimeAzureResource) Exists(ctx context.Context, clients *cl
 	dataFactoryName := id.Path["factories"]
 	name := id.Path["integrationruntimes"]
 
-	resp, err := clients.DataFactory.IntegrationRuntimesClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	resp, err := clients.DataFactory().IntegrationRuntimesClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory Azure Integration Runtime (%s): %+v", id, err)
 	}
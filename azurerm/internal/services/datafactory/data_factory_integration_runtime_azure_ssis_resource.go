@@ -201,7 +201,7 @@ func resourceDataFactoryIntegrationRuntimeAzureSsis() *schema.Resource {
 }
 
 func resourceDataFactoryIntegrationRuntimeAzureSsisCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	client := meta.(*clients.Client).DataFactory().IntegrationRuntimesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -258,7 +258,7 @@ func resourceDataFactoryIntegrationRuntimeAzureSsisCreateUpdate(d *schema.Resour
 }
 
 func resourceDataFactoryIntegrationRuntimeAzureSsisRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	client := meta.(*clients.Client).DataFactory().IntegrationRuntimesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -332,7 +332,7 @@ func resourceDataFactoryIntegrationRuntimeAzureSsisRead(d *schema.ResourceData,
 }
 
 func resourceDataFactoryIntegrationRuntimeAzureSsisDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	client := meta.(*clients.Client).DataFactory().IntegrationRuntimesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
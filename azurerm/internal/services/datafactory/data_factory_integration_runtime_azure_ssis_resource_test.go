@@ -291,7 +291,7 @@ func (t IntegrationRuntimeManagedSsisResource) Exists(ctx context.Context, clien
 	dataFactoryName := id.Path["factories"]
 	name := id.Path["integrationruntimes"]
 
-	resp, err := clients.DataFactory.IntegrationRuntimesClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	resp, err := clients.DataFactory().IntegrationRuntimesClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory Integration Runtime Managed SSIS (%s): %+v", id, err)
 	}
@@ -201,7 +201,7 @@ func resourceDataFactoryIntegrationRuntimeManaged() *schema.Resource {
 }
 
 func resourceDataFactoryIntegrationRuntimeManagedCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	client := meta.(*clients.Client).DataFactory().IntegrationRuntimesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -258,7 +258,7 @@ func resourceDataFactoryIntegrationRuntimeManagedCreateUpdate(d *schema.Resource
 }
 
 func resourceDataFactoryIntegrationRuntimeManagedRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	client := meta.(*clients.Client).DataFactory().IntegrationRuntimesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -332,7 +332,7 @@ func resourceDataFactoryIntegrationRuntimeManagedRead(d *schema.ResourceData, me
 }
 
 func resourceDataFactoryIntegrationRuntimeManagedDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	client := meta.(*clients.Client).DataFactory().IntegrationRuntimesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -362,7 +362,7 @@ func (t IntegrationRuntimeManagedResource) Exists(ctx context.Context, clients *
 	dataFactoryName := id.Path["factories"]
 	name := id.Path["integrationruntimes"]
 
-	resp, err := clients.DataFactory.IntegrationRuntimesClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	resp, err := clients.DataFactory().IntegrationRuntimesClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory Integration Runtime Managed (%s): %+v", id, err)
 	}
@@ -92,7 +92,7 @@ func resourceDataFactoryIntegrationRuntimeSelfHosted() *schema.Resource {
 }
 
 func resourceDataFactoryIntegrationRuntimeSelfHostedCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	client := meta.(*clients.Client).DataFactory().IntegrationRuntimesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -151,7 +151,7 @@ func resourceDataFactoryIntegrationRuntimeSelfHostedCreateUpdate(d *schema.Resou
 }
 
 func resourceDataFactoryIntegrationRuntimeSelfHostedRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	client := meta.(*clients.Client).DataFactory().IntegrationRuntimesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -217,7 +217,7 @@ func resourceDataFactoryIntegrationRuntimeSelfHostedRead(d *schema.ResourceData,
 }
 
 func resourceDataFactoryIntegrationRuntimeSelfHostedDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	client := meta.(*clients.Client).DataFactory().IntegrationRuntimesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -224,7 +224,7 @@ func (t IntegrationRuntimeSelfHostedResource) Exists(ctx context.Context, client
 	dataFactoryName := id.Path["factories"]
 	name := id.Path["integrationruntimes"]
 
-	resp, err := clients.DataFactory.IntegrationRuntimesClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	resp, err := clients.DataFactory().IntegrationRuntimesClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory Integration Runtime Self Hosted (%s): %+v", id, err)
 	}
@@ -101,7 +101,7 @@ func (t LinkedServiceAzureBlobStorageResource) Exists(ctx context.Context, clien
 	dataFactoryName := id.Path["factories"]
 	name := id.Path["linkedservices"]
 
-	resp, err := clients.DataFactory.LinkedServiceClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	resp, err := clients.DataFactory().LinkedServiceClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory Linked Service Azure Storage Blob (%s): %+v", id, err)
 	}
@@ -124,7 +124,7 @@ func resourceDataFactoryLinkedServiceAzureFileStorage() *schema.Resource {
 }
 
 func resourceDataFactoryLinkedServiceAzureFileStorageCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -209,7 +209,7 @@ func resourceDataFactoryLinkedServiceAzureFileStorageCreateUpdate(d *schema.Reso
 }
 
 func resourceDataFactoryLinkedServiceAzureFileStorageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -269,7 +269,7 @@ func resourceDataFactoryLinkedServiceAzureFileStorageRead(d *schema.ResourceData
 }
 
 func resourceDataFactoryLinkedServiceAzureFileStorageDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
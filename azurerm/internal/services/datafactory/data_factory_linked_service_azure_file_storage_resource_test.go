@@ -87,7 +87,7 @@ func (t LinkedServiceAzureFileStorageResource) Exists(ctx context.Context, clien
 	dataFactoryName := id.Path["factories"]
 	name := id.Path["linkedservices"]
 
-	resp, err := clients.DataFactory.LinkedServiceClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	resp, err := clients.DataFactory().LinkedServiceClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory Linked Service Azure File Blob (%s): %+v", id, err)
 	}
@@ -105,7 +105,7 @@ func resourceDataFactoryLinkedServiceAzureFunction() *schema.Resource {
 }
 
 func resourceDataFactoryLinkedServiceAzureFunctionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -178,7 +178,7 @@ func resourceDataFactoryLinkedServiceAzureFunctionCreateUpdate(d *schema.Resourc
 }
 
 func resourceDataFactoryLinkedServiceAzureFunctionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -234,7 +234,7 @@ func resourceDataFactoryLinkedServiceAzureFunctionRead(d *schema.ResourceData, m
 }
 
 func resourceDataFactoryLinkedServiceAzureFunctionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
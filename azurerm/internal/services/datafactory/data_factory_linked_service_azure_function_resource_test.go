@@ -71,7 +71,7 @@ func (t LinkedServiceAzureFunctionResource) Exists(ctx context.Context, clients
 	dataFactoryName := id.Path["factories"]
 	name := id.Path["linkedservices"]
 
-	resp, err := clients.DataFactory.LinkedServiceClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	resp, err := clients.DataFactory().LinkedServiceClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory Linked Service Azure Function (%s): %+v", id, err)
 	}
@@ -99,7 +99,7 @@ func resourceDataFactoryLinkedServiceAzureTableStorage() *schema.Resource {
 }
 
 func resourceDataFactoryLinkedServiceTableStorageCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -171,7 +171,7 @@ func resourceDataFactoryLinkedServiceTableStorageCreateUpdate(d *schema.Resource
 }
 
 func resourceDataFactoryLinkedServiceTableStorageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -225,7 +225,7 @@ func resourceDataFactoryLinkedServiceTableStorageRead(d *schema.ResourceData, me
 }
 
 func resourceDataFactoryLinkedServiceTableStorageDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -71,7 +71,7 @@ func (t LinkedServiceAzureTableStorageResource) Exists(ctx context.Context, clie
 	dataFactoryName := id.Path["factories"]
 	name := id.Path["linkedservices"]
 
-	resp, err := clients.DataFactory.LinkedServiceClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	resp, err := clients.DataFactory().LinkedServiceClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory Linked Service Azure Table Storage (%s): %+v", id, err)
 	}
@@ -85,7 +85,7 @@ func (t LinkedServiceDataLakeStorageGen2Resource) Exists(ctx context.Context, cl
 	dataFactoryName := id.Path["factories"]
 	name := id.Path["linkedservices"]
 
-	resp, err := clients.DataFactory.LinkedServiceClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	resp, err := clients.DataFactory().LinkedServiceClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory LinkedServiceDataLakeStorageGen2Resource (%s): %+v", id, err)
 	}
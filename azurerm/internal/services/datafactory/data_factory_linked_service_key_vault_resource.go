@@ -100,8 +100,8 @@ func resourceDataFactoryLinkedServiceKeyVault() *schema.Resource {
 }
 
 func resourceDataFactoryLinkedServiceKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
-	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -181,9 +181,9 @@ func resourceDataFactoryLinkedServiceKeyVaultCreateUpdate(d *schema.ResourceData
 }
 
 func resourceDataFactoryLinkedServiceKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	resourcesClient := meta.(*clients.Client).Resource
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -258,7 +258,7 @@ func resourceDataFactoryLinkedServiceKeyVaultRead(d *schema.ResourceData, meta i
 }
 
 func resourceDataFactoryLinkedServiceKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
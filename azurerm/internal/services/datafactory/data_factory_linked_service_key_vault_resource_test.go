@@ -70,7 +70,7 @@ func (t LinkedServiceKeyVaultResource) Exists(ctx context.Context, clients *clie
 	dataFactoryName := id.Path["factories"]
 	name := id.Path["linkedservices"]
 
-	resp, err := clients.DataFactory.LinkedServiceClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	resp, err := clients.DataFactory().LinkedServiceClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory LinkedServiceKeyVaultResource (%s): %+v", id, err)
 	}
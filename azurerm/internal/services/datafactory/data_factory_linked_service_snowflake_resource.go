@@ -125,7 +125,7 @@ func resourceDataFactoryLinkedServiceSnowflake() *schema.Resource {
 }
 
 func resourceDataFactoryLinkedServiceSnowflakeCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -197,7 +197,7 @@ func resourceDataFactoryLinkedServiceSnowflakeCreateUpdate(d *schema.ResourceDat
 }
 
 func resourceDataFactoryLinkedServiceSnowflakeRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -267,7 +267,7 @@ func resourceDataFactoryLinkedServiceSnowflakeRead(d *schema.ResourceData, meta
 }
 
 func resourceDataFactoryLinkedServiceSnowflakeDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -125,7 +125,7 @@ func resourceDataFactoryLinkedServiceSynapse() *schema.Resource {
 }
 
 func resourceDataFactoryLinkedServiceSynapseCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -197,7 +197,7 @@ func resourceDataFactoryLinkedServiceSynapseCreateUpdate(d *schema.ResourceData,
 }
 
 func resourceDataFactoryLinkedServiceSynapseRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -263,7 +263,7 @@ func resourceDataFactoryLinkedServiceSynapseRead(d *schema.ResourceData, meta in
 }
 
 func resourceDataFactoryLinkedServiceSynapseDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -117,7 +117,7 @@ func resourceDataFactoryLinkedServiceWeb() *schema.Resource {
 }
 
 func resourceDataFactoryLinkedServiceWebCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -212,7 +212,7 @@ func resourceDataFactoryLinkedServiceWebCreateUpdate(d *schema.ResourceData, met
 }
 
 func resourceDataFactoryLinkedServiceWebRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -285,7 +285,7 @@ func resourceDataFactoryLinkedServiceWebRead(d *schema.ResourceData, meta interf
 }
 
 func resourceDataFactoryLinkedServiceWebDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	client := meta.(*clients.Client).DataFactory().LinkedServiceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -91,7 +91,7 @@ func resourceDataFactoryPipeline() *schema.Resource {
 }
 
 func resourceDataFactoryPipelineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.PipelinesClient
+	client := meta.(*clients.Client).DataFactory().PipelinesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -160,7 +160,7 @@ func resourceDataFactoryPipelineCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceDataFactoryPipelineRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.PipelinesClient
+	client := meta.(*clients.Client).DataFactory().PipelinesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -218,7 +218,7 @@ func resourceDataFactoryPipelineRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceDataFactoryPipelineDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.PipelinesClient
+	client := meta.(*clients.Client).DataFactory().PipelinesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -106,7 +106,7 @@ func (t PipelineResource) Exists(ctx context.Context, clients *clients.Client, s
 	dataFactoryName := id.Path["factories"]
 	name := id.Path["pipelines"]
 
-	resp, err := clients.DataFactory.PipelinesClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	resp, err := clients.DataFactory().PipelinesClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory Pipeline (%s): %+v", id, err)
 	}
@@ -173,7 +173,7 @@ func resourceDataFactory() *schema.Resource {
 }
 
 func resourceDataFactoryCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.FactoriesClient
+	client := meta.(*clients.Client).DataFactory().FactoriesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -243,7 +243,7 @@ func resourceDataFactoryCreateUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceDataFactoryRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.FactoriesClient
+	client := meta.(*clients.Client).DataFactory().FactoriesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -301,7 +301,7 @@ func resourceDataFactoryRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDataFactoryDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.FactoriesClient
+	client := meta.(*clients.Client).DataFactory().FactoriesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
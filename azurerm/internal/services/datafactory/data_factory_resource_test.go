@@ -174,7 +174,7 @@ func (t DataFactoryResource) Exists(ctx context.Context, clients *clients.Client
 	resourceGroup := id.ResourceGroup
 	name := id.Path["factories"]
 
-	resp, err := clients.DataFactory.FactoriesClient.Get(ctx, resourceGroup, name, "")
+	resp, err := clients.DataFactory().FactoriesClient.Get(ctx, resourceGroup, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory (%s): %+v", id, err)
 	}
@@ -190,7 +190,7 @@ func (DataFactoryResource) Destroy(ctx context.Context, client *clients.Client,
 	resourceGroup := id.ResourceGroup
 	name := id.Path["factories"]
 
-	resp, err := client.DataFactory.FactoriesClient.Delete(ctx, resourceGroup, name)
+	resp, err := client.DataFactory().FactoriesClient.Delete(ctx, resourceGroup, name)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
 			return nil, fmt.Errorf("delete on dataFactoryClient: %+v", err)
@@ -122,7 +122,7 @@ func resourceDataFactoryTriggerSchedule() *schema.Resource {
 }
 
 func resourceDataFactoryTriggerScheduleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.TriggersClient
+	client := meta.(*clients.Client).DataFactory().TriggersClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -207,7 +207,7 @@ func resourceDataFactoryTriggerScheduleCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceDataFactoryTriggerScheduleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.TriggersClient
+	client := meta.(*clients.Client).DataFactory().TriggersClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -269,7 +269,7 @@ func resourceDataFactoryTriggerScheduleRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceDataFactoryTriggerScheduleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataFactory.TriggersClient
+	client := meta.(*clients.Client).DataFactory().TriggersClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -66,7 +66,7 @@ func (t TriggerScheduleResource) Exists(ctx context.Context, clients *clients.Cl
 	dataFactoryName := id.Path["factories"]
 	name := id.Path["triggers"]
 
-	resp, err := clients.DataFactory.TriggersClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	resp, err := clients.DataFactory().TriggersClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory Trigger Schedule (%s): %+v", id, err)
 	}
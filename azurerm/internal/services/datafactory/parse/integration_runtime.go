@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type IntegrationRuntimeId struct {
@@ -73,3 +74,12 @@ func IntegrationRuntimeID(input string) (*IntegrationRuntimeId, error) {
 
 	return &resourceId, nil
 }
+
+// IntegrationRuntimeIDNormalized parses a IntegrationRuntime ID into an IntegrationRuntimeId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the IntegrationRuntimeID method should be used instead for validation etc.
+func IntegrationRuntimeIDNormalized(input string) (*IntegrationRuntimeId, error) {
+	return IntegrationRuntimeID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataFactory/factories/%s/integrationruntimes/%s"))
+}
@@ -1,5 +1,5 @@
 package datafactory
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=IntegrationRuntime -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/integrationruntimes/runtime1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LinkedService -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/linkedservices/linkedService1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=DataSet -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/facName1/datasets/dataSet1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=IntegrationRuntime -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/integrationruntimes/runtime1 -resource-type=azurerm_data_factory_integration_runtime_self_hosted
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LinkedService -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/linkedservices/linkedService1 -resource-type=azurerm_data_factory_linked_service_azure_blob_storage,azurerm_data_factory_linked_service_azure_file_storage,azurerm_data_factory_linked_service_azure_function,azurerm_data_factory_linked_service_azure_sql_database,azurerm_data_factory_linked_service_azure_table_storage,azurerm_data_factory_linked_service_cosmosdb,azurerm_data_factory_linked_service_data_lake_storage_gen2,azurerm_data_factory_linked_service_key_vault,azurerm_data_factory_linked_service_mysql,azurerm_data_factory_linked_service_postgresql,azurerm_data_factory_linked_service_sftp,azurerm_data_factory_linked_service_snowflake,azurerm_data_factory_linked_service_sql_server,azurerm_data_factory_linked_service_synapse,azurerm_data_factory_linked_service_web
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=DataSet -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/facName1/datasets/dataSet1 -resource-type=azurerm_data_factory_dataset_azure_blob,azurerm_data_factory_dataset_cosmosdb_sqlapi,azurerm_data_factory_dataset_delimited_text,azurerm_data_factory_dataset_http,azurerm_data_factory_dataset_json,azurerm_data_factory_dataset_mysql,azurerm_data_factory_dataset_postgresql,azurerm_data_factory_dataset_sql_server_table
@@ -96,7 +96,7 @@ func (t DataLakeAnalyticsAccountResource) Exists(ctx context.Context, clients *c
 
 	name := id.Path["accounts"]
 
-	resp, err := clients.Datalake.AnalyticsAccountsClient.Get(ctx, id.ResourceGroup, name)
+	resp, err := clients.Datalake().AnalyticsAccountsClient.Get(ctx, id.ResourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Date Lake Analytics Account %q (resource group: %q): %+v", name, id.ResourceGroup, err)
 	}
@@ -110,7 +110,7 @@ func (t DataLakeAnalyticsFirewallRuleResource) Exists(ctx context.Context, clien
 	accountName := id.Path["accounts"]
 	name := id.Path["firewallRules"]
 
-	resp, err := clients.Datalake.AnalyticsFirewallRulesClient.Get(ctx, id.ResourceGroup, accountName, name)
+	resp, err := clients.Datalake().AnalyticsFirewallRulesClient.Get(ctx, id.ResourceGroup, accountName, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Date Lake Analytics Firewall Rule %q (Account %q / Resource Group: %q): %v", name, accountName, id.ResourceGroup, err)
 	}
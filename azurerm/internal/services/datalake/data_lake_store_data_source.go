@@ -61,7 +61,7 @@ func dataSourceDataLakeStoreAccount() *schema.Resource {
 }
 
 func dataSourceArmDateLakeStoreAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Datalake.StoreAccountsClient
+	client := meta.(*clients.Client).Datalake().StoreAccountsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -36,7 +36,7 @@ func TestAccDataLakeStoreFileMigrateState(t *testing.T) {
 
 	client.StopContext = context.Background()
 
-	filesClient := client.Datalake.StoreFilesClient
+	filesClient := client.Datalake().StoreFilesClient
 
 	cases := map[string]struct {
 		StateVersion       int
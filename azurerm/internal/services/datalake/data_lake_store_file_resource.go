@@ -62,7 +62,7 @@ func resourceDataLakeStoreFile() *schema.Resource {
 }
 
 func resourceDataLakeStoreFileCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Datalake.StoreFilesClient
+	client := meta.(*clients.Client).Datalake().StoreFilesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	chunkSize := 4 * 1024 * 1024
@@ -124,7 +124,7 @@ func resourceDataLakeStoreFileCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceDataLakeStoreFileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Datalake.StoreFilesClient
+	client := meta.(*clients.Client).Datalake().StoreFilesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -151,7 +151,7 @@ func resourceDataLakeStoreFileRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceDataLakeStoreFileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Datalake.StoreFilesClient
+	client := meta.(*clients.Client).Datalake().StoreFilesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -111,7 +111,7 @@ func TestAccDataLakeStoreFile_requiresimport(t *testing.T) {
 }
 
 func (t DataLakeStoreFileResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	client := clients.Datalake.StoreFilesClient
+	client := clients.Datalake().StoreFilesClient
 	id, err := datalake.ParseDataLakeStoreFileId(state.ID, client.AdlsFileSystemDNSSuffix)
 	if err != nil {
 		return nil, err
@@ -110,7 +110,7 @@ func (t DataLakeStoreFirewallRuleResource) Exists(ctx context.Context, clients *
 	accountName := id.Path["accounts"]
 	name := id.Path["firewallRules"]
 
-	resp, err := clients.Datalake.StoreFirewallRulesClient.Get(ctx, resourceGroup, accountName, name)
+	resp, err := clients.Datalake().StoreFirewallRulesClient.Get(ctx, resourceGroup, accountName, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Date Lake Store Firewall Rule %q (Account %q / Resource Group: %q): %v", name, accountName, resourceGroup, err)
 	}
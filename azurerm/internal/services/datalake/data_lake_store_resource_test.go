@@ -158,7 +158,7 @@ func (t DataLakeStoreResource) Exists(ctx context.Context, clients *clients.Clie
 
 	name := id.Path["accounts"]
 
-	resp, err := clients.Datalake.StoreAccountsClient.Get(ctx, id.ResourceGroup, name)
+	resp, err := clients.Datalake().StoreAccountsClient.Get(ctx, id.ResourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Date Lake Store %q (resource group: %q): %+v", name, id.ResourceGroup, err)
 	}
@@ -57,7 +57,7 @@ func dataSourceDataShareAccount() *schema.Resource {
 }
 
 func dataSourceDataShareAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.AccountClient
+	client := meta.(*clients.Client).DataShare().AccountClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -84,7 +84,7 @@ func resourceDataShareAccount() *schema.Resource {
 }
 
 func resourceDataShareAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.AccountClient
+	client := meta.(*clients.Client).DataShare().AccountClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -132,7 +132,7 @@ func resourceDataShareAccountCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceDataShareAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.AccountClient
+	client := meta.(*clients.Client).DataShare().AccountClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -161,7 +161,7 @@ func resourceDataShareAccountRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceDataShareAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.AccountClient
+	client := meta.(*clients.Client).DataShare().AccountClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -172,7 +172,7 @@ func resourceDataShareAccountUpdate(d *schema.ResourceData, meta interface{}) er
 
 	props := datashare.AccountUpdateParameters{}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		props.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -184,7 +184,7 @@ func resourceDataShareAccountUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceDataShareAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.AccountClient
+	client := meta.(*clients.Client).DataShare().AccountClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -115,7 +115,7 @@ func (t DataShareAccountResource) Exists(ctx context.Context, clients *clients.C
 		return nil, err
 	}
 
-	resp, err := clients.DataShare.AccountClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.DataShare().AccountClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Data Share Account %q (resource group: %q): %+v", id.Name, id.ResourceGroup, err)
 	}
@@ -76,9 +76,9 @@ func dataSourceDataShare() *schema.Resource {
 }
 
 func dataSourceDataShareRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.SharesClient
+	client := meta.(*clients.Client).DataShare().SharesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	syncClient := meta.(*clients.Client).DataShare.SynchronizationClient
+	syncClient := meta.(*clients.Client).DataShare().SynchronizationClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
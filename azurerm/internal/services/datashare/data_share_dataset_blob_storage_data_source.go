@@ -81,7 +81,7 @@ func dataSourceDataShareDatasetBlobStorage() *schema.Resource {
 }
 
 func dataSourceDataShareDatasetBlobStorageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
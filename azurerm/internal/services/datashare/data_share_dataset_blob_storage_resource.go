@@ -112,7 +112,7 @@ func resourceDataShareDataSetBlobStorage() *schema.Resource {
 }
 
 func resourceDataShareDataSetBlobStorageCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -187,8 +187,8 @@ func resourceDataShareDataSetBlobStorageCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceDataShareDataSetBlobStorageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
-	shareClient := meta.(*clients.Client).DataShare.SharesClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
+	shareClient := meta.(*clients.Client).DataShare().SharesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -256,7 +256,7 @@ func resourceDataShareDataSetBlobStorageRead(d *schema.ResourceData, meta interf
 }
 
 func resourceDataShareDataSetBlobStorageDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
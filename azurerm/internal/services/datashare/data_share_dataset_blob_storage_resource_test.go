@@ -88,7 +88,7 @@ func (t DataShareDataSetBlobStorageResource) Exists(ctx context.Context, clients
 		return nil, err
 	}
 
-	resp, err := clients.DataShare.DataSetClient.Get(ctx, id.ResourceGroup, id.AccountName, id.ShareName, id.Name)
+	resp, err := clients.DataShare().DataSetClient.Get(ctx, id.ResourceGroup, id.AccountName, id.ShareName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Data Share Data Set %q (resource group: %q): %+v", id.Name, id.ResourceGroup, err)
 	}
@@ -58,7 +58,7 @@ func dataSourceDataShareDatasetDataLakeGen1() *schema.Resource {
 }
 
 func dataSourceDataShareDatasetDataLakeGen1Read(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -82,7 +82,7 @@ func resourceDataShareDataSetDataLakeGen1() *schema.Resource {
 }
 
 func resourceDataShareDataSetDataLakeGen1Create(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -152,8 +152,8 @@ func resourceDataShareDataSetDataLakeGen1Create(d *schema.ResourceData, meta int
 }
 
 func resourceDataShareDataSetDataLakeGen1Read(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
-	shareClient := meta.(*clients.Client).DataShare.SharesClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
+	shareClient := meta.(*clients.Client).DataShare().SharesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -209,7 +209,7 @@ func resourceDataShareDataSetDataLakeGen1Read(d *schema.ResourceData, meta inter
 }
 
 func resourceDataShareDataSetDataLakeGen1Delete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
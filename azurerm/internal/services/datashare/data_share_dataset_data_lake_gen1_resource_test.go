@@ -71,7 +71,7 @@ func (t DataShareDataSetDataLakeGen1Resource) Exists(ctx context.Context, client
 		return nil, err
 	}
 
-	resp, err := clients.DataShare.DataSetClient.Get(ctx, id.ResourceGroup, id.AccountName, id.ShareName, id.Name)
+	resp, err := clients.DataShare().DataSetClient.Get(ctx, id.ResourceGroup, id.AccountName, id.ShareName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Data Share Data Set %q (resource group: %q): %+v", id.Name, id.ResourceGroup, err)
 	}
@@ -63,7 +63,7 @@ func dataSourceDataShareDatasetDataLakeGen2() *schema.Resource {
 }
 
 func dataSourceDataShareDatasetDataLakeGen2Read(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -91,7 +91,7 @@ func resourceDataShareDataSetDataLakeGen2() *schema.Resource {
 }
 
 func resourceDataShareDataSetDataLakeGen2Create(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -172,8 +172,8 @@ func resourceDataShareDataSetDataLakeGen2Create(d *schema.ResourceData, meta int
 }
 
 func resourceDataShareDataSetDataLakeGen2Read(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
-	shareClient := meta.(*clients.Client).DataShare.SharesClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
+	shareClient := meta.(*clients.Client).DataShare().SharesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -233,7 +233,7 @@ func resourceDataShareDataSetDataLakeGen2Read(d *schema.ResourceData, meta inter
 }
 
 func resourceDataShareDataSetDataLakeGen2Delete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -71,7 +71,7 @@ func resourceDataShareDataSetKustoCluster() *schema.Resource {
 }
 
 func resourceDataShareDataSetKustoClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -118,8 +118,8 @@ func resourceDataShareDataSetKustoClusterCreate(d *schema.ResourceData, meta int
 }
 
 func resourceDataShareDataSetKustoClusterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
-	shareClient := meta.(*clients.Client).DataShare.SharesClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
+	shareClient := meta.(*clients.Client).DataShare().SharesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -163,7 +163,7 @@ func resourceDataShareDataSetKustoClusterRead(d *schema.ResourceData, meta inter
 }
 
 func resourceDataShareDataSetKustoClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
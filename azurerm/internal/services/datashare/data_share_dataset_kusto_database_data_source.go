@@ -52,7 +52,7 @@ func dataSourceDataShareDatasetKustoDatabase() *schema.Resource {
 }
 
 func dataSourceDataShareDatasetKustoDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
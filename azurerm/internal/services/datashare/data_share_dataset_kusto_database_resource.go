@@ -71,7 +71,7 @@ func resourceDataShareDataSetKustoDatabase() *schema.Resource {
 }
 
 func resourceDataShareDataSetKustoDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -119,8 +119,8 @@ func resourceDataShareDataSetKustoDatabaseCreate(d *schema.ResourceData, meta in
 }
 
 func resourceDataShareDataSetKustoDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
-	shareClient := meta.(*clients.Client).DataShare.SharesClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
+	shareClient := meta.(*clients.Client).DataShare().SharesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -164,7 +164,7 @@ func resourceDataShareDataSetKustoDatabaseRead(d *schema.ResourceData, meta inte
 }
 
 func resourceDataShareDataSetKustoDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.DataSetClient
+	client := meta.(*clients.Client).DataShare().DataSetClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -55,7 +55,7 @@ func (t DataShareDataSetKustoDatabaseResource) Exists(ctx context.Context, clien
 		return nil, err
 	}
 
-	respRaw, err := clients.DataShare.DataSetClient.Get(ctx, id.ResourceGroup, id.AccountName, id.ShareName, id.Name)
+	respRaw, err := clients.DataShare().DataSetClient.Get(ctx, id.ResourceGroup, id.AccountName, id.ShareName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Data Share Data Set %q (resource group: %q): %+v", id.Name, id.ResourceGroup, err)
 	}
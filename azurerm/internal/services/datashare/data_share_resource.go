@@ -108,9 +108,9 @@ func resourceDataShare() *schema.Resource {
 }
 
 func resourceDataShareCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.SharesClient
+	client := meta.(*clients.Client).DataShare().SharesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	syncClient := meta.(*clients.Client).DataShare.SynchronizationClient
+	syncClient := meta.(*clients.Client).DataShare().SynchronizationClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -174,9 +174,9 @@ func resourceDataShareCreateUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceDataShareRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.SharesClient
+	client := meta.(*clients.Client).DataShare().SharesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	syncClient := meta.(*clients.Client).DataShare.SynchronizationClient
+	syncClient := meta.(*clients.Client).DataShare().SynchronizationClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -229,8 +229,8 @@ func resourceDataShareRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDataShareDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DataShare.SharesClient
-	syncClient := meta.(*clients.Client).DataShare.SynchronizationClient
+	client := meta.(*clients.Client).DataShare().SharesClient
+	syncClient := meta.(*clients.Client).DataShare().SynchronizationClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
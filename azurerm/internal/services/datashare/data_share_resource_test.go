@@ -135,7 +135,7 @@ func (t DataShareResource) Exists(ctx context.Context, clients *clients.Client,
 		return nil, err
 	}
 
-	resp, err := clients.DataShare.SharesClient.Get(ctx, id.ResourceGroup, id.AccountName, id.Name)
+	resp, err := clients.DataShare().SharesClient.Get(ctx, id.ResourceGroup, id.AccountName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Data Share %q (resource group: %q): %+v", id.Name, id.ResourceGroup, err)
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ShareId struct {
@@ -73,3 +74,12 @@ func ShareID(input string) (*ShareId, error) {
 
 	return &resourceId, nil
 }
+
+// ShareIDNormalized parses a Share ID into an ShareId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ShareID method should be used instead for validation etc.
+func ShareIDNormalized(input string) (*ShareId, error) {
+	return ShareID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataShare/accounts/%s/shares/%s"))
+}
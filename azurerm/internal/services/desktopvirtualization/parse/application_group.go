@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApplicationGroupId struct {
@@ -68,6 +69,15 @@ func ApplicationGroupID(input string) (*ApplicationGroupId, error) {
 	return &resourceId, nil
 }
 
+// ApplicationGroupIDNormalized parses a ApplicationGroup ID into an ApplicationGroupId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ApplicationGroupID method should be used instead for validation etc.
+func ApplicationGroupIDNormalized(input string) (*ApplicationGroupId, error) {
+	return ApplicationGroupID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DesktopVirtualization/applicationGroups/%s"))
+}
+
 // ApplicationGroupIDInsensitively parses an ApplicationGroup ID into an ApplicationGroupId struct, insensitively
 // This should only be used to parse an ID for rewriting, the ApplicationGroupID
 // method should be used instead for validation etc.
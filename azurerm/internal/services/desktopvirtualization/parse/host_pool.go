@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type HostPoolId struct {
@@ -68,6 +69,15 @@ func HostPoolID(input string) (*HostPoolId, error) {
 	return &resourceId, nil
 }
 
+// HostPoolIDNormalized parses a HostPool ID into an HostPoolId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the HostPoolID method should be used instead for validation etc.
+func HostPoolIDNormalized(input string) (*HostPoolId, error) {
+	return HostPoolID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DesktopVirtualization/hostPools/%s"))
+}
+
 // HostPoolIDInsensitively parses an HostPool ID into an HostPoolId struct, insensitively
 // This should only be used to parse an ID for rewriting, the HostPoolID
 // method should be used instead for validation etc.
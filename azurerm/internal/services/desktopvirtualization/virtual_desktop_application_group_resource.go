@@ -104,7 +104,7 @@ func resourceVirtualDesktopApplicationGroup() *schema.Resource {
 }
 
 func resourceVirtualDesktopApplicationGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DesktopVirtualization.ApplicationGroupsClient
+	client := meta.(*clients.Client).DesktopVirtualization().ApplicationGroupsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 
 	log.Printf("[INFO] preparing arguments for Virtual Desktop Application Group creation")
@@ -112,12 +112,14 @@ func resourceVirtualDesktopApplicationGroupCreateUpdate(d *schema.ResourceData,
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(name, applicationGroupType)
-	defer locks.UnlockByName(name, applicationGroupType)
-
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	if err := locks.ByNameWithContext(ctx, name, applicationGroupType); err != nil {
+		return fmt.Errorf("acquiring lock for Virtual Desktop Application Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	defer locks.UnlockByName(name, applicationGroupType)
+
 	resourceId := parse.NewApplicationGroupID(subscriptionId, resourceGroup, name).ID()
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name)
@@ -155,7 +157,7 @@ func resourceVirtualDesktopApplicationGroupCreateUpdate(d *schema.ResourceData,
 }
 
 func resourceVirtualDesktopApplicationGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DesktopVirtualization.ApplicationGroupsClient
+	client := meta.(*clients.Client).DesktopVirtualization().ApplicationGroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -204,18 +206,20 @@ func resourceVirtualDesktopApplicationGroupRead(d *schema.ResourceData, meta int
 }
 
 func resourceVirtualDesktopApplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DesktopVirtualization.ApplicationGroupsClient
+	client := meta.(*clients.Client).DesktopVirtualization().ApplicationGroupsClient
 
 	id, err := parse.ApplicationGroupID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(id.Name, applicationGroupType)
-	defer locks.UnlockByName(id.Name, applicationGroupType)
-
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+
+	if err := locks.ByNameWithContext(ctx, id.Name, applicationGroupType); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
+	defer locks.UnlockByName(id.Name, applicationGroupType)
 	if _, err = client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
 		return fmt.Errorf("deleting Virtual Desktop Application Group %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
@@ -100,7 +100,7 @@ func (VirtualDesktopApplicationGroupResource) Exists(ctx context.Context, client
 		return nil, err
 	}
 
-	resp, err := clients.DesktopVirtualization.ApplicationGroupsClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.DesktopVirtualization().ApplicationGroupsClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Virtual Desktop Application Group %q (Resource Group: %q) does not exist", id.Name, id.ResourceGroup)
 	}
@@ -161,7 +161,7 @@ func resourceVirtualDesktopHostPool() *schema.Resource {
 }
 
 func resourceVirtualDesktopHostPoolCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DesktopVirtualization.HostPoolsClient
+	client := meta.(*clients.Client).DesktopVirtualization().HostPoolsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -214,7 +214,7 @@ func resourceVirtualDesktopHostPoolCreateUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceVirtualDesktopHostPoolRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DesktopVirtualization.HostPoolsClient
+	client := meta.(*clients.Client).DesktopVirtualization().HostPoolsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -264,7 +264,7 @@ func resourceVirtualDesktopHostPoolRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceVirtualDesktopHostPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DesktopVirtualization.HostPoolsClient
+	client := meta.(*clients.Client).DesktopVirtualization().HostPoolsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
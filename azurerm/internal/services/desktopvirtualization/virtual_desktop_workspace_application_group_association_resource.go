@@ -65,7 +65,7 @@ func resourceVirtualDesktopWorkspaceApplicationGroupAssociation() *schema.Resour
 }
 
 func resourceVirtualDesktopWorkspaceApplicationGroupAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DesktopVirtualization.WorkspacesClient
+	client := meta.(*clients.Client).DesktopVirtualization().WorkspacesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -80,10 +80,14 @@ func resourceVirtualDesktopWorkspaceApplicationGroupAssociationCreate(d *schema.
 	}
 	associationId := parse.NewWorkspaceApplicationGroupAssociationId(*workspaceId, *applicationGroupId).ID()
 
-	locks.ByName(workspaceId.Name, workspaceResourceType)
+	if err := locks.ByNameWithContext(ctx, workspaceId.Name, workspaceResourceType); err != nil {
+		return fmt.Errorf("acquiring lock for Virtual Desktop Workspace Application Group Association (Workspace %q / Application Group %q): %+v", workspaceId.Name, applicationGroupId.Name, err)
+	}
 	defer locks.UnlockByName(workspaceId.Name, workspaceResourceType)
 
-	locks.ByName(applicationGroupId.Name, applicationGroupType)
+	if err := locks.ByNameWithContext(ctx, applicationGroupId.Name, applicationGroupType); err != nil {
+		return fmt.Errorf("acquiring lock for Virtual Desktop Workspace Application Group Association (Workspace %q / Application Group %q): %+v", workspaceId.Name, applicationGroupId.Name, err)
+	}
 	defer locks.UnlockByName(applicationGroupId.Name, applicationGroupType)
 
 	workspace, err := client.Get(ctx, workspaceId.ResourceGroup, workspaceId.Name)
@@ -117,7 +121,7 @@ func resourceVirtualDesktopWorkspaceApplicationGroupAssociationCreate(d *schema.
 }
 
 func resourceVirtualDesktopWorkspaceApplicationGroupAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DesktopVirtualization.WorkspacesClient
+	client := meta.(*clients.Client).DesktopVirtualization().WorkspacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -152,7 +156,7 @@ func resourceVirtualDesktopWorkspaceApplicationGroupAssociationRead(d *schema.Re
 }
 
 func resourceVirtualDesktopWorkspaceApplicationGroupAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DesktopVirtualization.WorkspacesClient
+	client := meta.(*clients.Client).DesktopVirtualization().WorkspacesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -161,10 +165,14 @@ func resourceVirtualDesktopWorkspaceApplicationGroupAssociationDelete(d *schema.
 		return err
 	}
 
-	locks.ByName(id.Workspace.Name, workspaceResourceType)
+	if err := locks.ByNameWithContext(ctx, id.Workspace.Name, workspaceResourceType); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.Workspace.Name, workspaceResourceType)
 
-	locks.ByName(id.ApplicationGroup.Name, applicationGroupType)
+	if err := locks.ByNameWithContext(ctx, id.ApplicationGroup.Name, applicationGroupType); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.ApplicationGroup.Name, applicationGroupType)
 
 	workspace, err := client.Get(ctx, id.Workspace.ResourceGroup, id.Workspace.Name)
@@ -94,7 +94,7 @@ func (t VirtualDesktopWorkspaceApplicationGroupAssociationResource) Exists(ctx c
 		return nil, err
 	}
 
-	resp, err := clients.DesktopVirtualization.WorkspacesClient.Get(ctx, id.Workspace.ResourceGroup, id.Workspace.Name)
+	resp, err := clients.DesktopVirtualization().WorkspacesClient.Get(ctx, id.Workspace.ResourceGroup, id.Workspace.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Virtual Desktop Workspace Application Group Association %q (Resource Group: %q) does not exist", id.Workspace.Name, id.Workspace.ResourceGroup)
 	}
@@ -80,7 +80,7 @@ func resourceArmDesktopVirtualizationWorkspace() *schema.Resource {
 }
 
 func resourceArmDesktopVirtualizationWorkspaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DesktopVirtualization.WorkspacesClient
+	client := meta.(*clients.Client).DesktopVirtualization().WorkspacesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -126,7 +126,7 @@ func resourceArmDesktopVirtualizationWorkspaceCreateUpdate(d *schema.ResourceDat
 }
 
 func resourceArmDesktopVirtualizationWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DesktopVirtualization.WorkspacesClient
+	client := meta.(*clients.Client).DesktopVirtualization().WorkspacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -162,18 +162,20 @@ func resourceArmDesktopVirtualizationWorkspaceRead(d *schema.ResourceData, meta
 }
 
 func resourceArmDesktopVirtualizationWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DesktopVirtualization.WorkspacesClient
+	client := meta.(*clients.Client).DesktopVirtualization().WorkspacesClient
 
 	id, err := parse.WorkspaceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(id.Name, workspaceResourceType)
-	defer locks.UnlockByName(id.Name, workspaceResourceType)
-
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+
+	if err := locks.ByNameWithContext(ctx, id.Name, workspaceResourceType); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
+	defer locks.UnlockByName(id.Name, workspaceResourceType)
 	if _, err = client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
 		return fmt.Errorf("deleting Desktop Virtualization Workspace %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
@@ -100,7 +100,7 @@ func (t AzureRMDesktopVirtualizationWorkspaceResource) Exists(ctx context.Contex
 		return nil, err
 	}
 
-	resp, err := clients.DesktopVirtualization.WorkspacesClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.DesktopVirtualization().WorkspacesClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Virtual Desktop Workspace %q (Resource Group: %q): %v", id.Name, id.ResourceGroup, err)
 	}
@@ -94,7 +94,7 @@ Since these are deprecated and can no longer be provisioned, version 3.0 of the
 }
 
 func resourceDevSpaceControllerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DevSpace.ControllersClient
+	client := meta.(*clients.Client).DevSpace().ControllersClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -157,7 +157,7 @@ func resourceDevSpaceControllerCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceDevSpaceControllerUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DevSpace.ControllersClient
+	client := meta.(*clients.Client).DevSpace().ControllersClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -185,7 +185,7 @@ func resourceDevSpaceControllerUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceDevSpaceControllerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DevSpace.ControllersClient
+	client := meta.(*clients.Client).DevSpace().ControllersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -225,7 +225,7 @@ func resourceDevSpaceControllerRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceDevSpaceControllerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DevSpace.ControllersClient
+	client := meta.(*clients.Client).DevSpace().ControllersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
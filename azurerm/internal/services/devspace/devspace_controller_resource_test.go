@@ -70,7 +70,7 @@ func (t DevSpaceControllerResource) Exists(ctx context.Context, clients *clients
 		return nil, err
 	}
 
-	resp, err := clients.DevSpace.ControllersClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.DevSpace().ControllersClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving DevSpace Controller %q (Resource Group: %q): %v", id.Name, id.ResourceGroup, err)
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ControllerId struct {
@@ -67,3 +68,12 @@ func ControllerID(input string) (*ControllerId, error) {
 
 	return &resourceId, nil
 }
+
+// ControllerIDNormalized parses a Controller ID into an ControllerId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ControllerID method should be used instead for validation etc.
+func ControllerIDNormalized(input string) (*ControllerId, error) {
+	return ControllerID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevSpaces/controllers/%s"))
+}
@@ -1,3 +1,3 @@
 package devspace
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Controller -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DevSpaces/controllers/controller1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Controller -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DevSpaces/controllers/controller1 -resource-type=azurerm_devspace_controller
@@ -99,7 +99,7 @@ func resourceDevTestGlobalVMShutdownSchedule() *schema.Resource {
 }
 
 func resourceDevTestGlobalVMShutdownScheduleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DevTestLabs.GlobalLabSchedulesClient
+	client := meta.(*clients.Client).DevTestLabs().GlobalLabSchedulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -177,7 +177,7 @@ func resourceDevTestGlobalVMShutdownScheduleCreateUpdate(d *schema.ResourceData,
 }
 
 func resourceDevTestGlobalVMShutdownScheduleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DevTestLabs.GlobalLabSchedulesClient
+	client := meta.(*clients.Client).DevTestLabs().GlobalLabSchedulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -217,7 +217,7 @@ func resourceDevTestGlobalVMShutdownScheduleRead(d *schema.ResourceData, meta in
 }
 
 func resourceDevTestGlobalVMShutdownScheduleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DevTestLabs.GlobalLabSchedulesClient
+	client := meta.(*clients.Client).DevTestLabs().GlobalLabSchedulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -110,7 +110,7 @@ func (DevTestGlobalVMShutdownScheduleResource) Exists(ctx context.Context, clien
 		return nil, err
 	}
 
-	resp, err := clients.DevTestLabs.GlobalLabSchedulesClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := clients.DevTestLabs().GlobalLabSchedulesClient.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Dev Test Lab Global Schedule %q (resource group: %q): %+v", id.Name, id.ResourceGroup, err)
 	}
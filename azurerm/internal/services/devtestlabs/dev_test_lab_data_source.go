@@ -73,7 +73,7 @@ func dataSourceDevTestLab() *schema.Resource {
 }
 
 func dataSourceDevTestLabRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DevTestLabs.LabsClient
+	client := meta.(*clients.Client).DevTestLabs().LabsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -94,7 +94,7 @@ func resourceDevTestLab() *schema.Resource {
 }
 
 func resourceDevTestLabCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DevTestLabs.LabsClient
+	client := meta.(*clients.Client).DevTestLabs().LabsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -152,7 +152,7 @@ func resourceDevTestLabCreateUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceDevTestLabRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DevTestLabs.LabsClient
+	client := meta.(*clients.Client).DevTestLabs().LabsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -196,7 +196,7 @@ func resourceDevTestLabRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDevTestLabDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DevTestLabs.LabsClient
+	client := meta.(*clients.Client).DevTestLabs().LabsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
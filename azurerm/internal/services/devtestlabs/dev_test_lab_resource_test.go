@@ -77,7 +77,7 @@ func (DevTestLabResource) Exists(ctx context.Context, clients *clients.Client, s
 	}
 	name := id.Path["labs"]
 
-	resp, err := clients.DevTestLabs.LabsClient.Get(ctx, id.ResourceGroup, name, "")
+	resp, err := clients.DevTestLabs().LabsClient.Get(ctx, id.ResourceGroup, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Dev Test Lab %q (resource group: %q): %+v", name, id.ResourceGroup, err)
 	}
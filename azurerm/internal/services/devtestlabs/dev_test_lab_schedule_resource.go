@@ -179,7 +179,7 @@ func resourceDevTestLabSchedules() *schema.Resource {
 }
 
 func resourceDevTestLabSchedulesCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DevTestLabs.LabSchedulesClient
+	client := meta.(*clients.Client).DevTestLabs().LabSchedulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -266,7 +266,7 @@ func resourceDevTestLabSchedulesCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceDevTestLabSchedulesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DevTestLabs.LabSchedulesClient
+	client := meta.(*clients.Client).DevTestLabs().LabSchedulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -321,7 +321,7 @@ func resourceDevTestLabSchedulesRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceDevTestLabSchedulesDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.VMExtensionClient
+	client := meta.(*clients.Client).Compute().VMExtensionClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
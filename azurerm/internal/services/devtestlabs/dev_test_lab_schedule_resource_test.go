@@ -105,7 +105,7 @@ func (DevTestLabScheduleResource) Exists(ctx context.Context, clients *clients.C
 	devTestLabName := id.Path["labs"]
 	name := id.Path["schedules"]
 
-	resp, err := clients.DevTestLabs.LabSchedulesClient.Get(ctx, id.ResourceGroup, devTestLabName, name, "")
+	resp, err := clients.DevTestLabs().LabSchedulesClient.Get(ctx, id.ResourceGroup, devTestLabName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Dev Test Lab Schedule %q (resource group: %q): %+v", name, id.ResourceGroup, err)
 	}
@@ -139,7 +139,7 @@ func (DevTestLinuxVirtualMachineResource) Exists(ctx context.Context, clients *c
 	labName := id.Path["labs"]
 	name := id.Path["virtualmachines"]
 
-	resp, err := clients.DevTestLabs.VirtualMachinesClient.Get(ctx, id.ResourceGroup, labName, name, "")
+	resp, err := clients.DevTestLabs().VirtualMachinesClient.Get(ctx, id.ResourceGroup, labName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving DevTest Linux Virtual Machine %q (Lab %q / Resource Group: %q): %v", name, labName, id.ResourceGroup, err)
 	}
@@ -78,7 +78,7 @@ func (DevTestPolicyResource) Exists(ctx context.Context, clients *clients.Client
 	policySetName := id.Path["policysets"]
 	name := id.Path["policies"]
 
-	resp, err := clients.DevTestLabs.PoliciesClient.Get(ctx, id.ResourceGroup, labName, policySetName, name, "")
+	resp, err := clients.DevTestLabs().PoliciesClient.Get(ctx, id.ResourceGroup, labName, policySetName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving DevTest Policy %q (Policy Set %q / Lab %q / Resource Group: %q): %v", name, policySetName, labName, id.ResourceGroup, err)
 	}
@@ -96,7 +96,7 @@ func dataSourceArmDevTestVirtualNetwork() *schema.Resource {
 }
 
 func dataSourceArmDevTestVnetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DevTestLabs.VirtualNetworksClient
+	client := meta.(*clients.Client).DevTestLabs().VirtualNetworksClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -108,7 +108,7 @@ func (DevTestVirtualNetworkResource) Exists(ctx context.Context, clients *client
 	labName := id.Path["labs"]
 	name := id.Path["virtualnetworks"]
 
-	resp, err := clients.DevTestLabs.VirtualNetworksClient.Get(ctx, id.ResourceGroup, labName, name, "")
+	resp, err := clients.DevTestLabs().VirtualNetworksClient.Get(ctx, id.ResourceGroup, labName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving DevTest Virtual Network %q (Lab %q / Resource Group: %q): %v", name, labName, id.ResourceGroup, err)
 	}
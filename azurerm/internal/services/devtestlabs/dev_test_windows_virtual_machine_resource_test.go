@@ -116,7 +116,7 @@ func (DevTestVirtualMachineResource) Exists(ctx context.Context, clients *client
 	labName := id.Path["labs"]
 	name := id.Path["virtualmachines"]
 
-	resp, err := clients.DevTestLabs.VirtualMachinesClient.Get(ctx, id.ResourceGroup, labName, name, "")
+	resp, err := clients.DevTestLabs().VirtualMachinesClient.Get(ctx, id.ResourceGroup, labName, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving DevTest Windows Virtual Machine %q (Lab %q / Resource Group: %q): %v", name, labName, id.ResourceGroup, err)
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ScheduleId struct {
@@ -67,3 +68,12 @@ func ScheduleID(input string) (*ScheduleId, error) {
 
 	return &resourceId, nil
 }
+
+// ScheduleIDNormalized parses a Schedule ID into an ScheduleId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ScheduleID method should be used instead for validation etc.
+func ScheduleIDNormalized(input string) (*ScheduleId, error) {
+	return ScheduleID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevTestLab/schedules/%s"))
+}
@@ -79,7 +79,7 @@ func resourceDigitalTwinsEndpointEventGrid() *schema.Resource {
 }
 func resourceDigitalTwinsEndpointEventGridCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).DigitalTwins.EndpointClient
+	client := meta.(*clients.Client).DigitalTwins().EndpointClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -133,7 +133,7 @@ func resourceDigitalTwinsEndpointEventGridCreateUpdate(d *schema.ResourceData, m
 
 func resourceDigitalTwinsEndpointEventGridRead(d *schema.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).DigitalTwins.EndpointClient
+	client := meta.(*clients.Client).DigitalTwins().EndpointClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -163,7 +163,7 @@ func resourceDigitalTwinsEndpointEventGridRead(d *schema.ResourceData, meta inte
 }
 
 func resourceDigitalTwinsEndpointEventGridDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DigitalTwins.EndpointClient
+	client := meta.(*clients.Client).DigitalTwins().EndpointClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -76,7 +76,7 @@ func resourceDigitalTwinsEndpointEventHub() *schema.Resource {
 }
 func resourceDigitalTwinsEndpointEventHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).DigitalTwins.EndpointClient
+	client := meta.(*clients.Client).DigitalTwins().EndpointClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -129,7 +129,7 @@ func resourceDigitalTwinsEndpointEventHubCreateUpdate(d *schema.ResourceData, me
 
 func resourceDigitalTwinsEndpointEventHubRead(d *schema.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).DigitalTwins.EndpointClient
+	client := meta.(*clients.Client).DigitalTwins().EndpointClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -158,7 +158,7 @@ func resourceDigitalTwinsEndpointEventHubRead(d *schema.ResourceData, meta inter
 }
 
 func resourceDigitalTwinsEndpointEventHubDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DigitalTwins.EndpointClient
+	client := meta.(*clients.Client).DigitalTwins().EndpointClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
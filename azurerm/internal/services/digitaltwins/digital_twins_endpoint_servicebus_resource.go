@@ -76,7 +76,7 @@ func resourceDigitalTwinsEndpointServiceBus() *schema.Resource {
 }
 func resourceDigitalTwinsEndpointServiceBusCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).DigitalTwins.EndpointClient
+	client := meta.(*clients.Client).DigitalTwins().EndpointClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -129,7 +129,7 @@ func resourceDigitalTwinsEndpointServiceBusCreateUpdate(d *schema.ResourceData,
 
 func resourceDigitalTwinsEndpointServiceBusRead(d *schema.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).DigitalTwins.EndpointClient
+	client := meta.(*clients.Client).DigitalTwins().EndpointClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -158,7 +158,7 @@ func resourceDigitalTwinsEndpointServiceBusRead(d *schema.ResourceData, meta int
 }
 
 func resourceDigitalTwinsEndpointServiceBusDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DigitalTwins.EndpointClient
+	client := meta.(*clients.Client).DigitalTwins().EndpointClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -109,7 +109,7 @@ func (r DigitalTwinsEndpointServiceBusResource) Exists(ctx context.Context, clie
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.DigitalTwins.EndpointClient.Get(ctx, id.ResourceGroup, id.DigitalTwinsInstanceName, id.EndpointName)
+	resp, err := client.DigitalTwins().EndpointClient.Get(ctx, id.ResourceGroup, id.DigitalTwinsInstanceName, id.EndpointName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
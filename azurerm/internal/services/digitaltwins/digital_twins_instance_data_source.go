@@ -45,7 +45,7 @@ func dataSourceDigitalTwinsInstance() *schema.Resource {
 }
 
 func dataSourceDigitalTwinsInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DigitalTwins.InstanceClient
+	client := meta.(*clients.Client).DigitalTwins().InstanceClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
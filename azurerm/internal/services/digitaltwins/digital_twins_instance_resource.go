@@ -61,7 +61,7 @@ func resourceDigitalTwinsInstance() *schema.Resource {
 }
 func resourceDigitalTwinsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).DigitalTwins.InstanceClient
+	client := meta.(*clients.Client).DigitalTwins().InstanceClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -104,7 +104,7 @@ func resourceDigitalTwinsInstanceCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceDigitalTwinsInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DigitalTwins.InstanceClient
+	client := meta.(*clients.Client).DigitalTwins().InstanceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -134,7 +134,7 @@ func resourceDigitalTwinsInstanceRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceDigitalTwinsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DigitalTwins.InstanceClient
+	client := meta.(*clients.Client).DigitalTwins().InstanceClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -145,7 +145,7 @@ func resourceDigitalTwinsInstanceUpdate(d *schema.ResourceData, meta interface{}
 
 	props := digitaltwins.PatchDescription{}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		props.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -157,7 +157,7 @@ func resourceDigitalTwinsInstanceUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceDigitalTwinsInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DigitalTwins.InstanceClient
+	client := meta.(*clients.Client).DigitalTwins().InstanceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -107,7 +107,7 @@ func (DigitalTwinsInstanceResource) Exists(ctx context.Context, clients *clients
 		return nil, err
 	}
 
-	resp, err := clients.DigitalTwins.InstanceClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.DigitalTwins().InstanceClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Digital Twins Instance %q (resource group: %q): %+v", id.Name, id.ResourceGroup, err)
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DigitalTwinsEndpointId struct {
@@ -73,3 +74,12 @@ func DigitalTwinsEndpointID(input string) (*DigitalTwinsEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+// DigitalTwinsEndpointIDNormalized parses a DigitalTwinsEndpoint ID into an DigitalTwinsEndpointId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DigitalTwinsEndpointID method should be used instead for validation etc.
+func DigitalTwinsEndpointIDNormalized(input string) (*DigitalTwinsEndpointId, error) {
+	return DigitalTwinsEndpointID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DigitalTwins/digitalTwinsInstances/%s/endpoints/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DigitalTwinsInstanceId struct {
@@ -67,3 +68,12 @@ func DigitalTwinsInstanceID(input string) (*DigitalTwinsInstanceId, error) {
 
 	return &resourceId, nil
 }
+
+// DigitalTwinsInstanceIDNormalized parses a DigitalTwinsInstance ID into an DigitalTwinsInstanceId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DigitalTwinsInstanceID method should be used instead for validation etc.
+func DigitalTwinsInstanceIDNormalized(input string) (*DigitalTwinsInstanceId, error) {
+	return DigitalTwinsInstanceID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DigitalTwins/digitalTwinsInstances/%s"))
+}
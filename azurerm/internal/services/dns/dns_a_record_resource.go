@@ -82,7 +82,7 @@ func resourceDnsARecord() *schema.Resource {
 }
 
 func resourceDnsARecordCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Dns.RecordSetsClient
+	client := meta.(*clients.Client).Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -148,7 +148,7 @@ func resourceDnsARecordCreateUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceDnsARecordRead(d *schema.ResourceData, meta interface{}) error {
-	dnsClient := meta.(*clients.Client).Dns.RecordSetsClient
+	dnsClient := meta.(*clients.Client).Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -187,7 +187,7 @@ func resourceDnsARecordRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDnsARecordDelete(d *schema.ResourceData, meta interface{}) error {
-	dnsClient := meta.(*clients.Client).Dns.RecordSetsClient
+	dnsClient := meta.(*clients.Client).Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
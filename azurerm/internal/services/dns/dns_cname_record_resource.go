@@ -79,7 +79,7 @@ func resourceDnsCNameRecord() *schema.Resource {
 }
 
 func resourceDnsCNameRecordCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Dns.RecordSetsClient
+	client := meta.(*clients.Client).Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -149,7 +149,7 @@ func resourceDnsCNameRecordCreateUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceDnsCNameRecordRead(d *schema.ResourceData, meta interface{}) error {
-	dnsClient := meta.(*clients.Client).Dns.RecordSetsClient
+	dnsClient := meta.(*clients.Client).Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -192,7 +192,7 @@ func resourceDnsCNameRecordRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceDnsCNameRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	dnsClient := meta.(*clients.Client).Dns.RecordSetsClient
+	dnsClient := meta.(*clients.Client).Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
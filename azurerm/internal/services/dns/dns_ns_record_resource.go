@@ -74,7 +74,7 @@ func resourceDnsNsRecord() *schema.Resource {
 }
 
 func resourceDnsNsRecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Dns.RecordSetsClient
+	client := meta.(*clients.Client).Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -129,7 +129,7 @@ func resourceDnsNsRecordCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDnsNsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Dns.RecordSetsClient
+	client := meta.(*clients.Client).Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -153,7 +153,7 @@ func resourceDnsNsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 		existing.RecordSetProperties.NsRecords = records
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
 		existing.RecordSetProperties.Metadata = tags.Expand(t)
 	}
@@ -172,7 +172,7 @@ func resourceDnsNsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDnsNsRecordRead(d *schema.ResourceData, meta interface{}) error {
-	dnsClient := meta.(*clients.Client).Dns.RecordSetsClient
+	dnsClient := meta.(*clients.Client).Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -207,7 +207,7 @@ func resourceDnsNsRecordRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDnsNsRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	dnsClient := meta.(*clients.Client).Dns.RecordSetsClient
+	dnsClient := meta.(*clients.Client).Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -72,7 +72,7 @@ func resourceDnsPtrRecord() *schema.Resource {
 }
 
 func resourceDnsPtrRecordCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Dns.RecordSetsClient
+	client := meta.(*clients.Client).Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -126,7 +126,7 @@ func resourceDnsPtrRecordCreateUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceDnsPtrRecordRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client)
-	dnsClient := client.Dns.RecordSetsClient
+	dnsClient := client.Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -159,7 +159,7 @@ func resourceDnsPtrRecordRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceDnsPtrRecordDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client)
-	dnsClient := client.Dns.RecordSetsClient
+	dnsClient := client.Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
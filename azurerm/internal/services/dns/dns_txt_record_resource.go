@@ -80,7 +80,7 @@ func resourceDnsTxtRecord() *schema.Resource {
 }
 
 func resourceDnsTxtRecordCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Dns.RecordSetsClient
+	client := meta.(*clients.Client).Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -134,7 +134,7 @@ func resourceDnsTxtRecordCreateUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceDnsTxtRecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Dns.RecordSetsClient
+	client := meta.(*clients.Client).Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -165,7 +165,7 @@ func resourceDnsTxtRecordRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDnsTxtRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Dns.RecordSetsClient
+	client := meta.(*clients.Client).Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -57,7 +57,7 @@ func dataSourceDnsZone() *schema.Resource {
 }
 
 func dataSourceDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Dns.ZonesClient
+	client := meta.(*clients.Client).Dns().ZonesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -142,8 +142,8 @@ func resourceDnsZone() *schema.Resource {
 }
 
 func resourceDnsZoneCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Dns.ZonesClient
-	recordSetsClient := meta.(*clients.Client).Dns.RecordSetsClient
+	client := meta.(*clients.Client).Dns().ZonesClient
+	recordSetsClient := meta.(*clients.Client).Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -211,8 +211,8 @@ func resourceDnsZoneCreateUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
-	zonesClient := meta.(*clients.Client).Dns.ZonesClient
-	recordSetsClient := meta.(*clients.Client).Dns.RecordSetsClient
+	zonesClient := meta.(*clients.Client).Dns().ZonesClient
+	recordSetsClient := meta.(*clients.Client).Dns().RecordSetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -257,7 +257,7 @@ func resourceDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDnsZoneDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Dns.ZonesClient
+	client := meta.(*clients.Client).Dns().ZonesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
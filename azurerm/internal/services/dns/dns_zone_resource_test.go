@@ -108,7 +108,7 @@ func (DnsZoneResource) Exists(ctx context.Context, clients *clients.Client, stat
 		return nil, err
 	}
 
-	resp, err := clients.Dns.ZonesClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Dns().ZonesClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving DNS zone %s (resource group: %s): %v", id.Name, id.ResourceGroup, err)
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ARecordId struct {
@@ -73,3 +74,12 @@ func ARecordID(input string) (*ARecordId, error) {
 
 	return &resourceId, nil
 }
+
+// ARecordIDNormalized parses a ARecord ID into an ARecordId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ARecordID method should be used instead for validation etc.
+func ARecordIDNormalized(input string) (*ARecordId, error) {
+	return ARecordID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/A/%s"))
+}
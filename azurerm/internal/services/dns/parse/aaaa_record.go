@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AaaaRecordId struct {
@@ -73,3 +74,12 @@ func AaaaRecordID(input string) (*AaaaRecordId, error) {
 
 	return &resourceId, nil
 }
+
+// AaaaRecordIDNormalized parses a AaaaRecord ID into an AaaaRecordId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the AaaaRecordID method should be used instead for validation etc.
+func AaaaRecordIDNormalized(input string) (*AaaaRecordId, error) {
+	return AaaaRecordID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/AAAA/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CaaRecordId struct {
@@ -73,3 +74,12 @@ func CaaRecordID(input string) (*CaaRecordId, error) {
 
 	return &resourceId, nil
 }
+
+// CaaRecordIDNormalized parses a CaaRecord ID into an CaaRecordId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the CaaRecordID method should be used instead for validation etc.
+func CaaRecordIDNormalized(input string) (*CaaRecordId, error) {
+	return CaaRecordID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/CAA/%s"))
+}
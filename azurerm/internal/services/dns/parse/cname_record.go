@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CnameRecordId struct {
@@ -73,3 +74,12 @@ func CnameRecordID(input string) (*CnameRecordId, error) {
 
 	return &resourceId, nil
 }
+
+// CnameRecordIDNormalized parses a CnameRecord ID into an CnameRecordId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the CnameRecordID method should be used instead for validation etc.
+func CnameRecordIDNormalized(input string) (*CnameRecordId, error) {
+	return CnameRecordID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/CNAME/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DnsZoneId struct {
@@ -67,3 +68,12 @@ func DnsZoneID(input string) (*DnsZoneId, error) {
 
 	return &resourceId, nil
 }
+
+// DnsZoneIDNormalized parses a DnsZone ID into an DnsZoneId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DnsZoneID method should be used instead for validation etc.
+func DnsZoneIDNormalized(input string) (*DnsZoneId, error) {
+	return DnsZoneID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s"))
+}
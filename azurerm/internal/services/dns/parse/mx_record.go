@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type MxRecordId struct {
@@ -73,3 +74,12 @@ func MxRecordID(input string) (*MxRecordId, error) {
 
 	return &resourceId, nil
 }
+
+// MxRecordIDNormalized parses a MxRecord ID into an MxRecordId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the MxRecordID method should be used instead for validation etc.
+func MxRecordIDNormalized(input string) (*MxRecordId, error) {
+	return MxRecordID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/MX/%s"))
+}
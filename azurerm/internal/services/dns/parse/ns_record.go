@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NsRecordId struct {
@@ -73,3 +74,12 @@ func NsRecordID(input string) (*NsRecordId, error) {
 
 	return &resourceId, nil
 }
+
+// NsRecordIDNormalized parses a NsRecord ID into an NsRecordId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the NsRecordID method should be used instead for validation etc.
+func NsRecordIDNormalized(input string) (*NsRecordId, error) {
+	return NsRecordID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/NS/%s"))
+}
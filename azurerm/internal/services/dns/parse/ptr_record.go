@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PtrRecordId struct {
@@ -73,3 +74,12 @@ func PtrRecordID(input string) (*PtrRecordId, error) {
 
 	return &resourceId, nil
 }
+
+// PtrRecordIDNormalized parses a PtrRecord ID into an PtrRecordId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the PtrRecordID method should be used instead for validation etc.
+func PtrRecordIDNormalized(input string) (*PtrRecordId, error) {
+	return PtrRecordID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/PTR/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SrvRecordId struct {
@@ -73,3 +74,12 @@ func SrvRecordID(input string) (*SrvRecordId, error) {
 
 	return &resourceId, nil
 }
+
+// SrvRecordIDNormalized parses a SrvRecord ID into an SrvRecordId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SrvRecordID method should be used instead for validation etc.
+func SrvRecordIDNormalized(input string) (*SrvRecordId, error) {
+	return SrvRecordID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/SRV/%s"))
+}
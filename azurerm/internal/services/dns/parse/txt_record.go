@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type TxtRecordId struct {
@@ -73,3 +74,12 @@ func TxtRecordID(input string) (*TxtRecordId, error) {
 
 	return &resourceId, nil
 }
+
+// TxtRecordIDNormalized parses a TxtRecord ID into an TxtRecordId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the TxtRecordID method should be used instead for validation etc.
+func TxtRecordIDNormalized(input string) (*TxtRecordId, error) {
+	return TxtRecordID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnszones/%s/TXT/%s"))
+}
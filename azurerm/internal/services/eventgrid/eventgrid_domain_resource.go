@@ -163,7 +163,7 @@ func resourceEventGridDomain() *schema.Resource {
 }
 
 func resourceEventGridDomainCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.DomainsClient
+	client := meta.(*clients.Client).EventGrid().DomainsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -224,7 +224,7 @@ func resourceEventGridDomainCreateUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceEventGridDomainRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.DomainsClient
+	client := meta.(*clients.Client).EventGrid().DomainsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -293,7 +293,7 @@ func resourceEventGridDomainRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceEventGridDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.DomainsClient
+	client := meta.(*clients.Client).EventGrid().DomainsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
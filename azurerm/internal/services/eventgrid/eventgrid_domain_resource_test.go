@@ -115,7 +115,7 @@ func (EventGridDomainResource) Exists(ctx context.Context, clients *clients.Clie
 		return nil, err
 	}
 
-	resp, err := clients.EventGrid.DomainsClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.EventGrid().DomainsClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving EventGrid Domain %q (resource group: %q): %+v", id.Name, id.ResourceGroup, err)
 	}
@@ -39,7 +39,7 @@ func dataSourceEventGridDomainTopic() *schema.Resource {
 }
 
 func dataSourceEventGridDomainTopicRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.DomainTopicsClient
+	client := meta.(*clients.Client).EventGrid().DomainTopicsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
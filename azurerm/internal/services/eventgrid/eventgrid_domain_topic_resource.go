@@ -68,7 +68,7 @@ func resourceEventGridDomainTopic() *schema.Resource {
 }
 
 func resourceEventGridDomainTopicCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.DomainTopicsClient
+	client := meta.(*clients.Client).EventGrid().DomainTopicsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -112,7 +112,7 @@ func resourceEventGridDomainTopicCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceEventGridDomainTopicRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.DomainTopicsClient
+	client := meta.(*clients.Client).EventGrid().DomainTopicsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -140,7 +140,7 @@ func resourceEventGridDomainTopicRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceEventGridDomainTopicDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.DomainTopicsClient
+	client := meta.(*clients.Client).EventGrid().DomainTopicsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -56,7 +56,7 @@ func (EventGridDomainTopicResource) Exists(ctx context.Context, clients *clients
 		return nil, err
 	}
 
-	resp, err := clients.EventGrid.DomainTopicsClient.Get(ctx, id.ResourceGroup, id.DomainName, id.TopicName)
+	resp, err := clients.EventGrid().DomainTopicsClient.Get(ctx, id.ResourceGroup, id.DomainName, id.TopicName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving EventGrid Domain Topic %q (resource group: %q): %+v", id.TopicName, id.ResourceGroup, err)
 	}
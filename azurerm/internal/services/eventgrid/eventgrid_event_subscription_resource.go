@@ -150,7 +150,7 @@ func resourceEventGridEventSubscription() *schema.Resource {
 }
 
 func resourceEventGridEventSubscriptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.EventSubscriptionsClient
+	client := meta.(*clients.Client).EventGrid().EventSubscriptionsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -224,7 +224,7 @@ func resourceEventGridEventSubscriptionCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceEventGridEventSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.EventSubscriptionsClient
+	client := meta.(*clients.Client).EventGrid().EventSubscriptionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -335,7 +335,7 @@ func resourceEventGridEventSubscriptionRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceEventGridEventSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.EventSubscriptionsClient
+	client := meta.(*clients.Client).EventGrid().EventSubscriptionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -254,7 +254,7 @@ func (EventGridEventSubscriptionResource) Exists(ctx context.Context, clients *c
 		return nil, err
 	}
 
-	resp, err := clients.EventGrid.EventSubscriptionsClient.Get(ctx, id.Scope, id.Name)
+	resp, err := clients.EventGrid().EventSubscriptionsClient.Get(ctx, id.Scope, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving EventGrid Event Subscription %q (scope: %q): %+v", id.Name, id.Scope, err)
 	}
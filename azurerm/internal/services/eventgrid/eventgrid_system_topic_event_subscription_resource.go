@@ -130,7 +130,7 @@ func resourceEventGridSystemTopicEventSubscription() *schema.Resource {
 }
 
 func resourceEventGridSystemTopicEventSubscriptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.SystemTopicEventSubscriptionsClient
+	client := meta.(*clients.Client).EventGrid().SystemTopicEventSubscriptionsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -205,7 +205,7 @@ func resourceEventGridSystemTopicEventSubscriptionCreateUpdate(d *schema.Resourc
 }
 
 func resourceEventGridSystemTopicEventSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.SystemTopicEventSubscriptionsClient
+	client := meta.(*clients.Client).EventGrid().SystemTopicEventSubscriptionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -309,7 +309,7 @@ func resourceEventGridSystemTopicEventSubscriptionRead(d *schema.ResourceData, m
 }
 
 func resourceEventGridSystemTopicEventSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.SystemTopicEventSubscriptionsClient
+	client := meta.(*clients.Client).EventGrid().SystemTopicEventSubscriptionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
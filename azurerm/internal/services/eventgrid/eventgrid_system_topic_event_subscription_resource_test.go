@@ -254,7 +254,7 @@ func (EventGridSystemTopicEventSubscriptionResource) Exists(ctx context.Context,
 		return nil, err
 	}
 
-	resp, err := clients.EventGrid.SystemTopicEventSubscriptionsClient.Get(ctx, id.ResourceGroup, id.SystemTopic, id.Name)
+	resp, err := clients.EventGrid().SystemTopicEventSubscriptionsClient.Get(ctx, id.ResourceGroup, id.SystemTopic, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving EventGrid System Topic Event Subscription %q (System Topic: %q): %+v", id.Name, id.SystemTopic, err)
 	}
@@ -101,7 +101,7 @@ func resourceEventGridSystemTopic() *schema.Resource {
 }
 
 func resourceEventGridSystemTopicCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.SystemTopicsClient
+	client := meta.(*clients.Client).EventGrid().SystemTopicsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -160,7 +160,7 @@ func resourceEventGridSystemTopicCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceEventGridSystemTopicRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.SystemTopicsClient
+	client := meta.(*clients.Client).EventGrid().SystemTopicsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -196,7 +196,7 @@ func resourceEventGridSystemTopicRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceEventGridSystemTopicDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.SystemTopicsClient
+	client := meta.(*clients.Client).EventGrid().SystemTopicsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
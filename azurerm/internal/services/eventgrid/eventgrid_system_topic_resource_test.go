@@ -79,7 +79,7 @@ func (EventGridSystemTopicResource) Exists(ctx context.Context, clients *clients
 		return nil, err
 	}
 
-	resp, err := clients.EventGrid.SystemTopicsClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.EventGrid().SystemTopicsClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Event Grid System Topic %q (resource group: %q): %+v", id.Name, id.ResourceGroup, err)
 	}
@@ -55,7 +55,7 @@ func dataSourceEventGridTopic() *schema.Resource {
 }
 
 func dataSourceEventGridTopicRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.TopicsClient
+	client := meta.(*clients.Client).EventGrid().TopicsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
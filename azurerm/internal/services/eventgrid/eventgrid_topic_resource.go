@@ -163,7 +163,7 @@ func resourceEventGridTopic() *schema.Resource {
 }
 
 func resourceEventGridTopicCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.TopicsClient
+	client := meta.(*clients.Client).EventGrid().TopicsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -224,7 +224,7 @@ func resourceEventGridTopicCreateUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceEventGridTopicRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.TopicsClient
+	client := meta.(*clients.Client).EventGrid().TopicsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -297,7 +297,7 @@ func resourceEventGridTopicRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceEventGridTopicDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).EventGrid.TopicsClient
+	client := meta.(*clients.Client).EventGrid().TopicsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -118,7 +118,7 @@ func (EventGridTopicResource) Exists(ctx context.Context, clients *clients.Clien
 		return nil, err
 	}
 
-	resp, err := clients.EventGrid.TopicsClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.EventGrid().TopicsClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving EventGrid Topic %q (resource group: %q): %+v", id.Name, id.ResourceGroup, err)
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DomainId struct {
@@ -67,3 +68,12 @@ func DomainID(input string) (*DomainId, error) {
 
 	return &resourceId, nil
 }
+
+// DomainIDNormalized parses a Domain ID into an DomainId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DomainID method should be used instead for validation etc.
+func DomainIDNormalized(input string) (*DomainId, error) {
+	return DomainID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/domains/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DomainTopicId struct {
@@ -73,3 +74,12 @@ func DomainTopicID(input string) (*DomainTopicId, error) {
 
 	return &resourceId, nil
 }
+
+// DomainTopicIDNormalized parses a DomainTopic ID into an DomainTopicId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DomainTopicID method should be used instead for validation etc.
+func DomainTopicIDNormalized(input string) (*DomainTopicId, error) {
+	return DomainTopicID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/domains/%s/topics/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SystemTopicId struct {
@@ -67,3 +68,12 @@ func SystemTopicID(input string) (*SystemTopicId, error) {
 
 	return &resourceId, nil
 }
+
+// SystemTopicIDNormalized parses a SystemTopic ID into an SystemTopicId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SystemTopicID method should be used instead for validation etc.
+func SystemTopicIDNormalized(input string) (*SystemTopicId, error) {
+	return SystemTopicID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/systemTopics/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type TopicId struct {
@@ -67,3 +68,12 @@ func TopicID(input string) (*TopicId, error) {
 
 	return &resourceId, nil
 }
+
+// TopicIDNormalized parses a Topic ID into an TopicId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the TopicID method should be used instead for validation etc.
+func TopicIDNormalized(input string) (*TopicId, error) {
+	return TopicID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/topics/%s"))
+}
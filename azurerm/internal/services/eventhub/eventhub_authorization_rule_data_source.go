@@ -71,7 +71,7 @@ func EventHubAuthorizationRuleDataSource() *schema.Resource {
 }
 
 func EventHubAuthorizationRuleDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.EventHubsClient
+	client := meta.(*clients.Client).Eventhub().EventHubsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -65,7 +65,7 @@ func resourceEventHubAuthorizationRule() *schema.Resource {
 }
 
 func resourceEventHubAuthorizationRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.EventHubsClient
+	client := meta.(*clients.Client).Eventhub().EventHubsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -89,10 +89,14 @@ func resourceEventHubAuthorizationRuleCreateUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	locks.ByName(eventHubName, eventHubResourceName)
+	if err := locks.ByNameWithContext(ctx, eventHubName, eventHubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Authorization Rule %q (EventHub %q / Namespace %q / Resource Group %q): %+v", name, eventHubName, namespaceName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(eventHubName, eventHubResourceName)
 
-	locks.ByName(namespaceName, eventHubNamespaceResourceName)
+	if err := locks.ByNameWithContext(ctx, namespaceName, eventHubNamespaceResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Authorization Rule %q (EventHub %q / Namespace %q / Resource Group %q): %+v", name, eventHubName, namespaceName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(namespaceName, eventHubNamespaceResourceName)
 
 	parameters := eventhub.AuthorizationRule{
@@ -126,7 +130,7 @@ func resourceEventHubAuthorizationRuleCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceEventHubAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.EventHubsClient
+	client := meta.(*clients.Client).Eventhub().EventHubsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -177,7 +181,7 @@ func resourceEventHubAuthorizationRuleRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceEventHubAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	eventhubClient := meta.(*clients.Client).Eventhub.EventHubsClient
+	eventhubClient := meta.(*clients.Client).Eventhub().EventHubsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -191,10 +195,14 @@ func resourceEventHubAuthorizationRuleDelete(d *schema.ResourceData, meta interf
 	namespaceName := id.Path["namespaces"]
 	eventHubName := id.Path["eventhubs"]
 
-	locks.ByName(eventHubName, eventHubResourceName)
+	if err := locks.ByNameWithContext(ctx, eventHubName, eventHubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Authorization Rule %q (EventHub %q / Namespace %q / Resource Group %q): %+v", name, eventHubName, namespaceName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(eventHubName, eventHubResourceName)
 
-	locks.ByName(namespaceName, eventHubNamespaceResourceName)
+	if err := locks.ByNameWithContext(ctx, namespaceName, eventHubNamespaceResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Authorization Rule %q (EventHub %q / Namespace %q / Resource Group %q): %+v", name, eventHubName, namespaceName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(namespaceName, eventHubNamespaceResourceName)
 
 	if resp, err := eventhubClient.DeleteAuthorizationRule(ctx, resourceGroup, namespaceName, eventHubName, name); err != nil {
@@ -181,7 +181,7 @@ func (EventHubAuthorizationRuleResource) Exists(ctx context.Context, clients *cl
 	namespaceName := id.Path["namespaces"]
 	eventHubName := id.Path["eventhubs"]
 
-	resp, err := clients.Eventhub.EventHubsClient.GetAuthorizationRule(ctx, id.ResourceGroup, namespaceName, eventHubName, name)
+	resp, err := clients.Eventhub().EventHubsClient.GetAuthorizationRule(ctx, id.ResourceGroup, namespaceName, eventHubName, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Event Hub Authorization Rule %q (eventhub %s / namespace %s / resource group: %s) does not exist", name, eventHubName, namespaceName, id.ResourceGroup)
 	}
@@ -69,7 +69,7 @@ func resourceEventHubCluster() *schema.Resource {
 }
 
 func resourceEventHubClusterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.ClusterClient
+	client := meta.(*clients.Client).Eventhub().ClusterClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM EventHub Cluster creation.")
@@ -107,7 +107,7 @@ func resourceEventHubClusterCreateUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceEventHubClusterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.ClusterClient
+	client := meta.(*clients.Client).Eventhub().ClusterClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -135,7 +135,7 @@ func resourceEventHubClusterRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceEventHubClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.ClusterClient
+	client := meta.(*clients.Client).Eventhub().ClusterClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	id, err := parse.ClusterID(d.Id())
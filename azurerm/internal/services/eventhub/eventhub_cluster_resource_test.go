@@ -67,7 +67,7 @@ func (EventHubClusterResource) Exists(ctx context.Context, clients *clients.Clie
 		return nil, err
 	}
 
-	resp, err := clients.Eventhub.ClusterClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Eventhub().ClusterClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %v", id.String(), err)
 	}
@@ -56,7 +56,7 @@ func EventHubConsumerGroupDataSource() *schema.Resource {
 }
 
 func EventHubConsumerGroupDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.ConsumerGroupClient
+	client := meta.(*clients.Client).Eventhub().ConsumerGroupClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
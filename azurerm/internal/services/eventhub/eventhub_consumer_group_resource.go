@@ -80,7 +80,7 @@ func (r ConsumerGroupResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.Logger.Infof("creating Consumer Group %q..", state.Name)
-			client := metadata.Client.Eventhub.ConsumerGroupClient
+			client := metadata.Client.Eventhub().ConsumerGroupClient
 			subscriptionId := metadata.Client.Account.SubscriptionId
 
 			id := parse.NewEventHubConsumerGroupID(subscriptionId, state.ResourceGroupName, state.NamespaceName, state.EventHubName, state.Name)
@@ -125,7 +125,7 @@ func (r ConsumerGroupResource) Update() sdk.ResourceFunc {
 			}
 
 			metadata.Logger.Infof("updating Consumer Group %q..", state.Name)
-			client := metadata.Client.Eventhub.ConsumerGroupClient
+			client := metadata.Client.Eventhub().ConsumerGroupClient
 
 			parameters := eventhub.ConsumerGroup{
 				Name: utils.String(id.ConsumergroupName),
@@ -147,7 +147,7 @@ func (r ConsumerGroupResource) Update() sdk.ResourceFunc {
 func (r ConsumerGroupResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.Eventhub.ConsumerGroupClient
+			client := metadata.Client.Eventhub().ConsumerGroupClient
 			id, err := parse.EventHubConsumerGroupID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
@@ -179,7 +179,7 @@ func (r ConsumerGroupResource) Read() sdk.ResourceFunc {
 func (r ConsumerGroupResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.Eventhub.ConsumerGroupClient
+			client := metadata.Client.Eventhub().ConsumerGroupClient
 			id, err := parse.EventHubConsumerGroupID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
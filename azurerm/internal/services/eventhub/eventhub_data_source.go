@@ -47,7 +47,7 @@ func dataSourceEventHub() *schema.Resource {
 }
 
 func dataSourceEventHubRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.EventHubsClient
+	client := meta.(*clients.Client).Eventhub().EventHubsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -90,7 +90,7 @@ func EventHubNamespaceDataSourceAuthorizationRule() *schema.Resource {
 }
 
 func EventHubNamespaceDataSourceAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.NamespacesClient
+	client := meta.(*clients.Client).Eventhub().NamespacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
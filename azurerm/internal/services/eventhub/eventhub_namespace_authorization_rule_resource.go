@@ -73,7 +73,7 @@ func resourceEventHubNamespaceAuthorizationRule() *schema.Resource {
 }
 
 func resourceEventHubNamespaceAuthorizationRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.NamespacesClient
+	client := meta.(*clients.Client).Eventhub().NamespacesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -96,7 +96,9 @@ func resourceEventHubNamespaceAuthorizationRuleCreateUpdate(d *schema.ResourceDa
 		}
 	}
 
-	locks.ByName(namespaceName, eventHubNamespaceResourceName)
+	if err := locks.ByNameWithContext(ctx, namespaceName, eventHubNamespaceResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Authorization Rule %q (EventHub Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(namespaceName, eventHubNamespaceResourceName)
 
 	parameters := eventhub.AuthorizationRule{
@@ -125,7 +127,7 @@ func resourceEventHubNamespaceAuthorizationRuleCreateUpdate(d *schema.ResourceDa
 }
 
 func resourceEventHubNamespaceAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.NamespacesClient
+	client := meta.(*clients.Client).Eventhub().NamespacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -170,7 +172,7 @@ func resourceEventHubNamespaceAuthorizationRuleRead(d *schema.ResourceData, meta
 }
 
 func resourceEventHubNamespaceAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	eventhubClient := meta.(*clients.Client).Eventhub.NamespacesClient
+	eventhubClient := meta.(*clients.Client).Eventhub().NamespacesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -179,7 +181,9 @@ func resourceEventHubNamespaceAuthorizationRuleDelete(d *schema.ResourceData, me
 		return err
 	}
 
-	locks.ByName(id.NamespaceName, eventHubNamespaceResourceName)
+	if err := locks.ByNameWithContext(ctx, id.NamespaceName, eventHubNamespaceResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.NamespaceName, eventHubNamespaceResourceName)
 
 	if _, err := eventhubClient.DeleteAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.AuthorizationRuleName); err != nil {
@@ -177,7 +177,7 @@ func (EventHubNamespaceAuthorizationRuleResource) Exists(ctx context.Context, cl
 		return nil, err
 	}
 
-	resp, err := clients.Eventhub.NamespacesClient.GetAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.AuthorizationRuleName)
+	resp, err := clients.Eventhub().NamespacesClient.GetAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.AuthorizationRuleName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %v", id.String(), err)
 	}
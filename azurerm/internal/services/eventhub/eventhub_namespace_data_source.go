@@ -108,7 +108,7 @@ func EventHubNamespaceDataSource() *schema.Resource {
 }
 
 func EventHubNamespaceDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.NamespacesClient
+	client := meta.(*clients.Client).Eventhub().NamespacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -71,7 +71,7 @@ func resourceEventHubNamespaceDisasterRecoveryConfig() *schema.Resource {
 }
 
 func resourceEventHubNamespaceDisasterRecoveryConfigCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.DisasterRecoveryConfigsClient
+	client := meta.(*clients.Client).Eventhub().DisasterRecoveryConfigsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -94,7 +94,9 @@ func resourceEventHubNamespaceDisasterRecoveryConfigCreate(d *schema.ResourceDat
 		}
 	}
 
-	locks.ByName(namespaceName, eventHubNamespaceResourceName)
+	if err := locks.ByNameWithContext(ctx, namespaceName, eventHubNamespaceResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(namespaceName, eventHubNamespaceResourceName)
 
 	parameters := eventhub.ArmDisasterRecovery{
@@ -130,7 +132,7 @@ func resourceEventHubNamespaceDisasterRecoveryConfigCreate(d *schema.ResourceDat
 }
 
 func resourceEventHubNamespaceDisasterRecoveryConfigUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.DisasterRecoveryConfigsClient
+	client := meta.(*clients.Client).Eventhub().DisasterRecoveryConfigsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -143,7 +145,9 @@ func resourceEventHubNamespaceDisasterRecoveryConfigUpdate(d *schema.ResourceDat
 	resourceGroup := id.ResourceGroup
 	namespaceName := id.Path["namespaces"]
 
-	locks.ByName(namespaceName, eventHubNamespaceResourceName)
+	if err := locks.ByNameWithContext(ctx, namespaceName, eventHubNamespaceResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(namespaceName, eventHubNamespaceResourceName)
 
 	if d.HasChange("partner_namespace_id") {
@@ -180,7 +184,7 @@ func resourceEventHubNamespaceDisasterRecoveryConfigUpdate(d *schema.ResourceDat
 }
 
 func resourceEventHubNamespaceDisasterRecoveryConfigRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.DisasterRecoveryConfigsClient
+	client := meta.(*clients.Client).Eventhub().DisasterRecoveryConfigsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -215,7 +219,7 @@ func resourceEventHubNamespaceDisasterRecoveryConfigRead(d *schema.ResourceData,
 }
 
 func resourceEventHubNamespaceDisasterRecoveryConfigDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.DisasterRecoveryConfigsClient
+	client := meta.(*clients.Client).Eventhub().DisasterRecoveryConfigsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -228,7 +232,9 @@ func resourceEventHubNamespaceDisasterRecoveryConfigDelete(d *schema.ResourceDat
 	resourceGroup := id.ResourceGroup
 	namespaceName := id.Path["namespaces"]
 
-	locks.ByName(namespaceName, eventHubNamespaceResourceName)
+	if err := locks.ByNameWithContext(ctx, namespaceName, eventHubNamespaceResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Disaster Recovery Config %q (EventHub Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(namespaceName, eventHubNamespaceResourceName)
 
 	breakPair, err := client.BreakPairing(ctx, resourceGroup, namespaceName, name)
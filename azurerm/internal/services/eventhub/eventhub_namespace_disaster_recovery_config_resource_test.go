@@ -86,7 +86,7 @@ func (EventHubNamespaceDisasterRecoveryConfigResource) Exists(ctx context.Contex
 	resourceGroup := id.ResourceGroup
 	namespaceName := id.Path["namespaces"]
 
-	resp, err := clients.Eventhub.DisasterRecoveryConfigsClient.Get(ctx, resourceGroup, namespaceName, name)
+	resp, err := clients.Eventhub().DisasterRecoveryConfigsClient.Get(ctx, resourceGroup, namespaceName, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving EventHub Namespace Disaster Recovery Configs %q (namespace %q / resource group: %q): %v", name, namespaceName, id.ResourceGroup, err)
 	}
@@ -251,7 +251,7 @@ func resourceEventHubNamespace() *schema.Resource {
 }
 
 func resourceEventHubNamespaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.NamespacesClient
+	client := meta.(*clients.Client).Eventhub().NamespacesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM EventHub Namespace creation.")
@@ -356,7 +356,7 @@ func resourceEventHubNamespaceCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceEventHubNamespaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.NamespacesClient
+	client := meta.(*clients.Client).Eventhub().NamespacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -421,7 +421,7 @@ func resourceEventHubNamespaceRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceEventHubNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.NamespacesClient
+	client := meta.(*clients.Client).Eventhub().NamespacesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -154,7 +154,7 @@ func resourceEventHub() *schema.Resource {
 }
 
 func resourceEventHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.EventHubsClient
+	client := meta.(*clients.Client).Eventhub().EventHubsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -200,7 +200,7 @@ func resourceEventHubCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceEventHubRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.EventHubsClient
+	client := meta.(*clients.Client).Eventhub().EventHubsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -237,7 +237,7 @@ func resourceEventHubRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceEventHubDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Eventhub.EventHubsClient
+	client := meta.(*clients.Client).Eventhub().EventHubsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -330,7 +330,7 @@ func (EventHubResource) Exists(ctx context.Context, clients *clients.Client, sta
 		return nil, err
 	}
 
-	resp, err := clients.Eventhub.EventHubsClient.Get(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
+	resp, err := clients.Eventhub().EventHubsClient.Get(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %v", id, err)
 	}
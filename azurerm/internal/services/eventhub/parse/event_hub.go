@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EventHubId struct {
@@ -73,3 +74,12 @@ func EventHubID(input string) (*EventHubId, error) {
 
 	return &resourceId, nil
 }
+
+// EventHubIDNormalized parses a EventHub ID into an EventHubId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the EventHubID method should be used instead for validation etc.
+func EventHubIDNormalized(input string) (*EventHubId, error) {
+	return EventHubID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/eventhubs/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EventHubConsumerGroupId struct {
@@ -79,3 +80,12 @@ func EventHubConsumerGroupID(input string) (*EventHubConsumerGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// EventHubConsumerGroupIDNormalized parses a EventHubConsumerGroup ID into an EventHubConsumerGroupId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the EventHubConsumerGroupID method should be used instead for validation etc.
+func EventHubConsumerGroupIDNormalized(input string) (*EventHubConsumerGroupId, error) {
+	return EventHubConsumerGroupID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/eventhubs/%s/consumergroups/%s"))
+}
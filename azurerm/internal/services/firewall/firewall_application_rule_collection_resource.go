@@ -139,7 +139,7 @@ func resourceFirewallApplicationRuleCollection() *schema.Resource {
 }
 
 func resourceFirewallApplicationRuleCollectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.AzureFirewallsClient
+	client := meta.(*clients.Client).Firewall().AzureFirewallsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -151,7 +151,9 @@ func resourceFirewallApplicationRuleCollectionCreateUpdate(d *schema.ResourceDat
 		return fmt.Errorf("expanding Firewall Application Rules: %+v", err)
 	}
 
-	locks.ByName(firewallName, azureFirewallResourceName)
+	if err := locks.ByNameWithContext(ctx, firewallName, azureFirewallResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Application Rule Collection %q (Firewall %q / Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(firewallName, azureFirewallResourceName)
 
 	firewall, err := client.Get(ctx, resourceGroup, firewallName)
@@ -250,7 +252,7 @@ func resourceFirewallApplicationRuleCollectionCreateUpdate(d *schema.ResourceDat
 }
 
 func resourceFirewallApplicationRuleCollectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.AzureFirewallsClient
+	client := meta.(*clients.Client).Firewall().AzureFirewallsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -323,7 +325,7 @@ func resourceFirewallApplicationRuleCollectionRead(d *schema.ResourceData, meta
 }
 
 func resourceFirewallApplicationRuleCollectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.AzureFirewallsClient
+	client := meta.(*clients.Client).Firewall().AzureFirewallsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -336,7 +338,9 @@ func resourceFirewallApplicationRuleCollectionDelete(d *schema.ResourceData, met
 	firewallName := id.Path["azureFirewalls"]
 	name := id.Path["applicationRuleCollections"]
 
-	locks.ByName(firewallName, azureFirewallResourceName)
+	if err := locks.ByNameWithContext(ctx, firewallName, azureFirewallResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Application Rule Collection %q (Firewall %q / Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(firewallName, azureFirewallResourceName)
 
 	firewall, err := client.Get(ctx, resourceGroup, firewallName)
@@ -379,7 +379,7 @@ func (FirewallApplicationRuleCollectionResource) Exists(ctx context.Context, cli
 	firewallName := id.Path["azureFirewalls"]
 	name := id.Path["applicationRuleCollections"]
 
-	resp, err := clients.Firewall.AzureFirewallsClient.Get(ctx, id.ResourceGroup, firewallName)
+	resp, err := clients.Firewall().AzureFirewallsClient.Get(ctx, id.ResourceGroup, firewallName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Firewall Application Rule Collection %q (Firewall %q / Resource Group %q): %v", name, firewallName, id.ResourceGroup, err)
 	}
@@ -422,7 +422,7 @@ func (t FirewallApplicationRuleCollectionResource) doesNotExist(ctx context.Cont
 }
 
 func (t FirewallApplicationRuleCollectionResource) disappears(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) error {
-	client := clients.Firewall.AzureFirewallsClient
+	client := clients.Firewall().AzureFirewallsClient
 	var id, err = azure.ParseAzureResourceID(state.ID)
 	if err != nil {
 		return err
@@ -143,7 +143,7 @@ func FirewallDataSource() *schema.Resource {
 }
 
 func FirewallDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.AzureFirewallsClient
+	client := meta.(*clients.Client).Firewall().AzureFirewallsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -137,7 +137,7 @@ func resourceFirewallNatRuleCollection() *schema.Resource {
 }
 
 func resourceFirewallNatRuleCollectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.AzureFirewallsClient
+	client := meta.(*clients.Client).Firewall().AzureFirewallsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -145,7 +145,9 @@ func resourceFirewallNatRuleCollectionCreateUpdate(d *schema.ResourceData, meta
 	firewallName := d.Get("azure_firewall_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(firewallName, azureFirewallResourceName)
+	if err := locks.ByNameWithContext(ctx, firewallName, azureFirewallResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for NAT Rule Collection %q (Firewall %q / Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(firewallName, azureFirewallResourceName)
 
 	firewall, err := client.Get(ctx, resourceGroup, firewallName)
@@ -249,7 +251,7 @@ func resourceFirewallNatRuleCollectionCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceFirewallNatRuleCollectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.AzureFirewallsClient
+	client := meta.(*clients.Client).Firewall().AzureFirewallsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -322,7 +324,7 @@ func resourceFirewallNatRuleCollectionRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceFirewallNatRuleCollectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.AzureFirewallsClient
+	client := meta.(*clients.Client).Firewall().AzureFirewallsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -335,7 +337,9 @@ func resourceFirewallNatRuleCollectionDelete(d *schema.ResourceData, meta interf
 	firewallName := id.Path["azureFirewalls"]
 	name := id.Path["natRuleCollections"]
 
-	locks.ByName(firewallName, azureFirewallResourceName)
+	if err := locks.ByNameWithContext(ctx, firewallName, azureFirewallResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for NAT Rule Collection %q (Firewall %q / Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(firewallName, azureFirewallResourceName)
 
 	firewall, err := client.Get(ctx, resourceGroup, firewallName)
@@ -233,7 +233,7 @@ func (FirewallNatRuleCollectionResource) Exists(ctx context.Context, clients *cl
 	firewallName := id.Path["azureFirewalls"]
 	name := id.Path["natRuleCollections"]
 
-	resp, err := clients.Firewall.AzureFirewallsClient.Get(ctx, id.ResourceGroup, firewallName)
+	resp, err := clients.Firewall().AzureFirewallsClient.Get(ctx, id.ResourceGroup, firewallName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Firewall Nat Rule Collection %q (Firewall %q / Resource Group %q): %v", name, firewallName, id.ResourceGroup, err)
 	}
@@ -276,7 +276,7 @@ func (t FirewallNatRuleCollectionResource) doesNotExist(ctx context.Context, cli
 }
 
 func (t FirewallNatRuleCollectionResource) disappears(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) error {
-	client := clients.Firewall.AzureFirewallsClient
+	client := clients.Firewall().AzureFirewallsClient
 	var id, err = azure.ParseAzureResourceID(state.ID)
 	if err != nil {
 		return err
@@ -342,7 +342,7 @@ func (FirewallNetworkRuleCollectionResource) Exists(ctx context.Context, clients
 	firewallName := id.Path["azureFirewalls"]
 	name := id.Path["networkRuleCollections"]
 
-	resp, err := clients.Firewall.AzureFirewallsClient.Get(ctx, id.ResourceGroup, firewallName)
+	resp, err := clients.Firewall().AzureFirewallsClient.Get(ctx, id.ResourceGroup, firewallName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Firewall  Network Rule Collection %q (Firewall %q / Resource Group %q): %v", name, firewallName, id.ResourceGroup, err)
 	}
@@ -374,7 +374,7 @@ func (r FirewallNetworkRuleCollectionResource) checkFirewallNetworkRuleCollectio
 		firewallName := id.AzureFirewallName
 		resourceGroup := id.ResourceGroup
 
-		read, err := clients.Firewall.AzureFirewallsClient.Get(ctx, resourceGroup, firewallName)
+		read, err := clients.Firewall().AzureFirewallsClient.Get(ctx, resourceGroup, firewallName)
 		if err != nil {
 			return err
 		}
@@ -399,7 +399,7 @@ func (FirewallNetworkRuleCollectionResource) Destroy(ctx context.Context, client
 	firewallName := id.AzureFirewallName
 	resourceGroup := id.ResourceGroup
 
-	read, err := clients.Firewall.AzureFirewallsClient.Get(ctx, resourceGroup, firewallName)
+	read, err := clients.Firewall().AzureFirewallsClient.Get(ctx, resourceGroup, firewallName)
 	if err != nil {
 		return utils.Bool(false), err
 	}
@@ -413,16 +413,16 @@ func (FirewallNetworkRuleCollectionResource) Destroy(ctx context.Context, client
 
 	read.AzureFirewallPropertiesFormat.NetworkRuleCollections = &rules
 
-	future, err := clients.Firewall.AzureFirewallsClient.CreateOrUpdate(ctx, resourceGroup, firewallName, read)
+	future, err := clients.Firewall().AzureFirewallsClient.CreateOrUpdate(ctx, resourceGroup, firewallName, read)
 	if err != nil {
 		return utils.Bool(false), fmt.Errorf("Error removing Network Rule Collection from Firewall: %+v", err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, clients.Firewall.AzureFirewallsClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, clients.Firewall().AzureFirewallsClient.Client); err != nil {
 		return utils.Bool(false), fmt.Errorf("Error waiting for the removal of Network Rule Collection from Firewall: %+v", err)
 	}
 
-	_, err = clients.Firewall.AzureFirewallsClient.Get(ctx, resourceGroup, firewallName)
+	_, err = clients.Firewall().AzureFirewallsClient.Get(ctx, resourceGroup, firewallName)
 	return utils.Bool(err == nil), err
 }
 
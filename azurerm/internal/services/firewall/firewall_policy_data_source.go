@@ -120,7 +120,7 @@ func FirewallDataSourcePolicy() *schema.Resource {
 }
 
 func FirewallDataSourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
+	client := meta.(*clients.Client).Firewall().FirewallPolicyClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
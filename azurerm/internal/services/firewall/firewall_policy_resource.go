@@ -173,7 +173,7 @@ func resourceFirewallPolicy() *schema.Resource {
 }
 
 func resourceFirewallPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
+	client := meta.(*clients.Client).Firewall().FirewallPolicyClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -212,7 +212,9 @@ func resourceFirewallPolicyCreateUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	locks.ByName(name, azureFirewallPolicyResourceName)
+	if err := locks.ByNameWithContext(ctx, name, azureFirewallPolicyResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, azureFirewallPolicyResourceName)
 
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, props); err != nil {
@@ -232,7 +234,7 @@ func resourceFirewallPolicyCreateUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
+	client := meta.(*clients.Client).Firewall().FirewallPolicyClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -294,7 +296,7 @@ func resourceFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
+	client := meta.(*clients.Client).Firewall().FirewallPolicyClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -303,7 +305,9 @@ func resourceFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	locks.ByName(id.Name, azureFirewallPolicyResourceName)
+	if err := locks.ByNameWithContext(ctx, id.Name, azureFirewallPolicyResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.Name, azureFirewallPolicyResourceName)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
@@ -374,7 +374,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *schema.Resource {
 }
 
 func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	client := meta.(*clients.Client).Firewall().FirewallPolicyRuleGroupClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -397,7 +397,9 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *schema.ResourceDat
 		}
 	}
 
-	locks.ByName(policyId.Name, azureFirewallPolicyResourceName)
+	if err := locks.ByNameWithContext(ctx, policyId.Name, azureFirewallPolicyResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Firewall Policy Rule Collection Group %q (Firewall Policy %q / Resource Group %q): %+v", name, policyId.Name, policyId.ResourceGroup, err)
+	}
 	defer locks.UnlockByName(policyId.Name, azureFirewallPolicyResourceName)
 
 	param := network.FirewallPolicyRuleCollectionGroup{
@@ -437,7 +439,7 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *schema.ResourceDat
 
 func resourceFirewallPolicyRuleCollectionGroupRead(d *schema.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	client := meta.(*clients.Client).Firewall().FirewallPolicyRuleGroupClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -480,7 +482,7 @@ func resourceFirewallPolicyRuleCollectionGroupRead(d *schema.ResourceData, meta
 }
 
 func resourceFirewallPolicyRuleCollectionGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	client := meta.(*clients.Client).Firewall().FirewallPolicyRuleGroupClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -489,7 +491,9 @@ func resourceFirewallPolicyRuleCollectionGroupDelete(d *schema.ResourceData, met
 		return err
 	}
 
-	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+	if err := locks.ByNameWithContext(ctx, id.FirewallPolicyName, azureFirewallPolicyResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
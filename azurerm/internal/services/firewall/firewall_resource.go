@@ -204,7 +204,7 @@ func resourceFirewall() *schema.Resource {
 }
 
 func resourceFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.AzureFirewallsClient
+	client := meta.(*clients.Client).Firewall().AzureFirewallsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -299,13 +299,19 @@ func resourceFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 		parameters.Sku.Tier = network.AzureFirewallSkuTier(skuTier)
 	}
 
-	locks.ByName(name, azureFirewallResourceName)
+	if err := locks.ByNameWithContext(ctx, name, azureFirewallResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Firewall %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, azureFirewallResourceName)
 
-	locks.MultipleByName(vnetToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, vnetToLock, VirtualNetworkResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Firewall %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockMultipleByName(vnetToLock, VirtualNetworkResourceName)
 
-	locks.MultipleByName(subnetToLock, SubnetResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, subnetToLock, SubnetResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Firewall %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockMultipleByName(subnetToLock, SubnetResourceName)
 
 	if !d.IsNewResource() {
@@ -349,7 +355,7 @@ func resourceFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceFirewallRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.AzureFirewallsClient
+	client := meta.(*clients.Client).Firewall().AzureFirewallsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -419,7 +425,7 @@ func resourceFirewallRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceFirewallDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.AzureFirewallsClient
+	client := meta.(*clients.Client).Firewall().AzureFirewallsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -454,13 +460,13 @@ func resourceFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 				if err2 != nil {
 					return err2
 				}
-				subnetName := parsedSubnetID.Path["subnets"]
+				virtualNetworkName := parsedSubnetID.Path["virtualNetworks"]
+				subnetName := locks.ChildName(virtualNetworkName, parsedSubnetID.Path["subnets"])
 
 				if !utils.SliceContainsValue(subnetNamesToLock, subnetName) {
 					subnetNamesToLock = append(subnetNamesToLock, subnetName)
 				}
 
-				virtualNetworkName := parsedSubnetID.Path["virtualNetworks"]
 				if !utils.SliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
 					virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
 				}
@@ -473,13 +479,13 @@ func resourceFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 				if err2 != nil {
 					return err2
 				}
-				subnetName := parsedSubnetID.Path["subnets"]
+				virtualNetworkName := parsedSubnetID.Path["virtualNetworks"]
+				subnetName := locks.ChildName(virtualNetworkName, parsedSubnetID.Path["subnets"])
 
 				if !utils.SliceContainsValue(subnetNamesToLock, subnetName) {
 					subnetNamesToLock = append(subnetNamesToLock, subnetName)
 				}
 
-				virtualNetworkName := parsedSubnetID.Path["virtualNetworks"]
 				if !utils.SliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
 					virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
 				}
@@ -487,13 +493,19 @@ func resourceFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	locks.ByName(name, azureFirewallResourceName)
+	if err := locks.ByNameWithContext(ctx, name, azureFirewallResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Firewall %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, azureFirewallResourceName)
 
-	locks.MultipleByName(&virtualNetworkNamesToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, &virtualNetworkNamesToLock, VirtualNetworkResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Firewall %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockMultipleByName(&virtualNetworkNamesToLock, VirtualNetworkResourceName)
 
-	locks.MultipleByName(&subnetNamesToLock, SubnetResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, &subnetNamesToLock, SubnetResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Firewall %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockMultipleByName(&subnetNamesToLock, SubnetResourceName)
 
 	future, err := client.Delete(ctx, resourceGroup, name)
@@ -534,8 +546,8 @@ func expandFirewallIPConfigurations(configs []interface{}) (*[]network.AzureFire
 				return nil, nil, nil, err
 			}
 
-			subnetName := subnetID.Path["subnets"]
 			virtualNetworkName := subnetID.Path["virtualNetworks"]
+			subnetName := locks.ChildName(virtualNetworkName, subnetID.Path["subnets"])
 
 			if !utils.SliceContainsValue(subnetNamesToLock, subnetName) {
 				subnetNamesToLock = append(subnetNamesToLock, subnetName)
@@ -274,7 +274,7 @@ func (FirewallResource) Exists(ctx context.Context, clients *clients.Client, sta
 
 	name := id.Path["azureFirewalls"]
 
-	resp, err := clients.Firewall.AzureFirewallsClient.Get(ctx, id.ResourceGroup, name)
+	resp, err := clients.Firewall().AzureFirewallsClient.Get(ctx, id.ResourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Azure Firewall %q (Resource Group: %q): %v", name, id.ResourceGroup, err)
 	}
@@ -288,12 +288,12 @@ func (FirewallResource) Destroy(ctx context.Context, clients *clients.Client, st
 		return nil, err
 	}
 
-	future, err := clients.Firewall.AzureFirewallsClient.Delete(ctx, id.ResourceGroup, id.AzureFirewallName)
+	future, err := clients.Firewall().AzureFirewallsClient.Delete(ctx, id.ResourceGroup, id.AzureFirewallName)
 	if err != nil {
 		return nil, fmt.Errorf("deleting Azure Firewall %q: %+v", id.AzureFirewallName, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, clients.Firewall.AzureFirewallsClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, clients.Firewall().AzureFirewallsClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for Deletion on azureFirewallsClient: %+v", err)
 	}
 
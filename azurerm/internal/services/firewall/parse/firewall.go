@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallId struct {
@@ -67,3 +68,12 @@ func FirewallID(input string) (*FirewallId, error) {
 
 	return &resourceId, nil
 }
+
+// FirewallIDNormalized parses a Firewall ID into an FirewallId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the FirewallID method should be used instead for validation etc.
+func FirewallIDNormalized(input string) (*FirewallId, error) {
+	return FirewallID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/azureFirewalls/%s"))
+}
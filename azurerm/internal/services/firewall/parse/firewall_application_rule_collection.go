@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallApplicationRuleCollectionId struct {
@@ -73,3 +74,12 @@ func FirewallApplicationRuleCollectionID(input string) (*FirewallApplicationRule
 
 	return &resourceId, nil
 }
+
+// FirewallApplicationRuleCollectionIDNormalized parses a FirewallApplicationRuleCollection ID into an FirewallApplicationRuleCollectionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the FirewallApplicationRuleCollectionID method should be used instead for validation etc.
+func FirewallApplicationRuleCollectionIDNormalized(input string) (*FirewallApplicationRuleCollectionId, error) {
+	return FirewallApplicationRuleCollectionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/azureFirewalls/%s/applicationRuleCollections/%s"))
+}
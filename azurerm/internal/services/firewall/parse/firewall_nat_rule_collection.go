@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallNatRuleCollectionId struct {
@@ -73,3 +74,12 @@ func FirewallNatRuleCollectionID(input string) (*FirewallNatRuleCollectionId, er
 
 	return &resourceId, nil
 }
+
+// FirewallNatRuleCollectionIDNormalized parses a FirewallNatRuleCollection ID into an FirewallNatRuleCollectionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the FirewallNatRuleCollectionID method should be used instead for validation etc.
+func FirewallNatRuleCollectionIDNormalized(input string) (*FirewallNatRuleCollectionId, error) {
+	return FirewallNatRuleCollectionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/azureFirewalls/%s/natRuleCollections/%s"))
+}
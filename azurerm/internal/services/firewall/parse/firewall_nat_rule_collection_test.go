@@ -126,3 +126,164 @@ func TestFirewallNatRuleCollectionID(t *testing.T) {
 		}
 	}
 }
+
+func TestFirewallNatRuleCollectionIDNormalized(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *FirewallNatRuleCollectionId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing AzureFirewallName
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/",
+			Error: true,
+		},
+
+		{
+			// missing value for AzureFirewallName
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/",
+			Error: true,
+		},
+
+		{
+			// missing NatRuleCollectionName
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/",
+			Error: true,
+		},
+
+		{
+			// missing value for NatRuleCollectionName
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/natRuleCollections/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/natRuleCollections/natRuleCollection1",
+			Expected: &FirewallNatRuleCollectionId{
+				SubscriptionId:        "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:         "mygroup1",
+				AzureFirewallName:     "myfirewall",
+				NatRuleCollectionName: "natRuleCollection1",
+			},
+		},
+
+		{
+			// trailing slash
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/natRuleCollections/natRuleCollection1/",
+			Expected: &FirewallNatRuleCollectionId{
+				SubscriptionId:        "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:         "mygroup1",
+				AzureFirewallName:     "myfirewall",
+				NatRuleCollectionName: "natRuleCollection1",
+			},
+		},
+
+		{
+			// lower-cased segment names
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/mygroup1/providers/microsoft.network/azurefirewalls/myfirewall/natrulecollections/natRuleCollection1",
+			Expected: &FirewallNatRuleCollectionId{
+				SubscriptionId:        "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:         "mygroup1",
+				AzureFirewallName:     "myfirewall",
+				NatRuleCollectionName: "natRuleCollection1",
+			},
+		},
+
+		{
+			// upper-cased segment names
+			Input: "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/RESOURCEGROUPS/mygroup1/PROVIDERS/MICROSOFT.NETWORK/AZUREFIREWALLS/myfirewall/NATRULECOLLECTIONS/natRuleCollection1",
+			Expected: &FirewallNatRuleCollectionId{
+				SubscriptionId:        "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:         "mygroup1",
+				AzureFirewallName:     "myfirewall",
+				NatRuleCollectionName: "natRuleCollection1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := FirewallNatRuleCollectionIDNormalized(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.AzureFirewallName != v.Expected.AzureFirewallName {
+			t.Fatalf("Expected %q but got %q for AzureFirewallName", v.Expected.AzureFirewallName, actual.AzureFirewallName)
+		}
+		if actual.NatRuleCollectionName != v.Expected.NatRuleCollectionName {
+			t.Fatalf("Expected %q but got %q for NatRuleCollectionName", v.Expected.NatRuleCollectionName, actual.NatRuleCollectionName)
+		}
+	}
+}
+
+func TestFirewallNatRuleCollectionIDRoundTrip(t *testing.T) {
+	testData := []string{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/natRuleCollections/natRuleCollection1",
+	}
+
+	for _, input := range testData {
+		t.Logf("[DEBUG] Testing %q", input)
+
+		id, err := FirewallNatRuleCollectionID(input)
+		if err != nil {
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+
+		// formatting the parsed ID should return the original ID
+		if actual := id.ID(); actual != input {
+			t.Fatalf("Expected %q but got %q", input, actual)
+		}
+
+		// and building the ID from each of it's segments should return the same ID
+		if actual := NewFirewallNatRuleCollectionID(id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName, id.NatRuleCollectionName); actual != *id {
+			t.Fatalf("Expected %+v but got %+v", *id, actual)
+		}
+	}
+}
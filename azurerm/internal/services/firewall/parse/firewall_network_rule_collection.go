@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallNetworkRuleCollectionId struct {
@@ -73,3 +74,12 @@ func FirewallNetworkRuleCollectionID(input string) (*FirewallNetworkRuleCollecti
 
 	return &resourceId, nil
 }
+
+// FirewallNetworkRuleCollectionIDNormalized parses a FirewallNetworkRuleCollection ID into an FirewallNetworkRuleCollectionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the FirewallNetworkRuleCollectionID method should be used instead for validation etc.
+func FirewallNetworkRuleCollectionIDNormalized(input string) (*FirewallNetworkRuleCollectionId, error) {
+	return FirewallNetworkRuleCollectionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/azureFirewalls/%s/networkRuleCollections/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallPolicyId struct {
@@ -67,3 +68,12 @@ func FirewallPolicyID(input string) (*FirewallPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// FirewallPolicyIDNormalized parses a FirewallPolicy ID into an FirewallPolicyId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the FirewallPolicyID method should be used instead for validation etc.
+func FirewallPolicyIDNormalized(input string) (*FirewallPolicyId, error) {
+	return FirewallPolicyID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/firewallPolicies/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallPolicyRuleCollectionGroupId struct {
@@ -73,3 +74,12 @@ func FirewallPolicyRuleCollectionGroupID(input string) (*FirewallPolicyRuleColle
 
 	return &resourceId, nil
 }
+
+// FirewallPolicyRuleCollectionGroupIDNormalized parses a FirewallPolicyRuleCollectionGroup ID into an FirewallPolicyRuleCollectionGroupId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the FirewallPolicyRuleCollectionGroupID method should be used instead for validation etc.
+func FirewallPolicyRuleCollectionGroupIDNormalized(input string) (*FirewallPolicyRuleCollectionGroupId, error) {
+	return FirewallPolicyRuleCollectionGroupID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/firewallPolicies/%s/ruleCollectionGroups/%s"))
+}
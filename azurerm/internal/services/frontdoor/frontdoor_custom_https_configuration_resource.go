@@ -78,7 +78,7 @@ func resourceFrontDoorCustomHttpsConfiguration() *schema.Resource {
 }
 
 func resourceFrontDoorCustomHttpsConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Frontdoor.FrontDoorsFrontendClient
+	client := meta.(*clients.Client).Frontdoor().FrontDoorsFrontendClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -118,7 +118,7 @@ func resourceFrontDoorCustomHttpsConfigurationCreateUpdate(d *schema.ResourceDat
 }
 
 func resourceFrontDoorCustomHttpsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Frontdoor.FrontDoorsFrontendClient
+	client := meta.(*clients.Client).Frontdoor().FrontDoorsFrontendClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -153,7 +153,7 @@ func resourceFrontDoorCustomHttpsConfigurationRead(d *schema.ResourceData, meta
 }
 
 func resourceFrontDoorCustomHttpsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Frontdoor.FrontDoorsFrontendClient
+	client := meta.(*clients.Client).Frontdoor().FrontDoorsFrontendClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -200,7 +200,9 @@ type customHttpsConfigurationUpdateInput struct {
 func updateCustomHttpsConfiguration(ctx context.Context, client *frontdoor.FrontendEndpointsClient, input customHttpsConfigurationUpdateInput) error {
 	// Locking to prevent parallel changes causing issues
 	frontendEndpointResourceId := input.frontendEndpointId.ID()
-	locks.ByID(frontendEndpointResourceId)
+	if err := locks.ByIDWithContext(ctx, frontendEndpointResourceId); err != nil {
+		return fmt.Errorf("acquiring lock for Custom HTTPS Configuration (Frontend Endpoint %q / Resource Group %q): %+v", input.frontendEndpointId.Name, input.frontendEndpointId.ResourceGroup, err)
+	}
 	defer locks.UnlockByID(frontendEndpointResourceId)
 
 	if input.provisioningState == "" {
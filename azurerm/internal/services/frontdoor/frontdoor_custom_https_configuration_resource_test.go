@@ -45,7 +45,7 @@ func (FrontDoorCustomHttpsConfigurationResource) Exists(ctx context.Context, cli
 		return nil, err
 	}
 
-	resp, err := clients.Frontdoor.FrontDoorsFrontendClient.Get(ctx, id.ResourceGroup, id.FrontDoorName, id.Name)
+	resp, err := clients.Frontdoor().FrontDoorsFrontendClient.Get(ctx, id.ResourceGroup, id.FrontDoorName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Frontend Endpoint %q (Front Door %q / Resource Group %q): %v", id.Name, id.FrontDoorName, id.ResourceGroup, err)
 	}
@@ -434,7 +434,7 @@ func resourceFrontDoorFirewallPolicy() *schema.Resource {
 }
 
 func resourceFrontDoorFirewallPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Frontdoor.FrontDoorsPolicyClient
+	client := meta.(*clients.Client).Frontdoor().FrontDoorsPolicyClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -508,7 +508,7 @@ func resourceFrontDoorFirewallPolicyCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceFrontDoorFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Frontdoor.FrontDoorsPolicyClient
+	client := meta.(*clients.Client).Frontdoor().FrontDoorsPolicyClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -560,7 +560,7 @@ func resourceFrontDoorFirewallPolicyRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceFrontDoorFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Frontdoor.FrontDoorsPolicyClient
+	client := meta.(*clients.Client).Frontdoor().FrontDoorsPolicyClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
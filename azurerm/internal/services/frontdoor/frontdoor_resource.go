@@ -519,7 +519,7 @@ func resourceFrontDoor() *schema.Resource {
 }
 
 func resourceFrontDoorCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Frontdoor.FrontDoorsClient
+	client := meta.(*clients.Client).Frontdoor().FrontDoorsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -601,7 +601,7 @@ func resourceFrontDoorCreateUpdate(d *schema.ResourceData, meta interface{}) err
 
 	// Now loop through the FrontendEndpoints and enable/disable Custom Domain HTTPS
 	// on each individual Frontend Endpoint if required
-	feClient := meta.(*clients.Client).Frontdoor.FrontDoorsFrontendClient
+	feClient := meta.(*clients.Client).Frontdoor().FrontDoorsFrontendClient
 
 	for _, v := range frontendEndpoints {
 		frontendEndpoint := v.(map[string]interface{})
@@ -615,7 +615,7 @@ func resourceFrontDoorCreateUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 
 		if properties := resp.FrontendEndpointProperties; properties != nil {
-			frontendClient := meta.(*clients.Client).Frontdoor.FrontDoorsFrontendClient
+			frontendClient := meta.(*clients.Client).Frontdoor().FrontDoorsFrontendClient
 			customHttpsConfigurationNew := frontendEndpoint["custom_https_configuration"].([]interface{})
 			frontendInputId := parse.NewFrontendEndpointID(frontDoorId.SubscriptionId, frontDoorId.ResourceGroup, frontDoorId.Name, endpointName)
 			input := customHttpsConfigurationUpdateInput{
@@ -635,7 +635,7 @@ func resourceFrontDoorCreateUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceFrontDoorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Frontdoor.FrontDoorsClient
+	client := meta.(*clients.Client).Frontdoor().FrontDoorsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -678,7 +678,7 @@ func resourceFrontDoorRead(d *schema.ResourceData, meta interface{}) error {
 
 		// Need to call frontEndEndpointClient here to get custom(HTTPS)Configuration information from that client
 		// because the information is hidden from the main frontDoorClient "by design"...
-		frontEndEndpointsClient := meta.(*clients.Client).Frontdoor.FrontDoorsFrontendClient
+		frontEndEndpointsClient := meta.(*clients.Client).Frontdoor().FrontDoorsFrontendClient
 		frontEndEndpointInfo, err := retrieveFrontEndEndpointInformation(ctx, frontEndEndpointsClient, *id, props.FrontendEndpoints)
 		if err != nil {
 			return fmt.Errorf("retrieving FrontEnd Endpoint Custom HTTPS Information: %+v", err)
@@ -803,7 +803,7 @@ func resourceFrontDoorRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceFrontDoorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Frontdoor.FrontDoorsClient
+	client := meta.(*clients.Client).Frontdoor().FrontDoorsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -215,7 +215,7 @@ func (FrontDoorResource) Exists(ctx context.Context, clients *clients.Client, st
 		return nil, err
 	}
 
-	resp, err := clients.Frontdoor.FrontDoorsClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Frontdoor().FrontDoorsClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Front Door %q (Resource Group %q): %v", id.Name, id.ResourceGroup, err)
 	}
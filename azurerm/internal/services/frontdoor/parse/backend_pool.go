@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BackendPoolId struct {
@@ -74,6 +75,15 @@ func BackendPoolID(input string) (*BackendPoolId, error) {
 	return &resourceId, nil
 }
 
+// BackendPoolIDNormalized parses a BackendPool ID into an BackendPoolId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the BackendPoolID method should be used instead for validation etc.
+func BackendPoolIDNormalized(input string) (*BackendPoolId, error) {
+	return BackendPoolID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/frontDoors/%s/backendPools/%s"))
+}
+
 // BackendPoolIDInsensitively parses an BackendPool ID into an BackendPoolId struct, insensitively
 // This should only be used to parse an ID for rewriting, the BackendPoolID
 // method should be used instead for validation etc.
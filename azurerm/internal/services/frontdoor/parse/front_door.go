@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FrontDoorId struct {
@@ -68,6 +69,15 @@ func FrontDoorID(input string) (*FrontDoorId, error) {
 	return &resourceId, nil
 }
 
+// FrontDoorIDNormalized parses a FrontDoor ID into an FrontDoorId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the FrontDoorID method should be used instead for validation etc.
+func FrontDoorIDNormalized(input string) (*FrontDoorId, error) {
+	return FrontDoorID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/frontDoors/%s"))
+}
+
 // FrontDoorIDInsensitively parses an FrontDoor ID into an FrontDoorId struct, insensitively
 // This should only be used to parse an ID for rewriting, the FrontDoorID
 // method should be used instead for validation etc.
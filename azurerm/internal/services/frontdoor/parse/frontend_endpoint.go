@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FrontendEndpointId struct {
@@ -74,6 +75,15 @@ func FrontendEndpointID(input string) (*FrontendEndpointId, error) {
 	return &resourceId, nil
 }
 
+// FrontendEndpointIDNormalized parses a FrontendEndpoint ID into an FrontendEndpointId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the FrontendEndpointID method should be used instead for validation etc.
+func FrontendEndpointIDNormalized(input string) (*FrontendEndpointId, error) {
+	return FrontendEndpointID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/frontDoors/%s/frontendEndpoints/%s"))
+}
+
 // FrontendEndpointIDInsensitively parses an FrontendEndpoint ID into an FrontendEndpointId struct, insensitively
 // This should only be used to parse an ID for rewriting, the FrontendEndpointID
 // method should be used instead for validation etc.
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type HealthProbeId struct {
@@ -74,6 +75,15 @@ func HealthProbeID(input string) (*HealthProbeId, error) {
 	return &resourceId, nil
 }
 
+// HealthProbeIDNormalized parses a HealthProbe ID into an HealthProbeId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the HealthProbeID method should be used instead for validation etc.
+func HealthProbeIDNormalized(input string) (*HealthProbeId, error) {
+	return HealthProbeID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/frontDoors/%s/healthProbeSettings/%s"))
+}
+
 // HealthProbeIDInsensitively parses an HealthProbe ID into an HealthProbeId struct, insensitively
 // This should only be used to parse an ID for rewriting, the HealthProbeID
 // method should be used instead for validation etc.
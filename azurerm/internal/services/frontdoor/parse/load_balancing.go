@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancingId struct {
@@ -74,6 +75,15 @@ func LoadBalancingID(input string) (*LoadBalancingId, error) {
 	return &resourceId, nil
 }
 
+// LoadBalancingIDNormalized parses a LoadBalancing ID into an LoadBalancingId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LoadBalancingID method should be used instead for validation etc.
+func LoadBalancingIDNormalized(input string) (*LoadBalancingId, error) {
+	return LoadBalancingID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/frontDoors/%s/loadBalancingSettings/%s"))
+}
+
 // LoadBalancingIDInsensitively parses an LoadBalancing ID into an LoadBalancingId struct, insensitively
 // This should only be used to parse an ID for rewriting, the LoadBalancingID
 // method should be used instead for validation etc.
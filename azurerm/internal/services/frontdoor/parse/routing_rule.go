@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RoutingRuleId struct {
@@ -74,6 +75,15 @@ func RoutingRuleID(input string) (*RoutingRuleId, error) {
 	return &resourceId, nil
 }
 
+// RoutingRuleIDNormalized parses a RoutingRule ID into an RoutingRuleId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the RoutingRuleID method should be used instead for validation etc.
+func RoutingRuleIDNormalized(input string) (*RoutingRuleId, error) {
+	return RoutingRuleID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/frontDoors/%s/routingRules/%s"))
+}
+
 // RoutingRuleIDInsensitively parses an RoutingRule ID into an RoutingRuleId struct, insensitively
 // This should only be used to parse an ID for rewriting, the RoutingRuleID
 // method should be used instead for validation etc.
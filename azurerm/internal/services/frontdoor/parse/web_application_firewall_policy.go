@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type WebApplicationFirewallPolicyId struct {
@@ -68,6 +69,15 @@ func WebApplicationFirewallPolicyID(input string) (*WebApplicationFirewallPolicy
 	return &resourceId, nil
 }
 
+// WebApplicationFirewallPolicyIDNormalized parses a WebApplicationFirewallPolicy ID into an WebApplicationFirewallPolicyId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the WebApplicationFirewallPolicyID method should be used instead for validation etc.
+func WebApplicationFirewallPolicyIDNormalized(input string) (*WebApplicationFirewallPolicyId, error) {
+	return WebApplicationFirewallPolicyID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/frontDoorWebApplicationFirewallPolicies/%s"))
+}
+
 // WebApplicationFirewallPolicyIDInsensitively parses an WebApplicationFirewallPolicy ID into an WebApplicationFirewallPolicyId struct, insensitively
 // This should only be used to parse an ID for rewriting, the WebApplicationFirewallPolicyID
 // method should be used instead for validation etc.
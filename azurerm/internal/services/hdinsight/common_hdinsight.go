@@ -19,8 +19,8 @@ import (
 
 func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema.UpdateFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
-		client := meta.(*clients.Client).HDInsight.ClustersClient
-		extensionsClient := meta.(*clients.Client).HDInsight.ExtensionsClient
+		client := meta.(*clients.Client).HDInsight().ClustersClient
+		extensionsClient := meta.(*clients.Client).HDInsight().ExtensionsClient
 		ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 		defer cancel()
 
@@ -32,7 +32,7 @@ func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema
 		resourceGroup := id.ResourceGroup
 		name := id.Name
 
-		if d.HasChange("tags") {
+		if d.HasChanges("tags", "tags_all") {
 			t := d.Get("tags").(map[string]interface{})
 			params := hdinsight.ClusterPatchParameters{
 				Tags: tags.Expand(t),
@@ -70,7 +70,7 @@ func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema
 				log.Printf("[DEBUG] Detected change in edge nodes")
 				edgeNodeRaw := d.Get("roles.0.edge_node").([]interface{})
 				edgeNodeConfig := edgeNodeRaw[0].(map[string]interface{})
-				applicationsClient := meta.(*clients.Client).HDInsight.ApplicationsClient
+				applicationsClient := meta.(*clients.Client).HDInsight().ApplicationsClient
 
 				oldEdgeNodeCount, newEdgeNodeCount := d.GetChange("roles.0.edge_node.0.target_instance_count")
 				oldEdgeNodeInt := oldEdgeNodeCount.(int)
@@ -147,7 +147,7 @@ func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema
 
 func hdinsightClusterDelete(clusterKind string) schema.DeleteFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
-		client := meta.(*clients.Client).HDInsight.ClustersClient
+		client := meta.(*clients.Client).HDInsight().ClustersClient
 		ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 		defer cancel()
 
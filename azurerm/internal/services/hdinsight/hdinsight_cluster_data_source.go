@@ -104,8 +104,8 @@ func dataSourceHDInsightSparkCluster() *schema.Resource {
 }
 
 func dataSourceHDInsightClusterRead(d *schema.ResourceData, meta interface{}) error {
-	clustersClient := meta.(*clients.Client).HDInsight.ClustersClient
-	configurationsClient := meta.(*clients.Client).HDInsight.ConfigurationsClient
+	clustersClient := meta.(*clients.Client).HDInsight().ClustersClient
+	configurationsClient := meta.(*clients.Client).HDInsight().ConfigurationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
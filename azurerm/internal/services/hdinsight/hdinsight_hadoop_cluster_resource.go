@@ -174,9 +174,9 @@ func resourceHDInsightHadoopCluster() *schema.Resource {
 }
 
 func resourceHDInsightHadoopClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HDInsight.ClustersClient
+	client := meta.(*clients.Client).HDInsight().ClustersClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	extensionsClient := meta.(*clients.Client).HDInsight.ExtensionsClient
+	extensionsClient := meta.(*clients.Client).HDInsight().ExtensionsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -275,7 +275,7 @@ func resourceHDInsightHadoopClusterCreate(d *schema.ResourceData, meta interface
 	// We can only add an edge node after creation
 	if v, ok := d.GetOk("roles.0.edge_node"); ok {
 		edgeNodeRaw := v.([]interface{})
-		applicationsClient := meta.(*clients.Client).HDInsight.ApplicationsClient
+		applicationsClient := meta.(*clients.Client).HDInsight().ApplicationsClient
 		edgeNodeConfig := edgeNodeRaw[0].(map[string]interface{})
 
 		err := createHDInsightEdgeNodes(ctx, applicationsClient, resourceGroup, name, edgeNodeConfig)
@@ -310,9 +310,9 @@ func resourceHDInsightHadoopClusterCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceHDInsightHadoopClusterRead(d *schema.ResourceData, meta interface{}) error {
-	clustersClient := meta.(*clients.Client).HDInsight.ClustersClient
-	configurationsClient := meta.(*clients.Client).HDInsight.ConfigurationsClient
-	extensionsClient := meta.(*clients.Client).HDInsight.ExtensionsClient
+	clustersClient := meta.(*clients.Client).HDInsight().ClustersClient
+	configurationsClient := meta.(*clients.Client).HDInsight().ConfigurationsClient
+	extensionsClient := meta.(*clients.Client).HDInsight().ExtensionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -377,7 +377,7 @@ func resourceHDInsightHadoopClusterRead(d *schema.ResourceData, meta interface{}
 		}
 		flattenedRoles := flattenHDInsightRoles(d, props.ComputeProfile, hadoopRoles)
 
-		applicationsClient := meta.(*clients.Client).HDInsight.ApplicationsClient
+		applicationsClient := meta.(*clients.Client).HDInsight().ApplicationsClient
 
 		edgeNode, err := applicationsClient.Get(ctx, resourceGroup, name, name)
 		if err != nil {
@@ -530,7 +530,7 @@ func (t HDInsightHadoopClusterResource) Exists(ctx context.Context, clients *cli
 	resourceGroup := id.ResourceGroup
 	name := id.Name
 
-	resp, err := clients.HDInsight.ClustersClient.Get(ctx, resourceGroup, name)
+	resp, err := clients.HDInsight().ClustersClient.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading HDInsight Hadoop Cluster (%s): %+v", id.String(), err)
 	}
@@ -127,9 +127,9 @@ func resourceHDInsightHBaseCluster() *schema.Resource {
 }
 
 func resourceHDInsightHBaseClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HDInsight.ClustersClient
+	client := meta.(*clients.Client).HDInsight().ClustersClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	extensionsClient := meta.(*clients.Client).HDInsight.ExtensionsClient
+	extensionsClient := meta.(*clients.Client).HDInsight().ExtensionsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -237,9 +237,9 @@ func resourceHDInsightHBaseClusterCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceHDInsightHBaseClusterRead(d *schema.ResourceData, meta interface{}) error {
-	clustersClient := meta.(*clients.Client).HDInsight.ClustersClient
-	configurationsClient := meta.(*clients.Client).HDInsight.ConfigurationsClient
-	extensionsClient := meta.(*clients.Client).HDInsight.ExtensionsClient
+	clustersClient := meta.(*clients.Client).HDInsight().ClustersClient
+	configurationsClient := meta.(*clients.Client).HDInsight().ConfigurationsClient
+	extensionsClient := meta.(*clients.Client).HDInsight().ExtensionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
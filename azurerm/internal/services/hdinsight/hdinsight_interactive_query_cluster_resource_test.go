@@ -391,7 +391,7 @@ func (t HDInsightInteractiveQueryClusterResource) Exists(ctx context.Context, cl
 	resourceGroup := id.ResourceGroup
 	name := id.Name
 
-	resp, err := clients.HDInsight.ClustersClient.Get(ctx, resourceGroup, name)
+	resp, err := clients.HDInsight().ClustersClient.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading HDInsight Interactive Query Cluster (%s): %+v", id.String(), err)
 	}
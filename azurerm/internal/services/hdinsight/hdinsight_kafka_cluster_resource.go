@@ -164,10 +164,10 @@ func resourceHDInsightKafkaCluster() *schema.Resource {
 }
 
 func resourceHDInsightKafkaClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	groupClient := meta.(*clients.Client).Authorization.GroupsClient
-	client := meta.(*clients.Client).HDInsight.ClustersClient
+	groupClient := meta.(*clients.Client).Authorization().GroupsClient
+	client := meta.(*clients.Client).HDInsight().ClustersClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	extensionsClient := meta.(*clients.Client).HDInsight.ExtensionsClient
+	extensionsClient := meta.(*clients.Client).HDInsight().ExtensionsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -283,9 +283,9 @@ func resourceHDInsightKafkaClusterCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceHDInsightKafkaClusterRead(d *schema.ResourceData, meta interface{}) error {
-	clustersClient := meta.(*clients.Client).HDInsight.ClustersClient
-	configurationsClient := meta.(*clients.Client).HDInsight.ConfigurationsClient
-	extensionsClient := meta.(*clients.Client).HDInsight.ExtensionsClient
+	clustersClient := meta.(*clients.Client).HDInsight().ClustersClient
+	configurationsClient := meta.(*clients.Client).HDInsight().ConfigurationsClient
+	extensionsClient := meta.(*clients.Client).HDInsight().ExtensionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -417,7 +417,7 @@ func (t HDInsightKafkaClusterResource) Exists(ctx context.Context, clients *clie
 	resourceGroup := id.ResourceGroup
 	name := id.Name
 
-	resp, err := clients.HDInsight.ClustersClient.Get(ctx, resourceGroup, name)
+	resp, err := clients.HDInsight().ClustersClient.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading HDInsight Kafka Cluster (%s): %+v", id.String(), err)
 	}
@@ -212,7 +212,7 @@ func (t HDInsightRServerClusterResource) Exists(ctx context.Context, clients *cl
 	resourceGroup := id.ResourceGroup
 	name := id.Name
 
-	resp, err := clients.HDInsight.ClustersClient.Get(ctx, resourceGroup, name)
+	resp, err := clients.HDInsight().ClustersClient.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading HDInsight R Server Cluster (%s): %+v", id.String(), err)
 	}
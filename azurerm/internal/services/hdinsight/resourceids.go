@@ -1,3 +1,3 @@
 package hdinsight
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Cluster -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.HDInsight/clusters/cluster1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Cluster -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.HDInsight/clusters/cluster1 -resource-type=azurerm_hdinsight_hadoop_cluster,azurerm_hdinsight_hbase_cluster,azurerm_hdinsight_interactive_query_cluster,azurerm_hdinsight_kafka_cluster,azurerm_hdinsight_ml_services_cluster,azurerm_hdinsight_rserver_cluster,azurerm_hdinsight_spark_cluster,azurerm_hdinsight_storm_cluster
@@ -115,7 +115,7 @@ func dataSourceHealthcareService() *schema.Resource {
 }
 
 func dataSourceHealthcareServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HealthCare.HealthcareServiceClient
+	client := meta.(*clients.Client).HealthCare().HealthcareServiceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -160,7 +160,7 @@ func resourceHealthcareService() *schema.Resource {
 }
 
 func resourceHealthcareServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HealthCare.HealthcareServiceClient
+	client := meta.(*clients.Client).HealthCare().HealthcareServiceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -225,7 +225,7 @@ func resourceHealthcareServiceCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceHealthcareServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HealthCare.HealthcareServiceClient
+	client := meta.(*clients.Client).HealthCare().HealthcareServiceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -278,7 +278,7 @@ func resourceHealthcareServiceRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceHealthcareServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HealthCare.HealthcareServiceClient
+	client := meta.(*clients.Client).HealthCare().HealthcareServiceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
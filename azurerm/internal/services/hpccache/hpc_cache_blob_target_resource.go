@@ -72,7 +72,7 @@ func resourceHPCCacheBlobTarget() *schema.Resource {
 }
 
 func resourceHPCCacheBlobTargetCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HPCCache.StorageTargetsClient
+	client := meta.(*clients.Client).HPCCache().StorageTargetsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -138,7 +138,7 @@ func resourceHPCCacheBlobTargetCreateOrUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceHPCCacheBlobTargetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HPCCache.StorageTargetsClient
+	client := meta.(*clients.Client).HPCCache().StorageTargetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -187,7 +187,7 @@ func resourceHPCCacheBlobTargetRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceHPCCacheBlobTargetDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HPCCache.StorageTargetsClient
+	client := meta.(*clients.Client).HPCCache().StorageTargetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -75,7 +75,7 @@ func (HPCCacheBlobTargetResource) Exists(ctx context.Context, clients *clients.C
 		return nil, err
 	}
 
-	resp, err := clients.HPCCache.StorageTargetsClient.Get(ctx, id.ResourceGroup, id.CacheName, id.Name)
+	resp, err := clients.HPCCache().StorageTargetsClient.Get(ctx, id.ResourceGroup, id.CacheName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving HPC Cache Blob Target (%s): %+v", id.String(), err)
 	}
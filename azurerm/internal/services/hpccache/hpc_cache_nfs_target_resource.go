@@ -103,7 +103,7 @@ func resourceHPCCacheNFSTarget() *schema.Resource {
 }
 
 func resourceHPCCacheNFSTargetCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HPCCache.StorageTargetsClient
+	client := meta.(*clients.Client).HPCCache().StorageTargetsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -161,7 +161,7 @@ func resourceHPCCacheNFSTargetCreateOrUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceHPCCacheNFSTargetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HPCCache.StorageTargetsClient
+	client := meta.(*clients.Client).HPCCache().StorageTargetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -202,7 +202,7 @@ func resourceHPCCacheNFSTargetRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceHPCCacheNFSTargetDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HPCCache.StorageTargetsClient
+	client := meta.(*clients.Client).HPCCache().StorageTargetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -111,7 +111,7 @@ func (HPCCacheNFSTargetResource) Exists(ctx context.Context, clients *clients.Cl
 		return nil, err
 	}
 
-	resp, err := clients.HPCCache.StorageTargetsClient.Get(ctx, id.ResourceGroup, id.CacheName, id.Name)
+	resp, err := clients.HPCCache().StorageTargetsClient.Get(ctx, id.ResourceGroup, id.CacheName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving HPC Cache NFS Target (%s): %+v", id.String(), err)
 	}
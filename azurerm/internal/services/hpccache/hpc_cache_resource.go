@@ -105,7 +105,7 @@ func resourceHPCCache() *schema.Resource {
 }
 
 func resourceHPCCacheCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HPCCache.CachesClient
+	client := meta.(*clients.Client).HPCCache().CachesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -173,7 +173,7 @@ func resourceHPCCacheCreateOrUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceHPCCacheRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HPCCache.CachesClient
+	client := meta.(*clients.Client).HPCCache().CachesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -217,7 +217,7 @@ func resourceHPCCacheRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceHPCCacheDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HPCCache.CachesClient
+	client := meta.(*clients.Client).HPCCache().CachesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
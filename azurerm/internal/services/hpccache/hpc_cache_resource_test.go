@@ -117,7 +117,7 @@ func (HPCCacheResource) Exists(ctx context.Context, clients *clients.Client, sta
 		return nil, err
 	}
 
-	resp, err := clients.HPCCache.CachesClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.HPCCache().CachesClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving HPC Cache (%s): %+v", id.String(), err)
 	}
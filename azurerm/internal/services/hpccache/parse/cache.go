@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CacheId struct {
@@ -67,3 +68,12 @@ func CacheID(input string) (*CacheId, error) {
 
 	return &resourceId, nil
 }
+
+// CacheIDNormalized parses a Cache ID into an CacheId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the CacheID method should be used instead for validation etc.
+func CacheIDNormalized(input string) (*CacheId, error) {
+	return CacheID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StorageCache/caches/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageTargetId struct {
@@ -73,3 +74,12 @@ func StorageTargetID(input string) (*StorageTargetId, error) {
 
 	return &resourceId, nil
 }
+
+// StorageTargetIDNormalized parses a StorageTarget ID into an StorageTargetId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the StorageTargetID method should be used instead for validation etc.
+func StorageTargetIDNormalized(input string) (*StorageTargetId, error) {
+	return StorageTargetID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StorageCache/caches/%s/storageTargets/%s"))
+}
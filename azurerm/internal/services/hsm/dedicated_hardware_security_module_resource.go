@@ -106,7 +106,7 @@ func resourceDedicatedHardwareSecurityModule() *schema.Resource {
 }
 
 func resourceDedicatedHardwareSecurityModuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HSM.DedicatedHsmClient
+	client := meta.(*clients.Client).HSM().DedicatedHsmClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -165,7 +165,7 @@ func resourceDedicatedHardwareSecurityModuleCreate(d *schema.ResourceData, meta
 }
 
 func resourceDedicatedHardwareSecurityModuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HSM.DedicatedHsmClient
+	client := meta.(*clients.Client).HSM().DedicatedHsmClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -208,7 +208,7 @@ func resourceDedicatedHardwareSecurityModuleRead(d *schema.ResourceData, meta in
 }
 
 func resourceDedicatedHardwareSecurityModuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HSM.DedicatedHsmClient
+	client := meta.(*clients.Client).HSM().DedicatedHsmClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -218,7 +218,7 @@ func resourceDedicatedHardwareSecurityModuleUpdate(d *schema.ResourceData, meta
 	}
 
 	parameters := hardwaresecuritymodules.DedicatedHsmPatchParameters{}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -235,7 +235,7 @@ func resourceDedicatedHardwareSecurityModuleUpdate(d *schema.ResourceData, meta
 }
 
 func resourceDedicatedHardwareSecurityModuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).HSM.DedicatedHsmClient
+	client := meta.(*clients.Client).HSM().DedicatedHsmClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -109,7 +109,7 @@ func (DedicatedHardwareSecurityModuleResource) Exists(ctx context.Context, clien
 		return nil, err
 	}
 
-	resp, err := clients.HSM.DedicatedHsmClient.Get(ctx, id.ResourceGroup, id.DedicatedHSMName)
+	resp, err := clients.HSM().DedicatedHsmClient.Get(ctx, id.ResourceGroup, id.DedicatedHSMName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Dedicated HardwareSecurityModule %q (resource group: %q): %+v", id.DedicatedHSMName, id.ResourceGroup, err)
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DedicatedHardwareSecurityModuleId struct {
@@ -67,3 +68,12 @@ func DedicatedHardwareSecurityModuleID(input string) (*DedicatedHardwareSecurity
 
 	return &resourceId, nil
 }
+
+// DedicatedHardwareSecurityModuleIDNormalized parses a DedicatedHardwareSecurityModule ID into an DedicatedHardwareSecurityModuleId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DedicatedHardwareSecurityModuleID method should be used instead for validation etc.
+func DedicatedHardwareSecurityModuleIDNormalized(input string) (*DedicatedHardwareSecurityModuleId, error) {
+	return DedicatedHardwareSecurityModuleID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/%s"))
+}
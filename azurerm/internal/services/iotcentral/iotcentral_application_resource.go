@@ -85,7 +85,7 @@ func resourceIotCentralApplication() *schema.Resource {
 }
 
 func resourceIotCentralAppCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTCentral.AppsClient
+	client := meta.(*clients.Client).IoTCentral().AppsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -157,7 +157,7 @@ func resourceIotCentralAppCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceIotCentralAppUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTCentral.AppsClient
+	client := meta.(*clients.Client).IoTCentral().AppsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -204,7 +204,7 @@ func resourceIotCentralAppUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceIotCentralAppRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTCentral.AppsClient
+	client := meta.(*clients.Client).IoTCentral().AppsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -242,7 +242,7 @@ func resourceIotCentralAppRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIotCentralAppDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTCentral.AppsClient
+	client := meta.(*clients.Client).IoTCentral().AppsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -97,7 +97,7 @@ func (IoTCentralApplicationResource) Exists(ctx context.Context, clients *client
 		return nil, err
 	}
 
-	resp, err := clients.IoTCentral.AppsClient.Get(ctx, id.ResourceGroup, id.IoTAppName)
+	resp, err := clients.IoTCentral().AppsClient.Get(ctx, id.ResourceGroup, id.IoTAppName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Analysis Services Server %q (resource group: %q): %+v", id.IoTAppName, id.ResourceGroup, err)
 	}
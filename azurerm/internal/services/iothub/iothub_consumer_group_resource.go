@@ -58,7 +58,7 @@ func resourceIotHubConsumerGroup() *schema.Resource {
 }
 
 func resourceIotHubConsumerGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM IoTHub Consumer Group creation.")
@@ -68,7 +68,9 @@ func resourceIotHubConsumerGroupCreate(d *schema.ResourceData, meta interface{})
 	endpointName := d.Get("eventhub_endpoint_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(iotHubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iotHubName, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Consumer Group %q (Endpoint %q / IoTHub %q / Resource Group %q): %+v", name, endpointName, iotHubName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(iotHubName, IothubResourceName)
 
 	if d.IsNewResource() {
@@ -103,7 +105,7 @@ func resourceIotHubConsumerGroupCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceIotHubConsumerGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -135,7 +137,7 @@ func resourceIotHubConsumerGroupRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceIotHubConsumerGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -148,7 +150,9 @@ func resourceIotHubConsumerGroupDelete(d *schema.ResourceData, meta interface{})
 	endpointName := id.Path["eventHubEndpoints"]
 	name := id.Path["ConsumerGroups"]
 
-	locks.ByName(iotHubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iotHubName, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Consumer Group %q (Endpoint %q / IoTHub %q / Resource Group %q): %+v", name, endpointName, iotHubName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(iotHubName, IothubResourceName)
 
 	resp, err := client.DeleteEventHubConsumerGroup(ctx, resourceGroup, iotHubName, endpointName, name)
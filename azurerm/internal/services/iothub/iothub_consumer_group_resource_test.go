@@ -92,7 +92,7 @@ func (t IotHubConsumerGroupResource) Exists(ctx context.Context, clients *client
 	endpointName := id.Path["eventHubEndpoints"]
 	name := id.Path["ConsumerGroups"]
 
-	resp, err := clients.IoTHub.ResourceClient.GetEventHubConsumerGroup(ctx, resourceGroup, iotHubName, endpointName, name)
+	resp, err := clients.IoTHub().ResourceClient.GetEventHubConsumerGroup(ctx, resourceGroup, iotHubName, endpointName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading IotHuB Consumer Group (%s): %+v", id, err)
 	}
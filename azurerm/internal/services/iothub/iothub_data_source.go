@@ -35,7 +35,7 @@ func dataSourceIotHub() *schema.Resource {
 }
 
 func dataSourceIotHubRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -61,7 +61,7 @@ func resourceIotHubDPSCertificate() *schema.Resource {
 }
 
 func resourceIotHubDPSCertificateCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.DPSCertificateClient
+	client := meta.(*clients.Client).IoTHub().DPSCertificateClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -105,7 +105,7 @@ func resourceIotHubDPSCertificateCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceIotHubDPSCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.DPSCertificateClient
+	client := meta.(*clients.Client).IoTHub().DPSCertificateClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -135,7 +135,7 @@ func resourceIotHubDPSCertificateRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceIotHubDPSCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.DPSCertificateClient
+	client := meta.(*clients.Client).IoTHub().DPSCertificateClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -81,7 +81,7 @@ func (t IotHubDPSCertificateResource) Exists(ctx context.Context, clients *clien
 	iotDPSName := id.Path["provisioningServices"]
 	name := id.Path["certificates"]
 
-	resp, err := clients.IoTHub.DPSCertificateClient.Get(ctx, name, resourceGroup, iotDPSName, "")
+	resp, err := clients.IoTHub().DPSCertificateClient.Get(ctx, name, resourceGroup, iotDPSName, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading IotHuB DPS Certificate (%s): %+v", id, err)
 	}
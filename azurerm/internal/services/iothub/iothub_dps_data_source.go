@@ -58,7 +58,7 @@ func dataSourceIotHubDPS() *schema.Resource {
 }
 
 func dataSourceIotHubDPSRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.DPSResourceClient
+	client := meta.(*clients.Client).IoTHub().DPSResourceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -148,7 +148,7 @@ func resourceIotHubDPS() *schema.Resource {
 }
 
 func resourceIotHubDPSCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.DPSResourceClient
+	client := meta.(*clients.Client).IoTHub().DPSResourceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -202,7 +202,7 @@ func resourceIotHubDPSCreateUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceIotHubDPSRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.DPSResourceClient
+	client := meta.(*clients.Client).IoTHub().DPSResourceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -252,7 +252,7 @@ func resourceIotHubDPSRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIotHubDPSDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.DPSResourceClient
+	client := meta.(*clients.Client).IoTHub().DPSResourceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
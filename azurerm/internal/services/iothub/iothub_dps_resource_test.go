@@ -110,7 +110,7 @@ func (t IotHubDPSResource) Exists(ctx context.Context, clients *clients.Client,
 		name = id.Path["ProvisioningServices"]
 	}
 
-	resp, err := clients.IoTHub.DPSResourceClient.Get(ctx, name, resourceGroup)
+	resp, err := clients.IoTHub().DPSResourceClient.Get(ctx, name, resourceGroup)
 	if err != nil {
 		return nil, fmt.Errorf("reading IotHuB DPS (%s): %+v", id, err)
 	}
@@ -63,7 +63,7 @@ func dataSourceIotHubDPSSharedAccessPolicy() *schema.Resource {
 }
 
 func dataSourceIotHubDPSSharedAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.DPSResourceClient
+	client := meta.(*clients.Client).IoTHub().DPSResourceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -110,14 +110,16 @@ func resourceIotHubDPSSharedAccessPolicy() *schema.Resource {
 }
 
 func resourceIotHubDPSSharedAccessPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.DPSResourceClient
+	client := meta.(*clients.Client).IoTHub().DPSResourceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	iothubDpsName := d.Get("iothub_dps_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(iothubDpsName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iothubDpsName, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Shared Access Policy %q (IotHub DPS %q / Resource Group %q): %+v", d.Get("name").(string), iothubDpsName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(iothubDpsName, IothubResourceName)
 
 	iothubDps, err := client.Get(ctx, iothubDpsName, resourceGroup)
@@ -196,7 +198,7 @@ func resourceIotHubDPSSharedAccessPolicyCreateUpdate(d *schema.ResourceData, met
 }
 
 func resourceIotHubDPSSharedAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.DPSResourceClient
+	client := meta.(*clients.Client).IoTHub().DPSResourceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -255,7 +257,7 @@ func resourceIotHubDPSSharedAccessPolicyRead(d *schema.ResourceData, meta interf
 }
 
 func resourceIotHubDPSSharedAccessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.DPSResourceClient
+	client := meta.(*clients.Client).IoTHub().DPSResourceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -268,7 +270,9 @@ func resourceIotHubDPSSharedAccessPolicyDelete(d *schema.ResourceData, meta inte
 	iothubDpsName := id.Path["provisioningServices"]
 	keyName := id.Path["keys"]
 
-	locks.ByName(iothubDpsName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iothubDpsName, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Shared Access Policy %q (IotHub DPS %q / Resource Group %q): %+v", keyName, iothubDpsName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(iothubDpsName, IothubResourceName)
 
 	iothubDps, err := client.Get(ctx, iothubDpsName, resourceGroup)
@@ -211,7 +211,7 @@ func (t IotHubDpsSharedAccessPolicyResource) Exists(ctx context.Context, clients
 	iothubDpsName := id.Path["provisioningServices"]
 	keyName := id.Path["keys"]
 
-	accessPolicy, err := clients.IoTHub.DPSResourceClient.ListKeysForKeyName(ctx, iothubDpsName, keyName, resourceGroup)
+	accessPolicy, err := clients.IoTHub().DPSResourceClient.ListKeysForKeyName(ctx, iothubDpsName, keyName, resourceGroup)
 	if err != nil {
 		return nil, fmt.Errorf("loading Shared Access Policy (%s): %+v", id, err)
 	}
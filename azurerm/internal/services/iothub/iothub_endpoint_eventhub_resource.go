@@ -69,14 +69,16 @@ func resourceIotHubEndpointEventHub() *schema.Resource {
 }
 
 func resourceIotHubEndpointEventHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	iothubName := d.Get("iothub_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(iothubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iothubName, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for EventHub Endpoint %q (IotHub %q / Resource Group %q): %+v", d.Get("name").(string), iothubName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, resourceGroup, iothubName)
@@ -150,7 +152,7 @@ func resourceIotHubEndpointEventHubCreateUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceIotHubEndpointEventHubRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -190,7 +192,7 @@ func resourceIotHubEndpointEventHubRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceIotHubEndpointEventHubDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -203,7 +205,9 @@ func resourceIotHubEndpointEventHubDelete(d *schema.ResourceData, meta interface
 	iothubName := parsedIothubEndpointId.Path["IotHubs"]
 	endpointName := parsedIothubEndpointId.Path["Endpoints"]
 
-	locks.ByName(iothubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iothubName, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for EventHub Endpoint %q (IotHub %q / Resource Group %q): %+v", endpointName, iothubName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, resourceGroup, iothubName)
@@ -136,7 +136,7 @@ func (t IotHubEndpointEventHubResource) Exists(ctx context.Context, clients *cli
 	iothubName := id.Path["IotHubs"]
 	endpointName := id.Path["Endpoints"]
 
-	iothub, err := clients.IoTHub.ResourceClient.Get(ctx, resourceGroup, iothubName)
+	iothub, err := clients.IoTHub().ResourceClient.Get(ctx, resourceGroup, iothubName)
 	if err != nil || iothub.Properties == nil || iothub.Properties.Routing == nil || iothub.Properties.Routing.Endpoints == nil {
 		return nil, fmt.Errorf("reading IotHuB Endpoint Eventhub (%s): %+v", id, err)
 	}
@@ -69,7 +69,7 @@ func resourceIotHubEndpointServiceBusQueue() *schema.Resource {
 }
 
 func resourceIotHubEndpointServiceBusQueueCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	subscriptionID := meta.(*clients.Client).Account.SubscriptionId
@@ -77,7 +77,9 @@ func resourceIotHubEndpointServiceBusQueueCreateUpdate(d *schema.ResourceData, m
 	iothubName := d.Get("iothub_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(iothubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iothubName, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Service Bus Queue Endpoint %q (IotHub %q / Resource Group %q): %+v", d.Get("name").(string), iothubName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, resourceGroup, iothubName)
@@ -151,7 +153,7 @@ func resourceIotHubEndpointServiceBusQueueCreateUpdate(d *schema.ResourceData, m
 }
 
 func resourceIotHubEndpointServiceBusQueueRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -191,7 +193,7 @@ func resourceIotHubEndpointServiceBusQueueRead(d *schema.ResourceData, meta inte
 }
 
 func resourceIotHubEndpointServiceBusQueueDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -204,7 +206,9 @@ func resourceIotHubEndpointServiceBusQueueDelete(d *schema.ResourceData, meta in
 	iothubName := parsedIothubEndpointId.Path["IotHubs"]
 	endpointName := parsedIothubEndpointId.Path["Endpoints"]
 
-	locks.ByName(iothubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iothubName, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Service Bus Queue Endpoint %q (IotHub %q / Resource Group %q): %+v", endpointName, iothubName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, resourceGroup, iothubName)
@@ -69,7 +69,7 @@ func resourceIotHubEndpointServiceBusTopic() *schema.Resource {
 }
 
 func resourceIotHubEndpointServiceBusTopicCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	subscriptionID := meta.(*clients.Client).Account.SubscriptionId
@@ -77,7 +77,9 @@ func resourceIotHubEndpointServiceBusTopicCreateUpdate(d *schema.ResourceData, m
 	iothubName := d.Get("iothub_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(iothubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iothubName, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Service Bus Topic Endpoint %q (IotHub %q / Resource Group %q): %+v", d.Get("name").(string), iothubName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, resourceGroup, iothubName)
@@ -151,7 +153,7 @@ func resourceIotHubEndpointServiceBusTopicCreateUpdate(d *schema.ResourceData, m
 }
 
 func resourceIotHubEndpointServiceBusTopicRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -191,7 +193,7 @@ func resourceIotHubEndpointServiceBusTopicRead(d *schema.ResourceData, meta inte
 }
 
 func resourceIotHubEndpointServiceBusTopicDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -204,7 +206,9 @@ func resourceIotHubEndpointServiceBusTopicDelete(d *schema.ResourceData, meta in
 	iothubName := parsedIothubEndpointId.Path["IotHubs"]
 	endpointName := parsedIothubEndpointId.Path["Endpoints"]
 
-	locks.ByName(iothubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iothubName, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Service Bus Topic Endpoint %q (IotHub %q / Resource Group %q): %+v", endpointName, iothubName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, resourceGroup, iothubName)
@@ -134,7 +134,7 @@ func (t IotHubEndpointServiceBusTopicResource) Exists(ctx context.Context, clien
 	iothubName := id.Path["IotHubs"]
 	endpointName := id.Path["Endpoints"]
 
-	iothub, err := clients.IoTHub.ResourceClient.Get(ctx, resourceGroup, iothubName)
+	iothub, err := clients.IoTHub().ResourceClient.Get(ctx, resourceGroup, iothubName)
 	if err != nil || iothub.Properties == nil || iothub.Properties.Routing == nil || iothub.Properties.Routing.Endpoints == nil {
 		return nil, fmt.Errorf("reading IotHuB Endpoint Service Bus Topics (%s): %+v", id, err)
 	}
@@ -105,7 +105,7 @@ func resourceIotHubEndpointStorageContainer() *schema.Resource {
 }
 
 func resourceIotHubEndpointStorageContainerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	subscriptionID := meta.(*clients.Client).Account.SubscriptionId
@@ -113,7 +113,9 @@ func resourceIotHubEndpointStorageContainerCreateUpdate(d *schema.ResourceData,
 	iothubName := d.Get("iothub_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(iothubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iothubName, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Storage Container Endpoint %q (IotHub %q / Resource Group %q): %+v", d.Get("name").(string), iothubName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, resourceGroup, iothubName)
@@ -201,7 +203,7 @@ func resourceIotHubEndpointStorageContainerCreateUpdate(d *schema.ResourceData,
 }
 
 func resourceIotHubEndpointStorageContainerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -246,7 +248,7 @@ func resourceIotHubEndpointStorageContainerRead(d *schema.ResourceData, meta int
 }
 
 func resourceIotHubEndpointStorageContainerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -259,7 +261,9 @@ func resourceIotHubEndpointStorageContainerDelete(d *schema.ResourceData, meta i
 	iothubName := parsedIothubEndpointId.Path["IotHubs"]
 	endpointName := parsedIothubEndpointId.Path["Endpoints"]
 
-	locks.ByName(iothubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iothubName, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Storage Container Endpoint %q (IotHub %q / Resource Group %q): %+v", endpointName, iothubName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, resourceGroup, iothubName)
@@ -140,7 +140,7 @@ func (t IotHubEndpointStorageContainerResource) Exists(ctx context.Context, clie
 	iothubName := id.Path["IotHubs"]
 	endpointName := id.Path["Endpoints"]
 
-	iothub, err := clients.IoTHub.ResourceClient.Get(ctx, resourceGroup, iothubName)
+	iothub, err := clients.IoTHub().ResourceClient.Get(ctx, resourceGroup, iothubName)
 	if err != nil || iothub.Properties == nil || iothub.Properties.Routing == nil || iothub.Properties.Routing.Endpoints == nil {
 		return nil, fmt.Errorf("reading IotHuB Endpoint Storage Container (%s): %+v", id, err)
 	}
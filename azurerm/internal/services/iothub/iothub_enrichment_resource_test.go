@@ -77,7 +77,7 @@ func (IotHubEnrichmentResource) Exists(ctx context.Context, client *clients.Clie
 		return nil, err
 	}
 
-	iothub, err := client.IoTHub.ResourceClient.Get(ctx, id.ResourceGroup, id.IotHubName)
+	iothub, err := client.IoTHub().ResourceClient.Get(ctx, id.ResourceGroup, id.IotHubName)
 	if err != nil {
 		if utils.ResponseWasNotFound(iothub.Response) {
 			return utils.Bool(false), nil
@@ -69,14 +69,16 @@ func resourceIotHubFallbackRoute() *schema.Resource {
 }
 
 func resourceIotHubFallbackRouteCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	iothubName := d.Get("iothub_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(iothubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iothubName, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Fallback Route (IotHub %q / Resource Group %q): %+v", iothubName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, resourceGroup, iothubName)
@@ -120,7 +122,7 @@ func resourceIotHubFallbackRouteCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceIotHubFallbackRouteRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -154,7 +156,7 @@ func resourceIotHubFallbackRouteRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceIotHubFallbackRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -166,7 +168,9 @@ func resourceIotHubFallbackRouteDelete(d *schema.ResourceData, meta interface{})
 	resourceGroup := parsedIothubRouteId.ResourceGroup
 	iothubName := parsedIothubRouteId.Path["IotHubs"]
 
-	locks.ByName(iothubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iothubName, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Fallback Route (IotHub %q / Resource Group %q): %+v", iothubName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, resourceGroup, iothubName)
@@ -498,7 +498,7 @@ func resourceIotHub() *schema.Resource {
 }
 
 func resourceIotHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	subscriptionID := meta.(*clients.Client).Account.SubscriptionId
@@ -506,7 +506,9 @@ func resourceIotHubCreateUpdate(d *schema.ResourceData, meta interface{}) error
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(name, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, name, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for IoTHub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, IothubResourceName)
 
 	if d.IsNewResource() {
@@ -631,7 +633,7 @@ func resourceIotHubCreateUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceIotHubRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -735,11 +737,13 @@ func resourceIotHubDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	locks.ByName(id.Name, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, id.Name, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.Name, IothubResourceName)
 
 	// when running acctest of `azurerm_iot_security_solution`, we found after delete the iot security solution, the iothub provisionState is `Transitioning`
@@ -247,7 +247,7 @@ func (t IotHubResource) Exists(ctx context.Context, clients *clients.Client, sta
 		return nil, err
 	}
 
-	resp, err := clients.IoTHub.ResourceClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.IoTHub().ResourceClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading IotHuB (%s): %+v", id, err)
 	}
@@ -91,14 +91,16 @@ func resourceIotHubRoute() *schema.Resource {
 }
 
 func resourceIotHubRouteCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	iothubName := d.Get("iothub_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(iothubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iothubName, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Route %q (IotHub %q / Resource Group %q): %+v", d.Get("name").(string), iothubName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, resourceGroup, iothubName)
@@ -178,7 +180,7 @@ func resourceIotHubRouteCreateUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceIotHubRouteRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -221,7 +223,7 @@ func resourceIotHubRouteRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIotHubRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTHub.ResourceClient
+	client := meta.(*clients.Client).IoTHub().ResourceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -234,7 +236,9 @@ func resourceIotHubRouteDelete(d *schema.ResourceData, meta interface{}) error {
 	iothubName := parsedIothubRouteId.Path["IotHubs"]
 	routeName := parsedIothubRouteId.Path["Routes"]
 
-	locks.ByName(iothubName, IothubResourceName)
+	if err := locks.ByNameWithContext(ctx, iothubName, IothubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Route %q (IotHub %q / Resource Group %q): %+v", routeName, iothubName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
 	iothub, err := client.Get(ctx, resourceGroup, iothubName)
@@ -80,7 +80,7 @@ func (t IotHubRouteResource) Exists(ctx context.Context, clients *clients.Client
 	resourceGroup := id.ResourceGroup
 	iothubName := id.Path["IotHubs"]
 
-	resp, err := clients.IoTHub.ResourceClient.Get(ctx, resourceGroup, iothubName)
+	resp, err := clients.IoTHub().ResourceClient.Get(ctx, resourceGroup, iothubName)
 	if err != nil || resp.Properties == nil || resp.Properties.Routing == nil {
 		return nil, fmt.Errorf("reading IotHuB Route (%s): %+v", id, err)
 	}
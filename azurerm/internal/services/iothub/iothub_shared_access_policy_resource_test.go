@@ -165,7 +165,7 @@ func (t IoTHubSharedAccessPolicyResource) Exists(ctx context.Context, clients *c
 	iothubName := id.Path["IotHubs"]
 	keyName := id.Path["IotHubKeys"]
 
-	accessPolicy, err := clients.IoTHub.ResourceClient.GetKeysForKeyName(ctx, resourceGroup, iothubName, keyName)
+	accessPolicy, err := clients.IoTHub().ResourceClient.GetKeysForKeyName(ctx, resourceGroup, iothubName, keyName)
 	if err != nil {
 		return nil, fmt.Errorf("loading IotHub Shared Access Policy %q: %+v", id, err)
 	}
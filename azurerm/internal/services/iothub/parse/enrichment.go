@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EnrichmentId struct {
@@ -73,3 +74,12 @@ func EnrichmentID(input string) (*EnrichmentId, error) {
 
 	return &resourceId, nil
 }
+
+// EnrichmentIDNormalized parses a Enrichment ID into an EnrichmentId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the EnrichmentID method should be used instead for validation etc.
+func EnrichmentIDNormalized(input string) (*EnrichmentId, error) {
+	return EnrichmentID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Devices/IotHubs/%s/Enrichments/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type IotHubId struct {
@@ -67,3 +68,12 @@ func IotHubID(input string) (*IotHubId, error) {
 
 	return &resourceId, nil
 }
+
+// IotHubIDNormalized parses a IotHub ID into an IotHubId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the IotHubID method should be used instead for validation etc.
+func IotHubIDNormalized(input string) (*IotHubId, error) {
+	return IotHubID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Devices/IotHubs/%s"))
+}
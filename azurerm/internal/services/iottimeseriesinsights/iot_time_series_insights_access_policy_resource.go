@@ -88,7 +88,7 @@ func resourceIoTTimeSeriesInsightsAccessPolicy() *schema.Resource {
 }
 
 func resourceIoTTimeSeriesInsightsAccessPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTTimeSeriesInsights.AccessPoliciesClient
+	client := meta.(*clients.Client).IoTTimeSeriesInsights().AccessPoliciesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -130,7 +130,7 @@ func resourceIoTTimeSeriesInsightsAccessPolicyCreateUpdate(d *schema.ResourceDat
 }
 
 func resourceIoTTimeSeriesInsightsAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTTimeSeriesInsights.AccessPoliciesClient
+	client := meta.(*clients.Client).IoTTimeSeriesInsights().AccessPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -164,7 +164,7 @@ func resourceIoTTimeSeriesInsightsAccessPolicyRead(d *schema.ResourceData, meta
 }
 
 func resourceIoTTimeSeriesInsightsAccessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTTimeSeriesInsights.AccessPoliciesClient
+	client := meta.(*clients.Client).IoTTimeSeriesInsights().AccessPoliciesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
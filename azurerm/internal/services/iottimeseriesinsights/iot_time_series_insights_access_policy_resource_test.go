@@ -67,7 +67,7 @@ func (IoTTimeSeriesInsightsAccessPolicyResource) Exists(ctx context.Context, cli
 		return nil, err
 	}
 
-	resp, err := clients.IoTTimeSeriesInsights.AccessPoliciesClient.Get(ctx, id.ResourceGroup, id.EnvironmentName, id.Name)
+	resp, err := clients.IoTTimeSeriesInsights().AccessPoliciesClient.Get(ctx, id.ResourceGroup, id.EnvironmentName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving IoT Time Series INsights Access Policy (%q): %+v", id.String(), err)
 	}
@@ -81,7 +81,7 @@ func (IoTTimeSeriesInsightsGen2EnvironmentResource) Exists(ctx context.Context,
 		return nil, err
 	}
 
-	resp, err := clients.IoTTimeSeriesInsights.EnvironmentsClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := clients.IoTTimeSeriesInsights().EnvironmentsClient.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving IoT Time Series Insights Gen2 Environment (%q): %+v", id.String(), err)
 	}
@@ -102,7 +102,7 @@ func resourceIoTTimeSeriesInsightsReferenceDataSet() *schema.Resource {
 }
 
 func resourceIoTTimeSeriesInsightsReferenceDataSetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTTimeSeriesInsights.ReferenceDataSetsClient
+	client := meta.(*clients.Client).IoTTimeSeriesInsights().ReferenceDataSetsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -156,7 +156,7 @@ func resourceIoTTimeSeriesInsightsReferenceDataSetCreateUpdate(d *schema.Resourc
 }
 
 func resourceIoTTimeSeriesInsightsReferenceDataSetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTTimeSeriesInsights.ReferenceDataSetsClient
+	client := meta.(*clients.Client).IoTTimeSeriesInsights().ReferenceDataSetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -192,7 +192,7 @@ func resourceIoTTimeSeriesInsightsReferenceDataSetRead(d *schema.ResourceData, m
 }
 
 func resourceIoTTimeSeriesInsightsReferenceDataSetDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTTimeSeriesInsights.ReferenceDataSetsClient
+	client := meta.(*clients.Client).IoTTimeSeriesInsights().ReferenceDataSetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -67,7 +67,7 @@ func (IoTTimeSeriesInsightsReferenceDataSetResource) Exists(ctx context.Context,
 		return nil, err
 	}
 
-	resp, err := clients.IoTTimeSeriesInsights.ReferenceDataSetsClient.Get(ctx, id.ResourceGroup, id.EnvironmentName, id.Name)
+	resp, err := clients.IoTTimeSeriesInsights().ReferenceDataSetsClient.Get(ctx, id.ResourceGroup, id.EnvironmentName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving IoT Time Series Insights Reference Data Set (%q): %+v", id.String(), err)
 	}
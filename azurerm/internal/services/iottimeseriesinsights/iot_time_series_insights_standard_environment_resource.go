@@ -112,7 +112,7 @@ func resourceIoTTimeSeriesInsightsStandardEnvironment() *schema.Resource {
 }
 
 func resourceIoTTimeSeriesInsightsStandardEnvironmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTTimeSeriesInsights.EnvironmentsClient
+	client := meta.(*clients.Client).IoTTimeSeriesInsights().EnvironmentsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -193,7 +193,7 @@ func resourceIoTTimeSeriesInsightsStandardEnvironmentCreateUpdate(d *schema.Reso
 }
 
 func resourceIoTTimeSeriesInsightsStandardEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTTimeSeriesInsights.EnvironmentsClient
+	client := meta.(*clients.Client).IoTTimeSeriesInsights().EnvironmentsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -239,7 +239,7 @@ func resourceIoTTimeSeriesInsightsStandardEnvironmentRead(d *schema.ResourceData
 }
 
 func resourceIoTTimeSeriesInsightsStandardEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).IoTTimeSeriesInsights.EnvironmentsClient
+	client := meta.(*clients.Client).IoTTimeSeriesInsights().EnvironmentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
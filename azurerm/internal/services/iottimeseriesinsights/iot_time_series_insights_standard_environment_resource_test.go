@@ -82,7 +82,7 @@ func (IoTTimeSeriesInsightsStandardEnvironmentResource) Exists(ctx context.Conte
 		return nil, err
 	}
 
-	resp, err := clients.IoTTimeSeriesInsights.EnvironmentsClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := clients.IoTTimeSeriesInsights().EnvironmentsClient.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving IoT Time Series Insights Insights Standard Environment (%q): %+v", id.String(), err)
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AccessPolicyId struct {
@@ -73,3 +74,12 @@ func AccessPolicyID(input string) (*AccessPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// AccessPolicyIDNormalized parses a AccessPolicy ID into an AccessPolicyId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the AccessPolicyID method should be used instead for validation etc.
+func AccessPolicyIDNormalized(input string) (*AccessPolicyId, error) {
+	return AccessPolicyID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.TimeSeriesInsights/environments/%s/accessPolicies/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EnvironmentId struct {
@@ -67,3 +68,12 @@ func EnvironmentID(input string) (*EnvironmentId, error) {
 
 	return &resourceId, nil
 }
+
+// EnvironmentIDNormalized parses a Environment ID into an EnvironmentId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the EnvironmentID method should be used instead for validation etc.
+func EnvironmentIDNormalized(input string) (*EnvironmentId, error) {
+	return EnvironmentID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.TimeSeriesInsights/environments/%s"))
+}
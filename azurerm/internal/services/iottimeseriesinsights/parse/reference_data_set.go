@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ReferenceDataSetId struct {
@@ -73,3 +74,12 @@ func ReferenceDataSetID(input string) (*ReferenceDataSetId, error) {
 
 	return &resourceId, nil
 }
+
+// ReferenceDataSetIDNormalized parses a ReferenceDataSet ID into an ReferenceDataSetId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ReferenceDataSetID method should be used instead for validation etc.
+func ReferenceDataSetIDNormalized(input string) (*ReferenceDataSetId, error) {
+	return ReferenceDataSetID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.TimeSeriesInsights/environments/%s/referenceDataSets/%s"))
+}
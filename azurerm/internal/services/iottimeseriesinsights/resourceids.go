@@ -1,5 +1,5 @@
 package iottimeseriesinsights
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=AccessPolicy -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1/accessPolicies/policy1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Environment -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ReferenceDataSet -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1/referenceDataSets/dataSet1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=AccessPolicy -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1/accessPolicies/policy1 -resource-type=azurerm_iot_time_series_insights_access_policy
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Environment -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1 -resource-type=azurerm_iot_time_series_insights_gen2_environment,azurerm_iot_time_series_insights_standard_environment
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ReferenceDataSet -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1/referenceDataSets/dataSet1 -resource-type=azurerm_iot_time_series_insights_reference_data_set
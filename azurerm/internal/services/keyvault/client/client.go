@@ -9,6 +9,9 @@ import (
 type Client struct {
 	ManagementClient *keyvaultmgmt.BaseClient
 	VaultsClient     *keyvault.VaultsClient
+
+	// keyVaultDNSSuffix overrides the DNS Suffix of the Data Plane URI returned by Azure, when specified
+	keyVaultDNSSuffix string
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -21,5 +24,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	return &Client{
 		ManagementClient: &managementClient,
 		VaultsClient:     &vaultsClient,
+
+		keyVaultDNSSuffix: o.Endpoints.KeyVaultDNSSuffix,
 	}
 }
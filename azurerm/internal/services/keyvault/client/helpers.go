@@ -55,7 +55,13 @@ func (c *Client) BaseUriForKeyVault(ctx context.Context, keyVaultId parse.VaultI
 		return nil, fmt.Errorf("`properties` was nil for %s", keyVaultId)
 	}
 
-	return resp.Properties.VaultURI, nil
+	return c.dataPlaneUri(*resp.Properties.VaultURI)
+}
+
+// BaseUriForKeyVaultUrl returns the URI used to access the Data Plane of the Key Vault at the specified URL (for
+// example from the ID of a Key, Secret or Certificate) - using the DNS Suffix specified in the `endpoints` block
+func (c *Client) BaseUriForKeyVaultUrl(keyVaultBaseUrl string) (*string, error) {
+	return c.dataPlaneUri(keyVaultBaseUrl)
 }
 
 func (c *Client) Exists(ctx context.Context, keyVaultId parse.VaultId) (bool, error) {
@@ -165,14 +171,34 @@ func (c *Client) cacheKeyForKeyVault(name string) string {
 	return strings.ToLower(name)
 }
 
+// dataPlaneUri returns the URI used to access the Data Plane of the Key Vault, which uses the DNS Suffix
+// specified in the `endpoints` block (for example a Private Link DNS name) rather than the one returned by Azure
+func (c *Client) dataPlaneUri(vaultUri string) (*string, error) {
+	if c.keyVaultDNSSuffix == "" {
+		return &vaultUri, nil
+	}
+
+	uri, err := url.Parse(vaultUri)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Vault URI %q: %+v", vaultUri, err)
+	}
+	name, err := c.parseNameFromBaseUrl(vaultUri)
+	if err != nil {
+		return nil, err
+	}
+
+	uri.Host = fmt.Sprintf("%s.%s", *name, c.keyVaultDNSSuffix)
+	return utils.String(uri.String()), nil
+}
+
 func (c *Client) parseNameFromBaseUrl(input string) (*string, error) {
 	uri, err := url.Parse(input)
 	if err != nil {
 		return nil, err
 	}
-	// https://tharvey-keyvault.vault.azure.net/
+	// https://tharvey-keyvault.vault.azure.net/ or https://tharvey-keyvault.privatelink.vaultcore.azure.net/
 	segments := strings.Split(uri.Host, ".")
-	if len(segments) != 4 {
+	if len(segments) < 4 || segments[0] == "" {
 		return nil, fmt.Errorf("expected a URI in the format `vaultname.vault.azure.net` but got %q", uri.Host)
 	}
 	return &segments[0], nil
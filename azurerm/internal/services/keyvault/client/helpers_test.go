@@ -0,0 +1,103 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestDataPlaneUri(t *testing.T) {
+	testData := []struct {
+		DNSSuffix string
+		Input     string
+		Expected  string
+		Error     bool
+	}{
+		{
+			// no override
+			Input:    "https://vault1.vault.azure.net/",
+			Expected: "https://vault1.vault.azure.net/",
+		},
+		{
+			DNSSuffix: "privatelink.vaultcore.azure.net",
+			Input:     "https://vault1.vault.azure.net/",
+			Expected:  "https://vault1.privatelink.vaultcore.azure.net/",
+		},
+		{
+			DNSSuffix: "vault.example.com",
+			Input:     "https://vault1.vault.usgovcloudapi.net/",
+			Expected:  "https://vault1.vault.example.com/",
+		},
+		{
+			DNSSuffix: "privatelink.vaultcore.azure.net",
+			Input:     "https://vault1/",
+			Error:     true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q with the DNS Suffix %q..", v.Input, v.DNSSuffix)
+
+		client := &Client{
+			keyVaultDNSSuffix: v.DNSSuffix,
+		}
+		actual, err := client.dataPlaneUri(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if *actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, *actual)
+		}
+	}
+}
+
+func TestParseNameFromBaseUrl(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input:    "https://vault1.vault.azure.net/",
+			Expected: "vault1",
+		},
+		{
+			Input:    "https://vault1.privatelink.vaultcore.azure.net/",
+			Expected: "vault1",
+		},
+		{
+			Input: "https://vault.azure.net/",
+			Error: true,
+		},
+		{
+			Input: "not a uri",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		actual, err := (&Client{}).parseNameFromBaseUrl(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if *actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, *actual)
+		}
+	}
+}
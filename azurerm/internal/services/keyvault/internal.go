@@ -136,8 +136,8 @@ func keyVaultChildItemRefreshFunc(secretUri string) resource.StateRefreshFunc {
 }
 
 func nestedItemResourceImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	resourcesClient := meta.(*clients.Client).Resource
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -78,7 +78,7 @@ func resourceKeyVaultAccessPolicy() *schema.Resource {
 }
 
 func resourceKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta interface{}, action keyvault.AccessPolicyUpdateKind) error {
-	client := meta.(*clients.Client).KeyVault.VaultsClient
+	client := meta.(*clients.Client).KeyVault().VaultsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] Preparing arguments for Key Vault Access Policy: %s.", action)
@@ -129,7 +129,9 @@ func resourceKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta int
 	}
 
 	// Locking to prevent parallel changes causing issues
-	locks.ByName(vaultName, keyVaultResourceName)
+	if err := locks.ByNameWithContext(ctx, vaultName, keyVaultResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Access Policy (Key Vault %q / Resource Group %q): %+v", vaultName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(vaultName, keyVaultResourceName)
 
 	if d.IsNewResource() {
@@ -257,7 +259,7 @@ func resourceKeyVaultAccessPolicyUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceKeyVaultAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).KeyVault.VaultsClient
+	client := meta.(*clients.Client).KeyVault().VaultsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
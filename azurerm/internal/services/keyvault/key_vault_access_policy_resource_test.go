@@ -140,7 +140,7 @@ func (t KeyVaultAccessPolicyResource) Exists(ctx context.Context, clients *clien
 	objectId := id.Path["objectId"]
 	applicationId := id.Path["applicationId"]
 
-	resp, err := clients.KeyVault.VaultsClient.Get(ctx, resGroup, vaultName)
+	resp, err := clients.KeyVault().VaultsClient.Get(ctx, resGroup, vaultName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Key Vault (%s): %+v", id, err)
 	}
@@ -78,8 +78,8 @@ func dataSourceKeyVaultCertificateData() *schema.Resource {
 }
 
 func dataSourceArmKeyVaultCertificateDataRead(d *schema.ResourceData, meta interface{}) error {
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).KeyVault.ManagementClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	client := meta.(*clients.Client).KeyVault().ManagementClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -140,7 +140,7 @@ func dataSourceArmKeyVaultCertificateDataRead(d *schema.ResourceData, meta inter
 	d.Set("expires", t.Format(time.RFC3339))
 
 	// Get PFX
-	pfx, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	pfx, err := client.GetSecret(ctx, *keyVaultBaseUri, id.Name, id.Version)
 	if err != nil {
 		return fmt.Errorf("retrieving certificate %q from keyvault: %+v", id.Name, err)
 	}
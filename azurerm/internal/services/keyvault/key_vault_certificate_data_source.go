@@ -227,8 +227,8 @@ func dataSourceKeyVaultCertificate() *schema.Resource {
 }
 
 func dataSourceKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).KeyVault.ManagementClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	client := meta.(*clients.Client).KeyVault().ManagementClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -78,8 +78,8 @@ func dataSourceKeyVaultCertificateIssuer() *schema.Resource {
 }
 
 func dataSourceKeyVaultCertificateIssuerRead(d *schema.ResourceData, meta interface{}) error {
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).KeyVault.ManagementClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	client := meta.(*clients.Client).KeyVault().ManagementClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
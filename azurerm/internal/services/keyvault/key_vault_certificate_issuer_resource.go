@@ -106,8 +106,8 @@ func resourceKeyVaultCertificateIssuer() *schema.Resource {
 }
 
 func resourceKeyVaultCertificateIssuerCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).KeyVault.ManagementClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	client := meta.(*clients.Client).KeyVault().ManagementClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -176,9 +176,9 @@ func resourceKeyVaultCertificateIssuerCreateOrUpdate(d *schema.ResourceData, met
 }
 
 func resourceKeyVaultCertificateIssuerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).KeyVault.ManagementClient
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	resourcesClient := meta.(*clients.Client).Resource
+	client := meta.(*clients.Client).KeyVault().ManagementClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -187,6 +187,11 @@ func resourceKeyVaultCertificateIssuerRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVaultUrl(id.KeyVaultBaseUrl)
+	if err != nil {
+		return err
+	}
+
 	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
 	if err != nil {
 		return fmt.Errorf("Error retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
@@ -212,7 +217,7 @@ func resourceKeyVaultCertificateIssuerRead(d *schema.ResourceData, meta interfac
 		return nil
 	}
 
-	resp, err := client.GetCertificateIssuer(ctx, id.KeyVaultBaseUrl, id.Name)
+	resp, err := client.GetCertificateIssuer(ctx, *keyVaultBaseUri, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[DEBUG] KeyVault Certificate Issuer %q (KeyVault URI %q) does not exist - removing from state", id.Name, id.KeyVaultBaseUrl)
@@ -244,9 +249,9 @@ func resourceKeyVaultCertificateIssuerRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceKeyVaultCertificateIssuerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).KeyVault.ManagementClient
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	resourcesClient := meta.(*clients.Client).Resource
+	client := meta.(*clients.Client).KeyVault().ManagementClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -255,6 +260,11 @@ func resourceKeyVaultCertificateIssuerDelete(d *schema.ResourceData, meta interf
 		return err
 	}
 
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVaultUrl(id.KeyVaultBaseUrl)
+	if err != nil {
+		return err
+	}
+
 	// we verify it exists
 	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
 	if err != nil {
@@ -276,7 +286,7 @@ func resourceKeyVaultCertificateIssuerDelete(d *schema.ResourceData, meta interf
 		return nil
 	}
 
-	_, err = client.DeleteCertificateIssuer(ctx, id.KeyVaultBaseUrl, id.Name)
+	_, err = client.DeleteCertificateIssuer(ctx, *keyVaultBaseUri, id.Name)
 	return err
 }
 
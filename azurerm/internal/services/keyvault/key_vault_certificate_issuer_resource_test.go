@@ -99,15 +99,20 @@ func TestAccKeyVaultCertificateIssuer_disappears(t *testing.T) {
 }
 
 func (r KeyVaultCertificateIssuerResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	client := clients.KeyVault.ManagementClient
-	keyVaultsClient := clients.KeyVault
+	client := clients.KeyVault().ManagementClient
+	keyVaultsClient := clients.KeyVault()
 
 	id, err := parse.IssuerID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, clients.Resource, id.KeyVaultBaseUrl)
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVaultUrl(id.KeyVaultBaseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, clients.Resource(), id.KeyVaultBaseUrl)
 	if err != nil || keyVaultIdRaw == nil {
 		return nil, fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
 	}
@@ -121,7 +126,7 @@ func (r KeyVaultCertificateIssuerResource) Exists(ctx context.Context, clients *
 		return nil, fmt.Errorf("checking if key vault %q for Certificate %q in Vault at url %q exists: %v", *keyVaultId, id.Name, id.KeyVaultBaseUrl, err)
 	}
 
-	resp, err := client.GetCertificateIssuer(ctx, id.KeyVaultBaseUrl, id.Name)
+	resp, err := client.GetCertificateIssuer(ctx, *keyVaultBaseUri, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make Read request on Azure KeyVault Certificate Issuer %s: %+v", id.Name, err)
 	}
@@ -130,8 +135,8 @@ func (r KeyVaultCertificateIssuerResource) Exists(ctx context.Context, clients *
 }
 
 func (r KeyVaultCertificateIssuerResource) Destroy(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	dataPlaneClient := client.KeyVault.ManagementClient
-	keyVaultsClient := client.KeyVault
+	dataPlaneClient := client.KeyVault().ManagementClient
+	keyVaultsClient := client.KeyVault()
 
 	name := state.Attributes["name"]
 	keyVaultId, err := parse.VaultID(state.Attributes["key_vault_id"])
@@ -357,8 +357,8 @@ func resourceKeyVaultCertificate() *schema.Resource {
 }
 
 func resourceKeyVaultCertificateCreate(d *schema.ResourceData, meta interface{}) error {
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).KeyVault.ManagementClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	client := meta.(*clients.Client).KeyVault().ManagementClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -487,9 +487,9 @@ func keyVaultCertificateCreationRefreshFunc(ctx context.Context, client *keyvaul
 }
 
 func resourceKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).KeyVault.ManagementClient
-	resourcesClient := meta.(*clients.Client).Resource
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	client := meta.(*clients.Client).KeyVault().ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -498,6 +498,11 @@ func resourceKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVaultUrl(id.KeyVaultBaseUrl)
+	if err != nil {
+		return err
+	}
+
 	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
 	if err != nil {
 		return fmt.Errorf("Error retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
@@ -523,7 +528,7 @@ func resourceKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) e
 		return nil
 	}
 
-	cert, err := client.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	cert, err := client.GetCertificate(ctx, *keyVaultBaseUri, id.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(cert.Response) {
 			log.Printf("[DEBUG] Certificate %q was not found in Key Vault at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
@@ -576,9 +581,9 @@ func resourceKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceKeyVaultCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).KeyVault.ManagementClient
-	resourcesClient := meta.(*clients.Client).Resource
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	client := meta.(*clients.Client).KeyVault().ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -587,6 +592,11 @@ func resourceKeyVaultCertificateDelete(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVaultUrl(id.KeyVaultBaseUrl)
+	if err != nil {
+		return err
+	}
+
 	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
 	if err != nil {
 		return fmt.Errorf("Error retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
@@ -614,7 +624,7 @@ func resourceKeyVaultCertificateDelete(d *schema.ResourceData, meta interface{})
 	description := fmt.Sprintf("Certificate %q (Key Vault %q)", id.Name, id.KeyVaultBaseUrl)
 	deleter := deleteAndPurgeCertificate{
 		client:      client,
-		keyVaultUri: id.KeyVaultBaseUrl,
+		keyVaultUri: *keyVaultBaseUri,
 		name:        id.Name,
 	}
 	if err := deleteAndOptionallyPurge(ctx, description, shouldPurge, deleter); err != nil {
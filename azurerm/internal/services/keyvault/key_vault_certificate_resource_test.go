@@ -267,15 +267,20 @@ func TestAccKeyVaultCertificate_purge(t *testing.T) {
 }
 
 func (t KeyVaultCertificateResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	keyVaultsClient := clients.KeyVault
-	client := clients.KeyVault.ManagementClient
+	keyVaultsClient := clients.KeyVault()
+	client := clients.KeyVault().ManagementClient
 
 	id, err := parse.ParseNestedItemID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, clients.Resource, id.KeyVaultBaseUrl)
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVaultUrl(id.KeyVaultBaseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, clients.Resource(), id.KeyVaultBaseUrl)
 	if err != nil || keyVaultIdRaw == nil {
 		return nil, fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
 	}
@@ -288,7 +293,7 @@ func (t KeyVaultCertificateResource) Exists(ctx context.Context, clients *client
 		return nil, fmt.Errorf("checking if key vault %q for Certificate %q in Vault at url %q exists: %v", *keyVaultId, id.Name, id.KeyVaultBaseUrl, err)
 	}
 
-	cert, err := client.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	cert, err := client.GetCertificate(ctx, *keyVaultBaseUri, id.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Key Vault Certificate: %+v", err)
 	}
@@ -316,12 +321,12 @@ func (KeyVaultCertificateResource) Destroy(ctx context.Context, client *clients.
 		return nil, err
 	}
 
-	vaultBaseUrl, err := client.KeyVault.BaseUriForKeyVault(ctx, *keyVaultId)
+	vaultBaseUrl, err := client.KeyVault().BaseUriForKeyVault(ctx, *keyVaultId)
 	if err != nil {
 		return nil, fmt.Errorf("looking up base uri for Secret %q from id %q: %+v", name, keyVaultId, err)
 	}
 
-	if _, err := client.KeyVault.ManagementClient.DeleteCertificate(ctx, *vaultBaseUrl, name); err != nil {
+	if _, err := client.KeyVault().ManagementClient.DeleteCertificate(ctx, *vaultBaseUrl, name); err != nil {
 		return nil, fmt.Errorf("Bad: Delete on keyVaultManagementClient: %+v", err)
 	}
 
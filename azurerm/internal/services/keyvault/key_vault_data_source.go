@@ -164,7 +164,7 @@ func dataSourceKeyVault() *schema.Resource {
 }
 
 func dataSourceKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).KeyVault.VaultsClient
+	client := meta.(*clients.Client).KeyVault().VaultsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
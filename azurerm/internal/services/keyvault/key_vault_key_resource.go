@@ -162,8 +162,8 @@ func resourceKeyVaultKey() *schema.Resource {
 }
 
 func resourceKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).KeyVault.ManagementClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	client := meta.(*clients.Client).KeyVault().ManagementClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -271,9 +271,9 @@ func resourceKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).KeyVault.ManagementClient
-	resourcesClient := meta.(*clients.Client).Resource
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	client := meta.(*clients.Client).KeyVault().ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -282,6 +282,11 @@ func resourceKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVaultUrl(id.KeyVaultBaseUrl)
+	if err != nil {
+		return err
+	}
+
 	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
 	if err != nil {
 		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
@@ -328,7 +333,7 @@ func resourceKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		parameters.KeyAttributes.Expires = &expirationUnixTime
 	}
 
-	if _, err = client.UpdateKey(ctx, id.KeyVaultBaseUrl, id.Name, "", parameters); err != nil {
+	if _, err = client.UpdateKey(ctx, *keyVaultBaseUri, id.Name, "", parameters); err != nil {
 		return err
 	}
 
@@ -336,9 +341,9 @@ func resourceKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).KeyVault.ManagementClient
-	resourcesClient := meta.(*clients.Client).Resource
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	client := meta.(*clients.Client).KeyVault().ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -347,6 +352,11 @@ func resourceKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVaultUrl(id.KeyVaultBaseUrl)
+	if err != nil {
+		return err
+	}
+
 	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
 	if err != nil {
 		return fmt.Errorf("Error retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
@@ -371,7 +381,7 @@ func resourceKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	resp, err := client.GetKey(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	resp, err := client.GetKey(ctx, *keyVaultBaseUri, id.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[DEBUG] Key %q was not found in Key Vault at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
@@ -425,9 +435,9 @@ func resourceKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceKeyVaultKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).KeyVault.ManagementClient
-	resourcesClient := meta.(*clients.Client).Resource
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	client := meta.(*clients.Client).KeyVault().ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -436,6 +446,11 @@ func resourceKeyVaultKeyDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVaultUrl(id.KeyVaultBaseUrl)
+	if err != nil {
+		return err
+	}
+
 	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
 	if err != nil {
 		return fmt.Errorf("Error retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
@@ -462,7 +477,7 @@ func resourceKeyVaultKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	description := fmt.Sprintf("Key %q (Key Vault %q)", id.Name, id.KeyVaultBaseUrl)
 	deleter := deleteAndPurgeKey{
 		client:      client,
-		keyVaultUri: id.KeyVaultBaseUrl,
+		keyVaultUri: *keyVaultBaseUri,
 		name:        id.Name,
 	}
 	if err := deleteAndOptionallyPurge(ctx, description, shouldPurge, deleter); err != nil {
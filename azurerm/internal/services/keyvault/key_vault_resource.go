@@ -246,8 +246,8 @@ func resourceKeyVault() *schema.Resource {
 
 func resourceKeyVaultCreate(d *schema.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).KeyVault.VaultsClient
-	dataPlaneClient := meta.(*clients.Client).KeyVault.ManagementClient
+	client := meta.(*clients.Client).KeyVault().VaultsClient
+	dataPlaneClient := meta.(*clients.Client).KeyVault().ManagementClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -256,7 +256,9 @@ func resourceKeyVaultCreate(d *schema.ResourceData, meta interface{}) error {
 
 	// Locking this resource so we don't make modifications to it at the same time if there is a
 	// key vault access policy trying to update it as well
-	locks.ByName(id.Name, keyVaultResourceName)
+	if err := locks.ByNameWithContext(ctx, id.Name, keyVaultResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.Name, keyVaultResourceName)
 
 	// check for the presence of an existing, live one which should be imported into the state
@@ -354,7 +356,9 @@ func resourceKeyVaultCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, &virtualNetworkNames, network.VirtualNetworkResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
 
 	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
@@ -369,7 +373,7 @@ func resourceKeyVaultCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("retrieving %s: `properties.VaultUri` was nil", id)
 	}
 	d.SetId(id.ID())
-	meta.(*clients.Client).KeyVault.AddToCache(id, *read.Properties.VaultURI)
+	meta.(*clients.Client).KeyVault().AddToCache(id, *read.Properties.VaultURI)
 
 	if props := read.Properties; props != nil {
 		if vault := props.VaultURI; vault != nil {
@@ -406,8 +410,8 @@ func resourceKeyVaultCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceKeyVaultUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).KeyVault.VaultsClient
-	managementClient := meta.(*clients.Client).KeyVault.ManagementClient
+	client := meta.(*clients.Client).KeyVault().VaultsClient
+	managementClient := meta.(*clients.Client).KeyVault().ManagementClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -418,7 +422,9 @@ func resourceKeyVaultUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	// Locking this resource so we don't make modifications to it at the same time if there is a
 	// key vault access policy trying to update it as well
-	locks.ByName(id.Name, keyVaultResourceName)
+	if err := locks.ByNameWithContext(ctx, id.Name, keyVaultResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.Name, keyVaultResourceName)
 
 	d.Partial(true)
@@ -497,7 +503,9 @@ func resourceKeyVaultUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
-		locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+		if err := locks.MultipleByNameWithContext(ctx, &virtualNetworkNames, network.VirtualNetworkResourceName); err != nil {
+			return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+		}
 		defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
 
 		update.Properties.NetworkAcls = networkAcls
@@ -566,7 +574,7 @@ func resourceKeyVaultUpdate(d *schema.ResourceData, meta interface{}) error {
 		update.Properties.TenantID = &tenantUUID
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
 		update.Tags = tags.Expand(t)
 	}
@@ -601,8 +609,8 @@ func resourceKeyVaultUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).KeyVault.VaultsClient
-	managementClient := meta.(*clients.Client).KeyVault.ManagementClient
+	client := meta.(*clients.Client).KeyVault().VaultsClient
+	managementClient := meta.(*clients.Client).KeyVault().ManagementClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -628,7 +636,7 @@ func resourceKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	props := *resp.Properties
-	meta.(*clients.Client).KeyVault.AddToCache(*id, *resp.Properties.VaultURI)
+	meta.(*clients.Client).KeyVault().AddToCache(*id, *resp.Properties.VaultURI)
 
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
@@ -691,7 +699,7 @@ func resourceKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).KeyVault.VaultsClient
+	client := meta.(*clients.Client).KeyVault().VaultsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -700,7 +708,9 @@ func resourceKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.Name, keyVaultResourceName)
+	if err := locks.ByNameWithContext(ctx, id.Name, keyVaultResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.Name, keyVaultResourceName)
 
 	read, err := client.Get(ctx, id.ResourceGroup, id.Name)
@@ -752,7 +762,9 @@ func resourceKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, &virtualNetworkNames, network.VirtualNetworkResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
 
 	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name)
@@ -794,7 +806,7 @@ func resourceKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] Purged KeyVault %q.", id.Name)
 	}
 
-	meta.(*clients.Client).KeyVault.Purge(*id)
+	meta.(*clients.Client).KeyVault().Purge(*id)
 
 	return nil
 }
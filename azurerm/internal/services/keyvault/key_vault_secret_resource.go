@@ -93,8 +93,8 @@ func resourceKeyVaultSecret() *schema.Resource {
 }
 
 func resourceKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) error {
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).KeyVault.ManagementClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	client := meta.(*clients.Client).KeyVault().ManagementClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -193,9 +193,9 @@ func resourceKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) error {
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).KeyVault.ManagementClient
-	resourcesClient := meta.(*clients.Client).Resource
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	client := meta.(*clients.Client).KeyVault().ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Print("[INFO] preparing arguments for AzureRM KeyVault Secret update.")
@@ -205,6 +205,11 @@ func resourceKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVaultUrl(id.KeyVaultBaseUrl)
+	if err != nil {
+		return err
+	}
+
 	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
 	if err != nil {
 		return fmt.Errorf("Error retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
@@ -254,7 +259,7 @@ func resourceKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) erro
 			SecretAttributes: secretAttributes,
 		}
 
-		if _, err = client.SetSecret(ctx, id.KeyVaultBaseUrl, id.Name, parameters); err != nil {
+		if _, err = client.SetSecret(ctx, *keyVaultBaseUri, id.Name, parameters); err != nil {
 			return err
 		}
 	} else {
@@ -264,13 +269,13 @@ func resourceKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) erro
 			SecretAttributes: secretAttributes,
 		}
 
-		if _, err = client.UpdateSecret(ctx, id.KeyVaultBaseUrl, id.Name, "", parameters); err != nil {
+		if _, err = client.UpdateSecret(ctx, *keyVaultBaseUri, id.Name, "", parameters); err != nil {
 			return err
 		}
 	}
 
 	// "" indicates the latest version
-	read, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	read, err := client.GetSecret(ctx, *keyVaultBaseUri, id.Name, "")
 	if err != nil {
 		return fmt.Errorf("getting Key Vault Secret %q : %+v", id.Name, err)
 	}
@@ -286,9 +291,9 @@ func resourceKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) error {
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).KeyVault.ManagementClient
-	resourcesClient := meta.(*clients.Client).Resource
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	client := meta.(*clients.Client).KeyVault().ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -297,6 +302,11 @@ func resourceKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVaultUrl(id.KeyVaultBaseUrl)
+	if err != nil {
+		return err
+	}
+
 	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
 	if err != nil {
 		return fmt.Errorf("Error retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
@@ -322,7 +332,7 @@ func resourceKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// we always want to get the latest version
-	resp, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	resp, err := client.GetSecret(ctx, *keyVaultBaseUri, id.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[DEBUG] Secret %q was not found in Key Vault at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
@@ -358,9 +368,9 @@ func resourceKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceKeyVaultSecretDelete(d *schema.ResourceData, meta interface{}) error {
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).KeyVault.ManagementClient
-	resourcesClient := meta.(*clients.Client).Resource
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	client := meta.(*clients.Client).KeyVault().ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -369,6 +379,11 @@ func resourceKeyVaultSecretDelete(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVaultUrl(id.KeyVaultBaseUrl)
+	if err != nil {
+		return err
+	}
+
 	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
 	if err != nil {
 		return fmt.Errorf("Error retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
@@ -395,7 +410,7 @@ func resourceKeyVaultSecretDelete(d *schema.ResourceData, meta interface{}) erro
 	description := fmt.Sprintf("Secret %q (Key Vault %q)", id.Name, id.KeyVaultBaseUrl)
 	deleter := deleteAndPurgeSecret{
 		client:      client,
-		keyVaultUri: id.KeyVaultBaseUrl,
+		keyVaultUri: *keyVaultBaseUri,
 		name:        id.Name,
 	}
 	if err := deleteAndOptionallyPurge(ctx, description, shouldPurge, deleter); err != nil {
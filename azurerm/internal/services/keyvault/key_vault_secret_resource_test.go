@@ -219,15 +219,20 @@ func TestAccKeyVaultSecret_purge(t *testing.T) {
 }
 
 func (KeyVaultSecretResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	client := clients.KeyVault.ManagementClient
-	keyVaultsClient := clients.KeyVault
+	client := clients.KeyVault().ManagementClient
+	keyVaultsClient := clients.KeyVault()
 
 	id, err := parse.ParseNestedItemID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, clients.Resource, id.KeyVaultBaseUrl)
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVaultUrl(id.KeyVaultBaseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, clients.Resource(), id.KeyVaultBaseUrl)
 	if err != nil || keyVaultIdRaw == nil {
 		return nil, fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
 	}
@@ -242,7 +247,7 @@ func (KeyVaultSecretResource) Exists(ctx context.Context, clients *clients.Clien
 	}
 
 	// we always want to get the latest version
-	resp, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	resp, err := client.GetSecret(ctx, *keyVaultBaseUri, id.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("making Read request on Azure KeyVault Secret %s: %+v", id.Name, err)
 	}
@@ -251,14 +256,14 @@ func (KeyVaultSecretResource) Exists(ctx context.Context, clients *clients.Clien
 }
 
 func (KeyVaultSecretResource) Destroy(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	dataPlaneClient := client.KeyVault.ManagementClient
+	dataPlaneClient := client.KeyVault().ManagementClient
 
 	name := state.Attributes["name"]
 	keyVaultId, err := parse.VaultID(state.Attributes["key_vault_id"])
 	if err != nil {
 		return nil, err
 	}
-	vaultBaseUrl, err := client.KeyVault.BaseUriForKeyVault(ctx, *keyVaultId)
+	vaultBaseUrl, err := client.KeyVault().BaseUriForKeyVault(ctx, *keyVaultId)
 	if err != nil {
 		return nil, fmt.Errorf("looking up Secret %q vault url from id %q: %+v", name, keyVaultId, err)
 	}
@@ -285,7 +290,7 @@ func (KeyVaultSecretResource) destroyParentKeyVault(ctx context.Context, client
 
 func (r KeyVaultSecretResource) updateSecretValue(value string) acceptance.ClientCheckFunc {
 	return func(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) error {
-		dataPlaneClient := clients.KeyVault.ManagementClient
+		dataPlaneClient := clients.KeyVault().ManagementClient
 
 		name := state.Attributes["name"]
 		keyVaultId, err := parse.VaultID(state.Attributes["key_vault_id"])
@@ -293,7 +298,7 @@ func (r KeyVaultSecretResource) updateSecretValue(value string) acceptance.Clien
 			return err
 		}
 
-		vaultBaseUrl, err := clients.KeyVault.BaseUriForKeyVault(ctx, *keyVaultId)
+		vaultBaseUrl, err := clients.KeyVault().BaseUriForKeyVault(ctx, *keyVaultId)
 		if err != nil {
 			return fmt.Errorf("looking up Secret %q vault url from id %q: %+v", name, keyVaultId, err)
 		}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VaultId struct {
@@ -67,3 +68,12 @@ func VaultID(input string) (*VaultId, error) {
 
 	return &resourceId, nil
 }
+
+// VaultIDNormalized parses a Vault ID into an VaultId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VaultID method should be used instead for validation etc.
+func VaultIDNormalized(input string) (*VaultId, error) {
+	return VaultID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.KeyVault/vaults/%s"))
+}
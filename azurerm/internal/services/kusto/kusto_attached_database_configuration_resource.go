@@ -91,7 +91,7 @@ func resourceKustoAttachedDatabaseConfiguration() *schema.Resource {
 }
 
 func resourceKustoAttachedDatabaseConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.AttachedDatabaseConfigurationsClient
+	client := meta.(*clients.Client).Kusto().AttachedDatabaseConfigurationsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -143,7 +143,7 @@ func resourceKustoAttachedDatabaseConfigurationCreateUpdate(d *schema.ResourceDa
 }
 
 func resourceKustoAttachedDatabaseConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.AttachedDatabaseConfigurationsClient
+	client := meta.(*clients.Client).Kusto().AttachedDatabaseConfigurationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -180,7 +180,7 @@ func resourceKustoAttachedDatabaseConfigurationRead(d *schema.ResourceData, meta
 }
 
 func resourceKustoAttachedDatabaseConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.AttachedDatabaseConfigurationsClient
+	client := meta.(*clients.Client).Kusto().AttachedDatabaseConfigurationsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -38,7 +38,7 @@ func (KustoAttachedDatabaseConfigurationResource) Exists(ctx context.Context, cl
 		return nil, err
 	}
 
-	resp, err := clients.Kusto.AttachedDatabaseConfigurationsClient.Get(ctx, id.ResourceGroup, id.ClusterName, id.Name)
+	resp, err := clients.Kusto().AttachedDatabaseConfigurationsClient.Get(ctx, id.ResourceGroup, id.ClusterName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %v", id.String(), err)
 	}
@@ -68,8 +68,8 @@ func resourceKustoClusterCustomerManagedKey() *schema.Resource {
 }
 
 func resourceKustoClusterCustomerManagedKeyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	clusterClient := meta.(*clients.Client).Kusto.ClustersClient
-	keyVaultsClient := meta.(*clients.Client).KeyVault
+	clusterClient := meta.(*clients.Client).Kusto().ClustersClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
 	vaultsClient := keyVaultsClient.VaultsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -80,7 +80,9 @@ func resourceKustoClusterCustomerManagedKeyCreateUpdate(d *schema.ResourceData,
 		return err
 	}
 
-	locks.ByName(clusterID.Name, "azurerm_kusto_cluster")
+	if err := locks.ByNameWithContext(ctx, clusterID.Name, "azurerm_kusto_cluster"); err != nil {
+		return fmt.Errorf("acquiring lock for Customer Managed Key (Kusto Cluster %q / Resource Group %q): %+v", clusterID.Name, clusterID.ResourceGroup, err)
+	}
 	defer locks.UnlockByName(clusterID.Name, "azurerm_kusto_cluster")
 
 	cluster, err := clusterClient.Get(ctx, clusterID.ResourceGroup, clusterID.Name)
@@ -158,9 +160,9 @@ func resourceKustoClusterCustomerManagedKeyCreateUpdate(d *schema.ResourceData,
 }
 
 func resourceKustoClusterCustomerManagedKeyRead(d *schema.ResourceData, meta interface{}) error {
-	clusterClient := meta.(*clients.Client).Kusto.ClustersClient
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	resourcesClient := meta.(*clients.Client).Resource
+	clusterClient := meta.(*clients.Client).Kusto().ClustersClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -224,7 +226,7 @@ func resourceKustoClusterCustomerManagedKeyRead(d *schema.ResourceData, meta int
 }
 
 func resourceKustoClusterCustomerManagedKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.ClustersClient
+	client := meta.(*clients.Client).Kusto().ClustersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -233,7 +235,9 @@ func resourceKustoClusterCustomerManagedKeyDelete(d *schema.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(clusterID.Name, "azurerm_kusto_cluster")
+	if err := locks.ByNameWithContext(ctx, clusterID.Name, "azurerm_kusto_cluster"); err != nil {
+		return fmt.Errorf("acquiring lock for Customer Managed Key (Kusto Cluster %q / Resource Group %q): %+v", clusterID.Name, clusterID.ResourceGroup, err)
+	}
 	defer locks.UnlockByName(clusterID.Name, "azurerm_kusto_cluster")
 
 	// confirm it still exists prior to trying to update it, else we'll get an error
@@ -94,7 +94,7 @@ func (KustoClusterCustomerManagedKeyResource) Exists(ctx context.Context, client
 		return nil, err
 	}
 
-	resp, err := clients.Kusto.ClustersClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Kusto().ClustersClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %v", id.String(), err)
 	}
@@ -112,7 +112,7 @@ func (r KustoClusterCustomerManagedKeyResource) clusterIsNotUsingCustomerManaged
 		return err
 	}
 
-	resp, err := client.Kusto.ClustersClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Kusto().ClustersClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %v", id.String(), err)
 	}
@@ -48,7 +48,7 @@ func dataSourceKustoCluster() *schema.Resource {
 }
 
 func dataSourceKustoClusterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.ClustersClient
+	client := meta.(*clients.Client).Kusto().ClustersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -101,7 +101,7 @@ func resourceKustoClusterPrincipalAssignment() *schema.Resource {
 }
 
 func resourceKustoClusterPrincipalAssignmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.ClusterPrincipalAssignmentsClient
+	client := meta.(*clients.Client).Kusto().ClusterPrincipalAssignmentsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -162,7 +162,7 @@ func resourceKustoClusterPrincipalAssignmentCreateUpdate(d *schema.ResourceData,
 }
 
 func resourceKustoClusterPrincipalAssignmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.ClusterPrincipalAssignmentsClient
+	client := meta.(*clients.Client).Kusto().ClusterPrincipalAssignmentsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -218,7 +218,7 @@ func resourceKustoClusterPrincipalAssignmentRead(d *schema.ResourceData, meta in
 }
 
 func resourceKustoClusterPrincipalAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.ClusterPrincipalAssignmentsClient
+	client := meta.(*clients.Client).Kusto().ClusterPrincipalAssignmentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -38,7 +38,7 @@ func (KustoClusterPrincipalAssignmentResource) Exists(ctx context.Context, clien
 		return nil, err
 	}
 
-	resp, err := clients.Kusto.ClusterPrincipalAssignmentsClient.Get(ctx, id.ResourceGroup, id.ClusterName, id.PrincipalAssignmentName)
+	resp, err := clients.Kusto().ClusterPrincipalAssignmentsClient.Get(ctx, id.ResourceGroup, id.ClusterName, id.PrincipalAssignmentName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %v", id.String(), err)
 	}
@@ -217,7 +217,7 @@ func resourceKustoCluster() *schema.Resource {
 }
 
 func resourceKustoClusterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.ClustersClient
+	client := meta.(*clients.Client).Kusto().ClustersClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -374,7 +374,7 @@ func resourceKustoClusterCreateUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKustoClusterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.ClustersClient
+	client := meta.(*clients.Client).Kusto().ClustersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -435,7 +435,7 @@ func resourceKustoClusterRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceKustoClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.ClustersClient
+	client := meta.(*clients.Client).Kusto().ClustersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
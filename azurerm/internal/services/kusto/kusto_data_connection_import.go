@@ -17,7 +17,7 @@ func importDataConnection(kind kusto.KindBasicDataConnection) func(d *schema.Res
 			return []*schema.ResourceData{}, err
 		}
 
-		client := meta.(*clients.Client).Kusto.DataConnectionsClient
+		client := meta.(*clients.Client).Kusto().DataConnectionsClient
 		ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 		defer cancel()
 
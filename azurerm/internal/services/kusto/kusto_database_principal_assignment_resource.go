@@ -110,7 +110,7 @@ func resourceKustoDatabasePrincipalAssignment() *schema.Resource {
 }
 
 func resourceKustoDatabasePrincipalAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DatabasePrincipalAssignmentsClient
+	client := meta.(*clients.Client).Kusto().DatabasePrincipalAssignmentsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -170,7 +170,7 @@ func resourceKustoDatabasePrincipalAssignmentCreate(d *schema.ResourceData, meta
 }
 
 func resourceKustoDatabasePrincipalAssignmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DatabasePrincipalAssignmentsClient
+	client := meta.(*clients.Client).Kusto().DatabasePrincipalAssignmentsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -227,7 +227,7 @@ func resourceKustoDatabasePrincipalAssignmentRead(d *schema.ResourceData, meta i
 }
 
 func resourceKustoDatabasePrincipalAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DatabasePrincipalAssignmentsClient
+	client := meta.(*clients.Client).Kusto().DatabasePrincipalAssignmentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -53,7 +53,7 @@ func (KustoDatabasePrincipalAssignmentResource) Exists(ctx context.Context, clie
 		return nil, err
 	}
 
-	resp, err := clients.Kusto.DatabasePrincipalAssignmentsClient.Get(ctx, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.PrincipalAssignmentName)
+	resp, err := clients.Kusto().DatabasePrincipalAssignmentsClient.Get(ctx, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.PrincipalAssignmentName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %v", id.String(), err)
 	}
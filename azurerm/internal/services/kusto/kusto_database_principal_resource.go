@@ -120,7 +120,7 @@ func resourceKustoDatabasePrincipal() *schema.Resource {
 }
 
 func resourceKustoDatabasePrincipalCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DatabasesClient
+	client := meta.(*clients.Client).Kusto().DatabasesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -212,7 +212,7 @@ func resourceKustoDatabasePrincipalCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceKustoDatabasePrincipalRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DatabasesClient
+	client := meta.(*clients.Client).Kusto().DatabasesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -290,7 +290,7 @@ func resourceKustoDatabasePrincipalRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceKustoDatabasePrincipalDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DatabasesClient
+	client := meta.(*clients.Client).Kusto().DatabasesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -33,7 +33,7 @@ func TestAccKustoDatabasePrincipal_basic(t *testing.T) {
 }
 
 func (KustoDatabasePrincipalResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	client := clients.Kusto.DatabasesClient
+	client := clients.Kusto().DatabasesClient
 	id, err := parse.DatabasePrincipalID(state.ID)
 	if err != nil {
 		return nil, err
@@ -75,7 +75,7 @@ func resourceKustoDatabase() *schema.Resource {
 }
 
 func resourceKustoDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DatabasesClient
+	client := meta.(*clients.Client).Kusto().DatabasesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -146,7 +146,7 @@ func resourceKustoDatabaseCreateUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceKustoDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DatabasesClient
+	client := meta.(*clients.Client).Kusto().DatabasesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -194,7 +194,7 @@ func resourceKustoDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceKustoDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DatabasesClient
+	client := meta.(*clients.Client).Kusto().DatabasesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
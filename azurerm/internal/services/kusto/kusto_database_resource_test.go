@@ -245,7 +245,7 @@ func (KustoDatabaseResource) Exists(ctx context.Context, clients *clients.Client
 		return nil, err
 	}
 
-	resp, err := clients.Kusto.DatabasesClient.Get(ctx, id.ResourceGroup, id.ClusterName, id.Name)
+	resp, err := clients.Kusto().DatabasesClient.Get(ctx, id.ResourceGroup, id.ClusterName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %v", id.String(), err)
 	}
@@ -107,7 +107,7 @@ func resourceKustoEventGridDataConnection() *schema.Resource {
 }
 
 func resourceKustoEventGridDataConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DataConnectionsClient
+	client := meta.(*clients.Client).Kusto().DataConnectionsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -154,7 +154,7 @@ func resourceKustoEventGridDataConnectionCreateUpdate(d *schema.ResourceData, me
 }
 
 func resourceKustoEventGridDataConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DataConnectionsClient
+	client := meta.(*clients.Client).Kusto().DataConnectionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -192,7 +192,7 @@ func resourceKustoEventGridDataConnectionRead(d *schema.ResourceData, meta inter
 }
 
 func resourceKustoEventGridDataConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DataConnectionsClient
+	client := meta.(*clients.Client).Kusto().DataConnectionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
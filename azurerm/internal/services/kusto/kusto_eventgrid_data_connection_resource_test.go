@@ -97,7 +97,7 @@ func (KustoEventGridDataConnectionResource) Exists(ctx context.Context, clients
 		return nil, err
 	}
 
-	resp, err := clients.Kusto.DataConnectionsClient.Get(ctx, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.Name)
+	resp, err := clients.Kusto().DataConnectionsClient.Get(ctx, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %v", id.String(), err)
 	}
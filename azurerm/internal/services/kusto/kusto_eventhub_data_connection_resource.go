@@ -135,7 +135,7 @@ func resourceKustoEventHubDataConnection() *schema.Resource {
 }
 
 func resourceKustoEventHubDataConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DataConnectionsClient
+	client := meta.(*clients.Client).Kusto().DataConnectionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -198,7 +198,7 @@ func resourceKustoEventHubDataConnectionCreateUpdate(d *schema.ResourceData, met
 }
 
 func resourceKustoEventHubDataConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DataConnectionsClient
+	client := meta.(*clients.Client).Kusto().DataConnectionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -240,7 +240,7 @@ func resourceKustoEventHubDataConnectionRead(d *schema.ResourceData, meta interf
 }
 
 func resourceKustoEventHubDataConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DataConnectionsClient
+	client := meta.(*clients.Client).Kusto().DataConnectionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -110,7 +110,7 @@ func resourceKustoIotHubDataConnection() *schema.Resource {
 }
 
 func resourceKustoIotHubDataConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DataConnectionsClient
+	client := meta.(*clients.Client).Kusto().DataConnectionsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -156,7 +156,7 @@ func resourceKustoIotHubDataConnectionCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceKustoIotHubDataConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DataConnectionsClient
+	client := meta.(*clients.Client).Kusto().DataConnectionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -193,7 +193,7 @@ func resourceKustoIotHubDataConnectionRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceKustoIotHubDataConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Kusto.DataConnectionsClient
+	client := meta.(*clients.Client).Kusto().DataConnectionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
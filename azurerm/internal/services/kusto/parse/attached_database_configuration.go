@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AttachedDatabaseConfigurationId struct {
@@ -73,3 +74,12 @@ func AttachedDatabaseConfigurationID(input string) (*AttachedDatabaseConfigurati
 
 	return &resourceId, nil
 }
+
+// AttachedDatabaseConfigurationIDNormalized parses a AttachedDatabaseConfiguration ID into an AttachedDatabaseConfigurationId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the AttachedDatabaseConfigurationID method should be used instead for validation etc.
+func AttachedDatabaseConfigurationIDNormalized(input string) (*AttachedDatabaseConfigurationId, error) {
+	return AttachedDatabaseConfigurationID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Kusto/Clusters/%s/AttachedDatabaseConfigurations/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ClusterPrincipalAssignmentId struct {
@@ -73,3 +74,12 @@ func ClusterPrincipalAssignmentID(input string) (*ClusterPrincipalAssignmentId,
 
 	return &resourceId, nil
 }
+
+// ClusterPrincipalAssignmentIDNormalized parses a ClusterPrincipalAssignment ID into an ClusterPrincipalAssignmentId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ClusterPrincipalAssignmentID method should be used instead for validation etc.
+func ClusterPrincipalAssignmentIDNormalized(input string) (*ClusterPrincipalAssignmentId, error) {
+	return ClusterPrincipalAssignmentID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Kusto/Clusters/%s/PrincipalAssignments/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DataConnectionId struct {
@@ -79,3 +80,12 @@ func DataConnectionID(input string) (*DataConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+// DataConnectionIDNormalized parses a DataConnection ID into an DataConnectionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DataConnectionID method should be used instead for validation etc.
+func DataConnectionIDNormalized(input string) (*DataConnectionId, error) {
+	return DataConnectionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Kusto/Clusters/%s/Databases/%s/DataConnections/%s"))
+}
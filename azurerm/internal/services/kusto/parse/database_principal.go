@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabasePrincipalId struct {
@@ -85,3 +86,12 @@ func DatabasePrincipalID(input string) (*DatabasePrincipalId, error) {
 
 	return &resourceId, nil
 }
+
+// DatabasePrincipalIDNormalized parses a DatabasePrincipal ID into an DatabasePrincipalId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DatabasePrincipalID method should be used instead for validation etc.
+func DatabasePrincipalIDNormalized(input string) (*DatabasePrincipalId, error) {
+	return DatabasePrincipalID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Kusto/Clusters/%s/Databases/%s/Role/%s/FQN/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabasePrincipalAssignmentId struct {
@@ -79,3 +80,12 @@ func DatabasePrincipalAssignmentID(input string) (*DatabasePrincipalAssignmentId
 
 	return &resourceId, nil
 }
+
+// DatabasePrincipalAssignmentIDNormalized parses a DatabasePrincipalAssignment ID into an DatabasePrincipalAssignmentId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DatabasePrincipalAssignmentID method should be used instead for validation etc.
+func DatabasePrincipalAssignmentIDNormalized(input string) (*DatabasePrincipalAssignmentId, error) {
+	return DatabasePrincipalAssignmentID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Kusto/Clusters/%s/Databases/%s/PrincipalAssignments/%s"))
+}
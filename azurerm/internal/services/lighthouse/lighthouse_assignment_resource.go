@@ -63,7 +63,7 @@ func resourceLighthouseAssignment() *schema.Resource {
 }
 
 func resourceLighthouseAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Lighthouse.AssignmentsClient
+	client := meta.(*clients.Client).Lighthouse().AssignmentsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -115,7 +115,7 @@ func resourceLighthouseAssignmentCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceLighthouseAssignmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Lighthouse.AssignmentsClient
+	client := meta.(*clients.Client).Lighthouse().AssignmentsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -146,7 +146,7 @@ func resourceLighthouseAssignmentRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLighthouseAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Lighthouse.AssignmentsClient
+	client := meta.(*clients.Client).Lighthouse().AssignmentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -85,7 +85,7 @@ func (LighthouseAssignmentResource) Exists(ctx context.Context, clients *clients
 		return nil, err
 	}
 
-	resp, err := clients.Lighthouse.AssignmentsClient.Get(ctx, id.Scope, id.Name, utils.Bool(false))
+	resp, err := clients.Lighthouse().AssignmentsClient.Get(ctx, id.Scope, id.Name, utils.Bool(false))
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Lighthouse Assignment %q (Scope: %q) does not exist", id.Name, id.Scope)
 	}
@@ -100,7 +100,7 @@ func resourceLighthouseDefinition() *schema.Resource {
 }
 
 func resourceLighthouseDefinitionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Lighthouse.DefinitionsClient
+	client := meta.(*clients.Client).Lighthouse().DefinitionsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -162,7 +162,7 @@ func resourceLighthouseDefinitionCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceLighthouseDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Lighthouse.DefinitionsClient
+	client := meta.(*clients.Client).Lighthouse().DefinitionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -198,7 +198,7 @@ func resourceLighthouseDefinitionRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLighthouseDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Lighthouse.DefinitionsClient
+	client := meta.(*clients.Client).Lighthouse().DefinitionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -137,7 +137,7 @@ func (LighthouseDefinitionResource) Exists(ctx context.Context, clients *clients
 		return nil, err
 	}
 
-	resp, err := clients.Lighthouse.DefinitionsClient.Get(ctx, id.Scope, id.LighthouseDefinitionID)
+	resp, err := clients.Lighthouse().DefinitionsClient.Get(ctx, id.Scope, id.LighthouseDefinitionID)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Lighthouse Definition %q (Scope: %q) does not exist", id.LighthouseDefinitionID, id.Scope)
 	}
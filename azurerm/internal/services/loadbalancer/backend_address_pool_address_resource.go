@@ -73,7 +73,7 @@ func (r BackendAddressPoolAddressResource) ResourceType() string {
 func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.LoadBalancers.LoadBalancerBackendAddressPoolsClient
+			client := metadata.Client.LoadBalancers().LoadBalancerBackendAddressPoolsClient
 			subscriptionId := metadata.Client.Account.SubscriptionId
 
 			var model BackendAddressPoolAddressModel
@@ -86,11 +86,13 @@ func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
-			locks.ByName(poolId.BackendAddressPoolName, backendAddressPoolResourceName)
+			if err := locks.ByNameWithContext(ctx, poolId.BackendAddressPoolName, backendAddressPoolResourceName); err != nil {
+				return fmt.Errorf("acquiring lock for Backend Address Pool Address %q (Backend Address Pool %q / Load Balancer %q / Resource Group %q): %+v", model.Name, poolId.BackendAddressPoolName, poolId.LoadBalancerName, poolId.ResourceGroup, err)
+			}
 			defer locks.UnlockByName(poolId.BackendAddressPoolName, backendAddressPoolResourceName)
 
 			// Backend Addresses can only be created for Standard LB's - not Basic, so we have to check
-			lb, err := metadata.Client.LoadBalancers.LoadBalancersClient.Get(ctx, poolId.ResourceGroup, poolId.LoadBalancerName, "")
+			lb, err := metadata.Client.LoadBalancers().LoadBalancersClient.Get(ctx, poolId.ResourceGroup, poolId.LoadBalancerName, "")
 			if err != nil {
 				return fmt.Errorf("retrieving Load Balancer %q (Resource Group %q): %+v", poolId.LoadBalancerName, poolId.ResourceGroup, err)
 			}
@@ -157,7 +159,7 @@ func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 func (r BackendAddressPoolAddressResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.LoadBalancers.LoadBalancerBackendAddressPoolsClient
+			client := metadata.Client.LoadBalancers().LoadBalancerBackendAddressPoolsClient
 			id, err := parse.BackendAddressPoolAddressID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
@@ -214,13 +216,15 @@ func (r BackendAddressPoolAddressResource) Read() sdk.ResourceFunc {
 func (r BackendAddressPoolAddressResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.LoadBalancers.LoadBalancerBackendAddressPoolsClient
+			client := metadata.Client.LoadBalancers().LoadBalancerBackendAddressPoolsClient
 			id, err := parse.BackendAddressPoolAddressID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
 
-			locks.ByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
+			if err := locks.ByNameWithContext(ctx, id.BackendAddressPoolName, backendAddressPoolResourceName); err != nil {
+				return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+			}
 			defer locks.UnlockByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
 
 			pool, err := client.Get(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
@@ -269,13 +273,15 @@ func (r BackendAddressPoolAddressResource) IDValidationFunc() schema.SchemaValid
 func (r BackendAddressPoolAddressResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.LoadBalancers.LoadBalancerBackendAddressPoolsClient
+			client := metadata.Client.LoadBalancers().LoadBalancerBackendAddressPoolsClient
 			id, err := parse.BackendAddressPoolAddressID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
 
-			locks.ByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
+			if err := locks.ByNameWithContext(ctx, id.BackendAddressPoolName, backendAddressPoolResourceName); err != nil {
+				return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+			}
 			defer locks.UnlockByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
 
 			var model BackendAddressPoolAddressModel
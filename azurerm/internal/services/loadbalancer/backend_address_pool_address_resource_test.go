@@ -86,7 +86,7 @@ func (BackendAddressPoolAddressResourceTests) Exists(ctx context.Context, client
 		return nil, err
 	}
 
-	pool, err := client.LoadBalancers.LoadBalancerBackendAddressPoolsClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
+	pool, err := client.LoadBalancers().LoadBalancerBackendAddressPoolsClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
@@ -114,7 +114,7 @@ func (BackendAddressPoolAddressResourceTests) Destroy(ctx context.Context, clien
 		return nil, err
 	}
 
-	pool, err := client.LoadBalancers.LoadBalancerBackendAddressPoolsClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
+	pool, err := client.LoadBalancers().LoadBalancerBackendAddressPoolsClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
@@ -138,11 +138,11 @@ func (BackendAddressPoolAddressResourceTests) Destroy(ctx context.Context, clien
 	}
 	pool.BackendAddressPoolPropertiesFormat.LoadBalancerBackendAddresses = &newAddresses
 
-	future, err := client.LoadBalancers.LoadBalancerBackendAddressPoolsClient.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName, pool)
+	future, err := client.LoadBalancers().LoadBalancerBackendAddressPoolsClient.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName, pool)
 	if err != nil {
 		return nil, fmt.Errorf("updating %s: %+v", *id, err)
 	}
-	if err := future.WaitForCompletionRef(ctx, client.LoadBalancers.LoadBalancerBackendAddressPoolsClient.Client); err != nil {
+	if err := future.WaitForCompletionRef(ctx, client.LoadBalancers().LoadBalancerBackendAddressPoolsClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for update of %s: %+v", *id, err)
 	}
 	return utils.Bool(true), nil
@@ -156,7 +156,7 @@ func (BackendAddressPoolAddressResourceTests) backendAddressPoolHasAddresses(exp
 			return err
 		}
 
-		client := clients.LoadBalancers.LoadBalancerBackendAddressPoolsClient
+		client := clients.LoadBalancers().LoadBalancerBackendAddressPoolsClient
 		pool, err := client.Get(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
 		if err != nil {
 			return err
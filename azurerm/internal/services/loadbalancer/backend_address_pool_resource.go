@@ -126,8 +126,8 @@ func resourceArmLoadBalancerBackendAddressPool() *schema.Resource {
 }
 
 func resourceArmLoadBalancerBackendAddressPoolCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LoadBalancers.LoadBalancerBackendAddressPoolsClient
-	lbClient := meta.(*clients.Client).LoadBalancers.LoadBalancersClient
+	client := meta.(*clients.Client).LoadBalancers().LoadBalancerBackendAddressPoolsClient
+	lbClient := meta.(*clients.Client).LoadBalancers().LoadBalancersClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -152,10 +152,14 @@ func resourceArmLoadBalancerBackendAddressPoolCreateUpdate(d *schema.ResourceDat
 		}
 	}
 
-	locks.ByName(name, backendAddressPoolResourceName)
+	if err := locks.ByNameWithContext(ctx, name, backendAddressPoolResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(name, backendAddressPoolResourceName)
 
-	locks.ByID(loadBalancerId.ID())
+	if err := locks.ByIDWithContext(ctx, loadBalancerId.ID()); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByID(loadBalancerId.ID())
 
 	lb, err := lbClient.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
@@ -227,7 +231,7 @@ func resourceArmLoadBalancerBackendAddressPoolCreateUpdate(d *schema.ResourceDat
 }
 
 func resourceArmLoadBalancerBackendAddressPoolRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LoadBalancers.LoadBalancerBackendAddressPoolsClient
+	client := meta.(*clients.Client).LoadBalancers().LoadBalancerBackendAddressPoolsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -305,8 +309,8 @@ func resourceArmLoadBalancerBackendAddressPoolRead(d *schema.ResourceData, meta
 }
 
 func resourceArmLoadBalancerBackendAddressPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LoadBalancers.LoadBalancerBackendAddressPoolsClient
-	lbClient := meta.(*clients.Client).LoadBalancers.LoadBalancersClient
+	client := meta.(*clients.Client).LoadBalancers().LoadBalancerBackendAddressPoolsClient
+	lbClient := meta.(*clients.Client).LoadBalancers().LoadBalancersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -317,10 +321,14 @@ func resourceArmLoadBalancerBackendAddressPoolDelete(d *schema.ResourceData, met
 
 	loadBalancerId := parse.NewLoadBalancerID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName)
 	loadBalancerID := loadBalancerId.ID()
-	locks.ByID(loadBalancerID)
+	if err := locks.ByIDWithContext(ctx, loadBalancerID); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByID(loadBalancerID)
 
-	locks.ByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
+	if err := locks.ByNameWithContext(ctx, id.BackendAddressPoolName, backendAddressPoolResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
 
 	lb, err := lbClient.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
@@ -110,7 +110,7 @@ func (r LoadBalancerBackendAddressPool) Exists(ctx context.Context, client *clie
 		return nil, err
 	}
 
-	lb, err := client.LoadBalancers.LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
+	lb, err := client.LoadBalancers().LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(lb.Response) {
 			return nil, fmt.Errorf("Load Balancer %q (resource group %q) not found for Backend Address Pool %q", id.LoadBalancerName, id.ResourceGroup, id.BackendAddressPoolName)
@@ -140,7 +140,7 @@ func (r LoadBalancerBackendAddressPool) Destroy(ctx context.Context, client *cli
 		return nil, err
 	}
 
-	lb, err := client.LoadBalancers.LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
+	lb, err := client.LoadBalancers().LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Load Balancer %q (Resource Group %q)", id.LoadBalancerName, id.ResourceGroup)
 	}
@@ -161,12 +161,12 @@ func (r LoadBalancerBackendAddressPool) Destroy(ctx context.Context, client *cli
 	}
 	lb.LoadBalancerPropertiesFormat.BackendAddressPools = &backendAddressPools
 
-	future, err := client.LoadBalancers.LoadBalancersClient.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, lb)
+	future, err := client.LoadBalancers().LoadBalancersClient.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, lb)
 	if err != nil {
 		return nil, fmt.Errorf("updating Load Balancer %q (Resource Group %q): %+v", id.LoadBalancerName, id.ResourceGroup, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.LoadBalancers.LoadBalancersClient.Client); err != nil {
+	if err := future.WaitForCompletionRef(ctx, client.LoadBalancers().LoadBalancersClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for update of Load Balancer %q (Resource Group %q): %+v", id.LoadBalancerName, id.ResourceGroup, err)
 	}
 
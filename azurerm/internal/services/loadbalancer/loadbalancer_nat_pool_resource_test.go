@@ -95,7 +95,7 @@ func (r LoadBalancerNatPool) Exists(ctx context.Context, client *clients.Client,
 		return nil, err
 	}
 
-	lb, err := client.LoadBalancers.LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
+	lb, err := client.LoadBalancers().LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(lb.Response) {
 			return nil, fmt.Errorf("Load Balancer %q (resource group %q) not found for Nat Pool %q", id.LoadBalancerName, id.ResourceGroup, id.InboundNatPoolName)
@@ -126,7 +126,7 @@ func (r LoadBalancerNatPool) Destroy(ctx context.Context, client *clients.Client
 		return nil, err
 	}
 
-	lb, err := client.LoadBalancers.LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
+	lb, err := client.LoadBalancers().LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Load Balancer %q (Resource Group %q)", id.LoadBalancerName, id.ResourceGroup)
 	}
@@ -147,12 +147,12 @@ func (r LoadBalancerNatPool) Destroy(ctx context.Context, client *clients.Client
 	}
 	lb.LoadBalancerPropertiesFormat.InboundNatPools = &inboundNatPools
 
-	future, err := client.LoadBalancers.LoadBalancersClient.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, lb)
+	future, err := client.LoadBalancers().LoadBalancersClient.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, lb)
 	if err != nil {
 		return nil, fmt.Errorf("updating Load Balancer %q (Resource Group %q): %+v", id.LoadBalancerName, id.ResourceGroup, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.LoadBalancers.LoadBalancersClient.Client); err != nil {
+	if err := future.WaitForCompletionRef(ctx, client.LoadBalancers().LoadBalancersClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for update of Load Balancer %q (Resource Group %q): %+v", id.LoadBalancerName, id.ResourceGroup, err)
 	}
 
@@ -108,7 +108,7 @@ func (r LoadBalancerOutboundRule) Exists(ctx context.Context, client *clients.Cl
 		return nil, err
 	}
 
-	lb, err := client.LoadBalancers.LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
+	lb, err := client.LoadBalancers().LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(lb.Response) {
 			return nil, fmt.Errorf("Load Balancer %q (resource group %q) not found for Outbound Rule %q", id.LoadBalancerName, id.ResourceGroup, id.OutboundRuleName)
@@ -138,7 +138,7 @@ func (r LoadBalancerOutboundRule) Destroy(ctx context.Context, client *clients.C
 		return nil, err
 	}
 
-	lb, err := client.LoadBalancers.LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
+	lb, err := client.LoadBalancers().LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Load Balancer %q (Resource Group %q)", id.LoadBalancerName, id.ResourceGroup)
 	}
@@ -159,12 +159,12 @@ func (r LoadBalancerOutboundRule) Destroy(ctx context.Context, client *clients.C
 	}
 	lb.LoadBalancerPropertiesFormat.OutboundRules = &outboundRules
 
-	future, err := client.LoadBalancers.LoadBalancersClient.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, lb)
+	future, err := client.LoadBalancers().LoadBalancersClient.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, lb)
 	if err != nil {
 		return nil, fmt.Errorf("updating Load Balancer %q (Resource Group %q): %+v", id.LoadBalancerName, id.ResourceGroup, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.LoadBalancers.LoadBalancersClient.Client); err != nil {
+	if err := future.WaitForCompletionRef(ctx, client.LoadBalancers().LoadBalancersClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for update of Load Balancer %q (Resource Group %q): %+v", id.LoadBalancerName, id.ResourceGroup, err)
 	}
 
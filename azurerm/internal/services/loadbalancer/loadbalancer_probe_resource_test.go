@@ -117,7 +117,7 @@ func (r LoadBalancerProbe) Exists(ctx context.Context, client *clients.Client, s
 		return nil, err
 	}
 
-	lb, err := client.LoadBalancers.LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
+	lb, err := client.LoadBalancers().LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(lb.Response) {
 			return nil, fmt.Errorf("Load Balancer %q (resource group %q) not found for Probe %q", id.LoadBalancerName, id.ResourceGroup, id.ProbeName)
@@ -147,7 +147,7 @@ func (r LoadBalancerProbe) Destroy(ctx context.Context, client *clients.Client,
 		return nil, err
 	}
 
-	lb, err := client.LoadBalancers.LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
+	lb, err := client.LoadBalancers().LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Load Balancer %q (Resource Group %q)", id.LoadBalancerName, id.ResourceGroup)
 	}
@@ -168,12 +168,12 @@ func (r LoadBalancerProbe) Destroy(ctx context.Context, client *clients.Client,
 	}
 	lb.LoadBalancerPropertiesFormat.Probes = &probes
 
-	future, err := client.LoadBalancers.LoadBalancersClient.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, lb)
+	future, err := client.LoadBalancers().LoadBalancersClient.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, lb)
 	if err != nil {
 		return nil, fmt.Errorf("updating Load Balancer %q (Resource Group %q): %+v", id.LoadBalancerName, id.ResourceGroup, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.LoadBalancers.LoadBalancersClient.Client); err != nil {
+	if err := future.WaitForCompletionRef(ctx, client.LoadBalancers().LoadBalancersClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for update of Load Balancer %q (Resource Group %q): %+v", id.LoadBalancerName, id.ResourceGroup, err)
 	}
 
@@ -170,7 +170,7 @@ func (r LoadBalancer) Exists(ctx context.Context, client *clients.Client, state
 	loadBalancerName := state.Attributes["name"]
 	resourceGroup := state.Attributes["resource_group_name"]
 
-	resp, err := client.LoadBalancers.LoadBalancersClient.Get(ctx, resourceGroup, loadBalancerName, "")
+	resp, err := client.LoadBalancers().LoadBalancersClient.Get(ctx, resourceGroup, loadBalancerName, "")
 	if err != nil {
 		if resp.StatusCode == http.StatusNotFound {
 			return nil, fmt.Errorf("Bad: Load Balancer %q (resource group: %q) does not exist", loadBalancerName, resourceGroup)
@@ -189,7 +189,7 @@ func (r LoadBalancerRule) Exists(ctx context.Context, client *clients.Client, st
 		return nil, err
 	}
 
-	rule, err := client.LoadBalancers.LoadBalancingRulesClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, id.Name)
+	rule, err := client.LoadBalancers().LoadBalancingRulesClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(rule.Response) {
 			return utils.Bool(false), nil
@@ -207,7 +207,7 @@ func (r LoadBalancerRule) Destroy(ctx context.Context, client *clients.Client, s
 		return nil, err
 	}
 
-	loadBalancer, err := client.LoadBalancers.LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
+	loadBalancer, err := client.LoadBalancers().LoadBalancersClient.Get(ctx, id.ResourceGroup, id.LoadBalancerName, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
 	}
@@ -227,12 +227,12 @@ func (r LoadBalancerRule) Destroy(ctx context.Context, client *clients.Client, s
 	}
 	loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules = &rules
 
-	future, err := client.LoadBalancers.LoadBalancersClient.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, loadBalancer)
+	future, err := client.LoadBalancers().LoadBalancersClient.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, loadBalancer)
 	if err != nil {
 		return nil, fmt.Errorf("updating Load Balancer %q (Resource Group %q): %+v", id.LoadBalancerName, id.ResourceGroup, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.LoadBalancers.LoadBalancersClient.Client); err != nil {
+	if err := future.WaitForCompletionRef(ctx, client.LoadBalancers().LoadBalancersClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for update of Load Balancer %q (Resource Group %q): %+v", id.LoadBalancerName, id.ResourceGroup, err)
 	}
 
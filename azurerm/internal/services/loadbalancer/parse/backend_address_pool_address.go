@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BackendAddressPoolAddressId struct {
@@ -79,3 +80,12 @@ func BackendAddressPoolAddressID(input string) (*BackendAddressPoolAddressId, er
 
 	return &resourceId, nil
 }
+
+// BackendAddressPoolAddressIDNormalized parses a BackendAddressPoolAddress ID into an BackendAddressPoolAddressId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the BackendAddressPoolAddressID method should be used instead for validation etc.
+func BackendAddressPoolAddressIDNormalized(input string) (*BackendAddressPoolAddressId, error) {
+	return BackendAddressPoolAddressID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/backendAddressPools/%s/addresses/%s"))
+}
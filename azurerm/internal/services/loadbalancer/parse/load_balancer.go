@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerId struct {
@@ -67,3 +68,12 @@ func LoadBalancerID(input string) (*LoadBalancerId, error) {
 
 	return &resourceId, nil
 }
+
+// LoadBalancerIDNormalized parses a LoadBalancer ID into an LoadBalancerId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LoadBalancerID method should be used instead for validation etc.
+func LoadBalancerIDNormalized(input string) (*LoadBalancerId, error) {
+	return LoadBalancerID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s"))
+}
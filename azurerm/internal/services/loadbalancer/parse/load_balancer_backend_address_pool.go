@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerBackendAddressPoolId struct {
@@ -73,3 +74,12 @@ func LoadBalancerBackendAddressPoolID(input string) (*LoadBalancerBackendAddress
 
 	return &resourceId, nil
 }
+
+// LoadBalancerBackendAddressPoolIDNormalized parses a LoadBalancerBackendAddressPool ID into an LoadBalancerBackendAddressPoolId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LoadBalancerBackendAddressPoolID method should be used instead for validation etc.
+func LoadBalancerBackendAddressPoolIDNormalized(input string) (*LoadBalancerBackendAddressPoolId, error) {
+	return LoadBalancerBackendAddressPoolID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/backendAddressPools/%s"))
+}
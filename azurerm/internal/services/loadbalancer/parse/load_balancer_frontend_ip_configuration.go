@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerFrontendIpConfigurationId struct {
@@ -73,3 +74,12 @@ func LoadBalancerFrontendIpConfigurationID(input string) (*LoadBalancerFrontendI
 
 	return &resourceId, nil
 }
+
+// LoadBalancerFrontendIpConfigurationIDNormalized parses a LoadBalancerFrontendIpConfiguration ID into an LoadBalancerFrontendIpConfigurationId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LoadBalancerFrontendIpConfigurationID method should be used instead for validation etc.
+func LoadBalancerFrontendIpConfigurationIDNormalized(input string) (*LoadBalancerFrontendIpConfigurationId, error) {
+	return LoadBalancerFrontendIpConfigurationID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/frontendIPConfigurations/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerInboundNatPoolId struct {
@@ -73,3 +74,12 @@ func LoadBalancerInboundNatPoolID(input string) (*LoadBalancerInboundNatPoolId,
 
 	return &resourceId, nil
 }
+
+// LoadBalancerInboundNatPoolIDNormalized parses a LoadBalancerInboundNatPool ID into an LoadBalancerInboundNatPoolId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LoadBalancerInboundNatPoolID method should be used instead for validation etc.
+func LoadBalancerInboundNatPoolIDNormalized(input string) (*LoadBalancerInboundNatPoolId, error) {
+	return LoadBalancerInboundNatPoolID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/inboundNatPools/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerInboundNatRuleId struct {
@@ -73,3 +74,12 @@ func LoadBalancerInboundNatRuleID(input string) (*LoadBalancerInboundNatRuleId,
 
 	return &resourceId, nil
 }
+
+// LoadBalancerInboundNatRuleIDNormalized parses a LoadBalancerInboundNatRule ID into an LoadBalancerInboundNatRuleId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LoadBalancerInboundNatRuleID method should be used instead for validation etc.
+func LoadBalancerInboundNatRuleIDNormalized(input string) (*LoadBalancerInboundNatRuleId, error) {
+	return LoadBalancerInboundNatRuleID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/inboundNatRules/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerOutboundRuleId struct {
@@ -73,3 +74,12 @@ func LoadBalancerOutboundRuleID(input string) (*LoadBalancerOutboundRuleId, erro
 
 	return &resourceId, nil
 }
+
+// LoadBalancerOutboundRuleIDNormalized parses a LoadBalancerOutboundRule ID into an LoadBalancerOutboundRuleId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LoadBalancerOutboundRuleID method should be used instead for validation etc.
+func LoadBalancerOutboundRuleIDNormalized(input string) (*LoadBalancerOutboundRuleId, error) {
+	return LoadBalancerOutboundRuleID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/outboundRules/%s"))
+}
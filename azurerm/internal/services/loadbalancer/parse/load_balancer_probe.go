@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerProbeId struct {
@@ -73,3 +74,12 @@ func LoadBalancerProbeID(input string) (*LoadBalancerProbeId, error) {
 
 	return &resourceId, nil
 }
+
+// LoadBalancerProbeIDNormalized parses a LoadBalancerProbe ID into an LoadBalancerProbeId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LoadBalancerProbeID method should be used instead for validation etc.
+func LoadBalancerProbeIDNormalized(input string) (*LoadBalancerProbeId, error) {
+	return LoadBalancerProbeID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/probes/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancingRuleId struct {
@@ -73,3 +74,12 @@ func LoadBalancingRuleID(input string) (*LoadBalancingRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// LoadBalancingRuleIDNormalized parses a LoadBalancingRule ID into an LoadBalancingRuleId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LoadBalancingRuleID method should be used instead for validation etc.
+func LoadBalancingRuleIDNormalized(input string) (*LoadBalancingRuleId, error) {
+	return LoadBalancingRuleID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/loadBalancingRules/%s"))
+}
@@ -96,7 +96,7 @@ func dataSourceArmLoadBalancerRule() *schema.Resource {
 }
 
 func dataSourceArmLoadBalancerRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LoadBalancers.LoadBalancersClient
+	client := meta.(*clients.Client).LoadBalancers().LoadBalancersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -117,7 +117,7 @@ func dataSourceArmLoadBalancerRuleRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("failed to retrieve Load Balancer %q (resource group %q) for Rule %q: %+v", loadBalancerId.Name, loadBalancerId.ResourceGroup, name, err)
 	}
 
-	lbRuleClient := meta.(*clients.Client).LoadBalancers.LoadBalancingRulesClient
+	lbRuleClient := meta.(*clients.Client).LoadBalancers().LoadBalancingRulesClient
 	ctx, cancel = timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
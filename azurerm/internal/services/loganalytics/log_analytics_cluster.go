@@ -23,7 +23,7 @@ func logAnalyticsClusterWaitForState(ctx context.Context, meta interface{}, time
 
 func logAnalyticsClusterRefresh(ctx context.Context, meta interface{}, resourceGroup string, clusterName string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		client := meta.(*clients.Client).LogAnalytics.ClusterClient
+		client := meta.(*clients.Client).LogAnalytics().ClusterClient
 
 		log.Printf("[INFO] checking on state of Log Analytics Cluster %q", clusterName)
 
@@ -54,7 +54,7 @@ func resourceLogAnalyticsClusterCustomerManagedKey() *schema.Resource {
 }
 
 func resourceLogAnalyticsClusterCustomerManagedKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.ClusterClient
+	client := meta.(*clients.Client).LogAnalytics().ClusterClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -83,7 +83,7 @@ func resourceLogAnalyticsClusterCustomerManagedKeyCreate(d *schema.ResourceData,
 }
 
 func resourceLogAnalyticsClusterCustomerManagedKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.ClusterClient
+	client := meta.(*clients.Client).LogAnalytics().ClusterClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -121,7 +121,7 @@ func resourceLogAnalyticsClusterCustomerManagedKeyUpdate(d *schema.ResourceData,
 }
 
 func resourceLogAnalyticsClusterCustomerManagedKeyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.ClusterClient
+	client := meta.(*clients.Client).LogAnalytics().ClusterClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -172,7 +172,7 @@ func resourceLogAnalyticsClusterCustomerManagedKeyRead(d *schema.ResourceData, m
 }
 
 func resourceLogAnalyticsClusterCustomerManagedKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.ClusterClient
+	client := meta.(*clients.Client).LogAnalytics().ClusterClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -48,7 +48,7 @@ func (t LogAnalyticsClusterCustomerManagedKeyResource) Exists(ctx context.Contex
 		return nil, err
 	}
 
-	resp, err := clients.LogAnalytics.ClusterClient.Get(ctx, id.ResourceGroup, id.ClusterName)
+	resp, err := clients.LogAnalytics().ClusterClient.Get(ctx, id.ResourceGroup, id.ClusterName)
 	if err != nil {
 		return nil, fmt.Errorf("readingLog Analytics Cluster Customer Managed Key (%s): %+v", id.String(), err)
 	}
@@ -104,7 +104,7 @@ func resourceLogAnalyticsCluster() *schema.Resource {
 }
 
 func resourceLogAnalyticsClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.ClusterClient
+	client := meta.(*clients.Client).LogAnalytics().ClusterClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -158,7 +158,7 @@ func resourceLogAnalyticsClusterCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLogAnalyticsClusterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.ClusterClient
+	client := meta.(*clients.Client).LogAnalytics().ClusterClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -198,7 +198,7 @@ func resourceLogAnalyticsClusterRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceLogAnalyticsClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.ClusterClient
+	client := meta.(*clients.Client).LogAnalytics().ClusterClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -216,7 +216,7 @@ func resourceLogAnalyticsClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -238,7 +238,7 @@ func resourceLogAnalyticsClusterUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLogAnalyticsClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.ClusterClient
+	client := meta.(*clients.Client).LogAnalytics().ClusterClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -91,7 +91,7 @@ func (t LogAnalyticsClusterResource) Exists(ctx context.Context, clients *client
 		return nil, err
 	}
 
-	resp, err := clients.LogAnalytics.ClusterClient.Get(ctx, id.ResourceGroup, id.ClusterName)
+	resp, err := clients.LogAnalytics().ClusterClient.Get(ctx, id.ResourceGroup, id.ClusterName)
 	if err != nil {
 		return nil, fmt.Errorf("readingLog Analytics Cluster (%s): %+v", id.String(), err)
 	}
@@ -84,7 +84,7 @@ func resourceLogAnalyticsDataExport() *schema.Resource {
 }
 
 func resourceOperationalinsightsDataExportCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.DataExportClient
+	client := meta.(*clients.Client).LogAnalytics().DataExportClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -135,7 +135,7 @@ func resourceOperationalinsightsDataExportCreateUpdate(d *schema.ResourceData, m
 }
 
 func resourceOperationalinsightsDataExportRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.DataExportClient
+	client := meta.(*clients.Client).LogAnalytics().DataExportClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -166,7 +166,7 @@ func resourceOperationalinsightsDataExportRead(d *schema.ResourceData, meta inte
 }
 
 func resourceOperationalinsightsDataExportDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.DataExportClient
+	client := meta.(*clients.Client).LogAnalytics().DataExportClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
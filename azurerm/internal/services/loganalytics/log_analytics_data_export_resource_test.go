@@ -100,7 +100,7 @@ func (t LogAnalyticsDataExportRuleResource) Exists(ctx context.Context, clients
 		return nil, err
 	}
 
-	resp, err := clients.LogAnalytics.DataExportClient.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.DataexportName)
+	resp, err := clients.LogAnalytics().DataExportClient.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.DataexportName)
 	if err != nil {
 		return nil, fmt.Errorf("readingLog Analytics Data Export (%s): %+v", id.String(), err)
 	}
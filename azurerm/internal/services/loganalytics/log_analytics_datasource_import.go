@@ -17,7 +17,7 @@ func importLogAnalyticsDataSource(kind operationalinsights.DataSourceKind) func(
 			return nil, err
 		}
 
-		client := meta.(*clients.Client).LogAnalytics.DataSourcesClient
+		client := meta.(*clients.Client).LogAnalytics().DataSourcesClient
 		ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 		defer cancel()
 
@@ -95,7 +95,7 @@ type dataSourceWindowsEventEventType struct {
 }
 
 func resourceLogAnalyticsDataSourceWindowsEventCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.DataSourcesClient
+	client := meta.(*clients.Client).LogAnalytics().DataSourcesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -141,7 +141,7 @@ func resourceLogAnalyticsDataSourceWindowsEventCreateUpdate(d *schema.ResourceDa
 }
 
 func resourceLogAnalyticsDataSourceWindowsEventRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.DataSourcesClient
+	client := meta.(*clients.Client).LogAnalytics().DataSourcesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -183,7 +183,7 @@ func resourceLogAnalyticsDataSourceWindowsEventRead(d *schema.ResourceData, meta
 }
 
 func resourceLogAnalyticsDataSourceWindowsEventDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.DataSourcesClient
+	client := meta.(*clients.Client).LogAnalytics().DataSourcesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -95,7 +95,7 @@ type dataSourceWindowsPerformanceCounterProperty struct {
 }
 
 func resourceLogAnalyticsDataSourceWindowsPerformanceCounterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.DataSourcesClient
+	client := meta.(*clients.Client).LogAnalytics().DataSourcesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -147,7 +147,7 @@ func resourceLogAnalyticsDataSourceWindowsPerformanceCounterCreateUpdate(d *sche
 }
 
 func resourceLogAnalyticsDataSourceWindowsPerformanceCounterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.DataSourcesClient
+	client := meta.(*clients.Client).LogAnalytics().DataSourcesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -191,7 +191,7 @@ func resourceLogAnalyticsDataSourceWindowsPerformanceCounterRead(d *schema.Resou
 }
 
 func resourceLogAnalyticsDataSourceWindowsPerformanceCounterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.DataSourcesClient
+	client := meta.(*clients.Client).LogAnalytics().DataSourcesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
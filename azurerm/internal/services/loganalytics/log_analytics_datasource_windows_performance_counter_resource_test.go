@@ -106,7 +106,7 @@ func (t LogAnalyticsDataSourceWindowsPerformanceCounterResource) Exists(ctx cont
 		return nil, err
 	}
 
-	resp, err := clients.LogAnalytics.DataSourcesClient.Get(ctx, id.ResourceGroup, id.Workspace, id.Name)
+	resp, err := clients.LogAnalytics().DataSourcesClient.Get(ctx, id.ResourceGroup, id.Workspace, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("readingLog Analytics Data Source Windows Event (%s): %+v", id, err)
 	}
@@ -22,7 +22,7 @@ func logAnalyticsLinkedServiceDeleteWaitForState(ctx context.Context, meta inter
 
 func logAnalyticsLinkedServiceRefresh(ctx context.Context, meta interface{}, resourceGroup string, workspaceName string, serviceType string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		client := meta.(*clients.Client).LogAnalytics.LinkedServicesClient
+		client := meta.(*clients.Client).LogAnalytics().LinkedServicesClient
 
 		log.Printf("[INFO] checking on state of Log Analytics Linked Service '%s/%s' (Resource Group %q)", workspaceName, serviceType, resourceGroup)
 
@@ -199,7 +199,7 @@ func resourceLogAnalyticsLinkedService() *schema.Resource {
 }
 
 func resourceLogAnalyticsLinkedServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.LinkedServicesClient
+	client := meta.(*clients.Client).LogAnalytics().LinkedServicesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -293,7 +293,7 @@ func resourceLogAnalyticsLinkedServiceCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceLogAnalyticsLinkedServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.LinkedServicesClient
+	client := meta.(*clients.Client).LogAnalytics().LinkedServicesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -333,7 +333,7 @@ func resourceLogAnalyticsLinkedServiceRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceLogAnalyticsLinkedServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.LinkedServicesClient
+	client := meta.(*clients.Client).LogAnalytics().LinkedServicesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
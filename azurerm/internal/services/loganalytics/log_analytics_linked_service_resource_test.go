@@ -115,7 +115,7 @@ func (t LogAnalyticsLinkedServiceResource) Exists(ctx context.Context, clients *
 	workspaceName := id.Path["workspaces"]
 	serviceType := id.Path["linkedServices"]
 
-	resp, err := clients.LogAnalytics.LinkedServicesClient.Get(ctx, resourceGroup, workspaceName, serviceType)
+	resp, err := clients.LogAnalytics().LinkedServicesClient.Get(ctx, resourceGroup, workspaceName, serviceType)
 	if err != nil {
 		return nil, fmt.Errorf("readingLog Analytics Linked Service (%s): %+v", id, err)
 	}
@@ -77,7 +77,7 @@ func resourceLogAnalyticsLinkedStorageAccount() *schema.Resource {
 }
 
 func resourceLogAnalyticsLinkedStorageAccountCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.LinkedStorageAccountClient
+	client := meta.(*clients.Client).LogAnalytics().LinkedStorageAccountClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -123,7 +123,7 @@ func resourceLogAnalyticsLinkedStorageAccountCreateUpdate(d *schema.ResourceData
 }
 
 func resourceLogAnalyticsLinkedStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.LinkedStorageAccountClient
+	client := meta.(*clients.Client).LogAnalytics().LinkedStorageAccountClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -154,7 +154,7 @@ func resourceLogAnalyticsLinkedStorageAccountRead(d *schema.ResourceData, meta i
 }
 
 func resourceLogAnalyticsLinkedStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.LinkedStorageAccountClient
+	client := meta.(*clients.Client).LogAnalytics().LinkedStorageAccountClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -95,7 +95,7 @@ func (t LogAnalyticsLinkedStorageAccountResource) Exists(ctx context.Context, cl
 	}
 	dataSourceType := operationalinsights.DataSourceType(id.LinkedStorageAccountName)
 
-	resp, err := clients.LogAnalytics.LinkedStorageAccountClient.Get(ctx, id.ResourceGroup, id.WorkspaceName, dataSourceType)
+	resp, err := clients.LogAnalytics().LinkedStorageAccountClient.Get(ctx, id.ResourceGroup, id.WorkspaceName, dataSourceType)
 	if err != nil {
 		return nil, fmt.Errorf("readingLog Analytics Linked Service Storage Account (%s): %+v", id, err)
 	}
@@ -102,7 +102,7 @@ func resourceLogAnalyticsSavedSearch() *schema.Resource {
 }
 
 func resourceLogAnalyticsSavedSearchCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.SavedSearchesClient
+	client := meta.(*clients.Client).LogAnalytics().SavedSearchesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Log Analytics Saved Search creation.")
@@ -167,7 +167,7 @@ func resourceLogAnalyticsSavedSearchCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceLogAnalyticsSavedSearchRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.SavedSearchesClient
+	client := meta.(*clients.Client).LogAnalytics().SavedSearchesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -211,7 +211,7 @@ func resourceLogAnalyticsSavedSearchRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceLogAnalyticsSavedSearchDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.SavedSearchesClient
+	client := meta.(*clients.Client).LogAnalytics().SavedSearchesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	// FIXME: @favoretti: API returns ID without a leading slash
@@ -88,7 +88,7 @@ func (t LogAnalyticsSavedSearchResource) Exists(ctx context.Context, clients *cl
 		return nil, err
 	}
 
-	resp, err := clients.LogAnalytics.SavedSearchesClient.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.SavedSearcheName)
+	resp, err := clients.LogAnalytics().SavedSearchesClient.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.SavedSearcheName)
 	if err != nil {
 		return nil, fmt.Errorf("readingLog Analytics Linked Service Saved Search (%s): %+v", id, err)
 	}
@@ -100,7 +100,7 @@ func resourceLogAnalyticsSolution() *schema.Resource {
 }
 
 func resourceLogAnalyticsSolutionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.SolutionsClient
+	client := meta.(*clients.Client).LogAnalytics().SolutionsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Log Analytics Solution creation.")
@@ -163,7 +163,7 @@ func resourceLogAnalyticsSolutionCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceLogAnalyticsSolutionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.SolutionsClient
+	client := meta.(*clients.Client).LogAnalytics().SolutionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	id, err := azure.ParseAzureResourceID(d.Id())
@@ -226,7 +226,7 @@ func resourceLogAnalyticsSolutionRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLogAnalyticsSolutionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.SolutionsClient
+	client := meta.(*clients.Client).LogAnalytics().SolutionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	id, err := azure.ParseAzureResourceID(d.Id())
@@ -75,7 +75,7 @@ func (t LogAnalyticsSolutionResource) Exists(ctx context.Context, clients *clien
 	resGroup := id.ResourceGroup
 	name := id.Path["solutions"]
 
-	resp, err := clients.LogAnalytics.SolutionsClient.Get(ctx, resGroup, name)
+	resp, err := clients.LogAnalytics().SolutionsClient.Get(ctx, resGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Log Analytics Solution (%s): %+v", id, err)
 	}
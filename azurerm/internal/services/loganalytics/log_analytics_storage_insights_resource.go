@@ -95,7 +95,7 @@ func resourceLogAnalyticsStorageInsights() *schema.Resource {
 }
 
 func resourceLogAnalyticsStorageInsightsCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.StorageInsightsClient
+	client := meta.(*clients.Client).LogAnalytics().StorageInsightsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -147,7 +147,7 @@ func resourceLogAnalyticsStorageInsightsCreateUpdate(d *schema.ResourceData, met
 }
 
 func resourceLogAnalyticsStorageInsightsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.StorageInsightsClient
+	client := meta.(*clients.Client).LogAnalytics().StorageInsightsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -184,7 +184,7 @@ func resourceLogAnalyticsStorageInsightsRead(d *schema.ResourceData, meta interf
 }
 
 func resourceLogAnalyticsStorageInsightsDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.StorageInsightsClient
+	client := meta.(*clients.Client).LogAnalytics().StorageInsightsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -114,7 +114,7 @@ func (t LogAnalyticsStorageInsightsResource) Exists(ctx context.Context, clients
 		return nil, err
 	}
 
-	resp, err := clients.LogAnalytics.StorageInsightsClient.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.StorageInsightConfigName)
+	resp, err := clients.LogAnalytics().StorageInsightsClient.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.StorageInsightConfigName)
 	if err != nil {
 		return nil, fmt.Errorf("readingLog Analytics Storage Insights (%s): %+v", id.String(), err)
 	}
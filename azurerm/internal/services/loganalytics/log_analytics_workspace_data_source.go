@@ -77,8 +77,8 @@ func dataSourceLogAnalyticsWorkspace() *schema.Resource {
 }
 
 func dataSourceLogAnalyticsWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.WorkspacesClient
-	sharedKeysClient := meta.(*clients.Client).LogAnalytics.SharedKeysClient
+	client := meta.(*clients.Client).LogAnalytics().WorkspacesClient
+	sharedKeysClient := meta.(*clients.Client).LogAnalytics().SharedKeysClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
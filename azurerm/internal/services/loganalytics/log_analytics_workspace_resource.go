@@ -140,7 +140,7 @@ func resourceLogAnalyticsWorkspace() *schema.Resource {
 }
 
 func resourceLogAnalyticsWorkspaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.WorkspacesClient
+	client := meta.(*clients.Client).LogAnalytics().WorkspacesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -245,8 +245,8 @@ func resourceLogAnalyticsWorkspaceCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceLogAnalyticsWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.WorkspacesClient
-	sharedKeysClient := meta.(*clients.Client).LogAnalytics.SharedKeysClient
+	client := meta.(*clients.Client).LogAnalytics().WorkspacesClient
+	sharedKeysClient := meta.(*clients.Client).LogAnalytics().SharedKeysClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	id, err := parse.LogAnalyticsWorkspaceID(d.Id())
@@ -303,7 +303,7 @@ func resourceLogAnalyticsWorkspaceRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLogAnalyticsWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).LogAnalytics.WorkspacesClient
+	client := meta.(*clients.Client).LogAnalytics().WorkspacesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	id, err := parse.LogAnalyticsWorkspaceID(d.Id())
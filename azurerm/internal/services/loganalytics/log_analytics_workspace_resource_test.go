@@ -199,7 +199,7 @@ func (t LogAnalyticsWorkspaceResource) Exists(ctx context.Context, clients *clie
 		return nil, err
 	}
 
-	resp, err := clients.LogAnalytics.WorkspacesClient.Get(ctx, id.ResourceGroup, id.WorkspaceName)
+	resp, err := clients.LogAnalytics().WorkspacesClient.Get(ctx, id.ResourceGroup, id.WorkspaceName)
 	if err != nil {
 		return nil, fmt.Errorf("readingLog Analytics Workspace (%s): %+v", id.String(), err)
 	}
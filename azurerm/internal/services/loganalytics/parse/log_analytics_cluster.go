@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsClusterId struct {
@@ -67,3 +68,12 @@ func LogAnalyticsClusterID(input string) (*LogAnalyticsClusterId, error) {
 
 	return &resourceId, nil
 }
+
+// LogAnalyticsClusterIDNormalized parses a LogAnalyticsCluster ID into an LogAnalyticsClusterId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LogAnalyticsClusterID method should be used instead for validation etc.
+func LogAnalyticsClusterIDNormalized(input string) (*LogAnalyticsClusterId, error) {
+	return LogAnalyticsClusterID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/clusters/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsDataExportId struct {
@@ -73,3 +74,12 @@ func LogAnalyticsDataExportID(input string) (*LogAnalyticsDataExportId, error) {
 
 	return &resourceId, nil
 }
+
+// LogAnalyticsDataExportIDNormalized parses a LogAnalyticsDataExport ID into an LogAnalyticsDataExportId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LogAnalyticsDataExportID method should be used instead for validation etc.
+func LogAnalyticsDataExportIDNormalized(input string) (*LogAnalyticsDataExportId, error) {
+	return LogAnalyticsDataExportID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/dataexports/%s"))
+}
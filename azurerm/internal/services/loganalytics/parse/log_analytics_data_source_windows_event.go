@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsDataSourceWindowsEventId struct {
@@ -73,3 +74,12 @@ func LogAnalyticsDataSourceWindowsEventID(input string) (*LogAnalyticsDataSource
 
 	return &resourceId, nil
 }
+
+// LogAnalyticsDataSourceWindowsEventIDNormalized parses a LogAnalyticsDataSourceWindowsEvent ID into an LogAnalyticsDataSourceWindowsEventId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LogAnalyticsDataSourceWindowsEventID method should be used instead for validation etc.
+func LogAnalyticsDataSourceWindowsEventIDNormalized(input string) (*LogAnalyticsDataSourceWindowsEventId, error) {
+	return LogAnalyticsDataSourceWindowsEventID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/dataSources/%s"))
+}
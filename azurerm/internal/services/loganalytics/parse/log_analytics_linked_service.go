@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsLinkedServiceId struct {
@@ -73,3 +74,12 @@ func LogAnalyticsLinkedServiceID(input string) (*LogAnalyticsLinkedServiceId, er
 
 	return &resourceId, nil
 }
+
+// LogAnalyticsLinkedServiceIDNormalized parses a LogAnalyticsLinkedService ID into an LogAnalyticsLinkedServiceId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LogAnalyticsLinkedServiceID method should be used instead for validation etc.
+func LogAnalyticsLinkedServiceIDNormalized(input string) (*LogAnalyticsLinkedServiceId, error) {
+	return LogAnalyticsLinkedServiceID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/linkedServices/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsLinkedStorageAccountId struct {
@@ -73,3 +74,12 @@ func LogAnalyticsLinkedStorageAccountID(input string) (*LogAnalyticsLinkedStorag
 
 	return &resourceId, nil
 }
+
+// LogAnalyticsLinkedStorageAccountIDNormalized parses a LogAnalyticsLinkedStorageAccount ID into an LogAnalyticsLinkedStorageAccountId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LogAnalyticsLinkedStorageAccountID method should be used instead for validation etc.
+func LogAnalyticsLinkedStorageAccountIDNormalized(input string) (*LogAnalyticsLinkedStorageAccountId, error) {
+	return LogAnalyticsLinkedStorageAccountID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/linkedStorageAccounts/%s"))
+}
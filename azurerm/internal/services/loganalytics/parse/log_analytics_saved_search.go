@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsSavedSearchId struct {
@@ -73,3 +74,12 @@ func LogAnalyticsSavedSearchID(input string) (*LogAnalyticsSavedSearchId, error)
 
 	return &resourceId, nil
 }
+
+// LogAnalyticsSavedSearchIDNormalized parses a LogAnalyticsSavedSearch ID into an LogAnalyticsSavedSearchId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LogAnalyticsSavedSearchID method should be used instead for validation etc.
+func LogAnalyticsSavedSearchIDNormalized(input string) (*LogAnalyticsSavedSearchId, error) {
+	return LogAnalyticsSavedSearchID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/savedSearches/%s"))
+}
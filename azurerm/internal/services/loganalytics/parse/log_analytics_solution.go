@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsSolutionId struct {
@@ -67,3 +68,12 @@ func LogAnalyticsSolutionID(input string) (*LogAnalyticsSolutionId, error) {
 
 	return &resourceId, nil
 }
+
+// LogAnalyticsSolutionIDNormalized parses a LogAnalyticsSolution ID into an LogAnalyticsSolutionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LogAnalyticsSolutionID method should be used instead for validation etc.
+func LogAnalyticsSolutionIDNormalized(input string) (*LogAnalyticsSolutionId, error) {
+	return LogAnalyticsSolutionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationsManagement/solutions/%s"))
+}
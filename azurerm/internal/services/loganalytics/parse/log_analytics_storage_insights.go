@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsStorageInsightsId struct {
@@ -73,3 +74,12 @@ func LogAnalyticsStorageInsightsID(input string) (*LogAnalyticsStorageInsightsId
 
 	return &resourceId, nil
 }
+
+// LogAnalyticsStorageInsightsIDNormalized parses a LogAnalyticsStorageInsights ID into an LogAnalyticsStorageInsightsId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LogAnalyticsStorageInsightsID method should be used instead for validation etc.
+func LogAnalyticsStorageInsightsIDNormalized(input string) (*LogAnalyticsStorageInsightsId, error) {
+	return LogAnalyticsStorageInsightsID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/storageInsightConfigs/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsWorkspaceId struct {
@@ -67,3 +68,12 @@ func LogAnalyticsWorkspaceID(input string) (*LogAnalyticsWorkspaceId, error) {
 
 	return &resourceId, nil
 }
+
+// LogAnalyticsWorkspaceIDNormalized parses a LogAnalyticsWorkspace ID into an LogAnalyticsWorkspaceId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LogAnalyticsWorkspaceID method should be used instead for validation etc.
+func LogAnalyticsWorkspaceIDNormalized(input string) (*LogAnalyticsWorkspaceId, error) {
+	return LogAnalyticsWorkspaceID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s"))
+}
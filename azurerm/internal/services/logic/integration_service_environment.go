@@ -139,7 +139,7 @@ func resourceIntegrationServiceEnvironment() *schema.Resource {
 }
 
 func resourceIntegrationServiceEnvironmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Logic.IntegrationServiceEnvironmentClient
+	client := meta.(*clients.Client).Logic().IntegrationServiceEnvironmentClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -210,7 +210,7 @@ func resourceIntegrationServiceEnvironmentCreateUpdate(d *schema.ResourceData, m
 }
 
 func resourceIntegrationServiceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Logic.IntegrationServiceEnvironmentClient
+	client := meta.(*clients.Client).Logic().IntegrationServiceEnvironmentClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -269,7 +269,7 @@ func resourceIntegrationServiceEnvironmentRead(d *schema.ResourceData, meta inte
 }
 
 func resourceIntegrationServiceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Logic.IntegrationServiceEnvironmentClient
+	client := meta.(*clients.Client).Logic().IntegrationServiceEnvironmentClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -429,7 +429,7 @@ func linkExists(ctx context.Context, client *clients.Client, iseID string, subne
 		id := *(subnetID.(*string))
 		log.Printf("Checking links on subnetID: %q\n", id)
 
-		hasLink, err := serviceAssociationLinkExists(ctx, client.Network.ServiceAssociationLinkClient, iseID, id)
+		hasLink, err := serviceAssociationLinkExists(ctx, client.Network().ServiceAssociationLinkClient, iseID, id)
 		if err != nil {
 			return false, err
 		}
@@ -437,7 +437,7 @@ func linkExists(ctx context.Context, client *clients.Client, iseID string, subne
 		if hasLink {
 			return true, nil
 		} else {
-			hasLink, err := resourceNavigationLinkExists(ctx, client.Network.ResourceNavigationLinkClient, id)
+			hasLink, err := resourceNavigationLinkExists(ctx, client.Network().ResourceNavigationLinkClient, id)
 			if err != nil {
 				return false, err
 			}
@@ -191,7 +191,7 @@ func (IntegrationServiceEnvironmentResource) Exists(ctx context.Context, clients
 		return nil, err
 	}
 
-	resp, err := clients.Logic.IntegrationServiceEnvironmentClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Logic().IntegrationServiceEnvironmentClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Integration Service Environment %s (resource group: %s): %v", id.Name, id.ResourceGroup, err)
 	}
@@ -42,7 +42,7 @@ func dataSourceLogicAppIntegrationAccount() *schema.Resource {
 }
 
 func dataSourceLogicAppIntegrationAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Logic.IntegrationAccountClient
+	client := meta.(*clients.Client).Logic().IntegrationAccountClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -66,7 +66,7 @@ func resourceLogicAppIntegrationAccount() *schema.Resource {
 }
 
 func resourceLogicAppIntegrationAccountCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Logic.IntegrationAccountClient
+	client := meta.(*clients.Client).Logic().IntegrationAccountClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -113,7 +113,7 @@ func resourceLogicAppIntegrationAccountCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceLogicAppIntegrationAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Logic.IntegrationAccountClient
+	client := meta.(*clients.Client).Logic().IntegrationAccountClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -135,7 +135,7 @@ func resourceLogicAppIntegrationAccountRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceLogicAppIntegrationAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Logic.IntegrationAccountClient
+	client := meta.(*clients.Client).Logic().IntegrationAccountClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -103,7 +103,7 @@ func (LogicAppIntegrationAccountResource) Exists(ctx context.Context, clients *c
 		return nil, err
 	}
 
-	resp, err := clients.Logic.IntegrationAccountClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Logic().IntegrationAccountClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Logic App Workflow %s (resource group: %s): %v", id.Name, id.ResourceGroup, err)
 	}
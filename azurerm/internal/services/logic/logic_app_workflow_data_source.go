@@ -88,7 +88,7 @@ func dataSourceLogicAppWorkflow() *schema.Resource {
 }
 
 func dataSourceLogicAppWorkflowRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Logic.WorkflowClient
+	client := meta.(*clients.Client).Logic().WorkflowClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -124,7 +124,7 @@ func resourceLogicAppWorkflow() *schema.Resource {
 }
 
 func resourceLogicAppWorkflowCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Logic.WorkflowClient
+	client := meta.(*clients.Client).Logic().WorkflowClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -197,7 +197,7 @@ func resourceLogicAppWorkflowCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceLogicAppWorkflowUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Logic.WorkflowClient
+	client := meta.(*clients.Client).Logic().WorkflowClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -210,7 +210,9 @@ func resourceLogicAppWorkflowUpdate(d *schema.ResourceData, meta interface{}) er
 	name := id.Path["workflows"]
 
 	// lock to prevent against Actions, Parameters or Triggers conflicting
-	locks.ByName(name, logicAppResourceName)
+	if err := locks.ByNameWithContext(ctx, name, logicAppResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Logic App Workflow %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, logicAppResourceName)
 
 	read, err := client.Get(ctx, resourceGroup, name)
@@ -254,7 +256,7 @@ func resourceLogicAppWorkflowUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceLogicAppWorkflowRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Logic.WorkflowClient
+	client := meta.(*clients.Client).Logic().WorkflowClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -333,7 +335,7 @@ func resourceLogicAppWorkflowRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceLogicAppWorkflowDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Logic.WorkflowClient
+	client := meta.(*clients.Client).Logic().WorkflowClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -345,7 +347,9 @@ func resourceLogicAppWorkflowDelete(d *schema.ResourceData, meta interface{}) er
 	name := id.Path["workflows"]
 
 	// lock to prevent against Actions, Parameters or Triggers conflicting
-	locks.ByName(name, logicAppResourceName)
+	if err := locks.ByNameWithContext(ctx, name, logicAppResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Logic App Workflow %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, logicAppResourceName)
 
 	resp, err := client.Delete(ctx, resourceGroup, name)
@@ -142,7 +142,7 @@ func (LogicAppWorkflowResource) Exists(ctx context.Context, clients *clients.Cli
 
 	name := id.Path["workflows"]
 
-	resp, err := clients.Logic.WorkflowClient.Get(ctx, id.ResourceGroup, name)
+	resp, err := clients.Logic().WorkflowClient.Get(ctx, id.ResourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Logic App Workflow %s (resource group: %s): %v", name, id.ResourceGroup, err)
 	}
@@ -52,7 +52,7 @@ func resourceLogicAppTriggerUpdate(d *schema.ResourceData, meta interface{}, log
 }
 
 func resourceLogicAppComponentUpdate(d *schema.ResourceData, meta interface{}, kind string, propertyName string, logicAppId string, name string, vals map[string]interface{}, resourceName string) error {
-	client := meta.(*clients.Client).Logic.WorkflowClient
+	client := meta.(*clients.Client).Logic().WorkflowClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -67,7 +67,9 @@ func resourceLogicAppComponentUpdate(d *schema.ResourceData, meta interface{}, k
 	log.Printf("[DEBUG] Preparing arguments for Logic App Workspace %q (Resource Group %q) %s %q", logicAppName, resourceGroup, kind, name)
 
 	// lock to prevent against Actions or Triggers conflicting
-	locks.ByName(logicAppName, logicAppResourceName)
+	if err := locks.ByNameWithContext(ctx, logicAppName, logicAppResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s %q (Logic App Workflow %q / Resource Group %q): %+v", kind, name, logicAppName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(logicAppName, logicAppResourceName)
 
 	read, err := client.Get(ctx, resourceGroup, logicAppName)
@@ -130,14 +132,16 @@ func resourceLogicAppTriggerRemove(d *schema.ResourceData, meta interface{}, res
 }
 
 func resourceLogicAppComponentRemove(d *schema.ResourceData, meta interface{}, kind, propertyName, resourceGroup, logicAppName, name string) error {
-	client := meta.(*clients.Client).Logic.WorkflowClient
+	client := meta.(*clients.Client).Logic().WorkflowClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	log.Printf("[DEBUG] Preparing arguments for Logic App Workspace %q (Resource Group %q) %s %q Deletion", logicAppName, resourceGroup, kind, name)
 
 	// lock to prevent against Actions, Parameters or Actions conflicting
-	locks.ByName(logicAppName, logicAppResourceName)
+	if err := locks.ByNameWithContext(ctx, logicAppName, logicAppResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s %q (Logic App Workflow %q / Resource Group %q): %+v", kind, name, logicAppName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(logicAppName, logicAppResourceName)
 
 	read, err := client.Get(ctx, resourceGroup, logicAppName)
@@ -188,14 +192,16 @@ func retrieveLogicAppTrigger(d *schema.ResourceData, meta interface{}, resourceG
 }
 
 func retrieveLogicAppComponent(d *schema.ResourceData, meta interface{}, resourceGroup, kind, propertyName, logicAppName, name string) (*map[string]interface{}, *logic.Workflow, error) {
-	client := meta.(*clients.Client).Logic.WorkflowClient
+	client := meta.(*clients.Client).Logic().WorkflowClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	log.Printf("[DEBUG] Preparing arguments for Logic App Workspace %q (Resource Group %q) %s %q", logicAppName, resourceGroup, kind, name)
 
 	// lock to prevent against Actions, Parameters or Actions conflicting
-	locks.ByName(logicAppName, logicAppResourceName)
+	if err := locks.ByNameWithContext(ctx, logicAppName, logicAppResourceName); err != nil {
+		return nil, nil, err
+	}
 	defer locks.UnlockByName(logicAppName, logicAppResourceName)
 
 	read, err := client.Get(ctx, resourceGroup, logicAppName)
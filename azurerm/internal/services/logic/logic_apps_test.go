@@ -28,7 +28,7 @@ func componentExists(ctx context.Context, clients *clients.Client, state *terraf
 	workflowName := id.Path["workflows"]
 	componentName := id.Path[propertyName]
 
-	resp, err := clients.Logic.WorkflowClient.Get(ctx, id.ResourceGroup, workflowName)
+	resp, err := clients.Logic().WorkflowClient.Get(ctx, id.ResourceGroup, workflowName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Logic App Workflow %s %s (resource group: %s): %v", kind, workflowName, id.ResourceGroup, err)
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type IntegrationAccountId struct {
@@ -67,3 +68,12 @@ func IntegrationAccountID(input string) (*IntegrationAccountId, error) {
 
 	return &resourceId, nil
 }
+
+// IntegrationAccountIDNormalized parses a IntegrationAccount ID into an IntegrationAccountId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the IntegrationAccountID method should be used instead for validation etc.
+func IntegrationAccountIDNormalized(input string) (*IntegrationAccountId, error) {
+	return IntegrationAccountID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Logic/integrationAccounts/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type IntegrationServiceEnvironmentId struct {
@@ -67,3 +68,12 @@ func IntegrationServiceEnvironmentID(input string) (*IntegrationServiceEnvironme
 
 	return &resourceId, nil
 }
+
+// IntegrationServiceEnvironmentIDNormalized parses a IntegrationServiceEnvironment ID into an IntegrationServiceEnvironmentId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the IntegrationServiceEnvironmentID method should be used instead for validation etc.
+func IntegrationServiceEnvironmentIDNormalized(input string) (*IntegrationServiceEnvironmentId, error) {
+	return IntegrationServiceEnvironmentID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Logic/integrationServiceEnvironments/%s"))
+}
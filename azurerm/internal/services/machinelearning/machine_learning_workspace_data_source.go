@@ -60,7 +60,7 @@ func dataSourceMachineLearningWorkspace() *schema.Resource {
 }
 
 func dataSourceArmAMLWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MachineLearning.WorkspacesClient
+	client := meta.(*clients.Client).MachineLearning().WorkspacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
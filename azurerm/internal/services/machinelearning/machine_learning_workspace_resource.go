@@ -149,7 +149,7 @@ func resourceMachineLearningWorkspace() *schema.Resource {
 }
 
 func resourceMachineLearningWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MachineLearning.WorkspacesClient
+	client := meta.(*clients.Client).MachineLearning().WorkspacesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -206,12 +206,12 @@ func resourceMachineLearningWorkspaceCreate(d *schema.ResourceData, meta interfa
 		workspace.HbiWorkspace = utils.Bool(v.(bool))
 	}
 
-	accountsClient := meta.(*clients.Client).Storage.AccountsClient
+	accountsClient := meta.(*clients.Client).Storage().AccountsClient
 	if err := validateStorageAccount(ctx, accountsClient, storageAccountId); err != nil {
 		return fmt.Errorf("Error creating Machine Learning Workspace %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	registriesClient := meta.(*clients.Client).Containers.RegistriesClient
+	registriesClient := meta.(*clients.Client).Containers().RegistriesClient
 	if err := validateContainerRegistry(ctx, registriesClient, workspace.ContainerRegistry); err != nil {
 		return fmt.Errorf("Error creating Machine Learning Workspace %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -240,7 +240,7 @@ func resourceMachineLearningWorkspaceCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceMachineLearningWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MachineLearning.WorkspacesClient
+	client := meta.(*clients.Client).MachineLearning().WorkspacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -287,7 +287,7 @@ func resourceMachineLearningWorkspaceRead(d *schema.ResourceData, meta interface
 }
 
 func resourceMachineLearningWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MachineLearning.WorkspacesClient
+	client := meta.(*clients.Client).MachineLearning().WorkspacesClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -316,7 +316,7 @@ func resourceMachineLearningWorkspaceUpdate(d *schema.ResourceData, meta interfa
 		update.WorkspacePropertiesUpdateParameters.FriendlyName = utils.String(d.Get("friendly_name").(string))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -328,7 +328,7 @@ func resourceMachineLearningWorkspaceUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceMachineLearningWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MachineLearning.WorkspacesClient
+	client := meta.(*clients.Client).MachineLearning().WorkspacesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -134,7 +134,7 @@ func TestAccMachineLearningWorkspace_completeUpdate(t *testing.T) {
 }
 
 func (r WorkspaceResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	workspacesClient := client.MachineLearning.WorkspacesClient
+	workspacesClient := client.MachineLearning().WorkspacesClient
 	id, err := parse.WorkspaceID(state.ID)
 	if err != nil {
 		return nil, err
@@ -62,7 +62,7 @@ func resourceArmMaintenanceAssignmentDedicatedHost() *schema.Resource {
 }
 
 func resourceArmMaintenanceAssignmentDedicatedHostCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Maintenance.ConfigurationAssignmentsClient
+	client := meta.(*clients.Client).Maintenance().ConfigurationAssignmentsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -119,7 +119,7 @@ func resourceArmMaintenanceAssignmentDedicatedHostCreate(d *schema.ResourceData,
 }
 
 func resourceArmMaintenanceAssignmentDedicatedHostRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Maintenance.ConfigurationAssignmentsClient
+	client := meta.(*clients.Client).Maintenance().ConfigurationAssignmentsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -145,7 +145,7 @@ func resourceArmMaintenanceAssignmentDedicatedHostRead(d *schema.ResourceData, m
 }
 
 func resourceArmMaintenanceAssignmentDedicatedHostDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Maintenance.ConfigurationAssignmentsClient
+	client := meta.(*clients.Client).Maintenance().ConfigurationAssignmentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
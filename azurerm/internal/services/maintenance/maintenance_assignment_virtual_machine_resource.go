@@ -62,7 +62,7 @@ func resourceArmMaintenanceAssignmentVirtualMachine() *schema.Resource {
 }
 
 func resourceArmMaintenanceAssignmentVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Maintenance.ConfigurationAssignmentsClient
+	client := meta.(*clients.Client).Maintenance().ConfigurationAssignmentsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -119,7 +119,7 @@ func resourceArmMaintenanceAssignmentVirtualMachineCreate(d *schema.ResourceData
 }
 
 func resourceArmMaintenanceAssignmentVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Maintenance.ConfigurationAssignmentsClient
+	client := meta.(*clients.Client).Maintenance().ConfigurationAssignmentsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -145,7 +145,7 @@ func resourceArmMaintenanceAssignmentVirtualMachineRead(d *schema.ResourceData,
 }
 
 func resourceArmMaintenanceAssignmentVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Maintenance.ConfigurationAssignmentsClient
+	client := meta.(*clients.Client).Maintenance().ConfigurationAssignmentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
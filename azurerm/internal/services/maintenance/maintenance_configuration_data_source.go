@@ -44,7 +44,7 @@ func dataSourceMaintenanceConfiguration() *schema.Resource {
 }
 
 func dataSourceArmMaintenanceConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Maintenance.ConfigurationsClient
+	client := meta.(*clients.Client).Maintenance().ConfigurationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -81,7 +81,7 @@ func resourceArmMaintenanceConfiguration() *schema.Resource {
 }
 
 func resourceArmMaintenanceConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Maintenance.ConfigurationsClient
+	client := meta.(*clients.Client).Maintenance().ConfigurationsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -131,7 +131,7 @@ func resourceArmMaintenanceConfigurationCreateUpdate(d *schema.ResourceData, met
 }
 
 func resourceArmMaintenanceConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Maintenance.ConfigurationsClient
+	client := meta.(*clients.Client).Maintenance().ConfigurationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -160,7 +160,7 @@ func resourceArmMaintenanceConfigurationRead(d *schema.ResourceData, meta interf
 }
 
 func resourceArmMaintenanceConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Maintenance.ConfigurationsClient
+	client := meta.(*clients.Client).Maintenance().ConfigurationsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
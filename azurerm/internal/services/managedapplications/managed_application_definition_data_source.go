@@ -36,7 +36,7 @@ func dataSourceManagedApplicationDefinition() *schema.Resource {
 }
 
 func dataSourceManagedApplicationDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ManagedApplication.ApplicationDefinitionClient
+	client := meta.(*clients.Client).ManagedApplication().ApplicationDefinitionClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -129,7 +129,7 @@ func resourceManagedApplicationDefinition() *schema.Resource {
 }
 
 func resourceManagedApplicationDefinitionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ManagedApplication.ApplicationDefinitionClient
+	client := meta.(*clients.Client).ManagedApplication().ApplicationDefinitionClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -197,7 +197,7 @@ func resourceManagedApplicationDefinitionCreateUpdate(d *schema.ResourceData, me
 }
 
 func resourceManagedApplicationDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ManagedApplication.ApplicationDefinitionClient
+	client := meta.(*clients.Client).ManagedApplication().ApplicationDefinitionClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -245,7 +245,7 @@ func resourceManagedApplicationDefinitionRead(d *schema.ResourceData, meta inter
 }
 
 func resourceManagedApplicationDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ManagedApplication.ApplicationDefinitionClient
+	client := meta.(*clients.Client).ManagedApplication().ApplicationDefinitionClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -114,7 +114,7 @@ func (ManagedApplicationDefinitionResource) Exists(ctx context.Context, clients
 		return nil, err
 	}
 
-	resp, err := clients.ManagedApplication.ApplicationDefinitionClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.ManagedApplication().ApplicationDefinitionClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Managed Definition %s (resource group: %s): %v", id.Name, id.ResourceGroup, err)
 	}
@@ -127,7 +127,7 @@ func resourceManagedApplication() *schema.Resource {
 }
 
 func resourceManagedApplicationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ManagedApplication.ApplicationClient
+	client := meta.(*clients.Client).ManagedApplication().ApplicationClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -200,7 +200,7 @@ func resourceManagedApplicationCreateUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceManagedApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ManagedApplication.ApplicationClient
+	client := meta.(*clients.Client).ManagedApplication().ApplicationClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -248,7 +248,7 @@ func resourceManagedApplicationRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceManagedApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ManagedApplication.ApplicationClient
+	client := meta.(*clients.Client).ManagedApplication().ApplicationClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
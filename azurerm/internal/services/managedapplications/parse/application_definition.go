@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApplicationDefinitionId struct {
@@ -67,3 +68,12 @@ func ApplicationDefinitionID(input string) (*ApplicationDefinitionId, error) {
 
 	return &resourceId, nil
 }
+
+// ApplicationDefinitionIDNormalized parses a ApplicationDefinition ID into an ApplicationDefinitionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ApplicationDefinitionID method should be used instead for validation etc.
+func ApplicationDefinitionIDNormalized(input string) (*ApplicationDefinitionId, error) {
+	return ApplicationDefinitionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Solutions/applicationDefinitions/%s"))
+}
@@ -62,7 +62,7 @@ func dataSourceManagementGroup() *schema.Resource {
 }
 
 func dataSourceManagementGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ManagementGroups.GroupsClient
+	client := meta.(*clients.Client).ManagementGroups().GroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -86,8 +86,8 @@ func resourceManagementGroup() *schema.Resource {
 }
 
 func resourceManagementGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ManagementGroups.GroupsClient
-	subscriptionsClient := meta.(*clients.Client).ManagementGroups.SubscriptionClient
+	client := meta.(*clients.Client).ManagementGroups().GroupsClient
+	subscriptionsClient := meta.(*clients.Client).ManagementGroups().SubscriptionClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	armTenantID := meta.(*clients.Client).Account.TenantId
@@ -211,7 +211,7 @@ func resourceManagementGroupCreateUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceManagementGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ManagementGroups.GroupsClient
+	client := meta.(*clients.Client).ManagementGroups().GroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -259,8 +259,8 @@ func resourceManagementGroupRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceManagementGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ManagementGroups.GroupsClient
-	subscriptionsClient := meta.(*clients.Client).ManagementGroups.SubscriptionClient
+	client := meta.(*clients.Client).ManagementGroups().GroupsClient
+	subscriptionsClient := meta.(*clients.Client).ManagementGroups().SubscriptionClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -188,7 +188,7 @@ func (ManagementGroupResource) Exists(ctx context.Context, clients *clients.Clie
 		return nil, err
 	}
 
-	resp, err := clients.ManagementGroups.GroupsClient.Get(ctx, id.Name, "children", utils.Bool(true), "", "no-cache")
+	resp, err := clients.ManagementGroups().GroupsClient.Get(ctx, id.Name, "children", utils.Bool(true), "", "no-cache")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Management Group %s: %v", id.Name, err)
 	}
@@ -58,7 +58,7 @@ func dataSourceMapsAccount() *schema.Resource {
 }
 
 func dataSourceMapsAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Maps.AccountsClient
+	client := meta.(*clients.Client).Maps().AccountsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
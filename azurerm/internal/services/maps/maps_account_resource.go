@@ -81,7 +81,7 @@ func resourceMapsAccount() *schema.Resource {
 }
 
 func resourceMapsAccountCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Maps.AccountsClient
+	client := meta.(*clients.Client).Maps().AccountsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -132,7 +132,7 @@ func resourceMapsAccountCreateUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceMapsAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Maps.AccountsClient
+	client := meta.(*clients.Client).Maps().AccountsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -171,7 +171,7 @@ func resourceMapsAccountRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceMapsAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Maps.AccountsClient
+	client := meta.(*clients.Client).Maps().AccountsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -86,7 +86,7 @@ func (MapsAccountResource) Exists(ctx context.Context, clients *clients.Client,
 		return nil, err
 	}
 
-	resp, err := clients.Maps.AccountsClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Maps().AccountsClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Maps Account %s (resource group: %s): %v", id.Name, id.ResourceGroup, err)
 	}
@@ -59,7 +59,7 @@ func resourceMariaDbConfiguration() *schema.Resource {
 }
 
 func resourceMariaDbConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MariaDB.ConfigurationsClient
+	client := meta.(*clients.Client).MariaDB().ConfigurationsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -99,7 +99,7 @@ func resourceMariaDbConfigurationCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceMariaDbConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MariaDB.ConfigurationsClient
+	client := meta.(*clients.Client).MariaDB().ConfigurationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -131,7 +131,7 @@ func resourceMariaDbConfigurationRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceMariaDbConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MariaDB.ConfigurationsClient
+	client := meta.(*clients.Client).MariaDB().ConfigurationsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -95,7 +95,7 @@ func (MariaDbConfigurationResource) Exists(ctx context.Context, clients *clients
 	serverName := id.Path["servers"]
 	name := id.Path["configurations"]
 
-	resp, err := clients.MariaDB.ConfigurationsClient.Get(ctx, id.ResourceGroup, serverName, name)
+	resp, err := clients.MariaDB().ConfigurationsClient.Get(ctx, id.ResourceGroup, serverName, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving MariaDB Configuration %q (Server %q / Resource Group %q): %v", name, serverName, id.ResourceGroup, err)
 	}
@@ -113,7 +113,7 @@ func checkValueIs(value string) acceptance.ClientCheckFunc {
 		serverName := id.Path["servers"]
 		name := id.Path["configurations"]
 
-		resp, err := clients.MariaDB.ConfigurationsClient.Get(ctx, id.ResourceGroup, serverName, name)
+		resp, err := clients.MariaDB().ConfigurationsClient.Get(ctx, id.ResourceGroup, serverName, name)
 		if err != nil {
 			return fmt.Errorf("retrieving MariaDB Configuration %q (Server %q / Resource Group %q): %v", name, serverName, id.ResourceGroup, err)
 		}
@@ -139,7 +139,7 @@ func checkValueIsReset(configurationName string) acceptance.ClientCheckFunc {
 			return err
 		}
 
-		resp, err := clients.MariaDB.ConfigurationsClient.Get(ctx, id.ResourceGroup, id.Name, configurationName)
+		resp, err := clients.MariaDB().ConfigurationsClient.Get(ctx, id.ResourceGroup, id.Name, configurationName)
 		if err != nil {
 			return fmt.Errorf("retrieving MariaDB Configuration %q (Server %q / Resource Group %q): %v", configurationName, id.Name, id.ResourceGroup, err)
 		}
@@ -78,7 +78,7 @@ func resourceMariaDbDatabase() *schema.Resource {
 }
 
 func resourceMariaDbDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MariaDB.DatabasesClient
+	client := meta.(*clients.Client).MariaDB().DatabasesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -134,7 +134,7 @@ func resourceMariaDbDatabaseCreateUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceMariaDbDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MariaDB.DatabasesClient
+	client := meta.(*clients.Client).MariaDB().DatabasesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -170,7 +170,7 @@ func resourceMariaDbDatabaseRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceMariaDbDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MariaDB.DatabasesClient
+	client := meta.(*clients.Client).MariaDB().DatabasesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -61,7 +61,7 @@ func (MariaDbDatabaseResource) Exists(ctx context.Context, clients *clients.Clie
 	serverName := id.Path["servers"]
 	name := id.Path["databases"]
 
-	resp, err := clients.MariaDB.DatabasesClient.Get(ctx, id.ResourceGroup, serverName, name)
+	resp, err := clients.MariaDB().DatabasesClient.Get(ctx, id.ResourceGroup, serverName, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving MariaDB Database %q (Server %q / Resource Group %q): %v", name, serverName, id.ResourceGroup, err)
 	}
@@ -59,7 +59,7 @@ func (MariaDbFirewallRuleResource) Exists(ctx context.Context, clients *clients.
 	serverName := id.Path["servers"]
 	name := id.Path["firewallRules"]
 
-	resp, err := clients.MariaDB.FirewallRulesClient.Get(ctx, id.ResourceGroup, serverName, name)
+	resp, err := clients.MariaDB().FirewallRulesClient.Get(ctx, id.ResourceGroup, serverName, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving MariaDB Firewall Rule %q (Server %q / Resource Group %q): %v", name, serverName, id.ResourceGroup, err)
 	}
@@ -95,7 +95,7 @@ func dataSourceMariaDbServer() *schema.Resource {
 }
 
 func dataSourceMariaDbServerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MariaDB.ServersClient
+	client := meta.(*clients.Client).MariaDB().ServersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -256,7 +256,7 @@ func resourceMariaDbServer() *schema.Resource {
 }
 
 func resourceMariaDbServerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MariaDB.ServersClient
+	client := meta.(*clients.Client).MariaDB().ServersClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -394,7 +394,7 @@ func resourceMariaDbServerCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceMariaDbServerUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MariaDB.ServersClient
+	client := meta.(*clients.Client).MariaDB().ServersClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -458,7 +458,7 @@ func resourceMariaDbServerUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceMariaDbServerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MariaDB.ServersClient
+	client := meta.(*clients.Client).MariaDB().ServersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -515,7 +515,7 @@ func resourceMariaDbServerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceMariaDbServerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MariaDB.ServersClient
+	client := meta.(*clients.Client).MariaDB().ServersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -276,7 +276,7 @@ func (MariaDbServerResource) Exists(ctx context.Context, clients *clients.Client
 		return nil, err
 	}
 
-	resp, err := clients.MariaDB.ServersClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.MariaDB().ServersClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving MariaDB Server %q (Resource Group %q): %v", id.Name, id.ResourceGroup, err)
 	}
@@ -63,7 +63,7 @@ func resourceMariaDbVirtualNetworkRule() *schema.Resource {
 }
 
 func resourceMariaDbVirtualNetworkRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MariaDB.VirtualNetworkRulesClient
+	client := meta.(*clients.Client).MariaDB().VirtualNetworkRulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -126,7 +126,7 @@ func resourceMariaDbVirtualNetworkRuleCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceMariaDbVirtualNetworkRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MariaDB.VirtualNetworkRulesClient
+	client := meta.(*clients.Client).MariaDB().VirtualNetworkRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -162,7 +162,7 @@ func resourceMariaDbVirtualNetworkRuleRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceMariaDbVirtualNetworkRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MariaDB.VirtualNetworkRulesClient
+	client := meta.(*clients.Client).MariaDB().VirtualNetworkRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -101,7 +101,7 @@ func (MariaDbVirtualNetworkRuleResource) Exists(ctx context.Context, clients *cl
 	serverName := id.Path["servers"]
 	name := id.Path["virtualNetworkRules"]
 
-	resp, err := clients.MariaDB.VirtualNetworkRulesClient.Get(ctx, id.ResourceGroup, serverName, name)
+	resp, err := clients.MariaDB().VirtualNetworkRulesClient.Get(ctx, id.ResourceGroup, serverName, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving MariaDB Virtual Network Rule %q (Server %q / Resource Group %q): %v", name, serverName, id.ResourceGroup, err)
 	}
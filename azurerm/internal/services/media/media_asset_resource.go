@@ -97,7 +97,7 @@ func resourceMediaAsset() *schema.Resource {
 }
 
 func resourceMediaAssetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.AssetsClient
+	client := meta.(*clients.Client).Media().AssetsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -143,7 +143,7 @@ func resourceMediaAssetCreateUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceMediaAssetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.AssetsClient
+	client := meta.(*clients.Client).Media().AssetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -178,7 +178,7 @@ func resourceMediaAssetRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAssetDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.AssetsClient
+	client := meta.(*clients.Client).Media().AssetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -386,7 +386,7 @@ func resourceMediaContentKeyPolicy() *schema.Resource {
 }
 
 func resourceMediaContentKeyPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.ContentKeyPoliciesClient
+	client := meta.(*clients.Client).Media().ContentKeyPoliciesClient
 	subscriptionID := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -431,7 +431,7 @@ func resourceMediaContentKeyPolicyCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceMediaContentKeyPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.ContentKeyPoliciesClient
+	client := meta.(*clients.Client).Media().ContentKeyPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -468,7 +468,7 @@ func resourceMediaContentKeyPolicyRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceMediaContentKeyPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.ContentKeyPoliciesClient
+	client := meta.(*clients.Client).Media().ContentKeyPoliciesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -143,7 +143,7 @@ func resourceMediaJob() *schema.Resource {
 }
 
 func resourceMediaJobCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.JobsClient
+	client := meta.(*clients.Client).Media().JobsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -194,7 +194,7 @@ func resourceMediaJobCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceMediaJobRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.JobsClient
+	client := meta.(*clients.Client).Media().JobsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -242,7 +242,7 @@ func resourceMediaJobRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceMediaJobUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.JobsClient
+	client := meta.(*clients.Client).Media().JobsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -283,7 +283,7 @@ func resourceMediaJobUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceMediaJobDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.JobsClient
+	client := meta.(*clients.Client).Media().JobsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -111,7 +111,7 @@ func (MediaJobResource) Exists(ctx context.Context, clients *clients.Client, sta
 		return nil, err
 	}
 
-	resp, err := clients.Media.JobsClient.Get(ctx, id.ResourceGroup, id.MediaserviceName, id.TransformName, id.Name)
+	resp, err := clients.Media().JobsClient.Get(ctx, id.ResourceGroup, id.MediaserviceName, id.TransformName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Job %s (Media Services Account %s) (resource group: %s): %v", id.Name, id.MediaserviceName, id.ResourceGroup, err)
 	}
@@ -120,7 +120,7 @@ func resourceMediaServicesAccount() *schema.Resource {
 }
 
 func resourceMediaServicesAccountCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.ServicesClient
+	client := meta.(*clients.Client).Media().ServicesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -172,7 +172,7 @@ func resourceMediaServicesAccountCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceMediaServicesAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.ServicesClient
+	client := meta.(*clients.Client).Media().ServicesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -215,7 +215,7 @@ func resourceMediaServicesAccountRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceMediaServicesAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.ServicesClient
+	client := meta.(*clients.Client).Media().ServicesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
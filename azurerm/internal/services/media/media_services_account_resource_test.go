@@ -102,7 +102,7 @@ func (MediaServicesAccountResource) Exists(ctx context.Context, clients *clients
 		return nil, err
 	}
 
-	resp, err := clients.Media.ServicesClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Media().ServicesClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Media Services Account %s (resource group: %s): %v", id.Name, id.ResourceGroup, err)
 	}
@@ -204,7 +204,7 @@ func resourceMediaStreamingEndpoint() *schema.Resource {
 }
 
 func resourceMediaStreamingEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.StreamingEndpointsClient
+	client := meta.(*clients.Client).Media().StreamingEndpointsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -287,7 +287,7 @@ func resourceMediaStreamingEndpointCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceMediaStreamingEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.StreamingEndpointsClient
+	client := meta.(*clients.Client).Media().StreamingEndpointsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -370,7 +370,7 @@ func resourceMediaStreamingEndpointUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceMediaStreamingEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.StreamingEndpointsClient
+	client := meta.(*clients.Client).Media().StreamingEndpointsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -431,7 +431,7 @@ func resourceMediaStreamingEndpointRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceMediaStreamingEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.StreamingEndpointsClient
+	client := meta.(*clients.Client).Media().StreamingEndpointsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
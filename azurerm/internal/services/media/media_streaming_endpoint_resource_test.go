@@ -84,12 +84,12 @@ func (r MediaStreamingEndpointResource) Start(ctx context.Context, client *clien
 		return err
 	}
 
-	future, err := client.Media.StreamingEndpointsClient.Start(ctx, id.ResourceGroup, id.MediaserviceName, id.Name)
+	future, err := client.Media().StreamingEndpointsClient.Start(ctx, id.ResourceGroup, id.MediaserviceName, id.Name)
 	if err != nil {
 		return fmt.Errorf("starting %s: %+v", id, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Media.StreamingEndpointsClient.Client); err != nil {
+	if err := future.WaitForCompletionRef(ctx, client.Media().StreamingEndpointsClient.Client); err != nil {
 		return fmt.Errorf("waiting for %s to start: %+v", id, err)
 	}
 
@@ -102,7 +102,7 @@ func (MediaStreamingEndpointResource) Exists(ctx context.Context, clients *clien
 		return nil, err
 	}
 
-	resp, err := clients.Media.StreamingEndpointsClient.Get(ctx, id.ResourceGroup, id.MediaserviceName, id.Name)
+	resp, err := clients.Media().StreamingEndpointsClient.Get(ctx, id.ResourceGroup, id.MediaserviceName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %v", id.String(), err)
 	}
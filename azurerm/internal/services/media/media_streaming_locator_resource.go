@@ -163,7 +163,7 @@ func resourceMediaStreamingLocator() *schema.Resource {
 }
 
 func resourceMediaStreamingLocatorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.StreamingLocatorsClient
+	client := meta.(*clients.Client).Media().StreamingLocatorsClient
 	subscriptionID := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -240,7 +240,7 @@ func resourceMediaStreamingLocatorCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceMediaStreamingLocatorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.StreamingLocatorsClient
+	client := meta.(*clients.Client).Media().StreamingLocatorsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -298,7 +298,7 @@ func resourceMediaStreamingLocatorRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceMediaStreamingLocatorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.StreamingLocatorsClient
+	client := meta.(*clients.Client).Media().StreamingLocatorsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
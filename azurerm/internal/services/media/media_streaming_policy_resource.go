@@ -150,7 +150,7 @@ func resourceMediaStreamingPolicy() *schema.Resource {
 }
 
 func resourceMediaStreamingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.StreamingPoliciesClient
+	client := meta.(*clients.Client).Media().StreamingPoliciesClient
 	subscriptionID := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -199,7 +199,7 @@ func resourceMediaStreamingPolicyCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceMediaStreamingPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.StreamingPoliciesClient
+	client := meta.(*clients.Client).Media().StreamingPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -246,7 +246,7 @@ func resourceMediaStreamingPolicyRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceMediaStreamingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.StreamingPoliciesClient
+	client := meta.(*clients.Client).Media().StreamingPoliciesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
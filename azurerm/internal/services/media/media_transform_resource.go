@@ -228,7 +228,7 @@ func resourceMediaTransform() *schema.Resource {
 }
 
 func resourceMediaTransformCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.TransformsClient
+	client := meta.(*clients.Client).Media().TransformsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -270,7 +270,7 @@ func resourceMediaTransformCreateUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceMediaTransformRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.TransformsClient
+	client := meta.(*clients.Client).Media().TransformsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -309,7 +309,7 @@ func resourceMediaTransformRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceMediaTransformDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Media.TransformsClient
+	client := meta.(*clients.Client).Media().TransformsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
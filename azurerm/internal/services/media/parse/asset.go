@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AssetId struct {
@@ -73,3 +74,12 @@ func AssetID(input string) (*AssetId, error) {
 
 	return &resourceId, nil
 }
+
+// AssetIDNormalized parses a Asset ID into an AssetId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the AssetID method should be used instead for validation etc.
+func AssetIDNormalized(input string) (*AssetId, error) {
+	return AssetID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Media/mediaservices/%s/assets/%s"))
+}
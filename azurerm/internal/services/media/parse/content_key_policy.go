@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ContentKeyPolicyId struct {
@@ -73,3 +74,12 @@ func ContentKeyPolicyID(input string) (*ContentKeyPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// ContentKeyPolicyIDNormalized parses a ContentKeyPolicy ID into an ContentKeyPolicyId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ContentKeyPolicyID method should be used instead for validation etc.
+func ContentKeyPolicyIDNormalized(input string) (*ContentKeyPolicyId, error) {
+	return ContentKeyPolicyID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Media/mediaservices/%s/contentkeypolicies/%s"))
+}
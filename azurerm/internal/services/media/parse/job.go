@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type JobId struct {
@@ -79,3 +80,12 @@ func JobID(input string) (*JobId, error) {
 
 	return &resourceId, nil
 }
+
+// JobIDNormalized parses a Job ID into an JobId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the JobID method should be used instead for validation etc.
+func JobIDNormalized(input string) (*JobId, error) {
+	return JobID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Media/mediaservices/%s/transforms/%s/jobs/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type MediaServiceId struct {
@@ -67,3 +68,12 @@ func MediaServiceID(input string) (*MediaServiceId, error) {
 
 	return &resourceId, nil
 }
+
+// MediaServiceIDNormalized parses a MediaService ID into an MediaServiceId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the MediaServiceID method should be used instead for validation etc.
+func MediaServiceIDNormalized(input string) (*MediaServiceId, error) {
+	return MediaServiceID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Media/mediaservices/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StreamingEndpointId struct {
@@ -73,3 +74,12 @@ func StreamingEndpointID(input string) (*StreamingEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+// StreamingEndpointIDNormalized parses a StreamingEndpoint ID into an StreamingEndpointId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the StreamingEndpointID method should be used instead for validation etc.
+func StreamingEndpointIDNormalized(input string) (*StreamingEndpointId, error) {
+	return StreamingEndpointID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Media/mediaservices/%s/streamingendpoints/%s"))
+}
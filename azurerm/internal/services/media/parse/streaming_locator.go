@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StreamingLocatorId struct {
@@ -73,3 +74,12 @@ func StreamingLocatorID(input string) (*StreamingLocatorId, error) {
 
 	return &resourceId, nil
 }
+
+// StreamingLocatorIDNormalized parses a StreamingLocator ID into an StreamingLocatorId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the StreamingLocatorID method should be used instead for validation etc.
+func StreamingLocatorIDNormalized(input string) (*StreamingLocatorId, error) {
+	return StreamingLocatorID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Media/mediaservices/%s/streaminglocators/%s"))
+}
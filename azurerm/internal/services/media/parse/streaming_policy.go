@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StreamingPolicyId struct {
@@ -73,3 +74,12 @@ func StreamingPolicyID(input string) (*StreamingPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// StreamingPolicyIDNormalized parses a StreamingPolicy ID into an StreamingPolicyId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the StreamingPolicyID method should be used instead for validation etc.
+func StreamingPolicyIDNormalized(input string) (*StreamingPolicyId, error) {
+	return StreamingPolicyID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Media/mediaservices/%s/streamingpolicies/%s"))
+}
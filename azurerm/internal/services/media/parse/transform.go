@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type TransformId struct {
@@ -73,3 +74,12 @@ func TransformID(input string) (*TransformId, error) {
 
 	return &resourceId, nil
 }
+
+// TransformIDNormalized parses a Transform ID into an TransformId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the TransformID method should be used instead for validation etc.
+func TransformIDNormalized(input string) (*TransformId, error) {
+	return TransformID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Media/mediaservices/%s/transforms/%s"))
+}
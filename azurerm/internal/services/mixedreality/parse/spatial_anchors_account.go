@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SpatialAnchorsAccountId struct {
@@ -67,3 +68,12 @@ func SpatialAnchorsAccountID(input string) (*SpatialAnchorsAccountId, error) {
 
 	return &resourceId, nil
 }
+
+// SpatialAnchorsAccountIDNormalized parses a SpatialAnchorsAccount ID into an SpatialAnchorsAccountId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SpatialAnchorsAccountID method should be used instead for validation etc.
+func SpatialAnchorsAccountIDNormalized(input string) (*SpatialAnchorsAccountId, error) {
+	return SpatialAnchorsAccountID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.MixedReality/spatialAnchorsAccounts/%s"))
+}
@@ -1,3 +1,3 @@
 package mixedreality
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SpatialAnchorsAccount -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.MixedReality/spatialAnchorsAccounts/Account1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SpatialAnchorsAccount -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.MixedReality/spatialAnchorsAccounts/Account1 -resource-type=azurerm_spatial_anchors_account
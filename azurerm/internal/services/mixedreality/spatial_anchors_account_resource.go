@@ -56,7 +56,7 @@ func resourceSpatialAnchorsAccount() *schema.Resource {
 }
 
 func resourceSpatialAnchorsAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MixedReality.SpatialAnchorsAccountClient
+	client := meta.(*clients.Client).MixedReality().SpatialAnchorsAccountClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -102,7 +102,7 @@ func resourceSpatialAnchorsAccountCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceSpatialAnchorsAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MixedReality.SpatialAnchorsAccountClient
+	client := meta.(*clients.Client).MixedReality().SpatialAnchorsAccountClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -131,7 +131,7 @@ func resourceSpatialAnchorsAccountRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceSpatialAnchorsAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MixedReality.SpatialAnchorsAccountClient
+	client := meta.(*clients.Client).MixedReality().SpatialAnchorsAccountClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -55,7 +55,7 @@ func (SpatialAnchorsAccountResource) Exists(ctx context.Context, clients *client
 		return nil, err
 	}
 
-	resp, err := clients.MixedReality.SpatialAnchorsAccountClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.MixedReality().SpatialAnchorsAccountClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Spatial Anchors Account %s (resource group: %s): %v", id.Name, id.ResourceGroup, err)
 	}
@@ -270,7 +270,7 @@ func importMonitorActionRule(actionRuleType alertsmanagement.Type) func(d *schem
 			return nil, err
 		}
 
-		client := meta.(*clients.Client).Monitor.ActionRulesClient
+		client := meta.(*clients.Client).Monitor().ActionRulesClient
 		ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 		defer cancel()
 
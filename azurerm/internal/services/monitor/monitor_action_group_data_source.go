@@ -285,7 +285,7 @@ func dataSourceMonitorActionGroup() *schema.Resource {
 }
 
 func dataSourceMonitorActionGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ActionGroupsClient
+	client := meta.(*clients.Client).Monitor().ActionGroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
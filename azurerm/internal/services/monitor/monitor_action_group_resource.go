@@ -338,7 +338,7 @@ func resourceMonitorActionGroup() *schema.Resource {
 }
 
 func resourceMonitorActionGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ActionGroupsClient
+	client := meta.(*clients.Client).Monitor().ActionGroupsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -412,7 +412,7 @@ func resourceMonitorActionGroupCreateUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceMonitorActionGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ActionGroupsClient
+	client := meta.(*clients.Client).Monitor().ActionGroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -482,7 +482,7 @@ func resourceMonitorActionGroupRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceMonitorActionGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ActionGroupsClient
+	client := meta.(*clients.Client).Monitor().ActionGroupsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
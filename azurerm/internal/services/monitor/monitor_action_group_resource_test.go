@@ -921,7 +921,7 @@ func (t MonitorActionGroupResource) Exists(ctx context.Context, clients *clients
 	resGroup := id.ResourceGroup
 	name := id.Path["actionGroups"]
 
-	resp, err := clients.Monitor.ActionGroupsClient.Get(ctx, resGroup, name)
+	resp, err := clients.Monitor().ActionGroupsClient.Get(ctx, resGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading action group (%s): %+v", id, err)
 	}
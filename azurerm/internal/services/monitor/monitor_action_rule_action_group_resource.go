@@ -101,7 +101,7 @@ func resourceMonitorActionRuleActionGroup() *schema.Resource {
 }
 
 func resourceMonitorActionRuleActionGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ActionRulesClient
+	client := meta.(*clients.Client).Monitor().ActionRulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -157,7 +157,7 @@ func resourceMonitorActionRuleActionGroupCreateUpdate(d *schema.ResourceData, me
 }
 
 func resourceMonitorActionRuleActionGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ActionRulesClient
+	client := meta.(*clients.Client).Monitor().ActionRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -195,7 +195,7 @@ func resourceMonitorActionRuleActionGroupRead(d *schema.ResourceData, meta inter
 }
 
 func resourceMonitorActionRuleActionGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ActionRulesClient
+	client := meta.(*clients.Client).Monitor().ActionRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
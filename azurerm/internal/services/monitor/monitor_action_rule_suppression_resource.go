@@ -159,7 +159,7 @@ func resourceMonitorActionRuleSuppression() *schema.Resource {
 }
 
 func resourceMonitorActionRuleSuppressionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ActionRulesClient
+	client := meta.(*clients.Client).Monitor().ActionRulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -220,7 +220,7 @@ func resourceMonitorActionRuleSuppressionCreateUpdate(d *schema.ResourceData, me
 }
 
 func resourceMonitorActionRuleSuppressionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ActionRulesClient
+	client := meta.(*clients.Client).Monitor().ActionRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -260,7 +260,7 @@ func resourceMonitorActionRuleSuppressionRead(d *schema.ResourceData, meta inter
 }
 
 func resourceMonitorActionRuleSuppressionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ActionRulesClient
+	client := meta.(*clients.Client).Monitor().ActionRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
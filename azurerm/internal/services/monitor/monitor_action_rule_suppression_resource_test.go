@@ -110,7 +110,7 @@ func (t MonitorActionRuleSuppressionResource) Exists(ctx context.Context, client
 		return nil, err
 	}
 
-	resp, err := clients.Monitor.ActionRulesClient.GetByName(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Monitor().ActionRulesClient.GetByName(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading action rule (%s): %+v", id, err)
 	}
@@ -194,7 +194,7 @@ func resourceMonitorActivityLogAlert() *schema.Resource {
 }
 
 func resourceMonitorActivityLogAlertCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ActivityLogAlertsClient
+	client := meta.(*clients.Client).Monitor().ActivityLogAlertsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -252,7 +252,7 @@ func resourceMonitorActivityLogAlertCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceMonitorActivityLogAlertRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ActivityLogAlertsClient
+	client := meta.(*clients.Client).Monitor().ActivityLogAlertsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -292,7 +292,7 @@ func resourceMonitorActivityLogAlertRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceMonitorActivityLogAlertDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ActivityLogAlertsClient
+	client := meta.(*clients.Client).Monitor().ActivityLogAlertsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -319,7 +319,7 @@ func (t MonitorActivityLogAlertResource) Exists(ctx context.Context, clients *cl
 	resourceGroup := id.ResourceGroup
 	name := id.Path["activityLogAlerts"]
 
-	resp, err := clients.Monitor.ActivityLogAlertsClient.Get(ctx, resourceGroup, name)
+	resp, err := clients.Monitor().ActivityLogAlertsClient.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading activity log alert (%s): %+v", id, err)
 	}
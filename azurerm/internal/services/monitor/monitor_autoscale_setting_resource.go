@@ -394,7 +394,7 @@ func resourceMonitorAutoScaleSetting() *schema.Resource {
 }
 
 func resourceMonitorAutoScaleSettingCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.AutoscaleSettingsClient
+	client := meta.(*clients.Client).Monitor().AutoscaleSettingsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -459,7 +459,7 @@ func resourceMonitorAutoScaleSettingCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceMonitorAutoScaleSettingRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.AutoscaleSettingsClient
+	client := meta.(*clients.Client).Monitor().AutoscaleSettingsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -509,7 +509,7 @@ func resourceMonitorAutoScaleSettingRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceMonitorAutoScaleSettingDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.AutoscaleSettingsClient
+	client := meta.(*clients.Client).Monitor().AutoscaleSettingsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -296,7 +296,7 @@ func (t MonitorAutoScaleSettingResource) Exists(ctx context.Context, clients *cl
 	resourceGroup := id.ResourceGroup
 	name := id.Path["autoscalesettings"]
 
-	resp, err := clients.Monitor.AutoscaleSettingsClient.Get(ctx, resourceGroup, name)
+	resp, err := clients.Monitor().AutoscaleSettingsClient.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading autoscale settings (%s): %+v", id, err)
 	}
@@ -45,7 +45,7 @@ func dataSourceMonitorDiagnosticCategories() *schema.Resource {
 }
 
 func dataSourceMonitorDiagnosticCategoriesRead(d *schema.ResourceData, meta interface{}) error {
-	categoriesClient := meta.(*clients.Client).Monitor.DiagnosticSettingsCategoryClient
+	categoriesClient := meta.(*clients.Client).Monitor().DiagnosticSettingsCategoryClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
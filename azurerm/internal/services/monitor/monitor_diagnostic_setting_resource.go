@@ -19,9 +19,11 @@ import (
 	eventhubValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventhub/validate"
 	logAnalyticsParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
 	logAnalyticsValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/validate"
 	storageParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
 	storageValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -56,6 +58,8 @@ func resourceMonitorDiagnosticSetting() *schema.Resource {
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: azure.ValidateResourceID,
+				// the casing of the segment keys within the Target Resource ID is normalized when reading this from the ID
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"eventhub_name": {
@@ -177,7 +181,7 @@ func resourceMonitorDiagnosticSetting() *schema.Resource {
 }
 
 func resourceMonitorDiagnosticSettingCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	client := meta.(*clients.Client).Monitor().DiagnosticSettingsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM Diagnostic Settings.")
@@ -289,16 +293,16 @@ func resourceMonitorDiagnosticSettingCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceMonitorDiagnosticSettingRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	client := meta.(*clients.Client).Monitor().DiagnosticSettingsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := ParseMonitorDiagnosticId(d.Id())
+	id, err := parse.DiagnosticSettingID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	actualResourceId := id.ResourceID
+	actualResourceId := id.TargetResource.ID()
 	targetResourceId := strings.TrimPrefix(actualResourceId, "/")
 	resp, err := client.Get(ctx, targetResourceId, id.Name)
 	if err != nil {
@@ -312,7 +316,7 @@ func resourceMonitorDiagnosticSettingRead(d *schema.ResourceData, meta interface
 	}
 
 	d.Set("name", id.Name)
-	d.Set("target_resource_id", id.ResourceID)
+	d.Set("target_resource_id", id.TargetResource.ID())
 
 	d.Set("eventhub_name", resp.EventHubName)
 	eventhubAuthorizationRuleId := ""
@@ -362,16 +366,16 @@ func resourceMonitorDiagnosticSettingRead(d *schema.ResourceData, meta interface
 }
 
 func resourceMonitorDiagnosticSettingDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	client := meta.(*clients.Client).Monitor().DiagnosticSettingsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := ParseMonitorDiagnosticId(d.Id())
+	id, err := parse.DiagnosticSettingID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	targetResourceId := strings.TrimPrefix(id.ResourceID, "/")
+	targetResourceId := strings.TrimPrefix(id.TargetResource.ID(), "/")
 	resp, err := client.Delete(ctx, targetResourceId, id.Name)
 	if err != nil {
 		if !response.WasNotFound(resp.Response) {
@@ -380,7 +384,7 @@ func resourceMonitorDiagnosticSettingDelete(d *schema.ResourceData, meta interfa
 	}
 
 	// API appears to be eventually consistent (identified during tainting this resource)
-	log.Printf("[DEBUG] Waiting for Monitor Diagnostic Setting %q for Resource %q to disappear", id.Name, id.ResourceID)
+	log.Printf("[DEBUG] Waiting for Monitor Diagnostic Setting %q for Resource %q to disappear", id.Name, id.TargetResource.ID())
 	stateConf := &resource.StateChangeConf{
 		Pending:                   []string{"Exists"},
 		Target:                    []string{"NotFound"},
@@ -391,7 +395,7 @@ func resourceMonitorDiagnosticSettingDelete(d *schema.ResourceData, meta interfa
 	}
 
 	if _, err = stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for Monitor Diagnostic Setting %q for Resource %q to become available: %s", id.Name, id.ResourceID, err)
+		return fmt.Errorf("Error waiting for Monitor Diagnostic Setting %q for Resource %q to become available: %s", id.Name, id.TargetResource.ID(), err)
 	}
 
 	return nil
@@ -556,21 +560,3 @@ func flattenMonitorDiagnosticMetrics(input *[]insights.MetricSettings) []interfa
 
 	return results
 }
-
-type monitorDiagnosticId struct {
-	ResourceID string
-	Name       string
-}
-
-func ParseMonitorDiagnosticId(monitorId string) (*monitorDiagnosticId, error) {
-	v := strings.Split(monitorId, "|")
-	if len(v) != 2 {
-		return nil, fmt.Errorf("Expected the Monitor Diagnostics ID to be in the format `{resourceId}|{name}` but got %d segments", len(v))
-	}
-
-	identifier := monitorDiagnosticId{
-		ResourceID: v[0],
-		Name:       v[1],
-	}
-	return &identifier, nil
-}
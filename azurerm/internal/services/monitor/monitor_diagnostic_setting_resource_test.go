@@ -11,7 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -127,14 +127,14 @@ func TestAccMonitorDiagnosticSetting_activityLog(t *testing.T) {
 }
 
 func (t MonitorDiagnosticSettingResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	id, err := monitor.ParseMonitorDiagnosticId(state.ID)
+	id, err := parse.DiagnosticSettingID(state.ID)
 	if err != nil {
 		return nil, err
 	}
-	actualResourceId := id.ResourceID
+	actualResourceId := id.TargetResource.ID()
 	targetResourceId := strings.TrimPrefix(actualResourceId, "/")
 
-	resp, err := clients.Monitor.DiagnosticSettingsClient.Get(ctx, targetResourceId, id.Name)
+	resp, err := clients.Monitor().DiagnosticSettingsClient.Get(ctx, targetResourceId, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading diagnostic setting (%s): %+v", id, err)
 	}
@@ -66,7 +66,7 @@ func dataSourceMonitorLogProfile() *schema.Resource {
 }
 
 func dataSourceLogProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.LogProfilesClient
+	client := meta.(*clients.Client).Monitor().LogProfilesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
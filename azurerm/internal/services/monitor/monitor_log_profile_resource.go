@@ -98,7 +98,7 @@ func resourceMonitorLogProfile() *schema.Resource {
 }
 
 func resourceLogProfileCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.LogProfilesClient
+	client := meta.(*clients.Client).Monitor().LogProfilesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -174,7 +174,7 @@ func resourceLogProfileCreateUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceLogProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.LogProfilesClient
+	client := meta.(*clients.Client).Monitor().LogProfilesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -212,7 +212,7 @@ func resourceLogProfileRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLogProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.LogProfilesClient
+	client := meta.(*clients.Client).Monitor().LogProfilesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -131,7 +131,7 @@ func (t MonitorLogProfileResource) Exists(ctx context.Context, clients *clients.
 		return nil, fmt.Errorf("parsing log profile name from ID %s: %s", state.ID, err)
 	}
 
-	resp, err := clients.Monitor.LogProfilesClient.Get(ctx, name)
+	resp, err := clients.Monitor().LogProfilesClient.Get(ctx, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading log profile %q: %+v", state.ID, err)
 	}
@@ -145,7 +145,7 @@ func (t MonitorLogProfileResource) Destroy(ctx context.Context, clients *clients
 		return nil, fmt.Errorf("parsing log profile name from ID %s: %s", state.ID, err)
 	}
 
-	if _, err := clients.Monitor.LogProfilesClient.Delete(ctx, name); err != nil {
+	if _, err := clients.Monitor().LogProfilesClient.Delete(ctx, name); err != nil {
 		return nil, fmt.Errorf("deleting log profile %q: %+v", state.ID, err)
 	}
 
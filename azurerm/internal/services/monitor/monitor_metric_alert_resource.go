@@ -376,7 +376,7 @@ func resourceMonitorMetricAlert() *schema.Resource {
 }
 
 func resourceMonitorMetricAlertCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.MetricAlertsClient
+	client := meta.(*clients.Client).Monitor().MetricAlertsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -486,7 +486,7 @@ func resourceMonitorMetricAlertCreateUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceMonitorMetricAlertRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.MetricAlertsClient
+	client := meta.(*clients.Client).Monitor().MetricAlertsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -558,7 +558,7 @@ func resourceMonitorMetricAlertRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceMonitorMetricAlertDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.MetricAlertsClient
+	client := meta.(*clients.Client).Monitor().MetricAlertsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -143,7 +143,7 @@ func (t MonitorMetricAlertResource) Exists(ctx context.Context, clients *clients
 	resourceGroup := id.ResourceGroup
 	name := id.Path["metricAlerts"]
 
-	resp, err := clients.Monitor.MetricAlertsClient.Get(ctx, resourceGroup, name)
+	resp, err := clients.Monitor().MetricAlertsClient.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Metric Alert (%s): %+v", id, err)
 	}
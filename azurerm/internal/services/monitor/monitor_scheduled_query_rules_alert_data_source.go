@@ -145,7 +145,7 @@ func dataSourceMonitorScheduledQueryRulesAlert() *schema.Resource {
 }
 
 func dataSourceMonitorScheduledQueryRulesAlertRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ScheduledQueryRulesClient
+	client := meta.(*clients.Client).Monitor().ScheduledQueryRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
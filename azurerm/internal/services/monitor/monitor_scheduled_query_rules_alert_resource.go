@@ -203,7 +203,7 @@ func resourceMonitorScheduledQueryRulesAlert() *schema.Resource {
 func resourceMonitorScheduledQueryRulesAlertCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	action := expandMonitorScheduledQueryRulesAlertingAction(d)
 	schedule := expandMonitorScheduledQueryRulesAlertSchedule(d)
-	client := meta.(*clients.Client).Monitor.ScheduledQueryRulesClient
+	client := meta.(*clients.Client).Monitor().ScheduledQueryRulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -283,7 +283,7 @@ func resourceMonitorScheduledQueryRulesAlertCreateUpdate(d *schema.ResourceData,
 }
 
 func resourceMonitorScheduledQueryRulesAlertRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ScheduledQueryRulesClient
+	client := meta.(*clients.Client).Monitor().ScheduledQueryRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -360,7 +360,7 @@ func resourceMonitorScheduledQueryRulesAlertRead(d *schema.ResourceData, meta in
 }
 
 func resourceMonitorScheduledQueryRulesAlertDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ScheduledQueryRulesClient
+	client := meta.(*clients.Client).Monitor().ScheduledQueryRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
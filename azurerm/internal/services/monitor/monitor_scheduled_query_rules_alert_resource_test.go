@@ -317,7 +317,7 @@ func (t MonitorScheduledQueryRulesResource) Exists(ctx context.Context, clients
 	resourceGroup := id.ResourceGroup
 	name := id.Path["scheduledqueryrules"]
 
-	resp, err := clients.Monitor.ScheduledQueryRulesClient.Get(ctx, resourceGroup, name)
+	resp, err := clients.Monitor().ScheduledQueryRulesClient.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Scheduled Query Rules (%s): %+v", id, err)
 	}
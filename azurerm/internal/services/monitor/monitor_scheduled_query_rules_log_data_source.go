@@ -92,7 +92,7 @@ func dataSourceMonitorScheduledQueryRulesLog() *schema.Resource {
 }
 
 func dataSourceMonitorScheduledQueryRulesLogRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ScheduledQueryRulesClient
+	client := meta.(*clients.Client).Monitor().ScheduledQueryRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
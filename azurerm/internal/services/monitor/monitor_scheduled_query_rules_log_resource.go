@@ -122,7 +122,7 @@ func resourceMonitorScheduledQueryRulesLog() *schema.Resource {
 func resourceMonitorScheduledQueryRulesLogCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	action := expandMonitorScheduledQueryRulesLogToMetricAction(d)
 
-	client := meta.(*clients.Client).Monitor.ScheduledQueryRulesClient
+	client := meta.(*clients.Client).Monitor().ScheduledQueryRulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -185,7 +185,7 @@ func resourceMonitorScheduledQueryRulesLogCreateUpdate(d *schema.ResourceData, m
 }
 
 func resourceMonitorScheduledQueryRulesLogRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ScheduledQueryRulesClient
+	client := meta.(*clients.Client).Monitor().ScheduledQueryRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -240,7 +240,7 @@ func resourceMonitorScheduledQueryRulesLogRead(d *schema.ResourceData, meta inte
 }
 
 func resourceMonitorScheduledQueryRulesLogDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.ScheduledQueryRulesClient
+	client := meta.(*clients.Client).Monitor().ScheduledQueryRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -208,7 +208,7 @@ func (t MonitorScheduledQueryRulesLogResource) Exists(ctx context.Context, clien
 	resourceGroup := id.ResourceGroup
 	name := id.Path["scheduledqueryrules"]
 
-	resp, err := clients.Monitor.ScheduledQueryRulesClient.Get(ctx, resourceGroup, name)
+	resp, err := clients.Monitor().ScheduledQueryRulesClient.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading scheduled query rules log (%s): %+v", id, err)
 	}
@@ -144,7 +144,7 @@ func resourceMonitorSmartDetectorAlertRule() *schema.Resource {
 }
 
 func resourceMonitorSmartDetectorAlertRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.SmartDetectorAlertRulesClient
+	client := meta.(*clients.Client).Monitor().SmartDetectorAlertRulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -209,7 +209,7 @@ func resourceMonitorSmartDetectorAlertRuleCreateUpdate(d *schema.ResourceData, m
 }
 
 func resourceMonitorSmartDetectorAlertRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.SmartDetectorAlertRulesClient
+	client := meta.(*clients.Client).Monitor().SmartDetectorAlertRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -256,7 +256,7 @@ func resourceMonitorSmartDetectorAlertRuleRead(d *schema.ResourceData, meta inte
 }
 
 func resourceMonitorSmartDetectorAlertRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.SmartDetectorAlertRulesClient
+	client := meta.(*clients.Client).Monitor().SmartDetectorAlertRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -96,7 +96,7 @@ func (t MonitorSmartDetectorAlertRuleResource) Exists(ctx context.Context, clien
 		return nil, err
 	}
 
-	resp, err := clients.Monitor.SmartDetectorAlertRulesClient.Get(ctx, id.ResourceGroup, id.Name, utils.Bool(true))
+	resp, err := clients.Monitor().SmartDetectorAlertRulesClient.Get(ctx, id.ResourceGroup, id.Name, utils.Bool(true))
 	if err != nil {
 		return nil, fmt.Errorf("reading action rule (%s): %+v", id, err)
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ActionGroupId struct {
@@ -67,3 +68,12 @@ func ActionGroupID(input string) (*ActionGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// ActionGroupIDNormalized parses a ActionGroup ID into an ActionGroupId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ActionGroupID method should be used instead for validation etc.
+func ActionGroupIDNormalized(input string) (*ActionGroupId, error) {
+	return ActionGroupID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/microsoft.insights/actionGroups/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ActionRuleId struct {
@@ -67,3 +68,12 @@ func ActionRuleID(input string) (*ActionRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// ActionRuleIDNormalized parses a ActionRule ID into an ActionRuleId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ActionRuleID method should be used instead for validation etc.
+func ActionRuleIDNormalized(input string) (*ActionRuleId, error) {
+	return ActionRuleID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AlertsManagement/actionRules/%s"))
+}
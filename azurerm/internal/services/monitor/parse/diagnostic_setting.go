@@ -0,0 +1,63 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+// DiagnosticSettingId is a pseudo ID, formed of the Resource ID of the Target Resource
+// and the name of the Diagnostic Setting, in the format `{targetResourceId}|{name}`
+type DiagnosticSettingId struct {
+	TargetResource resourceid.Scope
+	Name           string
+}
+
+func NewDiagnosticSettingID(targetResource resourceid.Scope, name string) DiagnosticSettingId {
+	return DiagnosticSettingId{
+		TargetResource: targetResource,
+		Name:           name,
+	}
+}
+
+func (id DiagnosticSettingId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Target Resource %q", id.TargetResource.ID()),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Diagnostic Setting", segmentsStr)
+}
+
+func (id DiagnosticSettingId) ID() string {
+	return fmt.Sprintf("%s|%s", id.TargetResource.ID(), id.Name)
+}
+
+// DiagnosticSettingID parses a Diagnostic Setting ID into a DiagnosticSettingId struct
+//
+// The Target Resource ID is specified by users, so the casing of the segment keys within this is normalized
+func DiagnosticSettingID(input string) (*DiagnosticSettingId, error) {
+	v := strings.Split(input, "|")
+	if len(v) != 2 {
+		return nil, fmt.Errorf("Expected the Monitor Diagnostics ID to be in the format `{resourceId}|{name}` but got %d segments", len(v))
+	}
+
+	if v[0] == "" {
+		return nil, fmt.Errorf("ID was missing the Target Resource ID")
+	}
+
+	if v[1] == "" {
+		return nil, fmt.Errorf("ID was missing the Name of the Diagnostic Setting")
+	}
+
+	targetResource, err := resourceid.ParseScope(resourceid.NormalizeScope(v[0]))
+	if err != nil {
+		return nil, fmt.Errorf("parsing the Target Resource ID %q: %+v", v[0], err)
+	}
+
+	return &DiagnosticSettingId{
+		TargetResource: targetResource,
+		Name:           v[1],
+	}, nil
+}
@@ -0,0 +1,113 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = DiagnosticSettingId{}
+
+func TestDiagnosticSettingIDFormatter(t *testing.T) {
+	targetResource := resourceid.ResourceScope{
+		SubscriptionId: "12345678-1234-9876-4563-123456789012",
+		ResourceGroup:  "resGroup1",
+		Resource:       "providers/Microsoft.KeyVault/vaults/vault1",
+	}
+	actual := NewDiagnosticSettingID(targetResource, "setting1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1|setting1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestDiagnosticSettingID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *DiagnosticSettingId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// missing Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1",
+			Error: true,
+		},
+		{
+			// missing value for Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1|",
+			Error: true,
+		},
+		{
+			// missing Target Resource
+			Input: "|setting1",
+			Error: true,
+		},
+		{
+			// too many segments
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012|setting1|setting2",
+			Error: true,
+		},
+		{
+			// subscription
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012|setting1",
+			Expected: &DiagnosticSettingId{
+				TargetResource: resourceid.SubscriptionScope{
+					SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				},
+				Name: "setting1",
+			},
+		},
+		{
+			// resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1|setting1",
+			Expected: &DiagnosticSettingId{
+				TargetResource: resourceid.ResourceScope{
+					SubscriptionId: "12345678-1234-9876-4563-123456789012",
+					ResourceGroup:  "resGroup1",
+					Resource:       "providers/Microsoft.KeyVault/vaults/vault1",
+				},
+				Name: "setting1",
+			},
+		},
+		{
+			// lower-cased segment names
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resGroup1/providers/microsoft.keyvault/vaults/vault1|setting1",
+			Expected: &DiagnosticSettingId{
+				TargetResource: resourceid.ResourceScope{
+					SubscriptionId: "12345678-1234-9876-4563-123456789012",
+					ResourceGroup:  "resGroup1",
+					Resource:       "providers/microsoft.keyvault/vaults/vault1",
+				},
+				Name: "setting1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := DiagnosticSettingID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.TargetResource != v.Expected.TargetResource {
+			t.Fatalf("Expected %q but got %q for TargetResource", v.Expected.TargetResource, actual.TargetResource)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SmartDetectorAlertRuleId struct {
@@ -67,3 +68,12 @@ func SmartDetectorAlertRuleID(input string) (*SmartDetectorAlertRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// SmartDetectorAlertRuleIDNormalized parses a SmartDetectorAlertRule ID into an SmartDetectorAlertRuleId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SmartDetectorAlertRuleID method should be used instead for validation etc.
+func SmartDetectorAlertRuleIDNormalized(input string) (*SmartDetectorAlertRuleId, error) {
+	return SmartDetectorAlertRuleID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AlertsManagement/smartdetectoralertrules/%s"))
+}
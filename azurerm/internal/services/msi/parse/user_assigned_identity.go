@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type UserAssignedIdentityId struct {
@@ -68,6 +69,15 @@ func UserAssignedIdentityID(input string) (*UserAssignedIdentityId, error) {
 	return &resourceId, nil
 }
 
+// UserAssignedIdentityIDNormalized parses a UserAssignedIdentity ID into an UserAssignedIdentityId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the UserAssignedIdentityID method should be used instead for validation etc.
+func UserAssignedIdentityIDNormalized(input string) (*UserAssignedIdentityId, error) {
+	return UserAssignedIdentityID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ManagedIdentity/userAssignedIdentities/%s"))
+}
+
 // UserAssignedIdentityIDInsensitively parses an UserAssignedIdentity ID into an UserAssignedIdentityId struct, insensitively
 // This should only be used to parse an ID for rewriting, the UserAssignedIdentityID
 // method should be used instead for validation etc.
@@ -1,3 +1,3 @@
 package msi
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=UserAssignedIdentity -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1 -rewrite=true
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=UserAssignedIdentity -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1 -rewrite=true -resource-type=azurerm_user_assigned_identity
@@ -57,7 +57,7 @@ func (r UserAssignedIdentityResource) Exists(ctx context.Context, client *client
 		return nil, err
 	}
 
-	resp, err := client.MSI.UserAssignedIdentitiesClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.MSI().UserAssignedIdentitiesClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving User Assigned Identity %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
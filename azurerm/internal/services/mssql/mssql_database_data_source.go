@@ -86,7 +86,7 @@ func dataSourceMsSqlDatabase() *schema.Resource {
 }
 
 func dataSourceMsSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.DatabasesClient
+	client := meta.(*clients.Client).MSSQL().DatabasesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
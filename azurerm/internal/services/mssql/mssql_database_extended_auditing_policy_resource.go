@@ -81,7 +81,7 @@ func resourceMsSqlDatabaseExtendedAuditingPolicy() *schema.Resource {
 }
 
 func resourceMsSqlDatabaseExtendedAuditingPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.DatabaseExtendedBlobAuditingPoliciesClient
+	client := meta.(*clients.Client).MSSQL().DatabaseExtendedBlobAuditingPoliciesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -139,8 +139,8 @@ func resourceMsSqlDatabaseExtendedAuditingPolicyCreateUpdate(d *schema.ResourceD
 }
 
 func resourceMsSqlDatabaseExtendedAuditingPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.DatabaseExtendedBlobAuditingPoliciesClient
-	dbClient := meta.(*clients.Client).MSSQL.DatabasesClient
+	client := meta.(*clients.Client).MSSQL().DatabaseExtendedBlobAuditingPoliciesClient
+	dbClient := meta.(*clients.Client).MSSQL().DatabasesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -176,7 +176,7 @@ func resourceMsSqlDatabaseExtendedAuditingPolicyRead(d *schema.ResourceData, met
 }
 
 func resourceMsSqlDatabaseExtendedAuditingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.DatabaseExtendedBlobAuditingPoliciesClient
+	client := meta.(*clients.Client).MSSQL().DatabaseExtendedBlobAuditingPoliciesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -305,12 +305,12 @@ func resourceMsSqlDatabase() *schema.Resource {
 }
 
 func resourceMsSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.DatabasesClient
-	auditingClient := meta.(*clients.Client).MSSQL.DatabaseExtendedBlobAuditingPoliciesClient
-	serverClient := meta.(*clients.Client).MSSQL.ServersClient
-	threatClient := meta.(*clients.Client).MSSQL.DatabaseThreatDetectionPoliciesClient
-	longTermRetentionClient := meta.(*clients.Client).MSSQL.BackupLongTermRetentionPoliciesClient
-	shortTermRetentionClient := meta.(*clients.Client).MSSQL.BackupShortTermRetentionPoliciesClient
+	client := meta.(*clients.Client).MSSQL().DatabasesClient
+	auditingClient := meta.(*clients.Client).MSSQL().DatabaseExtendedBlobAuditingPoliciesClient
+	serverClient := meta.(*clients.Client).MSSQL().ServersClient
+	threatClient := meta.(*clients.Client).MSSQL().DatabaseThreatDetectionPoliciesClient
+	longTermRetentionClient := meta.(*clients.Client).MSSQL().BackupLongTermRetentionPoliciesClient
+	shortTermRetentionClient := meta.(*clients.Client).MSSQL().BackupShortTermRetentionPoliciesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -504,11 +504,11 @@ func resourceMsSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceMsSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.DatabasesClient
-	threatClient := meta.(*clients.Client).MSSQL.DatabaseThreatDetectionPoliciesClient
-	auditingClient := meta.(*clients.Client).MSSQL.DatabaseExtendedBlobAuditingPoliciesClient
-	longTermRetentionClient := meta.(*clients.Client).MSSQL.BackupLongTermRetentionPoliciesClient
-	shortTermRetentionClient := meta.(*clients.Client).MSSQL.BackupShortTermRetentionPoliciesClient
+	client := meta.(*clients.Client).MSSQL().DatabasesClient
+	threatClient := meta.(*clients.Client).MSSQL().DatabaseThreatDetectionPoliciesClient
+	auditingClient := meta.(*clients.Client).MSSQL().DatabaseExtendedBlobAuditingPoliciesClient
+	longTermRetentionClient := meta.(*clients.Client).MSSQL().BackupLongTermRetentionPoliciesClient
+	shortTermRetentionClient := meta.(*clients.Client).MSSQL().BackupShortTermRetentionPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -528,7 +528,7 @@ func resourceMsSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("name", resp.Name)
 
-	serverClient := meta.(*clients.Client).MSSQL.ServersClient
+	serverClient := meta.(*clients.Client).MSSQL().ServersClient
 
 	serverResp, err := serverClient.Get(ctx, id.ResourceGroup, id.ServerName)
 	if err != nil || *serverResp.ID == "" {
@@ -608,7 +608,7 @@ func resourceMsSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceMsSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.DatabasesClient
+	client := meta.(*clients.Client).MSSQL().DatabasesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
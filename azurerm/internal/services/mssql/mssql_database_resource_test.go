@@ -547,7 +547,7 @@ func (MsSqlDatabaseResource) Exists(ctx context.Context, client *clients.Client,
 		return nil, err
 	}
 
-	resp, err := client.MSSQL.DatabasesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	resp, err := client.MSSQL().DatabasesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return nil, fmt.Errorf("SQL Database %q (Server %q, Resource Group %q) does not exist", id.Name, id.ServerName, id.ResourceGroup)
@@ -90,8 +90,8 @@ func resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaseline() *schema.Resource
 }
 
 func resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaselineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.DatabaseVulnerabilityAssessmentRuleBaselinesClient
-	vulnerabilityClient := meta.(*clients.Client).MSSQL.ServerVulnerabilityAssessmentsClient
+	client := meta.(*clients.Client).MSSQL().DatabaseVulnerabilityAssessmentRuleBaselinesClient
+	vulnerabilityClient := meta.(*clients.Client).MSSQL().ServerVulnerabilityAssessmentsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -129,7 +129,7 @@ func resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaselineCreateUpdate(d *sch
 }
 
 func resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaselineRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.DatabaseVulnerabilityAssessmentRuleBaselinesClient
+	client := meta.(*clients.Client).MSSQL().DatabaseVulnerabilityAssessmentRuleBaselinesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -153,7 +153,7 @@ func resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaselineRead(d *schema.Reso
 		return fmt.Errorf("error making Read request to Vulnerability Assessment Rule Baselines: %+v", err)
 	}
 
-	vulnerabilityAssessmentClient := meta.(*clients.Client).MSSQL.ServerVulnerabilityAssessmentsClient
+	vulnerabilityAssessmentClient := meta.(*clients.Client).MSSQL().ServerVulnerabilityAssessmentsClient
 	vulnerabilityAssessment, err := vulnerabilityAssessmentClient.Get(ctx, id.ResourceGroup, id.ServerName)
 	if err != nil {
 		return fmt.Errorf("Error retrieving Server Vulnerability Assessment Settings: %+v", err)
@@ -172,7 +172,7 @@ func resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaselineRead(d *schema.Reso
 }
 
 func resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaselineDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.DatabaseVulnerabilityAssessmentRuleBaselinesClient
+	client := meta.(*clients.Client).MSSQL().DatabaseVulnerabilityAssessmentRuleBaselinesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -62,7 +62,7 @@ func (MsSqlDatabaseVulnerabilityAssessmentRuleBaselineResource) Exists(ctx conte
 	}
 
 	baselineName := sql.VulnerabilityAssessmentPolicyBaselineName(id.BaselineName)
-	resp, err := client.MSSQL.DatabaseVulnerabilityAssessmentRuleBaselinesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName, id.RuleName, baselineName)
+	resp, err := client.MSSQL().DatabaseVulnerabilityAssessmentRuleBaselinesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName, id.RuleName, baselineName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return nil, fmt.Errorf("Baseline %q not found (Resource Group %q, Server %q, Database %q, Rule %q)",
@@ -72,7 +72,7 @@ func dataSourceMsSqlElasticpool() *schema.Resource {
 }
 
 func dataSourceMsSqlElasticpoolRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.ElasticPoolsClient
+	client := meta.(*clients.Client).MSSQL().ElasticPoolsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -180,7 +180,7 @@ func resourceMsSqlElasticPool() *schema.Resource {
 }
 
 func resourceMsSqlElasticPoolCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.ElasticPoolsClient
+	client := meta.(*clients.Client).MSSQL().ElasticPoolsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -251,7 +251,7 @@ func resourceMsSqlElasticPoolCreateUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceMsSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.ElasticPoolsClient
+	client := meta.(*clients.Client).MSSQL().ElasticPoolsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -303,7 +303,7 @@ func resourceMsSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceMsSqlElasticPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.ElasticPoolsClient
+	client := meta.(*clients.Client).MSSQL().ElasticPoolsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -183,7 +183,7 @@ func (MsSqlElasticPoolResource) Exists(ctx context.Context, client *clients.Clie
 		return nil, err
 	}
 
-	resp, err := client.MSSQL.ElasticPoolsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	resp, err := client.MSSQL().ElasticPoolsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return nil, fmt.Errorf("SQL Elastic Pool %q (Server %q, Resource Group %q) does not exist", id.Name, id.ServerName, id.ResourceGroup)
@@ -82,8 +82,8 @@ func dataSourceMsSqlServer() *schema.Resource {
 }
 
 func dataSourceMsSqlServerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.ServersClient
-	restorableDroppedDatabasesClient := meta.(*clients.Client).MSSQL.RestorableDroppedDatabasesClient
+	client := meta.(*clients.Client).MSSQL().ServersClient
+	restorableDroppedDatabasesClient := meta.(*clients.Client).MSSQL().RestorableDroppedDatabasesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
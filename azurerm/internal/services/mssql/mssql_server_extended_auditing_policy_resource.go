@@ -81,7 +81,7 @@ func resourceMsSqlServerExtendedAuditingPolicy() *schema.Resource {
 }
 
 func resourceMsSqlServerExtendedAuditingPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.ServerExtendedBlobAuditingPoliciesClient
+	client := meta.(*clients.Client).MSSQL().ServerExtendedBlobAuditingPoliciesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -144,8 +144,8 @@ func resourceMsSqlServerExtendedAuditingPolicyCreateUpdate(d *schema.ResourceDat
 }
 
 func resourceMsSqlServerExtendedAuditingPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.ServerExtendedBlobAuditingPoliciesClient
-	serverClient := meta.(*clients.Client).MSSQL.ServersClient
+	client := meta.(*clients.Client).MSSQL().ServerExtendedBlobAuditingPoliciesClient
+	serverClient := meta.(*clients.Client).MSSQL().ServersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -181,7 +181,7 @@ func resourceMsSqlServerExtendedAuditingPolicyRead(d *schema.ResourceData, meta
 }
 
 func resourceMsSqlServerExtendedAuditingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.ServerExtendedBlobAuditingPoliciesClient
+	client := meta.(*clients.Client).MSSQL().ServerExtendedBlobAuditingPoliciesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -113,7 +113,7 @@ func (MsSqlServerExtendedAuditingPolicyResource) Exists(ctx context.Context, cli
 		return nil, err
 	}
 
-	resp, err := client.MSSQL.ServerExtendedBlobAuditingPoliciesClient.Get(ctx, id.ResourceGroup, id.ServerName)
+	resp, err := client.MSSQL().ServerExtendedBlobAuditingPoliciesClient.Get(ctx, id.ResourceGroup, id.ServerName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return nil, fmt.Errorf("SQL Server Extended Auditing Policy for server %q (Resource Group %q) does not exist", id.ServerName, id.ResourceGroup)
@@ -180,10 +180,10 @@ func resourceMsSqlServer() *schema.Resource {
 }
 
 func resourceMsSqlServerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.ServersClient
-	auditingClient := meta.(*clients.Client).MSSQL.ServerExtendedBlobAuditingPoliciesClient
-	connectionClient := meta.(*clients.Client).MSSQL.ServerConnectionPoliciesClient
-	adminClient := meta.(*clients.Client).MSSQL.ServerAzureADAdministratorsClient
+	client := meta.(*clients.Client).MSSQL().ServersClient
+	auditingClient := meta.(*clients.Client).MSSQL().ServerExtendedBlobAuditingPoliciesClient
+	connectionClient := meta.(*clients.Client).MSSQL().ServerConnectionPoliciesClient
+	adminClient := meta.(*clients.Client).MSSQL().ServerAzureADAdministratorsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -305,11 +305,11 @@ func resourceMsSqlServerCreateUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceMsSqlServerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.ServersClient
-	auditingClient := meta.(*clients.Client).MSSQL.ServerExtendedBlobAuditingPoliciesClient
-	connectionClient := meta.(*clients.Client).MSSQL.ServerConnectionPoliciesClient
-	adminClient := meta.(*clients.Client).MSSQL.ServerAzureADAdministratorsClient
-	restorableDroppedDatabasesClient := meta.(*clients.Client).MSSQL.RestorableDroppedDatabasesClient
+	client := meta.(*clients.Client).MSSQL().ServersClient
+	auditingClient := meta.(*clients.Client).MSSQL().ServerExtendedBlobAuditingPoliciesClient
+	connectionClient := meta.(*clients.Client).MSSQL().ServerConnectionPoliciesClient
+	adminClient := meta.(*clients.Client).MSSQL().ServerAzureADAdministratorsClient
+	restorableDroppedDatabasesClient := meta.(*clients.Client).MSSQL().RestorableDroppedDatabasesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -391,7 +391,7 @@ func resourceMsSqlServerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceMsSqlServerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.ServersClient
+	client := meta.(*clients.Client).MSSQL().ServersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -114,7 +114,7 @@ func resourceMsSqlServerSecurityAlertPolicy() *schema.Resource {
 }
 
 func resourceMsSqlServerSecurityAlertPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.ServerSecurityAlertPoliciesClient
+	client := meta.(*clients.Client).MSSQL().ServerSecurityAlertPoliciesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -149,7 +149,7 @@ func resourceMsSqlServerSecurityAlertPolicyCreateUpdate(d *schema.ResourceData,
 }
 
 func resourceMsSqlServerSecurityAlertPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.ServerSecurityAlertPoliciesClient
+	client := meta.(*clients.Client).MSSQL().ServerSecurityAlertPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -220,7 +220,7 @@ func resourceMsSqlServerSecurityAlertPolicyRead(d *schema.ResourceData, meta int
 }
 
 func resourceMsSqlServerSecurityAlertPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.ServerSecurityAlertPoliciesClient
+	client := meta.(*clients.Client).MSSQL().ServerSecurityAlertPoliciesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
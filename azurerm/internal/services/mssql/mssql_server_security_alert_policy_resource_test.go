@@ -60,7 +60,7 @@ func (MsSqlServerSecurityAlertPolicyResource) Exists(ctx context.Context, client
 		return nil, err
 	}
 
-	resp, err := client.MSSQL.ServerSecurityAlertPoliciesClient.Get(ctx, id.ResourceGroup, id.ServerName)
+	resp, err := client.MSSQL().ServerSecurityAlertPoliciesClient.Get(ctx, id.ResourceGroup, id.ServerName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return nil, fmt.Errorf("SQL Security Alert Policy for server %q (Resource Group %q) does not exist", id.ServerName, id.ResourceGroup)
@@ -99,7 +99,7 @@ func resourceMsSqlServerVulnerabilityAssessment() *schema.Resource {
 }
 
 func resourceMsSqlServerVulnerabilityAssessmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.ServerVulnerabilityAssessmentsClient
+	client := meta.(*clients.Client).MSSQL().ServerVulnerabilityAssessmentsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -108,7 +108,7 @@ func resourceMsSqlServerVulnerabilityAssessmentCreateUpdate(d *schema.ResourceDa
 		return err
 	}
 
-	policyClient := meta.(*clients.Client).MSSQL.ServerSecurityAlertPoliciesClient
+	policyClient := meta.(*clients.Client).MSSQL().ServerSecurityAlertPoliciesClient
 
 	policy, err := policyClient.Get(ctx, policyId.ResourceGroup, policyId.ServerName)
 	if err != nil {
@@ -157,7 +157,7 @@ func resourceMsSqlServerVulnerabilityAssessmentCreateUpdate(d *schema.ResourceDa
 }
 
 func resourceMsSqlServerVulnerabilityAssessmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.ServerVulnerabilityAssessmentsClient
+	client := meta.(*clients.Client).MSSQL().ServerVulnerabilityAssessmentsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -179,7 +179,7 @@ func resourceMsSqlServerVulnerabilityAssessmentRead(d *schema.ResourceData, meta
 		return fmt.Errorf("error making read request to mssql server vulnerability assessment: %+v", err)
 	}
 
-	policyClient := meta.(*clients.Client).MSSQL.ServerSecurityAlertPoliciesClient
+	policyClient := meta.(*clients.Client).MSSQL().ServerSecurityAlertPoliciesClient
 	policy, err := policyClient.Get(ctx, id.ResourceGroup, id.ServerName)
 	if err != nil {
 		return fmt.Errorf("Error retrieving Security Alert Policy by ID: %+v", err)
@@ -205,7 +205,7 @@ func resourceMsSqlServerVulnerabilityAssessmentRead(d *schema.ResourceData, meta
 }
 
 func resourceMsSqlServerVulnerabilityAssessmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.ServerVulnerabilityAssessmentsClient
+	client := meta.(*clients.Client).MSSQL().ServerVulnerabilityAssessmentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -60,7 +60,7 @@ func (MsSqlServerVulnerabilityAssessmentResource) Exists(ctx context.Context, cl
 		return nil, err
 	}
 
-	resp, err := client.MSSQL.ServerVulnerabilityAssessmentsClient.Get(ctx, id.ResourceGroup, id.ServerName)
+	resp, err := client.MSSQL().ServerVulnerabilityAssessmentsClient.Get(ctx, id.ResourceGroup, id.ServerName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return nil, fmt.Errorf("SQL Vulnerability Assessment for server %q (Resource Group %q) does not exist", id.ServerName, id.ResourceGroup)
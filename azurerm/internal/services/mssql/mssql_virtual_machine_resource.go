@@ -320,8 +320,8 @@ func resourceMsSqlVirtualMachineCustomDiff(d *schema.ResourceDiff, _ interface{}
 }
 
 func resourceMsSqlVirtualMachineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.VirtualMachinesClient
-	vmclient := meta.(*clients.Client).Compute.VMClient
+	client := meta.(*clients.Client).MSSQL().VirtualMachinesClient
+	vmclient := meta.(*clients.Client).Compute().VMClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -423,7 +423,7 @@ func resourceMsSqlVirtualMachineCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceMsSqlVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.VirtualMachinesClient
+	client := meta.(*clients.Client).MSSQL().VirtualMachinesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -482,7 +482,7 @@ func resourceMsSqlVirtualMachineRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceMsSqlVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MSSQL.VirtualMachinesClient
+	client := meta.(*clients.Client).MSSQL().VirtualMachinesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
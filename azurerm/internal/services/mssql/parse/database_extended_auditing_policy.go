@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabaseExtendedAuditingPolicyId struct {
@@ -79,3 +80,12 @@ func DatabaseExtendedAuditingPolicyID(input string) (*DatabaseExtendedAuditingPo
 
 	return &resourceId, nil
 }
+
+// DatabaseExtendedAuditingPolicyIDNormalized parses a DatabaseExtendedAuditingPolicy ID into an DatabaseExtendedAuditingPolicyId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DatabaseExtendedAuditingPolicyID method should be used instead for validation etc.
+func DatabaseExtendedAuditingPolicyIDNormalized(input string) (*DatabaseExtendedAuditingPolicyId, error) {
+	return DatabaseExtendedAuditingPolicyID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/databases/%s/extendedAuditingSettings/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabaseVulnerabilityAssessmentRuleBaselineId struct {
@@ -91,3 +92,12 @@ func DatabaseVulnerabilityAssessmentRuleBaselineID(input string) (*DatabaseVulne
 
 	return &resourceId, nil
 }
+
+// DatabaseVulnerabilityAssessmentRuleBaselineIDNormalized parses a DatabaseVulnerabilityAssessmentRuleBaseline ID into an DatabaseVulnerabilityAssessmentRuleBaselineId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DatabaseVulnerabilityAssessmentRuleBaselineID method should be used instead for validation etc.
+func DatabaseVulnerabilityAssessmentRuleBaselineIDNormalized(input string) (*DatabaseVulnerabilityAssessmentRuleBaselineId, error) {
+	return DatabaseVulnerabilityAssessmentRuleBaselineID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/databases/%s/vulnerabilityAssessments/%s/rules/%s/baselines/%s"))
+}
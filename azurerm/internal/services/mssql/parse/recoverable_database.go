@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RecoverableDatabaseId struct {
@@ -73,3 +74,12 @@ func RecoverableDatabaseID(input string) (*RecoverableDatabaseId, error) {
 
 	return &resourceId, nil
 }
+
+// RecoverableDatabaseIDNormalized parses a RecoverableDatabase ID into an RecoverableDatabaseId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the RecoverableDatabaseID method should be used instead for validation etc.
+func RecoverableDatabaseIDNormalized(input string) (*RecoverableDatabaseId, error) {
+	return RecoverableDatabaseID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/recoverabledatabases/%s"))
+}
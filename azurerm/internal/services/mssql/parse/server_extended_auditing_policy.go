@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ServerExtendedAuditingPolicyId struct {
@@ -73,3 +74,12 @@ func ServerExtendedAuditingPolicyID(input string) (*ServerExtendedAuditingPolicy
 
 	return &resourceId, nil
 }
+
+// ServerExtendedAuditingPolicyIDNormalized parses a ServerExtendedAuditingPolicy ID into an ServerExtendedAuditingPolicyId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ServerExtendedAuditingPolicyID method should be used instead for validation etc.
+func ServerExtendedAuditingPolicyIDNormalized(input string) (*ServerExtendedAuditingPolicyId, error) {
+	return ServerExtendedAuditingPolicyID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/extendedAuditingSettings/%s"))
+}
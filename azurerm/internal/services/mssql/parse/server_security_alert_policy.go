@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ServerSecurityAlertPolicyId struct {
@@ -73,3 +74,12 @@ func ServerSecurityAlertPolicyID(input string) (*ServerSecurityAlertPolicyId, er
 
 	return &resourceId, nil
 }
+
+// ServerSecurityAlertPolicyIDNormalized parses a ServerSecurityAlertPolicy ID into an ServerSecurityAlertPolicyId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ServerSecurityAlertPolicyID method should be used instead for validation etc.
+func ServerSecurityAlertPolicyIDNormalized(input string) (*ServerSecurityAlertPolicyId, error) {
+	return ServerSecurityAlertPolicyID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/securityAlertPolicies/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ServerVulnerabilityAssessmentId struct {
@@ -73,3 +74,12 @@ func ServerVulnerabilityAssessmentID(input string) (*ServerVulnerabilityAssessme
 
 	return &resourceId, nil
 }
+
+// ServerVulnerabilityAssessmentIDNormalized parses a ServerVulnerabilityAssessment ID into an ServerVulnerabilityAssessmentId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ServerVulnerabilityAssessmentID method should be used instead for validation etc.
+func ServerVulnerabilityAssessmentIDNormalized(input string) (*ServerVulnerabilityAssessmentId, error) {
+	return ServerVulnerabilityAssessmentID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/vulnerabilityAssessments/%s"))
+}
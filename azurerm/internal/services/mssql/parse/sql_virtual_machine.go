@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SqlVirtualMachineId struct {
@@ -67,3 +68,12 @@ func SqlVirtualMachineID(input string) (*SqlVirtualMachineId, error) {
 
 	return &resourceId, nil
 }
+
+// SqlVirtualMachineIDNormalized parses a SqlVirtualMachine ID into an SqlVirtualMachineId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SqlVirtualMachineID method should be used instead for validation etc.
+func SqlVirtualMachineIDNormalized(input string) (*SqlVirtualMachineId, error) {
+	return SqlVirtualMachineID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.SqlVirtualMachine/sqlVirtualMachines/%s"))
+}
@@ -63,7 +63,7 @@ func resourceMySQLAdministrator() *schema.Resource {
 }
 
 func resourceMySQLAdministratorCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.ServerAdministratorsClient
+	client := meta.(*clients.Client).MySQL().ServerAdministratorsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -115,7 +115,7 @@ func resourceMySQLAdministratorCreateUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceMySQLAdministratorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.ServerAdministratorsClient
+	client := meta.(*clients.Client).MySQL().ServerAdministratorsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -148,7 +148,7 @@ func resourceMySQLAdministratorRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceMySQLAdministratorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.ServerAdministratorsClient
+	client := meta.(*clients.Client).MySQL().ServerAdministratorsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -80,7 +80,7 @@ func (r MySqlAdministratorResource) Exists(ctx context.Context, clients *clients
 	resourceGroup := id.ResourceGroup
 	serverName := id.Path["servers"]
 
-	resp, err := clients.MySQL.ServerAdministratorsClient.Get(ctx, resourceGroup, serverName)
+	resp, err := clients.MySQL().ServerAdministratorsClient.Get(ctx, resourceGroup, serverName)
 	if err != nil {
 		return nil, fmt.Errorf("reading MySQL Administrator (%s): %+v", id, err)
 	}
@@ -97,12 +97,12 @@ func (r MySqlAdministratorResource) Destroy(ctx context.Context, client *clients
 	resourceGroup := id.ResourceGroup
 	serverName := id.Path["servers"]
 
-	future, err := client.MySQL.ServerAdministratorsClient.Delete(ctx, resourceGroup, serverName)
+	future, err := client.MySQL().ServerAdministratorsClient.Delete(ctx, resourceGroup, serverName)
 	if err != nil {
 		return nil, fmt.Errorf("deleting MySQL Administrator (%s): %+v", id, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.MySQL.ServerAdministratorsClient.Client); err != nil {
+	if err := future.WaitForCompletionRef(ctx, client.MySQL().ServerAdministratorsClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for deletion of MySQL Administrator (%s): %+v", id, err)
 	}
 
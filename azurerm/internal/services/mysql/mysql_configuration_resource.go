@@ -58,7 +58,7 @@ func resourceMySQLConfiguration() *schema.Resource {
 }
 
 func resourceMySQLConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.ConfigurationsClient
+	client := meta.(*clients.Client).MySQL().ConfigurationsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -101,7 +101,7 @@ func resourceMySQLConfigurationCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceMySQLConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.ConfigurationsClient
+	client := meta.(*clients.Client).MySQL().ConfigurationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -134,7 +134,7 @@ func resourceMySQLConfigurationRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceMySQLConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.ConfigurationsClient
+	client := meta.(*clients.Client).MySQL().ConfigurationsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
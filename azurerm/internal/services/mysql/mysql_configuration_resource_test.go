@@ -88,7 +88,7 @@ func (t MySQLConfigurationResource) Exists(ctx context.Context, clients *clients
 		return nil, err
 	}
 
-	resp, err := clients.MySQL.ConfigurationsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	resp, err := clients.MySQL().ConfigurationsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading MySQL Configuration (%s): %+v", id, err)
 	}
@@ -103,7 +103,7 @@ func (r MySQLConfigurationResource) checkReset(configurationName string) accepta
 			return err
 		}
 
-		resp, err := clients.MySQL.ConfigurationsClient.Get(ctx, id.ResourceGroup, id.Name, configurationName)
+		resp, err := clients.MySQL().ConfigurationsClient.Get(ctx, id.ResourceGroup, id.Name, configurationName)
 		if err != nil {
 			if utils.ResponseWasNotFound(resp.Response) {
 				return fmt.Errorf("Bad: MySQL Configuration %q (server %q resource group: %q) does not exist", configurationName, id.Name, id.ResourceGroup)
@@ -129,7 +129,7 @@ func (r MySQLConfigurationResource) checkValue(value string) acceptance.ClientCh
 			return err
 		}
 
-		resp, err := clients.MySQL.ConfigurationsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+		resp, err := clients.MySQL().ConfigurationsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 		if err != nil {
 			if utils.ResponseWasNotFound(resp.Response) {
 				return fmt.Errorf("Bad: MySQL Configuration %q (server %q resource group: %q) does not exist", id.Name, id.ServerName, id.ResourceGroup)
@@ -66,7 +66,7 @@ func resourceMySqlDatabase() *schema.Resource {
 }
 
 func resourceMySqlDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.DatabasesClient
+	client := meta.(*clients.Client).MySQL().DatabasesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -122,7 +122,7 @@ func resourceMySqlDatabaseCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceMySqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.DatabasesClient
+	client := meta.(*clients.Client).MySQL().DatabasesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -154,7 +154,7 @@ func resourceMySqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceMySqlDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.DatabasesClient
+	client := meta.(*clients.Client).MySQL().DatabasesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -92,7 +92,7 @@ func (t MySQLDatabaseResource) Exists(ctx context.Context, clients *clients.Clie
 	serverName := id.Path["servers"]
 	name := id.Path["databases"]
 
-	resp, err := clients.MySQL.DatabasesClient.Get(ctx, resourceGroup, serverName, name)
+	resp, err := clients.MySQL().DatabasesClient.Get(ctx, resourceGroup, serverName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading MySQL Database (%s): %+v", id, err)
 	}
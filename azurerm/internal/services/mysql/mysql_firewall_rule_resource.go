@@ -66,7 +66,7 @@ func resourceMySqlFirewallRule() *schema.Resource {
 }
 
 func resourceMySqlFirewallRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.FirewallRulesClient
+	client := meta.(*clients.Client).MySQL().FirewallRulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -121,7 +121,7 @@ func resourceMySqlFirewallRuleCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceMySqlFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.FirewallRulesClient
+	client := meta.(*clients.Client).MySQL().FirewallRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -152,7 +152,7 @@ func resourceMySqlFirewallRuleRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceMySqlFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.FirewallRulesClient
+	client := meta.(*clients.Client).MySQL().FirewallRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
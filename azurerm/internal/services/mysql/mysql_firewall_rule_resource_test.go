@@ -59,7 +59,7 @@ func (t MySQLFirewallRuleResource) Exists(ctx context.Context, clients *clients.
 	serverName := id.Path["servers"]
 	name := id.Path["firewallRules"]
 
-	resp, err := clients.MySQL.FirewallRulesClient.Get(ctx, resourceGroup, serverName, name)
+	resp, err := clients.MySQL().FirewallRulesClient.Get(ctx, resourceGroup, serverName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading MySQL Firewall Rule (%s): %+v", id, err)
 	}
@@ -179,8 +179,8 @@ func dataSourceMySqlServer() *schema.Resource {
 }
 
 func dataSourceMySqlServerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.ServersClient
-	securityClient := meta.(*clients.Client).MySQL.ServerSecurityAlertPoliciesClient
+	client := meta.(*clients.Client).MySQL().ServersClient
+	securityClient := meta.(*clients.Client).MySQL().ServerSecurityAlertPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
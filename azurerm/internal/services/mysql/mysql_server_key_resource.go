@@ -75,9 +75,9 @@ func getMySQLServerKeyName(ctx context.Context, keyVaultsClient *client.Client,
 }
 
 func resourceMySQLServerKeyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	keysClient := meta.(*clients.Client).MySQL.ServerKeysClient
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	resourcesClient := meta.(*clients.Client).Resource
+	keysClient := meta.(*clients.Client).MySQL().ServerKeysClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -91,7 +91,9 @@ func resourceMySQLServerKeyCreateUpdate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("cannot compose name for MySQL Server Key (Resource Group %q / Server %q): %+v", serverID.ResourceGroup, serverID.Name, err)
 	}
 
-	locks.ByName(serverID.Name, mySQLServerResourceName)
+	if err := locks.ByNameWithContext(ctx, serverID.Name, mySQLServerResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Key (MySQL Server %q / Resource Group %q): %+v", serverID.Name, serverID.ResourceGroup, err)
+	}
 	defer locks.UnlockByName(serverID.Name, mySQLServerResourceName)
 
 	if d.IsNewResource() {
@@ -140,8 +142,8 @@ func resourceMySQLServerKeyCreateUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceMySQLServerKeyRead(d *schema.ResourceData, meta interface{}) error {
-	serversClient := meta.(*clients.Client).MySQL.ServersClient
-	keysClient := meta.(*clients.Client).MySQL.ServerKeysClient
+	serversClient := meta.(*clients.Client).MySQL().ServersClient
+	keysClient := meta.(*clients.Client).MySQL().ServerKeysClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -175,7 +177,7 @@ func resourceMySQLServerKeyRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceMySQLServerKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.ServerKeysClient
+	client := meta.(*clients.Client).MySQL().ServerKeysClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -184,7 +186,9 @@ func resourceMySQLServerKeyDelete(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	locks.ByName(id.ServerName, mySQLServerResourceName)
+	if err := locks.ByNameWithContext(ctx, id.ServerName, mySQLServerResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.ServerName, mySQLServerResourceName)
 
 	future, err := client.Delete(ctx, id.ServerName, id.Name, id.ResourceGroup)
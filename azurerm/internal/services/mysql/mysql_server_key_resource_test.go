@@ -74,7 +74,7 @@ func (t MySQLServerKeyResource) Exists(ctx context.Context, clients *clients.Cli
 		return nil, err
 	}
 
-	resp, err := clients.MySQL.ServerKeysClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	resp, err := clients.MySQL().ServerKeysClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading MySQL Server Key (%s): %+v", id, err)
 	}
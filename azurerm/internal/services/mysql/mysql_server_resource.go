@@ -393,8 +393,8 @@ func resourceMySqlServer() *schema.Resource {
 }
 
 func resourceMySqlServerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.ServersClient
-	securityClient := meta.(*clients.Client).MySQL.ServerSecurityAlertPoliciesClient
+	client := meta.(*clients.Client).MySQL().ServersClient
+	securityClient := meta.(*clients.Client).MySQL().ServerSecurityAlertPoliciesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -566,8 +566,8 @@ func resourceMySqlServerCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceMySqlServerUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.ServersClient
-	securityClient := meta.(*clients.Client).MySQL.ServerSecurityAlertPoliciesClient
+	client := meta.(*clients.Client).MySQL().ServersClient
+	securityClient := meta.(*clients.Client).MySQL().ServerSecurityAlertPoliciesClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -649,8 +649,8 @@ func resourceMySqlServerUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceMySqlServerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.ServersClient
-	securityClient := meta.(*clients.Client).MySQL.ServerSecurityAlertPoliciesClient
+	client := meta.(*clients.Client).MySQL().ServersClient
+	securityClient := meta.(*clients.Client).MySQL().ServerSecurityAlertPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -730,7 +730,7 @@ func resourceMySqlServerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceMySqlServerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.ServersClient
+	client := meta.(*clients.Client).MySQL().ServersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
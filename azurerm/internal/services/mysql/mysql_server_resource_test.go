@@ -341,7 +341,7 @@ func (t MySQLServerResource) Exists(ctx context.Context, clients *clients.Client
 		return nil, err
 	}
 
-	resp, err := clients.MySQL.ServersClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.MySQL().ServersClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading MySQL Server (%s): %+v", id, err)
 	}
@@ -64,7 +64,7 @@ func resourceMySQLVirtualNetworkRule() *schema.Resource {
 }
 
 func resourceMySQLVirtualNetworkRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.VirtualNetworkRulesClient
+	client := meta.(*clients.Client).MySQL().VirtualNetworkRulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -127,7 +127,7 @@ func resourceMySQLVirtualNetworkRuleCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceMySQLVirtualNetworkRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.VirtualNetworkRulesClient
+	client := meta.(*clients.Client).MySQL().VirtualNetworkRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -159,7 +159,7 @@ func resourceMySQLVirtualNetworkRuleRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceMySQLVirtualNetworkRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).MySQL.VirtualNetworkRulesClient
+	client := meta.(*clients.Client).MySQL().VirtualNetworkRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
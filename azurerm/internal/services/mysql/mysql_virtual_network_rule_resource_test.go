@@ -123,7 +123,7 @@ func (r MySQLVirtualNetworkRuleResource) Exists(ctx context.Context, clients *cl
 	if err != nil {
 		return nil, err
 	}
-	resp, err := clients.MySQL.VirtualNetworkRulesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	resp, err := clients.MySQL().VirtualNetworkRulesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading MySQL Virtual Network Rule (%s): %+v", id, err)
 	}
@@ -137,12 +137,12 @@ func (r MySQLVirtualNetworkRuleResource) Destroy(ctx context.Context, client *cl
 		return nil, err
 	}
 
-	future, err := client.MySQL.VirtualNetworkRulesClient.Delete(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	future, err := client.MySQL().VirtualNetworkRulesClient.Delete(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting MySQL Virtual Network Rule (%s): %+v", id, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.MySQL.VirtualNetworkRulesClient.Client); err != nil {
+	if err := future.WaitForCompletionRef(ctx, client.MySQL().VirtualNetworkRulesClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for deletion of MySQL Virtual Network Rule (%s): %+v", id, err)
 	}
 
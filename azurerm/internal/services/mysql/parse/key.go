@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type KeyId struct {
@@ -73,3 +74,12 @@ func KeyID(input string) (*KeyId, error) {
 
 	return &resourceId, nil
 }
+
+// KeyIDNormalized parses a Key ID into an KeyId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the KeyID method should be used instead for validation etc.
+func KeyIDNormalized(input string) (*KeyId, error) {
+	return KeyID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/servers/%s/keys/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualNetworkRuleId struct {
@@ -73,3 +74,12 @@ func VirtualNetworkRuleID(input string) (*VirtualNetworkRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// VirtualNetworkRuleIDNormalized parses a VirtualNetworkRule ID into an VirtualNetworkRuleId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VirtualNetworkRuleID method should be used instead for validation etc.
+func VirtualNetworkRuleIDNormalized(input string) (*VirtualNetworkRuleId, error) {
+	return VirtualNetworkRuleID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/servers/%s/virtualNetworkRules/%s"))
+}
@@ -36,7 +36,7 @@ func dataSourceNetAppAccount() *schema.Resource {
 }
 
 func dataSourceNetAppAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NetApp.AccountClient
+	client := meta.(*clients.Client).NetApp().AccountClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -107,7 +107,7 @@ func resourceNetAppAccount() *schema.Resource {
 }
 
 func resourceNetAppAccountCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NetApp.AccountClient
+	client := meta.(*clients.Client).NetApp().AccountClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -158,7 +158,7 @@ func resourceNetAppAccountCreateUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceNetAppAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NetApp.AccountClient
+	client := meta.(*clients.Client).NetApp().AccountClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -187,7 +187,7 @@ func resourceNetAppAccountRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceNetAppAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NetApp.AccountClient
+	client := meta.(*clients.Client).NetApp().AccountClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
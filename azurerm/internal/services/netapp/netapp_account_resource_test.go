@@ -127,7 +127,7 @@ func (t NetAppAccountResource) Exists(ctx context.Context, clients *clients.Clie
 		return nil, err
 	}
 
-	resp, err := clients.NetApp.AccountClient.Get(ctx, id.ResourceGroup, id.NetAppAccountName)
+	resp, err := clients.NetApp().AccountClient.Get(ctx, id.ResourceGroup, id.NetAppAccountName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Netapp Account (%s): %+v", id.String(), err)
 	}
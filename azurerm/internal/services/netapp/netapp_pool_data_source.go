@@ -50,7 +50,7 @@ func dataSourceNetAppPool() *schema.Resource {
 }
 
 func dataSourceNetAppPoolRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NetApp.PoolClient
+	client := meta.(*clients.Client).NetApp().PoolClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -80,7 +80,7 @@ func resourceNetAppPool() *schema.Resource {
 }
 
 func resourceNetAppPoolCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NetApp.PoolClient
+	client := meta.(*clients.Client).NetApp().PoolClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -136,7 +136,7 @@ func resourceNetAppPoolCreateUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceNetAppPoolRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NetApp.PoolClient
+	client := meta.(*clients.Client).NetApp().PoolClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -177,7 +177,7 @@ func resourceNetAppPoolRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceNetAppPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NetApp.PoolClient
+	client := meta.(*clients.Client).NetApp().PoolClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
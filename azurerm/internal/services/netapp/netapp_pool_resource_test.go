@@ -104,7 +104,7 @@ func (t NetAppPoolResource) Exists(ctx context.Context, clients *clients.Client,
 		return nil, err
 	}
 
-	resp, err := clients.NetApp.PoolClient.Get(ctx, id.ResourceGroup, id.NetAppAccountName, id.Name)
+	resp, err := clients.NetApp().PoolClient.Get(ctx, id.ResourceGroup, id.NetAppAccountName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Netapp Pool (%s): %+v", id.String(), err)
 	}
@@ -52,7 +52,7 @@ func dataSourceNetAppSnapshot() *schema.Resource {
 }
 
 func dataSourceNetAppSnapshotRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NetApp.SnapshotClient
+	client := meta.(*clients.Client).NetApp().SnapshotClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -86,7 +86,7 @@ func resourceNetAppSnapshot() *schema.Resource {
 }
 
 func resourceNetAppSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NetApp.SnapshotClient
+	client := meta.(*clients.Client).NetApp().SnapshotClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -139,7 +139,7 @@ func resourceNetAppSnapshotCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceNetAppSnapshotRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NetApp.SnapshotClient
+	client := meta.(*clients.Client).NetApp().SnapshotClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -181,7 +181,7 @@ func resourceNetAppSnapshotUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceNetAppSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NetApp.SnapshotClient
+	client := meta.(*clients.Client).NetApp().SnapshotClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
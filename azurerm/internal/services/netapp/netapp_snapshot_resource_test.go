@@ -112,7 +112,7 @@ func (t NetAppSnapshotResource) Exists(ctx context.Context, clients *clients.Cli
 		return nil, err
 	}
 
-	resp, err := clients.NetApp.SnapshotClient.Get(ctx, id.ResourceGroup, id.NetAppAccountName, id.CapacityPoolName, id.VolumeName, id.Name)
+	resp, err := clients.NetApp().SnapshotClient.Get(ctx, id.ResourceGroup, id.NetAppAccountName, id.CapacityPoolName, id.VolumeName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Netapp Snapshot (%s): %+v", id.String(), err)
 	}
@@ -105,7 +105,7 @@ func dataSourceNetAppVolume() *schema.Resource {
 }
 
 func dataSourceNetAppVolumeRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NetApp.VolumeClient
+	client := meta.(*clients.Client).NetApp().VolumeClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -236,7 +236,7 @@ func resourceNetAppVolume() *schema.Resource {
 }
 
 func resourceNetAppVolumeCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NetApp.VolumeClient
+	client := meta.(*clients.Client).NetApp().VolumeClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -350,7 +350,7 @@ func resourceNetAppVolumeCreateUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceNetAppVolumeRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NetApp.VolumeClient
+	client := meta.(*clients.Client).NetApp().VolumeClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -401,7 +401,7 @@ func resourceNetAppVolumeRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceNetAppVolumeDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NetApp.VolumeClient
+	client := meta.(*clients.Client).NetApp().VolumeClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
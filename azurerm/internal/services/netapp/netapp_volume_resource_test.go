@@ -209,7 +209,7 @@ func (t NetAppVolumeResource) Exists(ctx context.Context, clients *clients.Clien
 		return nil, err
 	}
 
-	resp, err := clients.NetApp.VolumeClient.Get(ctx, id.ResourceGroup, id.NetAppAccountName, id.CapacityPoolName, id.Name)
+	resp, err := clients.NetApp().VolumeClient.Get(ctx, id.ResourceGroup, id.NetAppAccountName, id.CapacityPoolName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Netapp Volume (%s): %+v", id.String(), err)
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CapacityPoolId struct {
@@ -73,3 +74,12 @@ func CapacityPoolID(input string) (*CapacityPoolId, error) {
 
 	return &resourceId, nil
 }
+
+// CapacityPoolIDNormalized parses a CapacityPool ID into an CapacityPoolId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the CapacityPoolID method should be used instead for validation etc.
+func CapacityPoolIDNormalized(input string) (*CapacityPoolId, error) {
+	return CapacityPoolID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.NetApp/netAppAccounts/%s/capacityPools/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SnapshotId struct {
@@ -85,3 +86,12 @@ func SnapshotID(input string) (*SnapshotId, error) {
 
 	return &resourceId, nil
 }
+
+// SnapshotIDNormalized parses a Snapshot ID into an SnapshotId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SnapshotID method should be used instead for validation etc.
+func SnapshotIDNormalized(input string) (*SnapshotId, error) {
+	return SnapshotID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.NetApp/netAppAccounts/%s/capacityPools/%s/volumes/%s/snapshots/%s"))
+}
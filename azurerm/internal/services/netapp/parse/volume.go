@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VolumeId struct {
@@ -79,3 +80,12 @@ func VolumeID(input string) (*VolumeId, error) {
 
 	return &resourceId, nil
 }
+
+// VolumeIDNormalized parses a Volume ID into an VolumeId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VolumeID method should be used instead for validation etc.
+func VolumeIDNormalized(input string) (*VolumeId, error) {
+	return VolumeID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.NetApp/netAppAccounts/%s/capacityPools/%s/volumes/%s"))
+}
@@ -44,7 +44,7 @@ func dataSourceApplicationGateway() *schema.Resource {
 }
 
 func dataSourceApplicationGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	client := meta.(*clients.Client).Network().ApplicationGatewaysClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
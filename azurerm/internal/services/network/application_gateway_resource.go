@@ -1322,7 +1322,7 @@ func resourceApplicationGateway() *schema.Resource {
 }
 
 func resourceApplicationGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	client := meta.(*clients.Client).Network().ApplicationGatewaysClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -1493,7 +1493,7 @@ func resourceApplicationGatewayCreateUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceApplicationGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	client := meta.(*clients.Client).Network().ApplicationGatewaysClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -1637,7 +1637,7 @@ func resourceApplicationGatewayRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceApplicationGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	client := meta.(*clients.Client).Network().ApplicationGatewaysClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -936,7 +936,7 @@ func (t ApplicationGatewayResource) Exists(ctx context.Context, clients *clients
 		return nil, err
 	}
 
-	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Network().ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Application Gateway (%s): %+v", id, err)
 	}
@@ -3799,7 +3799,7 @@ func (ApplicationGatewayResource) changeCert(certificateName string) acceptance.
 		gatewayName := state.Attributes["name"]
 		resourceGroup := state.Attributes["resource_group_name"]
 
-		agw, err := clients.Network.ApplicationGatewaysClient.Get(ctx, resourceGroup, gatewayName)
+		agw, err := clients.Network().ApplicationGatewaysClient.Get(ctx, resourceGroup, gatewayName)
 		if err != nil {
 			return fmt.Errorf("Bad: Get on ApplicationGatewaysClient: %+v", err)
 		}
@@ -3823,12 +3823,12 @@ func (ApplicationGatewayResource) changeCert(certificateName string) acceptance.
 
 		agw.SslCertificates = &newSslCertificates
 
-		future, err := clients.Network.ApplicationGatewaysClient.CreateOrUpdate(ctx, resourceGroup, gatewayName, agw)
+		future, err := clients.Network().ApplicationGatewaysClient.CreateOrUpdate(ctx, resourceGroup, gatewayName, agw)
 		if err != nil {
 			return fmt.Errorf("Bad: updating AGW: %+v", err)
 		}
 
-		if err := future.WaitForCompletionRef(ctx, clients.Network.ApplicationGatewaysClient.Client); err != nil {
+		if err := future.WaitForCompletionRef(ctx, clients.Network().ApplicationGatewaysClient.Client); err != nil {
 			return fmt.Errorf("Bad: waiting for update of AGW: %+v", err)
 		}
 
@@ -36,7 +36,7 @@ func dataSourceApplicationSecurityGroup() *schema.Resource {
 }
 
 func dataSourceApplicationSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ApplicationSecurityGroupsClient
+	client := meta.(*clients.Client).Network().ApplicationSecurityGroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
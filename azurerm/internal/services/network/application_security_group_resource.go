@@ -50,7 +50,7 @@ func resourceApplicationSecurityGroup() *schema.Resource {
 }
 
 func resourceApplicationSecurityGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ApplicationSecurityGroupsClient
+	client := meta.(*clients.Client).Network().ApplicationSecurityGroupsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -100,7 +100,7 @@ func resourceApplicationSecurityGroupCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceApplicationSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ApplicationSecurityGroupsClient
+	client := meta.(*clients.Client).Network().ApplicationSecurityGroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -130,7 +130,7 @@ func resourceApplicationSecurityGroupRead(d *schema.ResourceData, meta interface
 }
 
 func resourceApplicationSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ApplicationSecurityGroupsClient
+	client := meta.(*clients.Client).Network().ApplicationSecurityGroupsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -97,7 +97,7 @@ func (t ApplicationSecurityGroupResource) Exists(ctx context.Context, clients *c
 	resGroup := id.ResourceGroup
 	name := id.Path["applicationSecurityGroups"]
 
-	resp, err := clients.Network.ApplicationSecurityGroupsClient.Get(ctx, resGroup, name)
+	resp, err := clients.Network().ApplicationSecurityGroupsClient.Get(ctx, resGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Application Security Group (%s): %+v", id, err)
 	}
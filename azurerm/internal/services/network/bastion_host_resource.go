@@ -87,7 +87,7 @@ func resourceBastionHost() *schema.Resource {
 }
 
 func resourceBastionHostCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.BastionHostsClient
+	client := meta.(*clients.Client).Network().BastionHostsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -139,7 +139,7 @@ func resourceBastionHostCreateUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceBastionHostRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.BastionHostsClient
+	client := meta.(*clients.Client).Network().BastionHostsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -179,7 +179,7 @@ func resourceBastionHostRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBastionHostDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.BastionHostsClient
+	client := meta.(*clients.Client).Network().BastionHostsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
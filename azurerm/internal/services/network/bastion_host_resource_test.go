@@ -72,7 +72,7 @@ func (BastionHostResource) Exists(ctx context.Context, clients *clients.Client,
 		return nil, err
 	}
 
-	resp, err := clients.Network.BastionHostsClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Network().BastionHostsClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Bastion Host (%s): %+v", *id, err)
 	}
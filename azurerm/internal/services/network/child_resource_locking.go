@@ -0,0 +1,39 @@
+package network
+
+import (
+	"context"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+)
+
+// lockingParent returns the parent which is locked exclusively when changing a child resource (for example the
+// Virtual Network containing a Subnet) - so that the children of the same parent are changed one at a time
+func lockingParent(name string, resourceType string) locks.Parent {
+	return locks.Parent{
+		Name:         name,
+		ResourceType: resourceType,
+	}
+}
+
+// networkSecurityGroupLockingParent returns the Network Security Group which is locked when changing a Network Security
+// Rule - when relaxed locking is enabled this is locked for reading, so that the other Rules within the same Network
+// Security Group can be changed concurrently, otherwise this is locked exclusively
+func networkSecurityGroupLockingParent(meta interface{}, name string) locks.Parent {
+	return locks.Parent{
+		Name:         name,
+		ResourceType: networkSecurityGroupResourceName,
+		Shared:       meta.(*clients.Client).Features.Network.RelaxedLocking,
+	}
+}
+
+// lockReferencedResource locks a resource which is referenced (but not changed) by the resource being changed, for
+// example the Network Security Group associated with a Subnet - this is only read, so is locked for reading such that
+// other resources can reference it concurrently, but not whilst it's being changed
+func lockReferencedResource(ctx context.Context, name string, resourceType string) error {
+	return locks.SharedByNameWithContext(ctx, name, resourceType)
+}
+
+func unlockReferencedResource(name string, resourceType string) {
+	locks.UnlockSharedByName(name, resourceType)
+}
@@ -61,7 +61,7 @@ func resourceExpressRouteCircuitAuthorization() *schema.Resource {
 }
 
 func resourceExpressRouteCircuitAuthorizationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ExpressRouteAuthsClient
+	client := meta.(*clients.Client).Network().ExpressRouteAuthsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -69,7 +69,9 @@ func resourceExpressRouteCircuitAuthorizationCreate(d *schema.ResourceData, meta
 	resourceGroup := d.Get("resource_group_name").(string)
 	circuitName := d.Get("express_route_circuit_name").(string)
 
-	locks.ByName(circuitName, expressRouteCircuitResourceName)
+	if err := locks.ByNameWithContext(ctx, circuitName, expressRouteCircuitResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Express Route Circuit Authorization %q (Circuit %q / Resource Group %q): %+v", name, circuitName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(circuitName, expressRouteCircuitResourceName)
 
 	if d.IsNewResource() {
@@ -109,7 +111,7 @@ func resourceExpressRouteCircuitAuthorizationCreate(d *schema.ResourceData, meta
 }
 
 func resourceExpressRouteCircuitAuthorizationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ExpressRouteAuthsClient
+	client := meta.(*clients.Client).Network().ExpressRouteAuthsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -144,7 +146,7 @@ func resourceExpressRouteCircuitAuthorizationRead(d *schema.ResourceData, meta i
 }
 
 func resourceExpressRouteCircuitAuthorizationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ExpressRouteAuthsClient
+	client := meta.(*clients.Client).Network().ExpressRouteAuthsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -157,7 +159,9 @@ func resourceExpressRouteCircuitAuthorizationDelete(d *schema.ResourceData, meta
 	circuitName := id.Path["expressRouteCircuits"]
 	name := id.Path["authorizations"]
 
-	locks.ByName(circuitName, expressRouteCircuitResourceName)
+	if err := locks.ByNameWithContext(ctx, circuitName, expressRouteCircuitResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Express Route Circuit Authorization %q (Circuit %q / Resource Group %q): %+v", name, circuitName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(circuitName, expressRouteCircuitResourceName)
 
 	future, err := client.Delete(ctx, resourceGroup, circuitName, name)
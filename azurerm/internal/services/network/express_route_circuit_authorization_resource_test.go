@@ -79,7 +79,7 @@ func (t ExpressRouteCircuitAuthorizationResource) Exists(ctx context.Context, cl
 	circuitName := id.Path["expressRouteCircuits"]
 	name := id.Path["authorizations"]
 
-	resp, err := clients.Network.ExpressRouteAuthsClient.Get(ctx, resGroup, circuitName, name)
+	resp, err := clients.Network().ExpressRouteAuthsClient.Get(ctx, resGroup, circuitName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Express Route Circuit Authorization (%s): %+v", id, err)
 	}
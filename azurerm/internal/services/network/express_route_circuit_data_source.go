@@ -122,7 +122,7 @@ func dataSourceExpressRouteCircuit() *schema.Resource {
 }
 
 func dataSourceExpressRouteCircuitRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ExpressRouteCircuitsClient
+	client := meta.(*clients.Client).Network().ExpressRouteCircuitsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -193,7 +193,7 @@ func resourceExpressRouteCircuitPeering() *schema.Resource {
 }
 
 func resourceExpressRouteCircuitPeeringCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ExpressRoutePeeringsClient
+	client := meta.(*clients.Client).Network().ExpressRoutePeeringsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -203,7 +203,9 @@ func resourceExpressRouteCircuitPeeringCreateUpdate(d *schema.ResourceData, meta
 	circuitName := d.Get("express_route_circuit_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(circuitName, expressRouteCircuitResourceName)
+	if err := locks.ByNameWithContext(ctx, circuitName, expressRouteCircuitResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Express Route Circuit Peering %q (Circuit %q / Resource Group %q): %+v", peeringType, circuitName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(circuitName, expressRouteCircuitResourceName)
 
 	if d.IsNewResource() {
@@ -291,7 +293,7 @@ func resourceExpressRouteCircuitPeeringCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceExpressRouteCircuitPeeringRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ExpressRoutePeeringsClient
+	client := meta.(*clients.Client).Network().ExpressRoutePeeringsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -344,7 +346,7 @@ func resourceExpressRouteCircuitPeeringRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceExpressRouteCircuitPeeringDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ExpressRoutePeeringsClient
+	client := meta.(*clients.Client).Network().ExpressRoutePeeringsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -357,7 +359,9 @@ func resourceExpressRouteCircuitPeeringDelete(d *schema.ResourceData, meta inter
 	circuitName := id.Path["expressRouteCircuits"]
 	peeringType := id.Path["peerings"]
 
-	locks.ByName(circuitName, expressRouteCircuitResourceName)
+	if err := locks.ByNameWithContext(ctx, circuitName, expressRouteCircuitResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Express Route Circuit Peering %q (Circuit %q / Resource Group %q): %+v", peeringType, circuitName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(circuitName, expressRouteCircuitResourceName)
 
 	future, err := client.Delete(ctx, resourceGroup, circuitName, peeringType)
@@ -182,7 +182,7 @@ func (t ExpressRouteCircuitPeeringResource) Exists(ctx context.Context, clients
 	circuitName := id.Path["expressRouteCircuits"]
 	peeringType := id.Path["peerings"]
 
-	resp, err := clients.Network.ExpressRoutePeeringsClient.Get(ctx, resourceGroup, circuitName, peeringType)
+	resp, err := clients.Network().ExpressRoutePeeringsClient.Get(ctx, resourceGroup, circuitName, peeringType)
 	if err != nil {
 		return nil, fmt.Errorf("reading Express Route Circuit Peering (%s): %+v", id, err)
 	}
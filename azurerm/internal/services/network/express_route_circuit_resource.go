@@ -132,7 +132,7 @@ func resourceExpressRouteCircuit() *schema.Resource {
 }
 
 func resourceExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ExpressRouteCircuitsClient
+	client := meta.(*clients.Client).Network().ExpressRouteCircuitsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -141,7 +141,9 @@ func resourceExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta interf
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(name, expressRouteCircuitResourceName)
+	if err := locks.ByNameWithContext(ctx, name, expressRouteCircuitResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for ExpressRoute Circuit %q (Resource Group %q): %+v", name, resGroup, err)
+	}
 	defer locks.UnlockByName(name, expressRouteCircuitResourceName)
 
 	if d.IsNewResource() {
@@ -251,7 +253,7 @@ func resourceExpressRouteCircuitCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceExpressRouteCircuitRead(d *schema.ResourceData, meta interface{}) error {
-	ercClient := meta.(*clients.Client).Network.ExpressRouteCircuitsClient
+	ercClient := meta.(*clients.Client).Network().ExpressRouteCircuitsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -301,7 +303,7 @@ func resourceExpressRouteCircuitRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceExpressRouteCircuitDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ExpressRouteCircuitsClient
+	client := meta.(*clients.Client).Network().ExpressRouteCircuitsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -313,7 +315,9 @@ func resourceExpressRouteCircuitDelete(d *schema.ResourceData, meta interface{})
 	resourceGroup := id.ResourceGroup
 	name := id.Path["expressRouteCircuits"]
 
-	locks.ByName(name, expressRouteCircuitResourceName)
+	if err := locks.ByNameWithContext(ctx, name, expressRouteCircuitResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for ExpressRoute Circuit %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, expressRouteCircuitResourceName)
 
 	future, err := client.Delete(ctx, resourceGroup, name)
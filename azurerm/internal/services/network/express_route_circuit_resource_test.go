@@ -267,7 +267,7 @@ func (t ExpressRouteCircuitResource) Exists(ctx context.Context, clients *client
 	resGroup := id.ResourceGroup
 	name := id.Path["expressRouteCircuits"]
 
-	resp, err := clients.Network.ExpressRouteCircuitsClient.Get(ctx, resGroup, name)
+	resp, err := clients.Network().ExpressRouteCircuitsClient.Get(ctx, resGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Express Route Circuit (%s): %+v", id, err)
 	}
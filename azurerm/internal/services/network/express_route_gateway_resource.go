@@ -65,7 +65,7 @@ func resourceExpressRouteGateway() *schema.Resource {
 }
 
 func resourceExpressRouteGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ExpressRouteGatewaysClient
+	client := meta.(*clients.Client).Network().ExpressRouteGatewaysClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -127,7 +127,7 @@ func resourceExpressRouteGatewayCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceExpressRouteGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ExpressRouteGatewaysClient
+	client := meta.(*clients.Client).Network().ExpressRouteGatewaysClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -172,7 +172,7 @@ func resourceExpressRouteGatewayRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceExpressRouteGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ExpressRouteGatewaysClient
+	client := meta.(*clients.Client).Network().ExpressRouteGatewaysClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
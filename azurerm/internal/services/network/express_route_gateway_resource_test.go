@@ -89,7 +89,7 @@ func (t ExpressRouteGatewayResource) Exists(ctx context.Context, clients *client
 	resGroup := id.ResourceGroup
 	name := id.Path["expressRouteGateways"]
 
-	resp, err := clients.Network.ExpressRouteGatewaysClient.Get(ctx, resGroup, name)
+	resp, err := clients.Network().ExpressRouteGatewaysClient.Get(ctx, resGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Express Route Gateway (%s): %+v", id, err)
 	}
@@ -43,7 +43,7 @@ func dataSourceIpGroup() *schema.Resource {
 }
 
 func dataSourceIpGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.IPGroupsClient
+	client := meta.(*clients.Client).Network().IPGroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
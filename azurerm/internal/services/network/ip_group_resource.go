@@ -64,7 +64,7 @@ func resourceIpGroup() *schema.Resource {
 }
 
 func resourceIpGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.IPGroupsClient
+	client := meta.(*clients.Client).Network().IPGroupsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -120,7 +120,7 @@ func resourceIpGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceIpGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.IPGroupsClient
+	client := meta.(*clients.Client).Network().IPGroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -157,7 +157,7 @@ func resourceIpGroupRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIpGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.IPGroupsClient
+	client := meta.(*clients.Client).Network().IPGroupsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -120,7 +120,7 @@ func (t IPGroupResource) Exists(ctx context.Context, clients *clients.Client, st
 		return nil, err
 	}
 
-	resp, err := clients.Network.IPGroupsClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := clients.Network().IPGroupsClient.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading IP Group (%s): %+v", id, err)
 	}
@@ -94,7 +94,7 @@ func resourceLocalNetworkGateway() *schema.Resource {
 }
 
 func resourceLocalNetworkGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.LocalNetworkGatewaysClient
+	client := meta.(*clients.Client).Network().LocalNetworkGatewaysClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -172,7 +172,7 @@ func resourceLocalNetworkGatewayCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceLocalNetworkGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.LocalNetworkGatewaysClient
+	client := meta.(*clients.Client).Network().LocalNetworkGatewaysClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -214,7 +214,7 @@ func resourceLocalNetworkGatewayRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceLocalNetworkGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.LocalNetworkGatewaysClient
+	client := meta.(*clients.Client).Network().LocalNetworkGatewaysClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
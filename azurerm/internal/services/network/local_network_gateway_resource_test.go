@@ -228,7 +228,7 @@ func (t LocalNetworkGatewayResource) Exists(ctx context.Context, clients *client
 	name := id.Path["localNetworkGateways"]
 	resGroup := id.ResourceGroup
 
-	resp, err := clients.Network.LocalNetworkGatewaysClient.Get(ctx, resGroup, name)
+	resp, err := clients.Network().LocalNetworkGatewaysClient.Get(ctx, resGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Local Network Gateway (%s): %+v", id, err)
 	}
@@ -244,12 +244,12 @@ func (LocalNetworkGatewayResource) Destroy(ctx context.Context, client *clients.
 	name := id.Path["localNetworkGateways"]
 	resGroup := id.ResourceGroup
 
-	future, err := client.Network.LocalNetworkGatewaysClient.Delete(ctx, resGroup, name)
+	future, err := client.Network().LocalNetworkGatewaysClient.Delete(ctx, resGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting Local Network Gateway %q: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.LocalNetworkGatewaysClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().LocalNetworkGatewaysClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for Deletion of Local Network Gateway %q: %+v", id, err)
 	}
 
@@ -77,7 +77,7 @@ func dataSourceNatGateway() *schema.Resource {
 }
 
 func dataSourceNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.NatGatewayClient
+	client := meta.(*clients.Client).Network().NatGatewayClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -54,7 +54,7 @@ func resourceNATGatewayPublicIpAssociation() *schema.Resource {
 }
 
 func resourceNATGatewayPublicIpAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.NatGatewayClient
+	client := meta.(*clients.Client).Network().NatGatewayClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -66,7 +66,9 @@ func resourceNATGatewayPublicIpAssociationCreate(d *schema.ResourceData, meta in
 		return err
 	}
 
-	locks.ByName(parsedNatGatewayId.Name, natGatewayResourceName)
+	if err := locks.ByNameWithContext(ctx, parsedNatGatewayId.Name, natGatewayResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Public IP Association (NAT Gateway %q / Resource Group %q): %+v", parsedNatGatewayId.Name, parsedNatGatewayId.ResourceGroup, err)
+	}
 	defer locks.UnlockByName(parsedNatGatewayId.Name, natGatewayResourceName)
 
 	natGateway, err := client.Get(ctx, parsedNatGatewayId.ResourceGroup, parsedNatGatewayId.Name, "")
@@ -113,7 +115,7 @@ func resourceNATGatewayPublicIpAssociationCreate(d *schema.ResourceData, meta in
 }
 
 func resourceNATGatewayPublicIpAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.NatGatewayClient
+	client := meta.(*clients.Client).Network().NatGatewayClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -168,7 +170,7 @@ func resourceNATGatewayPublicIpAssociationRead(d *schema.ResourceData, meta inte
 }
 
 func resourceNATGatewayPublicIpAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.NatGatewayClient
+	client := meta.(*clients.Client).Network().NatGatewayClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -177,7 +179,9 @@ func resourceNATGatewayPublicIpAssociationDelete(d *schema.ResourceData, meta in
 		return err
 	}
 
-	locks.ByName(id.NatGateway.Name, natGatewayResourceName)
+	if err := locks.ByNameWithContext(ctx, id.NatGateway.Name, natGatewayResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.NatGateway.Name, natGatewayResourceName)
 
 	natGateway, err := client.Get(ctx, id.NatGateway.ResourceGroup, id.NatGateway.Name, "")
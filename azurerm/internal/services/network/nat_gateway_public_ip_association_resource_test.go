@@ -90,7 +90,7 @@ func (t NatGatewayPublicAssociationResource) Exists(ctx context.Context, clients
 		return nil, err
 	}
 
-	resp, err := clients.Network.NatGatewayClient.Get(ctx, id.NatGateway.ResourceGroup, id.NatGateway.Name, "")
+	resp, err := clients.Network().NatGatewayClient.Get(ctx, id.NatGateway.ResourceGroup, id.NatGateway.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Nat Gateway Public IP Association (%s): %+v", id, err)
 	}
@@ -104,7 +104,7 @@ func (NatGatewayPublicAssociationResource) Destroy(ctx context.Context, client *
 		return nil, err
 	}
 
-	resp, err := client.Network.NatGatewayClient.Get(ctx, id.NatGateway.ResourceGroup, id.NatGateway.Name, "")
+	resp, err := client.Network().NatGatewayClient.Get(ctx, id.NatGateway.ResourceGroup, id.NatGateway.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Nat Gateway Public IP Association (%s): %+v", id, err)
 	}
@@ -119,12 +119,12 @@ func (NatGatewayPublicAssociationResource) Destroy(ctx context.Context, client *
 	}
 	resp.PublicIPAddresses = &updatedAddresses
 
-	future, err := client.Network.NatGatewayClient.CreateOrUpdate(ctx, id.NatGateway.ResourceGroup, id.NatGateway.Name, resp)
+	future, err := client.Network().NatGatewayClient.CreateOrUpdate(ctx, id.NatGateway.ResourceGroup, id.NatGateway.Name, resp)
 	if err != nil {
 		return nil, fmt.Errorf("failed to remove Nat Gateway Public Ip Association for Nat Gateway %q: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.NatGatewayClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().NatGatewayClient.Client); err != nil {
 		return nil, fmt.Errorf("failed to wait for removal of Nat Gateway Public Ip Association for Nat Gateway %q: %+v", id, err)
 	}
 
@@ -101,14 +101,16 @@ func resourceNatGateway() *schema.Resource {
 }
 
 func resourceNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.NatGatewayClient
+	client := meta.(*clients.Client).Network().NatGatewayClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(name, natGatewayResourceName)
+	if err := locks.ByNameWithContext(ctx, name, natGatewayResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for NAT Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, natGatewayResourceName)
 
 	resp, err := client.Get(ctx, resourceGroup, name, "")
@@ -164,7 +166,7 @@ func resourceNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.NatGatewayClient
+	client := meta.(*clients.Client).Network().NatGatewayClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -173,7 +175,9 @@ func resourceNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.Name, natGatewayResourceName)
+	if err := locks.ByNameWithContext(ctx, id.Name, natGatewayResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.Name, natGatewayResourceName)
 
 	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
@@ -224,7 +228,7 @@ func resourceNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 		parameters.NatGatewayPropertiesFormat.PublicIPPrefixes = expandNetworkSubResourceID(publicIpPrefixIds)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
 		parameters.Tags = tags.Expand(t)
 	}
@@ -241,7 +245,7 @@ func resourceNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.NatGatewayClient
+	client := meta.(*clients.Client).Network().NatGatewayClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -292,7 +296,7 @@ func resourceNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceNatGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.NatGatewayClient
+	client := meta.(*clients.Client).Network().NatGatewayClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -301,7 +305,9 @@ func resourceNatGatewayDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.Name, natGatewayResourceName)
+	if err := locks.ByNameWithContext(ctx, id.Name, natGatewayResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.Name, natGatewayResourceName)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
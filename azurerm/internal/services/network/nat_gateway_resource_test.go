@@ -84,7 +84,7 @@ func (t NatGatewayResource) Exists(ctx context.Context, clients *clients.Client,
 		return nil, err
 	}
 
-	resp, err := clients.Network.NatGatewayClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := clients.Network().NatGatewayClient.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading NAT Gateway (%s): %+v", id, err)
 	}
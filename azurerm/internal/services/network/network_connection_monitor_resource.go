@@ -437,7 +437,7 @@ func resourceNetworkConnectionMonitor() *schema.Resource {
 }
 
 func resourceNetworkConnectionMonitorCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ConnectionMonitorsClient
+	client := meta.(*clients.Client).Network().ConnectionMonitorsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -501,7 +501,7 @@ func resourceNetworkConnectionMonitorCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceNetworkConnectionMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ConnectionMonitorsClient
+	client := meta.(*clients.Client).Network().ConnectionMonitorsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -556,7 +556,7 @@ func resourceNetworkConnectionMonitorRead(d *schema.ResourceData, meta interface
 }
 
 func resourceNetworkConnectionMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ConnectionMonitorsClient
+	client := meta.(*clients.Client).Network().ConnectionMonitorsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -240,7 +240,7 @@ func (t NetworkConnectionMonitorResource) Exists(ctx context.Context, clients *c
 		return nil, err
 	}
 
-	resp, err := clients.Network.ConnectionMonitorsClient.Get(ctx, id.ResourceGroup, id.NetworkWatcherName, id.Name)
+	resp, err := clients.Network().ConnectionMonitorsClient.Get(ctx, id.ResourceGroup, id.NetworkWatcherName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Network Connection Monitor (%s): %+v", id, err)
 	}
@@ -44,7 +44,7 @@ func dataSourceNetworkDDoSProtectionPlan() *schema.Resource {
 }
 
 func dataSourceNetworkDDoSProtectionPlanRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.DDOSProtectionPlansClient
+	client := meta.(*clients.Client).Network().DDOSProtectionPlansClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -61,7 +61,7 @@ func resourceNetworkDDoSProtectionPlan() *schema.Resource {
 }
 
 func resourceNetworkDDoSProtectionPlanCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.DDOSProtectionPlansClient
+	client := meta.(*clients.Client).Network().DDOSProtectionPlansClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -91,10 +91,14 @@ func resourceNetworkDDoSProtectionPlanCreateUpdate(d *schema.ResourceData, meta
 		return fmt.Errorf("Error extracting names of Virtual Network: %+v", err)
 	}
 
-	locks.ByName(name, azureNetworkDDoSProtectionPlanResourceName)
+	if err := locks.ByNameWithContext(ctx, name, azureNetworkDDoSProtectionPlanResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for DDoS Protection Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, azureNetworkDDoSProtectionPlanResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, vnetsToLock, VirtualNetworkResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for DDoS Protection Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
 	parameters := network.DdosProtectionPlan{
@@ -126,7 +130,7 @@ func resourceNetworkDDoSProtectionPlanCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceNetworkDDoSProtectionPlanRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.DDOSProtectionPlansClient
+	client := meta.(*clients.Client).Network().DDOSProtectionPlansClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -165,7 +169,7 @@ func resourceNetworkDDoSProtectionPlanRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceNetworkDDoSProtectionPlanDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.DDOSProtectionPlansClient
+	client := meta.(*clients.Client).Network().DDOSProtectionPlansClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -192,10 +196,14 @@ func resourceNetworkDDoSProtectionPlanDelete(d *schema.ResourceData, meta interf
 		return fmt.Errorf("Error extracting names of Virtual Network: %+v", err)
 	}
 
-	locks.ByName(name, azureNetworkDDoSProtectionPlanResourceName)
+	if err := locks.ByNameWithContext(ctx, name, azureNetworkDDoSProtectionPlanResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for DDoS Protection Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, azureNetworkDDoSProtectionPlanResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, vnetsToLock, VirtualNetworkResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for DDoS Protection Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
 	future, err := client.Delete(ctx, resourceGroup, name)
@@ -123,7 +123,7 @@ func (t NetworkDDoSProtectionPlanResource) Exists(ctx context.Context, clients *
 	resGroup := id.ResourceGroup
 	name := id.Path["ddosProtectionPlans"]
 
-	resp, err := clients.Network.DDOSProtectionPlansClient.Get(ctx, resGroup, name)
+	resp, err := clients.Network().DDOSProtectionPlansClient.Get(ctx, resGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading DDOS Protection Plan (%s): %+v", id, err)
 	}
@@ -139,12 +139,12 @@ func (NetworkDDoSProtectionPlanResource) Destroy(ctx context.Context, client *cl
 	resGroup := id.ResourceGroup
 	name := id.Path["ddosProtectionPlans"]
 
-	future, err := client.Network.DDOSProtectionPlansClient.Delete(ctx, resGroup, name)
+	future, err := client.Network().DDOSProtectionPlansClient.Delete(ctx, resGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting DDoS Protection Plan %q: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.DDOSProtectionPlansClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().DDOSProtectionPlansClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for Deletion on NetworkDDoSProtectionPlanClient: %+v", err)
 	}
 
@@ -109,7 +109,7 @@ func (NetworkInterfaceApplicationGatewayBackendAddressPoolAssociationResource) E
 	resourceGroup := id.ResourceGroup
 	backendAddressPoolId := splitId[1]
 
-	read, err := clients.Network.InterfacesClient.Get(ctx, resourceGroup, networkInterfaceName, "")
+	read, err := clients.Network().InterfacesClient.Get(ctx, resourceGroup, networkInterfaceName, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading NetworkInterfaceApplicationGatewayBackendAddressPoolAssociation (%s): %+v", id, err)
 	}
@@ -160,7 +160,7 @@ func (NetworkInterfaceApplicationGatewayBackendAddressPoolAssociationResource) d
 	backendAddressPoolId := state.Attributes["backend_address_pool_id"]
 	ipConfigurationName := state.Attributes["ip_configuration_name"]
 
-	read, err := client.Network.InterfacesClient.Get(ctx, resourceGroup, nicName, "")
+	read, err := client.Network().InterfacesClient.Get(ctx, resourceGroup, nicName, "")
 	if err != nil {
 		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicName, resourceGroup, err)
 	}
@@ -181,12 +181,12 @@ func (NetworkInterfaceApplicationGatewayBackendAddressPoolAssociationResource) d
 	}
 	config.InterfaceIPConfigurationPropertiesFormat.ApplicationGatewayBackendAddressPools = &updatedPools
 
-	future, err := client.Network.InterfacesClient.CreateOrUpdate(ctx, resourceGroup, nicName, read)
+	future, err := client.Network().InterfacesClient.CreateOrUpdate(ctx, resourceGroup, nicName, read)
 	if err != nil {
 		return fmt.Errorf("Error removing Application Gateway Backend Address Pool Association for Network Interface %q (Resource Group %q): %+v", nicName, resourceGroup, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.InterfacesClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().InterfacesClient.Client); err != nil {
 		return fmt.Errorf("Error waiting for removal of Application Gateway Backend Address Pool Association for NIC %q (Resource Group %q): %+v", nicName, resourceGroup, err)
 	}
 
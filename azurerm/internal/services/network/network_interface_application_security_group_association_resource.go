@@ -59,7 +59,7 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociation() *schema.Resou
 }
 
 func resourceNetworkInterfaceApplicationSecurityGroupAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.InterfacesClient
+	client := meta.(*clients.Client).Network().InterfacesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -76,7 +76,9 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationCreate(d *schema
 	networkInterfaceName := id.Path["networkInterfaces"]
 	resourceGroup := id.ResourceGroup
 
-	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameWithContext(ctx, networkInterfaceName, networkInterfaceResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Application Security Group Association (Network Interface %q / Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
 
 	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
@@ -123,7 +125,7 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationCreate(d *schema
 }
 
 func resourceNetworkInterfaceApplicationSecurityGroupAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.InterfacesClient
+	client := meta.(*clients.Client).Network().InterfacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -178,7 +180,7 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationRead(d *schema.R
 }
 
 func resourceNetworkInterfaceApplicationSecurityGroupAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.InterfacesClient
+	client := meta.(*clients.Client).Network().InterfacesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -196,7 +198,9 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationDelete(d *schema
 	resourceGroup := nicID.ResourceGroup
 	applicationSecurityGroupId := splitId[1]
 
-	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameWithContext(ctx, networkInterfaceName, networkInterfaceResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Application Security Group Association (Network Interface %q / Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
 
 	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
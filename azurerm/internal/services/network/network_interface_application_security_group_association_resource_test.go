@@ -115,7 +115,7 @@ func (t NetworkInterfaceApplicationSecurityGroupAssociationResource) Exists(ctx
 	resourceGroup := id.ResourceGroup
 	applicationSecurityGroupId := splitId[1]
 
-	read, err := clients.Network.InterfacesClient.Get(ctx, resourceGroup, networkInterfaceName, "")
+	read, err := clients.Network().InterfacesClient.Get(ctx, resourceGroup, networkInterfaceName, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Network Interface (%s): %+v", id, err)
 	}
@@ -145,7 +145,7 @@ func (NetworkInterfaceApplicationSecurityGroupAssociationResource) destroy(ctx c
 	resourceGroup := nicID.ResourceGroup
 	applicationSecurityGroupId := state.Attributes["application_security_group_id"]
 
-	read, err := client.Network.InterfacesClient.Get(ctx, resourceGroup, nicName, "")
+	read, err := client.Network().InterfacesClient.Get(ctx, resourceGroup, nicName, "")
 	if err != nil {
 		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicName, resourceGroup, err)
 	}
@@ -165,12 +165,12 @@ func (NetworkInterfaceApplicationSecurityGroupAssociationResource) destroy(ctx c
 
 	read.InterfacePropertiesFormat.IPConfigurations = &configs
 
-	future, err := client.Network.InterfacesClient.CreateOrUpdate(ctx, resourceGroup, nicName, read)
+	future, err := client.Network().InterfacesClient.CreateOrUpdate(ctx, resourceGroup, nicName, read)
 	if err != nil {
 		return fmt.Errorf("Error removing Application Security Group Association for Network Interface %q (Resource Group %q): %+v", nicName, resourceGroup, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.InterfacesClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().InterfacesClient.Client); err != nil {
 		return fmt.Errorf("Error waiting for removal of Application Security Group Association for NIC %q (Resource Group %q): %+v", nicName, resourceGroup, err)
 	}
 
@@ -109,7 +109,7 @@ func (t NetworkInterfaceBackendAddressPoolResource) Exists(ctx context.Context,
 	resourceGroup := id.ResourceGroup
 	backendAddressPoolId := splitId[1]
 
-	read, err := clients.Network.InterfacesClient.Get(ctx, resourceGroup, networkInterfaceName, "")
+	read, err := clients.Network().InterfacesClient.Get(ctx, resourceGroup, networkInterfaceName, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading NetworkInterfaceApplicationGatewayBackendAddressPoolAssociation (%s): %+v", id, err)
 	}
@@ -149,7 +149,7 @@ func (NetworkInterfaceBackendAddressPoolResource) destroy(ctx context.Context, c
 	backendAddressPoolId := state.Attributes["backend_address_pool_id"]
 	ipConfigurationName := state.Attributes["ip_configuration_name"]
 
-	read, err := client.Network.InterfacesClient.Get(ctx, resourceGroup, nicName, "")
+	read, err := client.Network().InterfacesClient.Get(ctx, resourceGroup, nicName, "")
 	if err != nil {
 		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicName, resourceGroup, err)
 	}
@@ -170,12 +170,12 @@ func (NetworkInterfaceBackendAddressPoolResource) destroy(ctx context.Context, c
 	}
 	config.InterfaceIPConfigurationPropertiesFormat.LoadBalancerBackendAddressPools = &updatedPools
 
-	future, err := client.Network.InterfacesClient.CreateOrUpdate(ctx, resourceGroup, nicName, read)
+	future, err := client.Network().InterfacesClient.CreateOrUpdate(ctx, resourceGroup, nicName, read)
 	if err != nil {
 		return fmt.Errorf("Error removing Backend Address Pool Association for Network Interface %q (Resource Group %q): %+v", nicName, resourceGroup, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.InterfacesClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().InterfacesClient.Client); err != nil {
 		return fmt.Errorf("Error waiting for removal of Backend Address Pool Association for NIC %q (Resource Group %q): %+v", nicName, resourceGroup, err)
 	}
 
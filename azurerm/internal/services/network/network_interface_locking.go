@@ -1,6 +1,8 @@
 package network
 
 import (
+	"context"
+
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
@@ -8,13 +10,23 @@ import (
 )
 
 type networkInterfaceIPConfigurationLockingDetails struct {
+	// subnetNamesToLock are the names used to lock each Subnet, which are qualified by the name of the Virtual Network
 	subnetNamesToLock         []string
 	virtualNetworkNamesToLock []string
 }
 
-func (details networkInterfaceIPConfigurationLockingDetails) lock() {
-	locks.MultipleByName(&details.subnetNamesToLock, SubnetResourceName)
-	locks.MultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
+// lock locks the Virtual Networks followed by the Subnets within them - in the same order as these are locked
+// by the Subnet resources, so that these can't deadlock
+func (details networkInterfaceIPConfigurationLockingDetails) lock(ctx context.Context) error {
+	if err := locks.MultipleByNameWithContext(ctx, &details.virtualNetworkNamesToLock, VirtualNetworkResourceName); err != nil {
+		return err
+	}
+	if err := locks.MultipleByNameWithContext(ctx, &details.subnetNamesToLock, SubnetResourceName); err != nil {
+		locks.UnlockMultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
+		return err
+	}
+
+	return nil
 }
 
 func (details networkInterfaceIPConfigurationLockingDetails) unlock() {
@@ -44,7 +56,7 @@ func determineResourcesToLockFromIPConfiguration(input *[]network.InterfaceIPCon
 		}
 
 		virtualNetworkName := id.VirtualNetworkName
-		subnetName := id.Name
+		subnetName := locks.ChildName(id.VirtualNetworkName, id.Name)
 
 		if !utils.SliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
 			virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
@@ -59,7 +59,7 @@ func resourceNetworkInterfaceNatRuleAssociation() *schema.Resource {
 }
 
 func resourceNetworkInterfaceNatRuleAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.InterfacesClient
+	client := meta.(*clients.Client).Network().InterfacesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -77,7 +77,9 @@ func resourceNetworkInterfaceNatRuleAssociationCreate(d *schema.ResourceData, me
 	networkInterfaceName := id.Path["networkInterfaces"]
 	resourceGroup := id.ResourceGroup
 
-	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameWithContext(ctx, networkInterfaceName, networkInterfaceResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for NAT Rule Association (Network Interface %q / IP Configuration %q / Resource Group %q): %+v", networkInterfaceName, ipConfigurationName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
 
 	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
@@ -149,7 +151,7 @@ func resourceNetworkInterfaceNatRuleAssociationCreate(d *schema.ResourceData, me
 }
 
 func resourceNetworkInterfaceNatRuleAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.InterfacesClient
+	client := meta.(*clients.Client).Network().InterfacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -227,7 +229,7 @@ func resourceNetworkInterfaceNatRuleAssociationRead(d *schema.ResourceData, meta
 }
 
 func resourceNetworkInterfaceNatRuleAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.InterfacesClient
+	client := meta.(*clients.Client).Network().InterfacesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -246,7 +248,9 @@ func resourceNetworkInterfaceNatRuleAssociationDelete(d *schema.ResourceData, me
 	resourceGroup := nicID.ResourceGroup
 	natRuleId := splitId[1]
 
-	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameWithContext(ctx, networkInterfaceName, networkInterfaceResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for NAT Rule Association (Network Interface %q / IP Configuration %q / Resource Group %q): %+v", networkInterfaceName, ipConfigurationName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
 
 	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
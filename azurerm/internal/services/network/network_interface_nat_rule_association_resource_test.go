@@ -109,7 +109,7 @@ func (t NetworkInterfaceNATRuleAssociationResource) Exists(ctx context.Context,
 	resourceGroup := nicID.ResourceGroup
 	natRuleId := splitId[1]
 
-	read, err := clients.Network.InterfacesClient.Get(ctx, resourceGroup, networkInterfaceName, "")
+	read, err := clients.Network().InterfacesClient.Get(ctx, resourceGroup, networkInterfaceName, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Network Interface %q: %+v", nicID, err)
 	}
@@ -144,7 +144,7 @@ func (NetworkInterfaceNATRuleAssociationResource) destroy(ctx context.Context, c
 	ipConfigurationName := state.Attributes["ip_configuration_name"]
 	natRuleId := state.Attributes["nat_rule_id"]
 
-	read, err := client.Network.InterfacesClient.Get(ctx, resourceGroup, nicName, "")
+	read, err := client.Network().InterfacesClient.Get(ctx, resourceGroup, nicName, "")
 	if err != nil {
 		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicName, resourceGroup, err)
 	}
@@ -165,12 +165,12 @@ func (NetworkInterfaceNATRuleAssociationResource) destroy(ctx context.Context, c
 	}
 	config.InterfaceIPConfigurationPropertiesFormat.LoadBalancerInboundNatRules = &updatedRules
 
-	future, err := client.Network.InterfacesClient.CreateOrUpdate(ctx, resourceGroup, nicName, read)
+	future, err := client.Network().InterfacesClient.CreateOrUpdate(ctx, resourceGroup, nicName, read)
 	if err != nil {
 		return fmt.Errorf("Error removing NAT Rule Association for Network Interface %q (Resource Group %q): %+v", nicName, resourceGroup, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.InterfacesClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().InterfacesClient.Client); err != nil {
 		return fmt.Errorf("Error waiting for removal of NAT Rule Association for NIC %q (Resource Group %q): %+v", nicName, resourceGroup, err)
 	}
 
@@ -52,7 +52,7 @@ func resourceNetworkInterfaceSecurityGroupAssociation() *schema.Resource {
 }
 
 func resourceNetworkInterfaceSecurityGroupAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.InterfacesClient
+	client := meta.(*clients.Client).Network().InterfacesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -69,7 +69,9 @@ func resourceNetworkInterfaceSecurityGroupAssociationCreate(d *schema.ResourceDa
 	networkInterfaceName := nicId.Path["networkInterfaces"]
 	resourceGroup := nicId.ResourceGroup
 
-	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
+	if err := locks.ByNameWithContext(ctx, networkInterfaceName, networkInterfaceResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Security Group Association (Network Interface %q / Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
 
 	nsgId, err := azure.ParseAzureResourceID(networkSecurityGroupId)
@@ -78,8 +80,10 @@ func resourceNetworkInterfaceSecurityGroupAssociationCreate(d *schema.ResourceDa
 	}
 	nsgName := nsgId.Path["networkSecurityGroups"]
 
-	locks.ByName(nsgName, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(nsgName, networkSecurityGroupResourceName)
+	if err := lockReferencedResource(ctx, nsgName, networkSecurityGroupResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Security Group Association (Network Interface %q / Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+	defer unlockReferencedResource(nsgName, networkSecurityGroupResourceName)
 
 	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
 	if err != nil {
@@ -120,7 +124,7 @@ func resourceNetworkInterfaceSecurityGroupAssociationCreate(d *schema.ResourceDa
 }
 
 func resourceNetworkInterfaceSecurityGroupAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.InterfacesClient
+	client := meta.(*clients.Client).Network().InterfacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -168,7 +172,7 @@ func resourceNetworkInterfaceSecurityGroupAssociationRead(d *schema.ResourceData
 }
 
 func resourceNetworkInterfaceSecurityGroupAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.InterfacesClient
+	client := meta.(*clients.Client).Network().InterfacesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -185,7 +189,9 @@ func resourceNetworkInterfaceSecurityGroupAssociationDelete(d *schema.ResourceDa
 	name := nicID.Path["networkInterfaces"]
 	resourceGroup := nicID.ResourceGroup
 
-	locks.ByName(name, networkInterfaceResourceName)
+	if err := locks.ByNameWithContext(ctx, name, networkInterfaceResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Security Group Association (Network Interface %q / Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, networkInterfaceResourceName)
 
 	read, err := client.Get(ctx, resourceGroup, name, "")
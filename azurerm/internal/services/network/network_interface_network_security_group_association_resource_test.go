@@ -106,7 +106,7 @@ func (t NetworkInterfaceNetworkSecurityGroupAssociationResource) Exists(ctx cont
 	name := nicID.Path["networkInterfaces"]
 	resourceGroup := nicID.ResourceGroup
 
-	read, err := clients.Network.InterfacesClient.Get(ctx, resourceGroup, name, "")
+	read, err := clients.Network().InterfacesClient.Get(ctx, resourceGroup, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Network Interface %q: %+v", nicID, err)
 	}
@@ -130,19 +130,19 @@ func (NetworkInterfaceNetworkSecurityGroupAssociationResource) destroy(ctx conte
 	resourceGroup := nicID.ResourceGroup
 	nicName := nicID.Name
 
-	read, err := client.Network.InterfacesClient.Get(ctx, resourceGroup, nicName, "")
+	read, err := client.Network().InterfacesClient.Get(ctx, resourceGroup, nicName, "")
 	if err != nil {
 		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicName, resourceGroup, err)
 	}
 
 	read.InterfacePropertiesFormat.NetworkSecurityGroup = nil
 
-	future, err := azuresdkhacks.UpdateNetworkInterfaceAllowingRemovalOfNSG(ctx, client.Network.InterfacesClient, resourceGroup, nicName, read)
+	future, err := azuresdkhacks.UpdateNetworkInterfaceAllowingRemovalOfNSG(ctx, client.Network().InterfacesClient, resourceGroup, nicName, read)
 	if err != nil {
 		return fmt.Errorf("Error removing Network Security Group Association for Network Interface %q (Resource Group %q): %+v", nicName, resourceGroup, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.InterfacesClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().InterfacesClient.Client); err != nil {
 		return fmt.Errorf("Error waiting for removal of Network Security Group Association for NIC %q (Resource Group %q): %+v", nicName, resourceGroup, err)
 	}
 
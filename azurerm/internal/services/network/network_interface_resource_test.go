@@ -319,7 +319,7 @@ func (t NetworkInterfaceResource) Exists(ctx context.Context, clients *clients.C
 		return nil, err
 	}
 
-	resp, err := clients.Network.InterfacesClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := clients.Network().InterfacesClient.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading %s: %+v", *id, err)
 	}
@@ -333,12 +333,12 @@ func (NetworkInterfaceResource) Destroy(ctx context.Context, client *clients.Cli
 		return nil, err
 	}
 
-	future, err := client.Network.InterfacesClient.Delete(ctx, id.ResourceGroup, id.Name)
+	future, err := client.Network().InterfacesClient.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.InterfacesClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().InterfacesClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
 	}
 
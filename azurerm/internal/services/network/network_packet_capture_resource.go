@@ -154,7 +154,7 @@ func resourceNetworkPacketCapture() *schema.Resource {
 }
 
 func resourceNetworkPacketCaptureCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PacketCapturesClient
+	client := meta.(*clients.Client).Network().PacketCapturesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -214,7 +214,7 @@ func resourceNetworkPacketCaptureCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceNetworkPacketCaptureRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PacketCapturesClient
+	client := meta.(*clients.Client).Network().PacketCapturesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -259,7 +259,7 @@ func resourceNetworkPacketCaptureRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceNetworkPacketCaptureDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PacketCapturesClient
+	client := meta.(*clients.Client).Network().PacketCapturesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -101,7 +101,7 @@ func (t NetworkPacketCaptureResource) Exists(ctx context.Context, clients *clien
 		return nil, err
 	}
 
-	resp, err := clients.Network.PacketCapturesClient.Get(ctx, id.ResourceGroup, id.NetworkWatcherName, id.Name)
+	resp, err := clients.Network().PacketCapturesClient.Get(ctx, id.ResourceGroup, id.NetworkWatcherName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Network Packet Capture (%s): %+v", id, err)
 	}
@@ -95,7 +95,7 @@ func resourceNetworkProfile() *schema.Resource {
 }
 
 func resourceNetworkProfileCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ProfileClient
+	client := meta.(*clients.Client).Network().ProfileClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -125,13 +125,19 @@ func resourceNetworkProfileCreateUpdate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error extracting names of Subnet and Virtual Network: %+v", err)
 	}
 
-	locks.ByName(name, azureNetworkProfileResourceName)
+	if err := locks.ByNameWithContext(ctx, name, azureNetworkProfileResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, vnetsToLock, VirtualNetworkResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, subnetsToLock, SubnetResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
 
 	parameters := network.Profile{
@@ -161,7 +167,7 @@ func resourceNetworkProfileCreateUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceNetworkProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ProfileClient
+	client := meta.(*clients.Client).Network().ProfileClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -205,7 +211,7 @@ func resourceNetworkProfileRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceNetworkProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ProfileClient
+	client := meta.(*clients.Client).Network().ProfileClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -232,13 +238,19 @@ func resourceNetworkProfileDelete(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error extracting names of Subnet and Virtual Network: %+v", err)
 	}
 
-	locks.ByName(name, azureNetworkProfileResourceName)
+	if err := locks.ByNameWithContext(ctx, name, azureNetworkProfileResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, vnetsToLock, VirtualNetworkResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
 
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, subnetsToLock, SubnetResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
 
 	if _, err = client.Delete(ctx, resourceGroup, name); err != nil {
@@ -306,8 +318,8 @@ func expandNetworkProfileVirtualNetworkSubnetNames(d *schema.ResourceData) (*[]s
 				return nil, nil, err
 			}
 
-			subnetName := subnetResourceID.Path["subnets"]
 			vnetName := subnetResourceID.Path["virtualNetworks"]
+			subnetName := locks.ChildName(vnetName, subnetResourceID.Path["subnets"])
 
 			if !utils.SliceContainsValue(subnetNames, subnetName) {
 				subnetNames = append(subnetNames, subnetName)
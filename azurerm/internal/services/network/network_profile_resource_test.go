@@ -97,7 +97,7 @@ func (t NetworkProfileResource) Exists(ctx context.Context, clients *clients.Cli
 	resourceGroup := id.ResourceGroup
 	name := id.Path["networkProfiles"]
 
-	resp, err := clients.Network.ProfileClient.Get(ctx, resourceGroup, name, "")
+	resp, err := clients.Network().ProfileClient.Get(ctx, resourceGroup, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Network Profile (%s): %+v", id, err)
 	}
@@ -113,7 +113,7 @@ func (NetworkProfileResource) Destroy(ctx context.Context, client *clients.Clien
 	resourceGroup := id.ResourceGroup
 	name := id.Path["networkProfiles"]
 
-	if _, err = client.Network.ProfileClient.Delete(ctx, resourceGroup, name); err != nil {
+	if _, err = client.Network().ProfileClient.Delete(ctx, resourceGroup, name); err != nil {
 		return nil, fmt.Errorf("deleting on Network Profile %q: %+v", id, err)
 	}
 
@@ -137,7 +137,7 @@ func dataSourceNetworkSecurityGroup() *schema.Resource {
 }
 
 func dataSourceNetworkSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SecurityGroupClient
+	client := meta.(*clients.Client).Network().SecurityGroupClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -180,7 +180,7 @@ func resourceNetworkSecurityGroup() *schema.Resource {
 }
 
 func resourceNetworkSecurityGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SecurityGroupClient
+	client := meta.(*clients.Client).Network().SecurityGroupClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -208,7 +208,9 @@ func resourceNetworkSecurityGroupCreateUpdate(d *schema.ResourceData, meta inter
 		return fmt.Errorf("Error Building list of Network Security Group Rules: %+v", sgErr)
 	}
 
-	locks.ByName(name, networkSecurityGroupResourceName)
+	if err := locks.ByNameWithContext(ctx, name, networkSecurityGroupResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Security Group %q (Resource Group %q): %+v", name, resGroup, err)
+	}
 	defer locks.UnlockByName(name, networkSecurityGroupResourceName)
 
 	sg := network.SecurityGroup{
@@ -243,7 +245,7 @@ func resourceNetworkSecurityGroupCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceNetworkSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SecurityGroupClient
+	client := meta.(*clients.Client).Network().SecurityGroupClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -278,7 +280,7 @@ func resourceNetworkSecurityGroupRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceNetworkSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SecurityGroupClient
+	client := meta.(*clients.Client).Network().SecurityGroupClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -287,6 +289,11 @@ func resourceNetworkSecurityGroupDelete(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
+	if err := locks.ByNameWithContext(ctx, id.Name, networkSecurityGroupResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
+	defer locks.UnlockByName(id.Name, networkSecurityGroupResourceName)
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("Error deleting Network Security Group %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
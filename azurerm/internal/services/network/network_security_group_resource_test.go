@@ -218,7 +218,7 @@ func (t NetworkSecurityGroupResource) Exists(ctx context.Context, clients *clien
 		return nil, err
 	}
 
-	resp, err := clients.Network.SecurityGroupClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := clients.Network().SecurityGroupClient.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Network Security Group (%s): %+v", id, err)
 	}
@@ -232,12 +232,12 @@ func (NetworkSecurityGroupResource) Destroy(ctx context.Context, client *clients
 		return nil, err
 	}
 
-	future, err := client.Network.SecurityGroupClient.Delete(ctx, id.ResourceGroup, id.Name)
+	future, err := client.Network().SecurityGroupClient.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting Network Security Group %q: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.SecurityGroupClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().SecurityGroupClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for Deletion of Network Security Group: %+v", err)
 	}
 
@@ -16,6 +16,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var networkSecurityRuleResourceName = "azurerm_network_security_rule"
+
 func resourceNetworkSecurityRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetworkSecurityRuleCreateUpdate,
@@ -170,7 +172,7 @@ func resourceNetworkSecurityRule() *schema.Resource {
 }
 
 func resourceNetworkSecurityRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SecurityRuleClient
+	client := meta.(*clients.Client).Network().SecurityRuleClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -200,10 +202,11 @@ func resourceNetworkSecurityRuleCreateUpdate(d *schema.ResourceData, meta interf
 	direction := d.Get("direction").(string)
 	protocol := d.Get("protocol").(string)
 
-	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
-		locks.ByName(nsgName, networkSecurityGroupResourceName)
-		defer locks.UnlockByName(nsgName, networkSecurityGroupResourceName)
+	parent := networkSecurityGroupLockingParent(meta, nsgName)
+	if err := locks.ChildByNameWithContext(ctx, parent, name, networkSecurityRuleResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Security Rule %q (Network Security Group %q / Resource Group %q): %+v", name, nsgName, resGroup, err)
 	}
+	defer locks.UnlockChildByName(parent, name, networkSecurityRuleResourceName)
 
 	rule := network.SecurityRule{
 		Name: &name,
@@ -309,7 +312,7 @@ func resourceNetworkSecurityRuleCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceNetworkSecurityRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SecurityRuleClient
+	client := meta.(*clients.Client).Network().SecurityRuleClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -362,7 +365,7 @@ func resourceNetworkSecurityRuleRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceNetworkSecurityRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SecurityRuleClient
+	client := meta.(*clients.Client).Network().SecurityRuleClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -374,10 +377,11 @@ func resourceNetworkSecurityRuleDelete(d *schema.ResourceData, meta interface{})
 	nsgName := id.Path["networkSecurityGroups"]
 	sgRuleName := id.Path["securityRules"]
 
-	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
-		locks.ByName(nsgName, networkSecurityGroupResourceName)
-		defer locks.UnlockByName(nsgName, networkSecurityGroupResourceName)
+	parent := networkSecurityGroupLockingParent(meta, nsgName)
+	if err := locks.ChildByNameWithContext(ctx, parent, sgRuleName, networkSecurityRuleResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Security Rule %q (Network Security Group %q / Resource Group %q): %+v", sgRuleName, nsgName, resGroup, err)
 	}
+	defer locks.UnlockChildByName(parent, sgRuleName, networkSecurityRuleResourceName)
 
 	future, err := client.Delete(ctx, resGroup, nsgName, sgRuleName)
 	if err != nil {
@@ -120,7 +120,7 @@ func (t NetworkSecurityRuleResource) Exists(ctx context.Context, clients *client
 	networkSGName := id.Path["networkSecurityGroups"]
 	sgRuleName := id.Path["securityRules"]
 
-	resp, err := clients.Network.SecurityRuleClient.Get(ctx, resGroup, networkSGName, sgRuleName)
+	resp, err := clients.Network().SecurityRuleClient.Get(ctx, resGroup, networkSGName, sgRuleName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Security Rule (%s): %+v", id, err)
 	}
@@ -137,14 +137,14 @@ func (NetworkSecurityRuleResource) Destroy(ctx context.Context, client *clients.
 	networkSGName := id.Path["networkSecurityGroups"]
 	sgRuleName := id.Path["securityRules"]
 
-	future, err := client.Network.SecurityRuleClient.Delete(ctx, resGroup, networkSGName, sgRuleName)
+	future, err := client.Network().SecurityRuleClient.Delete(ctx, resGroup, networkSGName, sgRuleName)
 	if err != nil {
 		if !response.WasNotFound(future.Response()) {
 			return nil, fmt.Errorf("deleting Network Security Rule %q: %+v", id, err)
 		}
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.SecurityRuleClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().SecurityRuleClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for Deletion on Network Security Rule: %+v", err)
 	}
 
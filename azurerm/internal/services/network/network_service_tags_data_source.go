@@ -47,7 +47,7 @@ func dataSourceNetworkServiceTags() *schema.Resource {
 }
 
 func dataSourceNetworkServiceTagsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ServiceTagsClient
+	client := meta.(*clients.Client).Network().ServiceTagsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -36,7 +36,7 @@ func dataSourceNetworkWatcher() *schema.Resource {
 }
 
 func dataSourceNetworkWatcherRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.WatcherClient
+	client := meta.(*clients.Client).Network().WatcherClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -176,7 +176,7 @@ func azureRMSuppressFlowLogRetentionPolicyDaysDiff(_, old, _ string, d *schema.R
 }
 
 func resourceNetworkWatcherFlowLogCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.WatcherClient
+	client := meta.(*clients.Client).Network().WatcherClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -230,7 +230,7 @@ func resourceNetworkWatcherFlowLogCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceNetworkWatcherFlowLogRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.WatcherClient
+	client := meta.(*clients.Client).Network().WatcherClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -295,7 +295,7 @@ func resourceNetworkWatcherFlowLogRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceNetworkWatcherFlowLogDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.WatcherClient
+	client := meta.(*clients.Client).Network().WatcherClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -307,16 +307,16 @@ func (t NetworkWatcherFlowLogResource) Exists(ctx context.Context, clients *clie
 		TargetResourceID: &id.NetworkSecurityGroupID,
 	}
 
-	future, err := clients.Network.WatcherClient.GetFlowLogStatus(ctx, id.ResourceGroup, id.NetworkWatcherName, statusParameters)
+	future, err := clients.Network().WatcherClient.GetFlowLogStatus(ctx, id.ResourceGroup, id.NetworkWatcherName, statusParameters)
 	if err != nil {
 		return nil, fmt.Errorf("reading Network Watcher Flow Log (%s): %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, clients.Network.WatcherClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, clients.Network().WatcherClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for retrieval of Flow Log Configuration for target %q: %+v", id, err)
 	}
 
-	fli, err := future.Result(*clients.Network.WatcherClient)
+	fli, err := future.Result(*clients.Network().WatcherClient)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Flow Log Configuration for target %q: %+v", id, err)
 	}
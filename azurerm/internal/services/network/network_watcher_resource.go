@@ -51,7 +51,7 @@ func resourceNetworkWatcher() *schema.Resource {
 }
 
 func resourceNetworkWatcherCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.WatcherClient
+	client := meta.(*clients.Client).Network().WatcherClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -97,7 +97,7 @@ func resourceNetworkWatcherCreateUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceNetworkWatcherRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.WatcherClient
+	client := meta.(*clients.Client).Network().WatcherClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -125,7 +125,7 @@ func resourceNetworkWatcherRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceNetworkWatcherDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.WatcherClient
+	client := meta.(*clients.Client).Network().WatcherClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
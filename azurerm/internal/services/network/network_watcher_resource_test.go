@@ -169,7 +169,7 @@ func (t NetworkWatcherResource) Exists(ctx context.Context, clients *clients.Cli
 		return nil, err
 	}
 
-	resp, err := clients.Network.WatcherClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Network().WatcherClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Network Watcher (%s): %+v", id, err)
 	}
@@ -183,12 +183,12 @@ func (NetworkWatcherResource) Destroy(ctx context.Context, client *clients.Clien
 		return nil, err
 	}
 
-	future, err := client.Network.WatcherClient.Delete(ctx, id.ResourceGroup, id.Name)
+	future, err := client.Network().WatcherClient.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting Network Watcher %q: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.WatcherClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().WatcherClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for Deletion on NetworkWatcherClient: %+v", err)
 	}
 
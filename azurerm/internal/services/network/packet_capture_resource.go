@@ -143,7 +143,7 @@ func resourcePacketCapture() *schema.Resource {
 }
 
 func resourcePacketCaptureCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PacketCapturesClient
+	client := meta.(*clients.Client).Network().PacketCapturesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -203,7 +203,7 @@ func resourcePacketCaptureCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourcePacketCaptureRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PacketCapturesClient
+	client := meta.(*clients.Client).Network().PacketCapturesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -252,7 +252,7 @@ func resourcePacketCaptureRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePacketCaptureDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PacketCapturesClient
+	client := meta.(*clients.Client).Network().PacketCapturesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
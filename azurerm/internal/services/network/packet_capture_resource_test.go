@@ -104,7 +104,7 @@ func (t PacketCaptureResource) Exists(ctx context.Context, clients *clients.Clie
 	watcherName := id.Path["networkWatchers"]
 	name := id.Path["packetCaptures"]
 
-	resp, err := clients.Network.PacketCapturesClient.Get(ctx, resourceGroup, watcherName, name)
+	resp, err := clients.Network().PacketCapturesClient.Get(ctx, resourceGroup, watcherName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Packet Capture (%s): %+v", id, err)
 	}
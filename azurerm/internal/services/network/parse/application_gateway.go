@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApplicationGatewayId struct {
@@ -67,3 +68,12 @@ func ApplicationGatewayID(input string) (*ApplicationGatewayId, error) {
 
 	return &resourceId, nil
 }
+
+// ApplicationGatewayIDNormalized parses a ApplicationGateway ID into an ApplicationGatewayId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ApplicationGatewayID method should be used instead for validation etc.
+func ApplicationGatewayIDNormalized(input string) (*ApplicationGatewayId, error) {
+	return ApplicationGatewayID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s"))
+}
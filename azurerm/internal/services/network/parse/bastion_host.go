@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BastionHostId struct {
@@ -67,3 +68,12 @@ func BastionHostID(input string) (*BastionHostId, error) {
 
 	return &resourceId, nil
 }
+
+// BastionHostIDNormalized parses a BastionHost ID into an BastionHostId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the BastionHostID method should be used instead for validation etc.
+func BastionHostIDNormalized(input string) (*BastionHostId, error) {
+	return BastionHostID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/bastionHosts/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BgpConnectionId struct {
@@ -73,3 +74,12 @@ func BgpConnectionID(input string) (*BgpConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+// BgpConnectionIDNormalized parses a BgpConnection ID into an BgpConnectionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the BgpConnectionID method should be used instead for validation etc.
+func BgpConnectionIDNormalized(input string) (*BgpConnectionId, error) {
+	return BgpConnectionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualHubs/%s/bgpConnections/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ConnectionMonitorId struct {
@@ -73,3 +74,12 @@ func ConnectionMonitorID(input string) (*ConnectionMonitorId, error) {
 
 	return &resourceId, nil
 }
+
+// ConnectionMonitorIDNormalized parses a ConnectionMonitor ID into an ConnectionMonitorId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ConnectionMonitorID method should be used instead for validation etc.
+func ConnectionMonitorIDNormalized(input string) (*ConnectionMonitorId, error) {
+	return ConnectionMonitorID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkWatchers/%s/connectionMonitors/%s"))
+}
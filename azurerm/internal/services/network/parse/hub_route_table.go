@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type HubRouteTableId struct {
@@ -73,3 +74,12 @@ func HubRouteTableID(input string) (*HubRouteTableId, error) {
 
 	return &resourceId, nil
 }
+
+// HubRouteTableIDNormalized parses a HubRouteTable ID into an HubRouteTableId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the HubRouteTableID method should be used instead for validation etc.
+func HubRouteTableIDNormalized(input string) (*HubRouteTableId, error) {
+	return HubRouteTableID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualHubs/%s/hubRouteTables/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type HubVirtualNetworkConnectionId struct {
@@ -73,3 +74,12 @@ func HubVirtualNetworkConnectionID(input string) (*HubVirtualNetworkConnectionId
 
 	return &resourceId, nil
 }
+
+// HubVirtualNetworkConnectionIDNormalized parses a HubVirtualNetworkConnection ID into an HubVirtualNetworkConnectionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the HubVirtualNetworkConnectionID method should be used instead for validation etc.
+func HubVirtualNetworkConnectionIDNormalized(input string) (*HubVirtualNetworkConnectionId, error) {
+	return HubVirtualNetworkConnectionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualHubs/%s/hubVirtualNetworkConnections/%s"))
+}
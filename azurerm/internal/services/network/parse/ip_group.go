@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type IpGroupId struct {
@@ -67,3 +68,12 @@ func IpGroupID(input string) (*IpGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// IpGroupIDNormalized parses a IpGroup ID into an IpGroupId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the IpGroupID method should be used instead for validation etc.
+func IpGroupIDNormalized(input string) (*IpGroupId, error) {
+	return IpGroupID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/ipGroups/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NatGatewayId struct {
@@ -67,3 +68,12 @@ func NatGatewayID(input string) (*NatGatewayId, error) {
 
 	return &resourceId, nil
 }
+
+// NatGatewayIDNormalized parses a NatGateway ID into an NatGatewayId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the NatGatewayID method should be used instead for validation etc.
+func NatGatewayIDNormalized(input string) (*NatGatewayId, error) {
+	return NatGatewayID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/natGateways/%s"))
+}
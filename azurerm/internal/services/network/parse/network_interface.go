@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NetworkInterfaceId struct {
@@ -67,3 +68,12 @@ func NetworkInterfaceID(input string) (*NetworkInterfaceId, error) {
 
 	return &resourceId, nil
 }
+
+// NetworkInterfaceIDNormalized parses a NetworkInterface ID into an NetworkInterfaceId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the NetworkInterfaceID method should be used instead for validation etc.
+func NetworkInterfaceIDNormalized(input string) (*NetworkInterfaceId, error) {
+	return NetworkInterfaceID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkInterfaces/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NetworkSecurityGroupId struct {
@@ -67,3 +68,12 @@ func NetworkSecurityGroupID(input string) (*NetworkSecurityGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// NetworkSecurityGroupIDNormalized parses a NetworkSecurityGroup ID into an NetworkSecurityGroupId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the NetworkSecurityGroupID method should be used instead for validation etc.
+func NetworkSecurityGroupIDNormalized(input string) (*NetworkSecurityGroupId, error) {
+	return NetworkSecurityGroupID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkSecurityGroups/%s"))
+}
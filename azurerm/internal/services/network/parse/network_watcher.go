@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NetworkWatcherId struct {
@@ -67,3 +68,12 @@ func NetworkWatcherID(input string) (*NetworkWatcherId, error) {
 
 	return &resourceId, nil
 }
+
+// NetworkWatcherIDNormalized parses a NetworkWatcher ID into an NetworkWatcherId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the NetworkWatcherID method should be used instead for validation etc.
+func NetworkWatcherIDNormalized(input string) (*NetworkWatcherId, error) {
+	return NetworkWatcherID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkWatchers/%s"))
+}
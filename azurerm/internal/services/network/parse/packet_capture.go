@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PacketCaptureId struct {
@@ -73,3 +74,12 @@ func PacketCaptureID(input string) (*PacketCaptureId, error) {
 
 	return &resourceId, nil
 }
+
+// PacketCaptureIDNormalized parses a PacketCapture ID into an PacketCaptureId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the PacketCaptureID method should be used instead for validation etc.
+func PacketCaptureIDNormalized(input string) (*PacketCaptureId, error) {
+	return PacketCaptureID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkWatchers/%s/packetCaptures/%s"))
+}
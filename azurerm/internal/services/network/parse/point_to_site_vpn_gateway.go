@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PointToSiteVpnGatewayId struct {
@@ -67,3 +68,12 @@ func PointToSiteVpnGatewayID(input string) (*PointToSiteVpnGatewayId, error) {
 
 	return &resourceId, nil
 }
+
+// PointToSiteVpnGatewayIDNormalized parses a PointToSiteVpnGateway ID into an PointToSiteVpnGatewayId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the PointToSiteVpnGatewayID method should be used instead for validation etc.
+func PointToSiteVpnGatewayIDNormalized(input string) (*PointToSiteVpnGatewayId, error) {
+	return PointToSiteVpnGatewayID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/p2sVpnGateways/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PrivateDnsZoneConfigId struct {
@@ -79,3 +80,12 @@ func PrivateDnsZoneConfigID(input string) (*PrivateDnsZoneConfigId, error) {
 
 	return &resourceId, nil
 }
+
+// PrivateDnsZoneConfigIDNormalized parses a PrivateDnsZoneConfig ID into an PrivateDnsZoneConfigId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the PrivateDnsZoneConfigID method should be used instead for validation etc.
+func PrivateDnsZoneConfigIDNormalized(input string) (*PrivateDnsZoneConfigId, error) {
+	return PrivateDnsZoneConfigID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateEndpoints/%s/privateDnsZoneGroups/%s/privateDnsZoneConfigs/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PrivateDnsZoneGroupId struct {
@@ -73,3 +74,12 @@ func PrivateDnsZoneGroupID(input string) (*PrivateDnsZoneGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// PrivateDnsZoneGroupIDNormalized parses a PrivateDnsZoneGroup ID into an PrivateDnsZoneGroupId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the PrivateDnsZoneGroupID method should be used instead for validation etc.
+func PrivateDnsZoneGroupIDNormalized(input string) (*PrivateDnsZoneGroupId, error) {
+	return PrivateDnsZoneGroupID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateEndpoints/%s/privateDnsZoneGroups/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PrivateEndpointId struct {
@@ -67,3 +68,12 @@ func PrivateEndpointID(input string) (*PrivateEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+// PrivateEndpointIDNormalized parses a PrivateEndpoint ID into an PrivateEndpointId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the PrivateEndpointID method should be used instead for validation etc.
+func PrivateEndpointIDNormalized(input string) (*PrivateEndpointId, error) {
+	return PrivateEndpointID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateEndpoints/%s"))
+}
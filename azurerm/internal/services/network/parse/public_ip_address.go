@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PublicIpAddressId struct {
@@ -67,3 +68,12 @@ func PublicIpAddressID(input string) (*PublicIpAddressId, error) {
 
 	return &resourceId, nil
 }
+
+// PublicIpAddressIDNormalized parses a PublicIpAddress ID into an PublicIpAddressId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the PublicIpAddressID method should be used instead for validation etc.
+func PublicIpAddressIDNormalized(input string) (*PublicIpAddressId, error) {
+	return PublicIpAddressID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/publicIPAddresses/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RouteId struct {
@@ -73,3 +74,12 @@ func RouteID(input string) (*RouteId, error) {
 
 	return &resourceId, nil
 }
+
+// RouteIDNormalized parses a Route ID into an RouteId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the RouteID method should be used instead for validation etc.
+func RouteIDNormalized(input string) (*RouteId, error) {
+	return RouteID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/routeTables/%s/routes/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RouteFilterId struct {
@@ -67,3 +68,12 @@ func RouteFilterID(input string) (*RouteFilterId, error) {
 
 	return &resourceId, nil
 }
+
+// RouteFilterIDNormalized parses a RouteFilter ID into an RouteFilterId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the RouteFilterID method should be used instead for validation etc.
+func RouteFilterIDNormalized(input string) (*RouteFilterId, error) {
+	return RouteFilterID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/routeFilters/%s"))
+}
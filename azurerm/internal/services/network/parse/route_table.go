@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RouteTableId struct {
@@ -67,3 +68,12 @@ func RouteTableID(input string) (*RouteTableId, error) {
 
 	return &resourceId, nil
 }
+
+// RouteTableIDNormalized parses a RouteTable ID into an RouteTableId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the RouteTableID method should be used instead for validation etc.
+func RouteTableIDNormalized(input string) (*RouteTableId, error) {
+	return RouteTableID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/routeTables/%s"))
+}
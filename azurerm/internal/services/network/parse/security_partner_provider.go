@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SecurityPartnerProviderId struct {
@@ -67,3 +68,12 @@ func SecurityPartnerProviderID(input string) (*SecurityPartnerProviderId, error)
 
 	return &resourceId, nil
 }
+
+// SecurityPartnerProviderIDNormalized parses a SecurityPartnerProvider ID into an SecurityPartnerProviderId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SecurityPartnerProviderID method should be used instead for validation etc.
+func SecurityPartnerProviderIDNormalized(input string) (*SecurityPartnerProviderId, error) {
+	return SecurityPartnerProviderID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/securityPartnerProviders/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SubnetId struct {
@@ -74,6 +75,15 @@ func SubnetID(input string) (*SubnetId, error) {
 	return &resourceId, nil
 }
 
+// SubnetIDNormalized parses a Subnet ID into an SubnetId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SubnetID method should be used instead for validation etc.
+func SubnetIDNormalized(input string) (*SubnetId, error) {
+	return SubnetID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s/subnets/%s"))
+}
+
 // SubnetIDInsensitively parses an Subnet ID into an SubnetId struct, insensitively
 // This should only be used to parse an ID for rewriting, the SubnetID
 // method should be used instead for validation etc.
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SubnetServiceEndpointStoragePolicyId struct {
@@ -67,3 +68,12 @@ func SubnetServiceEndpointStoragePolicyID(input string) (*SubnetServiceEndpointS
 
 	return &resourceId, nil
 }
+
+// SubnetServiceEndpointStoragePolicyIDNormalized parses a SubnetServiceEndpointStoragePolicy ID into an SubnetServiceEndpointStoragePolicyId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SubnetServiceEndpointStoragePolicyID method should be used instead for validation etc.
+func SubnetServiceEndpointStoragePolicyIDNormalized(input string) (*SubnetServiceEndpointStoragePolicyId, error) {
+	return SubnetServiceEndpointStoragePolicyID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/serviceEndpointPolicies/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualHubId struct {
@@ -67,3 +68,12 @@ func VirtualHubID(input string) (*VirtualHubId, error) {
 
 	return &resourceId, nil
 }
+
+// VirtualHubIDNormalized parses a VirtualHub ID into an VirtualHubId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VirtualHubID method should be used instead for validation etc.
+func VirtualHubIDNormalized(input string) (*VirtualHubId, error) {
+	return VirtualHubID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualHubs/%s"))
+}
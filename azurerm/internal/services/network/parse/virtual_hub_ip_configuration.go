@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualHubIpConfigurationId struct {
@@ -73,3 +74,12 @@ func VirtualHubIpConfigurationID(input string) (*VirtualHubIpConfigurationId, er
 
 	return &resourceId, nil
 }
+
+// VirtualHubIpConfigurationIDNormalized parses a VirtualHubIpConfiguration ID into an VirtualHubIpConfigurationId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VirtualHubIpConfigurationID method should be used instead for validation etc.
+func VirtualHubIpConfigurationIDNormalized(input string) (*VirtualHubIpConfigurationId, error) {
+	return VirtualHubIpConfigurationID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualHubs/%s/ipConfigurations/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualNetworkId struct {
@@ -68,6 +69,15 @@ func VirtualNetworkID(input string) (*VirtualNetworkId, error) {
 	return &resourceId, nil
 }
 
+// VirtualNetworkIDNormalized parses a VirtualNetwork ID into an VirtualNetworkId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VirtualNetworkID method should be used instead for validation etc.
+func VirtualNetworkIDNormalized(input string) (*VirtualNetworkId, error) {
+	return VirtualNetworkID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s"))
+}
+
 // VirtualNetworkIDInsensitively parses an VirtualNetwork ID into an VirtualNetworkId struct, insensitively
 // This should only be used to parse an ID for rewriting, the VirtualNetworkID
 // method should be used instead for validation etc.
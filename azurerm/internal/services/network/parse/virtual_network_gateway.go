@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualNetworkGatewayId struct {
@@ -67,3 +68,12 @@ func VirtualNetworkGatewayID(input string) (*VirtualNetworkGatewayId, error) {
 
 	return &resourceId, nil
 }
+
+// VirtualNetworkGatewayIDNormalized parses a VirtualNetworkGateway ID into an VirtualNetworkGatewayId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VirtualNetworkGatewayID method should be used instead for validation etc.
+func VirtualNetworkGatewayIDNormalized(input string) (*VirtualNetworkGatewayId, error) {
+	return VirtualNetworkGatewayID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworkGateways/%s"))
+}
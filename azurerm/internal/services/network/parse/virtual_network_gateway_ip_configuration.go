@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualNetworkGatewayIpConfigurationId struct {
@@ -73,3 +74,12 @@ func VirtualNetworkGatewayIpConfigurationID(input string) (*VirtualNetworkGatewa
 
 	return &resourceId, nil
 }
+
+// VirtualNetworkGatewayIpConfigurationIDNormalized parses a VirtualNetworkGatewayIpConfiguration ID into an VirtualNetworkGatewayIpConfigurationId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VirtualNetworkGatewayIpConfigurationID method should be used instead for validation etc.
+func VirtualNetworkGatewayIpConfigurationIDNormalized(input string) (*VirtualNetworkGatewayIpConfigurationId, error) {
+	return VirtualNetworkGatewayIpConfigurationID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworkGateways/%s/ipConfigurations/%s"))
+}
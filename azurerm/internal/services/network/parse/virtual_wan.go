@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualWanId struct {
@@ -67,3 +68,12 @@ func VirtualWanID(input string) (*VirtualWanId, error) {
 
 	return &resourceId, nil
 }
+
+// VirtualWanIDNormalized parses a VirtualWan ID into an VirtualWanId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VirtualWanID method should be used instead for validation etc.
+func VirtualWanIDNormalized(input string) (*VirtualWanId, error) {
+	return VirtualWanID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualWans/%s"))
+}
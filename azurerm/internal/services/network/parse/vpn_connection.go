@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VpnConnectionId struct {
@@ -73,3 +74,12 @@ func VpnConnectionID(input string) (*VpnConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+// VpnConnectionIDNormalized parses a VpnConnection ID into an VpnConnectionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VpnConnectionID method should be used instead for validation etc.
+func VpnConnectionIDNormalized(input string) (*VpnConnectionId, error) {
+	return VpnConnectionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/vpnGateways/%s/vpnConnections/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VpnGatewayId struct {
@@ -67,3 +68,12 @@ func VpnGatewayID(input string) (*VpnGatewayId, error) {
 
 	return &resourceId, nil
 }
+
+// VpnGatewayIDNormalized parses a VpnGateway ID into an VpnGatewayId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VpnGatewayID method should be used instead for validation etc.
+func VpnGatewayIDNormalized(input string) (*VpnGatewayId, error) {
+	return VpnGatewayID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/vpnGateways/%s"))
+}
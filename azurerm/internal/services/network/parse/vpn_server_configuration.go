@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VpnServerConfigurationId struct {
@@ -67,3 +68,12 @@ func VpnServerConfigurationID(input string) (*VpnServerConfigurationId, error) {
 
 	return &resourceId, nil
 }
+
+// VpnServerConfigurationIDNormalized parses a VpnServerConfiguration ID into an VpnServerConfigurationId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VpnServerConfigurationID method should be used instead for validation etc.
+func VpnServerConfigurationIDNormalized(input string) (*VpnServerConfigurationId, error) {
+	return VpnServerConfigurationID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/vpnServerConfigurations/%s"))
+}
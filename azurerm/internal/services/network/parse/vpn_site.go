@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VpnSiteId struct {
@@ -67,3 +68,12 @@ func VpnSiteID(input string) (*VpnSiteId, error) {
 
 	return &resourceId, nil
 }
+
+// VpnSiteIDNormalized parses a VpnSite ID into an VpnSiteId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VpnSiteID method should be used instead for validation etc.
+func VpnSiteIDNormalized(input string) (*VpnSiteId, error) {
+	return VpnSiteID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/vpnSites/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VpnSiteLinkId struct {
@@ -73,3 +74,12 @@ func VpnSiteLinkID(input string) (*VpnSiteLinkId, error) {
 
 	return &resourceId, nil
 }
+
+// VpnSiteLinkIDNormalized parses a VpnSiteLink ID into an VpnSiteLinkId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VpnSiteLinkID method should be used instead for validation etc.
+func VpnSiteLinkIDNormalized(input string) (*VpnSiteLinkId, error) {
+	return VpnSiteLinkID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/vpnSites/%s/vpnSiteLinks/%s"))
+}
@@ -162,7 +162,7 @@ func resourcePointToSiteVPNGateway() *schema.Resource {
 }
 
 func resourcePointToSiteVPNGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PointToSiteVpnGatewaysClient
+	client := meta.(*clients.Client).Network().PointToSiteVpnGatewaysClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -230,7 +230,7 @@ func resourcePointToSiteVPNGatewayCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourcePointToSiteVPNGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PointToSiteVpnGatewaysClient
+	client := meta.(*clients.Client).Network().PointToSiteVpnGatewaysClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -287,7 +287,7 @@ func resourcePointToSiteVPNGatewayRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourcePointToSiteVPNGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PointToSiteVpnGatewaysClient
+	client := meta.(*clients.Client).Network().PointToSiteVpnGatewaysClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -93,7 +93,7 @@ func (PointToSiteVPNGatewayResource) Exists(ctx context.Context, clients *client
 		return nil, err
 	}
 
-	resp, err := clients.Network.PointToSiteVpnGatewaysClient.Get(ctx, id.ResourceGroup, id.P2sVpnGatewayName)
+	resp, err := clients.Network().PointToSiteVpnGatewaysClient.Get(ctx, id.ResourceGroup, id.P2sVpnGatewayName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Point to Site VPN Gateway (%s): %+v", *id, err)
 	}
@@ -60,8 +60,8 @@ func dataSourcePrivateEndpointConnection() *schema.Resource {
 }
 
 func dataSourcePrivateEndpointConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PrivateEndpointClient
-	nicsClient := meta.(*clients.Client).Network.InterfacesClient
+	client := meta.(*clients.Client).Network().PrivateEndpointClient
+	nicsClient := meta.(*clients.Client).Network().InterfacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
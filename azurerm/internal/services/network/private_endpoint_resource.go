@@ -211,8 +211,8 @@ func resourcePrivateEndpoint() *schema.Resource {
 }
 
 func resourcePrivateEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PrivateEndpointClient
-	dnsClient := meta.(*clients.Client).Network.PrivateDnsZoneGroupClient
+	client := meta.(*clients.Client).Network().PrivateEndpointClient
+	dnsClient := meta.(*clients.Client).Network().PrivateDnsZoneGroupClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -279,8 +279,8 @@ func resourcePrivateEndpointCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourcePrivateEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PrivateEndpointClient
-	dnsClient := meta.(*clients.Client).Network.PrivateDnsZoneGroupClient
+	client := meta.(*clients.Client).Network().PrivateEndpointClient
+	dnsClient := meta.(*clients.Client).Network().PrivateDnsZoneGroupClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -372,9 +372,9 @@ func resourcePrivateEndpointUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourcePrivateEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PrivateEndpointClient
-	nicsClient := meta.(*clients.Client).Network.InterfacesClient
-	dnsClient := meta.(*clients.Client).Network.PrivateDnsZoneGroupClient
+	client := meta.(*clients.Client).Network().PrivateEndpointClient
+	nicsClient := meta.(*clients.Client).Network().InterfacesClient
+	dnsClient := meta.(*clients.Client).Network().PrivateDnsZoneGroupClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -455,8 +455,8 @@ func resourcePrivateEndpointRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourcePrivateEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PrivateEndpointClient
-	dnsZoneGroupsClient := meta.(*clients.Client).Network.PrivateDnsZoneGroupClient
+	client := meta.(*clients.Client).Network().PrivateEndpointClient
+	dnsZoneGroupsClient := meta.(*clients.Client).Network().PrivateDnsZoneGroupClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
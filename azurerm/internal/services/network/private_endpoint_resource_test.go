@@ -211,7 +211,7 @@ func (t PrivateEndpointResource) Exists(ctx context.Context, clients *clients.Cl
 		return nil, err
 	}
 
-	resp, err := clients.Network.PrivateEndpointClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := clients.Network().PrivateEndpointClient.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Private Endpoint (%s): %+v", id.String(), err)
 	}
@@ -95,7 +95,7 @@ func dataSourcePrivateLinkService() *schema.Resource {
 }
 
 func dataSourcePrivateLinkServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PrivateLinkServiceClient
+	client := meta.(*clients.Client).Network().PrivateLinkServiceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
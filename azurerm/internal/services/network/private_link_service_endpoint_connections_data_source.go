@@ -76,7 +76,7 @@ func dataSourcePrivateLinkServiceEndpointConnections() *schema.Resource {
 }
 
 func dataSourcePrivateLinkServiceEndpointConnectionsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PrivateLinkServiceClient
+	client := meta.(*clients.Client).Network().PrivateLinkServiceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -149,7 +149,7 @@ func resourcePrivateLinkService() *schema.Resource {
 }
 
 func resourcePrivateLinkServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PrivateLinkServiceClient
+	client := meta.(*clients.Client).Network().PrivateLinkServiceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -235,7 +235,7 @@ func resourcePrivateLinkServiceCreateUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourcePrivateLinkServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PrivateLinkServiceClient
+	client := meta.(*clients.Client).Network().PrivateLinkServiceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -293,7 +293,7 @@ func resourcePrivateLinkServiceRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourcePrivateLinkServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PrivateLinkServiceClient
+	client := meta.(*clients.Client).Network().PrivateLinkServiceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
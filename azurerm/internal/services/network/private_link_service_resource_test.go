@@ -221,7 +221,7 @@ func (t PrivateLinkServiceResource) Exists(ctx context.Context, clients *clients
 	resourceGroup := id.ResourceGroup
 	name := id.Path["privateLinkServices"]
 
-	resp, err := clients.Network.PrivateLinkServiceClient.Get(ctx, resourceGroup, name, "")
+	resp, err := clients.Network().PrivateLinkServiceClient.Get(ctx, resourceGroup, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Private Link Service (%s): %+v", id, err)
 	}
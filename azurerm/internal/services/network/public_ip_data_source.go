@@ -80,7 +80,7 @@ func dataSourcePublicIP() *schema.Resource {
 }
 
 func dataSourcePublicIPRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PublicIPsClient
+	client := meta.(*clients.Client).Network().PublicIPsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -53,7 +53,7 @@ func dataSourcePublicIpPrefix() *schema.Resource {
 }
 
 func dataSourcePublicIpPrefixRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PublicIPPrefixesClient
+	client := meta.(*clients.Client).Network().PublicIPPrefixesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
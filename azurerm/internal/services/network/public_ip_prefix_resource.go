@@ -75,7 +75,7 @@ func resourcePublicIpPrefix() *schema.Resource {
 }
 
 func resourcePublicIpPrefixCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PublicIPPrefixesClient
+	client := meta.(*clients.Client).Network().PublicIPPrefixesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -125,7 +125,7 @@ func resourcePublicIpPrefixCreateUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourcePublicIpPrefixRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PublicIPPrefixesClient
+	client := meta.(*clients.Client).Network().PublicIPPrefixesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -166,7 +166,7 @@ func resourcePublicIpPrefixRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourcePublicIpPrefixDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PublicIPPrefixesClient
+	client := meta.(*clients.Client).Network().PublicIPPrefixesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -25,7 +25,7 @@ func (t PublicIPPrefixResource) Exists(ctx context.Context, clients *clients.Cli
 	resGroup := id.ResourceGroup
 	name := id.Path["publicIPPrefixes"]
 
-	resp, err := clients.Network.PublicIPPrefixesClient.Get(ctx, resGroup, name, "")
+	resp, err := clients.Network().PublicIPPrefixesClient.Get(ctx, resGroup, name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Public IP Prefix (%s): %+v", id, err)
 	}
@@ -41,12 +41,12 @@ func (PublicIPPrefixResource) Destroy(ctx context.Context, client *clients.Clien
 	resGroup := id.ResourceGroup
 	name := id.Path["publicIPPrefixes"]
 
-	future, err := client.Network.PublicIPPrefixesClient.Delete(ctx, resGroup, name)
+	future, err := client.Network().PublicIPPrefixesClient.Delete(ctx, resGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting Public IP Prefix %q: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.PublicIPPrefixesClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().PublicIPPrefixesClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for Deletion of Public IP Prefix %q: %+v", id, err)
 	}
 
@@ -128,7 +128,7 @@ func resourcePublicIp() *schema.Resource {
 }
 
 func resourcePublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PublicIPsClient
+	client := meta.(*clients.Client).Network().PublicIPsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -223,7 +223,7 @@ func resourcePublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourcePublicIpRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PublicIPsClient
+	client := meta.(*clients.Client).Network().PublicIPsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -275,7 +275,7 @@ func resourcePublicIpRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePublicIpDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PublicIPsClient
+	client := meta.(*clients.Client).Network().PublicIPsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -347,7 +347,7 @@ func (t PublicIPResource) Exists(ctx context.Context, clients *clients.Client, s
 		return nil, err
 	}
 
-	resp, err := clients.Network.PublicIPsClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := clients.Network().PublicIPsClient.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Public IP %s: %+v", *id, err)
 	}
@@ -361,12 +361,12 @@ func (PublicIPResource) Destroy(ctx context.Context, client *clients.Client, sta
 		return nil, err
 	}
 
-	future, err := client.Network.PublicIPsClient.Delete(ctx, id.ResourceGroup, id.Name)
+	future, err := client.Network().PublicIPsClient.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting Public IP %q: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.PublicIPsClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().PublicIPsClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for Deletion of Public IP %s: %+v", *id, err)
 	}
 
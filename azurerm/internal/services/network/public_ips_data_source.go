@@ -77,7 +77,7 @@ func dataSourcePublicIPs() *schema.Resource {
 }
 
 func dataSourcePublicIPsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PublicIPsClient
+	client := meta.(*clients.Client).Network().PublicIPsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
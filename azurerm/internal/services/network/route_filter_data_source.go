@@ -70,7 +70,7 @@ func dataSourceRouteFilter() *schema.Resource {
 }
 
 func dataSourceRouteFilterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.RouteFiltersClient
+	client := meta.(*clients.Client).Network().RouteFiltersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -98,7 +98,7 @@ func resourceRouteFilter() *schema.Resource {
 }
 
 func resourceRouteFilterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.RouteFiltersClient
+	client := meta.(*clients.Client).Network().RouteFiltersClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -155,7 +155,7 @@ func resourceRouteFilterCreateUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceRouteFilterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.RouteFiltersClient
+	client := meta.(*clients.Client).Network().RouteFiltersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -189,7 +189,7 @@ func resourceRouteFilterRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRouteFilterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.RouteFiltersClient
+	client := meta.(*clients.Client).Network().RouteFiltersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -137,7 +137,7 @@ func (t RouteFilterResource) Exists(ctx context.Context, clients *clients.Client
 		return nil, err
 	}
 
-	resp, err := clients.Network.RouteFiltersClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := clients.Network().RouteFiltersClient.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Route Filter (%s): %+v", id, err)
 	}
@@ -151,12 +151,12 @@ func (RouteFilterResource) Destroy(ctx context.Context, client *clients.Client,
 		return nil, err
 	}
 
-	future, err := client.Network.RouteFiltersClient.Delete(ctx, id.ResourceGroup, id.Name)
+	future, err := client.Network().RouteFiltersClient.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting Route Filter %q: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.RouteFiltersClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().RouteFiltersClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for Deletion of Route Filter %q: %+v", id, err)
 	}
 
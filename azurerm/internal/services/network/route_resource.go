@@ -18,6 +18,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var routeResourceName = "azurerm_route"
+
 func resourceRoute() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRouteCreateUpdate,
@@ -83,7 +85,7 @@ func resourceRoute() *schema.Resource {
 }
 
 func resourceRouteCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.RoutesClient
+	client := meta.(*clients.Client).Network().RoutesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -105,8 +107,11 @@ func resourceRouteCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	locks.ByName(id.RouteTableName, routeTableResourceName)
-	defer locks.UnlockByName(id.RouteTableName, routeTableResourceName)
+	parent := lockingParent(id.RouteTableName, routeTableResourceName)
+	if err := locks.ChildByNameWithContext(ctx, parent, id.Name, routeResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
+	defer locks.UnlockChildByName(parent, id.Name, routeResourceName)
 
 	route := network.Route{
 		Name: utils.String(id.Name),
@@ -134,7 +139,7 @@ func resourceRouteCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRouteRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.RoutesClient
+	client := meta.(*clients.Client).Network().RoutesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -166,7 +171,7 @@ func resourceRouteRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.RoutesClient
+	client := meta.(*clients.Client).Network().RoutesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -175,8 +180,11 @@ func resourceRouteDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.RouteTableName, routeTableResourceName)
-	defer locks.UnlockByName(id.RouteTableName, routeTableResourceName)
+	parent := lockingParent(id.RouteTableName, routeTableResourceName)
+	if err := locks.ChildByNameWithContext(ctx, parent, id.Name, routeResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
+	defer locks.UnlockChildByName(parent, id.Name, routeResourceName)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.RouteTableName, id.Name)
 	if err != nil {
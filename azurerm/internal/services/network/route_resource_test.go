@@ -121,7 +121,7 @@ func (t RouteResource) Exists(ctx context.Context, clients *clients.Client, stat
 		return nil, err
 	}
 
-	resp, err := clients.Network.RoutesClient.Get(ctx, id.ResourceGroup, id.RouteTableName, id.Name)
+	resp, err := clients.Network().RoutesClient.Get(ctx, id.ResourceGroup, id.RouteTableName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Route (%s): %+v", *id, err)
 	}
@@ -135,12 +135,12 @@ func (r RouteResource) Destroy(ctx context.Context, client *clients.Client, stat
 		return nil, err
 	}
 
-	future, err := client.Network.RoutesClient.Delete(ctx, id.ResourceGroup, id.RouteTableName, id.Name)
+	future, err := client.Network().RoutesClient.Delete(ctx, id.ResourceGroup, id.RouteTableName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting on routesClient: %+v", err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.RoutesClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().RoutesClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for deletion of Route %q: %+v", id, err)
 	}
 
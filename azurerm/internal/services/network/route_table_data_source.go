@@ -74,7 +74,7 @@ func dataSourceRouteTable() *schema.Resource {
 }
 
 func dataSourceRouteTableRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.RouteTablesClient
+	client := meta.(*clients.Client).Network().RouteTablesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
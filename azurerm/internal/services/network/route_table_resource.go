@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
@@ -113,7 +114,7 @@ func resourceRouteTable() *schema.Resource {
 }
 
 func resourceRouteTableCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.RouteTablesClient
+	client := meta.(*clients.Client).Network().RouteTablesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -137,6 +138,12 @@ func resourceRouteTableCreateUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	// the Routes within this Route Table can't be changed at the same time as the Route Table
+	if err := locks.ByNameWithContext(ctx, name, routeTableResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Route Table %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	defer locks.UnlockByName(name, routeTableResourceName)
+
 	routeSet := network.RouteTable{
 		Name:     &name,
 		Location: &location,
@@ -171,7 +178,7 @@ func resourceRouteTableCreateUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceRouteTableRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.RouteTablesClient
+	client := meta.(*clients.Client).Network().RouteTablesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -210,7 +217,7 @@ func resourceRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.RouteTablesClient
+	client := meta.(*clients.Client).Network().RouteTablesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -219,6 +226,11 @@ func resourceRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := locks.ByNameWithContext(ctx, id.Name, routeTableResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
+	defer locks.UnlockByName(id.Name, routeTableResourceName)
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		if !response.WasNotFound(future.Response()) {
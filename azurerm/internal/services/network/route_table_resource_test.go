@@ -230,7 +230,7 @@ func (t RouteTableResource) Exists(ctx context.Context, clients *clients.Client,
 		return nil, err
 	}
 
-	resp, err := clients.Network.RouteTablesClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := clients.Network().RouteTablesClient.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Route Table (%s): %+v", id, err)
 	}
@@ -244,12 +244,12 @@ func (RouteTableResource) Destroy(ctx context.Context, client *clients.Client, s
 		return nil, err
 	}
 
-	future, err := client.Network.RouteTablesClient.Delete(ctx, id.ResourceGroup, id.Name)
+	future, err := client.Network().RouteTablesClient.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting Route Table %q: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.RouteTablesClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().RouteTablesClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for Deletion of Route Table %q: %+v", id, err)
 	}
 
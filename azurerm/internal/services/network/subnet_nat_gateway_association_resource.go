@@ -50,7 +50,7 @@ func resourceSubnetNatGatewayAssociation() *schema.Resource {
 }
 
 func resourceSubnetNatGatewayAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SubnetsClient
+	client := meta.(*clients.Client).Network().SubnetsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -73,12 +73,15 @@ func resourceSubnetNatGatewayAssociationCreate(d *schema.ResourceData, meta inte
 
 	gatewayName := parsedGatewayId.Name
 
-	locks.ByName(gatewayName, natGatewayResourceName)
-	defer locks.UnlockByName(gatewayName, natGatewayResourceName)
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
-	locks.ByName(subnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetName, SubnetResourceName)
+	if err := lockReferencedResource(ctx, gatewayName, natGatewayResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for NAT Gateway Association (Subnet %q / Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+	}
+	defer unlockReferencedResource(gatewayName, natGatewayResourceName)
+	parent := lockingParent(virtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ChildByNameWithContext(ctx, parent, subnetName, SubnetResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for NAT Gateway Association (Subnet %q / Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+	}
+	defer locks.UnlockChildByName(parent, subnetName, SubnetResourceName)
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
@@ -119,7 +122,7 @@ func resourceSubnetNatGatewayAssociationCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceSubnetNatGatewayAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SubnetsClient
+	client := meta.(*clients.Client).Network().SubnetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -160,7 +163,7 @@ func resourceSubnetNatGatewayAssociationRead(d *schema.ResourceData, meta interf
 }
 
 func resourceSubnetNatGatewayAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SubnetsClient
+	client := meta.(*clients.Client).Network().SubnetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -196,10 +199,15 @@ func resourceSubnetNatGatewayAssociationDelete(d *schema.ResourceData, meta inte
 	}
 
 	gatewayName := parsedGatewayId.Path["natGateways"]
-	locks.ByName(gatewayName, natGatewayResourceName)
-	defer locks.UnlockByName(gatewayName, natGatewayResourceName)
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	if err := lockReferencedResource(ctx, gatewayName, natGatewayResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
+	defer unlockReferencedResource(gatewayName, natGatewayResourceName)
+	parent := lockingParent(virtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ChildByNameWithContext(ctx, parent, subnetName, SubnetResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
+	defer locks.UnlockChildByName(parent, subnetName, SubnetResourceName)
 
 	// ensure we get the latest state
 	subnet, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
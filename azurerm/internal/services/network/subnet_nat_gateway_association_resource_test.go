@@ -102,7 +102,7 @@ func (t SubnetNatGatewayAssociationResource) Exists(ctx context.Context, clients
 	virtualNetworkName := id.VirtualNetworkName
 	subnetName := id.Name
 
-	resp, err := clients.Network.SubnetsClient.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
+	resp, err := clients.Network().SubnetsClient.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Subnet Nat Gateway Association (%s): %+v", id, err)
 	}
@@ -125,7 +125,7 @@ func (SubnetNatGatewayAssociationResource) destroy(ctx context.Context, client *
 	virtualNetworkName := parsedSubnetId.VirtualNetworkName
 	subnetName := parsedSubnetId.Name
 
-	subnet, err := client.Network.SubnetsClient.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
+	subnet, err := client.Network().SubnetsClient.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
 		if !utils.ResponseWasNotFound(subnet.Response) {
 			return fmt.Errorf("Error retrieving Subnet %q (Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
@@ -139,12 +139,12 @@ func (SubnetNatGatewayAssociationResource) destroy(ctx context.Context, client *
 	}
 	props.NatGateway = nil
 
-	future, err := client.Network.SubnetsClient.CreateOrUpdate(ctx, resourceGroup, virtualNetworkName, subnetName, subnet)
+	future, err := client.Network().SubnetsClient.CreateOrUpdate(ctx, resourceGroup, virtualNetworkName, subnetName, subnet)
 	if err != nil {
 		return fmt.Errorf("Error updating Subnet %q (Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.SubnetsClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().SubnetsClient.Client); err != nil {
 		return fmt.Errorf("Error waiting for completion of Subnet %q (Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
 	}
 	return nil
@@ -51,7 +51,7 @@ func resourceSubnetNetworkSecurityGroupAssociation() *schema.Resource {
 }
 
 func resourceSubnetNetworkSecurityGroupAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SubnetsClient
+	client := meta.(*clients.Client).Network().SubnetsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -70,18 +70,20 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *schema.ResourceData,
 		return err
 	}
 
-	locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
+	if err := lockReferencedResource(ctx, parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Security Group Association (Subnet %q / Virtual Network %q / Resource Group %q): %+v", parsedSubnetId.Path["subnets"], parsedSubnetId.Path["virtualNetworks"], parsedSubnetId.ResourceGroup, err)
+	}
+	defer unlockReferencedResource(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
 
 	subnetName := parsedSubnetId.Path["subnets"]
 	virtualNetworkName := parsedSubnetId.Path["virtualNetworks"]
 	resourceGroup := parsedSubnetId.ResourceGroup
 
-	locks.ByName(subnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetName, SubnetResourceName)
-
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	parent := lockingParent(virtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ChildByNameWithContext(ctx, parent, subnetName, SubnetResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Security Group Association (Subnet %q / Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+	}
+	defer locks.UnlockChildByName(parent, subnetName, SubnetResourceName)
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
@@ -125,7 +127,7 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *schema.ResourceData,
 }
 
 func resourceSubnetNetworkSecurityGroupAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SubnetsClient
+	client := meta.(*clients.Client).Network().SubnetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -166,7 +168,7 @@ func resourceSubnetNetworkSecurityGroupAssociationRead(d *schema.ResourceData, m
 }
 
 func resourceSubnetNetworkSecurityGroupAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SubnetsClient
+	client := meta.(*clients.Client).Network().SubnetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -205,14 +207,16 @@ func resourceSubnetNetworkSecurityGroupAssociationDelete(d *schema.ResourceData,
 		return err
 	}
 
-	locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	if err := lockReferencedResource(ctx, parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Security Group Association (Subnet %q / Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+	}
+	defer unlockReferencedResource(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
 
-	locks.ByName(subnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetName, SubnetResourceName)
+	parent := lockingParent(virtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ChildByNameWithContext(ctx, parent, subnetName, SubnetResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Security Group Association (Subnet %q / Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+	}
+	defer locks.UnlockChildByName(parent, subnetName, SubnetResourceName)
 
 	// then re-retrieve it to ensure we've got the latest state
 	read, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
@@ -51,7 +51,7 @@ func resourceSubnetRouteTableAssociation() *schema.Resource {
 }
 
 func resourceSubnetRouteTableAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SubnetsClient
+	client := meta.(*clients.Client).Network().SubnetsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -70,15 +70,20 @@ func resourceSubnetRouteTableAssociationCreate(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	locks.ByName(parsedRouteTableId.Name, routeTableResourceName)
-	defer locks.UnlockByName(parsedRouteTableId.Name, routeTableResourceName)
+	if err := lockReferencedResource(ctx, parsedRouteTableId.Name, routeTableResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Route Table Association (Subnet %q / Virtual Network %q / Resource Group %q): %+v", parsedSubnetId.Name, parsedSubnetId.VirtualNetworkName, parsedSubnetId.ResourceGroup, err)
+	}
+	defer unlockReferencedResource(parsedRouteTableId.Name, routeTableResourceName)
 
 	subnetName := parsedSubnetId.Name
 	virtualNetworkName := parsedSubnetId.VirtualNetworkName
 	resourceGroup := parsedSubnetId.ResourceGroup
 
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	parent := lockingParent(virtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ChildByNameWithContext(ctx, parent, subnetName, SubnetResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Route Table Association (Subnet %q / Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
+	}
+	defer locks.UnlockChildByName(parent, subnetName, SubnetResourceName)
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
@@ -122,7 +127,7 @@ func resourceSubnetRouteTableAssociationCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceSubnetRouteTableAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SubnetsClient
+	client := meta.(*clients.Client).Network().SubnetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -163,7 +168,7 @@ func resourceSubnetRouteTableAssociationRead(d *schema.ResourceData, meta interf
 }
 
 func resourceSubnetRouteTableAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SubnetsClient
+	client := meta.(*clients.Client).Network().SubnetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -202,11 +207,16 @@ func resourceSubnetRouteTableAssociationDelete(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	locks.ByName(parsedRouteTableId.Name, routeTableResourceName)
-	defer locks.UnlockByName(parsedRouteTableId.Name, routeTableResourceName)
+	if err := lockReferencedResource(ctx, parsedRouteTableId.Name, routeTableResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
+	defer unlockReferencedResource(parsedRouteTableId.Name, routeTableResourceName)
 
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	parent := lockingParent(virtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ChildByNameWithContext(ctx, parent, subnetName, SubnetResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
+	defer locks.UnlockChildByName(parent, subnetName, SubnetResourceName)
 
 	// then re-retrieve it to ensure we've got the latest state
 	read, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
@@ -102,7 +102,7 @@ func (SubnetRouteTableAssociationResource) Exists(ctx context.Context, clients *
 	virtualNetworkName := id.VirtualNetworkName
 	subnetName := id.Name
 
-	resp, err := clients.Network.SubnetsClient.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
+	resp, err := clients.Network().SubnetsClient.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Subnet Route Table Association (%s): %+v", id, err)
 	}
@@ -125,7 +125,7 @@ func (SubnetRouteTableAssociationResource) destroy(ctx context.Context, client *
 	virtualNetworkName := parsedId.VirtualNetworkName
 	subnetName := parsedId.Name
 
-	read, err := client.Network.SubnetsClient.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
+	read, err := client.Network().SubnetsClient.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
 		if !utils.ResponseWasNotFound(read.Response) {
 			return fmt.Errorf("Error retrieving Subnet %q (Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
@@ -134,11 +134,11 @@ func (SubnetRouteTableAssociationResource) destroy(ctx context.Context, client *
 
 	read.SubnetPropertiesFormat.RouteTable = nil
 
-	future, err := client.Network.SubnetsClient.CreateOrUpdate(ctx, resourceGroup, virtualNetworkName, subnetName, read)
+	future, err := client.Network().SubnetsClient.CreateOrUpdate(ctx, resourceGroup, virtualNetworkName, subnetName, read)
 	if err != nil {
 		return fmt.Errorf("Error updating Subnet %q (Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
 	}
-	if err = future.WaitForCompletionRef(ctx, client.Network.SubnetsClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().SubnetsClient.Client); err != nil {
 		return fmt.Errorf("Error waiting for completion of Subnet %q (Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
 	}
 
@@ -95,7 +95,7 @@ func resourceSubnetServiceEndpointStoragePolicy() *schema.Resource {
 }
 
 func resourceSubnetServiceEndpointStoragePolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ServiceEndpointPoliciesClient
+	client := meta.(*clients.Client).Network().ServiceEndpointPoliciesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -138,7 +138,7 @@ func resourceSubnetServiceEndpointStoragePolicyCreateUpdate(d *schema.ResourceDa
 }
 
 func resourceSubnetServiceEndpointStoragePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ServiceEndpointPoliciesClient
+	client := meta.(*clients.Client).Network().ServiceEndpointPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -173,7 +173,7 @@ func resourceSubnetServiceEndpointStoragePolicyRead(d *schema.ResourceData, meta
 }
 
 func resourceSubnetServiceEndpointStoragePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.ServiceEndpointPoliciesClient
+	client := meta.(*clients.Client).Network().ServiceEndpointPoliciesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -97,7 +97,7 @@ func (t SubnetServiceEndpointPolicyStorageResource) Exists(ctx context.Context,
 		return nil, err
 	}
 
-	resp, err := clients.Network.ServiceEndpointPoliciesClient.Get(ctx, id.ResourceGroup, id.ServiceEndpointPolicyName, "")
+	resp, err := clients.Network().ServiceEndpointPoliciesClient.Get(ctx, id.ResourceGroup, id.ServiceEndpointPolicyName, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Service Endpoint Policy Storage (%s): %+v", id, err)
 	}
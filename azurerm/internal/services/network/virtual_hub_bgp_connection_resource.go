@@ -68,7 +68,7 @@ func resourceVirtualHubBgpConnection() *schema.Resource {
 }
 
 func resourceVirtualHubBgpConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VirtualHubBgpConnectionClient
+	client := meta.(*clients.Client).Network().VirtualHubBgpConnectionClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -77,7 +77,9 @@ func resourceVirtualHubBgpConnectionCreate(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	locks.ByName(id.Name, virtualHubResourceName)
+	if err := locks.ByNameWithContext(ctx, id.Name, virtualHubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.Name, virtualHubResourceName)
 
 	name := d.Get("name").(string)
@@ -127,7 +129,7 @@ func resourceVirtualHubBgpConnectionCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceVirtualHubBgpConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VirtualHubBgpConnectionClient
+	client := meta.(*clients.Client).Network().VirtualHubBgpConnectionClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -159,7 +161,7 @@ func resourceVirtualHubBgpConnectionRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceVirtualHubBgpConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VirtualHubBgpConnectionClient
+	client := meta.(*clients.Client).Network().VirtualHubBgpConnectionClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -168,7 +170,9 @@ func resourceVirtualHubBgpConnectionDelete(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualHubName, virtualHubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
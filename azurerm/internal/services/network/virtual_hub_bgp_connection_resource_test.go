@@ -51,7 +51,7 @@ func (t VirtualHubBGPConnectionResource) Exists(ctx context.Context, clients *cl
 		return nil, err
 	}
 
-	resp, err := clients.Network.VirtualHubBgpConnectionClient.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	resp, err := clients.Network().VirtualHubBgpConnectionClient.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Virtual Hub BGP Connectionn (%s): %+v", id, err)
 	}
@@ -158,7 +158,7 @@ func resourceVirtualHubConnection() *schema.Resource {
 }
 
 func resourceVirtualHubConnectionCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.HubVirtualNetworkConnectionClient
+	client := meta.(*clients.Client).Network().HubVirtualNetworkConnectionClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -167,7 +167,9 @@ func resourceVirtualHubConnectionCreateOrUpdate(d *schema.ResourceData, meta int
 		return err
 	}
 
-	locks.ByName(id.Name, virtualHubResourceName)
+	if err := locks.ByNameWithContext(ctx, id.Name, virtualHubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.Name, virtualHubResourceName)
 
 	name := d.Get("name").(string)
@@ -220,7 +222,7 @@ func resourceVirtualHubConnectionCreateOrUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceVirtualHubConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.HubVirtualNetworkConnectionClient
+	client := meta.(*clients.Client).Network().HubVirtualNetworkConnectionClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -265,7 +267,7 @@ func resourceVirtualHubConnectionRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceVirtualHubConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.HubVirtualNetworkConnectionClient
+	client := meta.(*clients.Client).Network().HubVirtualNetworkConnectionClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -274,7 +276,9 @@ func resourceVirtualHubConnectionDelete(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualHubName, virtualHubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
@@ -240,7 +240,7 @@ func (t VirtualHubConnectionResource) Exists(ctx context.Context, clients *clien
 		return nil, err
 	}
 
-	resp, err := clients.Network.HubVirtualNetworkConnectionClient.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	resp, err := clients.Network().HubVirtualNetworkConnectionClient.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Virtual Hub Network Connection (%s): %+v", id, err)
 	}
@@ -250,7 +250,7 @@ func (t VirtualHubConnectionResource) Exists(ctx context.Context, clients *clien
 
 func checkVirtualHubConnectionDoesNotExist(resourceGroupName, vhubName, vhubConnectionName string) acceptance.ClientCheckFunc {
 	return func(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) error {
-		if resp, err := clients.Network.HubVirtualNetworkConnectionClient.Get(ctx, resourceGroupName, vhubName, vhubConnectionName); err != nil {
+		if resp, err := clients.Network().HubVirtualNetworkConnectionClient.Get(ctx, resourceGroupName, vhubName, vhubConnectionName); err != nil {
 			if utils.ResponseWasNotFound(resp.Response) {
 				return nil
 			}
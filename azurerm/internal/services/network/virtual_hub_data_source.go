@@ -47,7 +47,7 @@ func dataSourceVirtualHub() *schema.Resource {
 }
 
 func dataSourceVirtualHubRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VirtualHubClient
+	client := meta.(*clients.Client).Network().VirtualHubClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -85,7 +85,7 @@ func resourceVirtualHubIP() *schema.Resource {
 }
 
 func resourceVirtualHubIPCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VirtualHubIPClient
+	client := meta.(*clients.Client).Network().VirtualHubIPClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -94,7 +94,9 @@ func resourceVirtualHubIPCreateUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	locks.ByName(id.Name, virtualHubResourceName)
+	if err := locks.ByNameWithContext(ctx, id.Name, virtualHubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.Name, virtualHubResourceName)
 
 	name := d.Get("name").(string)
@@ -159,7 +161,7 @@ func resourceVirtualHubIPCreateUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceVirtualHubIPRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VirtualHubIPClient
+	client := meta.(*clients.Client).Network().VirtualHubIPClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -199,7 +201,7 @@ func resourceVirtualHubIPRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVirtualHubIPDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VirtualHubIPClient
+	client := meta.(*clients.Client).Network().VirtualHubIPClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -208,7 +210,9 @@ func resourceVirtualHubIPDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualHubName, virtualHubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualHubName, id.IpConfigurationName)
@@ -93,7 +93,7 @@ func (t VirtualHubIPResource) Exists(ctx context.Context, clients *clients.Clien
 		return nil, err
 	}
 
-	resp, err := clients.Network.VirtualHubIPClient.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.IpConfigurationName)
+	resp, err := clients.Network().VirtualHubIPClient.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.IpConfigurationName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Virtual Hub IP (%s): %+v", id, err)
 	}
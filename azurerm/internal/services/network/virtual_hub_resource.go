@@ -106,7 +106,7 @@ func resourceVirtualHub() *schema.Resource {
 }
 
 func resourceVirtualHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VirtualHubClient
+	client := meta.(*clients.Client).Network().VirtualHubClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -117,7 +117,9 @@ func resourceVirtualHubCreateUpdate(d *schema.ResourceData, meta interface{}) er
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(name, virtualHubResourceName)
+	if err := locks.ByNameWithContext(ctx, name, virtualHubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, virtualHubResourceName)
 
 	if d.IsNewResource() {
@@ -196,7 +198,7 @@ func resourceVirtualHubCreateUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceVirtualHubRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VirtualHubClient
+	client := meta.(*clients.Client).Network().VirtualHubClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -239,7 +241,7 @@ func resourceVirtualHubRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVirtualHubDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VirtualHubClient
+	client := meta.(*clients.Client).Network().VirtualHubClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -248,7 +250,9 @@ func resourceVirtualHubDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.Name, virtualHubResourceName)
+	if err := locks.ByNameWithContext(ctx, id.Name, virtualHubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.Name, virtualHubResourceName)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
@@ -94,7 +94,7 @@ func (t VirtualHubResource) Exists(ctx context.Context, clients *clients.Client,
 		return nil, err
 	}
 
-	resp, err := clients.Network.VirtualHubClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Network().VirtualHubClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Virtual Hub (%s): %+v", id, err)
 	}
@@ -113,7 +113,7 @@ func resourceVirtualHubRouteTable() *schema.Resource {
 }
 
 func resourceVirtualHubRouteTableCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.HubRouteTableClient
+	client := meta.(*clients.Client).Network().HubRouteTableClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -122,7 +122,9 @@ func resourceVirtualHubRouteTableCreateUpdate(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	locks.ByName(id.Name, virtualHubResourceName)
+	if err := locks.ByNameWithContext(ctx, id.Name, virtualHubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.Name, virtualHubResourceName)
 
 	name := d.Get("name").(string)
@@ -172,7 +174,7 @@ func resourceVirtualHubRouteTableCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceVirtualHubRouteTableRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.HubRouteTableClient
+	client := meta.(*clients.Client).Network().HubRouteTableClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -206,7 +208,7 @@ func resourceVirtualHubRouteTableRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceVirtualHubRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.HubRouteTableClient
+	client := meta.(*clients.Client).Network().HubRouteTableClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -215,7 +217,9 @@ func resourceVirtualHubRouteTableDelete(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	locks.ByName(id.VirtualHubName, virtualHubResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VirtualHubName, virtualHubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
@@ -93,7 +93,7 @@ func (t VirtualHubRouteTableResource) Exists(ctx context.Context, clients *clien
 		return nil, err
 	}
 
-	resp, err := clients.Network.HubRouteTableClient.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	resp, err := clients.Network().HubRouteTableClient.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Virtual Hub Route Table (%s): %+v", id, err)
 	}
@@ -74,7 +74,7 @@ func resourceVirtualHubSecurityPartnerProvider() *schema.Resource {
 }
 
 func resourceVirtualHubSecurityPartnerProviderCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SecurityPartnerProviderClient
+	client := meta.(*clients.Client).Network().SecurityPartnerProviderClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -130,7 +130,7 @@ func resourceVirtualHubSecurityPartnerProviderCreate(d *schema.ResourceData, met
 }
 
 func resourceVirtualHubSecurityPartnerProviderRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SecurityPartnerProviderClient
+	client := meta.(*clients.Client).Network().SecurityPartnerProviderClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -165,7 +165,7 @@ func resourceVirtualHubSecurityPartnerProviderRead(d *schema.ResourceData, meta
 }
 
 func resourceVirtualHubSecurityPartnerProviderUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SecurityPartnerProviderClient
+	client := meta.(*clients.Client).Network().SecurityPartnerProviderClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -176,7 +176,7 @@ func resourceVirtualHubSecurityPartnerProviderUpdate(d *schema.ResourceData, met
 
 	parameters := network.TagsObject{}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -188,7 +188,7 @@ func resourceVirtualHubSecurityPartnerProviderUpdate(d *schema.ResourceData, met
 }
 
 func resourceVirtualHubSecurityPartnerProviderDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SecurityPartnerProviderClient
+	client := meta.(*clients.Client).Network().SecurityPartnerProviderClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
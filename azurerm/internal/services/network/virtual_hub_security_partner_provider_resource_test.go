@@ -86,7 +86,7 @@ func (t VirtualHubSecurityPartnerProviderResource) Exists(ctx context.Context, c
 		return nil, err
 	}
 
-	resp, err := clients.Network.SecurityPartnerProviderClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Network().SecurityPartnerProviderClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Security Partner Provider (%s): %+v", id, err)
 	}
@@ -74,7 +74,7 @@ func dataSourceVirtualNetwork() *schema.Resource {
 }
 
 func dataSourceVnetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetClient
+	client := meta.(*clients.Client).Network().VnetClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
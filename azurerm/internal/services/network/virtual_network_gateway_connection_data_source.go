@@ -189,7 +189,7 @@ func dataSourceVirtualNetworkGatewayConnection() *schema.Resource {
 }
 
 func dataSourceVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetGatewayConnectionsClient
+	client := meta.(*clients.Client).Network().VnetGatewayConnectionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -291,7 +291,7 @@ func resourceVirtualNetworkGatewayConnection() *schema.Resource {
 }
 
 func resourceVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetGatewayConnectionsClient
+	client := meta.(*clients.Client).Network().VnetGatewayConnectionsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -351,7 +351,7 @@ func resourceVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceData,
 }
 
 func resourceVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetGatewayConnectionsClient
+	client := meta.(*clients.Client).Network().VnetGatewayConnectionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -448,7 +448,7 @@ func resourceVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta in
 }
 
 func resourceVirtualNetworkGatewayConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetGatewayConnectionsClient
+	client := meta.(*clients.Client).Network().VnetGatewayConnectionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -186,7 +186,7 @@ func (t VirtualNetworkGatewayConnectionResource) Exists(ctx context.Context, cli
 	gatewayName := state.Attributes["name"]
 	resourceGroup := state.Attributes["resource_group_name"]
 
-	resp, err := clients.Network.VnetGatewayConnectionsClient.Get(ctx, resourceGroup, gatewayName)
+	resp, err := clients.Network().VnetGatewayConnectionsClient.Get(ctx, resourceGroup, gatewayName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Virtual Network Gateway Connection (%s): %+v", state.ID, err)
 	}
@@ -231,7 +231,7 @@ func dataSourceVirtualNetworkGateway() *schema.Resource {
 }
 
 func dataSourceVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetGatewayClient
+	client := meta.(*clients.Client).Network().VnetGatewayClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
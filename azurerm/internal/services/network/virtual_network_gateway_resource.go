@@ -402,7 +402,7 @@ func resourceVirtualNetworkGateway() *schema.Resource {
 }
 
 func resourceVirtualNetworkGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetGatewayClient
+	client := meta.(*clients.Client).Network().VnetGatewayClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	defer cancel()
@@ -461,7 +461,7 @@ func resourceVirtualNetworkGatewayCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetGatewayClient
+	client := meta.(*clients.Client).Network().VnetGatewayClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -529,7 +529,7 @@ func resourceVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceVirtualNetworkGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetGatewayClient
+	client := meta.(*clients.Client).Network().VnetGatewayClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -308,7 +308,7 @@ func (t VirtualNetworkGatewayResource) Exists(ctx context.Context, clients *clie
 	gatewayName := state.Attributes["name"]
 	resourceGroup := state.Attributes["resource_group_name"]
 
-	resp, err := clients.Network.VnetGatewayClient.Get(ctx, resourceGroup, gatewayName)
+	resp, err := clients.Network().VnetGatewayClient.Get(ctx, resourceGroup, gatewayName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Virtual Network Gateway (%s): %+v", state.ID, err)
 	}
@@ -13,10 +13,13 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var virtualNetworkPeeringResourceName = "azurerm_virtual_network_peering"
+
 // peerMutex is used to prevent multiple Peering resources being created, updated
 // or deleted at the same time
 var peerMutex = &sync.Mutex{}
@@ -87,7 +90,7 @@ func resourceVirtualNetworkPeering() *schema.Resource {
 }
 
 func resourceVirtualNetworkPeeringCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetPeeringsClient
+	client := meta.(*clients.Client).Network().VnetPeeringsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -118,6 +121,12 @@ func resourceVirtualNetworkPeeringCreateUpdate(d *schema.ResourceData, meta inte
 	peerMutex.Lock()
 	defer peerMutex.Unlock()
 
+	parent := lockingParent(vnetName, VirtualNetworkResourceName)
+	if err := locks.ChildByNameWithContext(ctx, parent, name, virtualNetworkPeeringResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Virtual Network Peering %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+	}
+	defer locks.UnlockChildByName(parent, name, virtualNetworkPeeringResourceName)
+
 	if err := resource.Retry(300*time.Second, retryVnetPeeringsClientCreateUpdate(d, resGroup, vnetName, name, peer, meta)); err != nil {
 		return err
 	}
@@ -136,7 +145,7 @@ func resourceVirtualNetworkPeeringCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceVirtualNetworkPeeringRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetPeeringsClient
+	client := meta.(*clients.Client).Network().VnetPeeringsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -176,7 +185,7 @@ func resourceVirtualNetworkPeeringRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceVirtualNetworkPeeringDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetPeeringsClient
+	client := meta.(*clients.Client).Network().VnetPeeringsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -191,6 +200,12 @@ func resourceVirtualNetworkPeeringDelete(d *schema.ResourceData, meta interface{
 	peerMutex.Lock()
 	defer peerMutex.Unlock()
 
+	parent := lockingParent(vnetName, VirtualNetworkResourceName)
+	if err := locks.ChildByNameWithContext(ctx, parent, name, virtualNetworkPeeringResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Virtual Network Peering %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+	}
+	defer locks.UnlockChildByName(parent, name, virtualNetworkPeeringResourceName)
+
 	future, err := client.Delete(ctx, resGroup, vnetName, name)
 	if err != nil {
 		return fmt.Errorf("Error deleting Virtual Network Peering %q (Network %q / RG %q): %+v", name, vnetName, resGroup, err)
@@ -223,7 +238,7 @@ func getVirtualNetworkPeeringProperties(d *schema.ResourceData) *network.Virtual
 
 func retryVnetPeeringsClientCreateUpdate(d *schema.ResourceData, resGroup string, vnetName string, name string, peer network.VirtualNetworkPeering, meta interface{}) func() *resource.RetryError {
 	return func() *resource.RetryError {
-		vnetPeeringsClient := meta.(*clients.Client).Network.VnetPeeringsClient
+		vnetPeeringsClient := meta.(*clients.Client).Network().VnetPeeringsClient
 		ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 		defer cancel()
 
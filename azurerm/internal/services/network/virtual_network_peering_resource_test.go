@@ -107,7 +107,7 @@ func (t VirtualNetworkPeeringResource) Exists(ctx context.Context, clients *clie
 	vnetName := id.Path["virtualNetworks"]
 	name := id.Path["virtualNetworkPeerings"]
 
-	resp, err := clients.Network.VnetPeeringsClient.Get(ctx, resGroup, vnetName, name)
+	resp, err := clients.Network().VnetPeeringsClient.Get(ctx, resGroup, vnetName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Virtual Network Peering (%s): %+v", id, err)
 	}
@@ -124,12 +124,12 @@ func (r VirtualNetworkPeeringResource) Destroy(ctx context.Context, client *clie
 	vnetName := id.Path["virtualNetworks"]
 	name := id.Path["virtualNetworkPeerings"]
 
-	future, err := client.Network.VnetPeeringsClient.Delete(ctx, resGroup, vnetName, name)
+	future, err := client.Network().VnetPeeringsClient.Delete(ctx, resGroup, vnetName, name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting on virtual network peering: %+v", err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.VnetPeeringsClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().VnetPeeringsClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for deletion of Peering %q: %+v", id, err)
 	}
 
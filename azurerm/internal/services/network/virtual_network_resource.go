@@ -150,7 +150,7 @@ func resourceVirtualNetwork() *schema.Resource {
 }
 
 func resourceVirtualNetworkCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetClient
+	client := meta.(*clients.Client).Network().VnetClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -199,9 +199,17 @@ func resourceVirtualNetworkCreateUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, &networkSecurityGroupNames, networkSecurityGroupResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
+	// the Subnets within this Virtual Network can't be changed at the same time as the Virtual Network
+	if err := locks.ByNameWithContext(ctx, id.Name, VirtualNetworkResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
+	defer locks.UnlockByName(id.Name, VirtualNetworkResourceName)
+
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, vnet)
 	if err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
@@ -216,7 +224,7 @@ func resourceVirtualNetworkCreateUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceVirtualNetworkRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetClient
+	client := meta.(*clients.Client).Network().VnetClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -277,7 +285,7 @@ func resourceVirtualNetworkRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VnetClient
+	client := meta.(*clients.Client).Network().VnetClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -291,8 +299,15 @@ func resourceVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error parsing Network Security Group ID's: %+v", err)
 	}
 
-	locks.MultipleByName(&nsgNames, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&nsgNames, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, &nsgNames, networkSecurityGroupResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
+	defer locks.UnlockMultipleByName(&nsgNames, networkSecurityGroupResourceName)
+
+	if err := locks.ByNameWithContext(ctx, id.Name, VirtualNetworkResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
+	defer locks.UnlockByName(id.Name, VirtualNetworkResourceName)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -468,7 +483,7 @@ func resourceAzureSubnetHash(v interface{}) int {
 }
 
 func getExistingSubnet(ctx context.Context, resGroup string, vnetName string, subnetName string, meta interface{}) (*network.Subnet, error) {
-	subnetClient := meta.(*clients.Client).Network.SubnetsClient
+	subnetClient := meta.(*clients.Client).Network().SubnetsClient
 	resp, err := subnetClient.Get(ctx, resGroup, vnetName, subnetName, "")
 	if err != nil {
 		if resp.StatusCode == http.StatusNotFound {
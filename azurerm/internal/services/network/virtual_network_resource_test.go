@@ -244,7 +244,7 @@ func (t VirtualNetworkResource) Exists(ctx context.Context, clients *clients.Cli
 		return nil, err
 	}
 
-	resp, err := clients.Network.VnetClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := clients.Network().VnetClient.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading %s: %+v", *id, err)
 	}
@@ -258,12 +258,12 @@ func (r VirtualNetworkResource) Destroy(ctx context.Context, client *clients.Cli
 		return nil, err
 	}
 
-	future, err := client.Network.VnetClient.Delete(ctx, id.ResourceGroup, id.Name)
+	future, err := client.Network().VnetClient.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting on Virtual Network: %+v", err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Network.VnetClient.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, client.Network().VnetClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for deletion of Virtual Network %q: %+v", id, err)
 	}
 
@@ -68,7 +68,7 @@ func dataSourceVirtualWan() *schema.Resource {
 }
 
 func dataSourceVirtualWanRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VirtualWanClient
+	client := meta.(*clients.Client).Network().VirtualWanClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -91,7 +91,7 @@ func resourceVirtualWan() *schema.Resource {
 }
 
 func resourceVirtualWanCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VirtualWanClient
+	client := meta.(*clients.Client).Network().VirtualWanClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -154,7 +154,7 @@ func resourceVirtualWanCreateUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceVirtualWanRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VirtualWanClient
+	client := meta.(*clients.Client).Network().VirtualWanClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -192,7 +192,7 @@ func resourceVirtualWanRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVirtualWanDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VirtualWanClient
+	client := meta.(*clients.Client).Network().VirtualWanClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
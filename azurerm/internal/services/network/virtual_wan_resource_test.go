@@ -71,7 +71,7 @@ func (t VirtualWanResource) Exists(ctx context.Context, clients *clients.Client,
 		return nil, err
 	}
 
-	resp, err := clients.Network.VirtualWanClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Network().VirtualWanClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Virtual WAN (%s): %+v", id, err)
 	}
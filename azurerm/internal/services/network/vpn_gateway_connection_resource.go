@@ -283,7 +283,7 @@ func resourceVPNGatewayConnection() *schema.Resource {
 }
 
 func resourceVpnGatewayConnectionResourceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VpnConnectionsClient
+	client := meta.(*clients.Client).Network().VpnConnectionsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -306,7 +306,9 @@ func resourceVpnGatewayConnectionResourceCreateUpdate(d *schema.ResourceData, me
 		}
 	}
 
-	locks.ByName(gatewayId.Name, VPNGatewayResourceName)
+	if err := locks.ByNameWithContext(ctx, gatewayId.Name, VPNGatewayResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for VPN Gateway Connection %q (VPN Gateway %q / Resource Group %q): %+v", name, gatewayId.Name, gatewayId.ResourceGroup, err)
+	}
 	defer locks.UnlockByName(gatewayId.Name, VPNGatewayResourceName)
 
 	param := network.VpnConnection{
@@ -348,7 +350,7 @@ func resourceVpnGatewayConnectionResourceCreateUpdate(d *schema.ResourceData, me
 }
 
 func resourceVpnGatewayConnectionResourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VpnConnectionsClient
+	client := meta.(*clients.Client).Network().VpnConnectionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -405,7 +407,7 @@ func resourceVpnGatewayConnectionResourceRead(d *schema.ResourceData, meta inter
 }
 
 func resourceVpnGatewayConnectionResourceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VpnConnectionsClient
+	client := meta.(*clients.Client).Network().VpnConnectionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -414,7 +416,9 @@ func resourceVpnGatewayConnectionResourceDelete(d *schema.ResourceData, meta int
 		return err
 	}
 
-	locks.ByName(id.VpnGatewayName, VPNGatewayResourceName)
+	if err := locks.ByNameWithContext(ctx, id.VpnGatewayName, VPNGatewayResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.VpnGatewayName, VPNGatewayResourceName)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VpnGatewayName, id.Name)
@@ -119,7 +119,7 @@ func (t VPNGatewayConnectionResource) Exists(ctx context.Context, clients *clien
 		return nil, err
 	}
 
-	resp, err := clients.Network.VpnConnectionsClient.Get(ctx, id.ResourceGroup, id.VpnGatewayName, id.Name)
+	resp, err := clients.Network().VpnConnectionsClient.Get(ctx, id.ResourceGroup, id.VpnGatewayName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading VPN Gateway Connnection (%s): %+v", id, err)
 	}
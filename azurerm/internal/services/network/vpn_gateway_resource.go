@@ -181,7 +181,7 @@ func resourceVPNGateway() *schema.Resource {
 }
 
 func resourceVPNGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VpnGatewaysClient
+	client := meta.(*clients.Client).Network().VpnGatewaysClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -262,14 +262,16 @@ func resourceVPNGatewayCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPNGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VpnGatewaysClient
+	client := meta.(*clients.Client).Network().VpnGatewaysClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(name, VPNGatewayResourceName)
+	if err := locks.ByNameWithContext(ctx, name, VPNGatewayResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, VPNGatewayResourceName)
 
 	existing, err := client.Get(ctx, resourceGroup, name)
@@ -280,7 +282,7 @@ func resourceVPNGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("scale_unit") {
 		existing.VpnGatewayScaleUnit = utils.Int32(int32(d.Get("scale_unit").(int)))
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		existing.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -313,7 +315,7 @@ func resourceVPNGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPNGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VpnGatewaysClient
+	client := meta.(*clients.Client).Network().VpnGatewaysClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -361,7 +363,7 @@ func resourceVPNGatewayRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPNGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VpnGatewaysClient
+	client := meta.(*clients.Client).Network().VpnGatewaysClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
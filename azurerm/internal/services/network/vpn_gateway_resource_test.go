@@ -122,7 +122,7 @@ func (t VPNGatewayResource) Exists(ctx context.Context, clients *clients.Client,
 		return nil, err
 	}
 
-	resp, err := clients.Network.VpnGatewaysClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Network().VpnGatewaysClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading VPN Gateway (%s): %+v", id, err)
 	}
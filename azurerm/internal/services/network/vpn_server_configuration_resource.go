@@ -387,7 +387,7 @@ func resourceVPNServerConfiguration() *schema.Resource {
 }
 
 func resourceVPNServerConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VpnServerConfigurationsClient
+	client := meta.(*clients.Client).Network().VpnServerConfigurationsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -516,7 +516,7 @@ func resourceVPNServerConfigurationCreateUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceVPNServerConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VpnServerConfigurationsClient
+	client := meta.(*clients.Client).Network().VpnServerConfigurationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -597,7 +597,7 @@ func resourceVPNServerConfigurationRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceVPNServerConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VpnServerConfigurationsClient
+	client := meta.(*clients.Client).Network().VpnServerConfigurationsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
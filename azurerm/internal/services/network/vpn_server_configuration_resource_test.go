@@ -181,7 +181,7 @@ func (t VPNServerConfigurationResource) Exists(ctx context.Context, clients *cli
 		return nil, err
 	}
 
-	resp, err := clients.Network.VpnServerConfigurationsClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Network().VpnServerConfigurationsClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Vpn Server Configuration (%s): %+v", id, err)
 	}
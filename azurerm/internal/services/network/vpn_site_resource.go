@@ -147,7 +147,7 @@ func resourceVpnSite() *schema.Resource {
 }
 
 func resourceVpnSiteCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VpnSitesClient
+	client := meta.(*clients.Client).Network().VpnSitesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -207,7 +207,7 @@ func resourceVpnSiteCreateUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceVpnSiteRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VpnSitesClient
+	client := meta.(*clients.Client).Network().VpnSitesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -254,7 +254,7 @@ func resourceVpnSiteRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVpnSiteDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VpnSitesClient
+	client := meta.(*clients.Client).Network().VpnSitesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -97,7 +97,7 @@ func (t VPNSiteResource) Exists(ctx context.Context, clients *clients.Client, st
 		return nil, err
 	}
 
-	resp, err := clients.Network.VpnSitesClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Network().VpnSitesClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading VPN Site (%s): %+v", id, err)
 	}
@@ -38,7 +38,7 @@ func dataWebApplicationFirewallPolicy() *schema.Resource {
 }
 
 func dataSourceWebApplicationFirewallPolicy(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.WebApplicationFirewallPoliciesClient
+	client := meta.(*clients.Client).Network().WebApplicationFirewallPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
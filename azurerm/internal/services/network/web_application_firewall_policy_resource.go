@@ -288,7 +288,7 @@ func resourceWebApplicationFirewallPolicy() *schema.Resource {
 }
 
 func resourceWebApplicationFirewallPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.WebApplicationFirewallPoliciesClient
+	client := meta.(*clients.Client).Network().WebApplicationFirewallPoliciesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -340,7 +340,7 @@ func resourceWebApplicationFirewallPolicyCreateUpdate(d *schema.ResourceData, me
 }
 
 func resourceWebApplicationFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.WebApplicationFirewallPoliciesClient
+	client := meta.(*clients.Client).Network().WebApplicationFirewallPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -385,7 +385,7 @@ func resourceWebApplicationFirewallPolicyRead(d *schema.ResourceData, meta inter
 }
 
 func resourceWebApplicationFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.WebApplicationFirewallPoliciesClient
+	client := meta.(*clients.Client).Network().WebApplicationFirewallPoliciesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
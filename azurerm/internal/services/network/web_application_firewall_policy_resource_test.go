@@ -182,7 +182,7 @@ func (t WebApplicationFirewallResource) Exists(ctx context.Context, clients *cli
 	resGroup := id.ResourceGroup
 	name := id.Path["ApplicationGatewayWebApplicationFirewallPolicies"]
 
-	resp, err := clients.Network.WebApplicationFirewallPoliciesClient.Get(ctx, resGroup, name)
+	resp, err := clients.Network().WebApplicationFirewallPoliciesClient.Get(ctx, resGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Web Application Firewall (%s): %+v", id, err)
 	}
@@ -89,7 +89,7 @@ func resourceNotificationHubAuthorizationRule() *schema.Resource {
 }
 
 func resourceNotificationHubAuthorizationRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NotificationHubs.HubsClient
+	client := meta.(*clients.Client).NotificationHubs().HubsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -115,10 +115,14 @@ func resourceNotificationHubAuthorizationRuleCreateUpdate(d *schema.ResourceData
 		}
 	}
 
-	locks.ByName(notificationHubName, notificationHubResourceName)
+	if err := locks.ByNameWithContext(ctx, notificationHubName, notificationHubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Authorization Rule %q (Notification Hub %q / Namespace %q / Resource Group %q): %+v", name, notificationHubName, namespaceName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(notificationHubName, notificationHubResourceName)
 
-	locks.ByName(namespaceName, notificationHubNamespaceResourceName)
+	if err := locks.ByNameWithContext(ctx, namespaceName, notificationHubNamespaceResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Authorization Rule %q (Notification Hub %q / Namespace %q / Resource Group %q): %+v", name, notificationHubName, namespaceName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(namespaceName, notificationHubNamespaceResourceName)
 
 	parameters := notificationhubs.SharedAccessAuthorizationRuleCreateOrUpdateParameters{
@@ -145,7 +149,7 @@ func resourceNotificationHubAuthorizationRuleCreateUpdate(d *schema.ResourceData
 }
 
 func resourceNotificationHubAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NotificationHubs.HubsClient
+	client := meta.(*clients.Client).NotificationHubs().HubsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -189,7 +193,7 @@ func resourceNotificationHubAuthorizationRuleRead(d *schema.ResourceData, meta i
 }
 
 func resourceNotificationHubAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NotificationHubs.HubsClient
+	client := meta.(*clients.Client).NotificationHubs().HubsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -198,10 +202,14 @@ func resourceNotificationHubAuthorizationRuleDelete(d *schema.ResourceData, meta
 		return err
 	}
 
-	locks.ByName(id.NotificationHubName, notificationHubResourceName)
+	if err := locks.ByNameWithContext(ctx, id.NotificationHubName, notificationHubResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.NotificationHubName, notificationHubResourceName)
 
-	locks.ByName(id.NamespaceName, notificationHubNamespaceResourceName)
+	if err := locks.ByNameWithContext(ctx, id.NamespaceName, notificationHubNamespaceResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.NamespaceName, notificationHubNamespaceResourceName)
 
 	resp, err := client.DeleteAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.NotificationHubName, id.AuthorizationRuleName)
@@ -164,7 +164,7 @@ func (NotificationHubAuthorizationRuleResource) Exists(ctx context.Context, clie
 		return nil, err
 	}
 
-	resp, err := clients.NotificationHubs.HubsClient.GetAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.NotificationHubName, id.AuthorizationRuleName)
+	resp, err := clients.NotificationHubs().HubsClient.GetAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.NotificationHubName, id.AuthorizationRuleName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %v", id.String(), err)
 	}
@@ -91,7 +91,7 @@ func resourceNotificationHubNamespace() *schema.Resource {
 }
 
 func resourceNotificationHubNamespaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NotificationHubs.NamespacesClient
+	client := meta.(*clients.Client).NotificationHubs().NamespacesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -165,7 +165,7 @@ func resourceNotificationHubNamespaceCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceNotificationHubNamespaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NotificationHubs.NamespacesClient
+	client := meta.(*clients.Client).NotificationHubs().NamespacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -209,7 +209,7 @@ func resourceNotificationHubNamespaceRead(d *schema.ResourceData, meta interface
 }
 
 func resourceNotificationHubNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NotificationHubs.NamespacesClient
+	client := meta.(*clients.Client).NotificationHubs().NamespacesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
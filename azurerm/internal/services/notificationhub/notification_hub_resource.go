@@ -147,7 +147,7 @@ func resourceNotificationHub() *schema.Resource {
 }
 
 func resourceNotificationHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NotificationHubs.HubsClient
+	client := meta.(*clients.Client).NotificationHubs().HubsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -232,7 +232,7 @@ func notificationHubStateRefreshFunc(ctx context.Context, client *notificationhu
 }
 
 func resourceNotificationHubRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NotificationHubs.HubsClient
+	client := meta.(*clients.Client).NotificationHubs().HubsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -280,7 +280,7 @@ func resourceNotificationHubRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceNotificationHubDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NotificationHubs.HubsClient
+	client := meta.(*clients.Client).NotificationHubs().HubsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NotificationHubId struct {
@@ -73,3 +74,12 @@ func NotificationHubID(input string) (*NotificationHubId, error) {
 
 	return &resourceId, nil
 }
+
+// NotificationHubIDNormalized parses a NotificationHub ID into an NotificationHubId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the NotificationHubID method should be used instead for validation etc.
+func NotificationHubIDNormalized(input string) (*NotificationHubId, error) {
+	return NotificationHubID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.NotificationHubs/namespaces/%s/notificationHubs/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NotificationHubAuthorizationRuleId struct {
@@ -79,3 +80,12 @@ func NotificationHubAuthorizationRuleID(input string) (*NotificationHubAuthoriza
 
 	return &resourceId, nil
 }
+
+// NotificationHubAuthorizationRuleIDNormalized parses a NotificationHubAuthorizationRule ID into an NotificationHubAuthorizationRuleId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the NotificationHubAuthorizationRuleID method should be used instead for validation etc.
+func NotificationHubAuthorizationRuleIDNormalized(input string) (*NotificationHubAuthorizationRuleId, error) {
+	return NotificationHubAuthorizationRuleID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.NotificationHubs/namespaces/%s/notificationHubs/%s/AuthorizationRules/%s"))
+}
@@ -2,42 +2,48 @@ package parse
 
 import (
 	"fmt"
-	"regexp"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PolicyAssignmentId struct {
-	Name string
-	PolicyScopeId
+	Name  string
+	Scope resourceid.Scope
 }
 
-// TODO: This paring function is currently suppressing every case difference due to github issue: https://github.com/Azure/azure-rest-api-specs/issues/8353
-func PolicyAssignmentID(input string) (*PolicyAssignmentId, error) {
-	// in general, the id of a assignment should be:
-	// {scope}/providers/Microsoft.Authorization/policyAssignment/{name}
-	regex := regexp.MustCompile(`/providers/[Mm]icrosoft\.[Aa]uthorization/policy[Aa]ssignments/`)
-	if !regex.MatchString(input) {
-		return nil, fmt.Errorf("unable to parse Policy Assignment ID %q", input)
+func NewPolicyAssignmentID(scope resourceid.Scope, name string) PolicyAssignmentId {
+	return PolicyAssignmentId{
+		Name:  name,
+		Scope: scope,
 	}
+}
 
-	segments := regex.Split(input, -1)
-
-	if len(segments) != 2 {
-		return nil, fmt.Errorf("unable to parse Policy Assignment ID %q: Expected 2 segments after split", input)
+func (id PolicyAssignmentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Scope %q", id.Scope.ID()),
 	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Policy Assignment", segmentsStr)
+}
 
-	scope := segments[0]
-	name := segments[1]
-	if name == "" {
-		return nil, fmt.Errorf("unable to parse Policy Assignment ID %q: assignment name is empty", input)
-	}
+func (id PolicyAssignmentId) ID() string {
+	return fmt.Sprintf("%s/providers/Microsoft.Authorization/policyAssignments/%s", id.Scope.ID(), id.Name)
+}
 
-	scopeId, err := PolicyScopeID(scope)
+// TODO: This paring function is currently suppressing every case difference due to github issue: https://github.com/Azure/azure-rest-api-specs/issues/8353
+func PolicyAssignmentID(input string) (*PolicyAssignmentId, error) {
+	// in general, the id of a assignment should be:
+	// {scope}/providers/Microsoft.Authorization/policyAssignment/{name}
+	normalized := resourceid.NormalizeSegmentKeys(input, "%s/providers/Microsoft.Authorization/policyAssignments/%s")
+	scope, values, err := resourceid.ParseScopedID(normalized, "/providers/Microsoft.Authorization/policyAssignments/%s")
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse Policy Assignment ID %q: %+v", input, err)
 	}
 
 	return &PolicyAssignmentId{
-		Name:          name,
-		PolicyScopeId: scopeId,
+		Name:  values[0],
+		Scope: scope,
 	}, nil
 }
@@ -1,10 +1,22 @@
 package parse
 
 import (
-	"reflect"
 	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
+var _ resourceid.Formatter = PolicyAssignmentId{}
+
+func TestPolicyAssignmentIDFormatter(t *testing.T) {
+	scope := resourceid.ManagementGroupScope{Name: "group1"}
+	actual := NewPolicyAssignmentID(scope, "assignment1").ID()
+	expected := "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
 func TestPolicyAssignmentID(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -22,8 +34,7 @@ func TestPolicyAssignmentID(t *testing.T) {
 			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/foo/providers/Microsoft.Authorization/policyAssignments/assignment1",
 			Expected: &PolicyAssignmentId{
 				Name: "assignment1",
-				PolicyScopeId: ScopeAtResourceGroup{
-					scopeId:        "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/foo",
+				Scope: resourceid.ResourceGroupScope{
 					SubscriptionId: "00000000-0000-0000-0000-000000000000",
 					ResourceGroup:  "foo",
 				},
@@ -39,8 +50,7 @@ func TestPolicyAssignmentID(t *testing.T) {
 			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/foo/providers/Microsoft.authorization/policyassignments/assignment1",
 			Expected: &PolicyAssignmentId{
 				Name: "assignment1",
-				PolicyScopeId: ScopeAtResourceGroup{
-					scopeId:        "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/foo",
+				Scope: resourceid.ResourceGroupScope{
 					SubscriptionId: "00000000-0000-0000-0000-000000000000",
 					ResourceGroup:  "foo",
 				},
@@ -51,8 +61,7 @@ func TestPolicyAssignmentID(t *testing.T) {
 			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/assignment1",
 			Expected: &PolicyAssignmentId{
 				Name: "assignment1",
-				PolicyScopeId: ScopeAtSubscription{
-					scopeId:        "/subscriptions/00000000-0000-0000-0000-000000000000",
+				Scope: resourceid.SubscriptionScope{
 					SubscriptionId: "00000000-0000-0000-0000-000000000000",
 				},
 			},
@@ -67,9 +76,8 @@ func TestPolicyAssignmentID(t *testing.T) {
 			Input: "/providers/Microsoft.Management/managementGroups/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/assignment1",
 			Expected: &PolicyAssignmentId{
 				Name: "assignment1",
-				PolicyScopeId: ScopeAtManagementGroup{
-					scopeId:             "/providers/Microsoft.Management/managementGroups/00000000-0000-0000-0000-000000000000",
-					ManagementGroupName: "00000000-0000-0000-0000-000000000000",
+				Scope: resourceid.ManagementGroupScope{
+					Name: "00000000-0000-0000-0000-000000000000",
 				},
 			},
 		},
@@ -83,8 +91,21 @@ func TestPolicyAssignmentID(t *testing.T) {
 			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/foo/providers/Microsoft.Compute/virtualMachines/vm1/providers/Microsoft.Authorization/policyAssignments/assignment1",
 			Expected: &PolicyAssignmentId{
 				Name: "assignment1",
-				PolicyScopeId: ScopeAtResource{
-					scopeId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/foo/providers/Microsoft.Compute/virtualMachines/vm1",
+				Scope: resourceid.ResourceScope{
+					SubscriptionId: "00000000-0000-0000-0000-000000000000",
+					ResourceGroup:  "foo",
+					Resource:       "providers/Microsoft.Compute/virtualMachines/vm1",
+				},
+			},
+		},
+		{
+			Name:  "policy assignment in resource group with a lower-cased scope",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/foo/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Expected: &PolicyAssignmentId{
+				Name: "assignment1",
+				Scope: resourceid.ResourceGroupScope{
+					SubscriptionId: "00000000-0000-0000-0000-000000000000",
+					ResourceGroup:  "foo",
 				},
 			},
 		},
@@ -111,8 +132,8 @@ func TestPolicyAssignmentID(t *testing.T) {
 			t.Fatalf("Expected %q but got %q", v.Expected.Name, actual.Name)
 		}
 
-		if !reflect.DeepEqual(v.Expected.PolicyScopeId, actual.PolicyScopeId) {
-			t.Fatalf("Expected %+v but got %+v", v.Expected.PolicyScopeId, actual.PolicyScopeId)
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %+v but got %+v", v.Expected.Scope, actual.Scope)
 		}
 	}
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -136,13 +137,18 @@ func TestAccAzureRMPolicyAssignment_enforcement_mode(t *testing.T) {
 }
 
 func (r PolicyAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	assignmentsClient := client.Policy.AssignmentsClient
-	resp, err := assignmentsClient.GetByID(ctx, state.ID)
+	id, err := parse.PolicyAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignmentsClient := client.Policy().AssignmentsClient
+	resp, err := assignmentsClient.Get(ctx, id.Scope.ID(), id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
 		}
-		return nil, fmt.Errorf("retrieving Policy Assignment %q: %+v", state.ID, err)
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 	return utils.Bool(resp.AssignmentProperties != nil), nil
 }
@@ -122,7 +122,7 @@ func TestAccAzureRMPolicyDefinition_modeUpdate(t *testing.T) {
 }
 
 func (r PolicyDefinitionResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	definitionsClient := client.Policy.DefinitionsClient
+	definitionsClient := client.Policy().DefinitionsClient
 	id, err := parse.PolicyDefinitionID(state.ID)
 	if err != nil {
 		return nil, err
@@ -94,7 +94,7 @@ func resourceArmPolicyRemediation() *schema.Resource {
 }
 
 func resourceArmPolicyRemediationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Policy.RemediationsClient
+	client := meta.(*clients.Client).Policy().RemediationsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -157,7 +157,7 @@ func resourceArmPolicyRemediationCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceArmPolicyRemediationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Policy.RemediationsClient
+	client := meta.(*clients.Client).Policy().RemediationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -197,7 +197,7 @@ func resourceArmPolicyRemediationRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmPolicyRemediationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Policy.RemediationsClient
+	client := meta.(*clients.Client).Policy().RemediationsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -150,7 +150,7 @@ func (r PolicyRemediationResource) Exists(ctx context.Context, client *clients.C
 		return nil, err
 	}
 
-	resp, err := policy.RemediationGetAtScope(ctx, client.Policy.RemediationsClient, id.Name, id.PolicyScopeId)
+	resp, err := policy.RemediationGetAtScope(ctx, client.Policy().RemediationsClient, id.Name, id.PolicyScopeId)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -143,7 +143,7 @@ func dataSourceArmPolicySetDefinition() *schema.Resource {
 }
 
 func dataSourceArmPolicySetDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Policy.SetDefinitionsClient
+	client := meta.(*clients.Client).Policy().SetDefinitionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -258,7 +258,7 @@ type DefinitionReferenceInOldApiVersion struct {
 }
 
 func resourceArmPolicySetDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Policy.SetDefinitionsClient
+	client := meta.(*clients.Client).Policy().SetDefinitionsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -370,7 +370,7 @@ func resourceArmPolicySetDefinitionCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceArmPolicySetDefinitionUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Policy.SetDefinitionsClient
+	client := meta.(*clients.Client).Policy().SetDefinitionsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -470,7 +470,7 @@ func resourceArmPolicySetDefinitionUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceArmPolicySetDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Policy.SetDefinitionsClient
+	client := meta.(*clients.Client).Policy().SetDefinitionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -548,7 +548,7 @@ func resourceArmPolicySetDefinitionRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceArmPolicySetDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Policy.SetDefinitionsClient
+	client := meta.(*clients.Client).Policy().SetDefinitionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
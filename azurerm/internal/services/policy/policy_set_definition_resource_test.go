@@ -975,9 +975,9 @@ func (r PolicySetDefinitionResource) Exists(ctx context.Context, client *clients
 
 	var resp policy.SetDefinition
 	if mgmtGroupID, ok := id.PolicyScopeId.(parse.ScopeAtManagementGroup); ok {
-		resp, err = client.Policy.SetDefinitionsClient.GetAtManagementGroup(ctx, id.Name, mgmtGroupID.ManagementGroupName)
+		resp, err = client.Policy().SetDefinitionsClient.GetAtManagementGroup(ctx, id.Name, mgmtGroupID.ManagementGroupName)
 	} else {
-		resp, err = client.Policy.SetDefinitionsClient.Get(ctx, id.Name)
+		resp, err = client.Policy().SetDefinitionsClient.Get(ctx, id.Name)
 	}
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
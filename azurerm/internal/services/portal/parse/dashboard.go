@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DashboardId struct {
@@ -67,3 +68,12 @@ func DashboardID(input string) (*DashboardId, error) {
 
 	return &resourceId, nil
 }
+
+// DashboardIDNormalized parses a Dashboard ID into an DashboardId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DashboardID method should be used instead for validation etc.
+func DashboardIDNormalized(input string) (*DashboardId, error) {
+	return DashboardID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Portal/dashboards/%s"))
+}
@@ -58,7 +58,7 @@ func resourceDashboard() *schema.Resource {
 }
 
 func resourceDashboardCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Portal.DashboardsClient
+	client := meta.(*clients.Client).Portal().DashboardsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -92,7 +92,7 @@ func resourceDashboardCreateUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceDashboardRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Portal.DashboardsClient
+	client := meta.(*clients.Client).Portal().DashboardsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -127,7 +127,7 @@ func resourceDashboardRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDashboardDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Portal.DashboardsClient
+	client := meta.(*clients.Client).Portal().DashboardsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -37,7 +37,7 @@ func (PortalDashboardResource) Exists(ctx context.Context, clients *clients.Clie
 		return nil, err
 	}
 
-	resp, err := clients.Portal.DashboardsClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Portal().DashboardsClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %v", id.String(), err)
 	}
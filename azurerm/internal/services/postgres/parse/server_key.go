@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ServerKeyId struct {
@@ -73,3 +74,12 @@ func ServerKeyID(input string) (*ServerKeyId, error) {
 
 	return &resourceId, nil
 }
+
+// ServerKeyIDNormalized parses a ServerKey ID into an ServerKeyId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ServerKeyID method should be used instead for validation etc.
+func ServerKeyIDNormalized(input string) (*ServerKeyId, error) {
+	return ServerKeyID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/servers/%s/keys/%s"))
+}
@@ -66,7 +66,7 @@ func resourcePostgreSQLAdministrator() *schema.Resource {
 }
 
 func resourcePostgreSQLAdministratorCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.ServerAdministratorsClient
+	client := meta.(*clients.Client).Postgres().ServerAdministratorsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -118,7 +118,7 @@ func resourcePostgreSQLAdministratorCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourcePostgreSQLAdministratorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.ServerAdministratorsClient
+	client := meta.(*clients.Client).Postgres().ServerAdministratorsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -151,7 +151,7 @@ func resourcePostgreSQLAdministratorRead(d *schema.ResourceData, meta interface{
 }
 
 func resourcePostgreSQLAdministratorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.ServerAdministratorsClient
+	client := meta.(*clients.Client).Postgres().ServerAdministratorsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -77,7 +77,7 @@ func (r PostgreSqlAdministratorResource) Exists(ctx context.Context, clients *cl
 		return nil, err
 	}
 
-	resp, err := clients.Postgres.ServerAdministratorsClient.Get(ctx, id.ResourceGroup, id.ServerName)
+	resp, err := clients.Postgres().ServerAdministratorsClient.Get(ctx, id.ResourceGroup, id.ServerName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Postgresql AAD Admnistrator (%s): %+v", id.String(), err)
 	}
@@ -91,7 +91,7 @@ func (r PostgreSqlAdministratorResource) Destroy(ctx context.Context, client *cl
 		return nil, err
 	}
 
-	if _, err := client.Postgres.ServerAdministratorsClient.Delete(ctx, id.ResourceGroup, id.ServerName); err != nil {
+	if _, err := client.Postgres().ServerAdministratorsClient.Delete(ctx, id.ResourceGroup, id.ServerName); err != nil {
 		return nil, fmt.Errorf("deleting Postgresql AAD Admnistrator (%s): %+v", id.String(), err)
 	}
 
@@ -60,7 +60,7 @@ func resourcePostgreSQLConfiguration() *schema.Resource {
 }
 
 func resourcePostgreSQLConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.ConfigurationsClient
+	client := meta.(*clients.Client).Postgres().ConfigurationsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -103,7 +103,7 @@ func resourcePostgreSQLConfigurationCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourcePostgreSQLConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.ConfigurationsClient
+	client := meta.(*clients.Client).Postgres().ConfigurationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -135,7 +135,7 @@ func resourcePostgreSQLConfigurationRead(d *schema.ResourceData, meta interface{
 }
 
 func resourcePostgreSQLConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.ConfigurationsClient
+	client := meta.(*clients.Client).Postgres().ConfigurationsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -86,7 +86,7 @@ func (r PostgreSQLConfigurationResource) checkReset(configurationName string) ac
 			return err
 		}
 
-		resp, err := clients.Postgres.ConfigurationsClient.Get(ctx, id.ResourceGroup, id.Name, configurationName)
+		resp, err := clients.Postgres().ConfigurationsClient.Get(ctx, id.ResourceGroup, id.Name, configurationName)
 		if err != nil {
 			if utils.ResponseWasNotFound(resp.Response) {
 				return fmt.Errorf("Bad: PostgreSQL Configuration %q (server %q resource group: %q) does not exist", configurationName, id.Name, id.ResourceGroup)
@@ -112,7 +112,7 @@ func (r PostgreSQLConfigurationResource) checkValue(value string) acceptance.Cli
 			return err
 		}
 
-		resp, err := clients.Postgres.ConfigurationsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+		resp, err := clients.Postgres().ConfigurationsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 		if err != nil {
 			if utils.ResponseWasNotFound(resp.Response) {
 				return fmt.Errorf("Bad: PostgreSQL Configuration %q (server %q resource group: %q) does not exist", id.Name, id.ServerName, id.ResourceGroup)
@@ -135,7 +135,7 @@ func (t PostgreSQLConfigurationResource) Exists(ctx context.Context, clients *cl
 		return nil, err
 	}
 
-	resp, err := clients.Postgres.ConfigurationsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	resp, err := clients.Postgres().ConfigurationsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Postgresql Configuration (%s): %+v", id.String(), err)
 	}
@@ -70,7 +70,7 @@ func resourcePostgreSQLDatabase() *schema.Resource {
 }
 
 func resourcePostgreSQLDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.DatabasesClient
+	client := meta.(*clients.Client).Postgres().DatabasesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -124,7 +124,7 @@ func resourcePostgreSQLDatabaseCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourcePostgreSQLDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.DatabasesClient
+	client := meta.(*clients.Client).Postgres().DatabasesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -157,7 +157,7 @@ func resourcePostgreSQLDatabaseRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourcePostgreSQLDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.DatabasesClient
+	client := meta.(*clients.Client).Postgres().DatabasesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
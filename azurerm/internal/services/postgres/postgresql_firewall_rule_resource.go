@@ -75,7 +75,7 @@ func resourcePostgreSQLFirewallRule() *schema.Resource {
 }
 
 func resourcePostgreSQLFirewallRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.FirewallRulesClient
+	client := meta.(*clients.Client).Postgres().FirewallRulesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -128,7 +128,7 @@ func resourcePostgreSQLFirewallRuleCreate(d *schema.ResourceData, meta interface
 }
 
 func resourcePostgreSQLFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.FirewallRulesClient
+	client := meta.(*clients.Client).Postgres().FirewallRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -161,7 +161,7 @@ func resourcePostgreSQLFirewallRuleRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourcePostgreSQLFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.FirewallRulesClient
+	client := meta.(*clients.Client).Postgres().FirewallRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
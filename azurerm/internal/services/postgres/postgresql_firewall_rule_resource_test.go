@@ -55,7 +55,7 @@ func (t PostgreSQLFirewallRuleResource) Exists(ctx context.Context, clients *cli
 		return nil, err
 	}
 
-	resp, err := clients.Postgres.FirewallRulesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	resp, err := clients.Postgres().FirewallRulesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Postgresql Firewall Rule (%s): %+v", id.String(), err)
 	}
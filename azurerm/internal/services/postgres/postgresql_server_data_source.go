@@ -82,7 +82,7 @@ func dataSourcePostgreSqlServer() *schema.Resource {
 }
 
 func dataSourcePostgreSqlServerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.ServersClient
+	client := meta.(*clients.Client).Postgres().ServersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -79,9 +79,9 @@ func getPostgreSQLServerKeyName(ctx context.Context, keyVaultsClient *client.Cli
 }
 
 func resourcePostgreSQLServerKeyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	keysClient := meta.(*clients.Client).Postgres.ServerKeysClient
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	resourcesClient := meta.(*clients.Client).Resource
+	keysClient := meta.(*clients.Client).Postgres().ServerKeysClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -95,7 +95,9 @@ func resourcePostgreSQLServerKeyCreateUpdate(d *schema.ResourceData, meta interf
 		return fmt.Errorf("cannot compose name for PostgreSQL Server Key (Resource Group %q / Server %q): %+v", serverID.ResourceGroup, serverID.Name, err)
 	}
 
-	locks.ByName(serverID.Name, postgreSQLServerResourceName)
+	if err := locks.ByNameWithContext(ctx, serverID.Name, postgreSQLServerResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Key (PostgreSQL Server %q / Resource Group %q): %+v", serverID.Name, serverID.ResourceGroup, err)
+	}
 	defer locks.UnlockByName(serverID.Name, postgreSQLServerResourceName)
 
 	if d.IsNewResource() {
@@ -143,8 +145,8 @@ func resourcePostgreSQLServerKeyCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourcePostgreSQLServerKeyRead(d *schema.ResourceData, meta interface{}) error {
-	serversClient := meta.(*clients.Client).Postgres.ServersClient
-	keysClient := meta.(*clients.Client).Postgres.ServerKeysClient
+	serversClient := meta.(*clients.Client).Postgres().ServersClient
+	keysClient := meta.(*clients.Client).Postgres().ServerKeysClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -178,7 +180,7 @@ func resourcePostgreSQLServerKeyRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourcePostgreSQLServerKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.ServerKeysClient
+	client := meta.(*clients.Client).Postgres().ServerKeysClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -187,7 +189,9 @@ func resourcePostgreSQLServerKeyDelete(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	locks.ByName(id.ServerName, postgreSQLServerResourceName)
+	if err := locks.ByNameWithContext(ctx, id.ServerName, postgreSQLServerResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.ServerName, postgreSQLServerResourceName)
 
 	future, err := client.Delete(ctx, id.ServerName, id.KeyName, id.ResourceGroup)
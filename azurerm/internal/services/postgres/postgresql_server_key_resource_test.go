@@ -75,7 +75,7 @@ func (t PostgreSQLServerKeyResource) Exists(ctx context.Context, clients *client
 		return nil, err
 	}
 
-	resp, err := clients.Postgres.ServerKeysClient.Get(ctx, id.ResourceGroup, id.ServerName, id.KeyName)
+	resp, err := clients.Postgres().ServerKeysClient.Get(ctx, id.ResourceGroup, id.ServerName, id.KeyName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Postgresql Server Key (%s): %+v", id.String(), err)
 	}
@@ -400,8 +400,8 @@ func resourcePostgreSQLServer() *schema.Resource {
 }
 
 func resourcePostgreSQLServerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.ServersClient
-	securityClient := meta.(*clients.Client).Postgres.ServerSecurityAlertPoliciesClient
+	client := meta.(*clients.Client).Postgres().ServersClient
+	securityClient := meta.(*clients.Client).Postgres().ServerSecurityAlertPoliciesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -567,8 +567,8 @@ func resourcePostgreSQLServerCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourcePostgreSQLServerUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.ServersClient
-	securityClient := meta.(*clients.Client).Postgres.ServerSecurityAlertPoliciesClient
+	client := meta.(*clients.Client).Postgres().ServersClient
+	securityClient := meta.(*clients.Client).Postgres().ServerSecurityAlertPoliciesClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -639,8 +639,8 @@ func resourcePostgreSQLServerUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourcePostgreSQLServerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.ServersClient
-	securityClient := meta.(*clients.Client).Postgres.ServerSecurityAlertPoliciesClient
+	client := meta.(*clients.Client).Postgres().ServersClient
+	securityClient := meta.(*clients.Client).Postgres().ServerSecurityAlertPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -721,7 +721,7 @@ func resourcePostgreSQLServerRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourcePostgreSQLServerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.ServersClient
+	client := meta.(*clients.Client).Postgres().ServersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -384,7 +384,7 @@ func (t PostgreSQLServerResource) Exists(ctx context.Context, clients *clients.C
 		return nil, err
 	}
 
-	resp, err := clients.Postgres.ServersClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Postgres().ServersClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Postgresql Server (%s): %+v", id.String(), err)
 	}
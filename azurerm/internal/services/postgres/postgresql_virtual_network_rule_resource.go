@@ -71,7 +71,7 @@ func resourcePostgreSQLVirtualNetworkRule() *schema.Resource {
 }
 
 func resourcePostgreSQLVirtualNetworkRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.VirtualNetworkRulesClient
+	client := meta.(*clients.Client).Postgres().VirtualNetworkRulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -141,7 +141,7 @@ func resourcePostgreSQLVirtualNetworkRuleCreateUpdate(d *schema.ResourceData, me
 }
 
 func resourcePostgreSQLVirtualNetworkRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.VirtualNetworkRulesClient
+	client := meta.(*clients.Client).Postgres().VirtualNetworkRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -174,7 +174,7 @@ func resourcePostgreSQLVirtualNetworkRuleRead(d *schema.ResourceData, meta inter
 }
 
 func resourcePostgreSQLVirtualNetworkRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Postgres.VirtualNetworkRulesClient
+	client := meta.(*clients.Client).Postgres().VirtualNetworkRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
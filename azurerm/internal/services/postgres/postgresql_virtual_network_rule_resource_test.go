@@ -116,7 +116,7 @@ func (r PostgreSQLVirtualNetworkRuleResource) Exists(ctx context.Context, client
 		return nil, err
 	}
 
-	resp, err := clients.Postgres.VirtualNetworkRulesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	resp, err := clients.Postgres().VirtualNetworkRulesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Postgresql Virtual Network Rule (%s): %+v", id.String(), err)
 	}
@@ -130,12 +130,12 @@ func (r PostgreSQLVirtualNetworkRuleResource) Destroy(ctx context.Context, clien
 		return nil, err
 	}
 
-	future, err := client.Postgres.VirtualNetworkRulesClient.Delete(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	future, err := client.Postgres().VirtualNetworkRulesClient.Delete(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting Postgresql Virtual Network Rule (%s): %+v", id.String(), err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Postgres.VirtualNetworkRulesClient.Client); err != nil {
+	if err := future.WaitForCompletionRef(ctx, client.Postgres().VirtualNetworkRulesClient.Client); err != nil {
 		return nil, fmt.Errorf("waiting for deletion of Postgresql Virtual Network Rule (%s): %+v", id.String(), err)
 	}
 
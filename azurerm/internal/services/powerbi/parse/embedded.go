@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EmbeddedId struct {
@@ -67,3 +68,12 @@ func EmbeddedID(input string) (*EmbeddedId, error) {
 
 	return &resourceId, nil
 }
+
+// EmbeddedIDNormalized parses a Embedded ID into an EmbeddedId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the EmbeddedID method should be used instead for validation etc.
+func EmbeddedIDNormalized(input string) (*EmbeddedId, error) {
+	return EmbeddedID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.PowerBIDedicated/capacities/%s"))
+}
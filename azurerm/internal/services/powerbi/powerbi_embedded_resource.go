@@ -79,7 +79,7 @@ func resourcePowerBIEmbedded() *schema.Resource {
 }
 
 func resourcePowerBIEmbeddedCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).PowerBI.CapacityClient
+	client := meta.(*clients.Client).PowerBI().CapacityClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -135,7 +135,7 @@ func resourcePowerBIEmbeddedCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourcePowerBIEmbeddedRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).PowerBI.CapacityClient
+	client := meta.(*clients.Client).PowerBI().CapacityClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -175,7 +175,7 @@ func resourcePowerBIEmbeddedRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourcePowerBIEmbeddedUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).PowerBI.CapacityClient
+	client := meta.(*clients.Client).PowerBI().CapacityClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -209,7 +209,7 @@ func resourcePowerBIEmbeddedUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourcePowerBIEmbeddedDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).PowerBI.CapacityClient
+	client := meta.(*clients.Client).PowerBI().CapacityClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
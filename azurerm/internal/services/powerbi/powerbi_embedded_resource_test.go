@@ -105,7 +105,7 @@ func (PowerBIEmbeddedResource) Exists(ctx context.Context, clients *clients.Clie
 		return nil, err
 	}
 
-	resp, err := clients.PowerBI.CapacityClient.GetDetails(ctx, id.ResourceGroup, id.CapacityName)
+	resp, err := clients.PowerBI().CapacityClient.GetDetails(ctx, id.ResourceGroup, id.CapacityName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %v", id.String(), err)
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PrivateDnsZoneId struct {
@@ -67,3 +68,12 @@ func PrivateDnsZoneID(input string) (*PrivateDnsZoneId, error) {
 
 	return &resourceId, nil
 }
+
+// PrivateDnsZoneIDNormalized parses a PrivateDnsZone ID into an PrivateDnsZoneId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the PrivateDnsZoneID method should be used instead for validation etc.
+func PrivateDnsZoneIDNormalized(input string) (*PrivateDnsZoneId, error) {
+	return PrivateDnsZoneID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateDnsZones/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualNetworkLinkId struct {
@@ -73,3 +74,12 @@ func VirtualNetworkLinkID(input string) (*VirtualNetworkLinkId, error) {
 
 	return &resourceId, nil
 }
+
+// VirtualNetworkLinkIDNormalized parses a VirtualNetworkLink ID into an VirtualNetworkLinkId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VirtualNetworkLinkID method should be used instead for validation etc.
+func VirtualNetworkLinkIDNormalized(input string) (*VirtualNetworkLinkId, error) {
+	return VirtualNetworkLinkID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateDnsZones/%s/virtualNetworkLinks/%s"))
+}
@@ -75,7 +75,7 @@ func resourcePrivateDnsARecord() *schema.Resource {
 }
 
 func resourcePrivateDnsARecordCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	client := meta.(*clients.Client).PrivateDns().RecordSetsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -117,7 +117,7 @@ func resourcePrivateDnsARecordCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourcePrivateDnsARecordRead(d *schema.ResourceData, meta interface{}) error {
-	dnsClient := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	dnsClient := meta.(*clients.Client).PrivateDns().RecordSetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -150,7 +150,7 @@ func resourcePrivateDnsARecordRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourcePrivateDnsARecordDelete(d *schema.ResourceData, meta interface{}) error {
-	dnsClient := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	dnsClient := meta.(*clients.Client).PrivateDns().RecordSetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -97,7 +97,7 @@ func (t PrivateDnsARecordResource) Exists(ctx context.Context, clients *clients.
 		return nil, err
 	}
 
-	resp, err := clients.PrivateDns.RecordSetsClient.Get(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.A, id.AName)
+	resp, err := clients.PrivateDns().RecordSetsClient.Get(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.A, id.AName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Private DNS AAAA Record (%s): %+v", id.String(), err)
 	}
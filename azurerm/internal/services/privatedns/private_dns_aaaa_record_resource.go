@@ -72,7 +72,7 @@ func resourcePrivateDnsAaaaRecord() *schema.Resource {
 }
 
 func resourcePrivateDnsAaaaRecordCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	client := meta.(*clients.Client).PrivateDns().RecordSetsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -114,7 +114,7 @@ func resourcePrivateDnsAaaaRecordCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourcePrivateDnsAaaaRecordRead(d *schema.ResourceData, meta interface{}) error {
-	dnsClient := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	dnsClient := meta.(*clients.Client).PrivateDns().RecordSetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -146,7 +146,7 @@ func resourcePrivateDnsAaaaRecordRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourcePrivateDnsAaaaRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	dnsClient := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	dnsClient := meta.(*clients.Client).PrivateDns().RecordSetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -97,7 +97,7 @@ func (t PrivateDnsAAAARecordResource) Exists(ctx context.Context, clients *clien
 		return nil, err
 	}
 
-	resp, err := clients.PrivateDns.RecordSetsClient.Get(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.AAAA, id.AAAAName)
+	resp, err := clients.PrivateDns().RecordSetsClient.Get(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.AAAA, id.AAAAName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Private DNS AAAA Record (%s): %+v", id.String(), err)
 	}
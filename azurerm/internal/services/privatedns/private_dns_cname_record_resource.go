@@ -78,7 +78,7 @@ func resourcePrivateDnsCNameRecord() *schema.Resource {
 }
 
 func resourcePrivateDnsCNameRecordCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	client := meta.(*clients.Client).PrivateDns().RecordSetsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -123,7 +123,7 @@ func resourcePrivateDnsCNameRecordCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourcePrivateDnsCNameRecordRead(d *schema.ResourceData, meta interface{}) error {
-	dnsClient := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	dnsClient := meta.(*clients.Client).PrivateDns().RecordSetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -159,7 +159,7 @@ func resourcePrivateDnsCNameRecordRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourcePrivateDnsCNameRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	dnsClient := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	dnsClient := meta.(*clients.Client).PrivateDns().RecordSetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -109,7 +109,7 @@ func (t PrivateDnsCNameRecordResource) Exists(ctx context.Context, clients *clie
 		return nil, err
 	}
 
-	resp, err := clients.PrivateDns.RecordSetsClient.Get(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.CNAME, id.CNAMEName)
+	resp, err := clients.PrivateDns().RecordSetsClient.Get(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.CNAME, id.CNAMEName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Private DNS CName Record (%s): %+v", id.String(), err)
 	}
@@ -94,7 +94,7 @@ func resourcePrivateDnsMxRecord() *schema.Resource {
 }
 
 func resourcePrivateDnsMxRecordCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	client := meta.(*clients.Client).PrivateDns().RecordSetsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -134,7 +134,7 @@ func resourcePrivateDnsMxRecordCreateUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourcePrivateDnsMxRecordRead(d *schema.ResourceData, meta interface{}) error {
-	dnsClient := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	dnsClient := meta.(*clients.Client).PrivateDns().RecordSetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -168,7 +168,7 @@ func resourcePrivateDnsMxRecordRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourcePrivateDnsMxRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	dnsClient := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	dnsClient := meta.(*clients.Client).PrivateDns().RecordSetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -110,7 +110,7 @@ func (t PrivateDnsMxRecordResource) Exists(ctx context.Context, clients *clients
 		return nil, err
 	}
 
-	resp, err := clients.PrivateDns.RecordSetsClient.Get(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.MX, id.MXName)
+	resp, err := clients.PrivateDns().RecordSetsClient.Get(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.MX, id.MXName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Private DNS MX Record (%s): %+v", id.String(), err)
 	}
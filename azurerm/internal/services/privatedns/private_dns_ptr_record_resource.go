@@ -79,7 +79,7 @@ func resourcePrivateDnsPtrRecord() *schema.Resource {
 }
 
 func resourcePrivateDnsPtrRecordCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	client := meta.(*clients.Client).PrivateDns().RecordSetsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -121,7 +121,7 @@ func resourcePrivateDnsPtrRecordCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourcePrivateDnsPtrRecordRead(d *schema.ResourceData, meta interface{}) error {
-	dnsClient := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	dnsClient := meta.(*clients.Client).PrivateDns().RecordSetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -156,7 +156,7 @@ func resourcePrivateDnsPtrRecordRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourcePrivateDnsPtrRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	dnsClient := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	dnsClient := meta.(*clients.Client).PrivateDns().RecordSetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -96,7 +96,7 @@ func (t PrivateDnsPtrRecordResource) Exists(ctx context.Context, clients *client
 		return nil, err
 	}
 
-	resp, err := clients.PrivateDns.RecordSetsClient.Get(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.PTR, id.PTRName)
+	resp, err := clients.PrivateDns().RecordSetsClient.Get(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.PTR, id.PTRName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Private DNS PTR Record (%s): %+v", id.String(), err)
 	}
@@ -96,7 +96,7 @@ func (t PrivateDnsSrvRecordResource) Exists(ctx context.Context, clients *client
 		return nil, err
 	}
 
-	resp, err := clients.PrivateDns.RecordSetsClient.Get(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.SRV, id.SRVName)
+	resp, err := clients.PrivateDns().RecordSetsClient.Get(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.SRV, id.SRVName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Private DNS SRV Record (%s): %+v", id.String(), err)
 	}
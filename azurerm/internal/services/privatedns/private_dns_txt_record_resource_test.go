@@ -107,7 +107,7 @@ func (t PrivateDnsTxtRecordResource) Exists(ctx context.Context, clients *client
 		return nil, err
 	}
 
-	resp, err := clients.PrivateDns.RecordSetsClient.Get(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.TXT, id.TXTName)
+	resp, err := clients.PrivateDns().RecordSetsClient.Get(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.TXT, id.TXTName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Private DNS TXT Record (%s): %+v", id.String(), err)
 	}
@@ -61,7 +61,7 @@ func dataSourcePrivateDnsZone() *schema.Resource {
 }
 
 func dataSourcePrivateDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).PrivateDns.PrivateZonesClient
+	client := meta.(*clients.Client).PrivateDns().PrivateZonesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -80,7 +80,7 @@ func dataSourcePrivateDnsZoneRead(d *schema.ResourceData, meta interface{}) erro
 		}
 		resp = &zone
 	} else {
-		resourcesClient := meta.(*clients.Client).Resource.ResourcesClient
+		resourcesClient := meta.(*clients.Client).Resource().ResourcesClient
 
 		zone, err := findPrivateZone(ctx, client, resourcesClient, name)
 		if err != nil {
@@ -148,8 +148,8 @@ func resourcePrivateDnsZone() *schema.Resource {
 }
 
 func resourcePrivateDnsZoneCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).PrivateDns.PrivateZonesClient
-	recordSetsClient := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	client := meta.(*clients.Client).PrivateDns().PrivateZonesClient
+	recordSetsClient := meta.(*clients.Client).PrivateDns().RecordSetsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -213,8 +213,8 @@ func resourcePrivateDnsZoneCreateUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourcePrivateDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).PrivateDns.PrivateZonesClient
-	recordSetsClient := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	client := meta.(*clients.Client).PrivateDns().PrivateZonesClient
+	recordSetsClient := meta.(*clients.Client).PrivateDns().RecordSetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -255,7 +255,7 @@ func resourcePrivateDnsZoneRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourcePrivateDnsZoneDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).PrivateDns.PrivateZonesClient
+	client := meta.(*clients.Client).PrivateDns().PrivateZonesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
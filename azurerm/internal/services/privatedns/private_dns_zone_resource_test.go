@@ -102,7 +102,7 @@ func (t PrivateDnsZoneResource) Exists(ctx context.Context, clients *clients.Cli
 		return nil, err
 	}
 
-	resp, err := clients.PrivateDns.PrivateZonesClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.PrivateDns().PrivateZonesClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Private DNS Zone (%s): %+v", id.String(), err)
 	}
@@ -79,7 +79,7 @@ func resourcePrivateDnsZoneVirtualNetworkLink() *schema.Resource {
 }
 
 func resourcePrivateDnsZoneVirtualNetworkLinkCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).PrivateDns.VirtualNetworkLinksClient
+	client := meta.(*clients.Client).PrivateDns().VirtualNetworkLinksClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -132,7 +132,7 @@ func resourcePrivateDnsZoneVirtualNetworkLinkCreateUpdate(d *schema.ResourceData
 }
 
 func resourcePrivateDnsZoneVirtualNetworkLinkRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).PrivateDns.VirtualNetworkLinksClient
+	client := meta.(*clients.Client).PrivateDns().VirtualNetworkLinksClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -166,7 +166,7 @@ func resourcePrivateDnsZoneVirtualNetworkLinkRead(d *schema.ResourceData, meta i
 }
 
 func resourcePrivateDnsZoneVirtualNetworkLinkDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).PrivateDns.VirtualNetworkLinksClient
+	client := meta.(*clients.Client).PrivateDns().VirtualNetworkLinksClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
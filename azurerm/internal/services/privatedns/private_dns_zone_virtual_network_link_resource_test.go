@@ -74,7 +74,7 @@ func (t PrivateDnsZoneVirtualNetworkLinkResource) Exists(ctx context.Context, cl
 		return nil, err
 	}
 
-	resp, err := clients.PrivateDns.VirtualNetworkLinksClient.Get(ctx, id.ResourceGroup, id.PrivateDnsZoneName, id.Name)
+	resp, err := clients.PrivateDns().VirtualNetworkLinksClient.Get(ctx, id.ResourceGroup, id.PrivateDnsZoneName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Private DNS Zone Virtual Network Link (%s): %+v", id.String(), err)
 	}
@@ -53,8 +53,8 @@ func resourceBackupProtectionContainerStorageAccount() *schema.Resource {
 }
 
 func resourceBackupProtectionContainerStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
-	opStatusClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	client := meta.(*clients.Client).RecoveryServices().BackupProtectionContainersClient
+	opStatusClient := meta.(*clients.Client).RecoveryServices().BackupOperationStatusesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -138,7 +138,7 @@ func resourceBackupProtectionContainerStorageAccountRead(d *schema.ResourceData,
 	fabricName := id.Path["backupFabrics"]
 	containerName := id.Path["protectionContainers"]
 
-	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	client := meta.(*clients.Client).RecoveryServices().BackupProtectionContainersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -172,8 +172,8 @@ func resourceBackupProtectionContainerStorageAccountDelete(d *schema.ResourceDat
 	fabricName := id.Path["backupFabrics"]
 	containerName := id.Path["protectionContainers"]
 
-	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
-	opClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	client := meta.(*clients.Client).RecoveryServices().BackupProtectionContainersClient
+	opClient := meta.(*clients.Client).RecoveryServices().BackupOperationStatusesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
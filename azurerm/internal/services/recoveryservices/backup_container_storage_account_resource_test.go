@@ -43,7 +43,7 @@ func (t BackupProtectionContainerStorageAccountResource) Exists(ctx context.Cont
 	fabricName := id.Path["backupFabrics"]
 	containerName := id.Path["protectionContainers"]
 
-	resp, err := clients.RecoveryServices.BackupProtectionContainersClient.Get(ctx, vaultName, resGroup, fabricName, containerName)
+	resp, err := clients.RecoveryServices().BackupProtectionContainersClient.Get(ctx, vaultName, resGroup, fabricName, containerName)
 	if err != nil {
 		return nil, fmt.Errorf("reading site recovery protection container (%s): %+v", id, err)
 	}
@@ -113,7 +113,7 @@ func resourceBackupProtectionPolicyFileShare() *schema.Resource {
 }
 
 func resourceBackupProtectionPolicyFileShareCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RecoveryServices.ProtectionPoliciesClient
+	client := meta.(*clients.Client).RecoveryServices().ProtectionPoliciesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -172,7 +172,7 @@ func resourceBackupProtectionPolicyFileShareCreateUpdate(d *schema.ResourceData,
 }
 
 func resourceBackupProtectionPolicyFileShareRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RecoveryServices.ProtectionPoliciesClient
+	client := meta.(*clients.Client).RecoveryServices().ProtectionPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -225,7 +225,7 @@ func resourceBackupProtectionPolicyFileShareRead(d *schema.ResourceData, meta in
 }
 
 func resourceBackupProtectionPolicyFileShareDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RecoveryServices.ProtectionPoliciesClient
+	client := meta.(*clients.Client).RecoveryServices().ProtectionPoliciesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -42,7 +42,7 @@ func dataSourceBackupPolicyVm() *schema.Resource {
 }
 
 func dataSourceBackupPolicyVmRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RecoveryServices.ProtectionPoliciesClient
+	client := meta.(*clients.Client).RecoveryServices().ProtectionPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
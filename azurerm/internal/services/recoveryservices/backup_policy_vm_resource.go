@@ -289,7 +289,7 @@ func resourceBackupProtectionPolicyVM() *schema.Resource {
 }
 
 func resourceBackupProtectionPolicyVMCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RecoveryServices.ProtectionPoliciesClient
+	client := meta.(*clients.Client).RecoveryServices().ProtectionPoliciesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -364,7 +364,7 @@ func resourceBackupProtectionPolicyVMCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceBackupProtectionPolicyVMRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RecoveryServices.ProtectionPoliciesClient
+	client := meta.(*clients.Client).RecoveryServices().ProtectionPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -442,7 +442,7 @@ func resourceBackupProtectionPolicyVMRead(d *schema.ResourceData, meta interface
 }
 
 func resourceBackupProtectionPolicyVMDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RecoveryServices.ProtectionPoliciesClient
+	client := meta.(*clients.Client).RecoveryServices().ProtectionPoliciesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -278,7 +278,7 @@ func (t BackupProtectionPolicyVMResource) Exists(ctx context.Context, clients *c
 	vaultName := id.Path["vaults"]
 	resourceGroup := id.ResourceGroup
 
-	resp, err := clients.RecoveryServices.ProtectionPoliciesClient.Get(ctx, vaultName, resourceGroup, policyName)
+	resp, err := clients.RecoveryServices().ProtectionPoliciesClient.Get(ctx, vaultName, resourceGroup, policyName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Recovery Service Protection Policy (%s): %+v", id, err)
 	}
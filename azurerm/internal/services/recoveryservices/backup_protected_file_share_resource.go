@@ -71,10 +71,10 @@ func resourceBackupProtectedFileShare() *schema.Resource {
 }
 
 func resourceBackupProtectedFileShareCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	protectedClient := meta.(*clients.Client).RecoveryServices.ProtectedItemsGroupClient
-	protectableClient := meta.(*clients.Client).RecoveryServices.ProtectableItemsClient
-	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
-	opClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	protectedClient := meta.(*clients.Client).RecoveryServices().ProtectedItemsGroupClient
+	protectableClient := meta.(*clients.Client).RecoveryServices().ProtectableItemsClient
+	client := meta.(*clients.Client).RecoveryServices().ProtectedItemsClient
+	opClient := meta.(*clients.Client).RecoveryServices().BackupOperationStatusesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -204,7 +204,7 @@ func resourceBackupProtectedFileShareCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceBackupProtectedFileShareRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	client := meta.(*clients.Client).RecoveryServices().ProtectedItemsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -249,8 +249,8 @@ func resourceBackupProtectedFileShareRead(d *schema.ResourceData, meta interface
 }
 
 func resourceBackupProtectedFileShareDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
-	opClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	client := meta.(*clients.Client).RecoveryServices().ProtectedItemsClient
+	opClient := meta.(*clients.Client).RecoveryServices().BackupOperationStatusesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
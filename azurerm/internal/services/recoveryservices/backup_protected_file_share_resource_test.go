@@ -119,7 +119,7 @@ func (t BackupProtectedFileShareResource) Exists(ctx context.Context, clients *c
 	resourceGroup := id.ResourceGroup
 	containerName := id.Path["protectionContainers"]
 
-	resp, err := clients.RecoveryServices.ProtectedItemsClient.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+	resp, err := clients.RecoveryServices().ProtectedItemsClient.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Recovery Service Protected File Share (%s): %+v", id, err)
 	}
@@ -66,7 +66,7 @@ func resourceRecoveryServicesBackupProtectedVM() *schema.Resource {
 }
 
 func resourceRecoveryServicesBackupProtectedVMCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	client := meta.(*clients.Client).RecoveryServices().ProtectedItemsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -133,7 +133,7 @@ func resourceRecoveryServicesBackupProtectedVMCreateUpdate(d *schema.ResourceDat
 }
 
 func resourceRecoveryServicesBackupProtectedVMRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	client := meta.(*clients.Client).RecoveryServices().ProtectedItemsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -176,7 +176,7 @@ func resourceRecoveryServicesBackupProtectedVMRead(d *schema.ResourceData, meta
 }
 
 func resourceRecoveryServicesBackupProtectedVMDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	client := meta.(*clients.Client).RecoveryServices().ProtectedItemsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
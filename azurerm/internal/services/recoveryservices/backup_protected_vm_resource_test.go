@@ -131,7 +131,7 @@ func (t BackupProtectedVmResource) Exists(ctx context.Context, clients *clients.
 	resourceGroup := id.ResourceGroup
 	containerName := id.Path["protectionContainers"]
 
-	resp, err := clients.RecoveryServices.ProtectedItemsClient.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+	resp, err := clients.RecoveryServices().ProtectedItemsClient.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Recovery Service Protected VM (%s): %+v", id, err)
 	}
@@ -42,7 +42,7 @@ func dataSourceRecoveryServicesVault() *schema.Resource {
 }
 
 func dataSourceRecoveryServicesVaultRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RecoveryServices.VaultsClient
+	client := meta.(*clients.Client).RecoveryServices().VaultsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -99,8 +99,8 @@ func resourceRecoveryServicesVault() *schema.Resource {
 }
 
 func resourceRecoveryServicesVaultCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RecoveryServices.VaultsClient
-	cfgsClient := meta.(*clients.Client).RecoveryServices.VaultsConfigsClient
+	client := meta.(*clients.Client).RecoveryServices().VaultsClient
+	cfgsClient := meta.(*clients.Client).RecoveryServices().VaultsConfigsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -192,8 +192,8 @@ func resourceRecoveryServicesVaultCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceRecoveryServicesVaultRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RecoveryServices.VaultsClient
-	cfgsClient := meta.(*clients.Client).RecoveryServices.VaultsConfigsClient
+	client := meta.(*clients.Client).RecoveryServices().VaultsClient
+	cfgsClient := meta.(*clients.Client).RecoveryServices().VaultsConfigsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -244,7 +244,7 @@ func resourceRecoveryServicesVaultRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceRecoveryServicesVaultDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RecoveryServices.VaultsClient
+	client := meta.(*clients.Client).RecoveryServices().VaultsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
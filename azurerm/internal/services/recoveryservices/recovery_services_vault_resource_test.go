@@ -120,7 +120,7 @@ func (t RecoveryServicesVaultResource) Exists(ctx context.Context, clients *clie
 	name := id.Path["vaults"]
 	resourceGroup := id.ResourceGroup
 
-	resp, err := clients.RecoveryServices.VaultsClient.Get(ctx, resourceGroup, name)
+	resp, err := clients.RecoveryServices().VaultsClient.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Recovery Service Vault (%s): %+v", id, err)
 	}
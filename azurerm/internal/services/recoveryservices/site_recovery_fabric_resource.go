@@ -57,7 +57,7 @@ func resourceSiteRecoveryFabricCreate(d *schema.ResourceData, meta interface{})
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	name := d.Get("name").(string)
 
-	client := meta.(*clients.Client).RecoveryServices.FabricClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().FabricClient(resGroup, vaultName)
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -112,7 +112,7 @@ func resourceSiteRecoveryFabricRead(d *schema.ResourceData, meta interface{}) er
 	vaultName := id.Path["vaults"]
 	name := id.Path["replicationFabrics"]
 
-	client := meta.(*clients.Client).RecoveryServices.FabricClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().FabricClient(resGroup, vaultName)
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -146,7 +146,7 @@ func resourceSiteRecoveryFabricDelete(d *schema.ResourceData, meta interface{})
 	vaultName := id.Path["vaults"]
 	name := id.Path["replicationFabrics"]
 
-	client := meta.(*clients.Client).RecoveryServices.FabricClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().FabricClient(resGroup, vaultName)
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
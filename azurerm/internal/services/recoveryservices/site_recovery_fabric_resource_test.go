@@ -72,7 +72,7 @@ func (t SiteRecoveryFabricResource) Exists(ctx context.Context, clients *clients
 	vaultName := id.Path["vaults"]
 	name := id.Path["replicationFabrics"]
 
-	resp, err := clients.RecoveryServices.FabricClient(resGroup, vaultName).Get(ctx, name)
+	resp, err := clients.RecoveryServices().FabricClient(resGroup, vaultName).Get(ctx, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Recovery Service Vault (%s): %+v", id, err)
 	}
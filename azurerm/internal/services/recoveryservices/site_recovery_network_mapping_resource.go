@@ -86,7 +86,7 @@ func resourceSiteRecoveryNetworkMappingCreate(d *schema.ResourceData, meta inter
 	targetNetworkId := d.Get("target_network_id").(string)
 	name := d.Get("name").(string)
 
-	client := meta.(*clients.Client).RecoveryServices.NetworkMappingClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().NetworkMappingClient(resGroup, vaultName)
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -158,7 +158,7 @@ func resourceSiteRecoveryNetworkMappingRead(d *schema.ResourceData, meta interfa
 	networkName := id.Path["replicationNetworks"]
 	name := id.Path["replicationNetworkMappings"]
 
-	client := meta.(*clients.Client).RecoveryServices.NetworkMappingClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().NetworkMappingClient(resGroup, vaultName)
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -201,7 +201,7 @@ func resourceSiteRecoveryNetworkMappingDelete(d *schema.ResourceData, meta inter
 	networkName := id.Path["replicationNetworks"]
 	name := id.Path["replicationNetworkMappings"]
 
-	client := meta.(*clients.Client).RecoveryServices.NetworkMappingClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().NetworkMappingClient(resGroup, vaultName)
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -105,7 +105,7 @@ func (t SiteRecoveryNetworkMappingResource) Exists(ctx context.Context, clients
 	networkName := id.Path["replicationNetworks"]
 	name := id.Path["replicationNetworkMappings"]
 
-	resp, err := clients.RecoveryServices.NetworkMappingClient(resGroup, vaultName).Get(ctx, fabricName, networkName, name)
+	resp, err := clients.RecoveryServices().NetworkMappingClient(resGroup, vaultName).Get(ctx, fabricName, networkName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Recovery Service Vault (%s): %+v", id, err)
 	}
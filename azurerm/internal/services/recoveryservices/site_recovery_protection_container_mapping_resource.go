@@ -86,7 +86,7 @@ func resourceSiteRecoveryContainerMappingCreate(d *schema.ResourceData, meta int
 	targetContainerId := d.Get("recovery_target_protection_container_id").(string)
 	name := d.Get("name").(string)
 
-	client := meta.(*clients.Client).RecoveryServices.ContainerMappingClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().ContainerMappingClient(resGroup, vaultName)
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -140,7 +140,7 @@ func resourceSiteRecoveryContainerMappingRead(d *schema.ResourceData, meta inter
 	protectionContainerName := id.Path["replicationProtectionContainers"]
 	name := id.Path["replicationProtectionContainerMappings"]
 
-	client := meta.(*clients.Client).RecoveryServices.ContainerMappingClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().ContainerMappingClient(resGroup, vaultName)
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -176,7 +176,7 @@ func resourceSiteRecoveryServicesContainerMappingDelete(d *schema.ResourceData,
 	name := id.Path["replicationProtectionContainerMappings"]
 	instanceType := string(siterecovery.InstanceTypeBasicReplicationProviderSpecificContainerMappingInputInstanceTypeA2A)
 
-	client := meta.(*clients.Client).RecoveryServices.ContainerMappingClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().ContainerMappingClient(resGroup, vaultName)
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -114,7 +114,7 @@ func (t SiteRecoveryProtectionContainerMappingResource) Exists(ctx context.Conte
 	protectionContainerName := id.Path["replicationProtectionContainers"]
 	name := id.Path["replicationProtectionContainerMappings"]
 
-	resp, err := clients.RecoveryServices.ContainerMappingClient(resGroup, vaultName).Get(ctx, fabricName, protectionContainerName, name)
+	resp, err := clients.RecoveryServices().ContainerMappingClient(resGroup, vaultName).Get(ctx, fabricName, protectionContainerName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading site recovery protection container mapping (%s): %+v", id, err)
 	}
@@ -62,7 +62,7 @@ func resourceSiteRecoveryProtectionContainerCreate(d *schema.ResourceData, meta
 	fabricName := d.Get("recovery_fabric_name").(string)
 	name := d.Get("name").(string)
 
-	client := meta.(*clients.Client).RecoveryServices.ProtectionContainerClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().ProtectionContainerClient(resGroup, vaultName)
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -112,7 +112,7 @@ func resourceSiteRecoveryProtectionContainerRead(d *schema.ResourceData, meta in
 	fabricName := id.Path["replicationFabrics"]
 	name := id.Path["replicationProtectionContainers"]
 
-	client := meta.(*clients.Client).RecoveryServices.ProtectionContainerClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().ProtectionContainerClient(resGroup, vaultName)
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -143,7 +143,7 @@ func resourceSiteRecoveryProtectionContainerDelete(d *schema.ResourceData, meta
 	fabricName := id.Path["replicationFabrics"]
 	name := id.Path["replicationProtectionContainers"]
 
-	client := meta.(*clients.Client).RecoveryServices.ProtectionContainerClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().ProtectionContainerClient(resGroup, vaultName)
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
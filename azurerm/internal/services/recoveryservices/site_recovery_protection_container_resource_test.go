@@ -79,7 +79,7 @@ func (t SiteRecoveryProtectionContainerResource) Exists(ctx context.Context, cli
 	fabricName := id.Path["replicationFabrics"]
 	name := id.Path["replicationProtectionContainers"]
 
-	resp, err := clients.RecoveryServices.ProtectionContainerClient(resGroup, vaultName).Get(ctx, fabricName, name)
+	resp, err := clients.RecoveryServices().ProtectionContainerClient(resGroup, vaultName).Get(ctx, fabricName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading site recovery protection container (%s): %+v", id, err)
 	}
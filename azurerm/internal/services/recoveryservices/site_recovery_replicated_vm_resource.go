@@ -212,7 +212,7 @@ func networkInterfaceResource() *schema.Resource {
 func resourceSiteRecoveryReplicatedItemCreate(d *schema.ResourceData, meta interface{}) error {
 	resGroup := d.Get("resource_group_name").(string)
 	vaultName := d.Get("recovery_vault_name").(string)
-	client := meta.(*clients.Client).RecoveryServices.ReplicationMigrationItemsClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().ReplicationMigrationItemsClient(resGroup, vaultName)
 	name := d.Get("name").(string)
 	fabricName := d.Get("source_recovery_fabric_name").(string)
 	sourceVmId := d.Get("source_vm_id").(string)
@@ -298,7 +298,7 @@ func resourceSiteRecoveryReplicatedItemCreate(d *schema.ResourceData, meta inter
 func resourceSiteRecoveryReplicatedItemUpdate(d *schema.ResourceData, meta interface{}) error {
 	resGroup := d.Get("resource_group_name").(string)
 	vaultName := d.Get("recovery_vault_name").(string)
-	client := meta.(*clients.Client).RecoveryServices.ReplicationMigrationItemsClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().ReplicationMigrationItemsClient(resGroup, vaultName)
 
 	// We are only allowed to update the configuration once the VM is fully protected
 	state, err := waitForReplicationToBeHealthy(d, meta)
@@ -413,7 +413,7 @@ func resourceSiteRecoveryReplicatedItemRead(d *schema.ResourceData, meta interfa
 
 	resGroup := id.ResourceGroup
 	vaultName := id.Path["vaults"]
-	client := meta.(*clients.Client).RecoveryServices.ReplicationMigrationItemsClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().ReplicationMigrationItemsClient(resGroup, vaultName)
 	fabricName := id.Path["replicationFabrics"]
 	protectionContainerName := id.Path["replicationProtectionContainers"]
 	name := id.Path["replicationProtectedItems"]
@@ -492,7 +492,7 @@ func resourceSiteRecoveryReplicatedItemDelete(d *schema.ResourceData, meta inter
 
 	resGroup := id.ResourceGroup
 	vaultName := id.Path["vaults"]
-	client := meta.(*clients.Client).RecoveryServices.ReplicationMigrationItemsClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().ReplicationMigrationItemsClient(resGroup, vaultName)
 	fabricName := id.Path["replicationFabrics"]
 	protectionContainerName := id.Path["replicationProtectionContainers"]
 	name := id.Path["replicationProtectedItems"]
@@ -561,7 +561,7 @@ func waitForReplicationToBeHealthyRefreshFunc(d *schema.ResourceData, meta inter
 
 		resGroup := id.ResourceGroup
 		vaultName := id.Path["vaults"]
-		client := meta.(*clients.Client).RecoveryServices.ReplicationMigrationItemsClient(resGroup, vaultName)
+		client := meta.(*clients.Client).RecoveryServices().ReplicationMigrationItemsClient(resGroup, vaultName)
 		fabricName := id.Path["replicationFabrics"]
 		protectionContainerName := id.Path["replicationProtectionContainers"]
 		name := id.Path["replicationProtectedItems"]
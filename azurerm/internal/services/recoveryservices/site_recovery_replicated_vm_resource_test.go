@@ -268,7 +268,7 @@ func (t SiteRecoveryReplicatedVmResource) Exists(ctx context.Context, clients *c
 	protectionContainerName := id.Path["replicationProtectionContainers"]
 	name := id.Path["replicationProtectedItems"]
 
-	resp, err := clients.RecoveryServices.ReplicationMigrationItemsClient(resGroup, vaultName).Get(ctx, fabricName, protectionContainerName, name)
+	resp, err := clients.RecoveryServices().ReplicationMigrationItemsClient(resGroup, vaultName).Get(ctx, fabricName, protectionContainerName, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading site recovery replicated vm (%s): %+v", id, err)
 	}
@@ -67,7 +67,7 @@ func resourceSiteRecoveryReplicationPolicyCreate(d *schema.ResourceData, meta in
 	vaultName := d.Get("recovery_vault_name").(string)
 	name := d.Get("name").(string)
 
-	client := meta.(*clients.Client).RecoveryServices.ReplicationPoliciesClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().ReplicationPoliciesClient(resGroup, vaultName)
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -119,7 +119,7 @@ func resourceSiteRecoveryReplicationPolicyUpdate(d *schema.ResourceData, meta in
 	vaultName := d.Get("recovery_vault_name").(string)
 	name := d.Get("name").(string)
 
-	client := meta.(*clients.Client).RecoveryServices.ReplicationPoliciesClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().ReplicationPoliciesClient(resGroup, vaultName)
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -163,7 +163,7 @@ func resourceSiteRecoveryReplicationPolicyRead(d *schema.ResourceData, meta inte
 	vaultName := id.Path["vaults"]
 	name := id.Path["replicationPolicies"]
 
-	client := meta.(*clients.Client).RecoveryServices.ReplicationPoliciesClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().ReplicationPoliciesClient(resGroup, vaultName)
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -196,7 +196,7 @@ func resourceSiteRecoveryReplicationPolicyDelete(d *schema.ResourceData, meta in
 	vaultName := id.Path["vaults"]
 	name := id.Path["replicationPolicies"]
 
-	client := meta.(*clients.Client).RecoveryServices.ReplicationPoliciesClient(resGroup, vaultName)
+	client := meta.(*clients.Client).RecoveryServices().ReplicationPoliciesClient(resGroup, vaultName)
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -73,7 +73,7 @@ func (t SiteRecoveryReplicationPolicyResource) Exists(ctx context.Context, clien
 	vaultName := id.Path["vaults"]
 	name := id.Path["replicationPolicies"]
 
-	resp, err := clients.RecoveryServices.ReplicationPoliciesClient(resGroup, vaultName).Get(ctx, name)
+	resp, err := clients.RecoveryServices().ReplicationPoliciesClient(resGroup, vaultName).Get(ctx, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading site recovery replication policy (%s): %+v", id, err)
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LinkedServerId struct {
@@ -73,3 +74,12 @@ func LinkedServerID(input string) (*LinkedServerId, error) {
 
 	return &resourceId, nil
 }
+
+// LinkedServerIDNormalized parses a LinkedServer ID into an LinkedServerId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the LinkedServerID method should be used instead for validation etc.
+func LinkedServerIDNormalized(input string) (*LinkedServerId, error) {
+	return LinkedServerID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cache/Redis/%s/linkedServers/%s"))
+}
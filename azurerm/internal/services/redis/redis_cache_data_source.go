@@ -216,9 +216,9 @@ func dataSourceRedisCache() *schema.Resource {
 }
 
 func dataSourceRedisCacheRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Redis.Client
+	client := meta.(*clients.Client).Redis().Client
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	patchSchedulesClient := meta.(*clients.Client).Redis.PatchSchedulesClient
+	patchSchedulesClient := meta.(*clients.Client).Redis().PatchSchedulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
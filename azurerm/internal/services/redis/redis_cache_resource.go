@@ -285,8 +285,8 @@ func resourceRedisCache() *schema.Resource {
 }
 
 func resourceRedisCacheCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Redis.Client
-	patchClient := meta.(*clients.Client).Redis.PatchSchedulesClient
+	client := meta.(*clients.Client).Redis().Client
+	patchClient := meta.(*clients.Client).Redis().PatchSchedulesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -355,11 +355,14 @@ func resourceRedisCacheCreate(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
-		locks.ByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-		defer locks.UnlockByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-
-		locks.ByName(parsed.Name, network.SubnetResourceName)
-		defer locks.UnlockByName(parsed.Name, network.SubnetResourceName)
+		parent := locks.Parent{
+			Name:         parsed.VirtualNetworkName,
+			ResourceType: network.VirtualNetworkResourceName,
+		}
+		if err := locks.ChildByNameWithContext(ctx, parent, parsed.Name, network.SubnetResourceName); err != nil {
+			return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+		}
+		defer locks.UnlockChildByName(parent, parsed.Name, network.SubnetResourceName)
 
 		parameters.SubnetID = utils.String(v.(string))
 	}
@@ -402,8 +405,8 @@ func resourceRedisCacheCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRedisCacheUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Redis.Client
-	patchClient := meta.(*clients.Client).Redis.PatchSchedulesClient
+	client := meta.(*clients.Client).Redis().Client
+	patchClient := meta.(*clients.Client).Redis().PatchSchedulesClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM Redis Cache update.")
@@ -493,8 +496,8 @@ func resourceRedisCacheUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRedisCacheRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Redis.Client
-	patchSchedulesClient := meta.(*clients.Client).Redis.PatchSchedulesClient
+	client := meta.(*clients.Client).Redis().Client
+	patchSchedulesClient := meta.(*clients.Client).Redis().PatchSchedulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -585,7 +588,7 @@ func resourceRedisCacheRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRedisCacheDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Redis.Client
+	client := meta.(*clients.Client).Redis().Client
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -608,11 +611,14 @@ func resourceRedisCacheDelete(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
-		locks.ByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-		defer locks.UnlockByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-
-		locks.ByName(parsed.Name, network.SubnetResourceName)
-		defer locks.UnlockByName(parsed.Name, network.SubnetResourceName)
+		parent := locks.Parent{
+			Name:         parsed.VirtualNetworkName,
+			ResourceType: network.VirtualNetworkResourceName,
+		}
+		if err := locks.ChildByNameWithContext(ctx, parent, parsed.Name, network.SubnetResourceName); err != nil {
+			return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+		}
+		defer locks.UnlockChildByName(parent, parsed.Name, network.SubnetResourceName)
 	}
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.RediName)
@@ -403,7 +403,7 @@ func (t RedisCacheResource) Exists(ctx context.Context, clients *clients.Client,
 		return nil, err
 	}
 
-	resp, err := clients.Redis.Client.Get(ctx, id.ResourceGroup, id.RediName)
+	resp, err := clients.Redis().Client.Get(ctx, id.ResourceGroup, id.RediName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Redis Cache (%s): %+v", id.String(), err)
 	}
@@ -74,7 +74,7 @@ func resourceRedisFirewallRule() *schema.Resource {
 }
 
 func resourceRedisFirewallRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Redis.FirewallRulesClient
+	client := meta.(*clients.Client).Redis().FirewallRulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Redis Firewall Rule creation.")
@@ -114,7 +114,7 @@ func resourceRedisFirewallRuleCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceRedisFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Redis.FirewallRulesClient
+	client := meta.(*clients.Client).Redis().FirewallRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -146,7 +146,7 @@ func resourceRedisFirewallRuleRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceRedisFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Redis.FirewallRulesClient
+	client := meta.(*clients.Client).Redis().FirewallRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
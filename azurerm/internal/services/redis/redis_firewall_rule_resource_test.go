@@ -90,7 +90,7 @@ func (t RedisFirewallRuleResource) Exists(ctx context.Context, clients *clients.
 		return nil, err
 	}
 
-	resp, err := clients.Redis.FirewallRulesClient.Get(ctx, id.ResourceGroup, id.RediName, id.Name)
+	resp, err := clients.Redis().FirewallRulesClient.Get(ctx, id.ResourceGroup, id.RediName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Redis Firewall Rule (%s): %+v", id.String(), err)
 	}
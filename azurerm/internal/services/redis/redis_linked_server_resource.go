@@ -78,7 +78,7 @@ func resourceRedisLinkedServer() *schema.Resource {
 }
 
 func resourceRedisLinkedServerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Redis.LinkedServerClient
+	client := meta.(*clients.Client).Redis().LinkedServerClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Redis Linked Server creation.")
@@ -142,7 +142,7 @@ func resourceRedisLinkedServerCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceRedisLinkedServerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Redis.LinkedServerClient
+	client := meta.(*clients.Client).Redis().LinkedServerClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -185,7 +185,7 @@ func resourceRedisLinkedServerRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceRedisLinkedServerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Redis.LinkedServerClient
+	client := meta.(*clients.Client).Redis().LinkedServerClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
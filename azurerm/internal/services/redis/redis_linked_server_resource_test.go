@@ -52,7 +52,7 @@ func (t RedisLinkedServerResource) Exists(ctx context.Context, clients *clients.
 		return nil, err
 	}
 
-	resp, err := clients.Redis.LinkedServerClient.Get(ctx, id.ResourceGroup, id.RediName, id.Name)
+	resp, err := clients.Redis().LinkedServerClient.Get(ctx, id.ResourceGroup, id.RediName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Redis Linked Server (%s): %+v", id.String(), err)
 	}
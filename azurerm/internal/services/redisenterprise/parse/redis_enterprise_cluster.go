@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RedisEnterpriseClusterId struct {
@@ -67,3 +68,12 @@ func RedisEnterpriseClusterID(input string) (*RedisEnterpriseClusterId, error) {
 
 	return &resourceId, nil
 }
+
+// RedisEnterpriseClusterIDNormalized parses a RedisEnterpriseCluster ID into an RedisEnterpriseClusterId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the RedisEnterpriseClusterID method should be used instead for validation etc.
+func RedisEnterpriseClusterIDNormalized(input string) (*RedisEnterpriseClusterId, error) {
+	return RedisEnterpriseClusterID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cache/redisEnterprise/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RedisEnterpriseDatabaseId struct {
@@ -73,3 +74,12 @@ func RedisEnterpriseDatabaseID(input string) (*RedisEnterpriseDatabaseId, error)
 
 	return &resourceId, nil
 }
+
+// RedisEnterpriseDatabaseIDNormalized parses a RedisEnterpriseDatabase ID into an RedisEnterpriseDatabaseId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the RedisEnterpriseDatabaseID method should be used instead for validation etc.
+func RedisEnterpriseDatabaseIDNormalized(input string) (*RedisEnterpriseDatabaseId, error) {
+	return RedisEnterpriseDatabaseID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cache/redisEnterprise/%s/databases/%s"))
+}
@@ -110,7 +110,7 @@ func resourceRedisEnterpriseCluster() *schema.Resource {
 }
 
 func resourceRedisEnterpriseClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RedisEnterprise.Client
+	client := meta.(*clients.Client).RedisEnterprise().Client
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Redis Enterprise Cluster creation.")
@@ -189,7 +189,7 @@ func resourceRedisEnterpriseClusterCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceRedisEnterpriseClusterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RedisEnterprise.Client
+	client := meta.(*clients.Client).RedisEnterprise().Client
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -232,7 +232,7 @@ func resourceRedisEnterpriseClusterRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceRedisEnterpriseClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RedisEnterprise.Client
+	client := meta.(*clients.Client).RedisEnterprise().Client
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
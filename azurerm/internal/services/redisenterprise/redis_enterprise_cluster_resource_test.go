@@ -63,7 +63,7 @@ func (r RedisEnterpriseClusterResource) Exists(ctx context.Context, client *clie
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.RedisEnterprise.Client.Get(ctx, id.ResourceGroup, id.RedisEnterpriseName)
+	resp, err := client.RedisEnterprise().Client.Get(ctx, id.ResourceGroup, id.RedisEnterpriseName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -180,7 +180,7 @@ func resourceRedisEnterpriseDatabase() *schema.Resource {
 }
 func resourceRedisEnterpriseDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).RedisEnterprise.DatabaseClient
+	client := meta.(*clients.Client).RedisEnterprise().DatabaseClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -216,7 +216,7 @@ func resourceRedisEnterpriseDatabaseCreate(d *schema.ResourceData, meta interfac
 	if err != nil {
 		// Need to check if this was due to the cluster having the wrong sku
 		if strings.Contains(err.Error(), "The value of the parameter 'properties.modules' is invalid") {
-			clusterClient := meta.(*clients.Client).RedisEnterprise.Client
+			clusterClient := meta.(*clients.Client).RedisEnterprise().Client
 			resp, err := clusterClient.Get(ctx, clusterID.ResourceGroup, clusterID.RedisEnterpriseName)
 			if err != nil {
 				return fmt.Errorf("retrieving Redis Enterprise Cluster (Name %q / Resource Group %q): %+v", clusterID.RedisEnterpriseName, clusterID.ResourceGroup, err)
@@ -240,7 +240,7 @@ func resourceRedisEnterpriseDatabaseCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceRedisEnterpriseDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RedisEnterprise.DatabaseClient
+	client := meta.(*clients.Client).RedisEnterprise().DatabaseClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -280,7 +280,7 @@ func resourceRedisEnterpriseDatabaseRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceRedisEnterpriseDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).RedisEnterprise.DatabaseClient
+	client := meta.(*clients.Client).RedisEnterprise().DatabaseClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
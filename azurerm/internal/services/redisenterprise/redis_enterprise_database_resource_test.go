@@ -64,7 +64,7 @@ func (r RedisenterpriseDatabaseResource) Exists(ctx context.Context, client *cli
 		return nil, err
 	}
 
-	resp, err := client.RedisEnterprise.DatabaseClient.Get(ctx, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
+	resp, err := client.RedisEnterprise().DatabaseClient.Get(ctx, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -72,7 +72,7 @@ func resourceArmRelayHybridConnection() *schema.Resource {
 }
 
 func resourceArmRelayHybridConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Relay.HybridConnectionsClient
+	client := meta.(*clients.Client).Relay().HybridConnectionsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -112,7 +112,7 @@ func resourceArmRelayHybridConnectionCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceArmRelayHybridConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Relay.HybridConnectionsClient
+	client := meta.(*clients.Client).Relay().HybridConnectionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -144,7 +144,7 @@ func resourceArmRelayHybridConnectionRead(d *schema.ResourceData, meta interface
 }
 
 func resourceArmRelayHybridConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Relay.HybridConnectionsClient
+	client := meta.(*clients.Client).Relay().HybridConnectionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
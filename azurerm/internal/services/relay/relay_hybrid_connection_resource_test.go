@@ -94,7 +94,7 @@ func (t RelayHybridConnectionResource) Exists(ctx context.Context, clients *clie
 		return nil, err
 	}
 
-	resp, err := clients.Relay.HybridConnectionsClient.Get(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
+	resp, err := clients.Relay().HybridConnectionsClient.Get(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Relay Hybrid Connection (%s): %+v", id.String(), err)
 	}
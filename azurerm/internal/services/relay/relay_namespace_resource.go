@@ -96,7 +96,7 @@ func resourceRelayNamespace() *schema.Resource {
 }
 
 func resourceRelayNamespaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Relay.NamespacesClient
+	client := meta.(*clients.Client).Relay().NamespacesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -145,7 +145,7 @@ func resourceRelayNamespaceCreateUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceRelayNamespaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Relay.NamespacesClient
+	client := meta.(*clients.Client).Relay().NamespacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -190,7 +190,7 @@ func resourceRelayNamespaceRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceRelayNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Relay.NamespacesClient
+	client := meta.(*clients.Client).Relay().NamespacesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -84,7 +84,7 @@ func (t RelayNamespaceResource) Exists(ctx context.Context, clients *clients.Cli
 		return nil, err
 	}
 
-	resp, err := clients.Relay.NamespacesClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Relay().NamespacesClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Relay Namespace (%s): %+v", id.String(), err)
 	}
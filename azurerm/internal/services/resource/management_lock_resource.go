@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
@@ -12,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -44,6 +45,8 @@ func resourceManagementLock() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
+				// the casing of the segment keys within the Scope is normalized when reading this from the ID
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"lock_level": {
@@ -67,7 +70,7 @@ func resourceManagementLock() *schema.Resource {
 }
 
 func resourceManagementLockCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.LocksClient
+	client := meta.(*clients.Client).Resource().LocksClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Management Lock creation.")
@@ -116,26 +119,26 @@ func resourceManagementLockCreateUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceManagementLockRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.LocksClient
+	client := meta.(*clients.Client).Resource().LocksClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := ParseAzureRMLockId(d.Id())
+	id, err := parse.ManagementLockIDNormalized(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.GetByScope(ctx, id.Scope, id.Name)
+	resp, err := client.GetByScope(ctx, id.Scope.ID(), id.LockName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on AzureRM Management Lock %q (Scope %q): %+v", id.Name, id.Scope, err)
+		return fmt.Errorf("Error making Read request on AzureRM Management Lock %q (Scope %q): %+v", id.LockName, id.Scope.ID(), err)
 	}
 
 	d.Set("name", resp.Name)
-	d.Set("scope", id.Scope)
+	d.Set("scope", id.Scope.ID())
 
 	if props := resp.ManagementLockProperties; props != nil {
 		d.Set("lock_level", string(props.Level))
@@ -146,47 +149,27 @@ func resourceManagementLockRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceManagementLockDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.LocksClient
+	client := meta.(*clients.Client).Resource().LocksClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := ParseAzureRMLockId(d.Id())
+	id, err := parse.ManagementLockIDNormalized(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.DeleteByScope(ctx, id.Scope, id.Name)
+	resp, err := client.DeleteByScope(ctx, id.Scope.ID(), id.LockName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp) {
 			return nil
 		}
 
-		return fmt.Errorf("Error issuing AzureRM delete request for Management Lock %q (Scope %q): %+v", id.Name, id.Scope, err)
+		return fmt.Errorf("Error issuing AzureRM delete request for Management Lock %q (Scope %q): %+v", id.LockName, id.Scope.ID(), err)
 	}
 
 	return nil
 }
 
-type AzureManagementLockId struct {
-	Scope string
-	Name  string
-}
-
-func ParseAzureRMLockId(id string) (*AzureManagementLockId, error) {
-	segments := strings.Split(id, "/providers/Microsoft.Authorization/locks/")
-	if len(segments) != 2 {
-		return nil, fmt.Errorf("Expected ID to be in the format `{scope}/providers/Microsoft.Authorization/locks/{name} - got %d segments", len(segments))
-	}
-
-	scope := segments[0]
-	name := segments[1]
-	lockId := AzureManagementLockId{
-		Scope: scope,
-		Name:  name,
-	}
-	return &lockId, nil
-}
-
 func validateManagementLockName(v interface{}, k string) (warnings []string, errors []error) {
 	input := v.(string)
 
@@ -11,7 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
-	azureResource "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -164,12 +164,12 @@ func TestAccManagementLock_subscriptionCanNotDeleteBasic(t *testing.T) {
 }
 
 func (t ManagementLockResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	id, err := azureResource.ParseAzureRMLockId(state.ID)
+	id, err := parse.ManagementLockID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := clients.Resource.LocksClient.GetByScope(ctx, id.Scope, id.Name)
+	resp, err := clients.Resource().LocksClient.GetByScope(ctx, id.Scope.ID(), id.LockName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Management Lock (%s): %+v", id, err)
 	}
@@ -0,0 +1,58 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+type ManagementLockId struct {
+	Scope    resourceid.Scope
+	LockName string
+}
+
+func NewManagementLockID(scope resourceid.Scope, lockName string) ManagementLockId {
+	return ManagementLockId{
+		Scope:    scope,
+		LockName: lockName,
+	}
+}
+
+func (id ManagementLockId) String() string {
+	segments := []string{
+		fmt.Sprintf("Lock Name %q", id.LockName),
+		fmt.Sprintf("Scope %q", id.Scope.ID()),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Management Lock", segmentsStr)
+}
+
+func (id ManagementLockId) ID() string {
+	fmtString := "%s/providers/Microsoft.Authorization/locks/%s"
+	return fmt.Sprintf(fmtString, id.Scope.ID(), id.LockName)
+}
+
+// ManagementLockID parses a ManagementLock ID into an ManagementLockId struct
+func ManagementLockID(input string) (*ManagementLockId, error) {
+	scope, values, err := resourceid.ParseScopedID(input, "/providers/Microsoft.Authorization/locks/%s")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManagementLockId{
+		Scope:    scope,
+		LockName: values[0],
+	}, nil
+}
+
+// ManagementLockIDNormalized parses a ManagementLock ID into an ManagementLockId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ManagementLockID method should be used instead for validation etc.
+func ManagementLockIDNormalized(input string) (*ManagementLockId, error) {
+	return ManagementLockID(resourceid.NormalizeSegmentKeys(input, "%s/providers/Microsoft.Authorization/locks/%s"))
+}
@@ -0,0 +1,212 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = ManagementLockId{}
+
+func TestManagementLockIDFormatter(t *testing.T) {
+	actual := NewManagementLockID(resourceid.ResourceGroupScope{SubscriptionId: "12345678-1234-9876-4563-123456789012", ResourceGroup: "resGroup1"}, "lock1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/locks/lock1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestManagementLockID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ManagementLockId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing invalid value for Scope
+			Input: "/subscriptions/providers/Microsoft.Authorization/locks/lock1",
+			Error: true,
+		},
+
+		{
+			// missing LockName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/",
+			Error: true,
+		},
+
+		{
+			// missing value for LockName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/locks/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &ManagementLockId{
+				Scope:    resourceid.ResourceGroupScope{SubscriptionId: "12345678-1234-9876-4563-123456789012", ResourceGroup: "resGroup1"},
+				LockName: "lock1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.AUTHORIZATION/LOCKS/LOCK1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ManagementLockID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.LockName != v.Expected.LockName {
+			t.Fatalf("Expected %q but got %q for LockName", v.Expected.LockName, actual.LockName)
+		}
+	}
+}
+
+func TestManagementLockIDNormalized(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ManagementLockId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing invalid value for Scope
+			Input: "/subscriptions/providers/Microsoft.Authorization/locks/lock1",
+			Error: true,
+		},
+
+		{
+			// missing LockName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/",
+			Error: true,
+		},
+
+		{
+			// missing value for LockName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/locks/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &ManagementLockId{
+				Scope:    resourceid.ResourceGroupScope{SubscriptionId: "12345678-1234-9876-4563-123456789012", ResourceGroup: "resGroup1"},
+				LockName: "lock1",
+			},
+		},
+
+		{
+			// trailing slash
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/locks/lock1/",
+			Expected: &ManagementLockId{
+				Scope:    resourceid.ResourceGroupScope{SubscriptionId: "12345678-1234-9876-4563-123456789012", ResourceGroup: "resGroup1"},
+				LockName: "lock1",
+			},
+		},
+
+		{
+			// lower-cased segment names
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/microsoft.authorization/locks/lock1",
+			Expected: &ManagementLockId{
+				Scope:    resourceid.ResourceGroupScope{SubscriptionId: "12345678-1234-9876-4563-123456789012", ResourceGroup: "resGroup1"},
+				LockName: "lock1",
+			},
+		},
+
+		{
+			// upper-cased segment names
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/PROVIDERS/MICROSOFT.AUTHORIZATION/LOCKS/lock1",
+			Expected: &ManagementLockId{
+				Scope:    resourceid.ResourceGroupScope{SubscriptionId: "12345678-1234-9876-4563-123456789012", ResourceGroup: "resGroup1"},
+				LockName: "lock1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ManagementLockIDNormalized(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.LockName != v.Expected.LockName {
+			t.Fatalf("Expected %q but got %q for LockName", v.Expected.LockName, actual.LockName)
+		}
+	}
+}
+
+func TestManagementLockIDRoundTrip(t *testing.T) {
+	testData := []string{
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/locks/lock1",
+		"/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks/lock1",
+		"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1/providers/Microsoft.Authorization/locks/lock1",
+		"/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/locks/lock1",
+		"/providers/Microsoft.Capacity/reservationOrders/order1/providers/Microsoft.Authorization/locks/lock1",
+		"/providers/Microsoft.Authorization/locks/lock1",
+	}
+
+	for _, input := range testData {
+		t.Logf("[DEBUG] Testing %q", input)
+
+		id, err := ManagementLockID(input)
+		if err != nil {
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+
+		// formatting the parsed ID should return the original ID
+		if actual := id.ID(); actual != input {
+			t.Fatalf("Expected %q but got %q", input, actual)
+		}
+
+		// and building the ID from each of it's segments should return the same ID
+		if actual := NewManagementLockID(id.Scope, id.LockName); actual != *id {
+			t.Fatalf("Expected %+v but got %+v", *id, actual)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ResourceGroupId struct {
@@ -60,3 +61,12 @@ func ResourceGroupID(input string) (*ResourceGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// ResourceGroupIDNormalized parses a ResourceGroup ID into an ResourceGroupId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ResourceGroupID method should be used instead for validation etc.
+func ResourceGroupIDNormalized(input string) (*ResourceGroupId, error) {
+	return ResourceGroupID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ResourceGroupTemplateDeploymentId struct {
@@ -67,3 +68,12 @@ func ResourceGroupTemplateDeploymentID(input string) (*ResourceGroupTemplateDepl
 
 	return &resourceId, nil
 }
+
+// ResourceGroupTemplateDeploymentIDNormalized parses a ResourceGroupTemplateDeployment ID into an ResourceGroupTemplateDeploymentId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ResourceGroupTemplateDeploymentID method should be used instead for validation etc.
+func ResourceGroupTemplateDeploymentIDNormalized(input string) (*ResourceGroupTemplateDeploymentId, error) {
+	return ResourceGroupTemplateDeploymentID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Resources/deployments/%s"))
+}
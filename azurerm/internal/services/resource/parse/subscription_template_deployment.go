@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SubscriptionTemplateDeploymentId struct {
@@ -59,3 +60,12 @@ func SubscriptionTemplateDeploymentID(input string) (*SubscriptionTemplateDeploy
 
 	return &resourceId, nil
 }
+
+// SubscriptionTemplateDeploymentIDNormalized parses a SubscriptionTemplateDeployment ID into an SubscriptionTemplateDeploymentId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SubscriptionTemplateDeploymentID method should be used instead for validation etc.
+func SubscriptionTemplateDeploymentIDNormalized(input string) (*SubscriptionTemplateDeploymentId, error) {
+	return SubscriptionTemplateDeploymentID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/providers/Microsoft.Resources/deployments/%s"))
+}
@@ -29,7 +29,7 @@ func dataSourceResourceGroup() *schema.Resource {
 }
 
 func dataSourceResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.GroupsClient
+	client := meta.(*clients.Client).Resource().GroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -48,7 +48,7 @@ func resourceResourceGroup() *schema.Resource {
 }
 
 func resourceResourceGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.GroupsClient
+	client := meta.(*clients.Client).Resource().GroupsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -89,7 +89,7 @@ func resourceResourceGroupCreateUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.GroupsClient
+	client := meta.(*clients.Client).Resource().GroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -115,7 +115,7 @@ func resourceResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.GroupsClient
+	client := meta.(*clients.Client).Resource().GroupsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
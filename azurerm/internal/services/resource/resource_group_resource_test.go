@@ -87,7 +87,7 @@ func TestAccResourceGroup_withTags(t *testing.T) {
 func (t ResourceGroupResource) Destroy(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	resourceGroup := state.Attributes["name"]
 
-	groupsClient := client.Resource.GroupsClient
+	groupsClient := client.Resource().GroupsClient
 	deleteFuture, err := groupsClient.Delete(ctx, resourceGroup)
 	if err != nil {
 		return nil, fmt.Errorf("deleting Resource Group %q: %+v", resourceGroup, err)
@@ -104,7 +104,7 @@ func (t ResourceGroupResource) Destroy(ctx context.Context, client *clients.Clie
 func (t ResourceGroupResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	name := state.Attributes["name"]
 
-	resp, err := client.Resource.GroupsClient.Get(ctx, name)
+	resp, err := client.Resource().GroupsClient.Get(ctx, name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Resource Group %q: %+v", name, err)
 	}
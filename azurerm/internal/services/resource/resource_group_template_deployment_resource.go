@@ -95,7 +95,7 @@ func resourceGroupTemplateDeploymentResource() *schema.Resource {
 }
 
 func resourceGroupTemplateDeploymentResourceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.DeploymentsClient
+	client := meta.(*clients.Client).Resource().DeploymentsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -155,7 +155,7 @@ func resourceGroupTemplateDeploymentResourceCreate(d *schema.ResourceData, meta
 }
 
 func resourceGroupTemplateDeploymentResourceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.DeploymentsClient
+	client := meta.(*clients.Client).Resource().DeploymentsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -215,7 +215,7 @@ func resourceGroupTemplateDeploymentResourceUpdate(d *schema.ResourceData, meta
 		deployment.Properties.Template = exportedTemplate.Template
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		deployment.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -240,7 +240,7 @@ func resourceGroupTemplateDeploymentResourceUpdate(d *schema.ResourceData, meta
 }
 
 func resourceGroupTemplateDeploymentResourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.DeploymentsClient
+	client := meta.(*clients.Client).Resource().DeploymentsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -296,7 +296,7 @@ func resourceGroupTemplateDeploymentResourceRead(d *schema.ResourceData, meta in
 }
 
 func resourceGroupTemplateDeploymentResourceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.DeploymentsClient
+	client := meta.(*clients.Client).Resource().DeploymentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -320,7 +320,7 @@ func resourceGroupTemplateDeploymentResourceDelete(d *schema.ResourceData, meta
 
 	deleteItemsInTemplate := meta.(*clients.Client).Features.TemplateDeployment.DeleteNestedItemsDuringDeletion
 	if deleteItemsInTemplate {
-		resourceClient := meta.(*clients.Client).Resource
+		resourceClient := meta.(*clients.Client).Resource()
 		log.Printf("[DEBUG] Removing items provisioned by the Template Deployment %q (Resource Group %q)..", id.DeploymentName, id.ResourceGroup)
 		if err := deleteItemsProvisionedByTemplate(ctx, resourceClient, *template.Properties); err != nil {
 			return fmt.Errorf("removing items provisioned by this Template Deployment: %+v", err)
@@ -53,7 +53,7 @@ func (r ResourceProviderRegistrationResource) ResourceType() string {
 func (r ResourceProviderRegistrationResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.Resource.ProvidersClient
+			client := metadata.Client.Resource().ProvidersClient
 			account := metadata.Client.Account
 
 			var obj ResourceProviderRegistrationModel
@@ -112,7 +112,7 @@ func (r ResourceProviderRegistrationResource) Create() sdk.ResourceFunc {
 func (r ResourceProviderRegistrationResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.Resource.ProvidersClient
+			client := metadata.Client.Resource().ProvidersClient
 			account := metadata.Client.Account
 
 			id, err := parse.ResourceProviderID(metadata.ResourceData.Id())
@@ -149,7 +149,7 @@ func (r ResourceProviderRegistrationResource) Read() sdk.ResourceFunc {
 func (r ResourceProviderRegistrationResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.Resource.ProvidersClient
+			client := metadata.Client.Resource().ProvidersClient
 			account := metadata.Client.Account
 
 			id, err := parse.ResourceProviderID(metadata.ResourceData.Id())
@@ -190,7 +190,7 @@ func (r ResourceProviderRegistrationResource) IDValidationFunc() schema.SchemaVa
 
 func (r ResourceProviderRegistrationResource) CustomImporter() sdk.ResourceRunFunc {
 	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-		client := metadata.Client.Resource.ProvidersClient
+		client := metadata.Client.Resource().ProvidersClient
 		account := metadata.Client.Account
 
 		id, err := parse.ResourceProviderID(metadata.ResourceData.Id())
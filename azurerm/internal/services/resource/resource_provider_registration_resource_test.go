@@ -52,7 +52,7 @@ func TestAccResourceProviderRegistration_requiresImport(t *testing.T) {
 func (ResourceProviderRegistrationResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	name := state.Attributes["name"]
 
-	resp, err := client.Resource.ProvidersClient.Get(ctx, name, "")
+	resp, err := client.Resource().ProvidersClient.Get(ctx, name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
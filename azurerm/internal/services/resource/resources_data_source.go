@@ -68,7 +68,7 @@ func dataSourceResources() *schema.Resource {
 }
 
 func dataSourceResourcesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.ResourcesClient
+	client := meta.(*clients.Client).Resource().ResourcesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -92,7 +92,7 @@ func resourceTemplateDeployment() *schema.Resource {
 }
 
 func resourceTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.DeploymentsClient
+	client := meta.(*clients.Client).Resource().DeploymentsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -207,7 +207,7 @@ func resourceTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.DeploymentsClient
+	client := meta.(*clients.Client).Resource().DeploymentsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -273,7 +273,7 @@ func resourceTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.DeploymentsClient
+	client := meta.(*clients.Client).Resource().DeploymentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -153,7 +153,7 @@ func (t TemplateDeploymentResource) Exists(ctx context.Context, clients *clients
 		name = id.Path["Deployments"]
 	}
 
-	resp, err := clients.Resource.DeploymentsClient.Get(ctx, id.ResourceGroup, name)
+	resp, err := clients.Resource().DeploymentsClient.Get(ctx, id.ResourceGroup, name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Template Deployment (%s): %+v", id, err)
 	}
@@ -162,7 +162,7 @@ func (t TemplateDeploymentResource) Exists(ctx context.Context, clients *clients
 }
 
 func (r TemplateDeploymentResource) Destroy(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	client := clients.Resource.DeploymentsClient
+	client := clients.Resource().DeploymentsClient
 
 	id, err := azure.ParseAzureResourceID(state.ID)
 	if err != nil {
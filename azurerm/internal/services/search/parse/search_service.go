@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SearchServiceId struct {
@@ -67,3 +68,12 @@ func SearchServiceID(input string) (*SearchServiceId, error) {
 
 	return &resourceId, nil
 }
+
+// SearchServiceIDNormalized parses a SearchService ID into an SearchServiceId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SearchServiceID method should be used instead for validation etc.
+func SearchServiceIDNormalized(input string) (*SearchServiceId, error) {
+	return SearchServiceID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Search/searchServices/%s"))
+}
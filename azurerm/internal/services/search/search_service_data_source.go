@@ -100,7 +100,7 @@ func dataSourceSearchService() *schema.Resource {
 }
 
 func dataSourceSearchServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Search.ServicesClient
+	client := meta.(*clients.Client).Search().ServicesClient
 	subscriptionID := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -130,14 +130,14 @@ func dataSourceSearchServiceRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("public_network_access_enabled", props.PublicNetworkAccess != "Disabled")
 	}
 
-	adminKeysClient := meta.(*clients.Client).Search.AdminKeysClient
+	adminKeysClient := meta.(*clients.Client).Search().AdminKeysClient
 	adminKeysResp, err := adminKeysClient.Get(ctx, id.ResourceGroup, id.Name, nil)
 	if err == nil {
 		d.Set("primary_key", adminKeysResp.PrimaryKey)
 		d.Set("secondary_key", adminKeysResp.SecondaryKey)
 	}
 
-	queryKeysClient := meta.(*clients.Client).Search.QueryKeysClient
+	queryKeysClient := meta.(*clients.Client).Search().QueryKeysClient
 	queryKeysResp, err := queryKeysClient.ListBySearchService(ctx, id.ResourceGroup, id.Name, nil)
 	if err == nil {
 		d.Set("query_keys", flattenSearchQueryKeys(queryKeysResp.Values()))
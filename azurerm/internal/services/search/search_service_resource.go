@@ -156,7 +156,7 @@ func resourceSearchService() *schema.Resource {
 }
 
 func resourceSearchServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Search.ServicesClient
+	client := meta.(*clients.Client).Search().ServicesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -230,7 +230,7 @@ func resourceSearchServiceCreateUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceSearchServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Search.ServicesClient
+	client := meta.(*clients.Client).Search().ServicesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -274,14 +274,14 @@ func resourceSearchServiceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("allowed_ips", flattenSearchServiceIPRules(props.NetworkRuleSet))
 	}
 
-	adminKeysClient := meta.(*clients.Client).Search.AdminKeysClient
+	adminKeysClient := meta.(*clients.Client).Search().AdminKeysClient
 	adminKeysResp, err := adminKeysClient.Get(ctx, id.ResourceGroup, id.Name, nil)
 	if err == nil {
 		d.Set("primary_key", adminKeysResp.PrimaryKey)
 		d.Set("secondary_key", adminKeysResp.SecondaryKey)
 	}
 
-	queryKeysClient := meta.(*clients.Client).Search.QueryKeysClient
+	queryKeysClient := meta.(*clients.Client).Search().QueryKeysClient
 	queryKeysResp, err := queryKeysClient.ListBySearchService(ctx, id.ResourceGroup, id.Name, nil)
 	if err == nil {
 		d.Set("query_keys", flattenSearchQueryKeys(queryKeysResp.Values()))
@@ -295,7 +295,7 @@ func resourceSearchServiceRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSearchServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Search.ServicesClient
+	client := meta.(*clients.Client).Search().ServicesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -58,7 +58,7 @@ func resourceAdvancedThreatProtection() *schema.Resource {
 }
 
 func resourceAdvancedThreatProtectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.AdvancedThreatProtectionClient
+	client := meta.(*clients.Client).SecurityCenter().AdvancedThreatProtectionClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -91,7 +91,7 @@ func resourceAdvancedThreatProtectionCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceAdvancedThreatProtectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.AdvancedThreatProtectionClient
+	client := meta.(*clients.Client).SecurityCenter().AdvancedThreatProtectionClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -120,7 +120,7 @@ func resourceAdvancedThreatProtectionRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAdvancedThreatProtectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.AdvancedThreatProtectionClient
+	client := meta.(*clients.Client).SecurityCenter().AdvancedThreatProtectionClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -101,7 +101,7 @@ func (AdvancedThreatProtectionResource) Exists(ctx context.Context, clients *cli
 		return nil, err
 	}
 
-	resp, err := clients.SecurityCenter.AdvancedThreatProtectionClient.Get(ctx, id.TargetResourceID)
+	resp, err := clients.SecurityCenter().AdvancedThreatProtectionClient.Get(ctx, id.TargetResourceID)
 	if err != nil {
 		return nil, fmt.Errorf("reading Advanced Threat Protection (%s): %+v", id.TargetResourceID, err)
 	}
@@ -116,7 +116,7 @@ func checkAdvancedThreatProtectionIsFalse(ctx context.Context, clients *clients.
 		return err
 	}
 
-	resp, err := clients.SecurityCenter.AdvancedThreatProtectionClient.Get(ctx, id.TargetResourceID)
+	resp, err := clients.SecurityCenter().AdvancedThreatProtectionClient.Get(ctx, id.TargetResourceID)
 	if err != nil {
 		return fmt.Errorf("reading Advanced Threat Protection (%s): %+v", id.TargetResourceID, err)
 	}
@@ -139,7 +139,7 @@ func resourceIotSecurityDeviceGroup() *schema.Resource {
 }
 
 func resourceIotSecurityDeviceGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.DeviceSecurityGroupsClient
+	client := meta.(*clients.Client).SecurityCenter().DeviceSecurityGroupsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -177,7 +177,7 @@ func resourceIotSecurityDeviceGroupCreateUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceIotSecurityDeviceGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.DeviceSecurityGroupsClient
+	client := meta.(*clients.Client).SecurityCenter().DeviceSecurityGroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -212,7 +212,7 @@ func resourceIotSecurityDeviceGroupRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceIotSecurityDeviceGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.DeviceSecurityGroupsClient
+	client := meta.(*clients.Client).SecurityCenter().DeviceSecurityGroupsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
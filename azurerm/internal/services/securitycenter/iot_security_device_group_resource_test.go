@@ -97,7 +97,7 @@ func (DeviceSecurityGroupResource) Exists(ctx context.Context, clients *clients.
 		return nil, err
 	}
 
-	resp, err := clients.SecurityCenter.DeviceSecurityGroupsClient.Get(ctx, id.IotHubID, id.Name)
+	resp, err := clients.SecurityCenter().DeviceSecurityGroupsClient.Get(ctx, id.IotHubID, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Iot Security Device Group %q: %+v", id.ID(), err)
 	}
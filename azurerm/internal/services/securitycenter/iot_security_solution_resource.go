@@ -230,7 +230,7 @@ func resourceIotSecuritySolution() *schema.Resource {
 }
 
 func resourceIotSecuritySolutionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.IotSecuritySolutionClient
+	client := meta.(*clients.Client).SecurityCenter().IotSecuritySolutionClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -301,7 +301,7 @@ func resourceIotSecuritySolutionCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceIotSecuritySolutionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.IotSecuritySolutionClient
+	client := meta.(*clients.Client).SecurityCenter().IotSecuritySolutionClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -345,7 +345,7 @@ func resourceIotSecuritySolutionRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceIotSecuritySolutionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.IotSecuritySolutionClient
+	client := meta.(*clients.Client).SecurityCenter().IotSecuritySolutionClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AssessmentMetadataId struct {
@@ -59,3 +60,12 @@ func AssessmentMetadataID(input string) (*AssessmentMetadataId, error) {
 
 	return &resourceId, nil
 }
+
+// AssessmentMetadataIDNormalized parses a AssessmentMetadata ID into an AssessmentMetadataId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the AssessmentMetadataID method should be used instead for validation etc.
+func AssessmentMetadataIDNormalized(input string) (*AssessmentMetadataId, error) {
+	return AssessmentMetadataID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/providers/Microsoft.Security/assessmentMetadata/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type IotSecuritySolutionId struct {
@@ -67,3 +68,12 @@ func IotSecuritySolutionID(input string) (*IotSecuritySolutionId, error) {
 
 	return &resourceId, nil
 }
+
+// IotSecuritySolutionIDNormalized parses a IotSecuritySolution ID into an IotSecuritySolutionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the IotSecuritySolutionID method should be used instead for validation etc.
+func IotSecuritySolutionIDNormalized(input string) (*IotSecuritySolutionId, error) {
+	return IotSecuritySolutionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Security/IoTSecuritySolutions/%s"))
+}
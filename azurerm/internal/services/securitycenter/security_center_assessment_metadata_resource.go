@@ -118,7 +118,7 @@ func resourceArmSecurityCenterAssessmentMetadata() *schema.Resource {
 
 func resourceArmSecurityCenterAssessmentMetadataCreate(d *schema.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).SecurityCenter.AssessmentsMetadataClient
+	client := meta.(*clients.Client).SecurityCenter().AssessmentsMetadataClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -176,7 +176,7 @@ func resourceArmSecurityCenterAssessmentMetadataCreate(d *schema.ResourceData, m
 }
 
 func resourceArmSecurityCenterAssessmentMetadataRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.AssessmentsMetadataClient
+	client := meta.(*clients.Client).SecurityCenter().AssessmentsMetadataClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -218,7 +218,7 @@ func resourceArmSecurityCenterAssessmentMetadataRead(d *schema.ResourceData, met
 }
 
 func resourceArmSecurityCenterAssessmentMetadataUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.AssessmentsMetadataClient
+	client := meta.(*clients.Client).SecurityCenter().AssessmentsMetadataClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -275,7 +275,7 @@ func resourceArmSecurityCenterAssessmentMetadataUpdate(d *schema.ResourceData, m
 }
 
 func resourceArmSecurityCenterAssessmentMetadataDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.AssessmentsMetadataClient
+	client := meta.(*clients.Client).SecurityCenter().AssessmentsMetadataClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -69,7 +69,7 @@ func TestAccSecurityCenterAssessmentMetadata_update(t *testing.T) {
 }
 
 func (r SecurityCenterAssessmentMetadataResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	assessmentMetadataClient := client.SecurityCenter.AssessmentsMetadataClient
+	assessmentMetadataClient := client.SecurityCenter().AssessmentsMetadataClient
 	id, err := parse.AssessmentMetadataID(state.ID)
 	if err != nil {
 		return nil, err
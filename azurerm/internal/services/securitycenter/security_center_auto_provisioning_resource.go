@@ -50,7 +50,7 @@ func resourceSecurityCenterAutoProvisioning() *schema.Resource {
 }
 
 func resourceSecurityCenterAutoProvisioningUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.AutoProvisioningClient
+	client := meta.(*clients.Client).SecurityCenter().AutoProvisioningClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -83,7 +83,7 @@ func resourceSecurityCenterAutoProvisioningUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceSecurityCenterAutoProvisioningRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.AutoProvisioningClient
+	client := meta.(*clients.Client).SecurityCenter().AutoProvisioningClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -109,7 +109,7 @@ func resourceSecurityCenterAutoProvisioningDelete(d *schema.ResourceData, meta i
 	// The API has no delete operation
 	// Instead we reset back to 'Off' which is the default
 
-	client := meta.(*clients.Client).SecurityCenter.AutoProvisioningClient
+	client := meta.(*clients.Client).SecurityCenter().AutoProvisioningClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -44,7 +44,7 @@ func TestAccSecurityCenterAutoProvision_update(t *testing.T) {
 func (SecurityCenterAutoProvisionResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	securityCenterAutoProvisioningName := "default"
 
-	resp, err := clients.SecurityCenter.AutoProvisioningClient.Get(ctx, securityCenterAutoProvisioningName)
+	resp, err := clients.SecurityCenter().AutoProvisioningClient.Get(ctx, securityCenterAutoProvisioningName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Security Center auto provision (%s): %+v", securityCenterAutoProvisioningName, err)
 	}
@@ -195,7 +195,7 @@ func resourceSecurityCenterAutomation() *schema.Resource {
 }
 
 func resourceSecurityCenterAutomationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.AutomationsClient
+	client := meta.(*clients.Client).SecurityCenter().AutomationsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -253,7 +253,7 @@ func resourceSecurityCenterAutomationCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceSecurityCenterAutomationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.AutomationsClient
+	client := meta.(*clients.Client).SecurityCenter().AutomationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -315,7 +315,7 @@ func resourceSecurityCenterAutomationRead(d *schema.ResourceData, meta interface
 }
 
 func resourceSecurityCenterAutomationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.AutomationsClient
+	client := meta.(*clients.Client).SecurityCenter().AutomationsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -213,7 +213,7 @@ func (t SecurityCenterAutomationResource) Exists(ctx context.Context, clients *c
 		return nil, err
 	}
 
-	resp, err := clients.SecurityCenter.AutomationsClient.Get(ctx, id.ResourceGroup, id.AutomationName)
+	resp, err := clients.SecurityCenter().AutomationsClient.Get(ctx, id.ResourceGroup, id.AutomationName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Security Center automation %q (resource group: %q): %v", id.AutomationName, id.ResourceGroup, err)
 	}
@@ -66,7 +66,7 @@ func resourceSecurityCenterContact() *schema.Resource {
 }
 
 func resourceSecurityCenterContactCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.ContactsClient
+	client := meta.(*clients.Client).SecurityCenter().ContactsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -128,7 +128,7 @@ func resourceSecurityCenterContactCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceSecurityCenterContactRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.ContactsClient
+	client := meta.(*clients.Client).SecurityCenter().ContactsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -156,7 +156,7 @@ func resourceSecurityCenterContactRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceSecurityCenterContactDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.ContactsClient
+	client := meta.(*clients.Client).SecurityCenter().ContactsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -118,7 +118,7 @@ func testAccSecurityCenterContact_phoneOptional(t *testing.T) {
 func (SecurityCenterContactResource) Exists(ctx context.Context, clients *clients.Client, _ *terraform.InstanceState) (*bool, error) {
 	contactName := "default1"
 
-	resp, err := clients.SecurityCenter.ContactsClient.Get(ctx, contactName)
+	resp, err := clients.SecurityCenter().ContactsClient.Get(ctx, contactName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Security Center Subscription Contact (%s): %+v", contactName, err)
 	}
@@ -50,7 +50,7 @@ func resourceSecurityCenterSetting() *schema.Resource {
 }
 
 func resourceSecurityCenterSettingUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.SettingClient
+	client := meta.(*clients.Client).SecurityCenter().SettingClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -83,7 +83,7 @@ func resourceSecurityCenterSettingUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceSecurityCenterSettingRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.SettingClient
+	client := meta.(*clients.Client).SecurityCenter().SettingClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -68,7 +68,7 @@ func (SecurityCenterSettingResource) Exists(ctx context.Context, clients *client
 		return nil, err
 	}
 
-	resp, err := clients.SecurityCenter.SettingClient.Get(ctx, id.SettingName)
+	resp, err := clients.SecurityCenter().SettingClient.Get(ctx, id.SettingName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Security Center Setting (%s): %+v", id.SettingName, err)
 	}
@@ -74,7 +74,7 @@ func resourceSecurityCenterSubscriptionPricing() *schema.Resource {
 }
 
 func resourceSecurityCenterSubscriptionPricingUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.PricingClient
+	client := meta.(*clients.Client).SecurityCenter().PricingClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -107,7 +107,7 @@ func resourceSecurityCenterSubscriptionPricingUpdate(d *schema.ResourceData, met
 }
 
 func resourceSecurityCenterSubscriptionPricingRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.PricingClient
+	client := meta.(*clients.Client).SecurityCenter().PricingClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -48,7 +48,7 @@ func (SecurityCenterSubscriptionPricingResource) Exists(ctx context.Context, cli
 		return nil, err
 	}
 
-	resp, err := clients.SecurityCenter.PricingClient.Get(ctx, id.ResourceType)
+	resp, err := clients.SecurityCenter().PricingClient.Get(ctx, id.ResourceType)
 	if err != nil {
 		return nil, fmt.Errorf("reading Security Center Subscription Pricing (%s): %+v", id.ResourceType, err)
 	}
@@ -58,8 +58,8 @@ func resourceSecurityCenterWorkspace() *schema.Resource {
 }
 
 func resourceSecurityCenterWorkspaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	priceClient := meta.(*clients.Client).SecurityCenter.PricingClient
-	client := meta.(*clients.Client).SecurityCenter.WorkspaceClient
+	priceClient := meta.(*clients.Client).SecurityCenter().PricingClient
+	client := meta.(*clients.Client).SecurityCenter().WorkspaceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -170,7 +170,7 @@ func isPricingStandard(ctx context.Context, priceClient *security.PricingsClient
 }
 
 func resourceSecurityCenterWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.WorkspaceClient
+	client := meta.(*clients.Client).SecurityCenter().WorkspaceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -202,7 +202,7 @@ func resourceSecurityCenterWorkspaceRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceSecurityCenterWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.WorkspaceClient
+	client := meta.(*clients.Client).SecurityCenter().WorkspaceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
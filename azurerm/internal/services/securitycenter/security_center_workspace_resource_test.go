@@ -94,7 +94,7 @@ func testAccSecurityCenterWorkspace_update(t *testing.T) {
 func (SecurityCenterWorkspaceResource) Exists(ctx context.Context, clients *clients.Client, _ *terraform.InstanceState) (*bool, error) {
 	workspaceSettingName := "default"
 
-	resp, err := clients.SecurityCenter.WorkspaceClient.Get(ctx, workspaceSettingName)
+	resp, err := clients.SecurityCenter().WorkspaceClient.Get(ctx, workspaceSettingName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Security Center Subscription Workspace Rule Set (%s): %+v", workspaceSettingName, err)
 	}
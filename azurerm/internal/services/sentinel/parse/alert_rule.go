@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AlertRuleId struct {
@@ -73,3 +74,12 @@ func AlertRuleID(input string) (*AlertRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// AlertRuleIDNormalized parses a AlertRule ID into an AlertRuleId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the AlertRuleID method should be used instead for validation etc.
+func AlertRuleIDNormalized(input string) (*AlertRuleId, error) {
+	return AlertRuleID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/providers/Microsoft.SecurityInsights/alertRules/%s"))
+}
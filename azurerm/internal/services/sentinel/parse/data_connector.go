@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DataConnectorId struct {
@@ -73,3 +74,12 @@ func DataConnectorID(input string) (*DataConnectorId, error) {
 
 	return &resourceId, nil
 }
+
+// DataConnectorIDNormalized parses a DataConnector ID into an DataConnectorId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the DataConnectorID method should be used instead for validation etc.
+func DataConnectorIDNormalized(input string) (*DataConnectorId, error) {
+	return DataConnectorID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/providers/Microsoft.SecurityInsights/dataConnectors/%s"))
+}
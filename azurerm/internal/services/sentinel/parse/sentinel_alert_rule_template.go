@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SentinelAlertRuleTemplateId struct {
@@ -73,3 +74,12 @@ func SentinelAlertRuleTemplateID(input string) (*SentinelAlertRuleTemplateId, er
 
 	return &resourceId, nil
 }
+
+// SentinelAlertRuleTemplateIDNormalized parses a SentinelAlertRuleTemplate ID into an SentinelAlertRuleTemplateId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SentinelAlertRuleTemplateID method should be used instead for validation etc.
+func SentinelAlertRuleTemplateIDNormalized(input string) (*SentinelAlertRuleTemplateId, error) {
+	return SentinelAlertRuleTemplateID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/providers/Microsoft.SecurityInsights/AlertRuleTemplates/%s"))
+}
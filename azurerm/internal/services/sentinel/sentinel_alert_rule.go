@@ -33,7 +33,7 @@ func importSentinelAlertRule(expectKind securityinsight.AlertRuleKind) func(d *s
 			return nil, err
 		}
 
-		client := meta.(*clients.Client).Sentinel.AlertRulesClient
+		client := meta.(*clients.Client).Sentinel().AlertRulesClient
 		ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 		defer cancel()
 
@@ -40,7 +40,7 @@ func dataSourceSentinelAlertRule() *schema.Resource {
 }
 
 func dataSourceSentinelAlertRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.AlertRulesClient
+	client := meta.(*clients.Client).Sentinel().AlertRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -69,7 +69,7 @@ func resourceSentinelAlertRuleFusion() *schema.Resource {
 }
 
 func resourceSentinelAlertRuleFusionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.AlertRulesClient
+	client := meta.(*clients.Client).Sentinel().AlertRulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -126,7 +126,7 @@ func resourceSentinelAlertRuleFusionCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceSentinelAlertRuleFusionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.AlertRulesClient
+	client := meta.(*clients.Client).Sentinel().AlertRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -165,7 +165,7 @@ func resourceSentinelAlertRuleFusionRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceSentinelAlertRuleFusionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.AlertRulesClient
+	client := meta.(*clients.Client).Sentinel().AlertRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
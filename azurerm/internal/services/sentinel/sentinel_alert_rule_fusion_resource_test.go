@@ -93,7 +93,7 @@ func TestAccSentinelAlertRuleFusion_requiresImport(t *testing.T) {
 }
 
 func (r SentinelAlertRuleFusionResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	alertRuleClient := client.Sentinel.AlertRulesClient
+	alertRuleClient := client.Sentinel().AlertRulesClient
 	id, err := parse.AlertRuleID(state.ID)
 	if err != nil {
 		return nil, err
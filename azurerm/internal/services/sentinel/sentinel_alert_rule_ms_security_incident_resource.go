@@ -145,7 +145,7 @@ func resourceSentinelAlertRuleMsSecurityIncident() *schema.Resource {
 }
 
 func resourceSentinelAlertRuleMsSecurityIncidentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.AlertRulesClient
+	client := meta.(*clients.Client).Sentinel().AlertRulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -218,7 +218,7 @@ func resourceSentinelAlertRuleMsSecurityIncidentCreateUpdate(d *schema.ResourceD
 }
 
 func resourceSentinelAlertRuleMsSecurityIncidentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.AlertRulesClient
+	client := meta.(*clients.Client).Sentinel().AlertRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -272,7 +272,7 @@ func resourceSentinelAlertRuleMsSecurityIncidentRead(d *schema.ResourceData, met
 }
 
 func resourceSentinelAlertRuleMsSecurityIncidentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.AlertRulesClient
+	client := meta.(*clients.Client).Sentinel().AlertRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
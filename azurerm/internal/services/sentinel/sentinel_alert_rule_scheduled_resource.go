@@ -250,7 +250,7 @@ func resourceSentinelAlertRuleScheduled() *schema.Resource {
 }
 
 func resourceSentinelAlertRuleScheduledCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.AlertRulesClient
+	client := meta.(*clients.Client).Sentinel().AlertRulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -347,7 +347,7 @@ func resourceSentinelAlertRuleScheduledCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceSentinelAlertRuleScheduledRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.AlertRulesClient
+	client := meta.(*clients.Client).Sentinel().AlertRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -412,7 +412,7 @@ func resourceSentinelAlertRuleScheduledRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceSentinelAlertRuleScheduledDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.AlertRulesClient
+	client := meta.(*clients.Client).Sentinel().AlertRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
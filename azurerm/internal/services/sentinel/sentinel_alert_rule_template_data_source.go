@@ -112,7 +112,7 @@ func dataSourceSentinelAlertRuleTemplate() *schema.Resource {
 }
 
 func dataSourceSentinelAlertRuleTemplateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.AlertRuleTemplatesClient
+	client := meta.(*clients.Client).Sentinel().AlertRuleTemplatesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
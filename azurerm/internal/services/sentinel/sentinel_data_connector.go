@@ -17,7 +17,7 @@ func importSentinelDataConnector(expectKind securityinsight.DataConnectorKind) f
 			return nil, err
 		}
 
-		client := meta.(*clients.Client).Sentinel.DataConnectorsClient
+		client := meta.(*clients.Client).Sentinel().DataConnectorsClient
 		ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 		defer cancel()
 
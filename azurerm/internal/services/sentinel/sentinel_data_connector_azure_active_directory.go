@@ -62,7 +62,7 @@ func resourceSentinelDataConnectorAzureActiveDirectory() *schema.Resource {
 }
 
 func resourceSentinelDataConnectorAzureActiveDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.DataConnectorsClient
+	client := meta.(*clients.Client).Sentinel().DataConnectorsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -114,7 +114,7 @@ func resourceSentinelDataConnectorAzureActiveDirectoryCreate(d *schema.ResourceD
 }
 
 func resourceSentinelDataConnectorAzureActiveDirectoryRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.DataConnectorsClient
+	client := meta.(*clients.Client).Sentinel().DataConnectorsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -148,7 +148,7 @@ func resourceSentinelDataConnectorAzureActiveDirectoryRead(d *schema.ResourceDat
 }
 
 func resourceSentinelDataConnectorAzureActiveDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.DataConnectorsClient
+	client := meta.(*clients.Client).Sentinel().DataConnectorsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -65,7 +65,7 @@ func TestAccAzureRMSentinelDataConnectorAzureActiveDirectory_requiresImport(t *t
 }
 
 func (r SentinelDataConnectorAzureActiveDirectoryResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	client := clients.Sentinel.DataConnectorsClient
+	client := clients.Sentinel().DataConnectorsClient
 
 	id, err := parse.DataConnectorID(state.ID)
 	if err != nil {
@@ -83,7 +83,7 @@ func resourceSentinelDataConnectorOffice365() *schema.Resource {
 }
 
 func resourceSentinelDataConnectorOffice365CreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.DataConnectorsClient
+	client := meta.(*clients.Client).Sentinel().DataConnectorsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -182,7 +182,7 @@ func resourceSentinelDataConnectorOffice365CreateUpdate(d *schema.ResourceData,
 }
 
 func resourceSentinelDataConnectorOffice365Read(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.DataConnectorsClient
+	client := meta.(*clients.Client).Sentinel().DataConnectorsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -236,7 +236,7 @@ func resourceSentinelDataConnectorOffice365Read(d *schema.ResourceData, meta int
 }
 
 func resourceSentinelDataConnectorOffice365Delete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sentinel.DataConnectorsClient
+	client := meta.(*clients.Client).Sentinel().DataConnectorsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
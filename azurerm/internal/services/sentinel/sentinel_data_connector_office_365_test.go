@@ -115,7 +115,7 @@ func TestAccAzureRMSentinelDataConnectorOffice365_requiresImport(t *testing.T) {
 }
 
 func (r SentinelDataConnectorOffice365Resource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	client := clients.Sentinel.DataConnectorsClient
+	client := clients.Sentinel().DataConnectorsClient
 
 	id, err := parse.DataConnectorID(state.ID)
 	if err != nil {
@@ -65,7 +65,7 @@ func TestAccAzureRMSentinelDataConnectorThreatIntelligence_requiresImport(t *tes
 }
 
 func (r SentinelDataConnectorThreatIntelligenceResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	client := clients.Sentinel.DataConnectorsClient
+	client := clients.Sentinel().DataConnectorsClient
 
 	id, err := parse.DataConnectorID(state.ID)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NamespaceAuthorizationRuleId struct {
@@ -73,3 +74,12 @@ func NamespaceAuthorizationRuleID(input string) (*NamespaceAuthorizationRuleId,
 
 	return &resourceId, nil
 }
+
+// NamespaceAuthorizationRuleIDNormalized parses a NamespaceAuthorizationRule ID into an NamespaceAuthorizationRuleId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the NamespaceAuthorizationRuleID method should be used instead for validation etc.
+func NamespaceAuthorizationRuleIDNormalized(input string) (*NamespaceAuthorizationRuleId, error) {
+	return NamespaceAuthorizationRuleID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/AuthorizationRules/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NamespaceNetworkRuleSetId struct {
@@ -73,3 +74,12 @@ func NamespaceNetworkRuleSetID(input string) (*NamespaceNetworkRuleSetId, error)
 
 	return &resourceId, nil
 }
+
+// NamespaceNetworkRuleSetIDNormalized parses a NamespaceNetworkRuleSet ID into an NamespaceNetworkRuleSetId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the NamespaceNetworkRuleSetID method should be used instead for validation etc.
+func NamespaceNetworkRuleSetIDNormalized(input string) (*NamespaceNetworkRuleSetId, error) {
+	return NamespaceNetworkRuleSetID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/networkrulesets/%s"))
+}
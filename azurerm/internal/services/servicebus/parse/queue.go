@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type QueueId struct {
@@ -73,3 +74,12 @@ func QueueID(input string) (*QueueId, error) {
 
 	return &resourceId, nil
 }
+
+// QueueIDNormalized parses a Queue ID into an QueueId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the QueueID method should be used instead for validation etc.
+func QueueIDNormalized(input string) (*QueueId, error) {
+	return QueueID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/queues/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type QueueAuthorizationRuleId struct {
@@ -79,3 +80,12 @@ func QueueAuthorizationRuleID(input string) (*QueueAuthorizationRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// QueueAuthorizationRuleIDNormalized parses a QueueAuthorizationRule ID into an QueueAuthorizationRuleId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the QueueAuthorizationRuleID method should be used instead for validation etc.
+func QueueAuthorizationRuleIDNormalized(input string) (*QueueAuthorizationRuleId, error) {
+	return QueueAuthorizationRuleID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/queues/%s/authorizationRules/%s"))
+}
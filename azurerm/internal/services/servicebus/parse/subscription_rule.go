@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SubscriptionRuleId struct {
@@ -85,3 +86,12 @@ func SubscriptionRuleID(input string) (*SubscriptionRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// SubscriptionRuleIDNormalized parses a SubscriptionRule ID into an SubscriptionRuleId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SubscriptionRuleID method should be used instead for validation etc.
+func SubscriptionRuleIDNormalized(input string) (*SubscriptionRuleId, error) {
+	return SubscriptionRuleID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/topics/%s/subscriptions/%s/rules/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type TopicAuthorizationRuleId struct {
@@ -79,3 +80,12 @@ func TopicAuthorizationRuleID(input string) (*TopicAuthorizationRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// TopicAuthorizationRuleIDNormalized parses a TopicAuthorizationRule ID into an TopicAuthorizationRuleId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the TopicAuthorizationRuleID method should be used instead for validation etc.
+func TopicAuthorizationRuleIDNormalized(input string) (*TopicAuthorizationRuleId, error) {
+	return TopicAuthorizationRuleID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s/topics/%s/authorizationRules/%s"))
+}
@@ -63,7 +63,7 @@ func dataSourceServiceBusNamespaceAuthorizationRule() *schema.Resource {
 }
 
 func dataSourceServiceBusNamespaceAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.NamespacesClient
+	client := meta.(*clients.Client).ServiceBus().NamespacesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -108,7 +108,7 @@ func (t ServiceBusNamespaceAuthorizationRuleResource) Exists(ctx context.Context
 		return nil, err
 	}
 
-	resp, err := clients.ServiceBus.NamespacesClient.GetAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.AuthorizationRuleName)
+	resp, err := clients.ServiceBus().NamespacesClient.GetAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.AuthorizationRuleName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Service Bus Name Space Authorization Rule (%s): %+v", id.String(), err)
 	}
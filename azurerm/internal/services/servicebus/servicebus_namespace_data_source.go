@@ -81,8 +81,8 @@ func dataSourceServiceBusNamespace() *schema.Resource {
 }
 
 func dataSourceServiceBusNamespaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.NamespacesClientPreview
-	clientStable := meta.(*clients.Client).ServiceBus.NamespacesClient
+	client := meta.(*clients.Client).ServiceBus().NamespacesClientPreview
+	clientStable := meta.(*clients.Client).ServiceBus().NamespacesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
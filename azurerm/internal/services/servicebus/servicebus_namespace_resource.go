@@ -117,7 +117,7 @@ func resourceServiceBusNamespace() *schema.Resource {
 }
 
 func resourceServiceBusNamespaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.NamespacesClientPreview
+	client := meta.(*clients.Client).ServiceBus().NamespacesClientPreview
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -178,8 +178,8 @@ func resourceServiceBusNamespaceCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceServiceBusNamespaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.NamespacesClientPreview
-	clientStable := meta.(*clients.Client).ServiceBus.NamespacesClient
+	client := meta.(*clients.Client).ServiceBus().NamespacesClientPreview
+	clientStable := meta.(*clients.Client).ServiceBus().NamespacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -224,7 +224,7 @@ func resourceServiceBusNamespaceRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceServiceBusNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.NamespacesClientPreview
+	client := meta.(*clients.Client).ServiceBus().NamespacesClientPreview
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -146,7 +146,7 @@ func (t ServiceBusNamespaceResource) Exists(ctx context.Context, clients *client
 		return nil, err
 	}
 
-	resp, err := clients.ServiceBus.NamespacesClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.ServiceBus().NamespacesClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Service Bus NameSpace (%s): %+v", id.String(), err)
 	}
@@ -85,7 +85,7 @@ func dataSourceServiceBusQueueAuthorizationRule() *schema.Resource {
 }
 
 func dataSourceServiceBusQueueAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.QueuesClient
+	client := meta.(*clients.Client).ServiceBus().QueuesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -66,7 +66,7 @@ func resourceServiceBusQueueAuthorizationRule() *schema.Resource {
 }
 
 func resourceServiceBusQueueAuthorizationRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.QueuesClient
+	client := meta.(*clients.Client).ServiceBus().QueuesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -103,7 +103,7 @@ func resourceServiceBusQueueAuthorizationRuleCreateUpdate(d *schema.ResourceData
 }
 
 func resourceServiceBusQueueAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.QueuesClient
+	client := meta.(*clients.Client).ServiceBus().QueuesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -148,7 +148,7 @@ func resourceServiceBusQueueAuthorizationRuleRead(d *schema.ResourceData, meta i
 }
 
 func resourceServiceBusQueueAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.QueuesClient
+	client := meta.(*clients.Client).ServiceBus().QueuesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
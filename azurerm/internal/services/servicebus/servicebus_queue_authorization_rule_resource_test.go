@@ -120,7 +120,7 @@ func (t ServiceBusQueueAuthorizationRuleResource) Exists(ctx context.Context, cl
 		return nil, err
 	}
 
-	resp, err := clients.ServiceBus.QueuesClient.GetAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.QueueName, id.AuthorizationRuleName)
+	resp, err := clients.ServiceBus().QueuesClient.GetAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.QueueName, id.AuthorizationRuleName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Service Bus NameSpace Queue Authorization Rule (%s): %+v", id.String(), err)
 	}
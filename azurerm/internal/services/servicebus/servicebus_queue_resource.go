@@ -169,7 +169,7 @@ func resourceServiceBusQueue() *schema.Resource {
 }
 
 func resourceServiceBusQueueCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.QueuesClient
+	client := meta.(*clients.Client).ServiceBus().QueuesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -240,7 +240,7 @@ func resourceServiceBusQueueCreateUpdate(d *schema.ResourceData, meta interface{
 
 	// We need to retrieve the namespace because Premium namespace works differently from Basic and Standard,
 	// so it needs different rules applied to it.
-	namespacesClient := meta.(*clients.Client).ServiceBus.NamespacesClient
+	namespacesClient := meta.(*clients.Client).ServiceBus().NamespacesClient
 	namespace, err := namespacesClient.Get(ctx, resourceId.ResourceGroup, resourceId.NamespaceName)
 	if err != nil {
 		return fmt.Errorf("retrieving ServiceBus Namespace %q (Resource Group %q): %+v", resourceId.NamespaceName, resourceId.ResourceGroup, err)
@@ -261,7 +261,7 @@ func resourceServiceBusQueueCreateUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceServiceBusQueueRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.QueuesClient
+	client := meta.(*clients.Client).ServiceBus().QueuesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -305,7 +305,7 @@ func resourceServiceBusQueueRead(d *schema.ResourceData, meta interface{}) error
 			// If the queue is NOT in a premium namespace (ie. it is Basic or Standard) and partitioning is enabled
 			// then the max size returned by the API will be 16 times greater than the value set.
 			if *props.EnablePartitioning {
-				namespacesClient := meta.(*clients.Client).ServiceBus.NamespacesClient
+				namespacesClient := meta.(*clients.Client).ServiceBus().NamespacesClient
 				namespace, err := namespacesClient.Get(ctx, id.ResourceGroup, id.NamespaceName)
 				if err != nil {
 					return err
@@ -325,7 +325,7 @@ func resourceServiceBusQueueRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceServiceBusQueueDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.QueuesClient
+	client := meta.(*clients.Client).ServiceBus().QueuesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -348,7 +348,7 @@ func (t ServiceBusQueueResource) Exists(ctx context.Context, clients *clients.Cl
 		return nil, err
 	}
 
-	resp, err := clients.ServiceBus.QueuesClient.Get(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
+	resp, err := clients.ServiceBus().QueuesClient.Get(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Service Bus NameSpace Queue (%s): %+v", id.String(), err)
 	}
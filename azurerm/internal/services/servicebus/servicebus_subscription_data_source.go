@@ -95,7 +95,7 @@ func dataSourceServiceBusSubscription() *schema.Resource {
 }
 
 func dataSourceServiceBusSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.SubscriptionsClient
+	client := meta.(*clients.Client).ServiceBus().SubscriptionsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -132,7 +132,7 @@ func resourceServiceBusSubscription() *schema.Resource {
 }
 
 func resourceServiceBusSubscriptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.SubscriptionsClient
+	client := meta.(*clients.Client).ServiceBus().SubscriptionsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -192,7 +192,7 @@ func resourceServiceBusSubscriptionCreateUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceServiceBusSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.SubscriptionsClient
+	client := meta.(*clients.Client).ServiceBus().SubscriptionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -236,7 +236,7 @@ func resourceServiceBusSubscriptionRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceServiceBusSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.SubscriptionsClient
+	client := meta.(*clients.Client).ServiceBus().SubscriptionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -145,7 +145,7 @@ func resourceServiceBusSubscriptionRule() *schema.Resource {
 }
 
 func resourceServiceBusSubscriptionRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.SubscriptionRulesClient
+	client := meta.(*clients.Client).ServiceBus().SubscriptionRulesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -209,7 +209,7 @@ func resourceServiceBusSubscriptionRuleCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceServiceBusSubscriptionRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.SubscriptionRulesClient
+	client := meta.(*clients.Client).ServiceBus().SubscriptionRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -253,7 +253,7 @@ func resourceServiceBusSubscriptionRuleRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceServiceBusSubscriptionRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.SubscriptionRulesClient
+	client := meta.(*clients.Client).ServiceBus().SubscriptionRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -161,7 +161,7 @@ func (t ServiceBusSubscriptionRuleResource) Exists(ctx context.Context, clients
 		return nil, err
 	}
 
-	resp, err := clients.ServiceBus.SubscriptionRulesClient.Get(ctx, id.ResourceGroup, id.NamespaceName, id.TopicName, id.SubscriptionName, id.RuleName)
+	resp, err := clients.ServiceBus().SubscriptionRulesClient.Get(ctx, id.ResourceGroup, id.NamespaceName, id.TopicName, id.SubscriptionName, id.RuleName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Service Bus NameSpace Subscription Rule (%s): %+v", id.String(), err)
 	}
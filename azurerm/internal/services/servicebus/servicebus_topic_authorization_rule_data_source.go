@@ -85,7 +85,7 @@ func dataSourceServiceBusTopicAuthorizationRule() *schema.Resource {
 }
 
 func dataSourceServiceBusTopicAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.TopicsClient
+	client := meta.(*clients.Client).ServiceBus().TopicsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -66,7 +66,7 @@ func resourceServiceBusTopicAuthorizationRule() *schema.Resource {
 }
 
 func resourceServiceBusTopicAuthorizationRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.TopicsClient
+	client := meta.(*clients.Client).ServiceBus().TopicsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -102,7 +102,7 @@ func resourceServiceBusTopicAuthorizationRuleCreateUpdate(d *schema.ResourceData
 }
 
 func resourceServiceBusTopicAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.TopicsClient
+	client := meta.(*clients.Client).ServiceBus().TopicsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -146,7 +146,7 @@ func resourceServiceBusTopicAuthorizationRuleRead(d *schema.ResourceData, meta i
 }
 
 func resourceServiceBusTopicAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.TopicsClient
+	client := meta.(*clients.Client).ServiceBus().TopicsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -119,7 +119,7 @@ func (t ServiceBusTopicAuthorizationRuleResource) Exists(ctx context.Context, cl
 		return nil, err
 	}
 
-	resp, err := clients.ServiceBus.TopicsClient.GetAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.TopicName, id.AuthorizationRuleName)
+	resp, err := clients.ServiceBus().TopicsClient.GetAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.TopicName, id.AuthorizationRuleName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Service Bus Topic Authorization Rule (%s): %+v", id.String(), err)
 	}
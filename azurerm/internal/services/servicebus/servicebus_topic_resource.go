@@ -126,7 +126,7 @@ func resourceServiceBusTopic() *schema.Resource {
 }
 
 func resourceServiceBusTopicCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.TopicsClient
+	client := meta.(*clients.Client).ServiceBus().TopicsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ServiceBus Topic creation.")
@@ -189,7 +189,7 @@ func resourceServiceBusTopicCreateUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceServiceBusTopicRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.TopicsClient
+	client := meta.(*clients.Client).ServiceBus().TopicsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -232,7 +232,7 @@ func resourceServiceBusTopicRead(d *schema.ResourceData, meta interface{}) error
 			// if the topic is in a premium namespace and partitioning is enabled then the
 			// max size returned by the API will be 16 times greater than the value set
 			if partitioning := props.EnablePartitioning; partitioning != nil && *partitioning {
-				namespacesClient := meta.(*clients.Client).ServiceBus.NamespacesClient
+				namespacesClient := meta.(*clients.Client).ServiceBus().NamespacesClient
 				namespace, err := namespacesClient.Get(ctx, id.ResourceGroup, id.NamespaceName)
 				if err != nil {
 					return err
@@ -252,7 +252,7 @@ func resourceServiceBusTopicRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceServiceBusTopicDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.TopicsClient
+	client := meta.(*clients.Client).ServiceBus().TopicsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
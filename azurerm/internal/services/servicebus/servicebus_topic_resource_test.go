@@ -204,7 +204,7 @@ func (t ServiceBusTopicResource) Exists(ctx context.Context, clients *clients.Cl
 		return nil, err
 	}
 
-	resp, err := clients.ServiceBus.TopicsClient.Get(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
+	resp, err := clients.ServiceBus().TopicsClient.Get(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading Service Bus Topic (%s): %+v", id.String(), err)
 	}
@@ -533,7 +533,7 @@ func resourceServiceFabricCluster() *schema.Resource {
 }
 
 func resourceServiceFabricClusterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceFabric.ClustersClient
+	client := meta.(*clients.Client).ServiceFabric().ClustersClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -646,7 +646,7 @@ func resourceServiceFabricClusterCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceServiceFabricClusterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceFabric.ClustersClient
+	client := meta.(*clients.Client).ServiceFabric().ClustersClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -745,7 +745,7 @@ func resourceServiceFabricClusterRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceServiceFabricClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceFabric.ClustersClient
+	client := meta.(*clients.Client).ServiceFabric().ClustersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
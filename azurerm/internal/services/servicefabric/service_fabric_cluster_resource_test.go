@@ -724,7 +724,7 @@ func (r ServiceFabricClusterResource) Exists(ctx context.Context, client *client
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.ServiceFabric.ClustersClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.ServiceFabric().ClustersClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NetworkId struct {
@@ -67,3 +68,12 @@ func NetworkID(input string) (*NetworkId, error) {
 
 	return &resourceId, nil
 }
+
+// NetworkIDNormalized parses a Network ID into an NetworkId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the NetworkID method should be used instead for validation etc.
+func NetworkIDNormalized(input string) (*NetworkId, error) {
+	return NetworkID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceFabricMesh/networks/%s"))
+}
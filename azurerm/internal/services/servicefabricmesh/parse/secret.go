@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SecretId struct {
@@ -67,3 +68,12 @@ func SecretID(input string) (*SecretId, error) {
 
 	return &resourceId, nil
 }
+
+// SecretIDNormalized parses a Secret ID into an SecretId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SecretID method should be used instead for validation etc.
+func SecretIDNormalized(input string) (*SecretId, error) {
+	return SecretID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceFabricMesh/secrets/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SecretValueId struct {
@@ -73,3 +74,12 @@ func SecretValueID(input string) (*SecretValueId, error) {
 
 	return &resourceId, nil
 }
+
+// SecretValueIDNormalized parses a SecretValue ID into an SecretValueId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SecretValueID method should be used instead for validation etc.
+func SecretValueIDNormalized(input string) (*SecretValueId, error) {
+	return SecretValueID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceFabricMesh/secrets/%s/values/%s"))
+}
@@ -148,7 +148,7 @@ func resourceServiceFabricMeshApplication() *schema.Resource {
 }
 
 func resourceServiceFabricMeshApplicationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceFabricMesh.ApplicationClient
+	client := meta.(*clients.Client).ServiceFabricMesh().ApplicationClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -207,8 +207,8 @@ func resourceServiceFabricMeshApplicationCreateUpdate(d *schema.ResourceData, me
 }
 
 func resourceServiceFabricMeshApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceFabricMesh.ApplicationClient
-	serviceClient := meta.(*clients.Client).ServiceFabricMesh.ServiceClient
+	client := meta.(*clients.Client).ServiceFabricMesh().ApplicationClient
+	serviceClient := meta.(*clients.Client).ServiceFabricMesh().ServiceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -250,7 +250,7 @@ func resourceServiceFabricMeshApplicationRead(d *schema.ResourceData, meta inter
 }
 
 func resourceServiceFabricMeshApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceFabricMesh.ApplicationClient
+	client := meta.(*clients.Client).ServiceFabricMesh().ApplicationClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -65,7 +65,7 @@ func (r ServiceFabricMeshApplicationResource) Exists(ctx context.Context, client
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.ServiceFabricMesh.ApplicationClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.ServiceFabricMesh().ApplicationClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
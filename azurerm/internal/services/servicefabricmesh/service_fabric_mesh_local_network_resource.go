@@ -71,7 +71,7 @@ func resourceServiceFabricMeshLocalNetwork() *schema.Resource {
 }
 
 func resourceServiceFabricMeshLocalNetworkCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceFabricMesh.NetworkClient
+	client := meta.(*clients.Client).ServiceFabricMesh().NetworkClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -122,7 +122,7 @@ func resourceServiceFabricMeshLocalNetworkCreateUpdate(d *schema.ResourceData, m
 }
 
 func resourceServiceFabricMeshLocalNetworkRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceFabricMesh.NetworkClient
+	client := meta.(*clients.Client).ServiceFabricMesh().NetworkClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -157,7 +157,7 @@ func resourceServiceFabricMeshLocalNetworkRead(d *schema.ResourceData, meta inte
 }
 
 func resourceServiceFabricMeshLocalNetworkDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceFabricMesh.NetworkClient
+	client := meta.(*clients.Client).ServiceFabricMesh().NetworkClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
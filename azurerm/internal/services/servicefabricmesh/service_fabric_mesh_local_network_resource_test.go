@@ -65,7 +65,7 @@ func (r ServiceFabricMeshLocalNetworkResource) Exists(ctx context.Context, clien
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.ServiceFabricMesh.NetworkClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.ServiceFabricMesh().NetworkClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
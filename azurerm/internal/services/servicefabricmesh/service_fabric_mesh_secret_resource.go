@@ -72,7 +72,7 @@ func resourceServiceFabricMeshSecret() *schema.Resource {
 }
 
 func resourceServiceFabricMeshSecretCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceFabricMesh.SecretClient
+	client := meta.(*clients.Client).ServiceFabricMesh().SecretClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -123,7 +123,7 @@ func resourceServiceFabricMeshSecretCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceServiceFabricMeshSecretRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceFabricMesh.SecretClient
+	client := meta.(*clients.Client).ServiceFabricMesh().SecretClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -158,7 +158,7 @@ func resourceServiceFabricMeshSecretRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceServiceFabricMeshSecretDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceFabricMesh.SecretClient
+	client := meta.(*clients.Client).ServiceFabricMesh().SecretClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -65,7 +65,7 @@ func (r ServiceFabricMeshSecretResource) Exists(ctx context.Context, client *cli
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.ServiceFabricMesh.SecretClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.ServiceFabricMesh().SecretClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -75,7 +75,7 @@ func resourceServiceFabricMeshSecretValue() *schema.Resource {
 }
 
 func resourceServiceFabricMeshSecretValueCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceFabricMesh.SecretValueClient
+	client := meta.(*clients.Client).ServiceFabricMesh().SecretValueClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -128,8 +128,8 @@ func resourceServiceFabricMeshSecretValueCreateUpdate(d *schema.ResourceData, me
 }
 
 func resourceServiceFabricMeshSecretValueRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceFabricMesh.SecretValueClient
-	secretClient := meta.(*clients.Client).ServiceFabricMesh.SecretClient
+	client := meta.(*clients.Client).ServiceFabricMesh().SecretValueClient
+	secretClient := meta.(*clients.Client).ServiceFabricMesh().SecretClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -168,7 +168,7 @@ func resourceServiceFabricMeshSecretValueRead(d *schema.ResourceData, meta inter
 }
 
 func resourceServiceFabricMeshSecretValueDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceFabricMesh.SecretValueClient
+	client := meta.(*clients.Client).ServiceFabricMesh().SecretValueClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -51,7 +51,7 @@ func (r ServiceFabricMeshSecretValueResource) Exists(ctx context.Context, client
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.ServiceFabricMesh.SecretValueClient.Get(ctx, id.ResourceGroup, id.SecretName, id.ValueName)
+	resp, err := client.ServiceFabricMesh().SecretValueClient.Get(ctx, id.ResourceGroup, id.SecretName, id.ValueName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -83,7 +83,7 @@ func dataSourceArmSignalRService() *schema.Resource {
 }
 
 func dataSourceArmSignalRServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SignalR.Client
+	client := meta.(*clients.Client).SignalR().Client
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -353,7 +353,7 @@ func (r SignalRServiceResource) Exists(ctx context.Context, client *clients.Clie
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.SignalR.Client.Get(ctx, id.ResourceGroup, id.SignalRName)
+	resp, err := client.SignalR().Client.Get(ctx, id.ResourceGroup, id.SignalRName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
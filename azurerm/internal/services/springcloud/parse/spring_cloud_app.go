@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SpringCloudAppId struct {
@@ -73,3 +74,12 @@ func SpringCloudAppID(input string) (*SpringCloudAppId, error) {
 
 	return &resourceId, nil
 }
+
+// SpringCloudAppIDNormalized parses a SpringCloudApp ID into an SpringCloudAppId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SpringCloudAppID method should be used instead for validation etc.
+func SpringCloudAppIDNormalized(input string) (*SpringCloudAppId, error) {
+	return SpringCloudAppID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AppPlatform/Spring/%s/apps/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SpringCloudCertificateId struct {
@@ -73,3 +74,12 @@ func SpringCloudCertificateID(input string) (*SpringCloudCertificateId, error) {
 
 	return &resourceId, nil
 }
+
+// SpringCloudCertificateIDNormalized parses a SpringCloudCertificate ID into an SpringCloudCertificateId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SpringCloudCertificateID method should be used instead for validation etc.
+func SpringCloudCertificateIDNormalized(input string) (*SpringCloudCertificateId, error) {
+	return SpringCloudCertificateID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AppPlatform/Spring/%s/certificates/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SpringCloudCustomDomainId struct {
@@ -79,3 +80,12 @@ func SpringCloudCustomDomainID(input string) (*SpringCloudCustomDomainId, error)
 
 	return &resourceId, nil
 }
+
+// SpringCloudCustomDomainIDNormalized parses a SpringCloudCustomDomain ID into an SpringCloudCustomDomainId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SpringCloudCustomDomainID method should be used instead for validation etc.
+func SpringCloudCustomDomainIDNormalized(input string) (*SpringCloudCustomDomainId, error) {
+	return SpringCloudCustomDomainID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AppPlatform/Spring/%s/apps/%s/domains/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SpringCloudDeploymentId struct {
@@ -79,3 +80,12 @@ func SpringCloudDeploymentID(input string) (*SpringCloudDeploymentId, error) {
 
 	return &resourceId, nil
 }
+
+// SpringCloudDeploymentIDNormalized parses a SpringCloudDeployment ID into an SpringCloudDeploymentId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SpringCloudDeploymentID method should be used instead for validation etc.
+func SpringCloudDeploymentIDNormalized(input string) (*SpringCloudDeploymentId, error) {
+	return SpringCloudDeploymentID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AppPlatform/Spring/%s/apps/%s/deployments/%s"))
+}
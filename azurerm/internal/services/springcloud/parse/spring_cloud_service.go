@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SpringCloudServiceId struct {
@@ -67,3 +68,12 @@ func SpringCloudServiceID(input string) (*SpringCloudServiceId, error) {
 
 	return &resourceId, nil
 }
+
+// SpringCloudServiceIDNormalized parses a SpringCloudService ID into an SpringCloudServiceId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SpringCloudServiceID method should be used instead for validation etc.
+func SpringCloudServiceIDNormalized(input string) (*SpringCloudServiceId, error) {
+	return SpringCloudServiceID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AppPlatform/Spring/%s"))
+}
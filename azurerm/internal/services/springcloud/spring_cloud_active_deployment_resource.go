@@ -53,7 +53,7 @@ func resourceSpringCloudActiveDeployment() *schema.Resource {
 }
 
 func resourceSpringCloudActiveDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.AppsClient
+	client := meta.(*clients.Client).AppPlatform().AppsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -93,7 +93,7 @@ func resourceSpringCloudActiveDeploymentCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceSpringCloudActiveDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.AppsClient
+	client := meta.(*clients.Client).AppPlatform().AppsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -122,7 +122,7 @@ func resourceSpringCloudActiveDeploymentUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceSpringCloudActiveDeploymentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.AppsClient
+	client := meta.(*clients.Client).AppPlatform().AppsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -154,7 +154,7 @@ func resourceSpringCloudActiveDeploymentRead(d *schema.ResourceData, meta interf
 }
 
 func resourceSpringCloudActiveDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.AppsClient
+	client := meta.(*clients.Client).AppPlatform().AppsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -75,7 +75,7 @@ func (r SpringCloudActiveDeploymentResource) Exists(ctx context.Context, clients
 		return nil, err
 	}
 
-	resp, err := clients.AppPlatform.AppsClient.Get(ctx, id.ResourceGroup, id.SpringName, id.AppName, "")
+	resp, err := clients.AppPlatform().AppsClient.Get(ctx, id.ResourceGroup, id.SpringName, id.AppName, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Spring Cloud App %q (Spring Cloud Service %q / Resource Group %q): %+v", id.AppName, id.SpringName, id.ResourceGroup, err)
 	}
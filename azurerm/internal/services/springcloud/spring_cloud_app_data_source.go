@@ -101,7 +101,7 @@ func dataSourceSpringCloudApp() *schema.Resource {
 }
 
 func dataSourceSpringCloudAppRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.AppsClient
+	client := meta.(*clients.Client).AppPlatform().AppsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
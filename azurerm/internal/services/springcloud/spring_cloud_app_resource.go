@@ -108,8 +108,8 @@ func resourceSpringCloudApp() *schema.Resource {
 }
 
 func resourceSpringCloudAppCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.AppsClient
-	servicesClient := meta.(*clients.Client).AppPlatform.ServicesClient
+	client := meta.(*clients.Client).AppPlatform().AppsClient
+	servicesClient := meta.(*clients.Client).AppPlatform().ServicesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -172,7 +172,7 @@ func resourceSpringCloudAppCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceSpringCloudAppUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.AppsClient
+	client := meta.(*clients.Client).AppPlatform().AppsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -206,7 +206,7 @@ func resourceSpringCloudAppUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceSpringCloudAppRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.AppsClient
+	client := meta.(*clients.Client).AppPlatform().AppsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -248,7 +248,7 @@ func resourceSpringCloudAppRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceSpringCloudAppDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.AppsClient
+	client := meta.(*clients.Client).AppPlatform().AppsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
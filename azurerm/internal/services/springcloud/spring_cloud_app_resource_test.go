@@ -101,7 +101,7 @@ func (t SpringCloudAppResource) Exists(ctx context.Context, clients *clients.Cli
 		return nil, err
 	}
 
-	resp, err := clients.AppPlatform.AppsClient.Get(ctx, id.ResourceGroup, id.SpringName, id.AppName, "")
+	resp, err := clients.AppPlatform().AppsClient.Get(ctx, id.ResourceGroup, id.SpringName, id.AppName, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Spring Cloud App %q (Spring Cloud Service %q / Resource Group %q): %+v", id.AppName, id.SpringName, id.ResourceGroup, err)
 	}
@@ -70,7 +70,7 @@ func resourceSpringCloudCertificate() *schema.Resource {
 }
 
 func resourceSpringCloudCertificateCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.CertificatesClient
+	client := meta.(*clients.Client).AppPlatform().CertificatesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -110,7 +110,7 @@ func resourceSpringCloudCertificateCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceSpringCloudCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.CertificatesClient
+	client := meta.(*clients.Client).AppPlatform().CertificatesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -140,7 +140,7 @@ func resourceSpringCloudCertificateRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceSpringCloudCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.CertificatesClient
+	client := meta.(*clients.Client).AppPlatform().CertificatesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
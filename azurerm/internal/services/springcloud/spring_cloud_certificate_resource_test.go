@@ -55,7 +55,7 @@ func (t SpringCloudCertificateResource) Exists(ctx context.Context, clients *cli
 		return nil, err
 	}
 
-	resp, err := clients.AppPlatform.CertificatesClient.Get(ctx, id.ResourceGroup, id.SpringName, id.CertificateName)
+	resp, err := clients.AppPlatform().CertificatesClient.Get(ctx, id.ResourceGroup, id.SpringName, id.CertificateName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Spring Cloud Certificate %q (Spring Cloud Service %q / Resource Group %q): %+v", id.CertificateName, id.SpringName, id.ResourceGroup, err)
 	}
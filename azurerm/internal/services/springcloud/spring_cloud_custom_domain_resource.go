@@ -68,7 +68,7 @@ func resourceSpringCloudCustomDomain() *schema.Resource {
 }
 
 func resourceSpringCloudCustomDomainCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.CustomDomainsClient
+	client := meta.(*clients.Client).AppPlatform().CustomDomainsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -106,7 +106,7 @@ func resourceSpringCloudCustomDomainCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceSpringCloudCustomDomainRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.CustomDomainsClient
+	client := meta.(*clients.Client).AppPlatform().CustomDomainsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -136,7 +136,7 @@ func resourceSpringCloudCustomDomainRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceSpringCloudCustomDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.CustomDomainsClient
+	client := meta.(*clients.Client).AppPlatform().CustomDomainsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
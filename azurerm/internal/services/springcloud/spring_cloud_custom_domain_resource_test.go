@@ -118,7 +118,7 @@ func (r SpringCloudCustomDomainResource) Exists(ctx context.Context, clients *cl
 		return nil, err
 	}
 
-	resp, err := clients.AppPlatform.CustomDomainsClient.Get(ctx, id.ResourceGroup, id.SpringName, id.AppName, id.DomainName)
+	resp, err := clients.AppPlatform().CustomDomainsClient.Get(ctx, id.ResourceGroup, id.SpringName, id.AppName, id.DomainName)
 	if err != nil {
 		return nil, fmt.Errorf("reading Spring Cloud Custom Domain %q (Spring Cloud Service %q / App %q / Resource Group %q): %+v", id.DomainName, id.SpringName, id.AppName, id.ResourceGroup, err)
 	}
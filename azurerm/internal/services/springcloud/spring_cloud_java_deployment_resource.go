@@ -99,8 +99,8 @@ func resourceSpringCloudJavaDeployment() *schema.Resource {
 }
 
 func resourceSpringCloudJavaDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.DeploymentsClient
-	servicesClient := meta.(*clients.Client).AppPlatform.ServicesClient
+	client := meta.(*clients.Client).AppPlatform().DeploymentsClient
+	servicesClient := meta.(*clients.Client).AppPlatform().ServicesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -165,7 +165,7 @@ func resourceSpringCloudJavaDeploymentCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceSpringCloudJavaDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.DeploymentsClient
+	client := meta.(*clients.Client).AppPlatform().DeploymentsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -219,7 +219,7 @@ func resourceSpringCloudJavaDeploymentUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceSpringCloudJavaDeploymentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.DeploymentsClient
+	client := meta.(*clients.Client).AppPlatform().DeploymentsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -256,7 +256,7 @@ func resourceSpringCloudJavaDeploymentRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceSpringCloudJavaDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.DeploymentsClient
+	client := meta.(*clients.Client).AppPlatform().DeploymentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -117,8 +117,8 @@ func dataSourceSpringCloudService() *schema.Resource {
 }
 
 func dataSourceSpringCloudServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.ServicesClient
-	configServersClient := meta.(*clients.Client).AppPlatform.ConfigServersClient
+	client := meta.(*clients.Client).AppPlatform().ServicesClient
+	configServersClient := meta.(*clients.Client).AppPlatform().ConfigServersClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -224,9 +224,9 @@ func resourceSpringCloudService() *schema.Resource {
 }
 
 func resourceSpringCloudServiceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.ServicesClient
-	configServersClient := meta.(*clients.Client).AppPlatform.ConfigServersClient
-	monitoringSettingsClient := meta.(*clients.Client).AppPlatform.MonitoringSettingsClient
+	client := meta.(*clients.Client).AppPlatform().ServicesClient
+	configServersClient := meta.(*clients.Client).AppPlatform().ConfigServersClient
+	monitoringSettingsClient := meta.(*clients.Client).AppPlatform().MonitoringSettingsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -296,9 +296,9 @@ func resourceSpringCloudServiceCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceSpringCloudServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.ServicesClient
-	configServersClient := meta.(*clients.Client).AppPlatform.ConfigServersClient
-	monitoringSettingsClient := meta.(*clients.Client).AppPlatform.MonitoringSettingsClient
+	client := meta.(*clients.Client).AppPlatform().ServicesClient
+	configServersClient := meta.(*clients.Client).AppPlatform().ConfigServersClient
+	monitoringSettingsClient := meta.(*clients.Client).AppPlatform().MonitoringSettingsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -307,7 +307,7 @@ func resourceSpringCloudServiceUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		model := appplatform.ServiceResource{
 			Sku: &appplatform.Sku{
 				Name: utils.String(d.Get("sku_name").(string)),
@@ -357,9 +357,9 @@ func resourceSpringCloudServiceUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceSpringCloudServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.ServicesClient
-	configServersClient := meta.(*clients.Client).AppPlatform.ConfigServersClient
-	monitoringSettingsClient := meta.(*clients.Client).AppPlatform.MonitoringSettingsClient
+	client := meta.(*clients.Client).AppPlatform().ServicesClient
+	configServersClient := meta.(*clients.Client).AppPlatform().ConfigServersClient
+	monitoringSettingsClient := meta.(*clients.Client).AppPlatform().MonitoringSettingsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -418,7 +418,7 @@ func resourceSpringCloudServiceRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceSpringCloudServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppPlatform.ServicesClient
+	client := meta.(*clients.Client).AppPlatform().ServicesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -138,7 +138,7 @@ func (t SpringCloudServiceResource) Exists(ctx context.Context, clients *clients
 		return nil, err
 	}
 
-	resp, err := clients.AppPlatform.ServicesClient.Get(ctx, id.ResourceGroup, id.SpringName)
+	resp, err := clients.AppPlatform().ServicesClient.Get(ctx, id.ResourceGroup, id.SpringName)
 	if err != nil {
 		return nil, fmt.Errorf("unable to read Spring Cloud Service %q (Resource Group %q): %+v", id.SpringName, id.ResourceGroup, err)
 	}
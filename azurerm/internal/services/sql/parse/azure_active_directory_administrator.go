@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AzureActiveDirectoryAdministratorId struct {
@@ -73,3 +74,12 @@ func AzureActiveDirectoryAdministratorID(input string) (*AzureActiveDirectoryAdm
 
 	return &resourceId, nil
 }
+
+// AzureActiveDirectoryAdministratorIDNormalized parses a AzureActiveDirectoryAdministrator ID into an AzureActiveDirectoryAdministratorId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the AzureActiveDirectoryAdministratorID method should be used instead for validation etc.
+func AzureActiveDirectoryAdministratorIDNormalized(input string) (*AzureActiveDirectoryAdministratorId, error) {
+	return AzureActiveDirectoryAdministratorID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.SQL/servers/%s/administrators/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FailoverGroupId struct {
@@ -73,3 +74,12 @@ func FailoverGroupID(input string) (*FailoverGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// FailoverGroupIDNormalized parses a FailoverGroup ID into an FailoverGroupId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the FailoverGroupID method should be used instead for validation etc.
+func FailoverGroupIDNormalized(input string) (*FailoverGroupId, error) {
+	return FailoverGroupID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/failoverGroups/%s"))
+}
@@ -64,7 +64,7 @@ func resourceSqlAdministrator() *schema.Resource {
 }
 
 func resourceSqlActiveDirectoryAdministratorCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.ServerAzureADAdministratorsClient
+	client := meta.(*clients.Client).Sql().ServerAzureADAdministratorsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -115,7 +115,7 @@ func resourceSqlActiveDirectoryAdministratorCreateUpdate(d *schema.ResourceData,
 }
 
 func resourceSqlActiveDirectoryAdministratorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.ServerAzureADAdministratorsClient
+	client := meta.(*clients.Client).Sql().ServerAzureADAdministratorsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -146,7 +146,7 @@ func resourceSqlActiveDirectoryAdministratorRead(d *schema.ResourceData, meta in
 }
 
 func resourceSqlActiveDirectoryAdministratorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.ServerAzureADAdministratorsClient
+	client := meta.(*clients.Client).Sql().ServerAzureADAdministratorsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
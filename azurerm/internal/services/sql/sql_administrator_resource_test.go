@@ -74,7 +74,7 @@ func (r SqlAdministratorResource) Exists(ctx context.Context, client *clients.Cl
 		return nil, err
 	}
 
-	resp, err := client.Sql.ServerAzureADAdministratorsClient.Get(ctx, id.ResourceGroup, id.ServerName)
+	resp, err := client.Sql().ServerAzureADAdministratorsClient.Get(ctx, id.ResourceGroup, id.ServerName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -89,7 +89,7 @@ func (r SqlAdministratorResource) Destroy(ctx context.Context, client *clients.C
 	if err != nil {
 		return nil, err
 	}
-	if _, err := client.Sql.ServerAzureADAdministratorsClient.Delete(ctx, id.ResourceGroup, id.ServerName); err != nil {
+	if _, err := client.Sql().ServerAzureADAdministratorsClient.Delete(ctx, id.ResourceGroup, id.ServerName); err != nil {
 		return nil, err
 	}
 	return utils.Bool(true), nil
@@ -75,7 +75,7 @@ func dataSourceSqlDatabase() *schema.Resource {
 }
 
 func dataSourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.DatabasesClient
+	client := meta.(*clients.Client).Sql().DatabasesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
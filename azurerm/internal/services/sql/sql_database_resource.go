@@ -360,8 +360,8 @@ func resourceSqlDatabase() *schema.Resource {
 }
 
 func resourceSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.DatabasesClient
-	threatClient := meta.(*clients.Client).Sql.DatabaseThreatDetectionPoliciesClient
+	client := meta.(*clients.Client).Sql().DatabasesClient
+	threatClient := meta.(*clients.Client).Sql().DatabaseThreatDetectionPoliciesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -512,7 +512,7 @@ func resourceSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if createMode != string(sql.CreateModeOnlineSecondary) {
-		auditingClient := meta.(*clients.Client).Sql.DatabaseExtendedBlobAuditingPoliciesClient
+		auditingClient := meta.(*clients.Client).Sql().DatabaseExtendedBlobAuditingPoliciesClient
 		auditingProps := sql.ExtendedDatabaseBlobAuditingPolicy{
 			ExtendedDatabaseBlobAuditingPolicyProperties: helper.ExpandAzureRmSqlDBBlobAuditingPolicies(auditingPolicies),
 		}
@@ -525,7 +525,7 @@ func resourceSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.DatabasesClient
+	client := meta.(*clients.Client).Sql().DatabasesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -545,7 +545,7 @@ func resourceSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error making Read request on Sql Database %s: %+v", id.Name, err)
 	}
 
-	threatClient := meta.(*clients.Client).Sql.DatabaseThreatDetectionPoliciesClient
+	threatClient := meta.(*clients.Client).Sql().DatabaseThreatDetectionPoliciesClient
 	threat, err := threatClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err == nil {
 		if err := d.Set("threat_detection_policy", flattenArmSqlServerThreatDetectionPolicy(d, threat)); err != nil {
@@ -600,7 +600,7 @@ func resourceSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("zone_redundant", props.ZoneRedundant)
 	}
 
-	auditingClient := meta.(*clients.Client).Sql.DatabaseExtendedBlobAuditingPoliciesClient
+	auditingClient := meta.(*clients.Client).Sql().DatabaseExtendedBlobAuditingPoliciesClient
 	auditingResp, err := auditingClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err != nil {
 		return fmt.Errorf("failure in reading SQL Database %q: %v Blob Auditing Policies", id.Name, err)
@@ -615,7 +615,7 @@ func resourceSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.DatabasesClient
+	client := meta.(*clients.Client).Sql().DatabasesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
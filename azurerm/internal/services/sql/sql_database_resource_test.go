@@ -345,7 +345,7 @@ func (r SqlDatabaseResource) Exists(ctx context.Context, client *clients.Client,
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Sql.DatabasesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name, "")
+	resp, err := client.Sql().DatabasesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -360,7 +360,7 @@ func (r SqlDatabaseResource) Destroy(ctx context.Context, client *clients.Client
 	if err != nil {
 		return nil, err
 	}
-	if _, err := client.Sql.DatabasesClient.Delete(ctx, id.ResourceGroup, id.ServerName, id.Name); err != nil {
+	if _, err := client.Sql().DatabasesClient.Delete(ctx, id.ResourceGroup, id.ServerName, id.Name); err != nil {
 		return nil, fmt.Errorf("deleting SQL Database %q (Server %q / Resource Group %q): %+v", id.Name, id.ServerName, id.ResourceGroup, err)
 	}
 	return utils.Bool(true), nil
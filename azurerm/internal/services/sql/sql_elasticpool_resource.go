@@ -99,7 +99,7 @@ func resourceSqlElasticPool() *schema.Resource {
 }
 
 func resourceSqlElasticPoolCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.ElasticPoolsClient
+	client := meta.(*clients.Client).Sql().ElasticPoolsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -154,7 +154,7 @@ func resourceSqlElasticPoolCreateUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.ElasticPoolsClient
+	client := meta.(*clients.Client).Sql().ElasticPoolsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -219,7 +219,7 @@ func resourceSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceSqlElasticPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.ElasticPoolsClient
+	client := meta.(*clients.Client).Sql().ElasticPoolsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -87,7 +87,7 @@ func (r SqlElasticPoolResource) Exists(ctx context.Context, client *clients.Clie
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Sql.ElasticPoolsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	resp, err := client.Sql().ElasticPoolsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -102,7 +102,7 @@ func (r SqlElasticPoolResource) Destroy(ctx context.Context, client *clients.Cli
 	if err != nil {
 		return nil, err
 	}
-	if _, err := client.Sql.ElasticPoolsClient.Delete(ctx, id.ResourceGroup, id.ServerName, id.Name); err != nil {
+	if _, err := client.Sql().ElasticPoolsClient.Delete(ctx, id.ResourceGroup, id.ServerName, id.Name); err != nil {
 		return nil, fmt.Errorf("deleting Sql Elastic Pool %q (Server %q / Resource Group %q): %+v", id.Name, id.ServerName, id.ResourceGroup, err)
 	}
 	return utils.Bool(true), nil
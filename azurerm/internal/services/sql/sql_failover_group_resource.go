@@ -138,7 +138,7 @@ func resourceSqlFailoverGroup() *schema.Resource {
 }
 
 func resourceSqlFailoverGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.FailoverGroupsClient
+	client := meta.(*clients.Client).Sql().FailoverGroupsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -200,7 +200,7 @@ func resourceSqlFailoverGroupCreateUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceSqlFailoverGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.FailoverGroupsClient
+	client := meta.(*clients.Client).Sql().FailoverGroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -249,7 +249,7 @@ func resourceSqlFailoverGroupRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceSqlFailoverGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.FailoverGroupsClient
+	client := meta.(*clients.Client).Sql().FailoverGroupsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -85,7 +85,7 @@ func (r SqlFailoverGroupResource) Exists(ctx context.Context, client *clients.Cl
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Sql.FailoverGroupsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	resp, err := client.Sql().FailoverGroupsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -100,7 +100,7 @@ func (r SqlFailoverGroupResource) Destroy(ctx context.Context, client *clients.C
 	if err != nil {
 		return nil, err
 	}
-	if _, err := client.Sql.FailoverGroupsClient.Delete(ctx, id.ResourceGroup, id.ServerName, id.Name); err != nil {
+	if _, err := client.Sql().FailoverGroupsClient.Delete(ctx, id.ResourceGroup, id.ServerName, id.Name); err != nil {
 		return nil, fmt.Errorf("deleting Sql Failover Group %q (Server %q / Resource Group %q): %+v", id.Name, id.ServerName, id.ResourceGroup, err)
 	}
 	return utils.Bool(true), nil
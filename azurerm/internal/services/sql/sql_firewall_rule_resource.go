@@ -73,7 +73,7 @@ func resourceSqlFirewallRule() *schema.Resource {
 }
 
 func resourceSqlFirewallRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.FirewallRulesClient
+	client := meta.(*clients.Client).Sql().FirewallRulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -118,7 +118,7 @@ func resourceSqlFirewallRuleCreateUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceSqlFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.FirewallRulesClient
+	client := meta.(*clients.Client).Sql().FirewallRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -148,7 +148,7 @@ func resourceSqlFirewallRuleRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceSqlFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.FirewallRulesClient
+	client := meta.(*clients.Client).Sql().FirewallRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
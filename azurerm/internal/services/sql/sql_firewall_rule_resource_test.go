@@ -76,7 +76,7 @@ func (r SqlFirewallRuleResource) Exists(ctx context.Context, client *clients.Cli
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Sql.FirewallRulesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	resp, err := client.Sql().FirewallRulesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -91,7 +91,7 @@ func (r SqlFirewallRuleResource) Destroy(ctx context.Context, client *clients.Cl
 	if err != nil {
 		return nil, err
 	}
-	if _, err := client.Sql.FirewallRulesClient.Delete(ctx, id.ResourceGroup, id.ServerName, id.Name); err != nil {
+	if _, err := client.Sql().FirewallRulesClient.Delete(ctx, id.ResourceGroup, id.ServerName, id.Name); err != nil {
 		return nil, fmt.Errorf("deleting Sql Firewall Rule %q (Server %q / Resource Group %q): %+v", id.Name, id.ServerName, id.ResourceGroup, err)
 	}
 	return utils.Bool(true), nil
@@ -121,9 +121,9 @@ func resourceSqlServer() *schema.Resource {
 }
 
 func resourceSqlServerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.ServersClient
-	auditingClient := meta.(*clients.Client).Sql.ServerExtendedBlobAuditingPoliciesClient
-	connectionClient := meta.(*clients.Client).Sql.ServerConnectionPoliciesClient
+	client := meta.(*clients.Client).Sql().ServersClient
+	auditingClient := meta.(*clients.Client).Sql().ServerExtendedBlobAuditingPoliciesClient
+	connectionClient := meta.(*clients.Client).Sql().ServerConnectionPoliciesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -208,9 +208,9 @@ func resourceSqlServerCreateUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceSqlServerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.ServersClient
-	auditingClient := meta.(*clients.Client).Sql.ServerExtendedBlobAuditingPoliciesClient
-	connectionClient := meta.(*clients.Client).Sql.ServerConnectionPoliciesClient
+	client := meta.(*clients.Client).Sql().ServersClient
+	auditingClient := meta.(*clients.Client).Sql().ServerExtendedBlobAuditingPoliciesClient
+	connectionClient := meta.(*clients.Client).Sql().ServerConnectionPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -268,7 +268,7 @@ func resourceSqlServerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSqlServerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.ServersClient
+	client := meta.(*clients.Client).Sql().ServersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -155,7 +155,7 @@ func (r SqlServerResource) Exists(ctx context.Context, client *clients.Client, s
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Sql.ServersClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Sql().ServersClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -170,7 +170,7 @@ func (r SqlServerResource) Destroy(ctx context.Context, client *clients.Client,
 	if err != nil {
 		return nil, err
 	}
-	serversClient := client.Sql.ServersClient
+	serversClient := client.Sql().ServersClient
 	future, err := serversClient.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting Sql Server %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
@@ -70,7 +70,7 @@ func resourceSqlVirtualNetworkRule() *schema.Resource {
 }
 
 func resourceSqlVirtualNetworkRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.VirtualNetworkRulesClient
+	client := meta.(*clients.Client).Sql().VirtualNetworkRulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -135,7 +135,7 @@ func resourceSqlVirtualNetworkRuleCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceSqlVirtualNetworkRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.VirtualNetworkRulesClient
+	client := meta.(*clients.Client).Sql().VirtualNetworkRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -168,7 +168,7 @@ func resourceSqlVirtualNetworkRuleRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceSqlVirtualNetworkRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Sql.VirtualNetworkRulesClient
+	client := meta.(*clients.Client).Sql().VirtualNetworkRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
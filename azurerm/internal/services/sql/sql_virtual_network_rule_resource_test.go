@@ -344,7 +344,7 @@ func (r SqlVirtualNetworkRuleResource) Exists(ctx context.Context, client *clien
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Sql.VirtualNetworkRulesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	resp, err := client.Sql().VirtualNetworkRulesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -359,7 +359,7 @@ func (r SqlVirtualNetworkRuleResource) Destroy(ctx context.Context, client *clie
 	if err != nil {
 		return nil, err
 	}
-	rulesClient := client.Sql.VirtualNetworkRulesClient
+	rulesClient := client.Sql().VirtualNetworkRulesClient
 	future, err := rulesClient.Delete(ctx, id.ResourceGroup, id.ServerName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("deleting Sql Virtual Network Rule %q (Server %q / Resource Group %q): %+v", id.Name, id.ServerName, id.ResourceGroup, err)
@@ -8,6 +8,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/storagesync/mgmt/2020-03-01/storagesync"
 	"github.com/Azure/go-autorest/autorest"
 	az "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/shim"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/accounts"
@@ -38,6 +39,7 @@ type Client struct {
 
 	resourceManagerAuthorizer autorest.Authorizer
 	storageAdAuth             *autorest.Authorizer
+	wireTracer                *common.WireTracer
 }
 
 func NewClient(options *common.ClientOptions) *Client {
@@ -84,6 +86,7 @@ func NewClient(options *common.ClientOptions) *Client {
 		SyncGroupsClient:         &syncGroupsClient,
 
 		resourceManagerAuthorizer: options.ResourceManagerAuthorizer,
+		wireTracer:                options.WireTracer,
 	}
 
 	if options.StorageUseAzureAD {
@@ -97,6 +100,7 @@ func (client Client) AccountsDataPlaneClient(ctx context.Context, account accoun
 	if client.storageAdAuth != nil {
 		accountsClient := accounts.NewWithEnvironment(client.Environment)
 		accountsClient.Client.Authorizer = *client.storageAdAuth
+		client.configureDataPlaneClient(&accountsClient.Client)
 		return &accountsClient, nil
 	}
 
@@ -112,6 +116,7 @@ func (client Client) AccountsDataPlaneClient(ctx context.Context, account accoun
 
 	accountsClient := accounts.NewWithEnvironment(client.Environment)
 	accountsClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&accountsClient.Client)
 	return &accountsClient, nil
 }
 
@@ -119,6 +124,7 @@ func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*
 	if client.storageAdAuth != nil {
 		blobsClient := blobs.NewWithEnvironment(client.Environment)
 		blobsClient.Client.Authorizer = *client.storageAdAuth
+		client.configureDataPlaneClient(&blobsClient.Client)
 		return &blobsClient, nil
 	}
 
@@ -134,6 +140,7 @@ func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*
 
 	blobsClient := blobs.NewWithEnvironment(client.Environment)
 	blobsClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&blobsClient.Client)
 	return &blobsClient, nil
 }
 
@@ -141,6 +148,7 @@ func (client Client) ContainersClient(ctx context.Context, account accountDetail
 	if client.storageAdAuth != nil {
 		containersClient := containers.NewWithEnvironment(client.Environment)
 		containersClient.Client.Authorizer = *client.storageAdAuth
+		client.configureDataPlaneClient(&containersClient.Client)
 		shim := shim.NewDataPlaneStorageContainerWrapper(&containersClient)
 		return shim, nil
 	}
@@ -157,6 +165,7 @@ func (client Client) ContainersClient(ctx context.Context, account accountDetail
 
 	containersClient := containers.NewWithEnvironment(client.Environment)
 	containersClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&containersClient.Client)
 
 	shim := shim.NewDataPlaneStorageContainerWrapper(&containersClient)
 	return shim, nil
@@ -177,6 +186,7 @@ func (client Client) FileShareDirectoriesClient(ctx context.Context, account acc
 
 	directoriesClient := directories.NewWithEnvironment(client.Environment)
 	directoriesClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&directoriesClient.Client)
 	return &directoriesClient, nil
 }
 
@@ -195,6 +205,7 @@ func (client Client) FileShareFilesClient(ctx context.Context, account accountDe
 
 	filesClient := files.NewWithEnvironment(client.Environment)
 	filesClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&filesClient.Client)
 	return &filesClient, nil
 }
 
@@ -213,6 +224,7 @@ func (client Client) FileSharesClient(ctx context.Context, account accountDetail
 
 	sharesClient := shares.NewWithEnvironment(client.Environment)
 	sharesClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&sharesClient.Client)
 	shim := shim.NewDataPlaneStorageShareWrapper(&sharesClient)
 	return shim, nil
 }
@@ -221,6 +233,7 @@ func (client Client) QueuesClient(ctx context.Context, account accountDetails) (
 	if client.storageAdAuth != nil {
 		queueClient := queues.NewWithEnvironment(client.Environment)
 		queueClient.Client.Authorizer = *client.storageAdAuth
+		client.configureDataPlaneClient(&queueClient.Client)
 		return shim.NewDataPlaneStorageQueueWrapper(&queueClient), nil
 	}
 
@@ -236,6 +249,7 @@ func (client Client) QueuesClient(ctx context.Context, account accountDetails) (
 
 	queuesClient := queues.NewWithEnvironment(client.Environment)
 	queuesClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&queuesClient.Client)
 	return shim.NewDataPlaneStorageQueueWrapper(&queuesClient), nil
 }
 
@@ -254,6 +268,7 @@ func (client Client) TableEntityClient(ctx context.Context, account accountDetai
 
 	entitiesClient := entities.NewWithEnvironment(client.Environment)
 	entitiesClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&entitiesClient.Client)
 	return &entitiesClient, nil
 }
 
@@ -272,6 +287,15 @@ func (client Client) TablesClient(ctx context.Context, account accountDetails) (
 
 	tablesClient := tables.NewWithEnvironment(client.Environment)
 	tablesClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&tablesClient.Client)
 	shim := shim.NewDataPlaneStorageTableWrapper(&tablesClient)
 	return shim, nil
 }
+
+// configureDataPlaneClient configures the Sender used by a Data Plane client, which are authorized separately
+// from the other clients (using either AzureAD or a SharedKey) and so aren't configured using the ClientOptions
+func (client Client) configureDataPlaneClient(c *autorest.Client) {
+	if client.wireTracer != nil {
+		c.Sender = autorest.DecorateSender(sender.BuildSender("AzureRM"), common.WithWireTracing(client.wireTracer))
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EncryptionScopeId struct {
@@ -73,3 +74,12 @@ func EncryptionScopeID(input string) (*EncryptionScopeId, error) {
 
 	return &resourceId, nil
 }
+
+// EncryptionScopeIDNormalized parses a EncryptionScope ID into an EncryptionScopeId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the EncryptionScopeID method should be used instead for validation etc.
+func EncryptionScopeIDNormalized(input string) (*EncryptionScopeId, error) {
+	return EncryptionScopeID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/encryptionScopes/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageAccountId struct {
@@ -67,3 +68,12 @@ func StorageAccountID(input string) (*StorageAccountId, error) {
 
 	return &resourceId, nil
 }
+
+// StorageAccountIDNormalized parses a StorageAccount ID into an StorageAccountId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the StorageAccountID method should be used instead for validation etc.
+func StorageAccountIDNormalized(input string) (*StorageAccountId, error) {
+	return StorageAccountID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageContainerResourceManagerId struct {
@@ -79,3 +80,12 @@ func StorageContainerResourceManagerID(input string) (*StorageContainerResourceM
 
 	return &resourceId, nil
 }
+
+// StorageContainerResourceManagerIDNormalized parses a StorageContainerResourceManager ID into an StorageContainerResourceManagerId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the StorageContainerResourceManagerID method should be used instead for validation etc.
+func StorageContainerResourceManagerIDNormalized(input string) (*StorageContainerResourceManagerId, error) {
+	return StorageContainerResourceManagerID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/blobServices/%s/containers/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageShareResourceManagerId struct {
@@ -79,3 +80,12 @@ func StorageShareResourceManagerID(input string) (*StorageShareResourceManagerId
 
 	return &resourceId, nil
 }
+
+// StorageShareResourceManagerIDNormalized parses a StorageShareResourceManager ID into an StorageShareResourceManagerId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the StorageShareResourceManagerID method should be used instead for validation etc.
+func StorageShareResourceManagerIDNormalized(input string) (*StorageShareResourceManagerId, error) {
+	return StorageShareResourceManagerID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/fileServices/%s/shares/%s"))
+}
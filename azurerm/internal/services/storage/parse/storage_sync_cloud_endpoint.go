@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageSyncCloudEndpointId struct {
@@ -79,3 +80,12 @@ func StorageSyncCloudEndpointID(input string) (*StorageSyncCloudEndpointId, erro
 
 	return &resourceId, nil
 }
+
+// StorageSyncCloudEndpointIDNormalized parses a StorageSyncCloudEndpoint ID into an StorageSyncCloudEndpointId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the StorageSyncCloudEndpointID method should be used instead for validation etc.
+func StorageSyncCloudEndpointIDNormalized(input string) (*StorageSyncCloudEndpointId, error) {
+	return StorageSyncCloudEndpointID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StorageSync/storageSyncServices/%s/syncGroups/%s/cloudEndpoints/%s"))
+}
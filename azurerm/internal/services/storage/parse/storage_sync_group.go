@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageSyncGroupId struct {
@@ -73,3 +74,12 @@ func StorageSyncGroupID(input string) (*StorageSyncGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// StorageSyncGroupIDNormalized parses a StorageSyncGroup ID into an StorageSyncGroupId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the StorageSyncGroupID method should be used instead for validation etc.
+func StorageSyncGroupIDNormalized(input string) (*StorageSyncGroupId, error) {
+	return StorageSyncGroupID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StorageSync/storageSyncServices/%s/syncGroups/%s"))
+}
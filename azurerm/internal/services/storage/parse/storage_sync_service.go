@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageSyncServiceId struct {
@@ -67,3 +68,12 @@ func StorageSyncServiceID(input string) (*StorageSyncServiceId, error) {
 
 	return &resourceId, nil
 }
+
+// StorageSyncServiceIDNormalized parses a StorageSyncService ID into an StorageSyncServiceId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the StorageSyncServiceID method should be used instead for validation etc.
+func StorageSyncServiceIDNormalized(input string) (*StorageSyncServiceId, error) {
+	return StorageSyncServiceID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StorageSync/storageSyncServices/%s"))
+}
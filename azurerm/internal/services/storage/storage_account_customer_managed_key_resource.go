@@ -68,8 +68,8 @@ func resourceStorageAccountCustomerManagedKey() *schema.Resource {
 }
 
 func resourceStorageAccountCustomerManagedKeyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*clients.Client).Storage.AccountsClient
-	keyVaultsClient := meta.(*clients.Client).KeyVault
+	storageClient := meta.(*clients.Client).Storage().AccountsClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
 	vaultsClient := keyVaultsClient.VaultsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -80,7 +80,9 @@ func resourceStorageAccountCustomerManagedKeyCreateUpdate(d *schema.ResourceData
 		return err
 	}
 
-	locks.ByName(storageAccountID.Name, storageAccountResourceName)
+	if err := locks.ByNameWithContext(ctx, storageAccountID.Name, storageAccountResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Customer Managed Key (Storage Account %q / Resource Group %q): %+v", storageAccountID.Name, storageAccountID.ResourceGroup, err)
+	}
 	defer locks.UnlockByName(storageAccountID.Name, storageAccountResourceName)
 
 	storageAccount, err := storageClient.GetProperties(ctx, storageAccountID.ResourceGroup, storageAccountID.Name, "")
@@ -166,9 +168,9 @@ func resourceStorageAccountCustomerManagedKeyCreateUpdate(d *schema.ResourceData
 }
 
 func resourceStorageAccountCustomerManagedKeyRead(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*clients.Client).Storage.AccountsClient
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	resourcesClient := meta.(*clients.Client).Resource
+	storageClient := meta.(*clients.Client).Storage().AccountsClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -233,7 +235,7 @@ func resourceStorageAccountCustomerManagedKeyRead(d *schema.ResourceData, meta i
 }
 
 func resourceStorageAccountCustomerManagedKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*clients.Client).Storage.AccountsClient
+	storageClient := meta.(*clients.Client).Storage().AccountsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -242,7 +244,9 @@ func resourceStorageAccountCustomerManagedKeyDelete(d *schema.ResourceData, meta
 		return err
 	}
 
-	locks.ByName(storageAccountID.Name, storageAccountResourceName)
+	if err := locks.ByNameWithContext(ctx, storageAccountID.Name, storageAccountResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Customer Managed Key (Storage Account %q / Resource Group %q): %+v", storageAccountID.Name, storageAccountID.ResourceGroup, err)
+	}
 	defer locks.UnlockByName(storageAccountID.Name, storageAccountResourceName)
 
 	// confirm it still exists prior to trying to update it, else we'll get an error
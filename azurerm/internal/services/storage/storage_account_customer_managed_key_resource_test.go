@@ -106,7 +106,7 @@ func (r StorageAccountCustomerManagedKeyResource) accountHasDefaultSettings(ctx
 		return err
 	}
 
-	resp, err := client.Storage.AccountsClient.GetProperties(ctx, accountId.ResourceGroup, accountId.Name, "")
+	resp, err := client.Storage().AccountsClient.GetProperties(ctx, accountId.ResourceGroup, accountId.Name, "")
 	if err != nil {
 		return fmt.Errorf("Bad: Get on storageServiceClient: %+v", err)
 	}
@@ -143,7 +143,7 @@ func (r StorageAccountCustomerManagedKeyResource) Exists(ctx context.Context, cl
 		return nil, err
 	}
 
-	resp, err := client.Storage.AccountsClient.GetProperties(ctx, accountId.ResourceGroup, accountId.Name, "")
+	resp, err := client.Storage().AccountsClient.GetProperties(ctx, accountId.ResourceGroup, accountId.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("Bad: Get on storageServiceClient: %+v", err)
 	}
@@ -260,7 +260,7 @@ func dataSourceStorageAccount() *schema.Resource {
 }
 
 func dataSourceStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.AccountsClient
+	client := meta.(*clients.Client).Storage().AccountsClient
 	endpointSuffix := meta.(*clients.Client).Account.Environment.StorageEndpointSuffix
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -96,14 +96,16 @@ func resourceStorageAccountNetworkRules() *schema.Resource {
 }
 
 func resourceStorageAccountNetworkRulesCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.AccountsClient
+	client := meta.(*clients.Client).Storage().AccountsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	storageAccountName := d.Get("storage_account_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(storageAccountName, storageAccountResourceName)
+	if err := locks.ByNameWithContext(ctx, storageAccountName, storageAccountResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Rules (Storage Account %q / Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(storageAccountName, storageAccountResourceName)
 
 	storageAccount, err := client.GetProperties(ctx, resourceGroup, storageAccountName, "")
@@ -151,7 +153,7 @@ func resourceStorageAccountNetworkRulesCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceStorageAccountNetworkRulesRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.AccountsClient
+	client := meta.(*clients.Client).Storage().AccountsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -188,7 +190,7 @@ func resourceStorageAccountNetworkRulesRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceStorageAccountNetworkRulesDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.AccountsClient
+	client := meta.(*clients.Client).Storage().AccountsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -200,7 +202,9 @@ func resourceStorageAccountNetworkRulesDelete(d *schema.ResourceData, meta inter
 	resourceGroup := parsedStorageAccountNetworkRuleId.ResourceGroup
 	storageAccountName := parsedStorageAccountNetworkRuleId.Path["storageAccounts"]
 
-	locks.ByName(storageAccountName, storageAccountResourceName)
+	if err := locks.ByNameWithContext(ctx, storageAccountName, storageAccountResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Network Rules (Storage Account %q / Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(storageAccountName, storageAccountResourceName)
 
 	storageAccount, err := client.GetProperties(ctx, resourceGroup, storageAccountName, "")
@@ -85,7 +85,7 @@ func (r StorageAccountNetworkRulesResource) Exists(ctx context.Context, client *
 	storageAccountName := state.Attributes["storage_account_name"]
 	resourceGroup := state.Attributes["resource_group_name"]
 
-	resp, err := client.Storage.AccountsClient.GetProperties(ctx, resourceGroup, storageAccountName, "")
+	resp, err := client.Storage().AccountsClient.GetProperties(ctx, resourceGroup, storageAccountName, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
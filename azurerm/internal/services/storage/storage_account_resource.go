@@ -618,14 +618,16 @@ func validateAzureRMStorageAccountTags(v interface{}, _ string) (warnings []stri
 
 func resourceStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	envName := meta.(*clients.Client).Account.Environment.Name
-	client := meta.(*clients.Client).Storage.AccountsClient
+	client := meta.(*clients.Client).Storage().AccountsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	storageAccountName := d.Get("name").(string)
 	resourceGroupName := d.Get("resource_group_name").(string)
 
-	locks.ByName(storageAccountName, storageAccountResourceName)
+	if err := locks.ByNameWithContext(ctx, storageAccountName, storageAccountResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+	}
 	defer locks.UnlockByName(storageAccountName, storageAccountResourceName)
 
 	existing, err := client.GetProperties(ctx, resourceGroupName, storageAccountName, "")
@@ -748,7 +750,7 @@ func resourceStorageAccountCreate(d *schema.ResourceData, meta interface{}) erro
 	if val, ok := d.GetOk("blob_properties"); ok {
 		// FileStorage does not support blob settings
 		if accountKind != string(storage.FileStorage) {
-			blobClient := meta.(*clients.Client).Storage.BlobServicesClient
+			blobClient := meta.(*clients.Client).Storage().BlobServicesClient
 
 			blobProperties := expandBlobProperties(val.([]interface{}))
 
@@ -761,7 +763,7 @@ func resourceStorageAccountCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if val, ok := d.GetOk("queue_properties"); ok {
-		storageClient := meta.(*clients.Client).Storage
+		storageClient := meta.(*clients.Client).Storage()
 		account, err := storageClient.FindAccount(ctx, storageAccountName)
 		if err != nil {
 			return fmt.Errorf("Error retrieving Account %q: %s", storageAccountName, err)
@@ -790,7 +792,7 @@ func resourceStorageAccountCreate(d *schema.ResourceData, meta interface{}) erro
 		if accountKind != string(storage.StorageV2) && accountKind != string(storage.BlockBlobStorage) {
 			return fmt.Errorf("`static_website` is only supported for StorageV2 and BlockBlobStorage.")
 		}
-		storageClient := meta.(*clients.Client).Storage
+		storageClient := meta.(*clients.Client).Storage()
 
 		account, err := storageClient.FindAccount(ctx, storageAccountName)
 		if err != nil {
@@ -817,7 +819,7 @@ func resourceStorageAccountCreate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceStorageAccountUpdate(d *schema.ResourceData, meta interface{}) error {
 	envName := meta.(*clients.Client).Account.Environment.Name
-	client := meta.(*clients.Client).Storage.AccountsClient
+	client := meta.(*clients.Client).Storage().AccountsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -828,7 +830,9 @@ func resourceStorageAccountUpdate(d *schema.ResourceData, meta interface{}) erro
 	storageAccountName := id.Path["storageAccounts"]
 	resourceGroupName := id.ResourceGroup
 
-	locks.ByName(storageAccountName, storageAccountResourceName)
+	if err := locks.ByNameWithContext(ctx, storageAccountName, storageAccountResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+	}
 	defer locks.UnlockByName(storageAccountName, storageAccountResourceName)
 
 	accountTier := d.Get("account_tier").(string)
@@ -880,7 +884,7 @@ func resourceStorageAccountUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
 
 		opts := storage.AccountUpdateParameters{
@@ -1007,7 +1011,7 @@ func resourceStorageAccountUpdate(d *schema.ResourceData, meta interface{}) erro
 	if d.HasChange("blob_properties") {
 		// FileStorage does not support blob settings
 		if accountKind != string(storage.FileStorage) {
-			blobClient := meta.(*clients.Client).Storage.BlobServicesClient
+			blobClient := meta.(*clients.Client).Storage().BlobServicesClient
 			blobProperties := expandBlobProperties(d.Get("blob_properties").([]interface{}))
 
 			if _, err = blobClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, blobProperties); err != nil {
@@ -1019,7 +1023,7 @@ func resourceStorageAccountUpdate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if d.HasChange("queue_properties") {
-		storageClient := meta.(*clients.Client).Storage
+		storageClient := meta.(*clients.Client).Storage()
 		account, err := storageClient.FindAccount(ctx, storageAccountName)
 		if err != nil {
 			return fmt.Errorf("Error retrieving Account %q: %s", storageAccountName, err)
@@ -1048,7 +1052,7 @@ func resourceStorageAccountUpdate(d *schema.ResourceData, meta interface{}) erro
 		if accountKind != string(storage.StorageV2) && accountKind != string(storage.BlockBlobStorage) {
 			return fmt.Errorf("`static_website` is only supported for StorageV2 and BlockBlobStorage.")
 		}
-		storageClient := meta.(*clients.Client).Storage
+		storageClient := meta.(*clients.Client).Storage()
 
 		account, err := storageClient.FindAccount(ctx, storageAccountName)
 		if err != nil {
@@ -1074,7 +1078,7 @@ func resourceStorageAccountUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.AccountsClient
+	client := meta.(*clients.Client).Storage().AccountsClient
 	endpointSuffix := meta.(*clients.Client).Account.Environment.StorageEndpointSuffix
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -1223,7 +1227,7 @@ func resourceStorageAccountRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 	account, err := storageClient.FindAccount(ctx, name)
 	if err != nil {
 		return fmt.Errorf("Error retrieving Account %q: %s", name, err)
@@ -1275,7 +1279,7 @@ func resourceStorageAccountRead(d *schema.ResourceData, meta interface{}) error
 
 	// static website only supported on StorageV2 and BlockBlobStorage
 	if resp.Kind == storage.StorageV2 || resp.Kind == storage.BlockBlobStorage {
-		storageClient := meta.(*clients.Client).Storage
+		storageClient := meta.(*clients.Client).Storage()
 
 		account, err := storageClient.FindAccount(ctx, name)
 		if err != nil {
@@ -1305,7 +1309,7 @@ func resourceStorageAccountRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 	client := storageClient.AccountsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -1317,7 +1321,9 @@ func resourceStorageAccountDelete(d *schema.ResourceData, meta interface{}) erro
 	name := id.Path["storageAccounts"]
 	resourceGroup := id.ResourceGroup
 
-	locks.ByName(name, storageAccountResourceName)
+	if err := locks.ByNameWithContext(ctx, name, storageAccountResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockByName(name, storageAccountResourceName)
 
 	read, err := client.GetProperties(ctx, resourceGroup, name, "")
@@ -1356,7 +1362,9 @@ func resourceStorageAccountDelete(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(ctx, &virtualNetworkNames, network.VirtualNetworkResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
 
 	resp, err := client.Delete(ctx, resourceGroup, name)
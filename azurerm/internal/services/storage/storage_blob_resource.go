@@ -141,7 +141,7 @@ func resourceStorageBlob() *schema.Resource {
 }
 
 func resourceStorageBlobCreate(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -215,7 +215,7 @@ func resourceStorageBlobCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceStorageBlobUpdate(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -287,7 +287,7 @@ func resourceStorageBlobUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceStorageBlobRead(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -357,7 +357,7 @@ func resourceStorageBlobRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceStorageBlobDelete(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
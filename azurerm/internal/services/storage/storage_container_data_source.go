@@ -56,7 +56,7 @@ func dataSourceStorageContainer() *schema.Resource {
 }
 
 func dataSourceStorageContainerRead(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
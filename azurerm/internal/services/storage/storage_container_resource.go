@@ -83,7 +83,7 @@ func resourceStorageContainer() *schema.Resource {
 }
 
 func resourceStorageContainerCreate(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -132,7 +132,7 @@ func resourceStorageContainerCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceStorageContainerUpdate(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -181,7 +181,7 @@ func resourceStorageContainerUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceStorageContainerRead(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -234,7 +234,7 @@ func resourceStorageContainerRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceStorageContainerDelete(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
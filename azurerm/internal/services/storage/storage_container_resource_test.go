@@ -189,7 +189,7 @@ func (r StorageContainerResource) Exists(ctx context.Context, client *clients.Cl
 	if err != nil {
 		return nil, err
 	}
-	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	account, err := client.Storage().FindAccount(ctx, id.AccountName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Account %q for Container %q: %+v", id.AccountName, id.Name, err)
 	}
@@ -197,7 +197,7 @@ func (r StorageContainerResource) Exists(ctx context.Context, client *clients.Cl
 		return nil, fmt.Errorf("unable to locate Storage Account %q", id.AccountName)
 	}
 
-	containersClient, err := client.Storage.ContainersClient(ctx, *account)
+	containersClient, err := client.Storage().ContainersClient(ctx, *account)
 	if err != nil {
 		return nil, fmt.Errorf("building Containers Client: %+v", err)
 	}
@@ -213,14 +213,14 @@ func (r StorageContainerResource) Destroy(ctx context.Context, client *clients.C
 	if err != nil {
 		return nil, err
 	}
-	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	account, err := client.Storage().FindAccount(ctx, id.AccountName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Account %q for Container %q: %+v", id.AccountName, id.Name, err)
 	}
 	if account == nil {
 		return nil, fmt.Errorf("unable to locate Storage Account %q", id.AccountName)
 	}
-	containersClient, err := client.Storage.ContainersClient(ctx, *account)
+	containersClient, err := client.Storage().ContainersClient(ctx, *account)
 	if err != nil {
 		return nil, fmt.Errorf("building Containers Client: %+v", err)
 	}
@@ -29,7 +29,7 @@ func resourceStorageDataLakeGen2FileSystem() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				storageClients := meta.(*clients.Client).Storage
+				storageClients := meta.(*clients.Client).Storage()
 				ctx, cancel := context.WithTimeout(meta.(*clients.Client).StopContext, 5*time.Minute)
 				defer cancel()
 
@@ -112,9 +112,9 @@ func resourceStorageDataLakeGen2FileSystem() *schema.Resource {
 }
 
 func resourceStorageDataLakeGen2FileSystemCreate(d *schema.ResourceData, meta interface{}) error {
-	accountsClient := meta.(*clients.Client).Storage.AccountsClient
-	client := meta.(*clients.Client).Storage.FileSystemsClient
-	pathClient := meta.(*clients.Client).Storage.ADLSGen2PathsClient
+	accountsClient := meta.(*clients.Client).Storage().AccountsClient
+	client := meta.(*clients.Client).Storage().FileSystemsClient
+	pathClient := meta.(*clients.Client).Storage().ADLSGen2PathsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -190,9 +190,9 @@ func resourceStorageDataLakeGen2FileSystemCreate(d *schema.ResourceData, meta in
 }
 
 func resourceStorageDataLakeGen2FileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
-	accountsClient := meta.(*clients.Client).Storage.AccountsClient
-	client := meta.(*clients.Client).Storage.FileSystemsClient
-	pathClient := meta.(*clients.Client).Storage.ADLSGen2PathsClient
+	accountsClient := meta.(*clients.Client).Storage().AccountsClient
+	client := meta.(*clients.Client).Storage().FileSystemsClient
+	pathClient := meta.(*clients.Client).Storage().ADLSGen2PathsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -258,9 +258,9 @@ func resourceStorageDataLakeGen2FileSystemUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceStorageDataLakeGen2FileSystemRead(d *schema.ResourceData, meta interface{}) error {
-	accountsClient := meta.(*clients.Client).Storage.AccountsClient
-	client := meta.(*clients.Client).Storage.FileSystemsClient
-	pathClient := meta.(*clients.Client).Storage.ADLSGen2PathsClient
+	accountsClient := meta.(*clients.Client).Storage().AccountsClient
+	client := meta.(*clients.Client).Storage().FileSystemsClient
+	pathClient := meta.(*clients.Client).Storage().ADLSGen2PathsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -325,7 +325,7 @@ func resourceStorageDataLakeGen2FileSystemRead(d *schema.ResourceData, meta inte
 }
 
 func resourceStorageDataLakeGen2FileSystemDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.FileSystemsClient
+	client := meta.(*clients.Client).Storage().FileSystemsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -122,7 +122,7 @@ func (r StorageDataLakeGen2FileSystemResource) Exists(ctx context.Context, clien
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Storage.FileSystemsClient.GetProperties(ctx, id.AccountName, id.DirectoryName)
+	resp, err := client.Storage().FileSystemsClient.GetProperties(ctx, id.AccountName, id.DirectoryName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -137,7 +137,7 @@ func (r StorageDataLakeGen2FileSystemResource) Destroy(ctx context.Context, clie
 	if err != nil {
 		return nil, err
 	}
-	if _, err := client.Storage.FileSystemsClient.Delete(ctx, id.AccountName, id.DirectoryName); err != nil {
+	if _, err := client.Storage().FileSystemsClient.Delete(ctx, id.AccountName, id.DirectoryName); err != nil {
 		return nil, fmt.Errorf("deleting File System %q (Account %q): %+v", id.DirectoryName, id.AccountName, err)
 	}
 	return utils.Bool(true), nil
@@ -27,7 +27,7 @@ func resourceStorageDataLakeGen2Path() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				storageClients := meta.(*clients.Client).Storage
+				storageClients := meta.(*clients.Client).Storage()
 
 				ctx, cancel := context.WithTimeout(meta.(*clients.Client).StopContext, 5*time.Minute)
 				defer cancel()
@@ -141,8 +141,8 @@ func resourceStorageDataLakeGen2Path() *schema.Resource {
 }
 
 func resourceStorageDataLakeGen2PathCreate(d *schema.ResourceData, meta interface{}) error {
-	accountsClient := meta.(*clients.Client).Storage.AccountsClient
-	client := meta.(*clients.Client).Storage.ADLSGen2PathsClient
+	accountsClient := meta.(*clients.Client).Storage().AccountsClient
+	client := meta.(*clients.Client).Storage().ADLSGen2PathsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -230,8 +230,8 @@ func resourceStorageDataLakeGen2PathCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceStorageDataLakeGen2PathUpdate(d *schema.ResourceData, meta interface{}) error {
-	accountsClient := meta.(*clients.Client).Storage.AccountsClient
-	client := meta.(*clients.Client).Storage.ADLSGen2PathsClient
+	accountsClient := meta.(*clients.Client).Storage().AccountsClient
+	client := meta.(*clients.Client).Storage().ADLSGen2PathsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -294,7 +294,7 @@ func resourceStorageDataLakeGen2PathUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceStorageDataLakeGen2PathRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.ADLSGen2PathsClient
+	client := meta.(*clients.Client).Storage().ADLSGen2PathsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -342,7 +342,7 @@ func resourceStorageDataLakeGen2PathRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceStorageDataLakeGen2PathDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.ADLSGen2PathsClient
+	client := meta.(*clients.Client).Storage().ADLSGen2PathsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
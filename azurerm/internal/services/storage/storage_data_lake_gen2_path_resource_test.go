@@ -118,7 +118,7 @@ func (r StorageDataLakeGen2PathResource) Exists(ctx context.Context, client *cli
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Storage.ADLSGen2PathsClient.GetProperties(ctx, id.AccountName, id.FileSystemName, id.Path, paths.GetPropertiesActionGetStatus)
+	resp, err := client.Storage().ADLSGen2PathsClient.GetProperties(ctx, id.AccountName, id.FileSystemName, id.Path, paths.GetPropertiesActionGetStatus)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
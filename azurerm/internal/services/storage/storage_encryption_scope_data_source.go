@@ -47,7 +47,7 @@ func dataSourceStorageEncryptionScope() *schema.Resource {
 }
 
 func dataSourceStorageEncryptionScopeRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.EncryptionScopesClient
+	client := meta.(*clients.Client).Storage().EncryptionScopesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -72,7 +72,7 @@ func resourceStorageEncryptionScope() *schema.Resource {
 }
 
 func resourceStorageEncryptionScopeCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.EncryptionScopesClient
+	client := meta.(*clients.Client).Storage().EncryptionScopesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -117,7 +117,7 @@ func resourceStorageEncryptionScopeCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceStorageEncryptionScopeUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.EncryptionScopesClient
+	client := meta.(*clients.Client).Storage().EncryptionScopesClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -149,7 +149,7 @@ func resourceStorageEncryptionScopeUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceStorageEncryptionScopeRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.EncryptionScopesClient
+	client := meta.(*clients.Client).Storage().EncryptionScopesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -198,7 +198,7 @@ func resourceStorageEncryptionScopeRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceStorageEncryptionScopeDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.EncryptionScopesClient
+	client := meta.(*clients.Client).Storage().EncryptionScopesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -141,7 +141,7 @@ func (t StorageEncryptionScopeResource) Exists(ctx context.Context, clients *cli
 		return nil, err
 	}
 
-	resp, err := clients.Storage.EncryptionScopesClient.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.Name)
+	resp, err := clients.Storage().EncryptionScopesClient.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Encryption Scope %q (Account %q / Resource Group: %q): %+v", id.Name, id.StorageAccountName, id.ResourceGroup, err)
 	}
@@ -104,7 +104,7 @@ func dataSourceStorageManagementPolicy() *schema.Resource {
 }
 
 func dataSourceStorageManagementPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.ManagementPoliciesClient
+	client := meta.(*clients.Client).Storage().ManagementPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -142,7 +142,7 @@ func resourceStorageManagementPolicy() *schema.Resource {
 }
 
 func resourceStorageManagementPolicyCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.ManagementPoliciesClient
+	client := meta.(*clients.Client).Storage().ManagementPoliciesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -188,7 +188,7 @@ func resourceStorageManagementPolicyCreateOrUpdate(d *schema.ResourceData, meta
 }
 
 func resourceStorageManagementPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.ManagementPoliciesClient
+	client := meta.(*clients.Client).Storage().ManagementPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -223,7 +223,7 @@ func resourceStorageManagementPolicyRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceStorageManagementPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.ManagementPoliciesClient
+	client := meta.(*clients.Client).Storage().ManagementPoliciesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
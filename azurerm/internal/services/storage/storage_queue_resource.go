@@ -53,7 +53,7 @@ func resourceStorageQueue() *schema.Resource {
 }
 
 func resourceStorageQueueCreate(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -95,7 +95,7 @@ func resourceStorageQueueCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceStorageQueueUpdate(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -128,7 +128,7 @@ func resourceStorageQueueUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceStorageQueueRead(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -173,7 +173,7 @@ func resourceStorageQueueRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceStorageQueueDelete(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
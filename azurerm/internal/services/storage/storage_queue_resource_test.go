@@ -88,14 +88,14 @@ func (r StorageQueueResource) Exists(ctx context.Context, client *clients.Client
 	if err != nil {
 		return nil, err
 	}
-	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	account, err := client.Storage().FindAccount(ctx, id.AccountName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Account %q for Queue %q: %+v", id.AccountName, id.Name, err)
 	}
 	if account == nil {
 		return nil, fmt.Errorf("unable to determine Resource Group for Storage Queue %q (Account %q)", id.Name, id.AccountName)
 	}
-	queuesClient, err := client.Storage.QueuesClient(ctx, *account)
+	queuesClient, err := client.Storage().QueuesClient(ctx, *account)
 	if err != nil {
 		return nil, fmt.Errorf("building Queues Client: %+v", err)
 	}
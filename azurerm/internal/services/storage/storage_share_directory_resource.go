@@ -63,7 +63,7 @@ func resourceStorageShareDirectory() *schema.Resource {
 func resourceStorageShareDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 
 	accountName := d.Get("storage_account_name").(string)
 	shareName := d.Get("share_name").(string)
@@ -128,7 +128,7 @@ func resourceStorageShareDirectoryCreate(d *schema.ResourceData, meta interface{
 func resourceStorageShareDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 
 	id, err := directories.ParseResourceID(d.Id())
 	if err != nil {
@@ -161,7 +161,7 @@ func resourceStorageShareDirectoryUpdate(d *schema.ResourceData, meta interface{
 func resourceStorageShareDirectoryRead(d *schema.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 
 	id, err := directories.ParseResourceID(d.Id())
 	if err != nil {
@@ -202,7 +202,7 @@ func resourceStorageShareDirectoryRead(d *schema.ResourceData, meta interface{})
 func resourceStorageShareDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 
 	id, err := directories.ParseResourceID(d.Id())
 	if err != nil {
@@ -121,14 +121,14 @@ func (r StorageShareDirectoryResource) Exists(ctx context.Context, client *clien
 	if err != nil {
 		return nil, err
 	}
-	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	account, err := client.Storage().FindAccount(ctx, id.AccountName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Account %q for Directory %q (Share %q): %s", id.AccountName, id.DirectoryName, id.ShareName, err)
 	}
 	if account == nil {
 		return nil, fmt.Errorf("unable to determine Resource Group for Storage Share Directory %q (Share %q / Account %q)", id.DirectoryName, id.ShareName, id.AccountName)
 	}
-	dirClient, err := client.Storage.FileShareDirectoriesClient(ctx, *account)
+	dirClient, err := client.Storage().FileShareDirectoriesClient(ctx, *account)
 	if err != nil {
 		return nil, fmt.Errorf("building File Share client for Storage Account %q (Resource Group %q): %+v", id.AccountName, account.ResourceGroup, err)
 	}
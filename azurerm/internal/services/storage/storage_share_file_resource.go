@@ -93,7 +93,7 @@ func resourceStorageShareFile() *schema.Resource {
 func resourceStorageShareFileCreate(d *schema.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 
 	storageShareID, err := parse.StorageShareDataPlaneID(d.Get("storage_share_id").(string))
 	if err != nil {
@@ -186,7 +186,7 @@ func resourceStorageShareFileCreate(d *schema.ResourceData, meta interface{}) er
 func resourceStorageShareFileUpdate(d *schema.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 
 	id, err := files.ParseResourceID(d.Id())
 	if err != nil {
@@ -249,7 +249,7 @@ func resourceStorageShareFileUpdate(d *schema.ResourceData, meta interface{}) er
 func resourceStorageShareFileRead(d *schema.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 
 	id, err := files.ParseResourceID(d.Id())
 	if err != nil {
@@ -309,7 +309,7 @@ func resourceStorageShareFileRead(d *schema.ResourceData, meta interface{}) erro
 func resourceStorageShareFileDelete(d *schema.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 
 	id, err := files.ParseResourceID(d.Id())
 	if err != nil {
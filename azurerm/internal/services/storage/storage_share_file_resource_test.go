@@ -121,7 +121,7 @@ func (StorageShareFileResource) Exists(ctx context.Context, clients *clients.Cli
 		return nil, err
 	}
 
-	account, err := clients.Storage.FindAccount(ctx, id.AccountName)
+	account, err := clients.Storage().FindAccount(ctx, id.AccountName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Account %q for File %q (Share %q): %s", id.AccountName, id.FileName, id.ShareName, err)
 	}
@@ -129,7 +129,7 @@ func (StorageShareFileResource) Exists(ctx context.Context, clients *clients.Cli
 		return utils.Bool(false), nil
 	}
 
-	client, err := clients.Storage.FileShareFilesClient(ctx, *account)
+	client, err := clients.Storage().FileShareFilesClient(ctx, *account)
 	if err != nil {
 		return nil, fmt.Errorf("building File Share Files Client: %s", err)
 	}
@@ -122,7 +122,7 @@ func resourceStorageShare() *schema.Resource {
 func resourceStorageShareCreate(d *schema.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 
 	accountName := d.Get("storage_account_name").(string)
 	shareName := d.Get("name").(string)
@@ -178,7 +178,7 @@ func resourceStorageShareCreate(d *schema.ResourceData, meta interface{}) error
 func resourceStorageShareRead(d *schema.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 
 	id, err := parse.StorageShareDataPlaneID(d.Id())
 	if err != nil {
@@ -232,7 +232,7 @@ func resourceStorageShareRead(d *schema.ResourceData, meta interface{}) error {
 func resourceStorageShareUpdate(d *schema.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 
 	id, err := parse.StorageShareDataPlaneID(d.Id())
 	if err != nil {
@@ -295,7 +295,7 @@ func resourceStorageShareUpdate(d *schema.ResourceData, meta interface{}) error
 func resourceStorageShareDelete(d *schema.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 
 	id, err := parse.StorageShareDataPlaneID(d.Id())
 	if err != nil {
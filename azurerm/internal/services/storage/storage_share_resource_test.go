@@ -191,7 +191,7 @@ func (r StorageShareResource) Exists(ctx context.Context, client *clients.Client
 		return nil, err
 	}
 
-	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	account, err := client.Storage().FindAccount(ctx, id.AccountName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Account %q for Share %q: %+v", id.AccountName, id.Name, err)
 	}
@@ -199,7 +199,7 @@ func (r StorageShareResource) Exists(ctx context.Context, client *clients.Client
 		return nil, fmt.Errorf("unable to determine Account %q for Storage Share %q", id.AccountName, id.Name)
 	}
 
-	sharesClient, err := client.Storage.FileSharesClient(ctx, *account)
+	sharesClient, err := client.Storage().FileSharesClient(ctx, *account)
 	if err != nil {
 		return nil, fmt.Errorf("building File Share Client for Storage Account %q (Resource Group %q): %+v", id.AccountName, account.ResourceGroup, err)
 	}
@@ -217,7 +217,7 @@ func (r StorageShareResource) Destroy(ctx context.Context, client *clients.Clien
 		return nil, err
 	}
 
-	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	account, err := client.Storage().FindAccount(ctx, id.AccountName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Account %q for Share %q: %+v", id.AccountName, id.Name, err)
 	}
@@ -225,7 +225,7 @@ func (r StorageShareResource) Destroy(ctx context.Context, client *clients.Clien
 		return nil, fmt.Errorf("unable to determine Account %q for Storage Share %q", id.AccountName, id.Name)
 	}
 
-	sharesClient, err := client.Storage.FileSharesClient(ctx, *account)
+	sharesClient, err := client.Storage().FileSharesClient(ctx, *account)
 	if err != nil {
 		return nil, fmt.Errorf("building File Share Client for Storage Account %q (Resource Group %q): %+v", id.AccountName, account.ResourceGroup, err)
 	}
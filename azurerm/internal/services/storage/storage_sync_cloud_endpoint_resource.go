@@ -76,7 +76,7 @@ func resourceStorageSyncCloudEndpoint() *schema.Resource {
 }
 
 func resourceStorageSyncCloudEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.CloudEndpointsClient
+	client := meta.(*clients.Client).Storage().CloudEndpointsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -130,8 +130,8 @@ func resourceStorageSyncCloudEndpointCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceStorageSyncCloudEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.CloudEndpointsClient
-	gpClient := meta.(*clients.Client).Storage.SyncGroupsClient
+	client := meta.(*clients.Client).Storage().CloudEndpointsClient
+	gpClient := meta.(*clients.Client).Storage().SyncGroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -171,7 +171,7 @@ func resourceStorageSyncCloudEndpointRead(d *schema.ResourceData, meta interface
 }
 
 func resourceStorageSyncCloudEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.CloudEndpointsClient
+	client := meta.(*clients.Client).Storage().CloudEndpointsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -67,7 +67,7 @@ func (r StorageSyncCloudEndpointResource) Exists(ctx context.Context, client *cl
 		return nil, err
 	}
 
-	resp, err := client.Storage.CloudEndpointsClient.Get(ctx, id.ResourceGroup, id.StorageSyncServiceName, id.SyncGroupName, id.CloudEndpointName)
+	resp, err := client.Storage().CloudEndpointsClient.Get(ctx, id.ResourceGroup, id.StorageSyncServiceName, id.SyncGroupName, id.CloudEndpointName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
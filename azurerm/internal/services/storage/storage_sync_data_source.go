@@ -44,7 +44,7 @@ func dataSourceStorageSync() *schema.Resource {
 }
 
 func dataSourceStorageSyncRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.SyncServiceClient
+	client := meta.(*clients.Client).Storage().SyncServiceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -37,7 +37,7 @@ func dataSourceStorageSyncGroup() *schema.Resource {
 }
 
 func dataSourceStorageSyncGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.SyncGroupsClient
+	client := meta.(*clients.Client).Storage().SyncGroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
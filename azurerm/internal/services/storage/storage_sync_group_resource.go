@@ -52,7 +52,7 @@ func resourceStorageSyncGroup() *schema.Resource {
 }
 
 func resourceStorageSyncGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.SyncGroupsClient
+	client := meta.(*clients.Client).Storage().SyncGroupsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -88,8 +88,8 @@ func resourceStorageSyncGroupCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceStorageSyncGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.SyncGroupsClient
-	ssClient := meta.(*clients.Client).Storage.SyncServiceClient
+	client := meta.(*clients.Client).Storage().SyncGroupsClient
+	ssClient := meta.(*clients.Client).Storage().SyncServiceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -125,7 +125,7 @@ func resourceStorageSyncGroupRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceStorageSyncGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.SyncGroupsClient
+	client := meta.(*clients.Client).Storage().SyncGroupsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -51,7 +51,7 @@ func (r StorageSyncGroupResource) Exists(ctx context.Context, client *clients.Cl
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Storage.SyncGroupsClient.Get(ctx, id.ResourceGroup, id.StorageSyncServiceName, id.SyncGroupName)
+	resp, err := client.Storage().SyncGroupsClient.Get(ctx, id.ResourceGroup, id.StorageSyncServiceName, id.SyncGroupName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -67,7 +67,7 @@ func resourceStorageSync() *schema.Resource {
 }
 
 func resourceStorageSyncCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.SyncServiceClient
+	client := meta.(*clients.Client).Storage().SyncServiceClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -114,7 +114,7 @@ func resourceStorageSyncCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceStorageSyncRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.SyncServiceClient
+	client := meta.(*clients.Client).Storage().SyncServiceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -142,7 +142,7 @@ func resourceStorageSyncRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceStorageSyncUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.SyncServiceClient
+	client := meta.(*clients.Client).Storage().SyncServiceClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -153,7 +153,7 @@ func resourceStorageSyncUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	update := storagesync.ServiceUpdateParameters{}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -176,7 +176,7 @@ func resourceStorageSyncUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceStorageSyncDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Storage.SyncServiceClient
+	client := meta.(*clients.Client).Storage().SyncServiceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -95,7 +95,7 @@ func (r StorageSyncResource) Exists(ctx context.Context, client *clients.Client,
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Storage.SyncServiceClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Storage().SyncServiceClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
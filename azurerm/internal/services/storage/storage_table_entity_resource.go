@@ -71,7 +71,7 @@ func resourceStorageTableEntity() *schema.Resource {
 func resourceStorageTableEntityCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 
 	accountName := d.Get("storage_account_name").(string)
 	tableName := d.Get("table_name").(string)
@@ -136,7 +136,7 @@ func resourceStorageTableEntityCreateUpdate(d *schema.ResourceData, meta interfa
 func resourceStorageTableEntityRead(d *schema.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 
 	id, err := entities.ParseResourceID(d.Id())
 	if err != nil {
@@ -183,7 +183,7 @@ func resourceStorageTableEntityRead(d *schema.ResourceData, meta interface{}) er
 func resourceStorageTableEntityDelete(d *schema.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	storageClient := meta.(*clients.Client).Storage
+	storageClient := meta.(*clients.Client).Storage()
 
 	id, err := entities.ParseResourceID(d.Id())
 	if err != nil {
@@ -73,7 +73,7 @@ func (r StorageTableEntityResource) Exists(ctx context.Context, client *clients.
 	if err != nil {
 		return nil, err
 	}
-	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	account, err := client.Storage().FindAccount(ctx, id.AccountName)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Account %q for Table %q: %+v", id.AccountName, id.TableName, err)
 	}
@@ -81,7 +81,7 @@ func (r StorageTableEntityResource) Exists(ctx context.Context, client *clients.
 		return nil, fmt.Errorf("storage Account %q was not found", id.AccountName)
 	}
 
-	entitiesClient, err := client.Storage.TableEntityClient(ctx, *account)
+	entitiesClient, err := client.Storage().TableEntityClient(ctx, *account)
 	if err != nil {
 		return nil, fmt.Errorf("building Table Entity Client: %+v", err)
 	}
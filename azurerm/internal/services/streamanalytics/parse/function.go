@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FunctionId struct {
@@ -73,3 +74,12 @@ func FunctionID(input string) (*FunctionId, error) {
 
 	return &resourceId, nil
 }
+
+// FunctionIDNormalized parses a Function ID into an FunctionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the FunctionID method should be used instead for validation etc.
+func FunctionIDNormalized(input string) (*FunctionId, error) {
+	return FunctionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StreamAnalytics/streamingjobs/%s/functions/%s"))
+}
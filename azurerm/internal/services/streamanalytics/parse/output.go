@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type OutputId struct {
@@ -73,3 +74,12 @@ func OutputID(input string) (*OutputId, error) {
 
 	return &resourceId, nil
 }
+
+// OutputIDNormalized parses a Output ID into an OutputId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the OutputID method should be used instead for validation etc.
+func OutputIDNormalized(input string) (*OutputId, error) {
+	return OutputID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StreamAnalytics/streamingjobs/%s/outputs/%s"))
+}
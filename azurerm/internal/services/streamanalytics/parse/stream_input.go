@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StreamInputId struct {
@@ -73,3 +74,12 @@ func StreamInputID(input string) (*StreamInputId, error) {
 
 	return &resourceId, nil
 }
+
+// StreamInputIDNormalized parses a StreamInput ID into an StreamInputId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the StreamInputID method should be used instead for validation etc.
+func StreamInputIDNormalized(input string) (*StreamInputId, error) {
+	return StreamInputID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StreamAnalytics/streamingjobs/%s/inputs/%s"))
+}
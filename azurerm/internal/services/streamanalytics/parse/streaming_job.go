@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StreamingJobId struct {
@@ -67,3 +68,12 @@ func StreamingJobID(input string) (*StreamingJobId, error) {
 
 	return &resourceId, nil
 }
+
+// StreamingJobIDNormalized parses a StreamingJob ID into an StreamingJobId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the StreamingJobID method should be used instead for validation etc.
+func StreamingJobIDNormalized(input string) (*StreamingJobId, error) {
+	return StreamingJobID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StreamAnalytics/streamingjobs/%s"))
+}
@@ -110,7 +110,7 @@ func resourceStreamAnalyticsFunctionUDF() *schema.Resource {
 }
 
 func resourceStreamAnalyticsFunctionUDFCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.FunctionsClient
+	client := meta.(*clients.Client).StreamAnalytics().FunctionsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -177,7 +177,7 @@ func resourceStreamAnalyticsFunctionUDFCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceStreamAnalyticsFunctionUDFRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.FunctionsClient
+	client := meta.(*clients.Client).StreamAnalytics().FunctionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -229,7 +229,7 @@ func resourceStreamAnalyticsFunctionUDFRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceStreamAnalyticsFunctionUDFDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.FunctionsClient
+	client := meta.(*clients.Client).StreamAnalytics().FunctionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -129,8 +129,8 @@ func resourceStreamAnalyticsJob() *schema.Resource {
 }
 
 func resourceStreamAnalyticsJobCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.JobsClient
-	transformationsClient := meta.(*clients.Client).StreamAnalytics.TransformationsClient
+	client := meta.(*clients.Client).StreamAnalytics().JobsClient
+	transformationsClient := meta.(*clients.Client).StreamAnalytics().TransformationsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -233,7 +233,7 @@ func resourceStreamAnalyticsJobCreateUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceStreamAnalyticsJobRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.JobsClient
+	client := meta.(*clients.Client).StreamAnalytics().JobsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -287,7 +287,7 @@ func resourceStreamAnalyticsJobRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceStreamAnalyticsJobDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.JobsClient
+	client := meta.(*clients.Client).StreamAnalytics().JobsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
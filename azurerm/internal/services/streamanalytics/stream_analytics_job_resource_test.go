@@ -89,7 +89,7 @@ func (r StreamAnalyticsJobResource) Exists(ctx context.Context, client *clients.
 	name := state.Attributes["name"]
 	resourceGroup := state.Attributes["resource_group_name"]
 
-	resp, err := client.StreamAnalytics.JobsClient.Get(ctx, resourceGroup, name, "")
+	resp, err := client.StreamAnalytics().JobsClient.Get(ctx, resourceGroup, name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), err
@@ -95,7 +95,7 @@ func resourceStreamAnalyticsOutputBlob() *schema.Resource {
 }
 
 func resourceStreamAnalyticsOutputBlobCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	client := meta.(*clients.Client).StreamAnalytics().OutputsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -174,7 +174,7 @@ func resourceStreamAnalyticsOutputBlobCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceStreamAnalyticsOutputBlobRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	client := meta.(*clients.Client).StreamAnalytics().OutputsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -223,7 +223,7 @@ func resourceStreamAnalyticsOutputBlobRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceStreamAnalyticsOutputBlobDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	client := meta.(*clients.Client).StreamAnalytics().OutputsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -84,7 +84,7 @@ func resourceStreamAnalyticsOutputEventHub() *schema.Resource {
 }
 
 func resourceStreamAnalyticsOutputEventHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	client := meta.(*clients.Client).StreamAnalytics().OutputsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -155,7 +155,7 @@ func resourceStreamAnalyticsOutputEventHubCreateUpdate(d *schema.ResourceData, m
 }
 
 func resourceStreamAnalyticsOutputEventHubRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	client := meta.(*clients.Client).StreamAnalytics().OutputsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -198,7 +198,7 @@ func resourceStreamAnalyticsOutputEventHubRead(d *schema.ResourceData, meta inte
 }
 
 func resourceStreamAnalyticsOutputEventHubDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	client := meta.(*clients.Client).StreamAnalytics().OutputsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
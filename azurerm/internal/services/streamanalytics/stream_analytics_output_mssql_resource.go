@@ -96,7 +96,7 @@ func resourceStreamAnalyticsOutputSql() *schema.Resource {
 }
 
 func resourceStreamAnalyticsOutputSqlCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	client := meta.(*clients.Client).StreamAnalytics().OutputsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -159,7 +159,7 @@ func resourceStreamAnalyticsOutputSqlCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceStreamAnalyticsOutputSqlRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	client := meta.(*clients.Client).StreamAnalytics().OutputsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -199,7 +199,7 @@ func resourceStreamAnalyticsOutputSqlRead(d *schema.ResourceData, meta interface
 }
 
 func resourceStreamAnalyticsOutputSqlDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	client := meta.(*clients.Client).StreamAnalytics().OutputsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
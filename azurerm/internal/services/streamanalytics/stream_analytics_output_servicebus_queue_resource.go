@@ -84,7 +84,7 @@ func resourceStreamAnalyticsOutputServiceBusQueue() *schema.Resource {
 }
 
 func resourceStreamAnalyticsOutputServiceBusQueueCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	client := meta.(*clients.Client).StreamAnalytics().OutputsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -155,7 +155,7 @@ func resourceStreamAnalyticsOutputServiceBusQueueCreateUpdate(d *schema.Resource
 }
 
 func resourceStreamAnalyticsOutputServiceBusQueueRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	client := meta.(*clients.Client).StreamAnalytics().OutputsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -198,7 +198,7 @@ func resourceStreamAnalyticsOutputServiceBusQueueRead(d *schema.ResourceData, me
 }
 
 func resourceStreamAnalyticsOutputServiceBusQueueDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	client := meta.(*clients.Client).StreamAnalytics().OutputsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
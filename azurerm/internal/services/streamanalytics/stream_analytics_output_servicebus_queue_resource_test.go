@@ -101,7 +101,7 @@ func (r StreamAnalyticsOutputServiceBusQueueResource) Exists(ctx context.Context
 	jobName := state.Attributes["stream_analytics_job_name"]
 	resourceGroup := state.Attributes["resource_group_name"]
 
-	resp, err := client.StreamAnalytics.OutputsClient.Get(ctx, resourceGroup, jobName, name)
+	resp, err := client.StreamAnalytics().OutputsClient.Get(ctx, resourceGroup, jobName, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
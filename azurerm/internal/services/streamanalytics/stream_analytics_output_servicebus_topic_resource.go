@@ -84,7 +84,7 @@ func resourceStreamAnalyticsOutputServiceBusTopic() *schema.Resource {
 }
 
 func resourceStreamAnalyticsOutputServiceBusTopicCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	client := meta.(*clients.Client).StreamAnalytics().OutputsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -155,7 +155,7 @@ func resourceStreamAnalyticsOutputServiceBusTopicCreateUpdate(d *schema.Resource
 }
 
 func resourceStreamAnalyticsOutputServiceBusTopicRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	client := meta.(*clients.Client).StreamAnalytics().OutputsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -198,7 +198,7 @@ func resourceStreamAnalyticsOutputServiceBusTopicRead(d *schema.ResourceData, me
 }
 
 func resourceStreamAnalyticsOutputServiceBusTopicDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	client := meta.(*clients.Client).StreamAnalytics().OutputsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
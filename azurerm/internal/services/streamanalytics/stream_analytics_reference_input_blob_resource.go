@@ -96,7 +96,7 @@ func resourceStreamAnalyticsReferenceInputBlob() *schema.Resource {
 }
 
 func resourceStreamAnalyticsReferenceInputBlobCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.InputsClient
+	client := meta.(*clients.Client).StreamAnalytics().InputsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -130,7 +130,7 @@ func resourceStreamAnalyticsReferenceInputBlobCreate(d *schema.ResourceData, met
 }
 
 func resourceStreamAnalyticsReferenceInputBlobUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.InputsClient
+	client := meta.(*clients.Client).StreamAnalytics().InputsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -153,7 +153,7 @@ func resourceStreamAnalyticsReferenceInputBlobUpdate(d *schema.ResourceData, met
 }
 
 func resourceStreamAnalyticsReferenceInputBlobRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.InputsClient
+	client := meta.(*clients.Client).StreamAnalytics().InputsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -207,7 +207,7 @@ func resourceStreamAnalyticsReferenceInputBlobRead(d *schema.ResourceData, meta
 }
 
 func resourceStreamAnalyticsReferenceInputBlobDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.InputsClient
+	client := meta.(*clients.Client).StreamAnalytics().InputsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -95,7 +95,7 @@ func resourceStreamAnalyticsStreamInputBlob() *schema.Resource {
 }
 
 func resourceStreamAnalyticsStreamInputBlobCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.InputsClient
+	client := meta.(*clients.Client).StreamAnalytics().InputsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -165,7 +165,7 @@ func resourceStreamAnalyticsStreamInputBlobCreateUpdate(d *schema.ResourceData,
 }
 
 func resourceStreamAnalyticsStreamInputBlobRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.InputsClient
+	client := meta.(*clients.Client).StreamAnalytics().InputsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -219,7 +219,7 @@ func resourceStreamAnalyticsStreamInputBlobRead(d *schema.ResourceData, meta int
 }
 
 func resourceStreamAnalyticsStreamInputBlobDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.InputsClient
+	client := meta.(*clients.Client).StreamAnalytics().InputsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -90,7 +90,7 @@ func resourceStreamAnalyticsStreamInputEventHub() *schema.Resource {
 }
 
 func resourceStreamAnalyticsStreamInputEventHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.InputsClient
+	client := meta.(*clients.Client).StreamAnalytics().InputsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -154,7 +154,7 @@ func resourceStreamAnalyticsStreamInputEventHubCreateUpdate(d *schema.ResourceDa
 }
 
 func resourceStreamAnalyticsStreamInputEventHubRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.InputsClient
+	client := meta.(*clients.Client).StreamAnalytics().InputsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -203,7 +203,7 @@ func resourceStreamAnalyticsStreamInputEventHubRead(d *schema.ResourceData, meta
 }
 
 func resourceStreamAnalyticsStreamInputEventHubDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).StreamAnalytics.InputsClient
+	client := meta.(*clients.Client).StreamAnalytics().InputsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -61,7 +61,7 @@ func dataSourceSubscription() *schema.Resource {
 
 func dataSourceSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client)
-	groupClient := client.Subscription.Client
+	groupClient := client.Subscription().Client
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
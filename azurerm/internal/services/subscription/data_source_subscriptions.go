@@ -84,7 +84,7 @@ func dataSourceSubscriptions() *schema.Resource {
 
 func dataSourceSubscriptionsRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*clients.Client)
-	subClient := armClient.Subscription.Client
+	subClient := armClient.Subscription().Client
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
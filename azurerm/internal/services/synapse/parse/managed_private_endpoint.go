@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ManagedPrivateEndpointId struct {
@@ -79,3 +80,12 @@ func ManagedPrivateEndpointID(input string) (*ManagedPrivateEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+// ManagedPrivateEndpointIDNormalized parses a ManagedPrivateEndpoint ID into an ManagedPrivateEndpointId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ManagedPrivateEndpointID method should be used instead for validation etc.
+func ManagedPrivateEndpointIDNormalized(input string) (*ManagedPrivateEndpointId, error) {
+	return ManagedPrivateEndpointID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Synapse/workspaces/%s/managedVirtualNetworks/%s/managedPrivateEndpoints/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SparkPoolId struct {
@@ -73,3 +74,12 @@ func SparkPoolID(input string) (*SparkPoolId, error) {
 
 	return &resourceId, nil
 }
+
+// SparkPoolIDNormalized parses a SparkPool ID into an SparkPoolId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SparkPoolID method should be used instead for validation etc.
+func SparkPoolIDNormalized(input string) (*SparkPoolId, error) {
+	return SparkPoolID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Synapse/workspaces/%s/bigDataPools/%s"))
+}
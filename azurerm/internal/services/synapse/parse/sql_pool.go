@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SqlPoolId struct {
@@ -73,3 +74,12 @@ func SqlPoolID(input string) (*SqlPoolId, error) {
 
 	return &resourceId, nil
 }
+
+// SqlPoolIDNormalized parses a SqlPool ID into an SqlPoolId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SqlPoolID method should be used instead for validation etc.
+func SqlPoolIDNormalized(input string) (*SqlPoolId, error) {
+	return SqlPoolID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Synapse/workspaces/%s/sqlPools/%s"))
+}
@@ -67,7 +67,7 @@ func resourceSynapseFirewallRule() *schema.Resource {
 }
 
 func resourceSynapseFirewallRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Synapse.FirewallRulesClient
+	client := meta.(*clients.Client).Synapse().FirewallRulesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -116,7 +116,7 @@ func resourceSynapseFirewallRuleCreateUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceSynapseFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Synapse.FirewallRulesClient
+	client := meta.(*clients.Client).Synapse().FirewallRulesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -149,7 +149,7 @@ func resourceSynapseFirewallRuleRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceSynapseFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Synapse.FirewallRulesClient
+	client := meta.(*clients.Client).Synapse().FirewallRulesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -74,7 +74,7 @@ func (r SynapseFirewallRuleResource) Exists(ctx context.Context, client *clients
 		return nil, err
 	}
 
-	resp, err := client.Synapse.FirewallRulesClient.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+	resp, err := client.Synapse().FirewallRulesClient.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -69,8 +69,8 @@ func resourceSynapseManagedPrivateEndpoint() *schema.Resource {
 }
 
 func resourceSynapseManagedPrivateEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	workspaceClient := meta.(*clients.Client).Synapse.WorkspaceClient
-	synapseClient := meta.(*clients.Client).Synapse
+	workspaceClient := meta.(*clients.Client).Synapse().WorkspaceClient
+	synapseClient := meta.(*clients.Client).Synapse()
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	environment := meta.(*clients.Client).Account.Environment
@@ -127,7 +127,7 @@ func resourceSynapseManagedPrivateEndpointCreate(d *schema.ResourceData, meta in
 }
 
 func resourceSynapseManagedPrivateEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	synapseClient := meta.(*clients.Client).Synapse
+	synapseClient := meta.(*clients.Client).Synapse()
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	environment := meta.(*clients.Client).Account.Environment
@@ -163,7 +163,7 @@ func resourceSynapseManagedPrivateEndpointRead(d *schema.ResourceData, meta inte
 }
 
 func resourceSynapseManagedPrivateEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	synapseClient := meta.(*clients.Client).Synapse
+	synapseClient := meta.(*clients.Client).Synapse()
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	environment := meta.(*clients.Client).Account.Environment
@@ -53,7 +53,7 @@ func (r SynapseManagedPrivateEndpointResource) Exists(ctx context.Context, clien
 	}
 
 	environment := client.Account.Environment
-	managedPrivateEndpointsClient, err := client.Synapse.ManagedPrivateEndpointsClient(id.WorkspaceName, environment.SynapseEndpointSuffix)
+	managedPrivateEndpointsClient, err := client.Synapse().ManagedPrivateEndpointsClient(id.WorkspaceName, environment.SynapseEndpointSuffix)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +66,7 @@ func resourceSynapseRoleAssignment() *schema.Resource {
 }
 
 func resourceSynapseRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
-	synapseClient := meta.(*clients.Client).Synapse
+	synapseClient := meta.(*clients.Client).Synapse()
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	environment := meta.(*clients.Client).Account.Environment
@@ -122,7 +122,7 @@ func resourceSynapseRoleAssignmentCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceSynapseRoleAssignmentRead(d *schema.ResourceData, meta interface{}) error {
-	synapseClient := meta.(*clients.Client).Synapse
+	synapseClient := meta.(*clients.Client).Synapse()
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	environment := meta.(*clients.Client).Account.Environment
@@ -160,7 +160,7 @@ func resourceSynapseRoleAssignmentRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceSynapseRoleAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
-	synapseClient := meta.(*clients.Client).Synapse
+	synapseClient := meta.(*clients.Client).Synapse()
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	environment := meta.(*clients.Client).Account.Environment
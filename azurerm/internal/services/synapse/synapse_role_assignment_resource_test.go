@@ -53,7 +53,7 @@ func (r SynapseRoleAssignmentResource) Exists(ctx context.Context, client *clien
 	}
 
 	environment := client.Account.Environment
-	accessClient, err := client.Synapse.AccessControlClient(id.Workspace.Name, environment.SynapseEndpointSuffix)
+	accessClient, err := client.Synapse().AccessControlClient(id.Workspace.Name, environment.SynapseEndpointSuffix)
 	if err != nil {
 		return nil, err
 	}
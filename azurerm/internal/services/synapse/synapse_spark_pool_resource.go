@@ -160,8 +160,8 @@ func resourceSynapseSparkPool() *schema.Resource {
 }
 
 func resourceSynapseSparkPoolCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Synapse.SparkPoolClient
-	workspaceClient := meta.(*clients.Client).Synapse.WorkspaceClient
+	client := meta.(*clients.Client).Synapse().SparkPoolClient
+	workspaceClient := meta.(*clients.Client).Synapse().WorkspaceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -228,7 +228,7 @@ func resourceSynapseSparkPoolCreateUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceSynapseSparkPoolRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Synapse.SparkPoolClient
+	client := meta.(*clients.Client).Synapse().SparkPoolClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -269,7 +269,7 @@ func resourceSynapseSparkPoolRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceSynapseSparkPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Synapse.SparkPoolClient
+	client := meta.(*clients.Client).Synapse().SparkPoolClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -98,7 +98,7 @@ func (r SynapseSparkPoolResource) Exists(ctx context.Context, client *clients.Cl
 		return nil, err
 	}
 
-	resp, err := client.Synapse.SparkPoolClient.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.BigDataPoolName)
+	resp, err := client.Synapse().SparkPoolClient.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.BigDataPoolName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -164,9 +164,9 @@ func resourceSynapseSqlPool() *schema.Resource {
 }
 
 func resourceSynapseSqlPoolCreate(d *schema.ResourceData, meta interface{}) error {
-	sqlClient := meta.(*clients.Client).Synapse.SqlPoolClient
-	sqlPTDEClient := meta.(*clients.Client).Synapse.SqlPoolTransparentDataEncryptionClient
-	workspaceClient := meta.(*clients.Client).Synapse.WorkspaceClient
+	sqlClient := meta.(*clients.Client).Synapse().SqlPoolClient
+	sqlPTDEClient := meta.(*clients.Client).Synapse().SqlPoolTransparentDataEncryptionClient
+	workspaceClient := meta.(*clients.Client).Synapse().WorkspaceClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -256,8 +256,8 @@ func resourceSynapseSqlPoolCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceSynapseSqlPoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	sqlClient := meta.(*clients.Client).Synapse.SqlPoolClient
-	sqlPTDEClient := meta.(*clients.Client).Synapse.SqlPoolTransparentDataEncryptionClient
+	sqlClient := meta.(*clients.Client).Synapse().SqlPoolClient
+	sqlPTDEClient := meta.(*clients.Client).Synapse().SqlPoolTransparentDataEncryptionClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -282,7 +282,7 @@ func resourceSynapseSqlPoolUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	if d.HasChanges("sku_name", "tags") {
+	if d.HasChanges("sku_name", "tags", "tags_all") {
 		sqlPoolInfo := synapse.SQLPoolPatchInfo{
 			Sku: &synapse.Sku{
 				Name: utils.String(d.Get("sku_name").(string)),
@@ -319,8 +319,8 @@ func resourceSynapseSqlPoolUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceSynapseSqlPoolRead(d *schema.ResourceData, meta interface{}) error {
-	sqlClient := meta.(*clients.Client).Synapse.SqlPoolClient
-	sqlPTDEClient := meta.(*clients.Client).Synapse.SqlPoolTransparentDataEncryptionClient
+	sqlClient := meta.(*clients.Client).Synapse().SqlPoolClient
+	sqlPTDEClient := meta.(*clients.Client).Synapse().SqlPoolTransparentDataEncryptionClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -365,7 +365,7 @@ func resourceSynapseSqlPoolRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceSynapseSqlPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	sqlClient := meta.(*clients.Client).Synapse.SqlPoolClient
+	sqlClient := meta.(*clients.Client).Synapse().SqlPoolClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -47,7 +47,7 @@ func dataSourceSynapseWorkspace() *schema.Resource {
 }
 
 func dataSourceSynapseWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Synapse.WorkspaceClient
+	client := meta.(*clients.Client).Synapse().WorkspaceClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
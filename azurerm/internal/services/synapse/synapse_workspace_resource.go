@@ -234,9 +234,9 @@ func resourceSynapseWorkspace() *schema.Resource {
 }
 
 func resourceSynapseWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Synapse.WorkspaceClient
-	aadAdminClient := meta.(*clients.Client).Synapse.WorkspaceAadAdminsClient
-	identitySQLControlClient := meta.(*clients.Client).Synapse.WorkspaceManagedIdentitySQLControlSettingsClient
+	client := meta.(*clients.Client).Synapse().WorkspaceClient
+	aadAdminClient := meta.(*clients.Client).Synapse().WorkspaceAadAdminsClient
+	identitySQLControlClient := meta.(*clients.Client).Synapse().WorkspaceManagedIdentitySQLControlSettingsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -314,9 +314,9 @@ func resourceSynapseWorkspaceCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceSynapseWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Synapse.WorkspaceClient
-	aadAdminClient := meta.(*clients.Client).Synapse.WorkspaceAadAdminsClient
-	identitySQLControlClient := meta.(*clients.Client).Synapse.WorkspaceManagedIdentitySQLControlSettingsClient
+	client := meta.(*clients.Client).Synapse().WorkspaceClient
+	aadAdminClient := meta.(*clients.Client).Synapse().WorkspaceAadAdminsClient
+	identitySQLControlClient := meta.(*clients.Client).Synapse().WorkspaceManagedIdentitySQLControlSettingsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -386,9 +386,9 @@ func resourceSynapseWorkspaceRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceSynapseWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Synapse.WorkspaceClient
-	aadAdminClient := meta.(*clients.Client).Synapse.WorkspaceAadAdminsClient
-	identitySQLControlClient := meta.(*clients.Client).Synapse.WorkspaceManagedIdentitySQLControlSettingsClient
+	client := meta.(*clients.Client).Synapse().WorkspaceClient
+	aadAdminClient := meta.(*clients.Client).Synapse().WorkspaceAadAdminsClient
+	identitySQLControlClient := meta.(*clients.Client).Synapse().WorkspaceManagedIdentitySQLControlSettingsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -397,7 +397,7 @@ func resourceSynapseWorkspaceUpdate(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	if d.HasChanges("tags", "sql_administrator_login_password", "github_repo", "azure_devops_repo") {
+	if d.HasChanges("tags", "tags_all", "sql_administrator_login_password", "github_repo", "azure_devops_repo") {
 		workspacePatchInfo := synapse.WorkspacePatchInfo{
 			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 			WorkspacePatchProperties: &synapse.WorkspacePatchProperties{
@@ -450,7 +450,7 @@ func resourceSynapseWorkspaceUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceSynapseWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Synapse.WorkspaceClient
+	client := meta.(*clients.Client).Synapse().WorkspaceClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
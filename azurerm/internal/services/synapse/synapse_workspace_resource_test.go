@@ -136,7 +136,7 @@ func (r SynapseWorkspaceResource) Exists(ctx context.Context, client *clients.Cl
 		return nil, err
 	}
 
-	resp, err := client.Synapse.WorkspaceClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Synapse().WorkspaceClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AzureEndpointId struct {
@@ -73,3 +74,12 @@ func AzureEndpointID(input string) (*AzureEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+// AzureEndpointIDNormalized parses a AzureEndpoint ID into an AzureEndpointId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the AzureEndpointID method should be used instead for validation etc.
+func AzureEndpointIDNormalized(input string) (*AzureEndpointId, error) {
+	return AzureEndpointID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/trafficManagerProfiles/%s/azureEndpoints/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ExternalEndpointId struct {
@@ -73,3 +74,12 @@ func ExternalEndpointID(input string) (*ExternalEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+// ExternalEndpointIDNormalized parses a ExternalEndpoint ID into an ExternalEndpointId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ExternalEndpointID method should be used instead for validation etc.
+func ExternalEndpointIDNormalized(input string) (*ExternalEndpointId, error) {
+	return ExternalEndpointID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/trafficManagerProfiles/%s/externalEndpoints/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NestedEndpointId struct {
@@ -73,3 +74,12 @@ func NestedEndpointID(input string) (*NestedEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+// NestedEndpointIDNormalized parses a NestedEndpoint ID into an NestedEndpointId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the NestedEndpointID method should be used instead for validation etc.
+func NestedEndpointIDNormalized(input string) (*NestedEndpointId, error) {
+	return NestedEndpointID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/trafficManagerProfiles/%s/nestedEndpoints/%s"))
+}
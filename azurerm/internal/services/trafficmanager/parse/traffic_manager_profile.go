@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type TrafficManagerProfileId struct {
@@ -67,3 +68,12 @@ func TrafficManagerProfileID(input string) (*TrafficManagerProfileId, error) {
 
 	return &resourceId, nil
 }
+
+// TrafficManagerProfileIDNormalized parses a TrafficManagerProfile ID into an TrafficManagerProfileId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the TrafficManagerProfileID method should be used instead for validation etc.
+func TrafficManagerProfileIDNormalized(input string) (*TrafficManagerProfileId, error) {
+	return TrafficManagerProfileID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/trafficManagerProfiles/%s"))
+}
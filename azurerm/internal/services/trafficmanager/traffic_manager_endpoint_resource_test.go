@@ -282,7 +282,7 @@ func (r TrafficManagerEndpointResource) Exists(ctx context.Context, client *clie
 	profileName := state.Attributes["profile_name"]
 	resourceGroup := state.Attributes["resource_group_name"]
 
-	resp, err := client.TrafficManager.EndpointsClient.Get(ctx, resourceGroup, profileName, path.Base(endpointType), name)
+	resp, err := client.TrafficManager().EndpointsClient.Get(ctx, resourceGroup, profileName, path.Base(endpointType), name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -298,7 +298,7 @@ func (r TrafficManagerEndpointResource) Destroy(ctx context.Context, client *cli
 	profileName := state.Attributes["profile_name"]
 	resourceGroup := state.Attributes["resource_group_name"]
 
-	if _, err := client.TrafficManager.EndpointsClient.Delete(ctx, resourceGroup, profileName, path.Base(endpointType), name); err != nil {
+	if _, err := client.TrafficManager().EndpointsClient.Delete(ctx, resourceGroup, profileName, path.Base(endpointType), name); err != nil {
 		return nil, fmt.Errorf("deleting Traffic Manager Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 	return utils.Bool(true), nil
@@ -31,7 +31,7 @@ func dataSourceArmTrafficManagerGeographicalLocation() *schema.Resource {
 }
 
 func dataSourceArmTrafficManagerGeographicalLocationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).TrafficManager.GeographialHierarchiesClient
+	client := meta.(*clients.Client).TrafficManager().GeographialHierarchiesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -138,7 +138,7 @@ func dataSourceArmTrafficManagerProfile() *schema.Resource {
 }
 
 func dataSourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).TrafficManager.ProfilesClient
+	client := meta.(*clients.Client).TrafficManager().ProfilesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -192,7 +192,7 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 }
 
 func resourceArmTrafficManagerProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).TrafficManager.ProfilesClient
+	client := meta.(*clients.Client).TrafficManager().ProfilesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -254,7 +254,7 @@ func resourceArmTrafficManagerProfileCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).TrafficManager.ProfilesClient
+	client := meta.(*clients.Client).TrafficManager().ProfilesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -293,7 +293,7 @@ func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface
 }
 
 func resourceArmTrafficManagerProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).TrafficManager.ProfilesClient
+	client := meta.(*clients.Client).TrafficManager().ProfilesClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -305,7 +305,7 @@ func resourceArmTrafficManagerProfileUpdate(d *schema.ResourceData, meta interfa
 	update := trafficmanager.Profile{
 		ProfileProperties: &trafficmanager.ProfileProperties{},
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -345,7 +345,7 @@ func resourceArmTrafficManagerProfileUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceArmTrafficManagerProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).TrafficManager.ProfilesClient
+	client := meta.(*clients.Client).TrafficManager().ProfilesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -243,7 +243,7 @@ func (r TrafficManagerProfileResource) Exists(ctx context.Context, client *clien
 	name := state.Attributes["name"]
 	resourceGroup := state.Attributes["resource_group_name"]
 
-	resp, err := client.TrafficManager.ProfilesClient.Get(ctx, resourceGroup, name)
+	resp, err := client.TrafficManager().ProfilesClient.Get(ctx, resourceGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
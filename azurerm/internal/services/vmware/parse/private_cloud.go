@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PrivateCloudId struct {
@@ -67,3 +68,12 @@ func PrivateCloudID(input string) (*PrivateCloudId, error) {
 
 	return &resourceId, nil
 }
+
+// PrivateCloudIDNormalized parses a PrivateCloud ID into an PrivateCloudId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the PrivateCloudID method should be used instead for validation etc.
+func PrivateCloudIDNormalized(input string) (*PrivateCloudId, error) {
+	return PrivateCloudID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AVS/privateClouds/%s"))
+}
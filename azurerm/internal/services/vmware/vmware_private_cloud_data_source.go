@@ -148,7 +148,7 @@ func dataSourceVmwarePrivateCloud() *schema.Resource {
 }
 
 func dataSourceVmwarePrivateCloudRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Vmware.PrivateCloudClient
+	client := meta.(*clients.Client).Vmware().PrivateCloudClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -192,7 +192,7 @@ func resourceVmwarePrivateCloud() *schema.Resource {
 }
 func resourceVmwarePrivateCloudCreate(d *schema.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).Vmware.PrivateCloudClient
+	client := meta.(*clients.Client).Vmware().PrivateCloudClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -248,7 +248,7 @@ func resourceVmwarePrivateCloudCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceVmwarePrivateCloudRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Vmware.PrivateCloudClient
+	client := meta.(*clients.Client).Vmware().PrivateCloudClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -297,7 +297,7 @@ func resourceVmwarePrivateCloudRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceVmwarePrivateCloudUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Vmware.PrivateCloudClient
+	client := meta.(*clients.Client).Vmware().PrivateCloudClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -328,7 +328,7 @@ func resourceVmwarePrivateCloudUpdate(d *schema.ResourceData, meta interface{})
 		privateCloudUpdate.PrivateCloudUpdateProperties.Internet = internet
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		privateCloudUpdate.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -344,7 +344,7 @@ func resourceVmwarePrivateCloudUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceVmwarePrivateCloudDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Vmware.PrivateCloudClient
+	client := meta.(*clients.Client).Vmware().PrivateCloudClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -105,7 +105,7 @@ func (VmwarePrivateCloudResource) Exists(ctx context.Context, clients *clients.C
 		return nil, err
 	}
 
-	resp, err := clients.Vmware.PrivateCloudClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := clients.Vmware().PrivateCloudClient.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving Vmware Private Cloud %q (resource group: %q): %+v", id.Name, id.ResourceGroup, err)
 	}
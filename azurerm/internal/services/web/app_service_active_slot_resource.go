@@ -52,7 +52,7 @@ func resourceAppServiceActiveSlot() *schema.Resource {
 }
 
 func resourceAppServiceActiveSlotCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -93,7 +93,7 @@ func resourceAppServiceActiveSlotCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceAppServiceActiveSlotRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -83,8 +83,8 @@ func resourceAppServiceCertificateBinding() *schema.Resource {
 }
 
 func resourceAppServiceCertificateBindingCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
-	certClient := meta.(*clients.Client).Web.CertificatesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
+	certClient := meta.(*clients.Client).Web().CertificatesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -133,7 +133,9 @@ func resourceAppServiceCertificateBindingCreate(d *schema.ResourceData, meta int
 		}
 	}
 
-	locks.ByName(id.HostnameBindingId.SiteName, appServiceHostnameBindingResourceName)
+	if err := locks.ByNameWithContext(ctx, id.HostnameBindingId.SiteName, appServiceHostnameBindingResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.HostnameBindingId.SiteName, appServiceHostnameBindingResourceName)
 
 	binding.HostNameBindingProperties.SslState = web.SslState(d.Get("ssl_state").(string))
@@ -149,7 +151,7 @@ func resourceAppServiceCertificateBindingCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAppServiceCertificateBindingRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -186,7 +188,7 @@ func resourceAppServiceCertificateBindingRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAppServiceCertificateBindingDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -212,7 +214,9 @@ func resourceAppServiceCertificateBindingDelete(d *schema.ResourceData, meta int
 		return nil
 	}
 
-	locks.ByName(id.HostnameBindingId.SiteName, appServiceHostnameBindingResourceName)
+	if err := locks.ByNameWithContext(ctx, id.HostnameBindingId.SiteName, appServiceHostnameBindingResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.HostnameBindingId.SiteName, appServiceHostnameBindingResourceName)
 
 	log.Printf("[DEBUG] Deleting App Service Hostname Binding %q (App Service %q / Resource Group %q)", id.HostnameBindingId.Name, id.HostnameBindingId.SiteName, id.HostnameBindingId.ResourceGroup)
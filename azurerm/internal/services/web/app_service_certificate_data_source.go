@@ -76,7 +76,7 @@ func dataSourceAppServiceCertificate() *schema.Resource {
 }
 
 func dataSourceAppServiceCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.CertificatesClient
+	client := meta.(*clients.Client).Web().CertificatesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
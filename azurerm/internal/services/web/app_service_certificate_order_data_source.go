@@ -138,7 +138,7 @@ func dataSourceAppServiceCertificateOrder() *schema.Resource {
 }
 
 func dataSourceAppServiceCertificateOrderRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.CertificatesOrderClient
+	client := meta.(*clients.Client).Web().CertificatesOrderClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
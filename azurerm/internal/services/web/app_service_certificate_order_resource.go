@@ -171,7 +171,7 @@ func resourceAppServiceCertificateOrder() *schema.Resource {
 }
 
 func resourceAppServiceCertificateOrderCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.CertificatesOrderClient
+	client := meta.(*clients.Client).Web().CertificatesOrderClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -248,7 +248,7 @@ func resourceAppServiceCertificateOrderCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAppServiceCertificateOrderRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.CertificatesOrderClient
+	client := meta.(*clients.Client).Web().CertificatesOrderClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -314,7 +314,7 @@ func resourceAppServiceCertificateOrderRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAppServiceCertificateOrderDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.CertificatesOrderClient
+	client := meta.(*clients.Client).Web().CertificatesOrderClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
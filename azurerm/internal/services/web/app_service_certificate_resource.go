@@ -125,9 +125,9 @@ func resourceAppServiceCertificate() *schema.Resource {
 }
 
 func resourceAppServiceCertificateCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).Web.CertificatesClient
-	resourcesClient := meta.(*clients.Client).Resource
+	keyVaultsClient := meta.(*clients.Client).KeyVault()
+	client := meta.(*clients.Client).Web().CertificatesClient
+	resourcesClient := meta.(*clients.Client).Resource()
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -219,7 +219,7 @@ func resourceAppServiceCertificateCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceAppServiceCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.CertificatesClient
+	client := meta.(*clients.Client).Web().CertificatesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -263,7 +263,7 @@ func resourceAppServiceCertificateRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAppServiceCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.CertificatesClient
+	client := meta.(*clients.Client).Web().CertificatesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
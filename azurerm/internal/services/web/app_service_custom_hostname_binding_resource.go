@@ -80,7 +80,7 @@ func resourceAppServiceCustomHostnameBinding() *schema.Resource {
 }
 
 func resourceAppServiceCustomHostnameBindingCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -92,7 +92,9 @@ func resourceAppServiceCustomHostnameBindingCreate(d *schema.ResourceData, meta
 	sslState := d.Get("ssl_state").(string)
 	thumbprint := d.Get("thumbprint").(string)
 
-	locks.ByName(appServiceName, appServiceCustomHostnameBindingResourceName)
+	if err := locks.ByNameWithContext(ctx, appServiceName, appServiceCustomHostnameBindingResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Custom Hostname Binding %q (App Service %q / Resource Group %q): %+v", hostname, appServiceName, resourceGroup, err)
+	}
 	defer locks.UnlockByName(appServiceName, appServiceCustomHostnameBindingResourceName)
 
 	if d.IsNewResource() {
@@ -148,7 +150,7 @@ func resourceAppServiceCustomHostnameBindingCreate(d *schema.ResourceData, meta
 }
 
 func resourceAppServiceCustomHostnameBindingRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -181,7 +183,7 @@ func resourceAppServiceCustomHostnameBindingRead(d *schema.ResourceData, meta in
 }
 
 func resourceAppServiceCustomHostnameBindingDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -190,7 +192,9 @@ func resourceAppServiceCustomHostnameBindingDelete(d *schema.ResourceData, meta
 		return err
 	}
 
-	locks.ByName(id.AppServiceName, appServiceCustomHostnameBindingResourceName)
+	if err := locks.ByNameWithContext(ctx, id.AppServiceName, appServiceCustomHostnameBindingResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.AppServiceName, appServiceCustomHostnameBindingResourceName)
 
 	log.Printf("[DEBUG] Deleting App Service Hostname Binding %q (App Service %q / Resource Group %q)", id.Name, id.AppServiceName, id.ResourceGroup)
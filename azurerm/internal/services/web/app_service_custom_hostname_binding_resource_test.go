@@ -128,7 +128,7 @@ func (r ServiceCustomHostnameBindingResource) Exists(ctx context.Context, client
 		return nil, err
 	}
 
-	resp, err := clients.Web.AppServicesClient.GetHostNameBinding(ctx, id.ResourceGroup, id.AppServiceName, id.Name)
+	resp, err := clients.Web().AppServicesClient.GetHostNameBinding(ctx, id.ResourceGroup, id.AppServiceName, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
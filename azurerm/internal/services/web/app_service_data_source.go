@@ -150,7 +150,7 @@ func dataSourceAppService() *schema.Resource {
 }
 
 func dataSourceAppServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
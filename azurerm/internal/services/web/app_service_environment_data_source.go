@@ -83,7 +83,7 @@ func dataSourceAppServiceEnvironment() *schema.Resource {
 }
 
 func dataSourceAppServiceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServiceEnvironmentsClient
+	client := meta.(*clients.Client).Web().AppServiceEnvironmentsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -156,8 +156,8 @@ func resourceAppServiceEnvironment() *schema.Resource {
 }
 
 func resourceAppServiceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServiceEnvironmentsClient
-	networksClient := meta.(*clients.Client).Network.VnetClient
+	client := meta.(*clients.Client).Web().AppServiceEnvironmentsClient
+	networksClient := meta.(*clients.Client).Network().VnetClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -272,7 +272,7 @@ func resourceAppServiceEnvironmentCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAppServiceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServiceEnvironmentsClient
+	client := meta.(*clients.Client).Web().AppServiceEnvironmentsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -337,7 +337,7 @@ func resourceAppServiceEnvironmentUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAppServiceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServiceEnvironmentsClient
+	client := meta.(*clients.Client).Web().AppServiceEnvironmentsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -392,7 +392,7 @@ func resourceAppServiceEnvironmentRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAppServiceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServiceEnvironmentsClient
+	client := meta.(*clients.Client).Web().AppServiceEnvironmentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -109,7 +109,7 @@ func resourceAppServiceHybridConnection() *schema.Resource {
 }
 
 func resourceAppServiceHybridConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -162,7 +162,7 @@ func resourceAppServiceHybridConnectionCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAppServiceHybridConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -196,7 +196,7 @@ func resourceAppServiceHybridConnectionRead(d *schema.ResourceData, meta interfa
 
 	// key values are not returned in the response, so we get the primary key from the relay namespace ListKeys func
 	if resp.ServiceBusNamespace != nil && resp.SendKeyName != nil {
-		relayNSClient := meta.(*clients.Client).Relay.NamespacesClient
+		relayNSClient := meta.(*clients.Client).Relay().NamespacesClient
 		relayNamespaceRG, err := findRelayNamespace(relayNSClient, ctx, *resp.ServiceBusNamespace)
 		if err != nil {
 			return err
@@ -213,7 +213,7 @@ func resourceAppServiceHybridConnectionRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAppServiceHybridConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -94,8 +94,8 @@ func resourceAppServiceManagedCertificate() *schema.Resource {
 }
 
 func resourceAppServiceManagedCertificateCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.CertificatesClient
-	appServiceClient := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().CertificatesClient
+	appServiceClient := meta.(*clients.Client).Web().AppServicesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -195,7 +195,7 @@ func resourceAppServiceManagedCertificateCreateUpdate(d *schema.ResourceData, me
 }
 
 func resourceAppServiceManagedCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.CertificatesClient
+	client := meta.(*clients.Client).Web().CertificatesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -233,7 +233,7 @@ func resourceAppServiceManagedCertificateRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAppServiceManagedCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.CertificatesClient
+	client := meta.(*clients.Client).Web().CertificatesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
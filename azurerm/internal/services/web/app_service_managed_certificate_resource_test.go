@@ -81,7 +81,7 @@ func (t AppServiceManagedCertificateResource) Exists(ctx context.Context, client
 		return nil, err
 	}
 
-	resp, err := clients.Web.CertificatesClient.Get(ctx, id.ResourceGroup, id.CertificateName)
+	resp, err := clients.Web().CertificatesClient.Get(ctx, id.ResourceGroup, id.CertificateName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -92,7 +92,7 @@ func dataSourceAppServicePlan() *schema.Resource {
 }
 
 func AppServicePlanDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicePlansClient
+	client := meta.(*clients.Client).Web().AppServicePlansClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -134,7 +134,7 @@ func resourceAppServicePlan() *schema.Resource {
 }
 
 func resourceAppServicePlanCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicePlansClient
+	client := meta.(*clients.Client).Web().AppServicePlansClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -228,7 +228,7 @@ func resourceAppServicePlanCreateUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAppServicePlanRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicePlansClient
+	client := meta.(*clients.Client).Web().AppServicePlansClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -295,7 +295,7 @@ func resourceAppServicePlanRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAppServicePlanDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicePlansClient
+	client := meta.(*clients.Client).Web().AppServicePlansClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -203,8 +203,8 @@ func resourceAppService() *schema.Resource {
 }
 
 func resourceAppServiceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
-	aspClient := meta.(*clients.Client).Web.AppServicePlansClient
+	client := meta.(*clients.Client).Web().AppServicesClient
+	aspClient := meta.(*clients.Client).Web().AppServicePlansClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -358,7 +358,7 @@ func resourceAppServiceCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAppServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -568,7 +568,7 @@ func resourceAppServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAppServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -737,7 +737,7 @@ func resourceAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAppServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
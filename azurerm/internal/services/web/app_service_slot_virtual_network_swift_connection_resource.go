@@ -58,7 +58,7 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnection() *schema.Resource {
 }
 
 func resourceAppServiceSlotVirtualNetworkSwiftConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -90,11 +90,14 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnectionCreateUpdate(d *schema.R
 		}
 	}
 
-	locks.ByName(virtualNetworkName, network.VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, network.VirtualNetworkResourceName)
-
-	locks.ByName(subnetName, network.SubnetResourceName)
-	defer locks.UnlockByName(subnetName, network.SubnetResourceName)
+	parent := locks.Parent{
+		Name:         virtualNetworkName,
+		ResourceType: network.VirtualNetworkResourceName,
+	}
+	if err := locks.ChildByNameWithContext(ctx, parent, subnetName, network.SubnetResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Virtual Network Swift Connection (App Service %q / Slot %q / Resource Group %q): %+v", name, slotName, resourceGroup, err)
+	}
+	defer locks.UnlockChildByName(parent, subnetName, network.SubnetResourceName)
 
 	appServiceExists, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -131,7 +134,7 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnectionCreateUpdate(d *schema.R
 }
 
 func resourceAppServiceSlotVirtualNetworkSwiftConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -181,7 +184,7 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnectionRead(d *schema.ResourceD
 }
 
 func resourceAppServiceSlotVirtualNetworkSwiftConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -197,11 +200,14 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnectionDelete(d *schema.Resourc
 	subnetName := subnetID.Name
 	virtualNetworkName := subnetID.VirtualNetworkName
 
-	locks.ByName(virtualNetworkName, network.VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, network.VirtualNetworkResourceName)
-
-	locks.ByName(subnetName, network.SubnetResourceName)
-	defer locks.UnlockByName(subnetName, network.SubnetResourceName)
+	parent := locks.Parent{
+		Name:         virtualNetworkName,
+		ResourceType: network.VirtualNetworkResourceName,
+	}
+	if err := locks.ChildByNameWithContext(ctx, parent, subnetName, network.SubnetResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
+	defer locks.UnlockChildByName(parent, subnetName, network.SubnetResourceName)
 
 	read, err := client.GetSwiftVirtualNetworkConnectionSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
 	if err != nil {
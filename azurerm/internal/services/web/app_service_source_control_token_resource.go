@@ -65,7 +65,7 @@ func resourceAppServiceSourceControlToken() *schema.Resource {
 }
 
 func resourceAppServiceSourceControlTokenCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.BaseClient
+	client := meta.(*clients.Client).Web().BaseClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -75,7 +75,9 @@ func resourceAppServiceSourceControlTokenCreateUpdate(d *schema.ResourceData, me
 	token := d.Get("token").(string)
 	tokenSecret := d.Get("token_secret").(string)
 
-	locks.ByName(scmType, appServiceSourceControlTokenResourceName)
+	if err := locks.ByNameWithContext(ctx, scmType, appServiceSourceControlTokenResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for App Service Source Control Token (Type %q): %+v", scmType, err)
+	}
 	defer locks.UnlockByName(scmType, appServiceSourceControlTokenResourceName)
 
 	properties := web.SourceControl{
@@ -103,7 +105,7 @@ func resourceAppServiceSourceControlTokenCreateUpdate(d *schema.ResourceData, me
 }
 
 func resourceAppServiceSourceControlTokenRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.BaseClient
+	client := meta.(*clients.Client).Web().BaseClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	scmType := d.Id()
@@ -129,7 +131,7 @@ func resourceAppServiceSourceControlTokenRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAppServiceSourceControlTokenDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.BaseClient
+	client := meta.(*clients.Client).Web().BaseClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -139,7 +141,9 @@ func resourceAppServiceSourceControlTokenDelete(d *schema.ResourceData, meta int
 	token := ""
 	tokenSecret := ""
 
-	locks.ByName(scmType, appServiceSourceControlTokenResourceName)
+	if err := locks.ByNameWithContext(ctx, scmType, appServiceSourceControlTokenResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for App Service Source Control Token (Type %q): %+v", scmType, err)
+	}
 	defer locks.UnlockByName(scmType, appServiceSourceControlTokenResourceName)
 
 	log.Printf("[DEBUG] Deleting App Service Source Control Token (Type %q)", scmType)
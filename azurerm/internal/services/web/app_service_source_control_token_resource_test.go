@@ -38,7 +38,7 @@ func TestAccAppServiceSourceControlToken(t *testing.T) {
 }
 
 func (r AppServiceSourceControlResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
-	resp, err := client.Web.BaseClient.GetSourceControl(ctx, state.ID)
+	resp, err := client.Web().BaseClient.GetSourceControl(ctx, state.ID)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -51,7 +51,7 @@ func resourceAppServiceVirtualNetworkSwiftConnection() *schema.Resource {
 }
 
 func resourceAppServiceVirtualNetworkSwiftConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -83,11 +83,14 @@ func resourceAppServiceVirtualNetworkSwiftConnectionCreateUpdate(d *schema.Resou
 		}
 	}
 
-	locks.ByName(virtualNetworkName, network.VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, network.VirtualNetworkResourceName)
-
-	locks.ByName(subnetName, network.SubnetResourceName)
-	defer locks.UnlockByName(subnetName, network.SubnetResourceName)
+	parent := locks.Parent{
+		Name:         virtualNetworkName,
+		ResourceType: network.VirtualNetworkResourceName,
+	}
+	if err := locks.ChildByNameWithContext(ctx, parent, subnetName, network.SubnetResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for Virtual Network Swift Connection (App Service %q / Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	defer locks.UnlockChildByName(parent, subnetName, network.SubnetResourceName)
 
 	exists, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -116,7 +119,7 @@ func resourceAppServiceVirtualNetworkSwiftConnectionCreateUpdate(d *schema.Resou
 }
 
 func resourceAppServiceVirtualNetworkSwiftConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -157,7 +160,7 @@ func resourceAppServiceVirtualNetworkSwiftConnectionRead(d *schema.ResourceData,
 }
 
 func resourceAppServiceVirtualNetworkSwiftConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -173,11 +176,14 @@ func resourceAppServiceVirtualNetworkSwiftConnectionDelete(d *schema.ResourceDat
 	subnetName := subnetID.Name
 	virtualNetworkName := subnetID.VirtualNetworkName
 
-	locks.ByName(virtualNetworkName, network.VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, network.VirtualNetworkResourceName)
-
-	locks.ByName(subnetName, network.SubnetResourceName)
-	defer locks.UnlockByName(subnetName, network.SubnetResourceName)
+	parent := locks.Parent{
+		Name:         virtualNetworkName,
+		ResourceType: network.VirtualNetworkResourceName,
+	}
+	if err := locks.ChildByNameWithContext(ctx, parent, subnetName, network.SubnetResourceName); err != nil {
+		return fmt.Errorf("acquiring lock for %s: %+v", id, err)
+	}
+	defer locks.UnlockChildByName(parent, subnetName, network.SubnetResourceName)
 
 	read, err := client.GetSwiftVirtualNetworkConnection(ctx, id.ResourceGroup, id.SiteName)
 	if err != nil {
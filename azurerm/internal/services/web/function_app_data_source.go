@@ -149,7 +149,7 @@ func dataSourceFunctionApp() *schema.Resource {
 }
 
 func dataSourceFunctionAppRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
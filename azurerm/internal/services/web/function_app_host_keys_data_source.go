@@ -50,7 +50,7 @@ func dataSourceFunctionAppHostKeys() *schema.Resource {
 }
 
 func dataSourceFunctionAppHostKeysRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Web.AppServicesClient
+	client := meta.(*clients.Client).Web().AppServicesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
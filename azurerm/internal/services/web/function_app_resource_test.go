@@ -907,7 +907,7 @@ func (r FunctionAppResource) Exists(ctx context.Context, clients *clients.Client
 		return nil, err
 	}
 
-	resp, err := clients.Web.AppServicesClient.Get(ctx, id.ResourceGroup, id.SiteName)
+	resp, err := clients.Web().AppServicesClient.Get(ctx, id.ResourceGroup, id.SiteName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return utils.Bool(false), nil
@@ -930,7 +930,7 @@ func (r FunctionAppResource) hasContentShareAppSetting(shouldExist bool) func(ct
 			return err
 		}
 
-		appSettingsResp, err := clients.Web.AppServicesClient.ListApplicationSettings(ctx, id.ResourceGroup, id.SiteName)
+		appSettingsResp, err := clients.Web().AppServicesClient.ListApplicationSettings(ctx, id.ResourceGroup, id.SiteName)
 		if err != nil {
 			return fmt.Errorf("listing AppSettings: %+v", err)
 		}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AppServiceId struct {
@@ -67,3 +68,12 @@ func AppServiceID(input string) (*AppServiceId, error) {
 
 	return &resourceId, nil
 }
+
+// AppServiceIDNormalized parses a AppService ID into an AppServiceId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the AppServiceID method should be used instead for validation etc.
+func AppServiceIDNormalized(input string) (*AppServiceId, error) {
+	return AppServiceID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s"))
+}
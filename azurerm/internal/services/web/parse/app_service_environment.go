@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AppServiceEnvironmentId struct {
@@ -67,3 +68,12 @@ func AppServiceEnvironmentID(input string) (*AppServiceEnvironmentId, error) {
 
 	return &resourceId, nil
 }
+
+// AppServiceEnvironmentIDNormalized parses a AppServiceEnvironment ID into an AppServiceEnvironmentId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the AppServiceEnvironmentID method should be used instead for validation etc.
+func AppServiceEnvironmentIDNormalized(input string) (*AppServiceEnvironmentId, error) {
+	return AppServiceEnvironmentID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/hostingEnvironments/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AppServicePlanId struct {
@@ -67,3 +68,12 @@ func AppServicePlanID(input string) (*AppServicePlanId, error) {
 
 	return &resourceId, nil
 }
+
+// AppServicePlanIDNormalized parses a AppServicePlan ID into an AppServicePlanId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the AppServicePlanID method should be used instead for validation etc.
+func AppServicePlanIDNormalized(input string) (*AppServicePlanId, error) {
+	return AppServicePlanID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/serverfarms/%s"))
+}
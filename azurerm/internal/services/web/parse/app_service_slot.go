@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AppServiceSlotId struct {
@@ -73,3 +74,12 @@ func AppServiceSlotID(input string) (*AppServiceSlotId, error) {
 
 	return &resourceId, nil
 }
+
+// AppServiceSlotIDNormalized parses a AppServiceSlot ID into an AppServiceSlotId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the AppServiceSlotID method should be used instead for validation etc.
+func AppServiceSlotIDNormalized(input string) (*AppServiceSlotId, error) {
+	return AppServiceSlotID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/slots/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CertificateOrderId struct {
@@ -67,3 +68,12 @@ func CertificateOrderID(input string) (*CertificateOrderId, error) {
 
 	return &resourceId, nil
 }
+
+// CertificateOrderIDNormalized parses a CertificateOrder ID into an CertificateOrderId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the CertificateOrderID method should be used instead for validation etc.
+func CertificateOrderIDNormalized(input string) (*CertificateOrderId, error) {
+	return CertificateOrderID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/certificateOrders/%s"))
+}
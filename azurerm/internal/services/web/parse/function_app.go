@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FunctionAppId struct {
@@ -67,3 +68,12 @@ func FunctionAppID(input string) (*FunctionAppId, error) {
 
 	return &resourceId, nil
 }
+
+// FunctionAppIDNormalized parses a FunctionApp ID into an FunctionAppId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the FunctionAppID method should be used instead for validation etc.
+func FunctionAppIDNormalized(input string) (*FunctionAppId, error) {
+	return FunctionAppID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s"))
+}
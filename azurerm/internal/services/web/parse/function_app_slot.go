@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FunctionAppSlotId struct {
@@ -73,3 +74,12 @@ func FunctionAppSlotID(input string) (*FunctionAppSlotId, error) {
 
 	return &resourceId, nil
 }
+
+// FunctionAppSlotIDNormalized parses a FunctionAppSlot ID into an FunctionAppSlotId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the FunctionAppSlotID method should be used instead for validation etc.
+func FunctionAppSlotIDNormalized(input string) (*FunctionAppSlotId, error) {
+	return FunctionAppSlotID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/slots/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type HostnameBindingId struct {
@@ -73,3 +74,12 @@ func HostnameBindingID(input string) (*HostnameBindingId, error) {
 
 	return &resourceId, nil
 }
+
+// HostnameBindingIDNormalized parses a HostnameBinding ID into an HostnameBindingId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the HostnameBindingID method should be used instead for validation etc.
+func HostnameBindingIDNormalized(input string) (*HostnameBindingId, error) {
+	return HostnameBindingID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/hostNameBindings/%s"))
+}
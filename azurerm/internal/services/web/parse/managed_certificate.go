@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ManagedCertificateId struct {
@@ -67,3 +68,12 @@ func ManagedCertificateID(input string) (*ManagedCertificateId, error) {
 
 	return &resourceId, nil
 }
+
+// ManagedCertificateIDNormalized parses a ManagedCertificate ID into an ManagedCertificateId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the ManagedCertificateID method should be used instead for validation etc.
+func ManagedCertificateIDNormalized(input string) (*ManagedCertificateId, error) {
+	return ManagedCertificateID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/certificates/%s"))
+}
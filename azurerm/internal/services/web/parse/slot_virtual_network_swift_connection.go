@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SlotVirtualNetworkSwiftConnectionId struct {
@@ -79,3 +80,12 @@ func SlotVirtualNetworkSwiftConnectionID(input string) (*SlotVirtualNetworkSwift
 
 	return &resourceId, nil
 }
+
+// SlotVirtualNetworkSwiftConnectionIDNormalized parses a SlotVirtualNetworkSwiftConnection ID into an SlotVirtualNetworkSwiftConnectionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the SlotVirtualNetworkSwiftConnectionID method should be used instead for validation etc.
+func SlotVirtualNetworkSwiftConnectionIDNormalized(input string) (*SlotVirtualNetworkSwiftConnectionId, error) {
+	return SlotVirtualNetworkSwiftConnectionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/slots/%s/config/%s"))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualNetworkSwiftConnectionId struct {
@@ -73,3 +74,12 @@ func VirtualNetworkSwiftConnectionID(input string) (*VirtualNetworkSwiftConnecti
 
 	return &resourceId, nil
 }
+
+// VirtualNetworkSwiftConnectionIDNormalized parses a VirtualNetworkSwiftConnection ID into an VirtualNetworkSwiftConnectionId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the VirtualNetworkSwiftConnectionID method should be used instead for validation etc.
+func VirtualNetworkSwiftConnectionIDNormalized(input string) (*VirtualNetworkSwiftConnectionId, error) {
+	return VirtualNetworkSwiftConnectionID(resourceid.NormalizeSegmentKeys(input, "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/config/%s"))
+}
@@ -0,0 +1,37 @@
+package tags
+
+import (
+	"strings"
+)
+
+// MergeDefaults returns the default tags combined with the specified tags, where the specified tags take
+// precedence over any default tag with the same key (compared case-insensitively, as Azure does)
+func (s Settings) MergeDefaults(tagsMap map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k, v := range s.DefaultTags {
+		if !containsKey(tagsMap, k) {
+			output[k] = v
+		}
+	}
+	for k, v := range tagsMap {
+		output[k] = v
+	}
+
+	return output
+}
+
+// isDefault returns whether the specified tag is a default tag with the same value
+func (s Settings) isDefault(key string, value string) bool {
+	v, ok := s.DefaultTags[key]
+	return ok && v == value
+}
+
+func containsKey(tagsMap map[string]interface{}, key string) bool {
+	for k := range tagsMap {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,54 @@
+package tags
+
+import (
+	"strings"
+)
+
+// IgnoreTags are the tags specified in the `ignore_tags` block within the Provider block, which are ignored on
+// every resource which supports tags (for example tags added by Azure Policy)
+type IgnoreTags struct {
+	// Keys are the keys of the tags which should be ignored
+	Keys []string
+
+	// KeyPrefixes are the prefixes of the keys of the tags which should be ignored
+	KeyPrefixes []string
+}
+
+// IsIgnored returns whether the specified tag should be ignored, comparing the key case-insensitively (as Azure does)
+func (i IgnoreTags) IsIgnored(key string) bool {
+	for _, v := range i.Keys {
+		if strings.EqualFold(v, key) {
+			return true
+		}
+	}
+
+	for _, v := range i.KeyPrefixes {
+		if len(v) > 0 && strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ignoredOnly returns the tags from the specified map which should be ignored
+func (i IgnoreTags) ignoredOnly(tagsMap map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k, v := range tagsMap {
+		if i.IsIgnored(k) {
+			output[k] = v
+		}
+	}
+	return output
+}
+
+// withoutIgnored returns the tags from the specified map which shouldn't be ignored
+func (i IgnoreTags) withoutIgnored(tagsMap map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k, v := range tagsMap {
+		if !i.IsIgnored(k) {
+			output[k] = v
+		}
+	}
+	return output
+}
@@ -0,0 +1,81 @@
+package tags
+
+import (
+	"testing"
+)
+
+func TestIsIgnored(t *testing.T) {
+	ignoreTags := IgnoreTags{
+		Keys:        []string{"ms-resource-usage", ""},
+		KeyPrefixes: []string{"hidden-link:", ""},
+	}
+
+	testData := []struct {
+		Key      string
+		Expected bool
+	}{
+		{
+			Key:      "environment",
+			Expected: false,
+		},
+		{
+			Key:      "ms-resource-usage",
+			Expected: true,
+		},
+		{
+			Key:      "MS-Resource-Usage",
+			Expected: true,
+		},
+		{
+			Key:      "ms-resource-usage-2",
+			Expected: false,
+		},
+		{
+			Key:      "hidden-link:/app-insights-resource-id",
+			Expected: true,
+		},
+		{
+			Key:      "Hidden-Link:/app-insights-resource-id",
+			Expected: true,
+		},
+		{
+			Key:      "link:hidden",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Key)
+
+		if actual := ignoreTags.IsIgnored(v.Key); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestWithoutIgnored(t *testing.T) {
+	input := map[string]interface{}{
+		"environment":       "production",
+		"ms-resource-usage": "azure-cloud-shell",
+		"hidden-link:/app":  "Resource",
+	}
+
+	if actual := (IgnoreTags{}).withoutIgnored(input); len(actual) != 3 {
+		t.Fatalf("Expected no tags to be removed when none are ignored but got %+v", actual)
+	}
+
+	ignoreTags := IgnoreTags{
+		Keys:        []string{"ms-resource-usage"},
+		KeyPrefixes: []string{"hidden-link:"},
+	}
+
+	actual := ignoreTags.withoutIgnored(input)
+	if len(actual) != 1 || actual["environment"] == nil {
+		t.Fatalf("Expected only the `environment` tag to remain but got %+v", actual)
+	}
+
+	ignored := ignoreTags.ignoredOnly(input)
+	if len(ignored) != 2 || ignored["environment"] != nil {
+		t.Fatalf("Expected only the ignored tags but got %+v", ignored)
+	}
+}
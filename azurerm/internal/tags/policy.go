@@ -0,0 +1,118 @@
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// KeyCase is the case which the keys of tags must use
+type KeyCase string
+
+const (
+	KeyCaseAny   KeyCase = "any"
+	KeyCaseLower KeyCase = "lower"
+	KeyCaseUpper KeyCase = "upper"
+)
+
+// Policy is the policy specified in the `tag_policy` block within the Provider block, which the tags for every
+// resource which supports tags must comply with
+type Policy struct {
+	// RequiredKeys are the keys of the tags which must be specified, compared case-insensitively
+	RequiredKeys []string
+
+	// MaxCount is the maximum number of tags which can be specified, where 0 uses the limit imposed by Azure
+	MaxCount int
+
+	// KeyCase is the case which the keys of tags must use
+	KeyCase KeyCase
+
+	// Keys are the policies for the values of individual tags
+	Keys []KeyPolicy
+}
+
+// KeyPolicy is the policy for the value of an individual tag, which is checked when the tag is specified
+type KeyPolicy struct {
+	// Key is the key of the tag, compared case-insensitively
+	Key string
+
+	// AllowedValues are the values which can be specified for this tag, if any
+	AllowedValues []string
+
+	// CaseSensitive specifies whether the AllowedValues are compared case-sensitively
+	CaseSensitive bool
+
+	// Pattern is a regular expression which the value for this tag must match, if any
+	Pattern *regexp.Regexp
+}
+
+// Validate validates the specified tags comply with this policy, returning an error for each violation of the
+// policy - where no policy is specified (that is, this is nil) the tags always comply
+func (policy *Policy) Validate(tagsMap map[string]interface{}) (errors []error) {
+	if policy == nil {
+		return nil
+	}
+
+	if policy.MaxCount > 0 && len(tagsMap) > policy.MaxCount {
+		errors = append(errors, fmt.Errorf("%d tags are specified but a maximum of %d tags are allowed", len(tagsMap), policy.MaxCount))
+	}
+
+	for _, key := range policy.RequiredKeys {
+		if !containsKey(tagsMap, key) {
+			errors = append(errors, fmt.Errorf("the required tag %q isn't specified", key))
+		}
+	}
+
+	// sorted to output the violations in a consistent order
+	keys := make([]string, 0, len(tagsMap))
+	for k := range tagsMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		switch policy.KeyCase {
+		case KeyCaseLower:
+			if key != strings.ToLower(key) {
+				errors = append(errors, fmt.Errorf("the key for the tag %q must be lower case", key))
+			}
+		case KeyCaseUpper:
+			if key != strings.ToUpper(key) {
+				errors = append(errors, fmt.Errorf("the key for the tag %q must be upper case", key))
+			}
+		}
+
+		value, err := TagValueToString(tagsMap[key])
+		if err != nil {
+			errors = append(errors, err)
+			continue
+		}
+
+		for _, keyPolicy := range policy.Keys {
+			if !strings.EqualFold(keyPolicy.Key, key) {
+				continue
+			}
+
+			if len(keyPolicy.AllowedValues) > 0 && !isAllowedValue(keyPolicy, value) {
+				errors = append(errors, fmt.Errorf("the value %q for the tag %q isn't allowed - the allowed values are %q", value, key, keyPolicy.AllowedValues))
+			}
+
+			if keyPolicy.Pattern != nil && !keyPolicy.Pattern.MatchString(value) {
+				errors = append(errors, fmt.Errorf("the value %q for the tag %q doesn't match the pattern %q", value, key, keyPolicy.Pattern.String()))
+			}
+		}
+	}
+
+	return errors
+}
+
+func isAllowedValue(keyPolicy KeyPolicy, value string) bool {
+	for _, v := range keyPolicy.AllowedValues {
+		if v == value || (!keyPolicy.CaseSensitive && strings.EqualFold(v, value)) {
+			return true
+		}
+	}
+
+	return false
+}
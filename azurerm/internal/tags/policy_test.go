@@ -0,0 +1,118 @@
+package tags
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestValidatePolicy(t *testing.T) {
+	policy := &Policy{
+		RequiredKeys: []string{"owner"},
+		MaxCount:     3,
+		KeyCase:      KeyCaseLower,
+		Keys: []KeyPolicy{
+			{
+				Key:           "environment",
+				AllowedValues: []string{"production", "test"},
+			},
+			{
+				Key:           "tier",
+				AllowedValues: []string{"Gold"},
+				CaseSensitive: true,
+			},
+			{
+				Key:     "cost_center",
+				Pattern: regexp.MustCompile("^[0-9]{4}$"),
+			},
+		},
+	}
+
+	testData := []struct {
+		Name   string
+		Input  map[string]interface{}
+		Errors int
+	}{
+		{
+			Name: "Compliant",
+			Input: map[string]interface{}{
+				"owner":       "platform",
+				"environment": "Production",
+				"cost_center": "1234",
+			},
+			Errors: 0,
+		},
+		{
+			Name: "Required Key Missing",
+			Input: map[string]interface{}{
+				"environment": "test",
+			},
+			Errors: 1,
+		},
+		{
+			Name: "Required Key in a Different Case",
+			Input: map[string]interface{}{
+				"Owner": "platform",
+			},
+			Errors: 1,
+		},
+		{
+			Name: "Too Many Tags",
+			Input: map[string]interface{}{
+				"owner": "platform",
+				"one":   "1",
+				"two":   "2",
+				"three": "3",
+			},
+			Errors: 1,
+		},
+		{
+			Name: "Value Not Allowed",
+			Input: map[string]interface{}{
+				"owner":       "platform",
+				"environment": "staging",
+			},
+			Errors: 1,
+		},
+		{
+			Name: "Value Not Allowed Case Sensitive",
+			Input: map[string]interface{}{
+				"owner": "platform",
+				"tier":  "gold",
+			},
+			Errors: 1,
+		},
+		{
+			Name: "Value Doesn't Match Pattern",
+			Input: map[string]interface{}{
+				"owner":       "platform",
+				"cost_center": "12345",
+			},
+			Errors: 1,
+		},
+		{
+			Name: "Multiple Violations",
+			Input: map[string]interface{}{
+				"Environment": "staging",
+				"cost_center": "abc",
+			},
+			Errors: 4,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test Case: %q", v.Name)
+
+		errors := policy.Validate(v.Input)
+		if len(errors) != v.Errors {
+			t.Fatalf("Expected %d errors but got %d: %+v", v.Errors, len(errors), errors)
+		}
+	}
+}
+
+func TestValidatePolicyNotSpecified(t *testing.T) {
+	var policy *Policy
+
+	if errors := policy.Validate(map[string]interface{}{"Example": "value"}); len(errors) > 0 {
+		t.Fatalf("Expected no errors when no policy is specified but got %+v", errors)
+	}
+}
@@ -0,0 +1,258 @@
+package tags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// ExtendResource adds support for the default and ignored tags and the tag policy from the Provider block to a resource
+// which supports tags, using the Settings for the instance of the Provider which is managing the resource, by:
+//
+// * exposing the `tags_all` attribute, which contains all of the tags assigned to this resource (including the default and ignored tags).
+// * planning `tags_all` to include the default tags, such that changes to the default tags are planned for existing resources too (replacing them where `tags` is ForceNew).
+// * sending the planned `tags_all` when the resource is created or updated, which includes the default tags and preserves any ignored tags.
+// * setting only the tags specified on the resource into `tags`, such that neither the default nor the ignored tags show as a diff.
+// * validating that the tags comply with the tag policy at plan time, when the tags are going to be sent to Azure.
+//
+// This is a no-op for resources which don't support tags.
+//
+// NOTE: since the default tags can change without `tags` changing, resources which only send the tags when these
+// change must check for changes to `tags_all` too (e.g. `d.HasChanges("tags", "tags_all")`), which is checked in
+// the Provider's tests
+func ExtendResource(resourceType string, resource *schema.Resource, settings SettingsFunc) {
+	tagsSchema, ok := resource.Schema["tags"]
+	if !ok || tagsSchema.Type != schema.TypeMap || !tagsSchema.Optional {
+		return
+	}
+	if _, exists := resource.Schema["tags_all"]; exists {
+		return
+	}
+
+	resource.Schema["tags_all"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		// the tags for some resources can't be updated (and some don't have an Update at all), so these are
+		// replaced when the tags assigned to them change - including when only the default tags change
+		ForceNew: tagsSchema.ForceNew,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	if create := resource.Create; create != nil {
+		resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+			return applyTags(d, settings(meta), func() error {
+				return create(d, meta)
+			})
+		}
+	}
+
+	if read := resource.Read; read != nil {
+		resource.Read = func(d *schema.ResourceData, meta interface{}) error {
+			// the tags in the state are those specified on the resource when it was last applied - which tells
+			// the default tags specified on the resource apart from those applied from the Provider block
+			specified, _ := d.Get("tags").(map[string]interface{})
+
+			if err := read(d, meta); err != nil {
+				return err
+			}
+
+			// the resource has been removed from the state
+			if d.Id() == "" {
+				return nil
+			}
+
+			return setTags(d, settings(meta), specified)
+		}
+	}
+
+	if update := resource.Update; update != nil {
+		resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+			return applyTags(d, settings(meta), func() error {
+				return update(d, meta)
+			})
+		}
+	}
+
+	customizeDiff := resource.CustomizeDiff
+	resource.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(d, meta); err != nil {
+				return err
+			}
+		}
+
+		s := settings(meta)
+		if err := planTagsAll(s, d); err != nil {
+			return err
+		}
+
+		return validateTagsPolicy(resourceType, s, d)
+	}
+}
+
+// ExtendDataSource adds support for the ignored tags from the Provider block to a data source which exposes tags,
+// such that the ignored tags aren't set into `tags` - this is a no-op for data sources which don't expose tags.
+func ExtendDataSource(dataSource *schema.Resource, settings SettingsFunc) {
+	tagsSchema, ok := dataSource.Schema["tags"]
+	if !ok || tagsSchema.Type != schema.TypeMap || !tagsSchema.Computed || dataSource.Read == nil {
+		return
+	}
+
+	read := dataSource.Read
+	dataSource.Read = func(d *schema.ResourceData, meta interface{}) error {
+		if err := read(d, meta); err != nil {
+			return err
+		}
+
+		tagsMap, _ := d.Get("tags").(map[string]interface{})
+		if err := d.Set("tags", settings(meta).IgnoreTags.withoutIgnored(tagsMap)); err != nil {
+			return fmt.Errorf("Error setting `tags`: %s", err)
+		}
+
+		return nil
+	}
+}
+
+// applyTags creates or updates the resource using the planned value of `tags_all` as the tags for the resource,
+// since the tags for a resource are replaced when they're sent - this includes the default tags, and any ignored
+// tags currently assigned to the resource (which would otherwise be removed)
+func applyTags(d *schema.ResourceData, settings Settings, apply func() error) error {
+	specified, _ := d.Get("tags").(map[string]interface{})
+
+	tagsAll, _ := d.Get("tags_all").(map[string]interface{})
+	if err := d.Set("tags", tagsAll); err != nil {
+		return fmt.Errorf("Error setting `tags`: %s", err)
+	}
+
+	applyErr := apply()
+
+	// the tags are set even when applying failed, providing the resource exists (and so is in the state)
+	if d.Id() != "" {
+		if err := setTags(d, settings, specified); err != nil && applyErr == nil {
+			return err
+		}
+	}
+
+	return applyErr
+}
+
+// setTags sets `tags_all` to all of the tags assigned to the resource (which the resource set into `tags`), and
+// `tags` to those which were specified on the resource - that is, excluding any ignored tags and any default tags
+// with the default value, unless these were specified on the resource
+func setTags(d *schema.ResourceData, settings Settings, specified map[string]interface{}) error {
+	assigned, _ := d.Get("tags").(map[string]interface{})
+	if err := d.Set("tags_all", assigned); err != nil {
+		return fmt.Errorf("Error setting `tags_all`: %s", err)
+	}
+
+	tagsMap := make(map[string]interface{})
+	for k, v := range assigned {
+		if containsKey(specified, k) {
+			tagsMap[k] = v
+			continue
+		}
+
+		if settings.IgnoreTags.IsIgnored(k) || settings.isDefault(k, fmt.Sprintf("%v", v)) {
+			continue
+		}
+
+		tagsMap[k] = v
+	}
+	if err := d.Set("tags", tagsMap); err != nil {
+		return fmt.Errorf("Error setting `tags`: %s", err)
+	}
+
+	return nil
+}
+
+// validateTagsPolicy validates that the tags which are going to be sent to Azure (including the default tags)
+// comply with the tag policy - existing resources are validated when the tags which are going to be sent to Azure
+// change (that is, `tags_all`), which is the case when either their tags or the default tags change
+func validateTagsPolicy(resourceType string, settings Settings, d *schema.ResourceDiff) error {
+	if d.Id() != "" && !d.HasChange("tags_all") {
+		return nil
+	}
+
+	if !d.NewValueKnown("tags") {
+		return nil
+	}
+
+	tagsMap, _ := d.Get("tags").(map[string]interface{})
+	errors := settings.Policy.Validate(settings.MergeDefaults(settings.IgnoreTags.withoutIgnored(tagsMap)))
+	if len(errors) == 0 {
+		return nil
+	}
+
+	violations := make([]string, 0, len(errors))
+	for _, err := range errors {
+		violations = append(violations, fmt.Sprintf("* %s", err))
+	}
+	return fmt.Errorf("the tags for the %s resource don't comply with the `tag_policy` specified in the Provider block:\n\n%s", describeResource(resourceType, d), strings.Join(violations, "\n"))
+}
+
+// describeResource describes the resource using its type and (where these are known) its name, Resource Group and ID,
+// since the address of the resource (e.g. `azurerm_resource_group.example`) isn't available within a CustomizeDiff
+func describeResource(resourceType string, d *schema.ResourceDiff) string {
+	description := resourceType
+	if name, ok := d.Get("name").(string); ok && name != "" {
+		description = fmt.Sprintf("%s %q", resourceType, name)
+	}
+
+	details := make([]string, 0)
+	if resourceGroup, ok := d.Get("resource_group_name").(string); ok && resourceGroup != "" {
+		details = append(details, fmt.Sprintf("Resource Group %q", resourceGroup))
+	}
+	if id := d.Id(); id != "" {
+		details = append(details, fmt.Sprintf("ID %q", id))
+	}
+	if len(details) > 0 {
+		description = fmt.Sprintf("%s (%s)", description, strings.Join(details, " / "))
+	}
+
+	return description
+}
+
+// planTagsAll sets the value of `tags_all` to the tags which are going to be assigned to this resource, including the
+// default tags - such that changes to the default tags are planned for existing resources too
+func planTagsAll(settings Settings, d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	tagsMap, _ := d.Get("tags").(map[string]interface{})
+	tagsAll := settings.MergeDefaults(tagsMap)
+
+	// the ignored tags are preserved when the tags are updated, unless these are specified on the resource
+	old, _ := d.GetChange("tags_all")
+	oldTagsAll, _ := old.(map[string]interface{})
+	for k, v := range settings.IgnoreTags.ignoredOnly(oldTagsAll) {
+		if !containsKey(tagsAll, k) {
+			tagsAll[k] = v
+		}
+	}
+
+	if d.Id() != "" && tagsEqual(oldTagsAll, tagsAll) {
+		return nil
+	}
+
+	return d.SetNew("tags_all", tagsAll)
+}
+
+// tagsEqual returns whether the specified tags contain the same keys and values
+func tagsEqual(first map[string]interface{}, second map[string]interface{}) bool {
+	if len(first) != len(second) {
+		return false
+	}
+
+	for k, v := range first {
+		other, ok := second[k]
+		if !ok || fmt.Sprintf("%v", other) != fmt.Sprintf("%v", v) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,606 @@
+package tags
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// testSettings returns the Settings passed to the resource as meta, rather than the Client
+func testSettings(meta interface{}) Settings {
+	return meta.(Settings)
+}
+
+// testState returns the state for a resource which has been read, with the tags specified on the resource and all of
+// the tags assigned to the resource
+func testState(tagsMap map[string]string, tagsAll map[string]string) *terraform.InstanceState {
+	attributes := map[string]string{
+		"id":         "example",
+		"tags.%":     strconv.Itoa(len(tagsMap)),
+		"tags_all.%": strconv.Itoa(len(tagsAll)),
+	}
+	for k, v := range tagsMap {
+		attributes["tags."+k] = v
+	}
+	for k, v := range tagsAll {
+		attributes["tags_all."+k] = v
+	}
+
+	return &terraform.InstanceState{
+		ID:         "example",
+		Attributes: attributes,
+	}
+}
+
+func TestExtendResourceDiff(t *testing.T) {
+	settings := Settings{
+		DefaultTags: map[string]string{
+			"cost_center": "1234",
+		},
+	}
+
+	testData := []struct {
+		Name     string
+		State    *terraform.InstanceState
+		Config   map[string]interface{}
+		Expected map[string]string
+	}{
+		{
+			Name:   "New Resource",
+			Config: map[string]interface{}{"tags": map[string]interface{}{"environment": "production"}},
+			Expected: map[string]string{
+				"tags.%":               "1",
+				"tags.environment":     "production",
+				"tags_all.%":           "2",
+				"tags_all.cost_center": "1234",
+				"tags_all.environment": "production",
+			},
+		},
+		{
+			Name:     "Default Tag Applied",
+			State:    testState(map[string]string{"environment": "production"}, map[string]string{"cost_center": "1234", "environment": "production"}),
+			Config:   map[string]interface{}{"tags": map[string]interface{}{"environment": "production"}},
+			Expected: map[string]string{},
+		},
+		{
+			Name:     "Default Tag Applied without Tags",
+			State:    testState(map[string]string{}, map[string]string{"cost_center": "1234"}),
+			Config:   map[string]interface{}{},
+			Expected: map[string]string{},
+		},
+		{
+			Name:     "Default Tag Specified",
+			State:    testState(map[string]string{"cost_center": "1234"}, map[string]string{"cost_center": "1234"}),
+			Config:   map[string]interface{}{"tags": map[string]interface{}{"cost_center": "1234"}},
+			Expected: map[string]string{},
+		},
+		{
+			Name:   "Default Tag Overridden",
+			State:  testState(map[string]string{}, map[string]string{"cost_center": "1234"}),
+			Config: map[string]interface{}{"tags": map[string]interface{}{"cost_center": "5678"}},
+			Expected: map[string]string{
+				"tags.%":               "1",
+				"tags.cost_center":     "5678",
+				"tags_all.cost_center": "5678",
+			},
+		},
+		{
+			Name:   "Default Tag Changed",
+			State:  testState(map[string]string{"environment": "production"}, map[string]string{"cost_center": "5678", "environment": "production"}),
+			Config: map[string]interface{}{"tags": map[string]interface{}{"environment": "production"}},
+			Expected: map[string]string{
+				"tags_all.cost_center": "1234",
+			},
+		},
+		{
+			Name:   "Default Tag Added",
+			State:  testState(map[string]string{"environment": "production"}, map[string]string{"environment": "production"}),
+			Config: map[string]interface{}{"tags": map[string]interface{}{"environment": "production"}},
+			Expected: map[string]string{
+				"tags_all.%":           "2",
+				"tags_all.cost_center": "1234",
+			},
+		},
+		{
+			Name:   "Tag Changed",
+			State:  testState(map[string]string{"environment": "production"}, map[string]string{"cost_center": "1234", "environment": "production"}),
+			Config: map[string]interface{}{"tags": map[string]interface{}{"environment": "test"}},
+			Expected: map[string]string{
+				"tags.environment":     "test",
+				"tags_all.environment": "test",
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+
+		resource := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": Schema(),
+			},
+		}
+		ExtendResource("azurerm_example", resource, testSettings)
+
+		diff, err := resource.Diff(testCase.State, terraform.NewResourceConfigRaw(testCase.Config), settings)
+		if err != nil {
+			t.Fatalf("computing diff: %+v", err)
+		}
+
+		actual := make(map[string]string)
+		if diff != nil {
+			for k, v := range diff.Attributes {
+				actual[k] = v.New
+			}
+		}
+
+		if len(actual) != len(testCase.Expected) {
+			t.Fatalf("Expected the diff %+v but got %+v", testCase.Expected, actual)
+		}
+		for k, v := range testCase.Expected {
+			if actual[k] != v {
+				t.Fatalf("Expected %q to be %q but got %q (diff %+v)", k, v, actual[k], actual)
+			}
+		}
+	}
+}
+
+func TestExtendResourceWithoutTags(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+	ExtendResource("azurerm_example", resource, testSettings)
+
+	if _, ok := resource.Schema["tags_all"]; ok {
+		t.Fatalf("Expected `tags_all` not to be added to a resource which doesn't support tags")
+	}
+	if resource.CustomizeDiff != nil {
+		t.Fatalf("Expected no CustomizeDiff to be added to a resource which doesn't support tags")
+	}
+}
+
+func TestExtendResourceForceNew(t *testing.T) {
+	settings := Settings{
+		DefaultTags: map[string]string{
+			"cost_center": "1234",
+		},
+	}
+
+	var created map[string]*string
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": ForceNewSchema(),
+		},
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			created = Expand(d.Get("tags").(map[string]interface{}))
+			d.SetId("example")
+			return nil
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+	ExtendResource("azurerm_example", resource, testSettings)
+
+	if err := resource.InternalValidate(nil, true); err != nil {
+		t.Fatalf("validating the resource: %+v", err)
+	}
+
+	t.Logf("[DEBUG] Testing the Default Tags being changed..")
+	state := testState(map[string]string{"environment": "production"}, map[string]string{"cost_center": "5678", "environment": "production"})
+	config := map[string]interface{}{
+		"tags": map[string]interface{}{
+			"environment": "production",
+		},
+	}
+	diff, err := resource.Diff(state, terraform.NewResourceConfigRaw(config), settings)
+	if err != nil {
+		t.Fatalf("computing diff: %+v", err)
+	}
+	if diff == nil || !diff.RequiresNew() {
+		t.Fatalf("Expected the resource to be replaced but got %+v", diff)
+	}
+
+	// the resource is replaced (rather than updated, which it doesn't support) using the updated default tags
+	if _, err := resource.Apply(state, diff, settings); err != nil {
+		t.Fatalf("applying diff: %+v", err)
+	}
+	if created["cost_center"] == nil || *created["cost_center"] != "1234" {
+		t.Fatalf("Expected the resource to be created with the updated default tag but got %+v", created)
+	}
+
+	t.Logf("[DEBUG] Testing the Default Tags being unchanged..")
+	state = testState(map[string]string{"environment": "production"}, map[string]string{"cost_center": "1234", "environment": "production"})
+	diff, err = resource.Diff(state, terraform.NewResourceConfigRaw(config), settings)
+	if err != nil {
+		t.Fatalf("computing diff: %+v", err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Fatalf("Expected no diff but got %+v", diff.Attributes)
+	}
+}
+
+func TestExtendResourceDiffWithIgnored(t *testing.T) {
+	settings := Settings{
+		IgnoreTags: IgnoreTags{
+			Keys: []string{"ms-resource-usage"},
+		},
+	}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": Schema(),
+		},
+	}
+	ExtendResource("azurerm_example", resource, testSettings)
+
+	state := testState(map[string]string{"environment": "production"}, map[string]string{"environment": "production", "ms-resource-usage": "azure-cloud-shell"})
+
+	t.Logf("[DEBUG] Testing an Ignored Tag assigned to the Resource..")
+	config := map[string]interface{}{
+		"tags": map[string]interface{}{
+			"environment": "production",
+		},
+	}
+	diff, err := resource.Diff(state, terraform.NewResourceConfigRaw(config), settings)
+	if err != nil {
+		t.Fatalf("computing diff: %+v", err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Fatalf("Expected no diff but got %+v", diff.Attributes)
+	}
+
+	t.Logf("[DEBUG] Testing the Tags being updated..")
+	config = map[string]interface{}{
+		"tags": map[string]interface{}{
+			"environment": "test",
+		},
+	}
+	diff, err = resource.Diff(state, terraform.NewResourceConfigRaw(config), settings)
+	if err != nil {
+		t.Fatalf("computing diff: %+v", err)
+	}
+	if diff == nil || diff.Attributes["tags_all.ms-resource-usage"] != nil || diff.Attributes["tags_all.environment"] == nil {
+		t.Fatalf("Expected `tags_all` to retain the ignored tag but got %+v", diff)
+	}
+}
+
+func TestExtendResourceCreateAppliesDefaults(t *testing.T) {
+	settings := Settings{
+		DefaultTags: map[string]string{
+			"cost_center": "1234",
+		},
+	}
+
+	var expanded map[string]*string
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": Schema(),
+		},
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			expanded = Expand(d.Get("tags").(map[string]interface{}))
+			d.SetId("example")
+			return nil
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+	ExtendResource("azurerm_example", resource, testSettings)
+
+	config := map[string]interface{}{
+		"tags": map[string]interface{}{
+			"environment": "production",
+		},
+	}
+
+	diff, err := resource.Diff(nil, terraform.NewResourceConfigRaw(config), settings)
+	if err != nil {
+		t.Fatalf("computing diff: %+v", err)
+	}
+	newState, err := resource.Apply(nil, diff, settings)
+	if err != nil {
+		t.Fatalf("applying diff: %+v", err)
+	}
+
+	if len(expanded) != 2 || expanded["cost_center"] == nil || *expanded["cost_center"] != "1234" {
+		t.Fatalf("Expected the default tag to be applied but got %+v", expanded)
+	}
+	if v, ok := newState.Attributes["tags.cost_center"]; ok {
+		t.Fatalf("Expected the default tag not to be in `tags` but got %q", v)
+	}
+	if v := newState.Attributes["tags_all.cost_center"]; v != "1234" {
+		t.Fatalf("Expected the default tag to be in `tags_all` but got %q", v)
+	}
+}
+
+func TestExtendResourceUpdatePreservesIgnored(t *testing.T) {
+	settings := Settings{
+		IgnoreTags: IgnoreTags{
+			Keys: []string{"ms-resource-usage"},
+		},
+	}
+
+	var expanded map[string]*string
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": Schema(),
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			expanded = Expand(d.Get("tags").(map[string]interface{}))
+			return nil
+		},
+	}
+	ExtendResource("azurerm_example", resource, testSettings)
+
+	state := testState(map[string]string{"environment": "production"}, map[string]string{"environment": "production", "ms-resource-usage": "azure-cloud-shell"})
+	config := map[string]interface{}{
+		"tags": map[string]interface{}{
+			"environment": "test",
+		},
+	}
+
+	diff, err := resource.Diff(state, terraform.NewResourceConfigRaw(config), settings)
+	if err != nil {
+		t.Fatalf("computing diff: %+v", err)
+	}
+	newState, err := resource.Apply(state, diff, settings)
+	if err != nil {
+		t.Fatalf("applying diff: %+v", err)
+	}
+
+	if len(expanded) != 2 || expanded["ms-resource-usage"] == nil || *expanded["ms-resource-usage"] != "azure-cloud-shell" {
+		t.Fatalf("Expected the ignored tag to be preserved but got %+v", expanded)
+	}
+	if v, ok := newState.Attributes["tags.ms-resource-usage"]; ok {
+		t.Fatalf("Expected the ignored tag not to be in the state but got %q", v)
+	}
+}
+
+func TestExtendResourceUpdateAppliesDefaults(t *testing.T) {
+	settings := Settings{
+		DefaultTags: map[string]string{
+			"cost_center": "1234",
+		},
+	}
+
+	var expanded map[string]*string
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": Schema(),
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			if d.HasChanges("tags", "tags_all") {
+				expanded = Expand(d.Get("tags").(map[string]interface{}))
+			}
+			return nil
+		},
+	}
+	ExtendResource("azurerm_example", resource, testSettings)
+
+	state := testState(map[string]string{"environment": "production"}, map[string]string{"environment": "production"})
+	config := map[string]interface{}{
+		"tags": map[string]interface{}{
+			"environment": "production",
+		},
+	}
+
+	diff, err := resource.Diff(state, terraform.NewResourceConfigRaw(config), settings)
+	if err != nil {
+		t.Fatalf("computing diff: %+v", err)
+	}
+	if _, err := resource.Apply(state, diff, settings); err != nil {
+		t.Fatalf("applying diff: %+v", err)
+	}
+
+	if len(expanded) != 2 || expanded["cost_center"] == nil || *expanded["cost_center"] != "1234" {
+		t.Fatalf("Expected the default tag to be applied but got %+v", expanded)
+	}
+}
+
+func TestExtendResourceRead(t *testing.T) {
+	settings := Settings{
+		DefaultTags: map[string]string{
+			"cost_center": "1234",
+			"owner":       "platform",
+		},
+		IgnoreTags: IgnoreTags{
+			KeyPrefixes: []string{"hidden-link:"},
+		},
+	}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": Schema(),
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return d.Set("tags", map[string]interface{}{
+				"cost_center":               "1234",
+				"environment":               "production",
+				"hidden-link:/subscription": "Resource",
+				"owner":                     "networking",
+			})
+		},
+	}
+	ExtendResource("azurerm_example", resource, testSettings)
+
+	state := testState(map[string]string{"environment": "production"}, map[string]string{"environment": "production"})
+	newState, err := resource.RefreshWithoutUpgrade(state, settings)
+	if err != nil {
+		t.Fatalf("reading: %+v", err)
+	}
+
+	// the default tag with the default value and the ignored tag aren't set into `tags`, however the default
+	// tag with another value is (since it's not the default tag which was applied from the Provider block)
+	expected := map[string]string{
+		"tags.%":                             "2",
+		"tags.environment":                   "production",
+		"tags.owner":                         "networking",
+		"tags_all.%":                         "4",
+		"tags_all.cost_center":               "1234",
+		"tags_all.environment":               "production",
+		"tags_all.hidden-link:/subscription": "Resource",
+		"tags_all.owner":                     "networking",
+	}
+	for k, v := range expected {
+		if actual := newState.Attributes[k]; actual != v {
+			t.Fatalf("Expected %q to be %q but got %q (state %+v)", k, v, actual, newState.Attributes)
+		}
+	}
+}
+
+func TestExtendDataSource(t *testing.T) {
+	settings := Settings{
+		IgnoreTags: IgnoreTags{
+			Keys: []string{"ms-resource-usage"},
+		},
+	}
+
+	dataSource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": SchemaDataSource(),
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			d.SetId("example")
+			return d.Set("tags", map[string]interface{}{
+				"environment":       "production",
+				"MS-Resource-Usage": "azure-cloud-shell",
+			})
+		},
+	}
+	ExtendDataSource(dataSource, testSettings)
+
+	state, err := dataSource.ReadDataApply(&terraform.InstanceDiff{}, settings)
+	if err != nil {
+		t.Fatalf("reading: %+v", err)
+	}
+
+	if state.Attributes["tags.%"] != "1" || state.Attributes["tags.environment"] != "production" {
+		t.Fatalf("Expected only the `environment` tag but got %+v", state.Attributes)
+	}
+}
+
+func TestExtendResourceDiffWithPolicyWithoutName(t *testing.T) {
+	settings := Settings{
+		Policy: &Policy{
+			RequiredKeys: []string{"owner"},
+		},
+	}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": Schema(),
+		},
+	}
+	ExtendResource("azurerm_example", resource, testSettings)
+
+	state := testState(map[string]string{"owner": "platform"}, map[string]string{"owner": "platform"})
+	config := map[string]interface{}{
+		"tags": map[string]interface{}{
+			"environment": "production",
+		},
+	}
+	_, err := resource.Diff(state, terraform.NewResourceConfigRaw(config), settings)
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+	if !strings.Contains(err.Error(), `the tags for the azurerm_example (ID "example") resource`) {
+		t.Fatalf("Expected the error to reference the resource by its ID but got: %s", err)
+	}
+}
+
+func TestExtendResourceDiffWithPolicy(t *testing.T) {
+	settings := Settings{
+		DefaultTags: map[string]string{
+			"cost_center": "1234",
+		},
+		Policy: &Policy{
+			RequiredKeys: []string{"cost_center", "owner"},
+		},
+	}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tags": Schema(),
+		},
+	}
+	ExtendResource("azurerm_example", resource, testSettings)
+
+	t.Logf("[DEBUG] Testing a New Resource which doesn't comply..")
+	config := map[string]interface{}{
+		"name":                "example",
+		"resource_group_name": "example-resources",
+		"tags": map[string]interface{}{
+			"environment": "production",
+		},
+	}
+	_, err := resource.Diff(nil, terraform.NewResourceConfigRaw(config), settings)
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+	if !strings.Contains(err.Error(), `azurerm_example "example" (Resource Group "example-resources")`) || !strings.Contains(err.Error(), `"owner"`) {
+		t.Fatalf("Expected the error to reference the resource and the missing tag but got: %s", err)
+	}
+
+	t.Logf("[DEBUG] Testing a New Resource which complies using the Default Tags..")
+	config["tags"] = map[string]interface{}{
+		"owner": "platform",
+	}
+	if _, err := resource.Diff(nil, terraform.NewResourceConfigRaw(config), settings); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	t.Logf("[DEBUG] Testing an Existing Resource where the Tags aren't changed..")
+	state := testState(map[string]string{"environment": "production"}, map[string]string{"cost_center": "1234", "environment": "production"})
+	state.Attributes["name"] = "example"
+	state.Attributes["resource_group_name"] = "example-resources"
+	config["tags"] = map[string]interface{}{
+		"environment": "production",
+	}
+	if _, err := resource.Diff(state, terraform.NewResourceConfigRaw(config), settings); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	t.Logf("[DEBUG] Testing an Existing Resource where the Default Tags are changed..")
+	state = testState(map[string]string{"environment": "production"}, map[string]string{"environment": "production"})
+	state.Attributes["name"] = "example"
+	state.Attributes["resource_group_name"] = "example-resources"
+	_, err = resource.Diff(state, terraform.NewResourceConfigRaw(config), settings)
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+	if !strings.Contains(err.Error(), `azurerm_example "example" (Resource Group "example-resources" / ID "example")`) || !strings.Contains(err.Error(), `"owner"`) {
+		t.Fatalf("Expected the error to reference the resource and the missing tag but got: %s", err)
+	}
+}
@@ -0,0 +1,19 @@
+package tags
+
+// Settings are the settings for tags specified in the Provider block, which are applied to every resource which
+// supports tags - these are configured for each instance of the Provider (for example an aliased Provider), and
+// are available to resources from the Client
+type Settings struct {
+	// DefaultTags are the tags specified in the `default_tags` block, which are applied to every resource
+	// which supports tags unless the resource specifies a tag with the same key
+	DefaultTags map[string]string
+
+	// IgnoreTags are the tags specified in the `ignore_tags` block, which are ignored on every resource
+	IgnoreTags IgnoreTags
+
+	// Policy is the policy specified in the `tag_policy` block, which is nil when this isn't specified
+	Policy *Policy
+}
+
+// SettingsFunc returns the Settings for the instance of the Provider which the specified meta (the Client) belongs to
+type SettingsFunc func(meta interface{}) Settings
@@ -8,6 +8,7 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 	"unicode"
@@ -18,6 +19,8 @@ func main() {
 	name := flag.String("name", "", "The name of this Resource Type")
 	id := flag.String("id", "", "An example of this Resource ID")
 	rewrite := flag.Bool("rewrite", false, "Should this Resource ID be parsed insensitively, to workaround an API bug?")
+	resourceTypes := flag.String("resource-type", "", "A comma-separated list of the Terraform Resource Types which use this Resource ID, used to build the Registry")
+	registryPath := flag.String("registry", "", "The path to the Registry which should be generated from the Resource ID's within the Services at `-path`")
 	showHelp := flag.Bool("help", false, "Display this message")
 
 	flag.Parse()
@@ -27,6 +30,17 @@ func main() {
 		return
 	}
 
+	if *registryPath != "" {
+		if err := runRegistry(*servicePackagePath, *registryPath); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	if _, err := parseResourceTypes(*resourceTypes); err != nil {
+		panic(err)
+	}
+
 	if err := run(*servicePackagePath, *name, *id, *rewrite); err != nil {
 		panic(err)
 	}
@@ -59,6 +73,10 @@ func run(servicePackagePath, name, id string, shouldRewrite bool) error {
 		return err
 	}
 
+	if shouldRewrite && resourceId.HasUserSpecifiedSegment {
+		return fmt.Errorf("`-rewrite` isn't supported for Resource ID's with a user-specified segment, the `%sIDNormalized` parser can be used instead", name)
+	}
+
 	generator := ResourceIdGenerator{
 		ResourceId:    *resourceId,
 		ShouldRewrite: shouldRewrite,
@@ -74,6 +92,11 @@ func run(servicePackagePath, name, id string, shouldRewrite bool) error {
 		return fmt.Errorf("generating Parser Tests at %q: %+v", parserTestsFilePath, err)
 	}
 
+	parserFuzzFilePath := fmt.Sprintf("%s/%s_fuzz_test.go", parsersPath, fileName)
+	if err := goFmtAndWriteToFile(parserFuzzFilePath, generator.FuzzCode()); err != nil {
+		return fmt.Errorf("generating Parser Fuzz Tests at %q: %+v", parserFuzzFilePath, err)
+	}
+
 	validatorFilePath := fmt.Sprintf("%s/%s.go", validatorPath, validatorFileName)
 	if err := goFmtAndWriteToFile(validatorFilePath, generator.ValidatorCode()); err != nil {
 		return fmt.Errorf("generating Validator at %q: %+v", validatorFilePath, err)
@@ -166,6 +189,51 @@ type ResourceIdSegment struct {
 
 	// SegmentValue is the value for this segment used in the Resource ID
 	SegmentValue string
+
+	// UserSpecified is whether this segment is a user-specified Scope (e.g. `{scope}`) which can
+	// contain any number of segments, for example a Subscription, Resource Group or Resource ID
+	UserSpecified bool
+}
+
+// userSpecifiedSegmentExampleLiteral is the Go literal for the first of the userSpecifiedSegmentExamples
+const userSpecifiedSegmentExampleLiteral = `resourceid.ResourceGroupScope{SubscriptionId: "12345678-1234-9876-4563-123456789012", ResourceGroup: "resGroup1"}`
+
+// userSpecifiedSegmentExamples are the example values used for a user-specified segment in the
+// generated tests - the first of which is used as the example value within the Resource ID
+var userSpecifiedSegmentExamples = []string{
+	"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+	"/subscriptions/12345678-1234-9876-4563-123456789012",
+	"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1",
+	"/providers/Microsoft.Management/managementGroups/group1",
+	"/providers/Microsoft.Capacity/reservationOrders/order1",
+	"",
+}
+
+// goType returns the type used for this segment in the Resource ID Struct
+func (segment ResourceIdSegment) goType() string {
+	if segment.UserSpecified {
+		return "resourceid.Scope"
+	}
+
+	return "string"
+}
+
+// formattedValue returns the expression used to format this segment of the Resource ID Struct `id`
+func (segment ResourceIdSegment) formattedValue() string {
+	if segment.UserSpecified {
+		return fmt.Sprintf("id.%s.ID()", segment.FieldName)
+	}
+
+	return fmt.Sprintf("id.%s", segment.FieldName)
+}
+
+// literal returns the Go literal for the example value of this segment, used in the generated tests
+func (segment ResourceIdSegment) literal() string {
+	if segment.UserSpecified {
+		return userSpecifiedSegmentExampleLiteral
+	}
+
+	return fmt.Sprintf("%q", segment.SegmentValue)
 }
 
 type ResourceId struct {
@@ -175,12 +243,17 @@ type ResourceId struct {
 
 	ServicePackageName string
 
-	HasResourceGroup  bool
-	HasSubscriptionId bool
-	Segments          []ResourceIdSegment // this has to be a slice not a map since we care about the order
+	HasResourceGroup        bool
+	HasSubscriptionId       bool
+	HasUserSpecifiedSegment bool
+	Segments                []ResourceIdSegment // this has to be a slice not a map since we care about the order
 }
 
 func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId, error) {
+	if strings.HasPrefix(resourceId, "{") {
+		return newResourceIDWithUserSpecifiedSegment(typeName, servicePackageName, resourceId)
+	}
+
 	// split the string, but remove the prefix of `/` since it's an empty segment
 	split := strings.Split(strings.TrimPrefix(resourceId, "/"), "/")
 	if len(split)%2 != 0 {
@@ -303,6 +376,110 @@ func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId
 	}, nil
 }
 
+// newResourceIDWithUserSpecifiedSegment parses a Resource ID which is nested beneath a user-specified Scope,
+// for example `{scope}/providers/Microsoft.Authorization/roleAssignments/assignment1` - which is used for
+// extension resources which can be nested beneath a Subscription, a Resource Group or another Resource.
+func newResourceIDWithUserSpecifiedSegment(typeName, servicePackageName, resourceId string) (*ResourceId, error) {
+	end := strings.Index(resourceId, "}")
+	if end == -1 {
+		return nil, fmt.Errorf("the user-specified segment in %q wasn't closed", resourceId)
+	}
+
+	argumentName := resourceId[1:end]
+	if argumentName == "" || strings.ContainsAny(argumentName, "/{") {
+		return nil, fmt.Errorf("the user-specified segment in %q must be named, e.g. `{scope}`", resourceId)
+	}
+
+	remaining := resourceId[end+1:]
+	if !strings.HasPrefix(remaining, "/") || strings.Contains(remaining, "{") {
+		return nil, fmt.Errorf("only the first segment of %q can be user-specified", resourceId)
+	}
+
+	id, err := NewResourceID(typeName, servicePackageName, remaining)
+	if err != nil {
+		return nil, err
+	}
+
+	if id.HasSubscriptionId || id.HasResourceGroup {
+		return nil, fmt.Errorf("the Subscription and Resource Group should be a part of the user-specified segment in %q", resourceId)
+	}
+
+	example := userSpecifiedSegmentExamples[0]
+	segment := ResourceIdSegment{
+		ArgumentName:  argumentName,
+		FieldName:     strings.Title(argumentName),
+		SegmentValue:  example,
+		UserSpecified: true,
+	}
+
+	id.Segments = append([]ResourceIdSegment{segment}, id.Segments...)
+	id.IDFmt = "%s" + id.IDFmt
+	id.IDRaw = example + id.IDRaw
+	id.HasUserSpecifiedSegment = true
+	return id, nil
+}
+
+// withUserSpecifiedSegment returns the example Resource ID using the specified value for the user-specified segment
+func (id ResourceId) withUserSpecifiedSegment(value string) string {
+	return value + strings.TrimPrefix(id.IDRaw, id.Segments[0].SegmentValue)
+}
+
+// transformedSegmentKeys returns the example Resource ID with each of the constant segments (that is, the
+// segment keys and the Resource Provider) transformed using `transform`
+func (id ResourceId) transformedSegmentKeys(transform func(in string) string) string {
+	formatSegments := strings.Split(id.IDFmt, "/")
+	for i, v := range formatSegments {
+		if v != "%s" {
+			formatSegments[i] = transform(v)
+		}
+	}
+
+	values := make([]interface{}, 0)
+	for _, segment := range id.Segments {
+		values = append(values, segment.SegmentValue)
+	}
+
+	return fmt.Sprintf(strings.Join(formatSegments, "/"), values...)
+}
+
+// invalidInput is an invalid Resource ID used in the generated tests
+type invalidInput struct {
+	Description string
+	Input       string
+}
+
+// invalidInputs returns the invalid Resource ID's used in the generated tests, that is an empty value,
+// and the Resource ID missing each segment key and value
+func (id ResourceId) invalidInputs() []invalidInput {
+	out := make([]invalidInput, 0)
+	for _, segment := range id.Segments {
+		if segment.UserSpecified {
+			// the Resource ID without a Scope is nested beneath the Tenant, so is valid
+			out = append(out, invalidInput{
+				Description: fmt.Sprintf("invalid value for %s", segment.FieldName),
+				Input:       "/subscriptions" + strings.TrimPrefix(id.IDRaw, segment.SegmentValue),
+			})
+			continue
+		}
+
+		// missing the key
+		resourceIdToThisPointIndex := strings.Index(id.IDRaw, segment.SegmentKey)
+		out = append(out, invalidInput{
+			Description: segment.FieldName,
+			Input:       id.IDRaw[0:resourceIdToThisPointIndex],
+		})
+
+		// missing the value
+		resourceIdToThisPointIndex = strings.Index(id.IDRaw, segment.SegmentValue)
+		out = append(out, invalidInput{
+			Description: fmt.Sprintf("value for %s", segment.FieldName),
+			Input:       id.IDRaw[0:resourceIdToThisPointIndex],
+		})
+	}
+
+	return out
+}
+
 type ResourceIdGenerator struct {
 	ResourceId
 
@@ -310,6 +487,13 @@ type ResourceIdGenerator struct {
 }
 
 func (id ResourceIdGenerator) Code() string {
+	imports := []string{
+		`	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"`,
+	}
+	if !id.HasUserSpecifiedSegment {
+		imports = append([]string{`	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"`}, imports...)
+	}
+
 	return fmt.Sprintf(`
 package parse
 
@@ -319,7 +503,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+%s
 )
 
 %s
@@ -328,13 +512,14 @@ import (
 %s
 %s
 %s
-`, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForParser(), id.codeForParserInsensitive())
+%s
+`, strings.Join(imports, "\n"), id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForParser(), id.codeForParserNormalized(), id.codeForParserInsensitive())
 }
 
 func (id ResourceIdGenerator) codeForType() string {
 	fields := make([]string, 0)
 	for _, segment := range id.Segments {
-		fields = append(fields, fmt.Sprintf("\t%s\tstring", segment.FieldName))
+		fields = append(fields, fmt.Sprintf("\t%s\t%s", segment.FieldName, segment.goType()))
 	}
 	fieldStr := strings.Join(fields, "\n")
 	return fmt.Sprintf(`
@@ -348,15 +533,20 @@ func (id ResourceIdGenerator) codeForConstructor() string {
 	arguments := make([]string, 0)
 	assignments := make([]string, 0)
 
+	argumentsStr := ""
 	for _, segment := range id.Segments {
-		arguments = append(arguments, segment.ArgumentName)
+		if segment.UserSpecified {
+			argumentsStr = fmt.Sprintf("%s %s, ", segment.ArgumentName, segment.goType())
+		} else {
+			arguments = append(arguments, segment.ArgumentName)
+		}
 		assignments = append(assignments, fmt.Sprintf("\t\t%s:\t%s,", segment.FieldName, segment.ArgumentName))
 	}
 
-	argumentsStr := strings.Join(arguments, ", ")
+	argumentsStr += fmt.Sprintf("%s string", strings.Join(arguments, ", "))
 	assignmentsStr := strings.Join(assignments, "\n")
 	return fmt.Sprintf(`
-func New%[1]sID(%[2]s string) %[1]sId {
+func New%[1]sID(%[2]s) %[1]sId {
 	return %[1]sId{
 %[3]s
 	}
@@ -385,7 +575,7 @@ func (id ResourceIdGenerator) codeForDescription() string {
 		}
 
 		humanReadableKey := makeHumanReadable(segment.FieldName)
-		formatKeys = append(formatKeys, fmt.Sprintf("\t\tfmt.Sprintf(\"%[1]s %%q\", id.%[2]s),", humanReadableKey, segment.FieldName))
+		formatKeys = append(formatKeys, fmt.Sprintf("\t\tfmt.Sprintf(\"%[1]s %%q\", %[2]s),", humanReadableKey, segment.formattedValue()))
 	}
 
 	reversedKeys := make([]string, 0)
@@ -408,7 +598,7 @@ func (id %[1]sId) String() string {
 func (id ResourceIdGenerator) codeForFormatter() string {
 	formatKeys := make([]string, 0)
 	for _, segment := range id.Segments {
-		formatKeys = append(formatKeys, fmt.Sprintf("id.%s", segment.FieldName))
+		formatKeys = append(formatKeys, segment.formattedValue())
 	}
 	formatKeysString := strings.Join(formatKeys, ", ")
 	return fmt.Sprintf(`
@@ -420,6 +610,10 @@ func (id %[1]sId) ID() string {
 }
 
 func (id ResourceIdGenerator) codeForParser() string {
+	if id.HasUserSpecifiedSegment {
+		return id.codeForParserWithUserSpecifiedSegment()
+	}
+
 	directAssignments := make([]string, 0)
 	if id.HasSubscriptionId {
 		directAssignments = append(directAssignments, "\t\tSubscriptionId: id.SubscriptionID,")
@@ -469,6 +663,46 @@ func %[1]sID(input string) (*%[1]sId, error) {
 `, id.TypeName, directAssignmentsStr, parserStatementsStr)
 }
 
+func (id ResourceIdGenerator) codeForParserWithUserSpecifiedSegment() string {
+	assignments := make([]string, 0)
+	for i, segment := range id.Segments {
+		if segment.UserSpecified {
+			assignments = append(assignments, fmt.Sprintf("\t\t%s:\tscope,", segment.FieldName))
+			continue
+		}
+
+		// the first segment is the user-specified segment
+		assignments = append(assignments, fmt.Sprintf("\t\t%s:\tvalues[%d],", segment.FieldName, i-1))
+	}
+	assignmentsStr := strings.Join(assignments, "\n")
+	return fmt.Sprintf(`
+// %[1]sID parses a %[1]s ID into an %[1]sId struct
+func %[1]sID(input string) (*%[1]sId, error) {
+	scope, values, err := resourceid.ParseScopedID(input, %[2]q)
+	if err != nil {
+		return nil, err
+	}
+
+	return &%[1]sId{
+%[3]s
+	}, nil
+}
+`, id.TypeName, strings.TrimPrefix(id.IDFmt, "%s"), assignmentsStr)
+}
+
+func (id ResourceIdGenerator) codeForParserNormalized() string {
+	return fmt.Sprintf(`
+// %[1]sIDNormalized parses a %[1]s ID into an %[1]sId struct, after normalizing the casing of
+// each segment key (and the Resource Provider) and removing any trailing slash
+//
+// This should be used to parse ID's returned from API's which don't return the ID in the
+// expected casing, the %[1]sID method should be used instead for validation etc.
+func %[1]sIDNormalized(input string) (*%[1]sId, error) {
+	return %[1]sID(resourceid.NormalizeSegmentKeys(input, %[2]q))
+}
+`, id.TypeName, id.IDFmt)
+}
+
 func (id ResourceIdGenerator) codeForParserInsensitive() string {
 	if !id.ShouldRewrite {
 		// this only exists to workaround broken API's to patch those ID's, so shouldn't be used in most circumstances
@@ -558,13 +792,15 @@ import (
 %s
 %s
 %s
-`, id.testCodeForFormatter(), id.testCodeForParser(), id.testCodeForParserInsensitive())
+%s
+%s
+`, id.testCodeForFormatter(), id.testCodeForParser(), id.testCodeForParserNormalized(), id.testCodeForParserInsensitive(), id.testCodeForRoundTrip())
 }
 
 func (id ResourceIdGenerator) testCodeForFormatter() string {
 	arguments := make([]string, 0)
 	for _, segment := range id.Segments {
-		arguments = append(arguments, fmt.Sprintf("%q", segment.SegmentValue))
+		arguments = append(arguments, segment.literal())
 	}
 	arguementsStr := strings.Join(arguments, ", ")
 	return fmt.Sprintf(`
@@ -589,24 +825,18 @@ func (id ResourceIdGenerator) testCodeForParser() string {
 			Error: true,
 		},
 `)
-	assignmentChecks := make([]string, 0)
-	for _, segment := range id.Segments {
+	for _, v := range id.invalidInputs() {
 		testCaseFmt := `
 		{
 			// missing %s
 			Input: %q,
 			Error: true,
 		},`
-		// missing the key
-		resourceIdToThisPointIndex := strings.Index(id.IDRaw, segment.SegmentKey)
-		resourceIdToThisPoint := id.IDRaw[0:resourceIdToThisPointIndex]
-		testCases = append(testCases, fmt.Sprintf(testCaseFmt, segment.FieldName, resourceIdToThisPoint))
-
-		// missing the value
-		resourceIdToThisPointIndex = strings.Index(id.IDRaw, segment.SegmentValue)
-		resourceIdToThisPoint = id.IDRaw[0:resourceIdToThisPointIndex]
-		testCases = append(testCases, fmt.Sprintf(testCaseFmt, fmt.Sprintf("value for %s", segment.FieldName), resourceIdToThisPoint))
+		testCases = append(testCases, fmt.Sprintf(testCaseFmt, v.Description, v.Input))
+	}
 
+	assignmentChecks := make([]string, 0)
+	for _, segment := range id.Segments {
 		assignmentsFmt := "\t\tif actual.%[1]s != v.Expected.%[1]s {\n\t\t\tt.Fatalf(\"Expected %%q but got %%q for %[1]s\", v.Expected.%[1]s, actual.%[1]s)\n\t\t}"
 		assignmentChecks = append(assignmentChecks, fmt.Sprintf(assignmentsFmt, segment.FieldName))
 	}
@@ -614,7 +844,7 @@ func (id ResourceIdGenerator) testCodeForParser() string {
 	// add a successful test case
 	expectAssignments := make([]string, 0)
 	for _, segment := range id.Segments {
-		expectAssignments = append(expectAssignments, fmt.Sprintf("\t\t\t\t%s:\t%q,", segment.FieldName, segment.SegmentValue))
+		expectAssignments = append(expectAssignments, fmt.Sprintf("\t\t\t\t%s:\t%s,", segment.FieldName, segment.literal()))
 	}
 	testCases = append(testCases, fmt.Sprintf(`
 		{
@@ -681,24 +911,18 @@ func (id ResourceIdGenerator) testCodeForParserInsensitive() string {
 			Error: true,
 		},
 `)
-	assignmentChecks := make([]string, 0)
-	for _, segment := range id.Segments {
+	for _, v := range id.invalidInputs() {
 		testCaseFmt := `
 		{
 			// missing %s
 			Input: %q,
 			Error: true,
 		},`
-		// missing the key
-		resourceIdToThisPointIndex := strings.Index(id.IDRaw, segment.SegmentKey)
-		resourceIdToThisPoint := id.IDRaw[0:resourceIdToThisPointIndex]
-		testCases = append(testCases, fmt.Sprintf(testCaseFmt, segment.FieldName, resourceIdToThisPoint))
-
-		// missing the value
-		resourceIdToThisPointIndex = strings.Index(id.IDRaw, segment.SegmentValue)
-		resourceIdToThisPoint = id.IDRaw[0:resourceIdToThisPointIndex]
-		testCases = append(testCases, fmt.Sprintf(testCaseFmt, fmt.Sprintf("value for %s", segment.FieldName), resourceIdToThisPoint))
+		testCases = append(testCases, fmt.Sprintf(testCaseFmt, v.Description, v.Input))
+	}
 
+	assignmentChecks := make([]string, 0)
+	for _, segment := range id.Segments {
 		assignmentsFmt := "\t\tif actual.%[1]s != v.Expected.%[1]s {\n\t\t\tt.Fatalf(\"Expected %%q but got %%q for %[1]s\", v.Expected.%[1]s, actual.%[1]s)\n\t\t}"
 		assignmentChecks = append(assignmentChecks, fmt.Sprintf(assignmentsFmt, segment.FieldName))
 	}
@@ -706,7 +930,7 @@ func (id ResourceIdGenerator) testCodeForParserInsensitive() string {
 	// add a successful test case
 	expectAssignments := make([]string, 0)
 	for _, segment := range id.Segments {
-		expectAssignments = append(expectAssignments, fmt.Sprintf("\t\t\t\t%s:\t%q,", segment.FieldName, segment.SegmentValue))
+		expectAssignments = append(expectAssignments, fmt.Sprintf("\t\t\t\t%s:\t%s,", segment.FieldName, segment.literal()))
 	}
 	testCases = append(testCases, fmt.Sprintf(`
 		{
@@ -786,6 +1010,180 @@ func Test%[1]sIDInsensitively(t *testing.T) {
 `, id.TypeName, testCasesStr, assignmentCheckStr)
 }
 
+func (id ResourceIdGenerator) testCodeForParserNormalized() string {
+	testCases := make([]string, 0)
+	testCases = append(testCases, `
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+`)
+	for _, v := range id.invalidInputs() {
+		testCaseFmt := `
+		{
+			// missing %s
+			Input: %q,
+			Error: true,
+		},`
+		testCases = append(testCases, fmt.Sprintf(testCaseFmt, v.Description, v.Input))
+	}
+
+	expectAssignments := make([]string, 0)
+	assignmentChecks := make([]string, 0)
+	for _, segment := range id.Segments {
+		expectAssignments = append(expectAssignments, fmt.Sprintf("\t\t\t\t%s:\t%s,", segment.FieldName, segment.literal()))
+
+		assignmentsFmt := "\t\tif actual.%[1]s != v.Expected.%[1]s {\n\t\t\tt.Fatalf(\"Expected %%q but got %%q for %[1]s\", v.Expected.%[1]s, actual.%[1]s)\n\t\t}"
+		assignmentChecks = append(assignmentChecks, fmt.Sprintf(assignmentsFmt, segment.FieldName))
+	}
+
+	var validTestCase = func(testCaseName, input string) string {
+		return fmt.Sprintf(`
+		{
+			// %[4]s
+			Input: %[1]q,
+			Expected: &%[2]sId{
+%[3]s
+			},
+		},`, input, id.TypeName, strings.Join(expectAssignments, "\n"), testCaseName)
+	}
+
+	testCases = append(testCases, validTestCase("valid", id.IDRaw))
+	testCases = append(testCases, validTestCase("trailing slash", id.IDRaw+"/"))
+	testCases = append(testCases, validTestCase("lower-cased segment names", id.transformedSegmentKeys(strings.ToLower)))
+	testCases = append(testCases, validTestCase("upper-cased segment names", id.transformedSegmentKeys(strings.ToUpper)))
+
+	testCasesStr := strings.Join(testCases, "\n")
+	assignmentCheckStr := strings.Join(assignmentChecks, "\n")
+	return fmt.Sprintf(`
+func Test%[1]sIDNormalized(t *testing.T) {
+	testData := []struct {
+		Input  string
+		Error  bool
+		Expected *%[1]sId
+	}{
+%[2]s
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %%q", v.Input)
+
+		actual, err := %[1]sIDNormalized(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %%s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+%[3]s
+	}
+}
+`, id.TypeName, testCasesStr, assignmentCheckStr)
+}
+
+func (id ResourceIdGenerator) testCodeForRoundTrip() string {
+	inputs := []string{
+		fmt.Sprintf("\t\t%q,", id.IDRaw),
+	}
+	if id.HasUserSpecifiedSegment {
+		// the first example is used in the Resource ID
+		for _, v := range userSpecifiedSegmentExamples[1:] {
+			inputs = append(inputs, fmt.Sprintf("\t\t%q,", id.withUserSpecifiedSegment(v)))
+		}
+	}
+
+	arguments := make([]string, 0)
+	for _, segment := range id.Segments {
+		arguments = append(arguments, fmt.Sprintf("id.%s", segment.FieldName))
+	}
+
+	return fmt.Sprintf(`
+func Test%[1]sIDRoundTrip(t *testing.T) {
+	testData := []string{
+%[2]s
+	}
+
+	for _, input := range testData {
+		t.Logf("[DEBUG] Testing %%q", input)
+
+		id, err := %[1]sID(input)
+		if err != nil {
+			t.Fatalf("Expect a value but got an error: %%s", err)
+		}
+
+		// formatting the parsed ID should return the original ID
+		if actual := id.ID(); actual != input {
+			t.Fatalf("Expected %%q but got %%q", input, actual)
+		}
+
+		// and building the ID from each of it's segments should return the same ID
+		if actual := New%[1]sID(%[3]s); actual != *id {
+			t.Fatalf("Expected %%+v but got %%+v", *id, actual)
+		}
+	}
+}
+`, id.TypeName, strings.Join(inputs, "\n"), strings.Join(arguments, ", "))
+}
+
+// FuzzCode returns the Fuzz Target for this Resource ID, which requires Go 1.18
+func (id ResourceIdGenerator) FuzzCode() string {
+	seeds := []string{
+		id.IDRaw,
+		id.IDRaw + "/",
+		id.transformedSegmentKeys(strings.ToUpper),
+	}
+	if id.HasUserSpecifiedSegment {
+		for _, v := range userSpecifiedSegmentExamples[1:] {
+			seeds = append(seeds, id.withUserSpecifiedSegment(v))
+		}
+	}
+	for _, v := range id.invalidInputs() {
+		seeds = append(seeds, v.Input)
+	}
+
+	seedStatements := make([]string, 0)
+	for _, v := range seeds {
+		seedStatements = append(seedStatements, fmt.Sprintf("\tf.Add(%q)", v))
+	}
+
+	return fmt.Sprintf(`//go:build go1.18
+// +build go1.18
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import "testing"
+
+func Fuzz%[1]sID(f *testing.F) {
+%[2]s
+
+	f.Fuzz(func(t *testing.T, input string) {
+		id, err := %[1]sIDNormalized(input)
+		if err != nil {
+			return
+		}
+
+		// any ID which can be parsed should be formatted into an ID which the strict parser accepts,
+		// and which is unchanged when it's parsed again
+		actual, err := %[1]sID(id.ID())
+		if err != nil {
+			t.Fatalf("parsing the formatted ID %%q: %%+v", id.ID(), err)
+		}
+		if *actual != *id {
+			t.Fatalf("Expected %%+v but got %%+v", *id, *actual)
+		}
+	})
+}
+`, id.TypeName, strings.Join(seedStatements, "\n"))
+}
+
 func (id ResourceIdGenerator) ValidatorCode() string {
 	return fmt.Sprintf(`package validate
 
@@ -822,22 +1220,14 @@ func (id ResourceIdGenerator) ValidatorTestCode() string {
 			Valid: false,
 		},
 `)
-	for _, segment := range id.Segments {
+	for _, v := range id.invalidInputs() {
 		testCaseFmt := `
 		{
 			// missing %s
 			Input: %q,
 			Valid: false,
 		},`
-		// missing the key
-		resourceIdToThisPointIndex := strings.Index(id.IDRaw, segment.SegmentKey)
-		resourceIdToThisPoint := id.IDRaw[0:resourceIdToThisPointIndex]
-		testCases = append(testCases, fmt.Sprintf(testCaseFmt, segment.FieldName, resourceIdToThisPoint))
-
-		// missing the value
-		resourceIdToThisPointIndex = strings.Index(id.IDRaw, segment.SegmentValue)
-		resourceIdToThisPoint = id.IDRaw[0:resourceIdToThisPointIndex]
-		testCases = append(testCases, fmt.Sprintf(testCaseFmt, fmt.Sprintf("value for %s", segment.FieldName), resourceIdToThisPoint))
+		testCases = append(testCases, fmt.Sprintf(testCaseFmt, v.Description, v.Input))
 	}
 
 	// add a successful test case
@@ -885,6 +1275,160 @@ func Test%[1]sID(t *testing.T) {
 `, id.TypeName, testCasesStr)
 }
 
+func parseResourceTypes(input string) ([]string, error) {
+	resourceTypes := make([]string, 0)
+	if input == "" {
+		return resourceTypes, nil
+	}
+
+	for _, v := range strings.Split(input, ",") {
+		if !strings.HasPrefix(v, "azurerm_") {
+			return nil, fmt.Errorf("expected the Resource Type %q to start with `azurerm_`", v)
+		}
+
+		resourceTypes = append(resourceTypes, v)
+	}
+
+	sort.Strings(resourceTypes)
+	return resourceTypes, nil
+}
+
+// RegistryEntry is a Resource ID (and the Resource Types using it) which is output into the Registry
+type RegistryEntry struct {
+	ResourceId
+
+	ResourceTypes []string
+}
+
+// Template returns the Resource ID with each user-specified value replaced with the name of that segment
+// e.g. `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}`
+func (e RegistryEntry) Template() string {
+	template := ""
+	idRaw := e.IDRaw
+	segments := e.Segments
+	if e.HasUserSpecifiedSegment {
+		// the user-specified segment can span any number of segments, so is a single placeholder
+		// e.g. `{scope}/providers/Microsoft.Authorization/roleAssignments/{name}`
+		template = fmt.Sprintf("{%s}", segments[0].ArgumentName)
+		idRaw = strings.TrimPrefix(idRaw, segments[0].SegmentValue)
+		segments = segments[1:]
+	}
+
+	split := strings.Split(strings.TrimPrefix(idRaw, "/"), "/")
+	segmentIndex := 0
+	for i := 0; i < len(split); i += 2 {
+		key := split[i]
+		value := split[i+1]
+
+		// the RP is a constant rather than a segment
+		if key != "providers" {
+			value = fmt.Sprintf("{%s}", segments[segmentIndex].ArgumentName)
+			segmentIndex++
+		}
+
+		template += fmt.Sprintf("/%s/%s", key, value)
+	}
+
+	return template
+}
+
+var generateResourceIdRegex = regexp.MustCompile(`(?m)^//go:generate go run [^\s]*generator-resource-id/main\.go (.*)$`)
+
+// runRegistry generates the Registry of Resource ID's from the `go:generate` lines within each Service Package
+func runRegistry(servicesPath, registryPath string) error {
+	entries, err := findRegistryEntries(servicesPath)
+	if err != nil {
+		return err
+	}
+
+	return goFmtAndWriteToFile(registryPath, registryCode(entries))
+}
+
+func findRegistryEntries(servicesPath string) ([]RegistryEntry, error) {
+	files, err := filepath.Glob(path.Join(servicesPath, "*", "*.go"))
+	if err != nil {
+		return nil, fmt.Errorf("finding files within %q: %+v", servicesPath, err)
+	}
+	sort.Strings(files)
+
+	entries := make([]RegistryEntry, 0)
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		contents, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("reading %q: %+v", file, err)
+		}
+
+		servicePackageName := filepath.Base(filepath.Dir(file))
+		for _, match := range generateResourceIdRegex.FindAllStringSubmatch(string(contents), -1) {
+			entry, err := parseRegistryEntry(servicePackageName, match[1])
+			if err != nil {
+				return nil, fmt.Errorf("parsing `go:generate` line within %q: %+v", file, err)
+			}
+
+			entries = append(entries, *entry)
+		}
+	}
+
+	return entries, nil
+}
+
+func parseRegistryEntry(servicePackageName, arguments string) (*RegistryEntry, error) {
+	f := flag.NewFlagSet("registry", flag.ContinueOnError)
+	_ = f.String("path", "", "")
+	name := f.String("name", "", "")
+	id := f.String("id", "", "")
+	_ = f.Bool("rewrite", false, "")
+	resourceTypes := f.String("resource-type", "", "")
+	if err := f.Parse(strings.Fields(arguments)); err != nil {
+		return nil, err
+	}
+
+	resourceId, err := NewResourceID(*name, servicePackageName, *id)
+	if err != nil {
+		return nil, err
+	}
+
+	types, err := parseResourceTypes(*resourceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RegistryEntry{
+		ResourceId:    *resourceId,
+		ResourceTypes: types,
+	}, nil
+}
+
+func registryCode(entries []RegistryEntry) string {
+	items := make([]string, 0)
+	for _, entry := range entries {
+		resourceTypes := make([]string, 0)
+		for _, v := range entry.ResourceTypes {
+			resourceTypes = append(resourceTypes, fmt.Sprintf("%q", v))
+		}
+
+		items = append(items, fmt.Sprintf(`	{
+		Name:               %q,
+		ServicePackageName: %q,
+		Template:           %q,
+		ResourceTypes:      []string{%s},
+	},`, entry.TypeName, entry.ServicePackageName, entry.Template(), strings.Join(resourceTypes, ", ")))
+	}
+
+	return fmt.Sprintf(`package resourceid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+var registeredIDs = []RegisteredID{
+%s
+}
+`, strings.Join(items, "\n"))
+}
+
 func goFmtAndWriteToFile(filePath, fileContents string) error {
 	fmt, err := GolangCodeFormatter{}.Format(fileContents)
 	if err != nil {
@@ -54,3 +54,93 @@ func Test(t *testing.T) {
 		}
 	}
 }
+
+func TestRegistryEntryTemplate(t *testing.T) {
+	cases := []struct {
+		id       string
+		expected string
+	}{
+		{
+			id:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			expected: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}",
+		},
+		{
+			id:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AnalysisServices/servers/Server1",
+			expected: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.AnalysisServices/servers/{serverName}",
+		},
+		{
+			id:       "{scope}/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			expected: "{scope}/providers/Microsoft.Authorization/roleAssignments/{roleAssignmentName}",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q..", v.id)
+
+		id, err := NewResourceID("Example", "example", v.id)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", v.id, err)
+		}
+
+		actual := RegistryEntry{ResourceId: *id}.Template()
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestNewResourceIDWithUserSpecifiedSegment(t *testing.T) {
+	cases := []struct {
+		id    string
+		valid bool
+	}{
+		{
+			id:    "{scope}/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			valid: true,
+		},
+		{
+			id:    "{}/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			valid: false,
+		},
+		{
+			id:    "{scope/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			valid: false,
+		},
+		{
+			id:    "{scope}/providers/Microsoft.Authorization/roleAssignments/{name}",
+			valid: false,
+		},
+		{
+			id:    "{scope}/resourceGroups/group1/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			valid: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q..", v.id)
+
+		id, err := NewResourceID("RoleAssignment", "authorization", v.id)
+		if err != nil {
+			if v.valid {
+				t.Fatalf("expected %q to be valid but got: %+v", v.id, err)
+			}
+			continue
+		}
+		if !v.valid {
+			t.Fatalf("expected %q to be invalid", v.id)
+		}
+
+		if !id.HasUserSpecifiedSegment || !id.Segments[0].UserSpecified {
+			t.Fatalf("expected the first segment to be user-specified")
+		}
+		if id.Segments[0].FieldName != "Scope" {
+			t.Fatalf("expected the first segment to be named %q but got %q", "Scope", id.Segments[0].FieldName)
+		}
+		if expected := "%s/providers/Microsoft.Authorization/roleAssignments/%s"; id.IDFmt != expected {
+			t.Fatalf("expected the format %q but got %q", expected, id.IDFmt)
+		}
+		if expected := userSpecifiedSegmentExamples[0] + "/providers/Microsoft.Authorization/roleAssignments/assignment1"; id.IDRaw != expected {
+			t.Fatalf("expected the example %q but got %q", expected, id.IDRaw)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package possiblevalues
+
+import (
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var possibleValuesRegex = regexp.MustCompile(`to be one of \[(.*)\], got`)
+
+// ForField returns the possible values for this field when it's validated using `validation.StringInSlice`
+// or `validation.IntInSlice`, in the order they're defined - since these are closures the only way to
+// determine the possible values is to validate an invalid value and parse the error
+func ForField(name string, field *schema.Schema) (values []string) {
+	if field.ValidateFunc == nil {
+		return nil
+	}
+
+	var invalidValue interface{}
+	switch field.Type {
+	case schema.TypeString:
+		invalidValue = "\x00"
+	case schema.TypeInt:
+		invalidValue = math.MinInt32
+	default:
+		return nil
+	}
+
+	// validation functions can make assumptions about the value, which we don't want to fail on
+	defer func() {
+		if r := recover(); r != nil {
+			values = nil
+		}
+	}()
+
+	_, errs := field.ValidateFunc(invalidValue, name)
+	for _, err := range errs {
+		matches := possibleValuesRegex.FindStringSubmatch(err.Error())
+		if len(matches) != 2 {
+			continue
+		}
+
+		if field.Type == schema.TypeString {
+			return splitValues(name, field, matches[1])
+		}
+		return strings.Fields(matches[1])
+	}
+
+	return nil
+}
+
+// splitValues splits the possible values from the error message - since these are separated by a space
+// (and a value can itself contain a space) the longest sequence of words which is a valid value is used
+func splitValues(name string, field *schema.Schema, input string) []string {
+	words := strings.Split(input, " ")
+	values := make([]string, 0)
+	for i := 0; i < len(words); {
+		end := i + 1
+		for j := len(words); j > i+1; j-- {
+			if isValid(name, field, strings.Join(words[i:j], " ")) {
+				end = j
+				break
+			}
+		}
+
+		values = append(values, strings.Join(words[i:end], " "))
+		i = end
+	}
+
+	return values
+}
+
+func isValid(name string, field *schema.Schema, value string) bool {
+	_, errs := field.ValidateFunc(value, name)
+	return len(errs) == 0
+}
@@ -0,0 +1,66 @@
+package possiblevalues
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func TestForField(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Field    *schema.Schema
+		Expected []string
+	}{
+		{
+			Name: "Not Validated",
+			Field: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Expected: nil,
+		},
+		{
+			Name: "Not Validated using a Slice",
+			Field: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			Expected: nil,
+		},
+		{
+			Name: "Strings",
+			Field: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"Standard", "Basic"}, false),
+			},
+			Expected: []string{"Standard", "Basic"},
+		},
+		{
+			Name: "Strings containing Spaces",
+			Field: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"Basic", "Standard LRS", "Standard", "Premium Zone Redundant"}, false),
+			},
+			Expected: []string{"Basic", "Standard LRS", "Standard", "Premium Zone Redundant"},
+		},
+		{
+			Name: "Integers",
+			Field: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntInSlice([]int{1, 2, 4}),
+			},
+			Expected: []string{"1", "2", "4"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := ForField("example", v.Field)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
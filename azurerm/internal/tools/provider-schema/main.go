@@ -0,0 +1,327 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tools/possiblevalues"
+)
+
+// NOTE: since we're using `go run` for these tools all of the code needs to live within the main.go (or a package it imports)
+
+func main() {
+	exportPath := flag.String("export", "", "The path to the file which the Provider Schema should be exported to")
+	oldPath := flag.String("old", "", "The path to the previously exported Provider Schema which should be compared")
+	newPath := flag.String("new", "", "The path to the exported Provider Schema which should be compared against `-old`")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	if *exportPath != "" {
+		if err := export(*exportPath); err != nil {
+			log.Printf("Error exporting the Provider Schema: %+v", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *oldPath == "" || *newPath == "" {
+		log.Print("Either `-export` or both `-old` and `-new` must be specified")
+		os.Exit(1)
+	}
+
+	breakingChanges, err := compare(*oldPath, *newPath)
+	if err != nil {
+		log.Printf("Error comparing the Provider Schemas: %+v", err)
+		os.Exit(1)
+	}
+	if len(breakingChanges) > 0 {
+		log.Printf("Found %d Breaking Changes:\n\n* %s", len(breakingChanges), strings.Join(breakingChanges, "\n* "))
+		os.Exit(1)
+	}
+
+	log.Print("No Breaking Changes were found")
+}
+
+// ProviderSchema is the exported Schema for each Data Source and Resource within the Provider
+type ProviderSchema struct {
+	DataSources map[string]ResourceSchema `json:"data_sources"`
+	Resources   map[string]ResourceSchema `json:"resources"`
+}
+
+// ResourceSchema is the exported Schema for a Data Source or Resource
+type ResourceSchema struct {
+	Fields map[string]FieldSchema `json:"fields"`
+}
+
+// FieldSchema is the exported Schema for a single field, including any nested fields
+type FieldSchema struct {
+	Type           string                 `json:"type"`
+	Required       bool                   `json:"required,omitempty"`
+	Optional       bool                   `json:"optional,omitempty"`
+	Computed       bool                   `json:"computed,omitempty"`
+	ForceNew       bool                   `json:"force_new,omitempty"`
+	Sensitive      bool                   `json:"sensitive,omitempty"`
+	Default        interface{}            `json:"default,omitempty"`
+	MinItems       int                    `json:"min_items,omitempty"`
+	MaxItems       int                    `json:"max_items,omitempty"`
+	Validated      bool                   `json:"validated,omitempty"`
+	PossibleValues []string               `json:"possible_values,omitempty"`
+	ElemType       string                 `json:"elem_type,omitempty"`
+	Block          map[string]FieldSchema `json:"block,omitempty"`
+}
+
+func export(outputPath string) error {
+	providerSchema, err := buildProviderSchema()
+	if err != nil {
+		return err
+	}
+
+	contents, err := json.MarshalIndent(providerSchema, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing: %+v", err)
+	}
+
+	return ioutil.WriteFile(outputPath, contents, 0644)
+}
+
+func buildProviderSchema() (*ProviderSchema, error) {
+	out := ProviderSchema{
+		DataSources: make(map[string]ResourceSchema),
+		Resources:   make(map[string]ResourceSchema),
+	}
+
+	for _, service := range provider.SupportedTypedServices() {
+		for _, ds := range service.DataSources() {
+			wrapper := sdk.NewDataSourceWrapper(ds)
+			resource, err := wrapper.DataSource()
+			if err != nil {
+				return nil, fmt.Errorf("wrapping Data Source %q: %+v", ds.ResourceType(), err)
+			}
+			out.DataSources[ds.ResourceType()] = exportResource(resource)
+		}
+
+		for _, rs := range service.Resources() {
+			wrapper := sdk.NewResourceWrapper(rs)
+			resource, err := wrapper.Resource()
+			if err != nil {
+				return nil, fmt.Errorf("wrapping Resource %q: %+v", rs.ResourceType(), err)
+			}
+			out.Resources[rs.ResourceType()] = exportResource(resource)
+		}
+	}
+
+	for _, service := range provider.SupportedUntypedServices() {
+		for key, ds := range service.SupportedDataSources() {
+			out.DataSources[key] = exportResource(ds)
+		}
+
+		for key, rs := range service.SupportedResources() {
+			out.Resources[key] = exportResource(rs)
+		}
+	}
+
+	return &out, nil
+}
+
+func exportResource(resource *schema.Resource) ResourceSchema {
+	return ResourceSchema{
+		Fields: exportFields(resource.Schema),
+	}
+}
+
+func exportFields(input map[string]*schema.Schema) map[string]FieldSchema {
+	out := make(map[string]FieldSchema)
+	for name, field := range input {
+		exported := FieldSchema{
+			Type:           field.Type.String(),
+			Required:       field.Required,
+			Optional:       field.Optional,
+			Computed:       field.Computed,
+			ForceNew:       field.ForceNew,
+			Sensitive:      field.Sensitive,
+			Default:        field.Default,
+			MinItems:       field.MinItems,
+			MaxItems:       field.MaxItems,
+			Validated:      field.ValidateFunc != nil,
+			PossibleValues: sortedPossibleValues(name, field),
+		}
+
+		switch elem := field.Elem.(type) {
+		case *schema.Schema:
+			exported.ElemType = elem.Type.String()
+			exported.Validated = exported.Validated || elem.ValidateFunc != nil
+		case *schema.Resource:
+			exported.Block = exportFields(elem.Schema)
+		}
+
+		out[name] = exported
+	}
+
+	return out
+}
+
+// sortedPossibleValues returns the possible values for this field sorted alphabetically, so that
+// the exported schema doesn't change when the order of these changes
+func sortedPossibleValues(name string, field *schema.Schema) []string {
+	values := possiblevalues.ForField(name, field)
+	sort.Strings(values)
+	return values
+}
+
+func compare(oldPath, newPath string) ([]string, error) {
+	oldSchema, err := loadProviderSchema(oldPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading %q: %+v", oldPath, err)
+	}
+
+	newSchema, err := loadProviderSchema(newPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading %q: %+v", newPath, err)
+	}
+
+	return breakingChanges(*oldSchema, *newSchema), nil
+}
+
+func loadProviderSchema(path string) (*ProviderSchema, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var out ProviderSchema
+	if err := json.Unmarshal(contents, &out); err != nil {
+		return nil, fmt.Errorf("parsing: %+v", err)
+	}
+
+	return &out, nil
+}
+
+// breakingChanges returns a sorted list of the breaking changes between the old and new Provider Schema
+func breakingChanges(oldSchema, newSchema ProviderSchema) []string {
+	out := make([]string, 0)
+	out = append(out, breakingChangesForResources("Data Source", oldSchema.DataSources, newSchema.DataSources)...)
+	out = append(out, breakingChangesForResources("Resource", oldSchema.Resources, newSchema.Resources)...)
+	sort.Strings(out)
+	return out
+}
+
+func breakingChangesForResources(kind string, oldResources, newResources map[string]ResourceSchema) []string {
+	out := make([]string, 0)
+	for name, oldResource := range oldResources {
+		newResource, ok := newResources[name]
+		if !ok {
+			out = append(out, fmt.Sprintf("%s %q: has been removed", kind, name))
+			continue
+		}
+
+		for _, change := range breakingChangesForFields("", oldResource.Fields, newResource.Fields) {
+			out = append(out, fmt.Sprintf("%s %q: %s", kind, name, change))
+		}
+	}
+
+	return out
+}
+
+func breakingChangesForFields(prefix string, oldFields, newFields map[string]FieldSchema) []string {
+	out := make([]string, 0)
+
+	for name, oldField := range oldFields {
+		key := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, name), ".")
+		newField, ok := newFields[name]
+		if !ok {
+			out = append(out, fmt.Sprintf("the field %q has been removed", key))
+			continue
+		}
+
+		out = append(out, breakingChangesForField(key, oldField, newField)...)
+	}
+
+	for name, newField := range newFields {
+		if _, ok := oldFields[name]; !ok && newField.Required {
+			key := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, name), ".")
+			out = append(out, fmt.Sprintf("the new field %q is Required", key))
+		}
+	}
+
+	return out
+}
+
+func breakingChangesForField(key string, oldField, newField FieldSchema) []string {
+	out := make([]string, 0)
+
+	if oldField.Type != newField.Type || oldField.ElemType != newField.ElemType {
+		out = append(out, fmt.Sprintf("the type of %q has changed from %s to %s", key, describeType(oldField), describeType(newField)))
+	}
+	if !oldField.Required && newField.Required {
+		out = append(out, fmt.Sprintf("the field %q is now Required", key))
+	}
+	if (oldField.Optional || oldField.Required) && !(newField.Optional || newField.Required) {
+		out = append(out, fmt.Sprintf("the field %q is no longer configurable", key))
+	}
+	if oldField.Computed && !newField.Computed {
+		out = append(out, fmt.Sprintf("the field %q is no longer Computed", key))
+	}
+	if !oldField.ForceNew && newField.ForceNew {
+		out = append(out, fmt.Sprintf("the field %q is now ForceNew", key))
+	}
+	if !reflect.DeepEqual(oldField.Default, newField.Default) {
+		out = append(out, fmt.Sprintf("the default value for %q has changed from %v to %v", key, oldField.Default, newField.Default))
+	}
+	if newField.MinItems > oldField.MinItems {
+		out = append(out, fmt.Sprintf("the MinItems for %q has increased from %d to %d", key, oldField.MinItems, newField.MinItems))
+	}
+	if newField.MaxItems != 0 && (oldField.MaxItems == 0 || newField.MaxItems < oldField.MaxItems) {
+		if oldField.MaxItems == 0 {
+			out = append(out, fmt.Sprintf("the MaxItems for %q is now limited to %d, where this was previously unlimited", key, newField.MaxItems))
+		} else {
+			out = append(out, fmt.Sprintf("the MaxItems for %q has decreased from %d to %d", key, oldField.MaxItems, newField.MaxItems))
+		}
+	}
+
+	// we can only compare validation where the possible values are known, otherwise we can
+	// only flag where validation has been added to a field which wasn't previously validated
+	if !oldField.Validated && newField.Validated {
+		out = append(out, fmt.Sprintf("validation has been added to %q", key))
+	}
+	if len(oldField.PossibleValues) > 0 {
+		newValues := make(map[string]struct{})
+		for _, v := range newField.PossibleValues {
+			newValues[v] = struct{}{}
+		}
+		for _, v := range oldField.PossibleValues {
+			if _, ok := newValues[v]; !ok && len(newField.PossibleValues) > 0 {
+				out = append(out, fmt.Sprintf("the possible value %q has been removed from %q", v, key))
+			}
+		}
+	}
+
+	if oldField.Block != nil || newField.Block != nil {
+		out = append(out, breakingChangesForFields(key, oldField.Block, newField.Block)...)
+	}
+
+	return out
+}
+
+func describeType(field FieldSchema) string {
+	if field.ElemType != "" {
+		return fmt.Sprintf("%s of %s", field.Type, field.ElemType)
+	}
+
+	return field.Type
+}
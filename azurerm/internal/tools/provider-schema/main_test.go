@@ -0,0 +1,176 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func TestExportFields(t *testing.T) {
+	actual := exportFields(map[string]*schema.Schema{
+		"sku": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "Standard",
+			ValidateFunc: validation.StringInSlice([]string{"Standard", "Basic"}, false),
+		},
+		"block": {
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"names": {
+						Type:     schema.TypeSet,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+	})
+
+	sku := actual["sku"]
+	if sku.Type != "TypeString" || !sku.Optional || sku.Default != "Standard" || !sku.Validated {
+		t.Fatalf("unexpected export for `sku`: %+v", sku)
+	}
+	if strings.Join(sku.PossibleValues, ",") != "Basic,Standard" {
+		t.Fatalf("expected the possible values to be `Basic,Standard` but got %+v", sku.PossibleValues)
+	}
+
+	block := actual["block"]
+	if block.Type != "TypeList" || !block.Required || block.MaxItems != 1 {
+		t.Fatalf("unexpected export for `block`: %+v", block)
+	}
+	if names := block.Block["names"]; names.Type != "TypeSet" || names.ElemType != "TypeString" || !names.Computed {
+		t.Fatalf("unexpected export for `block.names`: %+v", names)
+	}
+}
+
+func TestBreakingChanges(t *testing.T) {
+	oldSchema := ProviderSchema{
+		DataSources: map[string]ResourceSchema{
+			"azurerm_removed": {},
+		},
+		Resources: map[string]ResourceSchema{
+			"azurerm_example": {
+				Fields: map[string]FieldSchema{
+					"name": {
+						Type:     "TypeString",
+						Required: true,
+						ForceNew: true,
+					},
+					"removed": {
+						Type:     "TypeString",
+						Optional: true,
+					},
+					"now_required": {
+						Type:     "TypeString",
+						Optional: true,
+					},
+					"now_force_new": {
+						Type:     "TypeString",
+						Optional: true,
+					},
+					"sku": {
+						Type:           "TypeString",
+						Optional:       true,
+						Default:        "Standard",
+						Validated:      true,
+						PossibleValues: []string{"Basic", "Premium", "Standard"},
+					},
+					"block": {
+						Type:     "TypeList",
+						Optional: true,
+						Block: map[string]FieldSchema{
+							"value": {
+								Type:     "TypeInt",
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	newSchema := ProviderSchema{
+		DataSources: map[string]ResourceSchema{},
+		Resources: map[string]ResourceSchema{
+			"azurerm_example": {
+				Fields: map[string]FieldSchema{
+					"name": {
+						Type:     "TypeString",
+						Required: true,
+						ForceNew: true,
+					},
+					"now_required": {
+						Type:     "TypeString",
+						Required: true,
+					},
+					"now_force_new": {
+						Type:     "TypeString",
+						Optional: true,
+						ForceNew: true,
+					},
+					"sku": {
+						Type:           "TypeString",
+						Optional:       true,
+						Default:        "Basic",
+						Validated:      true,
+						PossibleValues: []string{"Basic", "Standard"},
+					},
+					"block": {
+						Type:     "TypeList",
+						Optional: true,
+						MaxItems: 1,
+						Block: map[string]FieldSchema{
+							"value": {
+								Type:      "TypeInt",
+								Optional:  true,
+								Validated: true,
+							},
+						},
+					},
+					"new_optional": {
+						Type:     "TypeString",
+						Optional: true,
+					},
+					"new_required": {
+						Type:     "TypeString",
+						Required: true,
+					},
+				},
+			},
+			"azurerm_new": {
+				Fields: map[string]FieldSchema{
+					"name": {
+						Type:     "TypeString",
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+
+	expected := []string{
+		`Data Source "azurerm_removed": has been removed`,
+		`Resource "azurerm_example": the MaxItems for "block" is now limited to 1, where this was previously unlimited`,
+		`Resource "azurerm_example": the default value for "sku" has changed from Standard to Basic`,
+		`Resource "azurerm_example": the field "now_force_new" is now ForceNew`,
+		`Resource "azurerm_example": the field "now_required" is now Required`,
+		`Resource "azurerm_example": the field "removed" has been removed`,
+		`Resource "azurerm_example": the new field "new_required" is Required`,
+		`Resource "azurerm_example": the possible value "Premium" has been removed from "sku"`,
+		`Resource "azurerm_example": validation has been added to "block.value"`,
+	}
+	actual := breakingChanges(oldSchema, newSchema)
+	if strings.Join(actual, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("expected:\n\n%s\n\nbut got:\n\n%s", strings.Join(expected, "\n"), strings.Join(actual, "\n"))
+	}
+
+	if changes := breakingChanges(oldSchema, oldSchema); len(changes) > 0 {
+		t.Fatalf("expected no breaking changes when comparing the same schema but got: %+v", changes)
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+func main() {
+	id := flag.String("id", "", "The Azure Resource ID which should be looked up")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	if *id == "" {
+		log.Print("The Azure Resource ID must be specified via `-id`")
+		os.Exit(1)
+	}
+
+	output, found := describe(*id, resourceid.Lookup(*id))
+	fmt.Println(output)
+	if !found {
+		os.Exit(1)
+	}
+}
+
+// describe returns a description of which Terraform Resources can import the specified Resource ID,
+// and whether at least one Terraform Resource was found
+func describe(id string, matches []resourceid.RegisteredID) (string, bool) {
+	if len(matches) == 0 {
+		return fmt.Sprintf("The Resource ID %q doesn't match any of the registered Resource ID's", id), false
+	}
+
+	lines := make([]string, 0)
+	found := false
+	for _, match := range matches {
+		lines = append(lines, fmt.Sprintf("Matches the Resource ID %q in the %q Service Package (%s)", match.Name, match.ServicePackageName, match.Template))
+
+		if len(match.ResourceTypes) == 0 {
+			lines = append(lines, "  No Terraform Resources are registered as using this Resource ID")
+			continue
+		}
+
+		found = true
+		for _, command := range match.ImportCommands(id) {
+			lines = append(lines, fmt.Sprintf("  %s", command))
+		}
+	}
+
+	return strings.Join(lines, "\n"), found
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+func TestDescribe(t *testing.T) {
+	id := "/subscriptions/1234/resourceGroups/group1"
+
+	t.Logf("[DEBUG] Testing no matches..")
+	if _, found := describe(id, nil); found {
+		t.Fatalf("expected nothing to be found")
+	}
+
+	t.Logf("[DEBUG] Testing a match without any Resource Types..")
+	output, found := describe(id, []resourceid.RegisteredID{
+		{
+			Name:               "ResourceGroup",
+			ServicePackageName: "resource",
+			Template:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}",
+		},
+	})
+	if found {
+		t.Fatalf("expected nothing to be found")
+	}
+	if !strings.Contains(output, "No Terraform Resources") {
+		t.Fatalf("expected the output to mention that no Terraform Resources are registered but got %q", output)
+	}
+
+	t.Logf("[DEBUG] Testing a match..")
+	output, found = describe(id, resourceid.Lookup(id))
+	if !found {
+		t.Fatalf("expected the Resource Group to be found but got %q", output)
+	}
+	if !strings.Contains(output, "terraform import azurerm_resource_group.example "+id) {
+		t.Fatalf("expected the output to contain the import command but got %q", output)
+	}
+}
@@ -3,10 +3,12 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"math"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -15,9 +17,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tools/possiblevalues"
 )
 
-// NOTE: since we're using `go run` for these tools all of the code needs to live within the main.go
+// NOTE: since we're using `go run` for these tools all of the code needs to live within the main.go (or a package it imports)
 
 func main() {
 	f := flag.NewFlagSet("example", flag.ExitOnError)
@@ -27,6 +30,7 @@ func main() {
 	resourceId := f.String("resource-id", "", "An Azure Resource ID showing an example of how to Import this Resource")
 	resourceType := f.String("type", "", "Whether this is a Data Source (data) or a Resource (resource)")
 	websitePath := f.String("website-path", "", "The relative path to the website folder")
+	checkOnly := f.Bool("check", false, "Check that the existing documentation matches the Schema, rather than generating it")
 
 	_ = f.Parse(os.Args[1:])
 
@@ -40,7 +44,7 @@ func main() {
 		return
 	}
 
-	if brandName == nil || *brandName == "" {
+	if !*checkOnly && (brandName == nil || *brandName == "") {
 		quitWithError("The friendly/brannd name of the Data Source/Resource must be specified via `-brand`")
 		return
 	}
@@ -61,11 +65,18 @@ func main() {
 	}
 
 	isResource := *resourceType == "resource"
-	if isResource && (resourceId == nil || *resourceId == "") {
+	if !*checkOnly && isResource && (resourceId == nil || *resourceId == "") {
 		quitWithError("An example of an Azure Resource ID must be specified via `-resource-id` when scaffolding for a Resource")
 		return
 	}
 
+	if *checkOnly {
+		if err := check(*resourceName, isResource, *websitePath); err != nil {
+			quitWithError(err.Error())
+		}
+		return
+	}
+
 	if err := run(*resourceName, *brandName, resourceId, isResource, *websitePath); err != nil {
 		panic(err)
 	}
@@ -80,7 +91,43 @@ func run(resourceName, brandName string, resourceId *string, isResource bool, we
 	return saveContent(resourceName, websitePath, *content, isResource)
 }
 
+func check(resourceName string, isResource bool, websitePath string) error {
+	generator, err := buildGenerator(resourceName, "", nil, isResource)
+	if err != nil {
+		return fmt.Errorf("Error building generator: %s", err)
+	}
+
+	contents, err := ioutil.ReadFile(documentationPath(resourceName, websitePath, isResource))
+	if err != nil {
+		return fmt.Errorf("Error reading existing documentation: %s", err)
+	}
+
+	if drift := generator.drift(string(contents)); len(drift) > 0 {
+		return fmt.Errorf("The documentation for %q has drifted from the Schema:\n\n* %s", resourceName, strings.Join(drift, "\n* "))
+	}
+
+	log.Printf("The documentation for %q matches the Schema", resourceName)
+	return nil
+}
+
 func getContent(resourceName, brandName string, resourceId *string, isResource bool) (*string, error) {
+	generator, err := buildGenerator(resourceName, brandName, resourceId, isResource)
+	if err != nil {
+		return nil, err
+	}
+
+	// typed resources expose a validation function for the Resource ID, so we can check the example is valid
+	if generator.idValidationFunc != nil && resourceId != nil {
+		if _, errs := generator.idValidationFunc(*resourceId, "resource-id"); len(errs) > 0 {
+			return nil, fmt.Errorf("the Resource ID %q isn't valid for %q: %+v", *resourceId, resourceName, errs)
+		}
+	}
+
+	docs := generator.generate()
+	return &docs, nil
+}
+
+func buildGenerator(resourceName, brandName string, resourceId *string, isResource bool) (*documentationGenerator, error) {
 	generator := documentationGenerator{
 		resourceName: resourceName,
 		brandName:    brandName,
@@ -128,6 +175,7 @@ func getContent(resourceName, brandName string, resourceId *string, isResource b
 					}
 
 					generator.resource = rsWrapper
+					generator.idValidationFunc = rs.IDValidationFunc()
 					generator.websiteCategories = service.WebsiteCategories()
 					break
 				}
@@ -148,18 +196,21 @@ func getContent(resourceName, brandName string, resourceId *string, isResource b
 		}
 	}
 
-	docs := generator.generate()
-	return &docs, nil
+	return &generator, nil
 }
 
-func saveContent(resourceName string, websitePath string, content string, isResource bool) error {
+func documentationPath(resourceName string, websitePath string, isResource bool) string {
 	resourceKind := "r"
 	if !isResource {
 		resourceKind = "d"
 	}
 
 	fileName := strings.TrimPrefix(resourceName, "azurerm_")
-	outputFileName := fmt.Sprintf("%s/docs/%s/%s.html.markdown", websitePath, resourceKind, fileName)
+	return fmt.Sprintf("%s/docs/%s/%s.html.markdown", websitePath, resourceKind, fileName)
+}
+
+func saveContent(resourceName string, websitePath string, content string, isResource bool) error {
+	outputFileName := documentationPath(resourceName, websitePath, isResource)
 	outputPath, err := filepath.Abs(outputFileName)
 	if err != nil {
 		return err
@@ -198,6 +249,9 @@ type documentationGenerator struct {
 	// resourceId is an example of the ID used by this Resource
 	resourceId *string
 
+	// idValidationFunc validates the ID of this Resource - which is only available for Typed Resources
+	idValidationFunc schema.SchemaValidateFunc
+
 	// websiteCategories is the list of categories available by this service definition
 	websiteCategories []string
 }
@@ -268,6 +322,12 @@ func (gen documentationGenerator) argumentsBlock() string {
 
 				value += fmt.Sprintf("Conflicts with %s", strings.Join(conflictingValues, ","))
 			}
+			if possibleValues := possiblevalues.ForField(fieldName, field); len(possibleValues) > 0 {
+				value += fmt.Sprintf(" Possible values are %s.", gen.formatPossibleValues(possibleValues))
+			}
+			if defaultValue := gen.defaultValueForField(field); defaultValue != "" {
+				value += fmt.Sprintf(" Defaults to `%s`.", defaultValue)
+			}
 			if field.ForceNew {
 				value += fmt.Sprintf(" Changing this forces a new %s to be created.", gen.brandName)
 			}
@@ -440,57 +500,24 @@ func (gen documentationGenerator) timeoutsBlock() string {
 
 	timeoutsBlurb := "The `timeouts` block allows you to specify [timeouts](https://www.terraform.io/docs/configuration/resources.html#timeouts) for certain actions:"
 
-	timeoutToFriendlyText := func(duration time.Duration) string {
-		hours := int(math.Floor(duration.Hours()))
-		if hours > 0 {
-			var hoursText string
-			if hours > 1 {
-				hoursText = fmt.Sprintf("%d hours", hours)
-			} else {
-				hoursText = "1 hour"
-			}
-
-			minutesRemaining := int(math.Floor(duration.Minutes())) % 60.0
-			if minutesRemaining == 0 {
-				return hoursText
-			}
-
-			var minutesText string
-			if minutesRemaining > 1 {
-				minutesText = fmt.Sprintf("%d minutes", minutesRemaining)
-			} else {
-				minutesText = "1 minute"
-			}
-
-			return fmt.Sprintf("%s and %s", hoursText, minutesText)
-		}
-
-		minutes := int(duration.Minutes())
-		if minutes > 1 {
-			return fmt.Sprintf("%d minutes", minutes)
-		}
-
-		return "1 minute"
-	}
-
 	timeoutsText := ""
 	if timeouts.Create != nil {
-		friendlyText := timeoutToFriendlyText(*timeouts.Create)
+		friendlyText := gen.timeoutToFriendlyText(*timeouts.Create)
 		timeoutsText += fmt.Sprintf("* `create` - (Defaults to %s) Used when creating the %s.\n", friendlyText, gen.brandName)
 	}
 
 	if timeouts.Read != nil {
-		friendlyText := timeoutToFriendlyText(*timeouts.Read)
+		friendlyText := gen.timeoutToFriendlyText(*timeouts.Read)
 		timeoutsText += fmt.Sprintf("* `read` - (Defaults to %s) Used when retrieving the %s.\n", friendlyText, gen.brandName)
 	}
 
 	if timeouts.Update != nil {
-		friendlyText := timeoutToFriendlyText(*timeouts.Update)
+		friendlyText := gen.timeoutToFriendlyText(*timeouts.Update)
 		timeoutsText += fmt.Sprintf("* `update` - (Defaults to %s) Used when updating the %s.\n", friendlyText, gen.brandName)
 	}
 
 	if timeouts.Delete != nil {
-		friendlyText := timeoutToFriendlyText(*timeouts.Delete)
+		friendlyText := gen.timeoutToFriendlyText(*timeouts.Delete)
 		timeoutsText += fmt.Sprintf("* `delete` - (Defaults to %s) Used when deleting the %s.\n", friendlyText, gen.brandName)
 	}
 
@@ -502,6 +529,39 @@ func (gen documentationGenerator) timeoutsBlock() string {
 %s`, timeoutsBlurb, timeoutsText)
 }
 
+func (gen documentationGenerator) timeoutToFriendlyText(duration time.Duration) string {
+	hours := int(math.Floor(duration.Hours()))
+	if hours > 0 {
+		var hoursText string
+		if hours > 1 {
+			hoursText = fmt.Sprintf("%d hours", hours)
+		} else {
+			hoursText = "1 hour"
+		}
+
+		minutesRemaining := int(math.Floor(duration.Minutes())) % 60.0
+		if minutesRemaining == 0 {
+			return hoursText
+		}
+
+		var minutesText string
+		if minutesRemaining > 1 {
+			minutesText = fmt.Sprintf("%d minutes", minutesRemaining)
+		} else {
+			minutesText = "1 minute"
+		}
+
+		return fmt.Sprintf("%s and %s", hoursText, minutesText)
+	}
+
+	minutes := int(duration.Minutes())
+	if minutes > 1 {
+		return fmt.Sprintf("%d minutes", minutes)
+	}
+
+	return "1 minute"
+}
+
 func (gen documentationGenerator) title() string {
 	if gen.isDataSource {
 		return fmt.Sprintf("Data Source: %s", gen.resourceName)
@@ -695,6 +755,27 @@ func (gen documentationGenerator) determineDefaultValueForExample(name string, f
 	return "\"TODO\""
 }
 
+func (gen documentationGenerator) defaultValueForField(field *schema.Schema) string {
+	if field.Default == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%v", field.Default)
+}
+
+func (gen documentationGenerator) formatPossibleValues(values []string) string {
+	quoted := make([]string, 0)
+	for _, v := range values {
+		quoted = append(quoted, fmt.Sprintf("`%s`", v))
+	}
+
+	if len(quoted) == 1 {
+		return quoted[0]
+	}
+
+	return fmt.Sprintf("%s and %s", strings.Join(quoted[0:len(quoted)-1], ", "), quoted[len(quoted)-1])
+}
+
 func (gen documentationGenerator) distinctBlockNames(input []string) []string {
 	// this is a delightful hack to work around multiple blocks being a thing
 	temp := make(map[string]struct{})
@@ -921,3 +1002,182 @@ func (gen documentationGenerator) uniqueBlockNamesForAttribute(fields map[string
 
 	return blockNames, blocks
 }
+
+// drift returns a list of differences between the existing documentation and the Schema
+func (gen documentationGenerator) drift(contents string) []string {
+	sections := gen.sectionsForDocumentation(contents)
+	drift := make([]string, 0)
+
+	// arguments, including those within nested blocks
+	expectedArguments := make(map[string]string)
+	gen.argumentNames(gen.resource.Schema, expectedArguments)
+	documentedArguments := gen.documentedFields(sections["arguments"], argumentLineRegex)
+	for name, status := range expectedArguments {
+		documentedStatus, ok := documentedArguments[name]
+		if !ok {
+			drift = append(drift, fmt.Sprintf("the argument `%s` isn't documented", name))
+			continue
+		}
+
+		// when the same name is used in multiple blocks with differing statuses we can't compare these
+		if status != "" && documentedStatus != status {
+			drift = append(drift, fmt.Sprintf("the argument `%s` is documented as %s but is %s in the Schema", name, documentedStatus, status))
+		}
+	}
+	for name := range documentedArguments {
+		if _, ok := expectedArguments[name]; !ok {
+			drift = append(drift, fmt.Sprintf("the argument `%s` is documented but doesn't exist in the Schema", name))
+		}
+	}
+
+	// attributes, including those within nested blocks
+	expectedAttributes := map[string]string{
+		"id": "",
+	}
+	gen.attributeNames(gen.resource.Schema, expectedAttributes)
+	documentedAttributes := gen.documentedFields(sections["attributes"], attributeLineRegex)
+	for name := range expectedAttributes {
+		if _, ok := documentedAttributes[name]; !ok {
+			drift = append(drift, fmt.Sprintf("the attribute `%s` isn't documented", name))
+		}
+	}
+	for name := range documentedAttributes {
+		_, isAttribute := expectedAttributes[name]
+		_, isArgument := expectedArguments[name]
+		if !isAttribute && !isArgument {
+			drift = append(drift, fmt.Sprintf("the attribute `%s` is documented but doesn't exist in the Schema", name))
+		}
+	}
+
+	// timeouts
+	expectedTimeouts := make(map[string]string)
+	if timeouts := gen.resource.Timeouts; timeouts != nil {
+		for name, v := range map[string]*time.Duration{"create": timeouts.Create, "read": timeouts.Read, "update": timeouts.Update, "delete": timeouts.Delete} {
+			if v != nil {
+				expectedTimeouts[name] = gen.timeoutToFriendlyText(*v)
+			}
+		}
+	}
+	documentedTimeouts := gen.documentedFields(sections["timeouts"], timeoutLineRegex)
+	for name, duration := range expectedTimeouts {
+		documentedDuration, ok := documentedTimeouts[name]
+		if !ok {
+			drift = append(drift, fmt.Sprintf("the `%s` timeout isn't documented", name))
+			continue
+		}
+		if documentedDuration != duration {
+			drift = append(drift, fmt.Sprintf("the `%s` timeout is documented as %s but is %s", name, documentedDuration, duration))
+		}
+	}
+	for name := range documentedTimeouts {
+		if _, ok := expectedTimeouts[name]; !ok {
+			drift = append(drift, fmt.Sprintf("the `%s` timeout is documented but isn't supported", name))
+		}
+	}
+
+	// import
+	if !gen.isDataSource && !strings.Contains(sections["import"], fmt.Sprintf("terraform import %s.", gen.resourceName)) {
+		drift = append(drift, "the Import section is missing an example of `terraform import`")
+	}
+
+	sort.Strings(drift)
+	return drift
+}
+
+var (
+	argumentLineRegex  = regexp.MustCompile("^\\* `([^`]+)` - \\((Required|Optional)\\)")
+	attributeLineRegex = regexp.MustCompile("^\\* `([^`]+)` -")
+	timeoutLineRegex   = regexp.MustCompile("^\\* `(create|read|update|delete)` - \\(Defaults to ([^)]+)\\)")
+)
+
+// sectionsForDocumentation splits the documentation into the Arguments, Attributes, Timeouts and Import sections
+func (gen documentationGenerator) sectionsForDocumentation(contents string) map[string]string {
+	sections := make(map[string]string)
+	current := ""
+	for _, line := range strings.Split(contents, "\n") {
+		if strings.HasPrefix(line, "## ") {
+			heading := strings.ToLower(strings.TrimPrefix(line, "## "))
+			switch {
+			case strings.HasPrefix(heading, "argument"):
+				current = "arguments"
+			case strings.HasPrefix(heading, "attribute"):
+				current = "attributes"
+			case strings.HasPrefix(heading, "timeout"):
+				current = "timeouts"
+			case strings.HasPrefix(heading, "import"):
+				current = "import"
+			default:
+				current = ""
+			}
+			continue
+		}
+
+		if current != "" {
+			sections[current] += line + "\n"
+		}
+	}
+
+	return sections
+}
+
+// documentedFields returns a map of the field name to the value of the second capture group (if any) for each matching line
+func (gen documentationGenerator) documentedFields(section string, lineRegex *regexp.Regexp) map[string]string {
+	out := make(map[string]string)
+	for _, line := range strings.Split(section, "\n") {
+		matches := lineRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if len(matches) < 2 {
+			continue
+		}
+
+		value := ""
+		if len(matches) > 2 {
+			value = matches[2]
+		}
+		out[matches[1]] = value
+	}
+
+	return out
+}
+
+// argumentNames populates `out` with the name and status (Required/Optional) of each argument, including
+// those within nested blocks - where the status differs between blocks this is set to an empty string
+func (gen documentationGenerator) argumentNames(input map[string]*schema.Schema, out map[string]string) {
+	for name, field := range input {
+		if !field.Optional && !field.Required {
+			continue
+		}
+
+		status := "Optional"
+		if field.Required {
+			status = "Required"
+		}
+		if existing, ok := out[name]; ok && existing != status {
+			status = ""
+		}
+		out[name] = status
+
+		if v, ok := field.Elem.(*schema.Resource); ok && v != nil {
+			gen.argumentNames(v.Schema, out)
+		}
+	}
+}
+
+// attributeNames populates `out` with the name of each computed-only attribute, including those within nested blocks
+func (gen documentationGenerator) attributeNames(input map[string]*schema.Schema, out map[string]string) {
+	for name, field := range input {
+		isAttribute := field.Computed && !field.Optional && !field.Required
+		if isAttribute {
+			out[name] = ""
+		}
+
+		if v, ok := field.Elem.(*schema.Resource); ok && v != nil {
+			if isAttribute {
+				// every field within a computed-only block is an attribute
+				for innerName := range v.Schema {
+					out[innerName] = ""
+				}
+			}
+			gen.attributeNames(v.Schema, out)
+		}
+	}
+}
@@ -3,8 +3,10 @@ package main
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/sergi/go-diff/diffmatchpatch"
 )
 
@@ -185,3 +187,118 @@ func setupDocGen(isDataSource bool, resource *schema.Resource) documentationGene
 		resource:          resource,
 	}
 }
+
+func TestArgumentPossibleValuesAndDefaults(t *testing.T) {
+	expectedOut := strings.ReplaceAll(`## Arguments Reference
+
+The following arguments are supported:
+
+* 'count' - (Optional) TODO. Possible values are '1', '2' and '3'. Defaults to '1'.
+
+* 'sku' - (Optional) TODO. Possible values are 'Basic' and 'Standard'. Defaults to 'Basic'. Changing this forces a new Foobar to be created.`, "'", "`")
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntInSlice([]int{1, 2, 3}),
+			},
+			"sku": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Basic",
+				ValidateFunc: validation.StringInSlice([]string{"Basic", "Standard"}, false),
+			},
+		},
+	}
+	gen := setupDocGen(false, resource)
+	actualOut := gen.argumentsBlock()
+
+	runTest(t, expectedOut, actualOut)
+}
+
+func TestDrift(t *testing.T) {
+	thirtyMinutes := 30 * time.Minute
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sku": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"block": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nested": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: &thirtyMinutes,
+			Read:   &thirtyMinutes,
+		},
+	}
+	gen := setupDocGen(false, resource)
+
+	t.Logf("[DEBUG] Testing the generated documentation..")
+	if drift := gen.drift(gen.generate()); len(drift) > 0 {
+		t.Fatalf("expected no drift for the generated documentation but got: %+v", drift)
+	}
+
+	t.Logf("[DEBUG] Testing documentation which has drifted..")
+	drifted := strings.ReplaceAll(`## Argument Reference
+
+* 'name' - (Optional) The name.
+
+* 'old_field' - (Optional) Removed.
+
+---
+
+A 'block' block supports the following:
+
+* 'nested' - (Required) Nested.
+
+## Attributes Reference
+
+* 'id' - The ID.
+
+## Timeouts
+
+* 'create' - (Defaults to 1 hour) Used when creating.
+
+* 'delete' - (Defaults to 30 minutes) Used when deleting.
+
+## Import
+
+terraform import azurerm_foobar.example 12345`, "'", "`")
+	expected := []string{
+		"the `create` timeout is documented as 1 hour but is 30 minutes",
+		"the `delete` timeout is documented but isn't supported",
+		"the `read` timeout isn't documented",
+		"the argument `block` isn't documented",
+		"the argument `name` is documented as Optional but is Required in the Schema",
+		"the argument `old_field` is documented but doesn't exist in the Schema",
+		"the argument `sku` isn't documented",
+		"the attribute `fqdn` isn't documented",
+	}
+	actual := gen.drift(drifted)
+	if strings.Join(actual, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("expected:\n\n%s\n\nbut got:\n\n%s", strings.Join(expected, "\n"), strings.Join(actual, "\n"))
+	}
+}